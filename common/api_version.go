@@ -20,6 +20,8 @@ const (
 	Vulkan1_1 APIVersion = C.VK_API_VERSION_1_1
 	// Vulkan1_2 indicates core 1.2
 	Vulkan1_2 APIVersion = C.VK_API_VERSION_1_2
+	// Vulkan1_3 indicates core 1.3
+	Vulkan1_3 APIVersion = C.VK_API_VERSION_1_3
 )
 
 // Variant is the variant number of the APIVersion number- this number is rarely included in
@@ -0,0 +1,66 @@
+package common
+
+import (
+	"fmt"
+	"github.com/cockroachdb/errors"
+)
+
+// ErrNilArgument is wrapped by the errors that wrapper methods return, or panic with, when they
+// are passed a nil object that they require
+var ErrNilArgument = errors.New("cannot be nil")
+
+// FunctionError is an error raised by a specific Vulkan command or wrapper method that was called
+// incorrectly, such as an instance-level command called on a driver without an instance, or
+// CommandBuffer.CmdBindPipeline called with a nil pipeline. Err is a sentinel error such as
+// ErrNilArgument, and can be checked with errors.Is.
+//
+// Methods that return an error return a FunctionError. Methods that cannot return an error panic
+// with one instead, which can be converted back to an error with RecoverFunctionError.
+type FunctionError struct {
+	// Function is the name of the Vulkan command or wrapper method that failed, i.e.
+	// "vkEnumeratePhysicalDevices" or "CmdBindPipeline"
+	Function string
+	// Err is the reason the function failed
+	Err error
+}
+
+func (e *FunctionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Function, e.Err.Error())
+}
+
+func (e *FunctionError) Unwrap() error {
+	return e.Err
+}
+
+// NilArgumentError creates a *FunctionError for function that indicates the argument named by
+// argument was nil. The FunctionError wraps ErrNilArgument.
+func NilArgumentError(function string, argument string) *FunctionError {
+	return &FunctionError{
+		Function: function,
+		Err:      fmt.Errorf("%s %w", argument, ErrNilArgument),
+	}
+}
+
+// RecoverFunctionError recovers from a panic with a *FunctionError and writes it to err. It must be
+// called with defer, from a function that calls methods which cannot return an error:
+//
+//	func record(commandBuffer core1_0.CommandBuffer, pipeline core1_0.Pipeline) (err error) {
+//		defer common.RecoverFunctionError(&err)
+//		commandBuffer.CmdBindPipeline(core1_0.PipelineBindPointGraphics, pipeline)
+//		return nil
+//	}
+//
+// Panics with any other value are not recovered.
+func RecoverFunctionError(err *error) {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+
+	functionErr, isFunctionErr := recovered.(*FunctionError)
+	if !isFunctionErr {
+		panic(recovered)
+	}
+
+	*err = functionErr
+}
@@ -0,0 +1,39 @@
+package common_test
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"testing"
+)
+
+var errSentinel = errors.New("sentinel")
+
+func TestRecoverFunctionError(t *testing.T) {
+	err := func() (err error) {
+		defer common.RecoverFunctionError(&err)
+		panic(&common.FunctionError{Function: "vkCmdDraw", Err: errSentinel})
+	}()
+	require.True(t, errors.Is(err, errSentinel))
+	require.EqualError(t, err, "vkCmdDraw: sentinel")
+
+	err = func() (err error) {
+		defer common.RecoverFunctionError(&err)
+		return nil
+	}()
+	require.NoError(t, err)
+}
+
+func TestRecoverFunctionError_OtherPanic(t *testing.T) {
+	require.PanicsWithValue(t, "not a function error", func() {
+		var err error
+		defer common.RecoverFunctionError(&err)
+		panic("not a function error")
+	})
+}
+
+func TestNilArgumentError(t *testing.T) {
+	err := common.NilArgumentError("CmdBindPipeline", "pipeline")
+	require.True(t, errors.Is(err, common.ErrNilArgument))
+	require.EqualError(t, err, "CmdBindPipeline: pipeline cannot be nil")
+}
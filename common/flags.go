@@ -1,15 +1,19 @@
 package common
 
-import "strings"
+import (
+	"strings"
+	"unsafe"
+)
 
 type flags interface {
-	~int32 | ~uint32
+	~int32 | ~uint32 | ~int64 | ~uint64
 }
 
 // FlagStringMapping is used as a base type for many bitflag enums in vkngwrapper.
 // It has the capability to Register flags with a descriptive string in an init() method.
 // Once that has done, the flag type can be stringified into a pipe-separated list of
-// flags.
+// flags. Both 32-bit and 64-bit flag types, such as the Flags2 types introduced by
+// Vulkan 1.3, are supported.
 type FlagStringMapping[T flags] struct {
 	stringValues map[T]string
 }
@@ -36,7 +40,8 @@ func (m FlagStringMapping[T]) FlagsToString(value T) string {
 	hasOne := false
 	var sb strings.Builder
 
-	for i := 0; i < 32; i++ {
+	bitCount := int(unsafe.Sizeof(value)) * 8
+	for i := 0; i < bitCount; i++ {
 		shiftedBit := T(1 << i)
 		if value&shiftedBit != 0 {
 			strVal, exists := m.stringValues[shiftedBit]
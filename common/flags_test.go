@@ -31,3 +31,30 @@ func ExampleFlagStringMapping() {
 	fmt.Println(CoolFlagsBlue | CoolFlagsRed)
 	// Output: Red|Blue
 }
+
+type WideFlags uint64
+
+var wideFlagsMapping = common.NewFlagStringMapping[WideFlags]()
+
+func (f WideFlags) Register(str string) {
+	wideFlagsMapping.Register(f, str)
+}
+
+func (f WideFlags) String() string {
+	return wideFlagsMapping.FlagsToString(f)
+}
+
+const (
+	WideFlagsLow  WideFlags = 0x00000001
+	WideFlagsHigh WideFlags = 0x100000000
+)
+
+func init() {
+	WideFlagsLow.Register("Low")
+	WideFlagsHigh.Register("High")
+}
+
+func ExampleFlagStringMapping_wide() {
+	fmt.Println(WideFlagsHigh | WideFlagsLow)
+	// Output: Low|High
+}
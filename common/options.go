@@ -33,6 +33,17 @@ type OutData interface {
 	NextOutDataInChain() OutData
 }
 
+// OptionsOutData is implemented by vkngwrapper Options structures that pass input into Vulkan,
+// but also point Vulkan at memory that it writes output into during the command. Commands that
+// accept these structures call PopulateOptionsOutData after Vulkan returns. If you are not
+// contributing to vkngwrapper or writing a Vulkan extension wrapper, you do not need to
+// understand this type.
+type OptionsOutData interface {
+	Options
+
+	PopulateOptionsOutData(cDataPointer unsafe.Pointer) error
+}
+
 // NextOptions is embedded by all vkngwrapper structures that pass input into Vulkan and
 // are chainable. If you are wondering which structures can chain onto which other structures,
 // all structures which embed NextOptions can be chained onto any other structures which embeds
@@ -215,6 +226,53 @@ func PopulateOutDataSlice[T any, O OutData](o []O, cSlicePointer unsafe.Pointer,
 	return nil
 }
 
+// chainHeader matches the layout of the sType and pNext fields that begin every chainable
+// Vulkan structure
+type chainHeader struct {
+	sType int32
+	pNext unsafe.Pointer
+}
+
+// PopulateOptionsOutData walks a chain of Options alongside the same chain in a C pointer and
+// populates output for every OptionsOutData in the chain. The C pointer should have been previously
+// allocated with AllocOptions and passed to a Vulkan command. If you are not contributing to
+// vkngwrapper or writing a Vulkan extension wrapper, you do not need to understand this method.
+func PopulateOptionsOutData(o Options, cPointer unsafe.Pointer) error {
+	for o != nil && cPointer != nil {
+		outData, ok := o.(OptionsOutData)
+		if ok {
+			err := outData.PopulateOptionsOutData(cPointer)
+			if err != nil {
+				return err
+			}
+		}
+
+		o = o.NextOptionsInChain()
+		cPointer = (*chainHeader)(cPointer).pNext
+	}
+
+	return nil
+}
+
+// PopulateOptionsOutDataSlice populates output for every OptionsOutData in a slice of Options
+// chains from an array of chains in a C pointer. The C pointer should have been previously
+// allocated with AllocOptionSlice and passed to a Vulkan command. If you are not contributing
+// to vkngwrapper or writing a Vulkan extension wrapper, you do not need to understand this method.
+func PopulateOptionsOutDataSlice[T any, O Options](o []O, cSlicePointer unsafe.Pointer) error {
+	cElementSize := unsafe.Sizeof([1]T{})
+
+	for i := 0; i < len(o); i++ {
+		err := PopulateOptionsOutData(o[i], cSlicePointer)
+		if err != nil {
+			return err
+		}
+
+		cSlicePointer = unsafe.Add(cSlicePointer, cElementSize)
+	}
+
+	return nil
+}
+
 // OfType locates the first value in an untyped array which matches the parameter type and
 // returns it, if any. Otherwise, it will return the default value of the parameter type.
 // an `ok` return value is included, for convenience. If you are not contributing to
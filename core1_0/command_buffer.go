@@ -106,7 +106,7 @@ func (c *VulkanCommandBuffer) CmdEndRenderPass() {
 
 func (c *VulkanCommandBuffer) CmdBindPipeline(bindPoint PipelineBindPoint, pipeline Pipeline) {
 	if pipeline == nil {
-		panic("pipeline cannot be nil")
+		panic(common.NilArgumentError("CmdBindPipeline", "pipeline"))
 	}
 
 	c.deviceDriver.VkCmdBindPipeline(c.commandBufferHandle, driver.VkPipelineBindPoint(bindPoint), pipeline.Handle())
@@ -142,7 +142,7 @@ func (c *VulkanCommandBuffer) CmdBindVertexBuffers(firstBinding int, buffers []B
 
 	for i := 0; i < bufferCount; i++ {
 		if buffers[i] == nil {
-			panic(fmt.Sprintf("element %d of buffers slice is nil", i))
+			panic(common.NilArgumentError("CmdBindVertexBuffers", fmt.Sprintf("element %d of buffers", i)))
 		}
 		bufferArraySlice[i] = buffers[i].Handle()
 		offsetArraySlice[i] = driver.VkDeviceSize(bufferOffsets[i])
@@ -159,7 +159,7 @@ func (c *VulkanCommandBuffer) CmdBindIndexBuffer(buffer Buffer, offset int, inde
 
 func (c *VulkanCommandBuffer) CmdBindDescriptorSets(bindPoint PipelineBindPoint, layout PipelineLayout, firstSet int, sets []DescriptorSet, dynamicOffsets []int) {
 	if layout == nil {
-		panic("layout cannot be nil")
+		panic(common.NilArgumentError("CmdBindDescriptorSets", "layout"))
 	}
 
 	arena := cgoparam.GetAlloc()
@@ -244,10 +244,10 @@ func (c *VulkanCommandBuffer) CmdPipelineBarrier(srcStageMask, dstStageMask Pipe
 
 func (c *VulkanCommandBuffer) CmdCopyBufferToImage(buffer Buffer, image Image, layout ImageLayout, regions []BufferImageCopy) error {
 	if buffer == nil {
-		panic("buffer cannot be nil")
+		return common.NilArgumentError("CmdCopyBufferToImage", "buffer")
 	}
 	if image == nil {
-		panic("image cannot be nil")
+		return common.NilArgumentError("CmdCopyBufferToImage", "image")
 	}
 	arena := cgoparam.GetAlloc()
 	defer cgoparam.ReturnAlloc(arena)
@@ -271,11 +271,11 @@ func (c *VulkanCommandBuffer) CmdCopyBufferToImage(buffer Buffer, image Image, l
 func (c *VulkanCommandBuffer) CmdBlitImage(sourceImage Image, sourceImageLayout ImageLayout, destinationImage Image, destinationImageLayout ImageLayout, regions []ImageBlit, filter Filter) error {
 
 	if sourceImage == nil {
-		panic("sourceImage must not be nil")
+		return common.NilArgumentError("CmdBlitImage", "sourceImage")
 	}
 
 	if destinationImage == nil {
-		panic("destinationImage must not be nil")
+		return common.NilArgumentError("CmdBlitImage", "destinationImage")
 	}
 
 	allocator := cgoparam.GetAlloc()
@@ -303,7 +303,7 @@ func (c *VulkanCommandBuffer) CmdBlitImage(sourceImage Image, sourceImageLayout
 
 func (c *VulkanCommandBuffer) CmdPushConstants(layout PipelineLayout, stageFlags ShaderStageFlags, offset int, valueBytes []byte) {
 	if layout == nil {
-		panic("layout cannot be nil")
+		panic(common.NilArgumentError("CmdPushConstants", "layout"))
 	}
 
 	alloc := cgoparam.GetAlloc()
@@ -391,7 +391,7 @@ func (c *VulkanCommandBuffer) CmdWaitEvents(events []Event, srcStageMask Pipelin
 
 		for i := 0; i < eventCount; i++ {
 			if events[i] == nil {
-				panic(fmt.Sprintf("element %d of the events slice was nil", i))
+				return common.NilArgumentError("CmdWaitEvents", fmt.Sprintf("element %d of events", i))
 			}
 			eventSlice[i] = C.VkEvent(unsafe.Pointer(events[i].Handle()))
 		}
@@ -425,7 +425,7 @@ func (c *VulkanCommandBuffer) CmdWaitEvents(events []Event, srcStageMask Pipelin
 
 func (c *VulkanCommandBuffer) CmdSetEvent(event Event, stageMask PipelineStageFlags) {
 	if event == nil {
-		panic("event cannot be nil")
+		panic(common.NilArgumentError("CmdSetEvent", "event"))
 	}
 
 	c.deviceDriver.VkCmdSetEvent(c.commandBufferHandle, event.Handle(), driver.VkPipelineStageFlags(stageMask))
@@ -434,7 +434,7 @@ func (c *VulkanCommandBuffer) CmdSetEvent(event Event, stageMask PipelineStageFl
 
 func (c *VulkanCommandBuffer) CmdClearColorImage(image Image, imageLayout ImageLayout, color ClearColorValue, ranges []ImageSubresourceRange) {
 	if image == nil {
-		panic("image cannot be nil")
+		panic(common.NilArgumentError("CmdClearColorImage", "image"))
 	}
 
 	arena := cgoparam.GetAlloc()
@@ -468,7 +468,7 @@ func (c *VulkanCommandBuffer) CmdClearColorImage(image Image, imageLayout ImageL
 
 func (c *VulkanCommandBuffer) CmdResetQueryPool(queryPool QueryPool, startQuery, queryCount int) {
 	if queryPool == nil {
-		panic("queryPool cannot be nil")
+		panic(common.NilArgumentError("CmdResetQueryPool", "queryPool"))
 	}
 
 	c.deviceDriver.VkCmdResetQueryPool(c.commandBufferHandle, queryPool.Handle(), driver.Uint32(startQuery), driver.Uint32(queryCount))
@@ -477,7 +477,7 @@ func (c *VulkanCommandBuffer) CmdResetQueryPool(queryPool QueryPool, startQuery,
 
 func (c *VulkanCommandBuffer) CmdBeginQuery(queryPool QueryPool, query int, flags QueryControlFlags) {
 	if queryPool == nil {
-		panic("queryPool cannot be nil")
+		panic(common.NilArgumentError("CmdBeginQuery", "queryPool"))
 	}
 
 	c.deviceDriver.VkCmdBeginQuery(c.commandBufferHandle, queryPool.Handle(), driver.Uint32(query), driver.VkQueryControlFlags(flags))
@@ -486,7 +486,7 @@ func (c *VulkanCommandBuffer) CmdBeginQuery(queryPool QueryPool, query int, flag
 
 func (c *VulkanCommandBuffer) CmdEndQuery(queryPool QueryPool, query int) {
 	if queryPool == nil {
-		panic("queryPool cannot be nil")
+		panic(common.NilArgumentError("CmdEndQuery", "queryPool"))
 	}
 
 	c.deviceDriver.VkCmdEndQuery(c.commandBufferHandle, queryPool.Handle(), driver.Uint32(query))
@@ -495,10 +495,10 @@ func (c *VulkanCommandBuffer) CmdEndQuery(queryPool QueryPool, query int) {
 
 func (c *VulkanCommandBuffer) CmdCopyQueryPoolResults(queryPool QueryPool, firstQuery, queryCount int, dstBuffer Buffer, dstOffset, stride int, flags QueryResultFlags) {
 	if queryPool == nil {
-		panic("queryPool cannot be nil")
+		panic(common.NilArgumentError("CmdCopyQueryPoolResults", "queryPool"))
 	}
 	if dstBuffer == nil {
-		panic("dstBuffer cannot be nil")
+		panic(common.NilArgumentError("CmdCopyQueryPoolResults", "dstBuffer"))
 	}
 	c.deviceDriver.VkCmdCopyQueryPoolResults(c.commandBufferHandle, queryPool.Handle(), driver.Uint32(firstQuery), driver.Uint32(queryCount), dstBuffer.Handle(), driver.VkDeviceSize(dstOffset), driver.VkDeviceSize(stride), driver.VkQueryResultFlags(flags))
 	c.commandCounter.CommandCount++
@@ -516,7 +516,7 @@ func (c *VulkanCommandBuffer) CmdExecuteCommands(commandBuffers []CommandBuffer)
 	var addToDispatchCount int
 	for i := 0; i < bufferCount; i++ {
 		if commandBuffers[i] == nil {
-			panic(fmt.Sprintf("element %d of the commandBuffers slice was nil", i))
+			panic(common.NilArgumentError("CmdExecuteCommands", fmt.Sprintf("element %d of commandBuffers", i)))
 		}
 		commandBufferSlice[i] = C.VkCommandBuffer(unsafe.Pointer(commandBuffers[i].Handle()))
 		addToDrawCount += commandBuffers[i].DrawsRecorded()
@@ -552,7 +552,7 @@ func (c *VulkanCommandBuffer) CmdClearAttachments(attachments []ClearAttachment,
 
 func (c *VulkanCommandBuffer) CmdClearDepthStencilImage(image Image, imageLayout ImageLayout, depthStencil *ClearValueDepthStencil, ranges []ImageSubresourceRange) {
 	if image == nil {
-		panic("image cannot be nil")
+		panic(common.NilArgumentError("CmdClearDepthStencilImage", "image"))
 	}
 	arena := cgoparam.GetAlloc()
 	defer cgoparam.ReturnAlloc(arena)
@@ -579,10 +579,10 @@ func (c *VulkanCommandBuffer) CmdClearDepthStencilImage(image Image, imageLayout
 
 func (c *VulkanCommandBuffer) CmdCopyImageToBuffer(srcImage Image, srcImageLayout ImageLayout, dstBuffer Buffer, regions []BufferImageCopy) error {
 	if srcImage == nil {
-		panic("srcImage cannot be nil")
+		return common.NilArgumentError("CmdCopyImageToBuffer", "srcImage")
 	}
 	if dstBuffer == nil {
-		panic("dstBuffer cannot be nil")
+		return common.NilArgumentError("CmdCopyImageToBuffer", "dstBuffer")
 	}
 	arena := cgoparam.GetAlloc()
 	defer cgoparam.ReturnAlloc(arena)
@@ -606,7 +606,7 @@ func (c *VulkanCommandBuffer) CmdDispatch(groupCountX, groupCountY, groupCountZ
 
 func (c *VulkanCommandBuffer) CmdDispatchIndirect(buffer Buffer, offset int) {
 	if buffer == nil {
-		panic("buffer cannot be nil")
+		panic(common.NilArgumentError("CmdDispatchIndirect", "buffer"))
 	}
 	c.deviceDriver.VkCmdDispatchIndirect(c.commandBufferHandle, buffer.Handle(), driver.VkDeviceSize(offset))
 	c.commandCounter.CommandCount++
@@ -615,7 +615,7 @@ func (c *VulkanCommandBuffer) CmdDispatchIndirect(buffer Buffer, offset int) {
 
 func (c *VulkanCommandBuffer) CmdDrawIndexedIndirect(buffer Buffer, offset int, drawCount, stride int) {
 	if buffer == nil {
-		panic("buffer cannot be nil")
+		panic(common.NilArgumentError("CmdDrawIndexedIndirect", "buffer"))
 	}
 	c.deviceDriver.VkCmdDrawIndexedIndirect(c.commandBufferHandle, buffer.Handle(), driver.VkDeviceSize(offset), driver.Uint32(drawCount), driver.Uint32(stride))
 	c.commandCounter.CommandCount++
@@ -624,7 +624,7 @@ func (c *VulkanCommandBuffer) CmdDrawIndexedIndirect(buffer Buffer, offset int,
 
 func (c *VulkanCommandBuffer) CmdDrawIndirect(buffer Buffer, offset int, drawCount, stride int) {
 	if buffer == nil {
-		panic("buffer cannot be nil")
+		panic(common.NilArgumentError("CmdDrawIndirect", "buffer"))
 	}
 	c.deviceDriver.VkCmdDrawIndirect(c.commandBufferHandle, buffer.Handle(), driver.VkDeviceSize(offset), driver.Uint32(drawCount), driver.Uint32(stride))
 	c.commandCounter.CommandCount++
@@ -633,7 +633,7 @@ func (c *VulkanCommandBuffer) CmdDrawIndirect(buffer Buffer, offset int, drawCou
 
 func (c *VulkanCommandBuffer) CmdFillBuffer(dstBuffer Buffer, dstOffset int, size int, data uint32) {
 	if dstBuffer == nil {
-		panic("dstBuffer cannot be nil")
+		panic(common.NilArgumentError("CmdFillBuffer", "dstBuffer"))
 	}
 	c.deviceDriver.VkCmdFillBuffer(c.commandBufferHandle, dstBuffer.Handle(), driver.VkDeviceSize(dstOffset), driver.VkDeviceSize(size), driver.Uint32(data))
 	c.commandCounter.CommandCount++
@@ -641,7 +641,7 @@ func (c *VulkanCommandBuffer) CmdFillBuffer(dstBuffer Buffer, dstOffset int, siz
 
 func (c *VulkanCommandBuffer) CmdResetEvent(event Event, stageMask PipelineStageFlags) {
 	if event == nil {
-		panic("event cannot be nil")
+		panic(common.NilArgumentError("CmdResetEvent", "event"))
 	}
 	c.deviceDriver.VkCmdResetEvent(c.commandBufferHandle, event.Handle(), driver.VkPipelineStageFlags(stageMask))
 	c.commandCounter.CommandCount++
@@ -649,10 +649,10 @@ func (c *VulkanCommandBuffer) CmdResetEvent(event Event, stageMask PipelineStage
 
 func (c *VulkanCommandBuffer) CmdResolveImage(srcImage Image, srcImageLayout ImageLayout, dstImage Image, dstImageLayout ImageLayout, regions []ImageResolve) error {
 	if srcImage == nil {
-		panic("srcImage cannot be nil")
+		return common.NilArgumentError("CmdResolveImage", "srcImage")
 	}
 	if dstImage == nil {
-		panic("dstImage cannot be nil")
+		return common.NilArgumentError("CmdResolveImage", "dstImage")
 	}
 	arena := cgoparam.GetAlloc()
 	defer cgoparam.ReturnAlloc(arena)
@@ -715,7 +715,7 @@ func (c *VulkanCommandBuffer) CmdSetStencilWriteMask(faceMask StencilFaceFlags,
 
 func (c *VulkanCommandBuffer) CmdUpdateBuffer(dstBuffer Buffer, dstOffset int, dataSize int, data []byte) {
 	if dstBuffer == nil {
-		panic("dstBuffer cannot be nil")
+		panic(common.NilArgumentError("CmdUpdateBuffer", "dstBuffer"))
 	}
 	arena := cgoparam.GetAlloc()
 	defer cgoparam.ReturnAlloc(arena)
@@ -731,7 +731,7 @@ func (c *VulkanCommandBuffer) CmdUpdateBuffer(dstBuffer Buffer, dstOffset int, d
 
 func (c *VulkanCommandBuffer) CmdWriteTimestamp(pipelineStage PipelineStageFlags, queryPool QueryPool, query int) {
 	if queryPool == nil {
-		panic("queryPool cannot be nil")
+		panic(common.NilArgumentError("CmdWriteTimestamp", "queryPool"))
 	}
 
 	c.deviceDriver.VkCmdWriteTimestamp(c.commandBufferHandle, driver.VkPipelineStageFlags(pipelineStage), queryPool.Handle(), driver.Uint32(query))
@@ -178,6 +178,25 @@ func TestCommandBuffer_CmdBindGraphicsPipeline(t *testing.T) {
 	buffer.CmdBindPipeline(core1_0.PipelineBindPointGraphics, pipeline)
 }
 
+func TestCommandBuffer_CmdBindPipeline_NilPipeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, buffer := setup(t, ctrl)
+
+	err := func() (err error) {
+		defer common.RecoverFunctionError(&err)
+		buffer.CmdBindPipeline(core1_0.PipelineBindPointGraphics, nil)
+		return nil
+	}()
+	require.ErrorIs(t, err, common.ErrNilArgument)
+	require.EqualError(t, err, "CmdBindPipeline: pipeline cannot be nil")
+
+	var functionErr *common.FunctionError
+	require.ErrorAs(t, err, &functionErr)
+	require.Equal(t, "CmdBindPipeline", functionErr.Function)
+}
+
 func TestCommandBuffer_CmdDraw(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -428,6 +447,18 @@ func TestVulkanCommandBuffer_CmdPipelineBarrier(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestVulkanCommandBuffer_CmdCopyBuffer_NilBuffer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, buffer := setup(t, ctrl)
+	dest := mocks.EasyMockBuffer(ctrl)
+
+	err := buffer.CmdCopyBuffer(nil, dest, []core1_0.BufferCopy{{Size: 7}})
+	require.ErrorIs(t, err, common.ErrNilArgument)
+	require.EqualError(t, err, "CmdCopyBuffer: srcBuffer cannot be nil")
+}
+
 func TestVulkanCommandBuffer_CmdCopyBufferToImage(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -14,10 +14,10 @@ import (
 
 func (c *VulkanCommandBuffer) CmdCopyBuffer(srcBuffer Buffer, dstBuffer Buffer, copyRegions []BufferCopy) error {
 	if srcBuffer == nil {
-		panic("srcBuffer cannot be nil")
+		return common.NilArgumentError("CmdCopyBuffer", "srcBuffer")
 	}
 	if dstBuffer == nil {
-		panic("dstBuffer cannot be nil")
+		return common.NilArgumentError("CmdCopyBuffer", "dstBuffer")
 	}
 
 	allocator := cgoparam.GetAlloc()
@@ -34,10 +34,10 @@ func (c *VulkanCommandBuffer) CmdCopyBuffer(srcBuffer Buffer, dstBuffer Buffer,
 
 func (c *VulkanCommandBuffer) CmdCopyImage(srcImage Image, srcImageLayout ImageLayout, dstImage Image, dstImageLayout ImageLayout, regions []ImageCopy) error {
 	if srcImage == nil {
-		panic("srcImage cannot be nil")
+		return common.NilArgumentError("CmdCopyImage", "srcImage")
 	}
 	if dstImage == nil {
-		panic("dstImage cannot be nil")
+		return common.NilArgumentError("CmdCopyImage", "dstImage")
 	}
 	allocator := cgoparam.GetAlloc()
 	defer cgoparam.ReturnAlloc(allocator)
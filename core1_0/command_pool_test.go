@@ -1,12 +1,14 @@
 package core1_0_test
 
 import (
+	"github.com/cockroachdb/errors"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/vkngwrapper/core/v2/common"
 	"github.com/vkngwrapper/core/v2/core1_0"
 	"github.com/vkngwrapper/core/v2/driver"
 	mock_driver "github.com/vkngwrapper/core/v2/driver/mocks"
+	"github.com/vkngwrapper/core/v2/driver/validation"
 	internal_mocks "github.com/vkngwrapper/core/v2/internal/dummies"
 	"github.com/vkngwrapper/core/v2/mocks"
 	"reflect"
@@ -96,6 +98,37 @@ func TestCommandBufferSingleAllocateFree(t *testing.T) {
 	device.FreeCommandBuffers(buffers)
 }
 
+func TestCommandBufferAllocate_DriverError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_0)
+	device := internal_mocks.EasyDummyDevice(mockDriver)
+
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+
+	// Errors from decorating drivers are passed through rather than replaced with one built from
+	// the VkResult
+	driverErr := &validation.ConcurrentUseError{
+		Handle:        driver.VulkanHandle(commandPool.Handle()),
+		Function:      "vkAllocateCommandBuffers",
+		OtherFunction: "vkResetCommandPool",
+	}
+	mockDriver.EXPECT().VkAllocateCommandBuffers(device.Handle(), gomock.Not(nil), gomock.Not(nil)).Return(core1_0.VKErrorUnknown, driverErr)
+
+	buffers, res, err := device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        commandPool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 1,
+	})
+	require.Nil(t, buffers)
+	require.Equal(t, core1_0.VKErrorUnknown, res)
+
+	var concurrentUse *validation.ConcurrentUseError
+	require.True(t, errors.As(err, &concurrentUse))
+	require.Equal(t, driverErr, concurrentUse)
+}
+
 func TestCommandBufferMultiAllocateFree(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -112,6 +112,10 @@ type WriteDescriptorSet struct {
 	common.NextOptions
 }
 
+// WriteDescriptorSetExtensionSource is implemented by Options structures that can be chained
+// onto WriteDescriptorSet to provide the descriptors being written, in place of ImageInfo,
+// BufferInfo, or TexelBufferView. WriteDescriptorSetCount returns the descriptorCount to use
+// for the write.
 type WriteDescriptorSetExtensionSource interface {
 	WriteDescriptorSetCount() int
 }
@@ -130,7 +134,7 @@ func (o WriteDescriptorSet) PopulateCPointer(allocator *cgoparam.Allocator, prea
 	nextObj := o.Next
 	for nextObj != nil {
 		var isExtSource bool
-		extSource, isExtSource = o.Next.(WriteDescriptorSetExtensionSource)
+		extSource, isExtSource = nextObj.(WriteDescriptorSetExtensionSource)
 		if isExtSource {
 			break
 		}
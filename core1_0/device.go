@@ -64,7 +64,7 @@ func (d *VulkanDevice) WaitForFences(waitForAll bool, timeout time.Duration, fen
 	fenceSlice := ([]driver.VkFence)(unsafe.Slice(fencePtr, fenceCount))
 	for i := 0; i < fenceCount; i++ {
 		if fences[i] == nil {
-			panic(fmt.Sprintf("element %d of slice fences is nil", i))
+			return VKErrorUnknown, common.NilArgumentError("WaitForFences", fmt.Sprintf("element %d of fences", i))
 		}
 		fenceSlice[i] = fences[i].Handle()
 	}
@@ -88,7 +88,7 @@ func (d *VulkanDevice) ResetFences(fences []Fence) (common.VkResult, error) {
 	fenceSlice := ([]driver.VkFence)(unsafe.Slice(fencePtr, fenceCount))
 	for i := 0; i < fenceCount; i++ {
 		if fences[i] == nil {
-			panic(fmt.Sprintf("element %d of slice fences is nil", i))
+			return VKErrorUnknown, common.NilArgumentError("ResetFences", fmt.Sprintf("element %d of fences", i))
 		}
 		fenceSlice[i] = fences[i].Handle()
 	}
@@ -402,6 +402,11 @@ func (d *VulkanDevice) CreateGraphicsPipelines(pipelineCache PipelineCache, allo
 		return nil, res, err
 	}
 
+	err = common.PopulateOptionsOutDataSlice[C.VkGraphicsPipelineCreateInfo, GraphicsPipelineCreateInfo](o, unsafe.Pointer(pipelineCreateInfosPtr))
+	if err != nil {
+		return nil, VKErrorUnknown, err
+	}
+
 	var output []Pipeline
 	pipelineSlice := ([]driver.VkPipeline)(unsafe.Slice(pipelinePtr, pipelineCount))
 
@@ -436,6 +441,11 @@ func (d *VulkanDevice) CreateComputePipelines(pipelineCache PipelineCache, alloc
 		return nil, res, err
 	}
 
+	err = common.PopulateOptionsOutDataSlice[C.VkComputePipelineCreateInfo, ComputePipelineCreateInfo](o, unsafe.Pointer(pipelineCreateInfosPtr))
+	if err != nil {
+		return nil, VKErrorUnknown, err
+	}
+
 	var output []Pipeline
 	pipelineSlice := ([]driver.VkPipeline)(unsafe.Slice(pipelinePtr, pipelineCount))
 
@@ -679,7 +689,6 @@ func (d *VulkanDevice) AllocateCommandBuffers(o CommandBufferAllocateInfo) ([]Co
 	commandBufferPtr := (*driver.VkCommandBuffer)(arena.Malloc(o.CommandBufferCount * int(unsafe.Sizeof([1]driver.VkCommandBuffer{}))))
 
 	res, err := o.CommandPool.Driver().VkAllocateCommandBuffers(device, (*driver.VkCommandBufferAllocateInfo)(createInfo), commandBufferPtr)
-	err = res.ToError()
 	if err != nil {
 		return nil, res, err
 	}
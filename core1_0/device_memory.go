@@ -7,8 +7,10 @@ package core1_0
 import "C"
 import (
 	"github.com/CannibalVox/cgoparam"
+	"github.com/cockroachdb/errors"
 	"github.com/vkngwrapper/core/v2/common"
 	"github.com/vkngwrapper/core/v2/driver"
+	"sync"
 	"unsafe"
 )
 
@@ -44,6 +46,11 @@ type VulkanDeviceMemory struct {
 	maximumAPIVersion common.APIVersion
 
 	size int
+
+	// mapLock guards mapped, and is held across vkMapMemory so that concurrent calls to Map
+	// cannot both map the memory
+	mapLock sync.Mutex
+	mapped  bool
 }
 
 func (m *VulkanDeviceMemory) Handle() driver.VkDeviceMemory {
@@ -63,20 +70,36 @@ func (m *VulkanDeviceMemory) APIVersion() common.APIVersion {
 }
 
 func (m *VulkanDeviceMemory) Map(offset int, size int, flags MemoryMapFlags) (unsafe.Pointer, common.VkResult, error) {
+	m.mapLock.Lock()
+	defer m.mapLock.Unlock()
+
+	if m.mapped {
+		return nil, VKErrorMemoryMapFailed, errors.Newf("attempted to map DeviceMemory 0x%x, which is already mapped", m.deviceMemoryHandle)
+	}
+
 	var data unsafe.Pointer
 	res, err := m.deviceDriver.VkMapMemory(m.device, m.deviceMemoryHandle, driver.VkDeviceSize(offset), driver.VkDeviceSize(size), driver.VkMemoryMapFlags(flags), &data)
 	if err != nil {
 		return nil, res, err
 	}
 
+	m.mapped = true
 	return data, res, nil
 }
 
 func (m *VulkanDeviceMemory) Unmap() {
+	m.mapLock.Lock()
+	defer m.mapLock.Unlock()
+
 	m.deviceDriver.VkUnmapMemory(m.device, m.deviceMemoryHandle)
+	m.mapped = false
 }
 
 func (m *VulkanDeviceMemory) Free(allocationCallbacks *driver.AllocationCallbacks) {
+	m.mapLock.Lock()
+	m.mapped = false
+	m.mapLock.Unlock()
+
 	m.Driver().VkFreeMemory(m.device, m.deviceMemoryHandle, allocationCallbacks.Handle())
 	m.Driver().ObjectStore().Delete(driver.VulkanHandle(m.deviceMemoryHandle))
 }
@@ -10,6 +10,7 @@ import (
 	internal_mocks "github.com/vkngwrapper/core/v2/internal/dummies"
 	"github.com/vkngwrapper/core/v2/mocks"
 	"reflect"
+	"sync"
 	"testing"
 	"unsafe"
 )
@@ -36,6 +37,78 @@ func TestVulkanDeviceMemory_MapMemory(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestVulkanDeviceMemory_MapMemory_AlreadyMapped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_0)
+
+	device := mocks.EasyMockDevice(ctrl, mockDriver)
+	memory := internal_mocks.EasyDummyDeviceMemory(mockDriver, device, 1)
+	memoryPtr := unsafe.Pointer(t)
+
+	mockDriver.EXPECT().VkMapMemory(device.Handle(), memory.Handle(), driver.VkDeviceSize(0), driver.VkDeviceSize(1), driver.VkMemoryMapFlags(0), gomock.Not(nil)).DoAndReturn(
+		func(device driver.VkDevice, memory driver.VkDeviceMemory, offset driver.VkDeviceSize, size driver.VkDeviceSize, flags driver.VkMemoryMapFlags, ppData *unsafe.Pointer) (common.VkResult, error) {
+			*ppData = memoryPtr
+
+			return core1_0.VKSuccess, nil
+		}).Times(2)
+	mockDriver.EXPECT().VkUnmapMemory(device.Handle(), memory.Handle())
+
+	_, _, err := memory.Map(0, 1, 0)
+	require.NoError(t, err)
+
+	_, res, err := memory.Map(0, 1, 0)
+	require.Equal(t, core1_0.VKErrorMemoryMapFailed, res)
+	require.Error(t, err)
+
+	memory.Unmap()
+
+	ptr, _, err := memory.Map(0, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, memoryPtr, ptr)
+}
+
+func TestVulkanDeviceMemory_MapMemory_Concurrent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_0)
+
+	device := mocks.EasyMockDevice(ctrl, mockDriver)
+	memory := internal_mocks.EasyDummyDeviceMemory(mockDriver, device, 1)
+	memoryPtr := unsafe.Pointer(t)
+
+	mockDriver.EXPECT().VkMapMemory(device.Handle(), memory.Handle(), driver.VkDeviceSize(0), driver.VkDeviceSize(1), driver.VkMemoryMapFlags(0), gomock.Not(nil)).DoAndReturn(
+		func(device driver.VkDevice, memory driver.VkDeviceMemory, offset driver.VkDeviceSize, size driver.VkDeviceSize, flags driver.VkMemoryMapFlags, ppData *unsafe.Pointer) (common.VkResult, error) {
+			*ppData = memoryPtr
+
+			return core1_0.VKSuccess, nil
+		})
+
+	const callers = 8
+	var waitGroup sync.WaitGroup
+	results := make([]common.VkResult, callers)
+	for i := 0; i < callers; i++ {
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+			_, results[i], _ = memory.Map(0, 1, 0)
+		}(i)
+	}
+	waitGroup.Wait()
+
+	var mapped int
+	for _, res := range results {
+		if res == core1_0.VKSuccess {
+			mapped++
+		} else {
+			require.Equal(t, core1_0.VKErrorMemoryMapFailed, res)
+		}
+	}
+	require.Equal(t, 1, mapped)
+}
+
 func TestVulkanDeviceMemory_UnmapMemory(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -708,7 +708,8 @@ type DeviceMemory interface {
 	// etc.
 	APIVersion() common.APIVersion
 
-	// Map maps a memory object into application address space
+	// Map maps a memory object into application address space. A DeviceMemory can only be
+	// mapped once at a time: mapping it again before calling Unmap returns an error.
 	//
 	// offset - A zero-based byte offset from the beginning of the memory object
 	//
@@ -8,8 +8,69 @@ import "C"
 import (
 	"github.com/CannibalVox/cgoparam"
 	"github.com/vkngwrapper/core/v2/driver"
+	"math/bits"
+	"sort"
 )
 
+// MemoryUsage describes how an application intends to access memory, and is used to rank
+// memory types in SelectMemoryTypes
+type MemoryUsage int32
+
+var memoryUsageMapping = make(map[MemoryUsage]string)
+
+func (e MemoryUsage) Register(str string) {
+	memoryUsageMapping[e] = str
+}
+
+func (e MemoryUsage) String() string {
+	return memoryUsageMapping[e]
+}
+
+const (
+	// MemoryUsageUnknown makes no assumptions about how memory will be accessed: memory types
+	// are ranked by MemoryTypeSelection.RequiredProperties and MemoryTypeSelection.PreferredProperties
+	// alone
+	MemoryUsageUnknown MemoryUsage = iota
+	// MemoryUsageGPUOnly specifies memory that is only accessed by the Device, such as
+	// attachments, sampled Image objects, and vertex Buffer objects that are written once
+	// through a staging Buffer
+	MemoryUsageGPUOnly
+	// MemoryUsageUpload specifies memory that the host writes and the Device reads, such as
+	// staging Buffer objects
+	MemoryUsageUpload
+	// MemoryUsageReadback specifies memory that the Device writes and the host reads
+	MemoryUsageReadback
+	// MemoryUsageTransient specifies memory for transient attachments, which is lazily allocated
+	// if the Device supports it and device-local otherwise
+	MemoryUsageTransient
+)
+
+func init() {
+	MemoryUsageUnknown.Register("Unknown")
+	MemoryUsageGPUOnly.Register("GPU Only")
+	MemoryUsageUpload.Register("Upload")
+	MemoryUsageReadback.Register("Readback")
+	MemoryUsageTransient.Register("Transient")
+}
+
+// coreMemoryProperties are the memory properties that SelectMemoryTypes knows how to rank. Memory
+// types with other properties, such as protected memory, are only selected ahead of others when
+// those properties are requested.
+const coreMemoryProperties = MemoryPropertyDeviceLocal | MemoryPropertyHostVisible |
+	MemoryPropertyHostCoherent | MemoryPropertyHostCached | MemoryPropertyLazilyAllocated
+
+// MemoryTypeSelection describes the memory type that SelectMemoryTypes should look for
+type MemoryTypeSelection struct {
+	// Usage is how the application intends to access the memory
+	Usage MemoryUsage
+	// RequiredProperties are memory properties that a memory type must have in order to be
+	// selected at all
+	RequiredProperties MemoryPropertyFlags
+	// PreferredProperties are memory properties that a memory type should have if possible, in
+	// addition to those implied by Usage
+	PreferredProperties MemoryPropertyFlags
+}
+
 func (d *VulkanPhysicalDevice) MemoryProperties() *PhysicalDeviceMemoryProperties {
 	allocator := cgoparam.GetAlloc()
 	defer cgoparam.ReturnAlloc(allocator)
@@ -42,3 +103,143 @@ func (d *VulkanPhysicalDevice) MemoryProperties() *PhysicalDeviceMemoryPropertie
 
 	return outProps
 }
+
+// SelectMemoryTypes returns the indices of the memory types in props that can back a resource with
+// the provided MemoryRequirements, ranked from most to least suitable for selection.Usage.
+// Memory types that lack any of selection.RequiredProperties are not returned. On devices
+// where every heap is device-local, such as most integrated GPUs, host-visible memory types
+// are not penalized for Device access. When selection.Usage is not MemoryUsageUnknown, ties
+// between memory types with different properties are broken in favor of larger heaps.
+//
+// props - The memory properties of a PhysicalDevice, as returned by PhysicalDevice.MemoryProperties
+//
+// requirements - The MemoryRequirements of the resource that the memory will back
+//
+// selection - Describes how the memory will be used
+func SelectMemoryTypes(props *PhysicalDeviceMemoryProperties, requirements MemoryRequirements, selection MemoryTypeSelection) []int {
+	required, preferred, avoided := props.usageProperties(selection)
+
+	type candidate struct {
+		memoryTypeIndex int
+		flags           MemoryPropertyFlags
+		missing         int
+		unwanted        int
+		heapSize        int
+	}
+	var candidates []candidate
+
+	for i, memoryType := range props.MemoryTypes {
+		if requirements.MemoryTypeBits&(1<<uint(i)) == 0 {
+			continue
+		}
+		if memoryType.PropertyFlags&required != required {
+			continue
+		}
+
+		heapSize := 0
+		if memoryType.HeapIndex < len(props.MemoryHeaps) {
+			heapSize = props.MemoryHeaps[memoryType.HeapIndex].Size
+		}
+		if heapSize == 0 {
+			continue
+		}
+
+		candidates = append(candidates, candidate{
+			memoryTypeIndex: i,
+			flags:           memoryType.PropertyFlags,
+			missing:         bits.OnesCount32(uint32(preferred &^ memoryType.PropertyFlags)),
+			unwanted:        bits.OnesCount32(uint32(avoided & memoryType.PropertyFlags)),
+			heapSize:        heapSize,
+		})
+	}
+
+	// Memory types with the same properties are already ordered from best to worst performance,
+	// so ties are broken by the largest heap among the memory types that share a candidate's
+	// properties, which keeps those memory types in order, and then by memory type index
+	largestHeaps := make(map[MemoryPropertyFlags]int)
+	for _, c := range candidates {
+		if c.heapSize > largestHeaps[c.flags] {
+			largestHeaps[c.flags] = c.heapSize
+		}
+	}
+	for i := range candidates {
+		candidates[i].heapSize = largestHeaps[candidates[i].flags]
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		left, right := candidates[i], candidates[j]
+		if left.missing != right.missing {
+			return left.missing < right.missing
+		}
+		if left.unwanted != right.unwanted {
+			return left.unwanted < right.unwanted
+		}
+		if selection.Usage != MemoryUsageUnknown && left.heapSize != right.heapSize {
+			return left.heapSize > right.heapSize
+		}
+		return left.memoryTypeIndex < right.memoryTypeIndex
+	})
+
+	var memoryTypes []int
+	for _, c := range candidates {
+		memoryTypes = append(memoryTypes, c.memoryTypeIndex)
+	}
+
+	return memoryTypes
+}
+
+// UnifiedMemory returns true if every memory heap is device-local, as is typical of integrated
+// GPUs, where host-visible memory is as fast for the Device to access as any other memory
+func (p *PhysicalDeviceMemoryProperties) UnifiedMemory() bool {
+	for _, heap := range p.MemoryHeaps {
+		if heap.Size > 0 && heap.Flags&MemoryHeapDeviceLocal == 0 {
+			return false
+		}
+	}
+
+	return len(p.MemoryHeaps) > 0
+}
+
+// usageProperties returns the memory properties that a memory type must have, should have, and
+// should not have for the provided selection
+func (p *PhysicalDeviceMemoryProperties) usageProperties(selection MemoryTypeSelection) (required, preferred, avoided MemoryPropertyFlags) {
+	required = selection.RequiredProperties
+	preferred = selection.PreferredProperties
+	avoided = ^coreMemoryProperties
+	unified := p.UnifiedMemory()
+
+	switch selection.Usage {
+	case MemoryUsageGPUOnly:
+		preferred |= MemoryPropertyDeviceLocal
+		avoided |= MemoryPropertyLazilyAllocated
+		if !unified {
+			// Host-visible device-local memory is often a small window of the heap that is
+			// better left to Buffer objects that the host writes every frame
+			avoided |= MemoryPropertyHostVisible
+		}
+	case MemoryUsageUpload:
+		required |= MemoryPropertyHostVisible
+		preferred |= MemoryPropertyHostCoherent
+		avoided |= MemoryPropertyHostCached | MemoryPropertyLazilyAllocated
+		if !unified {
+			avoided |= MemoryPropertyDeviceLocal
+		}
+	case MemoryUsageReadback:
+		required |= MemoryPropertyHostVisible
+		preferred |= MemoryPropertyHostCached
+		avoided |= MemoryPropertyLazilyAllocated
+		if !unified {
+			avoided |= MemoryPropertyDeviceLocal
+		}
+	case MemoryUsageTransient:
+		preferred |= MemoryPropertyDeviceLocal | MemoryPropertyLazilyAllocated
+		avoided |= MemoryPropertyHostVisible
+	default:
+		avoided |= MemoryPropertyLazilyAllocated
+	}
+
+	// Anything the caller asked for is never avoided
+	avoided &^= required | preferred
+
+	return required, preferred, avoided
+}
@@ -743,3 +743,153 @@ func TestVulkanPhysicalDevice_MemoryProperties(t *testing.T) {
 	require.Equal(t, 99, memoryProps.MemoryHeaps[0].Size)
 	require.Equal(t, core1_0.MemoryHeapDeviceLocal, memoryProps.MemoryHeaps[0].Flags)
 }
+
+func discreteMemoryProperties() *core1_0.PhysicalDeviceMemoryProperties {
+	return &core1_0.PhysicalDeviceMemoryProperties{
+		MemoryTypes: []core1_0.MemoryType{
+			{PropertyFlags: core1_0.MemoryPropertyDeviceLocal, HeapIndex: 0},
+			{PropertyFlags: core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent, HeapIndex: 1},
+			{PropertyFlags: core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent | core1_0.MemoryPropertyHostCached, HeapIndex: 1},
+			{PropertyFlags: core1_0.MemoryPropertyDeviceLocal | core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent, HeapIndex: 2},
+		},
+		MemoryHeaps: []core1_0.MemoryHeap{
+			{Size: 8 << 30, Flags: core1_0.MemoryHeapDeviceLocal},
+			{Size: 16 << 30},
+			{Size: 256 << 20, Flags: core1_0.MemoryHeapDeviceLocal},
+		},
+	}
+}
+
+func TestPhysicalDeviceMemoryProperties_SelectMemoryTypes_Discrete(t *testing.T) {
+	props := discreteMemoryProperties()
+	requirements := core1_0.MemoryRequirements{MemoryTypeBits: 0xf}
+
+	require.False(t, props.UnifiedMemory())
+	require.Equal(t, []int{0, 3, 1, 2}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageGPUOnly}))
+	require.Equal(t, []int{1, 2, 3}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageUpload}))
+	require.Equal(t, []int{2, 1, 3}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageReadback}))
+	require.Equal(t, []int{3}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{
+		Usage:              core1_0.MemoryUsageUpload,
+		RequiredProperties: core1_0.MemoryPropertyDeviceLocal,
+	}))
+
+	// Preferring device-local memory for uploads overrides the usage's aversion to it
+	require.Equal(t, []int{3, 1, 2}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{
+		Usage:               core1_0.MemoryUsageUpload,
+		PreferredProperties: core1_0.MemoryPropertyDeviceLocal,
+	}))
+
+	require.Equal(t, []int{1, 3}, core1_0.SelectMemoryTypes(props, core1_0.MemoryRequirements{MemoryTypeBits: 0xa}, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageReadback}))
+	require.Empty(t, core1_0.SelectMemoryTypes(props, core1_0.MemoryRequirements{MemoryTypeBits: 0x1}, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageUpload}))
+}
+
+func TestPhysicalDeviceMemoryProperties_SelectMemoryTypes_UnifiedMemory(t *testing.T) {
+	props := &core1_0.PhysicalDeviceMemoryProperties{
+		MemoryTypes: []core1_0.MemoryType{
+			{PropertyFlags: core1_0.MemoryPropertyDeviceLocal | core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent, HeapIndex: 0},
+			{PropertyFlags: core1_0.MemoryPropertyDeviceLocal | core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent | core1_0.MemoryPropertyHostCached, HeapIndex: 0},
+			{PropertyFlags: core1_0.MemoryPropertyDeviceLocal | core1_0.MemoryPropertyLazilyAllocated, HeapIndex: 0},
+		},
+		MemoryHeaps: []core1_0.MemoryHeap{
+			{Size: 4 << 30, Flags: core1_0.MemoryHeapDeviceLocal},
+		},
+	}
+	requirements := core1_0.MemoryRequirements{MemoryTypeBits: 0x7}
+
+	require.True(t, props.UnifiedMemory())
+	require.Equal(t, []int{0, 1, 2}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageGPUOnly}))
+	require.Equal(t, []int{0, 1}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageUpload}))
+	require.Equal(t, []int{1, 0}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageReadback}))
+	require.Equal(t, []int{2, 0, 1}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageTransient}))
+}
+
+func TestPhysicalDeviceMemoryProperties_SelectMemoryTypes_TransientFallback(t *testing.T) {
+	props := discreteMemoryProperties()
+
+	require.Equal(t, []int{0, 3, 1, 2}, core1_0.SelectMemoryTypes(props, core1_0.MemoryRequirements{MemoryTypeBits: 0xf}, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageTransient}))
+}
+
+func TestPhysicalDeviceMemoryProperties_SelectMemoryTypes_HeapSize(t *testing.T) {
+	props := &core1_0.PhysicalDeviceMemoryProperties{
+		MemoryTypes: []core1_0.MemoryType{
+			{PropertyFlags: core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCached, HeapIndex: 0},
+			{PropertyFlags: core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent | core1_0.MemoryPropertyHostCached, HeapIndex: 1},
+			{PropertyFlags: core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent | core1_0.MemoryPropertyHostCached | 0x20, HeapIndex: 1}, // VK_MEMORY_PROPERTY_PROTECTED_BIT
+			{PropertyFlags: core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent | core1_0.MemoryPropertyHostCached, HeapIndex: 2},
+		},
+		MemoryHeaps: []core1_0.MemoryHeap{
+			{Size: 1 << 30},
+			{Size: 4 << 30},
+			{Size: 0},
+		},
+	}
+	requirements := core1_0.MemoryRequirements{MemoryTypeBits: 0xf}
+
+	require.Equal(t, []int{1, 0, 2}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageReadback}))
+	require.Equal(t, []int{0, 1, 2}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{}))
+	require.Equal(t, []int{2}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{
+		Usage:              core1_0.MemoryUsageReadback,
+		RequiredProperties: 0x20,
+	}))
+}
+
+func TestPhysicalDeviceMemoryProperties_SelectMemoryTypes_HeapSizeOrder(t *testing.T) {
+	cached := core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCached
+	coherent := core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent | core1_0.MemoryPropertyHostCached
+	props := &core1_0.PhysicalDeviceMemoryProperties{
+		MemoryTypes: []core1_0.MemoryType{
+			{PropertyFlags: coherent, HeapIndex: 0},
+			{PropertyFlags: cached, HeapIndex: 1},
+			{PropertyFlags: cached, HeapIndex: 2},
+		},
+		MemoryHeaps: []core1_0.MemoryHeap{
+			{Size: 2 << 30},
+			{Size: 1 << 30},
+			{Size: 4 << 30},
+		},
+	}
+	requirements := core1_0.MemoryRequirements{MemoryTypeBits: 0x7}
+
+	// Memory types with the same properties stay in order, ranked by the largest heap among them
+	require.Equal(t, []int{1, 2, 0}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageReadback}))
+
+	props.MemoryTypes[0].HeapIndex = 2
+	props.MemoryTypes[2].HeapIndex = 0
+	require.Equal(t, []int{0, 1, 2}, core1_0.SelectMemoryTypes(props, requirements, core1_0.MemoryTypeSelection{Usage: core1_0.MemoryUsageReadback}))
+}
+
+func TestSelectMemoryTypes_PhysicalDeviceMemoryProperties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_0)
+	instance := mocks.EasyMockInstance(ctrl, mockDriver)
+	physicalDevice := internal_mocks.EasyDummyPhysicalDevice(mockDriver, instance)
+
+	mockDriver.EXPECT().VkGetPhysicalDeviceMemoryProperties(physicalDevice.Handle(), gomock.Not(nil)).DoAndReturn(
+		func(physicalDevice driver.VkPhysicalDevice, pProperties *driver.VkPhysicalDeviceMemoryProperties) {
+			val := reflect.ValueOf(unsafe.Slice(pProperties, 1)).Index(0)
+			*(*uint32)(unsafe.Pointer(val.FieldByName("memoryTypeCount").UnsafeAddr())) = uint32(2)
+			*(*uint32)(unsafe.Pointer(val.FieldByName("memoryHeapCount").UnsafeAddr())) = uint32(2)
+
+			memoryType := val.FieldByName("memoryTypes").Index(0)
+			*(*uint32)(unsafe.Pointer(memoryType.FieldByName("heapIndex").UnsafeAddr())) = uint32(0)
+			*(*int32)(unsafe.Pointer(memoryType.FieldByName("propertyFlags").UnsafeAddr())) = int32(1) // VK_MEMORY_PROPERTY_DEVICE_LOCAL_BIT
+
+			memoryType = val.FieldByName("memoryTypes").Index(1)
+			*(*uint32)(unsafe.Pointer(memoryType.FieldByName("heapIndex").UnsafeAddr())) = uint32(1)
+			*(*int32)(unsafe.Pointer(memoryType.FieldByName("propertyFlags").UnsafeAddr())) = int32(6) // VK_MEMORY_PROPERTY_HOST_VISIBLE_BIT|VK_MEMORY_PROPERTY_HOST_COHERENT_BIT
+
+			memoryHeap := val.FieldByName("memoryHeaps").Index(0)
+			*(*uint64)(unsafe.Pointer(memoryHeap.FieldByName("size").UnsafeAddr())) = uint64(1 << 30)
+			*(*int32)(unsafe.Pointer(memoryHeap.FieldByName("flags").UnsafeAddr())) = int32(1) // VK_MEMORY_HEAP_DEVICE_LOCAL_BIT
+
+			memoryHeap = val.FieldByName("memoryHeaps").Index(1)
+			*(*uint64)(unsafe.Pointer(memoryHeap.FieldByName("size").UnsafeAddr())) = uint64(1 << 30)
+		})
+
+	memoryTypes := core1_0.SelectMemoryTypes(physicalDevice.MemoryProperties(), core1_0.MemoryRequirements{MemoryTypeBits: 0x3}, core1_0.MemoryTypeSelection{
+		Usage: core1_0.MemoryUsageUpload,
+	})
+	require.Equal(t, []int{1}, memoryTypes)
+}
@@ -80,7 +80,7 @@ func (c *VulkanPipelineCache) MergePipelineCaches(srcCaches []PipelineCache) (co
 
 	for i := 0; i < srcCount; i++ {
 		if srcCaches[i] == nil {
-			panic(fmt.Sprintf("elements of srcCaches cannot be nil- element %d is nil", i))
+			return VKErrorUnknown, common.NilArgumentError("MergePipelineCaches", fmt.Sprintf("element %d of srcCaches", i))
 		}
 		srcSlice[i] = srcCaches[i].Handle()
 	}
@@ -31,6 +31,31 @@ func init() {
 	PipelineCreateDerivative.Register("Derivative")
 }
 
+// PipelineStageOptions is implemented by Options structures that hold one element for each shader
+// stage of the Pipeline create info they are chained onto. GraphicsPipelineCreateInfo and
+// ComputePipelineCreateInfo pass their stage count to ValidateStageCount and fail if it returns
+// an error. If you are not contributing to vkngwrapper or writing a Vulkan extension wrapper,
+// you do not need to understand this type.
+type PipelineStageOptions interface {
+	ValidateStageCount(stageCount int) error
+}
+
+func validatePipelineStageOptions(next common.Options, stageCount int) error {
+	for ; next != nil; next = next.NextOptionsInChain() {
+		stageOptions, ok := next.(PipelineStageOptions)
+		if !ok {
+			continue
+		}
+
+		err := stageOptions.ValidateStageCount(stageCount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GraphicsPipelineCreateInfo specifies parameters of a newly-created graphics Pipeline
 //
 // https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkGraphicsPipelineCreateInfo.html
@@ -80,6 +105,11 @@ type GraphicsPipelineCreateInfo struct {
 }
 
 func (o GraphicsPipelineCreateInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	err := validatePipelineStageOptions(o.Next, len(o.Stages))
+	if err != nil {
+		return nil, err
+	}
+
 	if preallocatedPointer == unsafe.Pointer(nil) {
 		preallocatedPointer = allocator.Malloc(C.sizeof_struct_VkGraphicsPipelineCreateInfo)
 	}
@@ -234,6 +264,10 @@ func (o ComputePipelineCreateInfo) PopulateCPointer(allocator *cgoparam.Allocato
 	if o.Layout == nil {
 		return nil, errors.New("core1_0.ComputePipelineCreateInfo.Layout cannot be nil")
 	}
+	err := validatePipelineStageOptions(o.Next, 1)
+	if err != nil {
+		return nil, err
+	}
 	if preallocatedPointer == unsafe.Pointer(nil) {
 		preallocatedPointer = allocator.Malloc(C.sizeof_struct_VkComputePipelineCreateInfo)
 	}
@@ -244,7 +278,7 @@ func (o ComputePipelineCreateInfo) PopulateCPointer(allocator *cgoparam.Allocato
 	createInfo.flags = C.VkPipelineCreateFlags(o.Flags)
 	createInfo.basePipelineHandle = (C.VkPipeline)(nil)
 
-	_, err := common.AllocOptions(allocator, &o.Stage, unsafe.Pointer(&createInfo.stage))
+	_, err = common.AllocOptions(allocator, &o.Stage, unsafe.Pointer(&createInfo.stage))
 	if err != nil {
 		return nil, err
 	}
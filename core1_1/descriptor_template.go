@@ -45,7 +45,7 @@ func (t *VulkanDescriptorUpdateTemplate) Destroy(allocator *driver.AllocationCal
 
 func (t *VulkanDescriptorUpdateTemplate) UpdateDescriptorSetFromImage(descriptorSet core1_0.DescriptorSet, data core1_0.DescriptorImageInfo) {
 	if descriptorSet == nil {
-		panic("descriptorSet cannot be nil")
+		panic(common.NilArgumentError("UpdateDescriptorSetFromImage", "descriptorSet"))
 	}
 	arena := cgoparam.GetAlloc()
 	defer cgoparam.ReturnAlloc(arena)
@@ -74,7 +74,7 @@ func (t *VulkanDescriptorUpdateTemplate) UpdateDescriptorSetFromImage(descriptor
 
 func (t *VulkanDescriptorUpdateTemplate) UpdateDescriptorSetFromBuffer(descriptorSet core1_0.DescriptorSet, data core1_0.DescriptorBufferInfo) {
 	if descriptorSet == nil {
-		panic("descriptorSet cannot be nil")
+		panic(common.NilArgumentError("UpdateDescriptorSetFromBuffer", "descriptorSet"))
 	}
 	arena := cgoparam.GetAlloc()
 	defer cgoparam.ReturnAlloc(arena)
@@ -99,7 +99,7 @@ func (t *VulkanDescriptorUpdateTemplate) UpdateDescriptorSetFromBuffer(descripto
 
 func (t *VulkanDescriptorUpdateTemplate) UpdateDescriptorSetFromObjectHandle(descriptorSet core1_0.DescriptorSet, data driver.VulkanHandle) {
 	if descriptorSet == nil {
-		panic("descriptorSet cannot be nil")
+		panic(common.NilArgumentError("UpdateDescriptorSetFromObjectHandle", "descriptorSet"))
 	}
 
 	t.DeviceDriver.VkUpdateDescriptorSetWithTemplate(
@@ -148,10 +148,10 @@ func (c *VulkanCommandBuffer) CmdNextSubpass2(subpassBegin SubpassBeginInfo, sub
 
 func (c *VulkanCommandBuffer) CmdDrawIndexedIndirectCount(buffer core1_0.Buffer, offset uint64, countBuffer core1_0.Buffer, countBufferOffset uint64, maxDrawCount, stride int) {
 	if buffer == nil {
-		panic("buffer cannot be nil")
+		panic(common.NilArgumentError("CmdDrawIndexedIndirectCount", "buffer"))
 	}
 	if countBuffer == nil {
-		panic("countBuffer cannot be nil")
+		panic(common.NilArgumentError("CmdDrawIndexedIndirectCount", "countBuffer"))
 	}
 	c.DeviceDriver.VkCmdDrawIndexedIndirectCount(
 		c.CommandBufferHandle,
@@ -168,10 +168,10 @@ func (c *VulkanCommandBuffer) CmdDrawIndexedIndirectCount(buffer core1_0.Buffer,
 
 func (c *VulkanCommandBuffer) CmdDrawIndirectCount(buffer core1_0.Buffer, offset uint64, countBuffer core1_0.Buffer, countBufferOffset uint64, maxDrawCount, stride int) {
 	if buffer == nil {
-		panic("buffer cannot be nil")
+		panic(common.NilArgumentError("CmdDrawIndirectCount", "buffer"))
 	}
 	if countBuffer == nil {
-		panic("countBuffer cannot be nil")
+		panic(common.NilArgumentError("CmdDrawIndirectCount", "countBuffer"))
 	}
 	c.DeviceDriver.VkCmdDrawIndirectCount(
 		c.CommandBufferHandle,
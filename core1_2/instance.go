@@ -10,7 +10,7 @@ import (
 // VulkanInstance is an implementation of the Instance interface that actually communicates with Vulkan. This
 // is the default implementation. See the interface for more documentation.
 type VulkanInstance struct {
-	core1_0.Instance
+	core1_1.Instance
 }
 
 // PromoteInstance accepts an Instance object from any core version. If provided an instance that supports
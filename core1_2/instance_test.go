@@ -0,0 +1,45 @@
+package core1_2_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_2"
+	"github.com/vkngwrapper/core/v2/driver"
+	mock_driver "github.com/vkngwrapper/core/v2/driver/mocks"
+	"github.com/vkngwrapper/core/v2/internal/dummies"
+	"testing"
+)
+
+func TestPromoteInstance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_2)
+	instance := core1_2.PromoteInstance(dummies.EasyDummyInstance(coreDriver))
+	require.NotNil(t, instance)
+
+	// Core 1.1 commands are reachable through a promoted Instance
+	coreDriver.EXPECT().VkEnumeratePhysicalDeviceGroups(
+		instance.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Nil(),
+	).DoAndReturn(func(instance driver.VkInstance, pCount *driver.Uint32, pProperties *driver.VkPhysicalDeviceGroupProperties) (common.VkResult, error) {
+		*pCount = driver.Uint32(0)
+
+		return core1_0.VKSuccess, nil
+	})
+
+	groups, _, err := instance.EnumeratePhysicalDeviceGroups(nil)
+	require.NoError(t, err)
+	require.Empty(t, groups)
+}
+
+func TestPromoteInstance_Version1_1(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_1)
+	require.Nil(t, core1_2.PromoteInstance(dummies.EasyDummyInstance(coreDriver)))
+}
@@ -0,0 +1,135 @@
+package core1_3
+
+const (
+	// Access2None specifies no accesses
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2None AccessFlags2 = 0
+	// Access2IndirectCommandRead specifies read access to indirect command data read as part of an
+	// indirect drawing or dispatching command. Such access occurs in the PipelineStage2DrawIndirect
+	// pipeline stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2IndirectCommandRead AccessFlags2 = 0x1
+	// Access2IndexRead specifies read access to an index buffer. Such access occurs in the
+	// PipelineStage2IndexInput pipeline stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2IndexRead AccessFlags2 = 0x2
+	// Access2VertexAttributeRead specifies read access to a vertex buffer. Such access occurs in the
+	// PipelineStage2VertexAttributeInput pipeline stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2VertexAttributeRead AccessFlags2 = 0x4
+	// Access2UniformRead specifies read access to a uniform buffer in any shader pipeline stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2UniformRead AccessFlags2 = 0x8
+	// Access2InputAttachmentRead specifies read access to an input attachment within a render pass
+	// during fragment shading. Such access occurs in the PipelineStage2FragmentShader pipeline
+	// stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2InputAttachmentRead AccessFlags2 = 0x10
+	// Access2ShaderRead is equivalent to the logical OR of Access2UniformRead, Access2ShaderSampledRead,
+	// and Access2ShaderStorageRead.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2ShaderRead AccessFlags2 = 0x20
+	// Access2ShaderWrite is equivalent to Access2ShaderStorageWrite.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2ShaderWrite AccessFlags2 = 0x40
+	// Access2ColorAttachmentRead specifies read access to a color attachment, such as via blending
+	// or subpass load operations. Such access occurs in the PipelineStage2ColorAttachmentOutput
+	// pipeline stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2ColorAttachmentRead AccessFlags2 = 0x80
+	// Access2ColorAttachmentWrite specifies write access to a color, resolve, or depth/stencil
+	// resolve attachment during a render pass. Such access occurs in the
+	// PipelineStage2ColorAttachmentOutput pipeline stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2ColorAttachmentWrite AccessFlags2 = 0x100
+	// Access2DepthStencilAttachmentRead specifies read access to a depth/stencil attachment. Such
+	// access occurs in the PipelineStage2EarlyFragmentTests or PipelineStage2LateFragmentTests
+	// pipeline stages.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2DepthStencilAttachmentRead AccessFlags2 = 0x200
+	// Access2DepthStencilAttachmentWrite specifies write access to a depth/stencil attachment. Such
+	// access occurs in the PipelineStage2EarlyFragmentTests or PipelineStage2LateFragmentTests
+	// pipeline stages.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2DepthStencilAttachmentWrite AccessFlags2 = 0x400
+	// Access2TransferRead specifies read access to an image or buffer in a copy operation. Such
+	// access occurs in the PipelineStage2Copy, PipelineStage2Blit, or PipelineStage2Resolve
+	// pipeline stages.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2TransferRead AccessFlags2 = 0x800
+	// Access2TransferWrite specifies write access to an image or buffer in a clear or copy
+	// operation. Such access occurs in the PipelineStage2Copy, PipelineStage2Blit,
+	// PipelineStage2Clear, or PipelineStage2Resolve pipeline stages.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2TransferWrite AccessFlags2 = 0x1000
+	// Access2HostRead specifies read access by a host operation. Such access occurs in the
+	// PipelineStage2Host pipeline stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2HostRead AccessFlags2 = 0x2000
+	// Access2HostWrite specifies write access by a host operation. Such access occurs in the
+	// PipelineStage2Host pipeline stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2HostWrite AccessFlags2 = 0x4000
+	// Access2MemoryRead specifies all read accesses. It is always valid in any access mask.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2MemoryRead AccessFlags2 = 0x8000
+	// Access2MemoryWrite specifies all write accesses. It is always valid in any access mask.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2MemoryWrite AccessFlags2 = 0x10000
+	// Access2ShaderSampledRead specifies read access to a uniform texel buffer or sampled image in
+	// any shader pipeline stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2ShaderSampledRead AccessFlags2 = 0x100000000
+	// Access2ShaderStorageRead specifies read access to a storage buffer, storage texel buffer, or
+	// storage image in any shader pipeline stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2ShaderStorageRead AccessFlags2 = 0x200000000
+	// Access2ShaderStorageWrite specifies write access to a storage buffer, storage texel buffer, or
+	// storage image in any shader pipeline stage.
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+	Access2ShaderStorageWrite AccessFlags2 = 0x400000000
+)
+
+func init() {
+	Access2IndirectCommandRead.Register("Indirect Command Read")
+	Access2IndexRead.Register("Index Read")
+	Access2VertexAttributeRead.Register("Vertex Attribute Read")
+	Access2UniformRead.Register("Uniform Read")
+	Access2InputAttachmentRead.Register("Input Attachment Read")
+	Access2ShaderRead.Register("Shader Read")
+	Access2ShaderWrite.Register("Shader Write")
+	Access2ColorAttachmentRead.Register("Color Attachment Read")
+	Access2ColorAttachmentWrite.Register("Color Attachment Write")
+	Access2DepthStencilAttachmentRead.Register("Depth/Stencil Attachment Read")
+	Access2DepthStencilAttachmentWrite.Register("Depth/Stencil Attachment Write")
+	Access2TransferRead.Register("Transfer Read")
+	Access2TransferWrite.Register("Transfer Write")
+	Access2HostRead.Register("Host Read")
+	Access2HostWrite.Register("Host Write")
+	Access2MemoryRead.Register("Memory Read")
+	Access2MemoryWrite.Register("Memory Write")
+	Access2ShaderSampledRead.Register("Shader Sampled Read")
+	Access2ShaderStorageRead.Register("Shader Storage Read")
+	Access2ShaderStorageWrite.Register("Shader Storage Write")
+}
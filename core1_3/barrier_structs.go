@@ -0,0 +1,279 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"unsafe"
+)
+
+const (
+	// PipelineStageNone specifies no stages of execution
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits.html
+	PipelineStageNone core1_0.PipelineStageFlags = C.VK_PIPELINE_STAGE_NONE
+	// AccessNone specifies no accesses
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits.html
+	AccessNone core1_0.AccessFlags = C.VK_ACCESS_NONE
+
+	// EventCreateDeviceOnly specifies that host event commands will not be used with this event
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkEventCreateFlagBits.html
+	EventCreateDeviceOnly core1_0.EventCreateFlags = C.VK_EVENT_CREATE_DEVICE_ONLY_BIT
+
+	// ImageLayoutReadOnlyOptimal specifies a layout allowing read only access as an attachment, or
+	// in shaders as a sampled image, combined image/sampler, or input attachment
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkImageLayout.html
+	ImageLayoutReadOnlyOptimal core1_0.ImageLayout = C.VK_IMAGE_LAYOUT_READ_ONLY_OPTIMAL
+	// ImageLayoutAttachmentOptimal specifies a layout that must only be used with attachment
+	// accesses in the graphics pipeline
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkImageLayout.html
+	ImageLayoutAttachmentOptimal core1_0.ImageLayout = C.VK_IMAGE_LAYOUT_ATTACHMENT_OPTIMAL
+
+	// SubmitProtected specifies that this batch is a protected submission
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkSubmitFlagBits.html
+	SubmitProtected SubmitFlags = C.VK_SUBMIT_PROTECTED_BIT
+)
+
+func init() {
+	EventCreateDeviceOnly.Register("Device Only")
+
+	ImageLayoutReadOnlyOptimal.Register("Read-Only Optimal")
+	ImageLayoutAttachmentOptimal.Register("Attachment Optimal")
+
+	SubmitProtected.Register("Protected")
+}
+
+////
+
+// MemoryBarrier2 specifies a global memory barrier
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkMemoryBarrier2.html
+type MemoryBarrier2 struct {
+	// SrcStageMask specifies the pipeline stages to be included in the first synchronization
+	// scope
+	SrcStageMask PipelineStageFlags2
+	// SrcAccessMask specifies the access flags to be included in the first access scope
+	SrcAccessMask AccessFlags2
+	// DstStageMask specifies the pipeline stages to be included in the second synchronization
+	// scope
+	DstStageMask PipelineStageFlags2
+	// DstAccessMask specifies the access flags to be included in the second access scope
+	DstAccessMask AccessFlags2
+
+	common.NextOptions
+}
+
+func (o MemoryBarrier2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkMemoryBarrier2{})))
+	}
+
+	info := (*C.VkMemoryBarrier2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_MEMORY_BARRIER_2
+	info.pNext = next
+	info.srcStageMask = C.VkPipelineStageFlags2(o.SrcStageMask)
+	info.srcAccessMask = C.VkAccessFlags2(o.SrcAccessMask)
+	info.dstStageMask = C.VkPipelineStageFlags2(o.DstStageMask)
+	info.dstAccessMask = C.VkAccessFlags2(o.DstAccessMask)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// BufferMemoryBarrier2 specifies a buffer memory barrier
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkBufferMemoryBarrier2.html
+type BufferMemoryBarrier2 struct {
+	// SrcStageMask specifies the pipeline stages to be included in the first synchronization
+	// scope
+	SrcStageMask PipelineStageFlags2
+	// SrcAccessMask specifies the access flags to be included in the first access scope
+	SrcAccessMask AccessFlags2
+	// DstStageMask specifies the pipeline stages to be included in the second synchronization
+	// scope
+	DstStageMask PipelineStageFlags2
+	// DstAccessMask specifies the access flags to be included in the second access scope
+	DstAccessMask AccessFlags2
+
+	// SrcQueueFamilyIndex is the source queue family for a queue family ownership transfer
+	SrcQueueFamilyIndex int
+	// DstQueueFamilyIndex is the destination queue family for a queue family ownership transfer
+	DstQueueFamilyIndex int
+
+	// Buffer is the buffer whose backing memory is affected by the barrier
+	Buffer core1_0.Buffer
+
+	// Offset is an offset in bytes into the backing memory for Buffer
+	Offset int
+	// Size is a size in bytes of the affected area of backing memory for Buffer
+	Size int
+
+	common.NextOptions
+}
+
+func (o BufferMemoryBarrier2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.Buffer == nil {
+		return nil, errors.New("core1_3.BufferMemoryBarrier2.Buffer cannot be nil")
+	}
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkBufferMemoryBarrier2{})))
+	}
+
+	info := (*C.VkBufferMemoryBarrier2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER_2
+	info.pNext = next
+	info.srcStageMask = C.VkPipelineStageFlags2(o.SrcStageMask)
+	info.srcAccessMask = C.VkAccessFlags2(o.SrcAccessMask)
+	info.dstStageMask = C.VkPipelineStageFlags2(o.DstStageMask)
+	info.dstAccessMask = C.VkAccessFlags2(o.DstAccessMask)
+	info.srcQueueFamilyIndex = C.uint32_t(o.SrcQueueFamilyIndex)
+	info.dstQueueFamilyIndex = C.uint32_t(o.DstQueueFamilyIndex)
+	info.buffer = C.VkBuffer(unsafe.Pointer(o.Buffer.Handle()))
+	info.offset = C.VkDeviceSize(o.Offset)
+	info.size = C.VkDeviceSize(o.Size)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// ImageMemoryBarrier2 specifies the parameters of an image memory barrier
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkImageMemoryBarrier2.html
+type ImageMemoryBarrier2 struct {
+	// SrcStageMask specifies the pipeline stages to be included in the first synchronization
+	// scope
+	SrcStageMask PipelineStageFlags2
+	// SrcAccessMask specifies the access flags to be included in the first access scope
+	SrcAccessMask AccessFlags2
+	// DstStageMask specifies the pipeline stages to be included in the second synchronization
+	// scope
+	DstStageMask PipelineStageFlags2
+	// DstAccessMask specifies the access flags to be included in the second access scope
+	DstAccessMask AccessFlags2
+
+	// OldLayout is the old layout in an image layout transition
+	OldLayout core1_0.ImageLayout
+	// NewLayout is the new layout in an image layout transition
+	NewLayout core1_0.ImageLayout
+
+	// SrcQueueFamilyIndex is the source queue family for a queue family ownership transfer
+	SrcQueueFamilyIndex int
+	// DstQueueFamilyIndex is the destination queue family for a queue family ownership transfer
+	DstQueueFamilyIndex int
+
+	// Image is the Image object affected by this barrier
+	Image core1_0.Image
+	// SubresourceRange describes the image subresource range within Image that is affected by this barrier
+	SubresourceRange core1_0.ImageSubresourceRange
+
+	common.NextOptions
+}
+
+func (o ImageMemoryBarrier2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.Image == nil {
+		return nil, errors.New("core1_3.ImageMemoryBarrier2.Image cannot be nil")
+	}
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkImageMemoryBarrier2{})))
+	}
+
+	info := (*C.VkImageMemoryBarrier2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER_2
+	info.pNext = next
+	info.srcStageMask = C.VkPipelineStageFlags2(o.SrcStageMask)
+	info.srcAccessMask = C.VkAccessFlags2(o.SrcAccessMask)
+	info.dstStageMask = C.VkPipelineStageFlags2(o.DstStageMask)
+	info.dstAccessMask = C.VkAccessFlags2(o.DstAccessMask)
+	info.oldLayout = C.VkImageLayout(o.OldLayout)
+	info.newLayout = C.VkImageLayout(o.NewLayout)
+	info.srcQueueFamilyIndex = C.uint32_t(o.SrcQueueFamilyIndex)
+	info.dstQueueFamilyIndex = C.uint32_t(o.DstQueueFamilyIndex)
+	info.image = C.VkImage(unsafe.Pointer(o.Image.Handle()))
+	info.subresourceRange.aspectMask = C.VkImageAspectFlags(o.SubresourceRange.AspectMask)
+	info.subresourceRange.baseMipLevel = C.uint32_t(o.SubresourceRange.BaseMipLevel)
+	info.subresourceRange.levelCount = C.uint32_t(o.SubresourceRange.LevelCount)
+	info.subresourceRange.baseArrayLayer = C.uint32_t(o.SubresourceRange.BaseArrayLayer)
+	info.subresourceRange.layerCount = C.uint32_t(o.SubresourceRange.LayerCount)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// DependencyInfo specifies the memory, buffer, and image barriers of a dependency
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDependencyInfo.html
+type DependencyInfo struct {
+	// DependencyFlags specifies how execution and memory dependencies are formed
+	DependencyFlags core1_0.DependencyFlags
+	// MemoryBarriers is a slice of MemoryBarrier2 structures defining memory dependencies between
+	// any memory accesses
+	MemoryBarriers []MemoryBarrier2
+	// BufferMemoryBarriers is a slice of BufferMemoryBarrier2 structures defining memory
+	// dependencies between buffer ranges
+	BufferMemoryBarriers []BufferMemoryBarrier2
+	// ImageMemoryBarriers is a slice of ImageMemoryBarrier2 structures defining memory
+	// dependencies between image subresources
+	ImageMemoryBarriers []ImageMemoryBarrier2
+
+	common.NextOptions
+}
+
+func (o DependencyInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkDependencyInfo{})))
+	}
+
+	info := (*C.VkDependencyInfo)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_DEPENDENCY_INFO
+	info.pNext = next
+	info.dependencyFlags = C.VkDependencyFlags(o.DependencyFlags)
+
+	memoryBarrierCount := len(o.MemoryBarriers)
+	bufferMemoryBarrierCount := len(o.BufferMemoryBarriers)
+	imageMemoryBarrierCount := len(o.ImageMemoryBarriers)
+
+	info.memoryBarrierCount = C.uint32_t(memoryBarrierCount)
+	info.pMemoryBarriers = nil
+	info.bufferMemoryBarrierCount = C.uint32_t(bufferMemoryBarrierCount)
+	info.pBufferMemoryBarriers = nil
+	info.imageMemoryBarrierCount = C.uint32_t(imageMemoryBarrierCount)
+	info.pImageMemoryBarriers = nil
+
+	var err error
+	if memoryBarrierCount > 0 {
+		info.pMemoryBarriers, err = common.AllocOptionSlice[C.VkMemoryBarrier2, MemoryBarrier2](allocator, o.MemoryBarriers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bufferMemoryBarrierCount > 0 {
+		info.pBufferMemoryBarriers, err = common.AllocOptionSlice[C.VkBufferMemoryBarrier2, BufferMemoryBarrier2](allocator, o.BufferMemoryBarriers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if imageMemoryBarrierCount > 0 {
+		info.pImageMemoryBarriers, err = common.AllocOptionSlice[C.VkImageMemoryBarrier2, ImageMemoryBarrier2](allocator, o.ImageMemoryBarriers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return preallocatedPointer, nil
+}
@@ -0,0 +1,515 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"fmt"
+	"github.com/CannibalVox/cgoparam"
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_2"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+// VulkanCommandBuffer is an implementation of the CommandBuffer interface that actually communicates with Vulkan. This
+// is the default implementation. See the interface for more documentation.
+type VulkanCommandBuffer struct {
+	core1_2.CommandBuffer
+
+	DeviceDriver        driver.Driver
+	CommandBufferHandle driver.VkCommandBuffer
+
+	CommandCounter *core1_0.CommandCounter
+}
+
+// PromoteCommandBuffer accepts a CommandBuffer object from any core version. If provided a command buffer that supports
+// at least core 1.3, it will return a core1_3.CommandBuffer. Otherwise, it will return nil. This method
+// will always return a core1_3.VulkanCommandBuffer, even if it is provided a VulkanCommandBuffer from a higher
+// core version. Two Vulkan 1.3 compatible CommandBuffer objects with the same CommandBuffer.Handle will
+// return the same interface value when passed to this method.
+func PromoteCommandBuffer(commandBuffer core1_0.CommandBuffer) CommandBuffer {
+	if commandBuffer == nil {
+		return nil
+	}
+	if !commandBuffer.APIVersion().IsAtLeast(common.Vulkan1_3) {
+		return nil
+	}
+
+	return promoteCommandBuffer(commandBuffer)
+}
+
+// PromoteCommandBufferFromExtensions accepts a CommandBuffer object from any core version, along with
+// the Device it was allocated from. If provided a command buffer that supports at least core 1.3, it
+// behaves like PromoteCommandBuffer. If provided a command buffer that supports core 1.2, from a Device
+// that enabled any of the following extensions, it will also return a core1_3.CommandBuffer, whose
+// commands are loaded from those extensions. Otherwise, it will return nil.
+//
+//   - VK_KHR_dynamic_rendering
+//   - VK_KHR_synchronization2
+//   - VK_EXT_extended_dynamic_state
+//   - VK_EXT_extended_dynamic_state2
+//   - VK_KHR_copy_commands2
+//
+// Only the commands of the enabled extensions may be called on a CommandBuffer promoted from core 1.2.
+// Other core 1.3 commands panic with a *common.FunctionError wrapping driver.ErrMissingCommand.
+func PromoteCommandBufferFromExtensions(commandBuffer core1_0.CommandBuffer, device core1_0.Device) CommandBuffer {
+	if commandBuffer == nil || device == nil {
+		return nil
+	}
+	if commandBuffer.APIVersion().IsAtLeast(common.Vulkan1_3) {
+		return promoteCommandBuffer(commandBuffer)
+	}
+	if !commandBuffer.APIVersion().IsAtLeast(common.Vulkan1_2) ||
+		commandBuffer.DeviceHandle() != device.Handle() ||
+		!anyExtensionActive(device, commandBufferExtensions) {
+		return nil
+	}
+
+	return promoteCommandBuffer(commandBuffer)
+}
+
+func promoteCommandBuffer(commandBuffer core1_0.CommandBuffer) CommandBuffer {
+	promotedBuffer := core1_2.PromoteCommandBuffer(commandBuffer)
+
+	return commandBuffer.Driver().ObjectStore().GetOrCreate(
+		driver.VulkanHandle(commandBuffer.Handle()),
+		driver.Core1_3,
+		func() any {
+			// The command/dispatch/draw counts should be shared between the various
+			// core versions of a command buffer, but if for some reason this isn't a real
+			// vulkan command buffer, feel free to just make up some new pointers
+			var commandCounter *core1_0.CommandCounter
+
+			promotedBufferImpl, isInternalVulkan := promotedBuffer.(*core1_2.VulkanCommandBuffer)
+			if isInternalVulkan {
+				commandCounter = promotedBufferImpl.CommandCounter
+			}
+
+			if commandCounter == nil {
+				commandCounter = &core1_0.CommandCounter{}
+			}
+
+			return &VulkanCommandBuffer{
+				CommandBuffer: promotedBuffer,
+
+				DeviceDriver:        commandBuffer.Driver(),
+				CommandBufferHandle: commandBuffer.Handle(),
+
+				CommandCounter: commandCounter,
+			}
+		}).(CommandBuffer)
+}
+
+func PromoteCommandBufferSlice(commandBuffers []core1_0.CommandBuffer) []CommandBuffer {
+	outBuffers := make([]CommandBuffer, len(commandBuffers))
+
+	for i := 0; i < len(commandBuffers); i++ {
+		outBuffers[i] = PromoteCommandBuffer(commandBuffers[i])
+
+		if outBuffers[i] == nil {
+			return nil
+		}
+	}
+
+	return outBuffers
+}
+
+func (c *VulkanCommandBuffer) CmdBeginRendering(renderingInfo RenderingInfo) error {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	renderingInfoPtr, err := common.AllocOptions(arena, renderingInfo)
+	if err != nil {
+		return err
+	}
+
+	c.DeviceDriver.VkCmdBeginRendering(
+		c.CommandBufferHandle,
+		(*driver.VkRenderingInfo)(renderingInfoPtr),
+	)
+
+	c.CommandCounter.CommandCount++
+	return nil
+}
+
+func (c *VulkanCommandBuffer) CmdEndRendering() {
+	c.DeviceDriver.VkCmdEndRendering(c.CommandBufferHandle)
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdPipelineBarrier2(dependencyInfo DependencyInfo) error {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	dependencyInfoPtr, err := common.AllocOptions(arena, dependencyInfo)
+	if err != nil {
+		return err
+	}
+
+	c.DeviceDriver.VkCmdPipelineBarrier2(
+		c.CommandBufferHandle,
+		(*driver.VkDependencyInfo)(dependencyInfoPtr),
+	)
+
+	c.CommandCounter.CommandCount++
+	return nil
+}
+
+func (c *VulkanCommandBuffer) CmdSetEvent2(event core1_0.Event, dependencyInfo DependencyInfo) error {
+	if event == nil {
+		return common.NilArgumentError("CmdSetEvent2", "event")
+	}
+
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	dependencyInfoPtr, err := common.AllocOptions(arena, dependencyInfo)
+	if err != nil {
+		return err
+	}
+
+	c.DeviceDriver.VkCmdSetEvent2(
+		c.CommandBufferHandle,
+		event.Handle(),
+		(*driver.VkDependencyInfo)(dependencyInfoPtr),
+	)
+
+	c.CommandCounter.CommandCount++
+	return nil
+}
+
+func (c *VulkanCommandBuffer) CmdResetEvent2(event core1_0.Event, stageMask PipelineStageFlags2) {
+	if event == nil {
+		panic(common.NilArgumentError("CmdResetEvent2", "event"))
+	}
+
+	c.DeviceDriver.VkCmdResetEvent2(c.CommandBufferHandle, event.Handle(), driver.VkPipelineStageFlags2(stageMask))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdWaitEvents2(events []core1_0.Event, dependencyInfos []DependencyInfo) error {
+	if len(events) != len(dependencyInfos) {
+		return errors.Newf("attempted to wait on %d events with %d dependency infos- these should match", len(events), len(dependencyInfos))
+	}
+
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	eventCount := len(events)
+
+	var err error
+	var eventPtr *C.VkEvent
+	var dependencyInfoPtr *C.VkDependencyInfo
+
+	if eventCount > 0 {
+		eventPtr = (*C.VkEvent)(arena.Malloc(eventCount * int(unsafe.Sizeof([1]C.VkEvent{}))))
+		eventSlice := ([]C.VkEvent)(unsafe.Slice(eventPtr, eventCount))
+
+		for i := 0; i < eventCount; i++ {
+			if events[i] == nil {
+				return common.NilArgumentError("CmdWaitEvents2", fmt.Sprintf("element %d of events", i))
+			}
+			eventSlice[i] = C.VkEvent(unsafe.Pointer(events[i].Handle()))
+		}
+
+		dependencyInfoPtr, err = common.AllocOptionSlice[C.VkDependencyInfo, DependencyInfo](arena, dependencyInfos)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.DeviceDriver.VkCmdWaitEvents2(
+		c.CommandBufferHandle,
+		driver.Uint32(eventCount),
+		(*driver.VkEvent)(unsafe.Pointer(eventPtr)),
+		(*driver.VkDependencyInfo)(unsafe.Pointer(dependencyInfoPtr)),
+	)
+
+	c.CommandCounter.CommandCount++
+	return nil
+}
+
+func (c *VulkanCommandBuffer) CmdWriteTimestamp2(stage PipelineStageFlags2, queryPool core1_0.QueryPool, query int) {
+	if queryPool == nil {
+		panic(common.NilArgumentError("CmdWriteTimestamp2", "queryPool"))
+	}
+
+	c.DeviceDriver.VkCmdWriteTimestamp2(c.CommandBufferHandle, driver.VkPipelineStageFlags2(stage), queryPool.Handle(), driver.Uint32(query))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetCullMode(cullMode core1_0.CullModeFlags) {
+	c.DeviceDriver.VkCmdSetCullMode(c.CommandBufferHandle, driver.VkCullModeFlags(cullMode))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetFrontFace(frontFace core1_0.FrontFace) {
+	c.DeviceDriver.VkCmdSetFrontFace(c.CommandBufferHandle, driver.VkFrontFace(frontFace))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetPrimitiveTopology(primitiveTopology core1_0.PrimitiveTopology) {
+	c.DeviceDriver.VkCmdSetPrimitiveTopology(c.CommandBufferHandle, driver.VkPrimitiveTopology(primitiveTopology))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetViewportWithCount(viewports []core1_0.Viewport) {
+	allocator := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(allocator)
+
+	viewportCount := len(viewports)
+	var viewportPtr *C.VkViewport
+
+	if viewportCount > 0 {
+		viewportPtr = (*C.VkViewport)(allocator.Malloc(viewportCount * C.sizeof_struct_VkViewport))
+		viewportSlice := ([]C.VkViewport)(unsafe.Slice(viewportPtr, viewportCount))
+
+		for i := 0; i < viewportCount; i++ {
+			viewport := viewports[i]
+			viewportSlice[i].x = C.float(viewport.X)
+			viewportSlice[i].y = C.float(viewport.Y)
+			viewportSlice[i].width = C.float(viewport.Width)
+			viewportSlice[i].height = C.float(viewport.Height)
+			viewportSlice[i].minDepth = C.float(viewport.MinDepth)
+			viewportSlice[i].maxDepth = C.float(viewport.MaxDepth)
+		}
+	}
+
+	c.DeviceDriver.VkCmdSetViewportWithCount(c.CommandBufferHandle, driver.Uint32(viewportCount), (*driver.VkViewport)(unsafe.Pointer(viewportPtr)))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetScissorWithCount(scissors []core1_0.Rect2D) {
+	allocator := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(allocator)
+
+	scissorCount := len(scissors)
+	var scissorPtr *C.VkRect2D
+
+	if scissorCount > 0 {
+		scissorPtr = (*C.VkRect2D)(allocator.Malloc(scissorCount * C.sizeof_struct_VkRect2D))
+		scissorSlice := ([]C.VkRect2D)(unsafe.Slice(scissorPtr, scissorCount))
+
+		for i := 0; i < scissorCount; i++ {
+			scissor := scissors[i]
+			scissorSlice[i].offset.x = C.int32_t(scissor.Offset.X)
+			scissorSlice[i].offset.y = C.int32_t(scissor.Offset.Y)
+			scissorSlice[i].extent.width = C.uint32_t(scissor.Extent.Width)
+			scissorSlice[i].extent.height = C.uint32_t(scissor.Extent.Height)
+		}
+	}
+
+	c.DeviceDriver.VkCmdSetScissorWithCount(c.CommandBufferHandle, driver.Uint32(scissorCount), (*driver.VkRect2D)(unsafe.Pointer(scissorPtr)))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdBindVertexBuffers2(firstBinding int, buffers []core1_0.Buffer, bufferOffsets []int, sizes []int, strides []int) error {
+	bufferCount := len(buffers)
+	if len(bufferOffsets) != bufferCount {
+		return errors.Newf("attempted to bind %d vertex buffers with %d buffer offsets- these should match", bufferCount, len(bufferOffsets))
+	}
+	if sizes != nil && len(sizes) != bufferCount {
+		return errors.Newf("attempted to bind %d vertex buffers with %d sizes- these should match", bufferCount, len(sizes))
+	}
+	if strides != nil && len(strides) != bufferCount {
+		return errors.Newf("attempted to bind %d vertex buffers with %d strides- these should match", bufferCount, len(strides))
+	}
+
+	allocator := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(allocator)
+
+	bufferArrayPtr := (*driver.VkBuffer)(allocator.Malloc(bufferCount * int(unsafe.Sizeof([1]C.VkBuffer{}))))
+	offsetArrayPtr := (*driver.VkDeviceSize)(allocator.Malloc(bufferCount * int(unsafe.Sizeof(C.VkDeviceSize(0)))))
+
+	bufferArraySlice := ([]driver.VkBuffer)(unsafe.Slice(bufferArrayPtr, bufferCount))
+	offsetArraySlice := ([]driver.VkDeviceSize)(unsafe.Slice(offsetArrayPtr, bufferCount))
+
+	for i := 0; i < bufferCount; i++ {
+		if buffers[i] == nil {
+			return common.NilArgumentError("CmdBindVertexBuffers2", fmt.Sprintf("element %d of buffers", i))
+		}
+		bufferArraySlice[i] = buffers[i].Handle()
+		offsetArraySlice[i] = driver.VkDeviceSize(bufferOffsets[i])
+	}
+
+	var sizeArrayPtr *driver.VkDeviceSize
+	if sizes != nil {
+		sizeArrayPtr = (*driver.VkDeviceSize)(allocator.Malloc(bufferCount * int(unsafe.Sizeof(C.VkDeviceSize(0)))))
+		sizeArraySlice := ([]driver.VkDeviceSize)(unsafe.Slice(sizeArrayPtr, bufferCount))
+
+		for i := 0; i < bufferCount; i++ {
+			sizeArraySlice[i] = driver.VkDeviceSize(sizes[i])
+		}
+	}
+
+	var strideArrayPtr *driver.VkDeviceSize
+	if strides != nil {
+		strideArrayPtr = (*driver.VkDeviceSize)(allocator.Malloc(bufferCount * int(unsafe.Sizeof(C.VkDeviceSize(0)))))
+		strideArraySlice := ([]driver.VkDeviceSize)(unsafe.Slice(strideArrayPtr, bufferCount))
+
+		for i := 0; i < bufferCount; i++ {
+			strideArraySlice[i] = driver.VkDeviceSize(strides[i])
+		}
+	}
+
+	c.DeviceDriver.VkCmdBindVertexBuffers2(
+		c.CommandBufferHandle,
+		driver.Uint32(firstBinding),
+		driver.Uint32(bufferCount),
+		bufferArrayPtr,
+		offsetArrayPtr,
+		sizeArrayPtr,
+		strideArrayPtr,
+	)
+	c.CommandCounter.CommandCount++
+	return nil
+}
+
+func (c *VulkanCommandBuffer) CmdSetDepthTestEnable(depthTestEnable bool) {
+	c.DeviceDriver.VkCmdSetDepthTestEnable(c.CommandBufferHandle, vkBool(depthTestEnable))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetDepthWriteEnable(depthWriteEnable bool) {
+	c.DeviceDriver.VkCmdSetDepthWriteEnable(c.CommandBufferHandle, vkBool(depthWriteEnable))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetDepthCompareOp(depthCompareOp core1_0.CompareOp) {
+	c.DeviceDriver.VkCmdSetDepthCompareOp(c.CommandBufferHandle, driver.VkCompareOp(depthCompareOp))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetDepthBoundsTestEnable(depthBoundsTestEnable bool) {
+	c.DeviceDriver.VkCmdSetDepthBoundsTestEnable(c.CommandBufferHandle, vkBool(depthBoundsTestEnable))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetStencilTestEnable(stencilTestEnable bool) {
+	c.DeviceDriver.VkCmdSetStencilTestEnable(c.CommandBufferHandle, vkBool(stencilTestEnable))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetStencilOp(faceMask core1_0.StencilFaceFlags, failOp core1_0.StencilOp, passOp core1_0.StencilOp, depthFailOp core1_0.StencilOp, compareOp core1_0.CompareOp) {
+	c.DeviceDriver.VkCmdSetStencilOp(
+		c.CommandBufferHandle,
+		driver.VkStencilFaceFlags(faceMask),
+		driver.VkStencilOp(failOp),
+		driver.VkStencilOp(passOp),
+		driver.VkStencilOp(depthFailOp),
+		driver.VkCompareOp(compareOp),
+	)
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetRasterizerDiscardEnable(rasterizerDiscardEnable bool) {
+	c.DeviceDriver.VkCmdSetRasterizerDiscardEnable(c.CommandBufferHandle, vkBool(rasterizerDiscardEnable))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetDepthBiasEnable(depthBiasEnable bool) {
+	c.DeviceDriver.VkCmdSetDepthBiasEnable(c.CommandBufferHandle, vkBool(depthBiasEnable))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdSetPrimitiveRestartEnable(primitiveRestartEnable bool) {
+	c.DeviceDriver.VkCmdSetPrimitiveRestartEnable(c.CommandBufferHandle, vkBool(primitiveRestartEnable))
+	c.CommandCounter.CommandCount++
+}
+
+func (c *VulkanCommandBuffer) CmdCopyBuffer2(copyBufferInfo CopyBufferInfo2) error {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	infoPtr, err := common.AllocOptions(arena, copyBufferInfo)
+	if err != nil {
+		return err
+	}
+
+	c.DeviceDriver.VkCmdCopyBuffer2(c.CommandBufferHandle, (*driver.VkCopyBufferInfo2)(infoPtr))
+	c.CommandCounter.CommandCount++
+	return nil
+}
+
+func (c *VulkanCommandBuffer) CmdCopyImage2(copyImageInfo CopyImageInfo2) error {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	infoPtr, err := common.AllocOptions(arena, copyImageInfo)
+	if err != nil {
+		return err
+	}
+
+	c.DeviceDriver.VkCmdCopyImage2(c.CommandBufferHandle, (*driver.VkCopyImageInfo2)(infoPtr))
+	c.CommandCounter.CommandCount++
+	return nil
+}
+
+func (c *VulkanCommandBuffer) CmdCopyBufferToImage2(copyBufferToImageInfo CopyBufferToImageInfo2) error {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	infoPtr, err := common.AllocOptions(arena, copyBufferToImageInfo)
+	if err != nil {
+		return err
+	}
+
+	c.DeviceDriver.VkCmdCopyBufferToImage2(c.CommandBufferHandle, (*driver.VkCopyBufferToImageInfo2)(infoPtr))
+	c.CommandCounter.CommandCount++
+	return nil
+}
+
+func (c *VulkanCommandBuffer) CmdCopyImageToBuffer2(copyImageToBufferInfo CopyImageToBufferInfo2) error {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	infoPtr, err := common.AllocOptions(arena, copyImageToBufferInfo)
+	if err != nil {
+		return err
+	}
+
+	c.DeviceDriver.VkCmdCopyImageToBuffer2(c.CommandBufferHandle, (*driver.VkCopyImageToBufferInfo2)(infoPtr))
+	c.CommandCounter.CommandCount++
+	return nil
+}
+
+func (c *VulkanCommandBuffer) CmdBlitImage2(blitImageInfo BlitImageInfo2) error {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	infoPtr, err := common.AllocOptions(arena, blitImageInfo)
+	if err != nil {
+		return err
+	}
+
+	c.DeviceDriver.VkCmdBlitImage2(c.CommandBufferHandle, (*driver.VkBlitImageInfo2)(infoPtr))
+	c.CommandCounter.CommandCount++
+	return nil
+}
+
+func (c *VulkanCommandBuffer) CmdResolveImage2(resolveImageInfo ResolveImageInfo2) error {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	infoPtr, err := common.AllocOptions(arena, resolveImageInfo)
+	if err != nil {
+		return err
+	}
+
+	c.DeviceDriver.VkCmdResolveImage2(c.CommandBufferHandle, (*driver.VkResolveImageInfo2)(infoPtr))
+	c.CommandCounter.CommandCount++
+	return nil
+}
+
+func vkBool(value bool) driver.VkBool32 {
+	if value {
+		return driver.VkBool32(C.VK_TRUE)
+	}
+
+	return driver.VkBool32(C.VK_FALSE)
+}
@@ -0,0 +1,647 @@
+package core1_3_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_2"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"github.com/vkngwrapper/core/v2/driver"
+	mock_driver "github.com/vkngwrapper/core/v2/driver/mocks"
+	"github.com/vkngwrapper/core/v2/internal/dummies"
+	"github.com/vkngwrapper/core/v2/mocks"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+func TestPromoteCommandBuffer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	baseBuffer := dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool)
+	buffer := mocks.EasyMockBuffer(ctrl)
+
+	commandBuffer := core1_3.PromoteCommandBuffer(baseBuffer)
+	require.NotNil(t, commandBuffer)
+	require.Same(t, commandBuffer, core1_3.PromoteCommandBuffer(baseBuffer))
+
+	// Commands recorded through any core version are counted on the same buffer
+	coreDriver.EXPECT().VkCmdDrawIndirectCount(
+		commandBuffer.Handle(),
+		buffer.Handle(),
+		gomock.Any(),
+		buffer.Handle(),
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	)
+
+	core1_2.PromoteCommandBuffer(baseBuffer).CmdDrawIndirectCount(buffer, 0, buffer, 0, 1, 16)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+	require.Equal(t, 1, commandBuffer.DrawsRecorded())
+	require.Same(t, baseBuffer.(*core1_0.VulkanCommandBuffer).CommandCounter(), commandBuffer.(*core1_3.VulkanCommandBuffer).CommandCounter)
+}
+
+func TestPromoteCommandBuffer_Core1_2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_2)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	baseBuffer := dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool)
+
+	require.Nil(t, core1_3.PromoteCommandBuffer(baseBuffer))
+	require.Nil(t, core1_3.PromoteCommandBufferSlice([]core1_0.CommandBuffer{baseBuffer}))
+	require.Nil(t, core1_3.PromoteDevice(dummies.EasyDummyDevice(coreDriver)))
+	require.Nil(t, core1_3.PromoteInstance(dummies.EasyDummyInstance(coreDriver)))
+}
+
+// extensionDevice creates a mock Device that reports the provided extensions as enabled
+func extensionDevice(ctrl *gomock.Controller, coreDriver driver.Driver, extensionNames ...string) *mocks.MockDevice {
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	device.EXPECT().IsDeviceExtensionActive(gomock.Any()).DoAndReturn(func(extensionName string) bool {
+		for _, name := range extensionNames {
+			if name == extensionName {
+				return true
+			}
+		}
+		return false
+	}).AnyTimes()
+
+	return device
+}
+
+func TestPromoteCommandBufferFromExtensions_DynamicRendering(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_2)
+	device := extensionDevice(ctrl, coreDriver, "VK_KHR_dynamic_rendering")
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	baseBuffer := dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool)
+
+	require.Nil(t, core1_3.PromoteCommandBuffer(baseBuffer))
+	commandBuffer := core1_3.PromoteCommandBufferFromExtensions(baseBuffer, device)
+	require.NotNil(t, commandBuffer)
+	require.Same(t, commandBuffer, core1_3.PromoteCommandBufferFromExtensions(baseBuffer, device))
+
+	coreDriver.EXPECT().VkCmdBeginRendering(commandBuffer.Handle(), gomock.Not(gomock.Nil()))
+	coreDriver.EXPECT().VkCmdEndRendering(commandBuffer.Handle())
+
+	err := commandBuffer.CmdBeginRendering(core1_3.RenderingInfo{
+		RenderArea: core1_0.Rect2D{Extent: core1_0.Extent2D{Width: 5, Height: 7}},
+		LayerCount: 1,
+	})
+	require.NoError(t, err)
+	commandBuffer.CmdEndRendering()
+	require.Equal(t, 2, commandBuffer.CommandsRecorded())
+
+	// The Device must have enabled the extension, and must be the Device the CommandBuffer was
+	// allocated from
+	plainDevice := extensionDevice(ctrl, coreDriver)
+	plainBuffer := dummies.EasyDummyCommandBuffer(coreDriver, plainDevice, mocks.EasyMockCommandPool(ctrl, plainDevice))
+	require.Nil(t, core1_3.PromoteCommandBufferFromExtensions(plainBuffer, plainDevice))
+	require.Nil(t, core1_3.PromoteCommandBufferFromExtensions(plainBuffer, device))
+
+	// Core 1.1 CommandBuffers cannot be promoted, even if the extension is enabled
+	core1_1Driver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_1)
+	core1_1Device := extensionDevice(ctrl, core1_1Driver, "VK_KHR_dynamic_rendering")
+	core1_1Buffer := dummies.EasyDummyCommandBuffer(core1_1Driver, core1_1Device, mocks.EasyMockCommandPool(ctrl, core1_1Device))
+	require.Nil(t, core1_3.PromoteCommandBufferFromExtensions(core1_1Buffer, core1_1Device))
+}
+
+func TestPromoteCommandBufferFromExtensions_Synchronization2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_2)
+	device := extensionDevice(ctrl, coreDriver, "VK_KHR_synchronization2")
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBufferFromExtensions(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool), device)
+	require.NotNil(t, commandBuffer)
+
+	coreDriver.EXPECT().VkCmdPipelineBarrier2(commandBuffer.Handle(), gomock.Not(gomock.Nil()))
+
+	err := commandBuffer.CmdPipelineBarrier2(core1_3.DependencyInfo{})
+	require.NoError(t, err)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+}
+
+func TestPromoteCommandBufferFromExtensions_ExtendedDynamicState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_2)
+
+	dynamicStateDevice := extensionDevice(ctrl, coreDriver, "VK_EXT_extended_dynamic_state")
+	commandBuffer := core1_3.PromoteCommandBufferFromExtensions(dummies.EasyDummyCommandBuffer(coreDriver, dynamicStateDevice, mocks.EasyMockCommandPool(ctrl, dynamicStateDevice)), dynamicStateDevice)
+	require.NotNil(t, commandBuffer)
+
+	coreDriver.EXPECT().VkCmdSetCullMode(commandBuffer.Handle(), driver.VkCullModeFlags(2)) // VK_CULL_MODE_BACK_BIT
+	commandBuffer.CmdSetCullMode(core1_0.CullModeBack)
+
+	dynamicState2Device := extensionDevice(ctrl, coreDriver, "VK_EXT_extended_dynamic_state2")
+	commandBuffer = core1_3.PromoteCommandBufferFromExtensions(dummies.EasyDummyCommandBuffer(coreDriver, dynamicState2Device, mocks.EasyMockCommandPool(ctrl, dynamicState2Device)), dynamicState2Device)
+	require.NotNil(t, commandBuffer)
+
+	coreDriver.EXPECT().VkCmdSetPrimitiveRestartEnable(commandBuffer.Handle(), driver.VkBool32(1))
+	commandBuffer.CmdSetPrimitiveRestartEnable(true)
+}
+
+func TestPromoteCommandBufferFromExtensions_CopyCommands2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_2)
+	device := extensionDevice(ctrl, coreDriver, "VK_KHR_copy_commands2")
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBufferFromExtensions(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool), device)
+	require.NotNil(t, commandBuffer)
+
+	coreDriver.EXPECT().VkCmdCopyBuffer2(commandBuffer.Handle(), gomock.Not(gomock.Nil()))
+
+	err := commandBuffer.CmdCopyBuffer2(core1_3.CopyBufferInfo2{
+		SrcBuffer: mocks.EasyMockBuffer(ctrl),
+		DstBuffer: mocks.EasyMockBuffer(ctrl),
+		Regions:   []core1_3.BufferCopy2{{Size: 16}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+}
+
+func TestCommandBuffer_CmdBeginRendering(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBuffer(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool))
+	colorView := mocks.EasyMockImageView(ctrl)
+	resolveView := mocks.EasyMockImageView(ctrl)
+	depthView := mocks.EasyMockImageView(ctrl)
+
+	coreDriver.EXPECT().VkCmdBeginRendering(
+		commandBuffer.Handle(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(commandBuffer driver.VkCommandBuffer, pRenderingInfo *driver.VkRenderingInfo) {
+		val := reflect.ValueOf(pRenderingInfo).Elem()
+		require.Equal(t, uint64(1000044000), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_RENDERING_INFO
+		require.True(t, val.FieldByName("pNext").IsNil())
+		require.Equal(t, uint64(4), val.FieldByName("flags").Uint()) // VK_RENDERING_RESUMING_BIT
+		require.Equal(t, int64(1), val.FieldByName("renderArea").FieldByName("offset").FieldByName("x").Int())
+		require.Equal(t, int64(3), val.FieldByName("renderArea").FieldByName("offset").FieldByName("y").Int())
+		require.Equal(t, uint64(5), val.FieldByName("renderArea").FieldByName("extent").FieldByName("width").Uint())
+		require.Equal(t, uint64(7), val.FieldByName("renderArea").FieldByName("extent").FieldByName("height").Uint())
+		require.Equal(t, uint64(1), val.FieldByName("layerCount").Uint())
+		require.Equal(t, uint64(0), val.FieldByName("viewMask").Uint())
+		require.Equal(t, uint64(1), val.FieldByName("colorAttachmentCount").Uint())
+		require.True(t, val.FieldByName("pStencilAttachment").IsNil())
+
+		color := val.FieldByName("pColorAttachments").Elem()
+		require.Equal(t, uint64(1000044001), color.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_RENDERING_ATTACHMENT_INFO
+		require.True(t, color.FieldByName("pNext").IsNil())
+		require.Equal(t, colorView.Handle(), driver.VkImageView(color.FieldByName("imageView").UnsafePointer()))
+		require.Equal(t, uint64(2), color.FieldByName("imageLayout").Uint()) // VK_IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL
+		require.Equal(t, uint64(2), color.FieldByName("resolveMode").Uint()) // VK_RESOLVE_MODE_AVERAGE_BIT
+		require.Equal(t, resolveView.Handle(), driver.VkImageView(color.FieldByName("resolveImageView").UnsafePointer()))
+		require.Equal(t, uint64(2), color.FieldByName("resolveImageLayout").Uint()) // VK_IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL
+		require.Equal(t, uint64(1), color.FieldByName("loadOp").Uint())             // VK_ATTACHMENT_LOAD_OP_CLEAR
+		require.Equal(t, uint64(0), color.FieldByName("storeOp").Uint())            // VK_ATTACHMENT_STORE_OP_STORE
+
+		values := (*driver.Float)(unsafe.Pointer(color.FieldByName("clearValue").UnsafeAddr()))
+		valueSlice := ([]driver.Float)(unsafe.Slice(values, 4))
+		require.InDelta(t, 1.0, float64(valueSlice[0]), 0.0001)
+		require.InDelta(t, 3.0, float64(valueSlice[1]), 0.0001)
+		require.InDelta(t, 5.0, float64(valueSlice[2]), 0.0001)
+		require.InDelta(t, 7.0, float64(valueSlice[3]), 0.0001)
+
+		depth := val.FieldByName("pDepthAttachment").Elem()
+		require.Equal(t, uint64(1000044001), depth.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_RENDERING_ATTACHMENT_INFO
+		require.Equal(t, depthView.Handle(), driver.VkImageView(depth.FieldByName("imageView").UnsafePointer()))
+		require.Equal(t, uint64(1000241000), depth.FieldByName("imageLayout").Uint()) // VK_IMAGE_LAYOUT_DEPTH_ATTACHMENT_OPTIMAL
+		require.Equal(t, uint64(0), depth.FieldByName("resolveMode").Uint())
+		require.True(t, depth.FieldByName("resolveImageView").IsNil())
+		require.Equal(t, uint64(1000301000), depth.FieldByName("storeOp").Uint()) // VK_ATTACHMENT_STORE_OP_NONE
+
+		depthValue := (*driver.Float)(unsafe.Pointer(depth.FieldByName("clearValue").UnsafeAddr()))
+		require.InDelta(t, 1.0, float64(*depthValue), 0.0001)
+	})
+
+	err := commandBuffer.CmdBeginRendering(core1_3.RenderingInfo{
+		Flags:      core1_3.RenderingResuming,
+		RenderArea: core1_0.Rect2D{Offset: core1_0.Offset2D{X: 1, Y: 3}, Extent: core1_0.Extent2D{Width: 5, Height: 7}},
+		LayerCount: 1,
+		ColorAttachments: []core1_3.RenderingAttachmentInfo{
+			{
+				ImageView:          colorView,
+				ImageLayout:        core1_0.ImageLayoutColorAttachmentOptimal,
+				ResolveMode:        core1_2.ResolveModeAverage,
+				ResolveImageView:   resolveView,
+				ResolveImageLayout: core1_0.ImageLayoutColorAttachmentOptimal,
+				LoadOp:             core1_0.AttachmentLoadOpClear,
+				StoreOp:            core1_0.AttachmentStoreOpStore,
+				ClearValue:         core1_0.ClearValueFloat{1, 3, 5, 7},
+			},
+		},
+		DepthAttachment: &core1_3.RenderingAttachmentInfo{
+			ImageView:   depthView,
+			ImageLayout: core1_2.ImageLayoutDepthAttachmentOptimal,
+			LoadOp:      core1_0.AttachmentLoadOpClear,
+			StoreOp:     core1_3.AttachmentStoreOpNone,
+			ClearValue:  core1_0.ClearValueDepthStencil{Depth: 1},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+}
+
+func TestCommandBuffer_CmdEndRendering(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBuffer(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool))
+
+	coreDriver.EXPECT().VkCmdEndRendering(commandBuffer.Handle())
+
+	commandBuffer.CmdEndRendering()
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+}
+
+func TestCommandBuffer_CmdPipelineBarrier2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBuffer(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool))
+	buffer := mocks.EasyMockBuffer(ctrl)
+	image := mocks.EasyMockImage(ctrl)
+
+	coreDriver.EXPECT().VkCmdPipelineBarrier2(
+		commandBuffer.Handle(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(commandBuffer driver.VkCommandBuffer, pDependencyInfo *driver.VkDependencyInfo) {
+		val := reflect.ValueOf(pDependencyInfo).Elem()
+		require.Equal(t, uint64(1000314003), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_DEPENDENCY_INFO
+		require.True(t, val.FieldByName("pNext").IsNil())
+		require.Equal(t, uint64(1), val.FieldByName("dependencyFlags").Uint()) // VK_DEPENDENCY_BY_REGION_BIT
+		require.Equal(t, uint64(1), val.FieldByName("memoryBarrierCount").Uint())
+		require.Equal(t, uint64(1), val.FieldByName("bufferMemoryBarrierCount").Uint())
+		require.Equal(t, uint64(1), val.FieldByName("imageMemoryBarrierCount").Uint())
+
+		memoryBarrier := val.FieldByName("pMemoryBarriers").Elem()
+		require.Equal(t, uint64(1000314000), memoryBarrier.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_MEMORY_BARRIER_2
+		require.True(t, memoryBarrier.FieldByName("pNext").IsNil())
+		require.Equal(t, uint64(0x100000000), memoryBarrier.FieldByName("srcStageMask").Uint())  // VK_PIPELINE_STAGE_2_COPY_BIT
+		require.Equal(t, uint64(0x200000000), memoryBarrier.FieldByName("srcAccessMask").Uint()) // VK_ACCESS_2_SHADER_STORAGE_READ_BIT
+		require.Equal(t, uint64(0x10000), memoryBarrier.FieldByName("dstStageMask").Uint())      // VK_PIPELINE_STAGE_2_ALL_COMMANDS_BIT
+		require.Equal(t, uint64(0x8000), memoryBarrier.FieldByName("dstAccessMask").Uint())      // VK_ACCESS_2_MEMORY_READ_BIT
+
+		bufferBarrier := val.FieldByName("pBufferMemoryBarriers").Elem()
+		require.Equal(t, uint64(1000314001), bufferBarrier.FieldByName("sType").Uint())     // VK_STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER_2
+		require.Equal(t, uint64(0x1000), bufferBarrier.FieldByName("srcStageMask").Uint())  // VK_PIPELINE_STAGE_2_TRANSFER_BIT
+		require.Equal(t, uint64(0x1000), bufferBarrier.FieldByName("srcAccessMask").Uint()) // VK_ACCESS_2_TRANSFER_WRITE_BIT
+		require.Equal(t, uint64(1), bufferBarrier.FieldByName("srcQueueFamilyIndex").Uint())
+		require.Equal(t, uint64(3), bufferBarrier.FieldByName("dstQueueFamilyIndex").Uint())
+		require.Equal(t, buffer.Handle(), driver.VkBuffer(bufferBarrier.FieldByName("buffer").UnsafePointer()))
+		require.Equal(t, uint64(5), bufferBarrier.FieldByName("offset").Uint())
+		require.Equal(t, uint64(7), bufferBarrier.FieldByName("size").Uint())
+
+		imageBarrier := val.FieldByName("pImageMemoryBarriers").Elem()
+		require.Equal(t, uint64(1000314002), imageBarrier.FieldByName("sType").Uint())     // VK_STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER_2
+		require.Equal(t, uint64(0), imageBarrier.FieldByName("oldLayout").Uint())          // VK_IMAGE_LAYOUT_UNDEFINED
+		require.Equal(t, uint64(1000314001), imageBarrier.FieldByName("newLayout").Uint()) // VK_IMAGE_LAYOUT_ATTACHMENT_OPTIMAL
+		require.Equal(t, image.Handle(), driver.VkImage(imageBarrier.FieldByName("image").UnsafePointer()))
+		require.Equal(t, uint64(1), imageBarrier.FieldByName("subresourceRange").FieldByName("aspectMask").Uint()) // VK_IMAGE_ASPECT_COLOR_BIT
+		require.Equal(t, uint64(1), imageBarrier.FieldByName("subresourceRange").FieldByName("levelCount").Uint())
+		require.Equal(t, uint64(1), imageBarrier.FieldByName("subresourceRange").FieldByName("layerCount").Uint())
+	})
+
+	err := commandBuffer.CmdPipelineBarrier2(core1_3.DependencyInfo{
+		DependencyFlags: core1_0.DependencyByRegion,
+		MemoryBarriers: []core1_3.MemoryBarrier2{
+			{
+				SrcStageMask:  core1_3.PipelineStage2Copy,
+				SrcAccessMask: core1_3.Access2ShaderStorageRead,
+				DstStageMask:  core1_3.PipelineStage2AllCommands,
+				DstAccessMask: core1_3.Access2MemoryRead,
+			},
+		},
+		BufferMemoryBarriers: []core1_3.BufferMemoryBarrier2{
+			{
+				SrcStageMask:        core1_3.PipelineStage2Transfer,
+				SrcAccessMask:       core1_3.Access2TransferWrite,
+				SrcQueueFamilyIndex: 1,
+				DstQueueFamilyIndex: 3,
+				Buffer:              buffer,
+				Offset:              5,
+				Size:                7,
+			},
+		},
+		ImageMemoryBarriers: []core1_3.ImageMemoryBarrier2{
+			{
+				OldLayout: core1_0.ImageLayoutUndefined,
+				NewLayout: core1_3.ImageLayoutAttachmentOptimal,
+				Image:     image,
+				SubresourceRange: core1_0.ImageSubresourceRange{
+					AspectMask: core1_0.ImageAspectColor,
+					LevelCount: 1,
+					LayerCount: 1,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+}
+
+func TestCommandBuffer_CmdWaitEvents2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBuffer(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool))
+	event1 := mocks.EasyMockEvent(ctrl)
+	event2 := mocks.EasyMockEvent(ctrl)
+
+	coreDriver.EXPECT().VkCmdWaitEvents2(
+		commandBuffer.Handle(),
+		driver.Uint32(2),
+		gomock.Not(gomock.Nil()),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(commandBuffer driver.VkCommandBuffer, eventCount driver.Uint32, pEvents *driver.VkEvent, pDependencyInfos *driver.VkDependencyInfo) {
+		events := ([]driver.VkEvent)(unsafe.Slice(pEvents, 2))
+		require.Equal(t, event1.Handle(), events[0])
+		require.Equal(t, event2.Handle(), events[1])
+
+		infos := reflect.ValueOf(([]driver.VkDependencyInfo)(unsafe.Slice(pDependencyInfos, 2)))
+		require.Equal(t, uint64(1000314003), infos.Index(0).FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_DEPENDENCY_INFO
+		require.Equal(t, uint64(0), infos.Index(0).FieldByName("memoryBarrierCount").Uint())
+		require.Equal(t, uint64(1000314003), infos.Index(1).FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_DEPENDENCY_INFO
+		require.Equal(t, uint64(1), infos.Index(1).FieldByName("memoryBarrierCount").Uint())
+	})
+
+	err := commandBuffer.CmdWaitEvents2(
+		[]core1_0.Event{event1, event2},
+		[]core1_3.DependencyInfo{
+			{},
+			{
+				MemoryBarriers: []core1_3.MemoryBarrier2{
+					{SrcStageMask: core1_3.PipelineStage2Host, DstStageMask: core1_3.PipelineStage2ComputeShader},
+				},
+			},
+		})
+	require.NoError(t, err)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+
+	err = commandBuffer.CmdWaitEvents2([]core1_0.Event{event1}, nil)
+	require.Error(t, err)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+}
+
+func TestCommandBuffer_CmdWriteTimestamp2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBuffer(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool))
+	queryPool := mocks.EasyMockQueryPool(ctrl)
+
+	coreDriver.EXPECT().VkCmdWriteTimestamp2(
+		commandBuffer.Handle(),
+		driver.VkPipelineStageFlags2(0x400000000), // VK_PIPELINE_STAGE_2_BLIT_BIT
+		queryPool.Handle(),
+		driver.Uint32(3),
+	)
+
+	commandBuffer.CmdWriteTimestamp2(core1_3.PipelineStage2Blit, queryPool, 3)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+}
+
+func TestCommandBuffer_CmdBindVertexBuffers2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBuffer(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool))
+	buffer1 := mocks.EasyMockBuffer(ctrl)
+	buffer2 := mocks.EasyMockBuffer(ctrl)
+
+	coreDriver.EXPECT().VkCmdBindVertexBuffers2(
+		commandBuffer.Handle(),
+		driver.Uint32(3),
+		driver.Uint32(2),
+		gomock.Not(gomock.Nil()),
+		gomock.Not(gomock.Nil()),
+		gomock.Nil(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(commandBuffer driver.VkCommandBuffer, firstBinding, bindingCount driver.Uint32, pBuffers *driver.VkBuffer, pOffsets, pSizes, pStrides *driver.VkDeviceSize) {
+		buffers := ([]driver.VkBuffer)(unsafe.Slice(pBuffers, 2))
+		require.Equal(t, buffer1.Handle(), buffers[0])
+		require.Equal(t, buffer2.Handle(), buffers[1])
+
+		offsets := ([]driver.VkDeviceSize)(unsafe.Slice(pOffsets, 2))
+		require.Equal(t, []driver.VkDeviceSize{5, 7}, offsets)
+
+		strides := ([]driver.VkDeviceSize)(unsafe.Slice(pStrides, 2))
+		require.Equal(t, []driver.VkDeviceSize{11, 13}, strides)
+	})
+
+	err := commandBuffer.CmdBindVertexBuffers2(3, []core1_0.Buffer{buffer1, buffer2}, []int{5, 7}, nil, []int{11, 13})
+	require.NoError(t, err)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+
+	err = commandBuffer.CmdBindVertexBuffers2(3, []core1_0.Buffer{buffer1, buffer2}, []int{5, 7}, []int{1}, nil)
+	require.Error(t, err)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+}
+
+func TestCommandBuffer_ExtendedDynamicState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBuffer(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool))
+
+	coreDriver.EXPECT().VkCmdSetCullMode(commandBuffer.Handle(), driver.VkCullModeFlags(2))              // VK_CULL_MODE_BACK_BIT
+	coreDriver.EXPECT().VkCmdSetFrontFace(commandBuffer.Handle(), driver.VkFrontFace(1))                 // VK_FRONT_FACE_CLOCKWISE
+	coreDriver.EXPECT().VkCmdSetPrimitiveTopology(commandBuffer.Handle(), driver.VkPrimitiveTopology(4)) // VK_PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP
+	coreDriver.EXPECT().VkCmdSetDepthTestEnable(commandBuffer.Handle(), driver.VkBool32(1))
+	coreDriver.EXPECT().VkCmdSetDepthWriteEnable(commandBuffer.Handle(), driver.VkBool32(0))
+	coreDriver.EXPECT().VkCmdSetDepthCompareOp(commandBuffer.Handle(), driver.VkCompareOp(3)) // VK_COMPARE_OP_LESS_OR_EQUAL
+	coreDriver.EXPECT().VkCmdSetStencilOp(
+		commandBuffer.Handle(),
+		driver.VkStencilFaceFlags(3), // VK_STENCIL_FACE_FRONT_AND_BACK
+		driver.VkStencilOp(0),        // VK_STENCIL_OP_KEEP
+		driver.VkStencilOp(2),        // VK_STENCIL_OP_REPLACE
+		driver.VkStencilOp(5),        // VK_STENCIL_OP_INVERT
+		driver.VkCompareOp(7),        // VK_COMPARE_OP_ALWAYS
+	)
+	coreDriver.EXPECT().VkCmdSetRasterizerDiscardEnable(commandBuffer.Handle(), driver.VkBool32(1))
+	coreDriver.EXPECT().VkCmdSetPrimitiveRestartEnable(commandBuffer.Handle(), driver.VkBool32(0))
+
+	commandBuffer.CmdSetCullMode(core1_0.CullModeBack)
+	commandBuffer.CmdSetFrontFace(core1_0.FrontFaceClockwise)
+	commandBuffer.CmdSetPrimitiveTopology(core1_0.PrimitiveTopologyTriangleStrip)
+	commandBuffer.CmdSetDepthTestEnable(true)
+	commandBuffer.CmdSetDepthWriteEnable(false)
+	commandBuffer.CmdSetDepthCompareOp(core1_0.CompareOpLessOrEqual)
+	commandBuffer.CmdSetStencilOp(core1_0.StencilFaceFront|core1_0.StencilFaceBack, core1_0.StencilKeep, core1_0.StencilReplace, core1_0.StencilInvert, core1_0.CompareOpAlways)
+	commandBuffer.CmdSetRasterizerDiscardEnable(true)
+	commandBuffer.CmdSetPrimitiveRestartEnable(false)
+	require.Equal(t, 9, commandBuffer.CommandsRecorded())
+}
+
+func TestCommandBuffer_CmdSetViewportWithCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBuffer(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool))
+
+	coreDriver.EXPECT().VkCmdSetViewportWithCount(
+		commandBuffer.Handle(),
+		driver.Uint32(1),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(commandBuffer driver.VkCommandBuffer, viewportCount driver.Uint32, pViewports *driver.VkViewport) {
+		val := reflect.ValueOf(pViewports).Elem()
+		require.InDelta(t, 1.0, val.FieldByName("x").Float(), 0.0001)
+		require.InDelta(t, 3.0, val.FieldByName("y").Float(), 0.0001)
+		require.InDelta(t, 5.0, val.FieldByName("width").Float(), 0.0001)
+		require.InDelta(t, 7.0, val.FieldByName("height").Float(), 0.0001)
+		require.InDelta(t, 0.0, val.FieldByName("minDepth").Float(), 0.0001)
+		require.InDelta(t, 1.0, val.FieldByName("maxDepth").Float(), 0.0001)
+	})
+
+	commandBuffer.CmdSetViewportWithCount([]core1_0.Viewport{{X: 1, Y: 3, Width: 5, Height: 7, MaxDepth: 1}})
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+}
+
+func TestCommandBuffer_CmdCopyBuffer2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBuffer(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool))
+	srcBuffer := mocks.EasyMockBuffer(ctrl)
+	dstBuffer := mocks.EasyMockBuffer(ctrl)
+
+	coreDriver.EXPECT().VkCmdCopyBuffer2(
+		commandBuffer.Handle(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(commandBuffer driver.VkCommandBuffer, pCopyBufferInfo *driver.VkCopyBufferInfo2) {
+		val := reflect.ValueOf(pCopyBufferInfo).Elem()
+		require.Equal(t, uint64(1000337000), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_COPY_BUFFER_INFO_2
+		require.True(t, val.FieldByName("pNext").IsNil())
+		require.Equal(t, srcBuffer.Handle(), driver.VkBuffer(val.FieldByName("srcBuffer").UnsafePointer()))
+		require.Equal(t, dstBuffer.Handle(), driver.VkBuffer(val.FieldByName("dstBuffer").UnsafePointer()))
+		require.Equal(t, uint64(2), val.FieldByName("regionCount").Uint())
+
+		region := val.FieldByName("pRegions").Elem()
+		require.Equal(t, uint64(1000337006), region.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_BUFFER_COPY_2
+		require.True(t, region.FieldByName("pNext").IsNil())
+		require.Equal(t, uint64(1), region.FieldByName("srcOffset").Uint())
+		require.Equal(t, uint64(3), region.FieldByName("dstOffset").Uint())
+		require.Equal(t, uint64(5), region.FieldByName("size").Uint())
+
+		region = reflect.NewAt(region.Type(), unsafe.Add(region.Addr().UnsafePointer(), region.Type().Size())).Elem()
+		require.Equal(t, uint64(1000337006), region.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_BUFFER_COPY_2
+		require.Equal(t, uint64(7), region.FieldByName("srcOffset").Uint())
+		require.Equal(t, uint64(11), region.FieldByName("dstOffset").Uint())
+		require.Equal(t, uint64(13), region.FieldByName("size").Uint())
+	})
+
+	err := commandBuffer.CmdCopyBuffer2(core1_3.CopyBufferInfo2{
+		SrcBuffer: srcBuffer,
+		DstBuffer: dstBuffer,
+		Regions: []core1_3.BufferCopy2{
+			{SrcOffset: 1, DstOffset: 3, Size: 5},
+			{SrcOffset: 7, DstOffset: 11, Size: 13},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+
+	err = commandBuffer.CmdCopyBuffer2(core1_3.CopyBufferInfo2{SrcBuffer: srcBuffer})
+	require.EqualError(t, err, "core1_3.CopyBufferInfo2.DstBuffer cannot be nil")
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+}
+
+func TestCommandBuffer_CmdBlitImage2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	commandPool := mocks.EasyMockCommandPool(ctrl, device)
+	commandBuffer := core1_3.PromoteCommandBuffer(dummies.EasyDummyCommandBuffer(coreDriver, device, commandPool))
+	srcImage := mocks.EasyMockImage(ctrl)
+	dstImage := mocks.EasyMockImage(ctrl)
+
+	coreDriver.EXPECT().VkCmdBlitImage2(
+		commandBuffer.Handle(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(commandBuffer driver.VkCommandBuffer, pBlitImageInfo *driver.VkBlitImageInfo2) {
+		val := reflect.ValueOf(pBlitImageInfo).Elem()
+		require.Equal(t, uint64(1000337004), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_BLIT_IMAGE_INFO_2
+		require.Equal(t, srcImage.Handle(), driver.VkImage(val.FieldByName("srcImage").UnsafePointer()))
+		require.Equal(t, uint64(6), val.FieldByName("srcImageLayout").Uint()) // VK_IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL
+		require.Equal(t, dstImage.Handle(), driver.VkImage(val.FieldByName("dstImage").UnsafePointer()))
+		require.Equal(t, uint64(7), val.FieldByName("dstImageLayout").Uint()) // VK_IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL
+		require.Equal(t, uint64(1), val.FieldByName("filter").Uint())         // VK_FILTER_LINEAR
+		require.Equal(t, uint64(1), val.FieldByName("regionCount").Uint())
+
+		region := val.FieldByName("pRegions").Elem()
+		require.Equal(t, uint64(1000337008), region.FieldByName("sType").Uint())                           // VK_STRUCTURE_TYPE_IMAGE_BLIT_2
+		require.Equal(t, uint64(1), region.FieldByName("srcSubresource").FieldByName("aspectMask").Uint()) // VK_IMAGE_ASPECT_COLOR_BIT
+		require.Equal(t, uint64(2), region.FieldByName("srcSubresource").FieldByName("mipLevel").Uint())
+		require.Equal(t, uint64(1), region.FieldByName("srcSubresource").FieldByName("layerCount").Uint())
+		require.Equal(t, int64(64), region.FieldByName("srcOffsets").Index(1).FieldByName("x").Int())
+		require.Equal(t, int64(32), region.FieldByName("srcOffsets").Index(1).FieldByName("y").Int())
+		require.Equal(t, int64(1), region.FieldByName("srcOffsets").Index(1).FieldByName("z").Int())
+		require.Equal(t, uint64(3), region.FieldByName("dstSubresource").FieldByName("mipLevel").Uint())
+		require.Equal(t, int64(32), region.FieldByName("dstOffsets").Index(1).FieldByName("x").Int())
+		require.Equal(t, int64(16), region.FieldByName("dstOffsets").Index(1).FieldByName("y").Int())
+	})
+
+	err := commandBuffer.CmdBlitImage2(core1_3.BlitImageInfo2{
+		SrcImage:       srcImage,
+		SrcImageLayout: core1_0.ImageLayoutTransferSrcOptimal,
+		DstImage:       dstImage,
+		DstImageLayout: core1_0.ImageLayoutTransferDstOptimal,
+		Regions: []core1_3.ImageBlit2{
+			{
+				SrcSubresource: core1_0.ImageSubresourceLayers{AspectMask: core1_0.ImageAspectColor, MipLevel: 2, LayerCount: 1},
+				SrcOffsets:     [2]core1_0.Offset3D{{}, {X: 64, Y: 32, Z: 1}},
+				DstSubresource: core1_0.ImageSubresourceLayers{AspectMask: core1_0.ImageAspectColor, MipLevel: 3, LayerCount: 1},
+				DstOffsets:     [2]core1_0.Offset3D{{}, {X: 32, Y: 16, Z: 1}},
+			},
+		},
+		Filter: core1_0.FilterLinear,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, commandBuffer.CommandsRecorded())
+}
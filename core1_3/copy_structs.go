@@ -0,0 +1,579 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"unsafe"
+)
+
+// BufferCopy2 specifies a buffer copy operation via CommandBuffer.CmdCopyBuffer2
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkBufferCopy2.html
+type BufferCopy2 struct {
+	// SrcOffset is the starting offset in bytes from the start of the source Buffer
+	SrcOffset int
+	// DstOffset is the starting offset in bytes from the start of the dest Buffer
+	DstOffset int
+	// Size is the number of bytes to copy
+	Size int
+
+	common.NextOptions
+}
+
+func (o BufferCopy2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkBufferCopy2{})))
+	}
+
+	info := (*C.VkBufferCopy2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_BUFFER_COPY_2
+	info.pNext = next
+	info.srcOffset = C.VkDeviceSize(o.SrcOffset)
+	info.dstOffset = C.VkDeviceSize(o.DstOffset)
+	info.size = C.VkDeviceSize(o.Size)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// ImageCopy2 specifies an Image copy operation via CommandBuffer.CmdCopyImage2
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkImageCopy2.html
+type ImageCopy2 struct {
+	// SrcSubresource specifies the Image subresources of the Image objects used for the
+	// source Image data
+	SrcSubresource core1_0.ImageSubresourceLayers
+	// SrcOffset selects the initial x, y, and z offsets in texels of the sub-regions of the
+	// source Image data
+	SrcOffset core1_0.Offset3D
+	// DstSubresource specifies the Image subresource of the Image objects used for the
+	// destination Image data
+	DstSubresource core1_0.ImageSubresourceLayers
+	// DstOffset selects the initial x, y, and z offsets in texels of the sub-regions of the
+	// destination Image data
+	DstOffset core1_0.Offset3D
+	// Extent is the size in texels of the Image to copy in width, height, and depth
+	Extent core1_0.Extent3D
+
+	common.NextOptions
+}
+
+func (o ImageCopy2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkImageCopy2{})))
+	}
+
+	info := (*C.VkImageCopy2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_IMAGE_COPY_2
+	info.pNext = next
+	info.srcSubresource.aspectMask = C.VkImageAspectFlags(o.SrcSubresource.AspectMask)
+	info.srcSubresource.mipLevel = C.uint32_t(o.SrcSubresource.MipLevel)
+	info.srcSubresource.baseArrayLayer = C.uint32_t(o.SrcSubresource.BaseArrayLayer)
+	info.srcSubresource.layerCount = C.uint32_t(o.SrcSubresource.LayerCount)
+	info.srcOffset.x = C.int32_t(o.SrcOffset.X)
+	info.srcOffset.y = C.int32_t(o.SrcOffset.Y)
+	info.srcOffset.z = C.int32_t(o.SrcOffset.Z)
+
+	info.dstSubresource.aspectMask = C.VkImageAspectFlags(o.DstSubresource.AspectMask)
+	info.dstSubresource.mipLevel = C.uint32_t(o.DstSubresource.MipLevel)
+	info.dstSubresource.baseArrayLayer = C.uint32_t(o.DstSubresource.BaseArrayLayer)
+	info.dstSubresource.layerCount = C.uint32_t(o.DstSubresource.LayerCount)
+	info.dstOffset.x = C.int32_t(o.DstOffset.X)
+	info.dstOffset.y = C.int32_t(o.DstOffset.Y)
+	info.dstOffset.z = C.int32_t(o.DstOffset.Z)
+
+	info.extent.width = C.uint32_t(o.Extent.Width)
+	info.extent.height = C.uint32_t(o.Extent.Height)
+	info.extent.depth = C.uint32_t(o.Extent.Depth)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// BufferImageCopy2 specifies a buffer image copy operation via CommandBuffer.CmdCopyBufferToImage2
+// or CommandBuffer.CmdCopyImageToBuffer2
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkBufferImageCopy2.html
+type BufferImageCopy2 struct {
+	// BufferOffset is the offset in bytes from the start of the Buffer
+	BufferOffset int
+	// BufferRowLength is the size in texels of the rows of the image stored in the Buffer.
+	// 0 indicates that the ImageExtent controls this value
+	BufferRowLength int
+	// BufferImageHeight is the height in texels of the image stored in the Buffer
+	// 0 indicates that the ImageExtent controls this value
+	BufferImageHeight int
+
+	// ImageSubresource is used to specify the specific image subresources of the Image
+	ImageSubresource core1_0.ImageSubresourceLayers
+	// ImageOffset selects the initial x, y, and z offset in texels of the Image subregion
+	ImageOffset core1_0.Offset3D
+	// ImageExtent is the size in texels of the Image subregion
+	ImageExtent core1_0.Extent3D
+
+	common.NextOptions
+}
+
+func (o BufferImageCopy2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.BufferImageHeight < 0 {
+		return nil, errors.New("provided BufferImageHeight of <0")
+	}
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkBufferImageCopy2{})))
+	}
+
+	info := (*C.VkBufferImageCopy2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_BUFFER_IMAGE_COPY_2
+	info.pNext = next
+	info.bufferOffset = C.VkDeviceSize(o.BufferOffset)
+	info.bufferRowLength = C.uint32_t(o.BufferRowLength)
+	info.bufferImageHeight = C.uint32_t(o.BufferImageHeight)
+
+	info.imageSubresource.aspectMask = C.VkImageAspectFlags(o.ImageSubresource.AspectMask)
+	info.imageSubresource.mipLevel = C.uint32_t(o.ImageSubresource.MipLevel)
+	info.imageSubresource.baseArrayLayer = C.uint32_t(o.ImageSubresource.BaseArrayLayer)
+	info.imageSubresource.layerCount = C.uint32_t(o.ImageSubresource.LayerCount)
+	info.imageOffset.x = C.int32_t(o.ImageOffset.X)
+	info.imageOffset.y = C.int32_t(o.ImageOffset.Y)
+	info.imageOffset.z = C.int32_t(o.ImageOffset.Z)
+	info.imageExtent.width = C.uint32_t(o.ImageExtent.Width)
+	info.imageExtent.height = C.uint32_t(o.ImageExtent.Height)
+	info.imageExtent.depth = C.uint32_t(o.ImageExtent.Depth)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// ImageBlit2 specifies an Image blit operation via CommandBuffer.CmdBlitImage2
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkImageBlit2.html
+type ImageBlit2 struct {
+	// SrcSubresource is the subresource to blit from
+	SrcSubresource core1_0.ImageSubresourceLayers
+	// SrcOffsets is a slice of Offset3D structures specifying the bounds of the source region
+	// within the source subresource
+	SrcOffsets [2]core1_0.Offset3D
+
+	// DstSubresource is the subresource to blit to
+	DstSubresource core1_0.ImageSubresourceLayers
+	// DstOffsets is a slice of Offset3D structures specifying the bounds of the destination region
+	// within the destination subresource
+	DstOffsets [2]core1_0.Offset3D
+
+	common.NextOptions
+}
+
+func (o ImageBlit2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkImageBlit2{})))
+	}
+
+	info := (*C.VkImageBlit2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_IMAGE_BLIT_2
+	info.pNext = next
+	info.srcSubresource.aspectMask = C.VkImageAspectFlags(o.SrcSubresource.AspectMask)
+	info.srcSubresource.mipLevel = C.uint32_t(o.SrcSubresource.MipLevel)
+	info.srcSubresource.baseArrayLayer = C.uint32_t(o.SrcSubresource.BaseArrayLayer)
+	info.srcSubresource.layerCount = C.uint32_t(o.SrcSubresource.LayerCount)
+	info.srcOffsets[0].x = C.int32_t(o.SrcOffsets[0].X)
+	info.srcOffsets[0].y = C.int32_t(o.SrcOffsets[0].Y)
+	info.srcOffsets[0].z = C.int32_t(o.SrcOffsets[0].Z)
+	info.srcOffsets[1].x = C.int32_t(o.SrcOffsets[1].X)
+	info.srcOffsets[1].y = C.int32_t(o.SrcOffsets[1].Y)
+	info.srcOffsets[1].z = C.int32_t(o.SrcOffsets[1].Z)
+
+	info.dstSubresource.aspectMask = C.VkImageAspectFlags(o.DstSubresource.AspectMask)
+	info.dstSubresource.mipLevel = C.uint32_t(o.DstSubresource.MipLevel)
+	info.dstSubresource.baseArrayLayer = C.uint32_t(o.DstSubresource.BaseArrayLayer)
+	info.dstSubresource.layerCount = C.uint32_t(o.DstSubresource.LayerCount)
+	info.dstOffsets[0].x = C.int32_t(o.DstOffsets[0].X)
+	info.dstOffsets[0].y = C.int32_t(o.DstOffsets[0].Y)
+	info.dstOffsets[0].z = C.int32_t(o.DstOffsets[0].Z)
+	info.dstOffsets[1].x = C.int32_t(o.DstOffsets[1].X)
+	info.dstOffsets[1].y = C.int32_t(o.DstOffsets[1].Y)
+	info.dstOffsets[1].z = C.int32_t(o.DstOffsets[1].Z)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// ImageResolve2 specifies an Image resolve operation via CommandBuffer.CmdResolveImage2
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkImageResolve2.html
+type ImageResolve2 struct {
+	// SrcSubresource specifies the Image subresources of the Image objects used for the source
+	// Image data
+	SrcSubresource core1_0.ImageSubresourceLayers
+	// SrcOffset selects the initial x, y, and z offsets in texels of the sub-regions of the
+	// source Image data
+	SrcOffset core1_0.Offset3D
+	// DstSubresource specifies the Image subresources of the Image objects used for the
+	// destination Image data
+	DstSubresource core1_0.ImageSubresourceLayers
+	// DstOffset selects the initial x, y, and z offsets in texels of the sub-regions of the
+	// destination Image data
+	DstOffset core1_0.Offset3D
+	// Extent is the size in texels of the source Image to resolve in width, height, and depth
+	Extent core1_0.Extent3D
+
+	common.NextOptions
+}
+
+func (o ImageResolve2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkImageResolve2{})))
+	}
+
+	info := (*C.VkImageResolve2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_IMAGE_RESOLVE_2
+	info.pNext = next
+	info.srcSubresource.aspectMask = C.VkImageAspectFlags(o.SrcSubresource.AspectMask)
+	info.srcSubresource.mipLevel = C.uint32_t(o.SrcSubresource.MipLevel)
+	info.srcSubresource.baseArrayLayer = C.uint32_t(o.SrcSubresource.BaseArrayLayer)
+	info.srcSubresource.layerCount = C.uint32_t(o.SrcSubresource.LayerCount)
+	info.srcOffset.x = C.int32_t(o.SrcOffset.X)
+	info.srcOffset.y = C.int32_t(o.SrcOffset.Y)
+	info.srcOffset.z = C.int32_t(o.SrcOffset.Z)
+
+	info.dstSubresource.aspectMask = C.VkImageAspectFlags(o.DstSubresource.AspectMask)
+	info.dstSubresource.mipLevel = C.uint32_t(o.DstSubresource.MipLevel)
+	info.dstSubresource.baseArrayLayer = C.uint32_t(o.DstSubresource.BaseArrayLayer)
+	info.dstSubresource.layerCount = C.uint32_t(o.DstSubresource.LayerCount)
+	info.dstOffset.x = C.int32_t(o.DstOffset.X)
+	info.dstOffset.y = C.int32_t(o.DstOffset.Y)
+	info.dstOffset.z = C.int32_t(o.DstOffset.Z)
+
+	info.extent.width = C.uint32_t(o.Extent.Width)
+	info.extent.height = C.uint32_t(o.Extent.Height)
+	info.extent.depth = C.uint32_t(o.Extent.Depth)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// CopyBufferInfo2 specifies parameters of a buffer copy command via CommandBuffer.CmdCopyBuffer2
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkCopyBufferInfo2.html
+type CopyBufferInfo2 struct {
+	// SrcBuffer is the source Buffer
+	SrcBuffer core1_0.Buffer
+	// DstBuffer is the destination Buffer
+	DstBuffer core1_0.Buffer
+	// Regions is a slice of BufferCopy2 structures specifying the regions to copy
+	Regions []BufferCopy2
+
+	common.NextOptions
+}
+
+func (o CopyBufferInfo2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.SrcBuffer == nil {
+		return nil, errors.New("core1_3.CopyBufferInfo2.SrcBuffer cannot be nil")
+	}
+	if o.DstBuffer == nil {
+		return nil, errors.New("core1_3.CopyBufferInfo2.DstBuffer cannot be nil")
+	}
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkCopyBufferInfo2{})))
+	}
+
+	info := (*C.VkCopyBufferInfo2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_COPY_BUFFER_INFO_2
+	info.pNext = next
+	info.srcBuffer = C.VkBuffer(unsafe.Pointer(o.SrcBuffer.Handle()))
+	info.dstBuffer = C.VkBuffer(unsafe.Pointer(o.DstBuffer.Handle()))
+
+	regionCount := len(o.Regions)
+	info.regionCount = C.uint32_t(regionCount)
+	info.pRegions = nil
+
+	if regionCount > 0 {
+		var err error
+		info.pRegions, err = common.AllocOptionSlice[C.VkBufferCopy2, BufferCopy2](allocator, o.Regions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// CopyImageInfo2 specifies parameters of an Image copy command via CommandBuffer.CmdCopyImage2
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkCopyImageInfo2.html
+type CopyImageInfo2 struct {
+	// SrcImage is the source Image
+	SrcImage core1_0.Image
+	// SrcImageLayout is the current layout of the source Image subresource
+	SrcImageLayout core1_0.ImageLayout
+	// DstImage is the destination Image
+	DstImage core1_0.Image
+	// DstImageLayout is the current layout of the destination Image subresource
+	DstImageLayout core1_0.ImageLayout
+	// Regions is a slice of ImageCopy2 structures specifying the regions to copy
+	Regions []ImageCopy2
+
+	common.NextOptions
+}
+
+func (o CopyImageInfo2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.SrcImage == nil {
+		return nil, errors.New("core1_3.CopyImageInfo2.SrcImage cannot be nil")
+	}
+	if o.DstImage == nil {
+		return nil, errors.New("core1_3.CopyImageInfo2.DstImage cannot be nil")
+	}
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkCopyImageInfo2{})))
+	}
+
+	info := (*C.VkCopyImageInfo2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_COPY_IMAGE_INFO_2
+	info.pNext = next
+	info.srcImage = C.VkImage(unsafe.Pointer(o.SrcImage.Handle()))
+	info.srcImageLayout = C.VkImageLayout(o.SrcImageLayout)
+	info.dstImage = C.VkImage(unsafe.Pointer(o.DstImage.Handle()))
+	info.dstImageLayout = C.VkImageLayout(o.DstImageLayout)
+
+	regionCount := len(o.Regions)
+	info.regionCount = C.uint32_t(regionCount)
+	info.pRegions = nil
+
+	if regionCount > 0 {
+		var err error
+		info.pRegions, err = common.AllocOptionSlice[C.VkImageCopy2, ImageCopy2](allocator, o.Regions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// CopyBufferToImageInfo2 specifies parameters of a buffer to Image copy command via
+// CommandBuffer.CmdCopyBufferToImage2
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkCopyBufferToImageInfo2.html
+type CopyBufferToImageInfo2 struct {
+	// SrcBuffer is the source Buffer
+	SrcBuffer core1_0.Buffer
+	// DstImage is the destination Image
+	DstImage core1_0.Image
+	// DstImageLayout is the current layout of the destination Image subresource
+	DstImageLayout core1_0.ImageLayout
+	// Regions is a slice of BufferImageCopy2 structures specifying the regions to copy
+	Regions []BufferImageCopy2
+
+	common.NextOptions
+}
+
+func (o CopyBufferToImageInfo2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.SrcBuffer == nil {
+		return nil, errors.New("core1_3.CopyBufferToImageInfo2.SrcBuffer cannot be nil")
+	}
+	if o.DstImage == nil {
+		return nil, errors.New("core1_3.CopyBufferToImageInfo2.DstImage cannot be nil")
+	}
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkCopyBufferToImageInfo2{})))
+	}
+
+	info := (*C.VkCopyBufferToImageInfo2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_COPY_BUFFER_TO_IMAGE_INFO_2
+	info.pNext = next
+	info.srcBuffer = C.VkBuffer(unsafe.Pointer(o.SrcBuffer.Handle()))
+	info.dstImage = C.VkImage(unsafe.Pointer(o.DstImage.Handle()))
+	info.dstImageLayout = C.VkImageLayout(o.DstImageLayout)
+
+	regionCount := len(o.Regions)
+	info.regionCount = C.uint32_t(regionCount)
+	info.pRegions = nil
+
+	if regionCount > 0 {
+		var err error
+		info.pRegions, err = common.AllocOptionSlice[C.VkBufferImageCopy2, BufferImageCopy2](allocator, o.Regions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// CopyImageToBufferInfo2 specifies parameters of an Image to buffer copy command via
+// CommandBuffer.CmdCopyImageToBuffer2
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkCopyImageToBufferInfo2.html
+type CopyImageToBufferInfo2 struct {
+	// SrcImage is the source Image
+	SrcImage core1_0.Image
+	// SrcImageLayout is the current layout of the source Image subresource
+	SrcImageLayout core1_0.ImageLayout
+	// DstBuffer is the destination Buffer
+	DstBuffer core1_0.Buffer
+	// Regions is a slice of BufferImageCopy2 structures specifying the regions to copy
+	Regions []BufferImageCopy2
+
+	common.NextOptions
+}
+
+func (o CopyImageToBufferInfo2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.SrcImage == nil {
+		return nil, errors.New("core1_3.CopyImageToBufferInfo2.SrcImage cannot be nil")
+	}
+	if o.DstBuffer == nil {
+		return nil, errors.New("core1_3.CopyImageToBufferInfo2.DstBuffer cannot be nil")
+	}
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkCopyImageToBufferInfo2{})))
+	}
+
+	info := (*C.VkCopyImageToBufferInfo2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_COPY_IMAGE_TO_BUFFER_INFO_2
+	info.pNext = next
+	info.srcImage = C.VkImage(unsafe.Pointer(o.SrcImage.Handle()))
+	info.srcImageLayout = C.VkImageLayout(o.SrcImageLayout)
+	info.dstBuffer = C.VkBuffer(unsafe.Pointer(o.DstBuffer.Handle()))
+
+	regionCount := len(o.Regions)
+	info.regionCount = C.uint32_t(regionCount)
+	info.pRegions = nil
+
+	if regionCount > 0 {
+		var err error
+		info.pRegions, err = common.AllocOptionSlice[C.VkBufferImageCopy2, BufferImageCopy2](allocator, o.Regions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// BlitImageInfo2 specifies parameters of a blit command via CommandBuffer.CmdBlitImage2
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkBlitImageInfo2.html
+type BlitImageInfo2 struct {
+	// SrcImage is the source Image
+	SrcImage core1_0.Image
+	// SrcImageLayout is the current layout of the source Image subresource
+	SrcImageLayout core1_0.ImageLayout
+	// DstImage is the destination Image
+	DstImage core1_0.Image
+	// DstImageLayout is the current layout of the destination Image subresource
+	DstImageLayout core1_0.ImageLayout
+	// Regions is a slice of ImageBlit2 structures specifying the regions to blit
+	Regions []ImageBlit2
+	// Filter specifies the filter to apply if the blits require scaling
+	Filter core1_0.Filter
+
+	common.NextOptions
+}
+
+func (o BlitImageInfo2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.SrcImage == nil {
+		return nil, errors.New("core1_3.BlitImageInfo2.SrcImage cannot be nil")
+	}
+	if o.DstImage == nil {
+		return nil, errors.New("core1_3.BlitImageInfo2.DstImage cannot be nil")
+	}
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkBlitImageInfo2{})))
+	}
+
+	info := (*C.VkBlitImageInfo2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_BLIT_IMAGE_INFO_2
+	info.pNext = next
+	info.srcImage = C.VkImage(unsafe.Pointer(o.SrcImage.Handle()))
+	info.srcImageLayout = C.VkImageLayout(o.SrcImageLayout)
+	info.dstImage = C.VkImage(unsafe.Pointer(o.DstImage.Handle()))
+	info.dstImageLayout = C.VkImageLayout(o.DstImageLayout)
+	info.filter = C.VkFilter(o.Filter)
+
+	regionCount := len(o.Regions)
+	info.regionCount = C.uint32_t(regionCount)
+	info.pRegions = nil
+
+	if regionCount > 0 {
+		var err error
+		info.pRegions, err = common.AllocOptionSlice[C.VkImageBlit2, ImageBlit2](allocator, o.Regions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// ResolveImageInfo2 specifies parameters of a resolve command via CommandBuffer.CmdResolveImage2
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkResolveImageInfo2.html
+type ResolveImageInfo2 struct {
+	// SrcImage is the source Image
+	SrcImage core1_0.Image
+	// SrcImageLayout is the current layout of the source Image subresource
+	SrcImageLayout core1_0.ImageLayout
+	// DstImage is the destination Image
+	DstImage core1_0.Image
+	// DstImageLayout is the current layout of the destination Image subresource
+	DstImageLayout core1_0.ImageLayout
+	// Regions is a slice of ImageResolve2 structures specifying the regions to resolve
+	Regions []ImageResolve2
+
+	common.NextOptions
+}
+
+func (o ResolveImageInfo2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.SrcImage == nil {
+		return nil, errors.New("core1_3.ResolveImageInfo2.SrcImage cannot be nil")
+	}
+	if o.DstImage == nil {
+		return nil, errors.New("core1_3.ResolveImageInfo2.DstImage cannot be nil")
+	}
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkResolveImageInfo2{})))
+	}
+
+	info := (*C.VkResolveImageInfo2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_RESOLVE_IMAGE_INFO_2
+	info.pNext = next
+	info.srcImage = C.VkImage(unsafe.Pointer(o.SrcImage.Handle()))
+	info.srcImageLayout = C.VkImageLayout(o.SrcImageLayout)
+	info.dstImage = C.VkImage(unsafe.Pointer(o.DstImage.Handle()))
+	info.dstImageLayout = C.VkImageLayout(o.DstImageLayout)
+
+	regionCount := len(o.Regions)
+	info.regionCount = C.uint32_t(regionCount)
+	info.pRegions = nil
+
+	if regionCount > 0 {
+		var err error
+		info.pRegions, err = common.AllocOptionSlice[C.VkImageResolve2, ImageResolve2](allocator, o.Regions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return preallocatedPointer, nil
+}
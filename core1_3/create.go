@@ -0,0 +1,18 @@
+package core1_3
+
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/driver"
+)
+
+func CreatePrivateDataSlot(coreDriver driver.Driver, device driver.VkDevice, handle driver.VkPrivateDataSlot, version common.APIVersion) *VulkanPrivateDataSlot {
+	return coreDriver.ObjectStore().GetOrCreate(driver.VulkanHandle(handle), driver.Core1_3,
+		func() any {
+			return &VulkanPrivateDataSlot{
+				DeviceDriver:          coreDriver,
+				Device:                device,
+				PrivateDataSlotHandle: handle,
+				MaximumAPIVersion:     version,
+			}
+		}).(*VulkanPrivateDataSlot)
+}
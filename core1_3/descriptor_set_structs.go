@@ -0,0 +1,88 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"unsafe"
+)
+
+const (
+	// DescriptorTypeInlineUniformBlock specifies an inline uniform block, whose data is stored
+	// directly in the DescriptorSet rather than in a Buffer
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDescriptorType.html
+	DescriptorTypeInlineUniformBlock core1_0.DescriptorType = C.VK_DESCRIPTOR_TYPE_INLINE_UNIFORM_BLOCK
+)
+
+func init() {
+	DescriptorTypeInlineUniformBlock.Register("Inline Uniform Block")
+}
+
+////
+
+// WriteDescriptorSetInlineUniformBlock specifies the data to write to an inline uniform block
+// descriptor. Add it to the option chain of a core1_0.WriteDescriptorSet whose DescriptorType is
+// DescriptorTypeInlineUniformBlock, and leave ImageInfo, BufferInfo, and TexelBufferView empty. The
+// WriteDescriptorSet's DstArrayElement is the byte offset into the block to begin writing at.
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkWriteDescriptorSetInlineUniformBlock.html
+type WriteDescriptorSetInlineUniformBlock struct {
+	// Data is the data to write to the inline uniform block. Its length must be a multiple of 4
+	Data []byte
+
+	common.NextOptions
+}
+
+func (o WriteDescriptorSetInlineUniformBlock) WriteDescriptorSetCount() int {
+	return len(o.Data)
+}
+
+func (o WriteDescriptorSetInlineUniformBlock) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkWriteDescriptorSetInlineUniformBlock{})))
+	}
+
+	info := (*C.VkWriteDescriptorSetInlineUniformBlock)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_INLINE_UNIFORM_BLOCK
+	info.pNext = next
+	info.dataSize = C.uint32_t(len(o.Data))
+	info.pData = nil
+
+	if len(o.Data) > 0 {
+		info.pData = allocator.CBytes(o.Data)
+	}
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// DescriptorPoolInlineUniformBlockCreateInfo specifies the maximum number of inline uniform
+// block bindings of a newly-created DescriptorPool
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDescriptorPoolInlineUniformBlockCreateInfo.html
+type DescriptorPoolInlineUniformBlockCreateInfo struct {
+	// MaxInlineUniformBlockBindings is the number of inline uniform block bindings to allocate
+	MaxInlineUniformBlockBindings int
+
+	common.NextOptions
+}
+
+func (o DescriptorPoolInlineUniformBlockCreateInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkDescriptorPoolInlineUniformBlockCreateInfo{})))
+	}
+
+	info := (*C.VkDescriptorPoolInlineUniformBlockCreateInfo)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_DESCRIPTOR_POOL_INLINE_UNIFORM_BLOCK_CREATE_INFO
+	info.pNext = next
+	info.maxInlineUniformBlockBindings = C.uint32_t(o.MaxInlineUniformBlockBindings)
+
+	return preallocatedPointer, nil
+}
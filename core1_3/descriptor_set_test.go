@@ -0,0 +1,151 @@
+package core1_3_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"github.com/vkngwrapper/core/v2/driver"
+	mock_driver "github.com/vkngwrapper/core/v2/driver/mocks"
+	"github.com/vkngwrapper/core/v2/internal/dummies"
+	"github.com/vkngwrapper/core/v2/mocks"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+func TestWriteDescriptorSetInlineUniformBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := dummies.EasyDummyDevice(coreDriver)
+	descriptorSet := mocks.EasyMockDescriptorSet(ctrl)
+
+	coreDriver.EXPECT().VkUpdateDescriptorSets(
+		device.Handle(),
+		driver.Uint32(1),
+		gomock.Not(gomock.Nil()),
+		driver.Uint32(0),
+		gomock.Nil(),
+	).DoAndReturn(func(device driver.VkDevice, descriptorWriteCount driver.Uint32, pDescriptorWrites *driver.VkWriteDescriptorSet, descriptorCopyCount driver.Uint32, pDescriptorCopies *driver.VkCopyDescriptorSet) {
+		val := reflect.ValueOf(pDescriptorWrites).Elem()
+		require.Equal(t, uint64(35), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET
+		require.Equal(t, descriptorSet.Handle(), driver.VkDescriptorSet(val.FieldByName("dstSet").UnsafePointer()))
+		require.Equal(t, uint64(3), val.FieldByName("dstBinding").Uint())
+		require.Equal(t, uint64(16), val.FieldByName("dstArrayElement").Uint())
+		require.Equal(t, uint64(1000138000), val.FieldByName("descriptorType").Uint()) // VK_DESCRIPTOR_TYPE_INLINE_UNIFORM_BLOCK
+		require.Equal(t, uint64(8), val.FieldByName("descriptorCount").Uint())
+		require.True(t, val.FieldByName("pImageInfo").IsNil())
+		require.True(t, val.FieldByName("pBufferInfo").IsNil())
+		require.True(t, val.FieldByName("pTexelBufferView").IsNil())
+
+		next := (*driver.VkWriteDescriptorSetInlineUniformBlock)(val.FieldByName("pNext").UnsafePointer())
+		val = reflect.ValueOf(next).Elem()
+		require.Equal(t, uint64(1000138002), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_INLINE_UNIFORM_BLOCK
+		require.True(t, val.FieldByName("pNext").IsNil())
+		require.Equal(t, uint64(8), val.FieldByName("dataSize").Uint())
+
+		data := unsafe.Slice((*byte)(val.FieldByName("pData").UnsafePointer()), 8)
+		require.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8}, data)
+	})
+
+	err := device.UpdateDescriptorSets([]core1_0.WriteDescriptorSet{
+		{
+			DstSet:          descriptorSet,
+			DstBinding:      3,
+			DstArrayElement: 16,
+			DescriptorType:  core1_3.DescriptorTypeInlineUniformBlock,
+
+			NextOptions: common.NextOptions{Next: core1_3.WriteDescriptorSetInlineUniformBlock{
+				Data: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			}},
+		},
+	}, nil)
+	require.NoError(t, err)
+}
+
+func TestDescriptorPoolInlineUniformBlockCreateInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := dummies.EasyDummyDevice(coreDriver)
+	expectedPool := mocks.EasyMockDescriptorPool(ctrl, device)
+
+	coreDriver.EXPECT().VkCreateDescriptorPool(
+		device.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Nil(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(device driver.VkDevice, pCreateInfo *driver.VkDescriptorPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDescriptorPool *driver.VkDescriptorPool) (common.VkResult, error) {
+		*pDescriptorPool = expectedPool.Handle()
+
+		val := reflect.ValueOf(pCreateInfo).Elem()
+		require.Equal(t, uint64(33), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_DESCRIPTOR_POOL_CREATE_INFO
+		require.Equal(t, uint64(1), val.FieldByName("poolSizeCount").Uint())
+
+		poolSize := val.FieldByName("pPoolSizes").Elem()
+		require.Equal(t, uint64(1000138000), poolSize.FieldByName("_type").Uint()) // VK_DESCRIPTOR_TYPE_INLINE_UNIFORM_BLOCK
+		require.Equal(t, uint64(256), poolSize.FieldByName("descriptorCount").Uint())
+
+		next := (*driver.VkDescriptorPoolInlineUniformBlockCreateInfo)(val.FieldByName("pNext").UnsafePointer())
+		val = reflect.ValueOf(next).Elem()
+		require.Equal(t, uint64(1000138003), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_DESCRIPTOR_POOL_INLINE_UNIFORM_BLOCK_CREATE_INFO
+		require.True(t, val.FieldByName("pNext").IsNil())
+		require.Equal(t, uint64(4), val.FieldByName("maxInlineUniformBlockBindings").Uint())
+
+		return core1_0.VKSuccess, nil
+	})
+
+	pool, _, err := device.CreateDescriptorPool(nil, core1_0.DescriptorPoolCreateInfo{
+		MaxSets: 4,
+		PoolSizes: []core1_0.DescriptorPoolSize{
+			{
+				Type:            core1_3.DescriptorTypeInlineUniformBlock,
+				DescriptorCount: 256,
+			},
+		},
+		NextOptions: common.NextOptions{Next: core1_3.DescriptorPoolInlineUniformBlockCreateInfo{
+			MaxInlineUniformBlockBindings: 4,
+		}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPool.Handle(), pool.Handle())
+}
+
+func TestPhysicalDeviceInlineUniformBlockFeaturesOutData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	instance := mocks.EasyMockInstance(ctrl, coreDriver)
+	physicalDevice := core1_3.PromoteInstanceScopedPhysicalDevice(dummies.EasyDummyPhysicalDevice(coreDriver, instance))
+
+	coreDriver.EXPECT().VkGetPhysicalDeviceFeatures2(
+		physicalDevice.Handle(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(physicalDevice driver.VkPhysicalDevice, pFeatures *driver.VkPhysicalDeviceFeatures2) {
+		val := reflect.ValueOf(pFeatures).Elem()
+		require.Equal(t, uint64(1000059000), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2
+
+		outData := (*driver.VkPhysicalDeviceInlineUniformBlockFeatures)(val.FieldByName("pNext").UnsafePointer())
+		val = reflect.ValueOf(outData).Elem()
+		require.Equal(t, uint64(1000138000), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_FEATURES
+		require.True(t, val.FieldByName("pNext").IsNil())
+
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("inlineUniformBlock").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("descriptorBindingInlineUniformBlockUpdateAfterBind").UnsafeAddr())) = driver.VkBool32(0)
+	})
+
+	var outData core1_3.PhysicalDeviceInlineUniformBlockFeatures
+	err := physicalDevice.Features2(&core1_1.PhysicalDeviceFeatures2{
+		NextOutData: common.NextOutData{Next: &outData},
+	})
+	require.NoError(t, err)
+	require.Equal(t, core1_3.PhysicalDeviceInlineUniformBlockFeatures{
+		InlineUniformBlock: true,
+	}, outData)
+}
@@ -0,0 +1,230 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/core1_2"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+// VulkanDevice is an implementation of the Device interface that actually communicates with Vulkan. This
+// is the default implementation. See the interface for more documentation.
+type VulkanDevice struct {
+	core1_2.Device
+
+	DeviceDriver      driver.Driver
+	DeviceHandle      driver.VkDevice
+	MaximumAPIVersion common.APIVersion
+}
+
+// PromoteDevice accepts a Device object from any core version. If provided a device that supports
+// at least core 1.3, it will return a core1_3.Device. Otherwise, it will return nil. This method
+// will always return a core1_3.VulkanDevice, even if it is provided a VulkanDevice from a higher
+// core version. Two Vulkan 1.3 compatible Device objects with the same Device.Handle will
+// return the same interface value when passed to this method.
+func PromoteDevice(device core1_0.Device) Device {
+	if device == nil {
+		return nil
+	}
+	if !device.APIVersion().IsAtLeast(common.Vulkan1_3) {
+		return nil
+	}
+
+	return promoteDevice(device)
+}
+
+// PromoteDeviceFromExtensions accepts a Device object from any core version. If provided a device that
+// supports at least core 1.3, it behaves like PromoteDevice. If provided a device that supports core 1.2
+// and enabled VK_KHR_maintenance4, it will also return a core1_3.Device, whose commands are loaded from
+// the extension. Otherwise, it will return nil.
+//
+// Only the commands of the enabled extensions may be called on a Device promoted from core 1.2. Other
+// core 1.3 commands panic with a *common.FunctionError wrapping driver.ErrMissingCommand.
+func PromoteDeviceFromExtensions(device core1_0.Device) Device {
+	if device == nil {
+		return nil
+	}
+	if device.APIVersion().IsAtLeast(common.Vulkan1_3) {
+		return promoteDevice(device)
+	}
+	if !device.APIVersion().IsAtLeast(common.Vulkan1_2) || !anyExtensionActive(device, deviceExtensions) {
+		return nil
+	}
+
+	return promoteDevice(device)
+}
+
+func promoteDevice(device core1_0.Device) Device {
+	promotedDevice := core1_2.PromoteDevice(device)
+
+	return device.Driver().ObjectStore().GetOrCreate(
+		driver.VulkanHandle(device.Handle()),
+		driver.Core1_3,
+		func() any {
+			return &VulkanDevice{
+				Device: promotedDevice,
+
+				DeviceDriver:      device.Driver(),
+				DeviceHandle:      device.Handle(),
+				MaximumAPIVersion: device.APIVersion(),
+			}
+		}).(Device)
+}
+
+func (d *VulkanDevice) DeviceBufferMemoryRequirements(o DeviceBufferMemoryRequirements, out *core1_1.MemoryRequirements2) error {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	optionPtr, err := common.AllocOptions(arena, o)
+	if err != nil {
+		return err
+	}
+
+	outDataPtr, err := common.AllocOutDataHeader(arena, out)
+	if err != nil {
+		return err
+	}
+
+	d.DeviceDriver.VkGetDeviceBufferMemoryRequirements(d.DeviceHandle,
+		(*driver.VkDeviceBufferMemoryRequirements)(optionPtr),
+		(*driver.VkMemoryRequirements2)(outDataPtr),
+	)
+
+	return common.PopulateOutData(out, outDataPtr)
+}
+
+func (d *VulkanDevice) DeviceImageMemoryRequirements(o DeviceImageMemoryRequirements, out *core1_1.MemoryRequirements2) error {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	optionPtr, err := common.AllocOptions(arena, o)
+	if err != nil {
+		return err
+	}
+
+	outDataPtr, err := common.AllocOutDataHeader(arena, out)
+	if err != nil {
+		return err
+	}
+
+	d.DeviceDriver.VkGetDeviceImageMemoryRequirements(d.DeviceHandle,
+		(*driver.VkDeviceImageMemoryRequirements)(optionPtr),
+		(*driver.VkMemoryRequirements2)(outDataPtr),
+	)
+
+	return common.PopulateOutData(out, outDataPtr)
+}
+
+func (d *VulkanDevice) DeviceImageSparseMemoryRequirements(o DeviceImageMemoryRequirements, outDataFactory func() *core1_1.SparseImageMemoryRequirements2) ([]*core1_1.SparseImageMemoryRequirements2, error) {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	optionPtr, err := common.AllocOptions(arena, o)
+	if err != nil {
+		return nil, err
+	}
+
+	requirementCountPtr := (*driver.Uint32)(arena.Malloc(int(unsafe.Sizeof(C.uint32_t(0)))))
+
+	d.DeviceDriver.VkGetDeviceImageSparseMemoryRequirements(d.DeviceHandle,
+		(*driver.VkDeviceImageMemoryRequirements)(optionPtr),
+		requirementCountPtr,
+		nil,
+	)
+
+	count := int(*requirementCountPtr)
+	if count == 0 {
+		return nil, nil
+	}
+
+	outDataSlice := make([]*core1_1.SparseImageMemoryRequirements2, count)
+	for i := 0; i < count; i++ {
+		if outDataFactory != nil {
+			outDataSlice[i] = outDataFactory()
+		} else {
+			outDataSlice[i] = &core1_1.SparseImageMemoryRequirements2{}
+		}
+	}
+
+	outDataPtr, err := common.AllocOutDataHeaderSlice[C.VkSparseImageMemoryRequirements2, *core1_1.SparseImageMemoryRequirements2](arena, outDataSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	castOutDataPtr := (*C.VkSparseImageMemoryRequirements2)(outDataPtr)
+
+	d.DeviceDriver.VkGetDeviceImageSparseMemoryRequirements(d.DeviceHandle,
+		(*driver.VkDeviceImageMemoryRequirements)(optionPtr),
+		requirementCountPtr,
+		(*driver.VkSparseImageMemoryRequirements2)(unsafe.Pointer(castOutDataPtr)),
+	)
+
+	err = common.PopulateOutDataSlice[C.VkSparseImageMemoryRequirements2, *core1_1.SparseImageMemoryRequirements2](outDataSlice, unsafe.Pointer(outDataPtr))
+	if err != nil {
+		return nil, err
+	}
+
+	return outDataSlice, nil
+}
+
+func (d *VulkanDevice) CreatePrivateDataSlot(o PrivateDataSlotCreateInfo, allocator *driver.AllocationCallbacks) (PrivateDataSlot, common.VkResult, error) {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	optionPtr, err := common.AllocOptions(arena, o)
+	if err != nil {
+		return nil, core1_0.VKErrorUnknown, err
+	}
+
+	var slotHandle driver.VkPrivateDataSlot
+	res, err := d.DeviceDriver.VkCreatePrivateDataSlot(
+		d.DeviceHandle,
+		(*driver.VkPrivateDataSlotCreateInfo)(optionPtr),
+		allocator.Handle(),
+		&slotHandle,
+	)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return CreatePrivateDataSlot(d.DeviceDriver, d.DeviceHandle, slotHandle, d.MaximumAPIVersion), res, nil
+}
+
+func (d *VulkanDevice) SetPrivateData(objectType core1_0.ObjectType, objectHandle driver.VulkanHandle, privateDataSlot PrivateDataSlot, data uint64) (common.VkResult, error) {
+	if privateDataSlot == nil {
+		return core1_0.VKErrorUnknown, common.NilArgumentError("SetPrivateData", "privateDataSlot")
+	}
+
+	return d.DeviceDriver.VkSetPrivateData(
+		d.DeviceHandle,
+		driver.VkObjectType(objectType),
+		driver.Uint64(objectHandle),
+		privateDataSlot.Handle(),
+		driver.Uint64(data),
+	)
+}
+
+func (d *VulkanDevice) GetPrivateData(objectType core1_0.ObjectType, objectHandle driver.VulkanHandle, privateDataSlot PrivateDataSlot) uint64 {
+	if privateDataSlot == nil {
+		panic(common.NilArgumentError("GetPrivateData", "privateDataSlot"))
+	}
+
+	var data driver.Uint64
+	d.DeviceDriver.VkGetPrivateData(
+		d.DeviceHandle,
+		driver.VkObjectType(objectType),
+		driver.Uint64(objectHandle),
+		privateDataSlot.Handle(),
+		&data,
+	)
+
+	return uint64(data)
+}
@@ -0,0 +1,77 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"unsafe"
+)
+
+// DeviceBufferMemoryRequirements describes the parameters of a Buffer whose memory requirements
+// are queried without creating it
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDeviceBufferMemoryRequirements.html
+type DeviceBufferMemoryRequirements struct {
+	// CreateInfo describes the Buffer that would be created
+	CreateInfo core1_0.BufferCreateInfo
+
+	common.NextOptions
+}
+
+func (o DeviceBufferMemoryRequirements) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkDeviceBufferMemoryRequirements{})))
+	}
+
+	createInfo, err := common.AllocOptions(allocator, o.CreateInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	info := (*C.VkDeviceBufferMemoryRequirements)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_DEVICE_BUFFER_MEMORY_REQUIREMENTS
+	info.pNext = next
+	info.pCreateInfo = (*C.VkBufferCreateInfo)(createInfo)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// DeviceImageMemoryRequirements describes the parameters of an Image whose memory requirements
+// are queried without creating it
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDeviceImageMemoryRequirements.html
+type DeviceImageMemoryRequirements struct {
+	// CreateInfo describes the Image that would be created
+	CreateInfo core1_0.ImageCreateInfo
+	// PlaneAspect selects the plane whose requirements are queried, when CreateInfo describes
+	// a disjoint multi-planar Image
+	PlaneAspect core1_0.ImageAspectFlags
+
+	common.NextOptions
+}
+
+func (o DeviceImageMemoryRequirements) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkDeviceImageMemoryRequirements{})))
+	}
+
+	createInfo, err := common.AllocOptions(allocator, o.CreateInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	info := (*C.VkDeviceImageMemoryRequirements)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_DEVICE_IMAGE_MEMORY_REQUIREMENTS
+	info.pNext = next
+	info.pCreateInfo = (*C.VkImageCreateInfo)(createInfo)
+	info.planeAspect = C.VkImageAspectFlagBits(o.PlaneAspect)
+
+	return preallocatedPointer, nil
+}
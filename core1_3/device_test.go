@@ -0,0 +1,175 @@
+package core1_3_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/core1_2"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"github.com/vkngwrapper/core/v2/driver"
+	mock_driver "github.com/vkngwrapper/core/v2/driver/mocks"
+	"github.com/vkngwrapper/core/v2/internal/dummies"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+func TestPromoteDevice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	baseDevice := dummies.EasyDummyDevice(coreDriver)
+
+	device := core1_3.PromoteDevice(baseDevice)
+	require.NotNil(t, device)
+	require.Equal(t, baseDevice.Handle(), device.Handle())
+	require.Equal(t, common.Vulkan1_3, device.APIVersion())
+	require.Same(t, device, core1_3.PromoteDevice(baseDevice))
+	require.Same(t, core1_2.PromoteDevice(baseDevice), device.(*core1_3.VulkanDevice).Device)
+}
+
+func TestPromoteInstance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	baseInstance := dummies.EasyDummyInstance(coreDriver)
+
+	instance := core1_3.PromoteInstance(baseInstance)
+	require.NotNil(t, instance)
+	require.Equal(t, baseInstance.Handle(), instance.Handle())
+	require.Same(t, instance, core1_3.PromoteInstance(baseInstance))
+}
+
+func TestPromoteDeviceFromExtensions_Maintenance4(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_2)
+	baseDevice := extensionDevice(ctrl, coreDriver, "VK_KHR_maintenance4")
+
+	require.Nil(t, core1_3.PromoteDevice(baseDevice))
+	device := core1_3.PromoteDeviceFromExtensions(baseDevice)
+	require.NotNil(t, device)
+	require.Equal(t, common.Vulkan1_2, device.APIVersion())
+	require.Same(t, device, core1_3.PromoteDeviceFromExtensions(baseDevice))
+
+	coreDriver.EXPECT().VkGetDeviceBufferMemoryRequirements(
+		device.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Not(gomock.Nil()),
+	)
+
+	var outData core1_1.MemoryRequirements2
+	err := device.DeviceBufferMemoryRequirements(core1_3.DeviceBufferMemoryRequirements{
+		CreateInfo: core1_0.BufferCreateInfo{Size: 256, Usage: core1_0.BufferUsageVertexBuffer},
+	}, &outData)
+	require.NoError(t, err)
+
+	require.Nil(t, core1_3.PromoteDeviceFromExtensions(extensionDevice(ctrl, coreDriver, "VK_KHR_synchronization2")))
+}
+
+func TestVulkanDevice_DeviceBufferMemoryRequirements(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := core1_3.PromoteDevice(dummies.EasyDummyDevice(coreDriver))
+
+	coreDriver.EXPECT().VkGetDeviceBufferMemoryRequirements(
+		device.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(device driver.VkDevice,
+		pInfo *driver.VkDeviceBufferMemoryRequirements,
+		pMemoryRequirements *driver.VkMemoryRequirements2,
+	) {
+		val := reflect.ValueOf(pInfo).Elem()
+		require.Equal(t, uint64(1000413002), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_DEVICE_BUFFER_MEMORY_REQUIREMENTS
+		require.True(t, val.FieldByName("pNext").IsNil())
+
+		createInfo := val.FieldByName("pCreateInfo").Elem()
+		require.Equal(t, uint64(12), createInfo.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_BUFFER_CREATE_INFO
+		require.Equal(t, uint64(256), createInfo.FieldByName("size").Uint())
+		require.Equal(t, uint64(0x80), createInfo.FieldByName("usage").Uint()) // VK_BUFFER_USAGE_VERTEX_BUFFER_BIT
+
+		val = reflect.ValueOf(pMemoryRequirements).Elem()
+		require.Equal(t, uint64(1000146003), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2
+		require.True(t, val.FieldByName("pNext").IsNil())
+
+		val = val.FieldByName("memoryRequirements")
+		*(*driver.VkDeviceSize)(unsafe.Pointer(val.FieldByName("size").UnsafeAddr())) = driver.VkDeviceSize(256)
+		*(*driver.VkDeviceSize)(unsafe.Pointer(val.FieldByName("alignment").UnsafeAddr())) = driver.VkDeviceSize(64)
+		*(*driver.Uint32)(unsafe.Pointer(val.FieldByName("memoryTypeBits").UnsafeAddr())) = driver.Uint32(5)
+	})
+
+	var outData core1_1.MemoryRequirements2
+	err := device.DeviceBufferMemoryRequirements(core1_3.DeviceBufferMemoryRequirements{
+		CreateInfo: core1_0.BufferCreateInfo{
+			Size:  256,
+			Usage: core1_0.BufferUsageVertexBuffer,
+		},
+	}, &outData)
+	require.NoError(t, err)
+
+	require.Equal(t, 256, outData.MemoryRequirements.Size)
+	require.Equal(t, 64, outData.MemoryRequirements.Alignment)
+	require.Equal(t, uint32(5), outData.MemoryRequirements.MemoryTypeBits)
+}
+
+func TestVulkanDevice_DeviceImageMemoryRequirements(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := core1_3.PromoteDevice(dummies.EasyDummyDevice(coreDriver))
+
+	coreDriver.EXPECT().VkGetDeviceImageMemoryRequirements(
+		device.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(device driver.VkDevice,
+		pInfo *driver.VkDeviceImageMemoryRequirements,
+		pMemoryRequirements *driver.VkMemoryRequirements2,
+	) {
+		val := reflect.ValueOf(pInfo).Elem()
+		require.Equal(t, uint64(1000413003), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_DEVICE_IMAGE_MEMORY_REQUIREMENTS
+		require.True(t, val.FieldByName("pNext").IsNil())
+		require.Equal(t, uint64(0x20), val.FieldByName("planeAspect").Uint()) // VK_IMAGE_ASPECT_PLANE_1_BIT
+
+		createInfo := val.FieldByName("pCreateInfo").Elem()
+		require.Equal(t, uint64(14), createInfo.FieldByName("sType").Uint())    // VK_STRUCTURE_TYPE_IMAGE_CREATE_INFO
+		require.Equal(t, uint64(1), createInfo.FieldByName("imageType").Uint()) // VK_IMAGE_TYPE_2D
+		require.Equal(t, uint64(64), createInfo.FieldByName("extent").FieldByName("width").Uint())
+		require.Equal(t, uint64(32), createInfo.FieldByName("extent").FieldByName("height").Uint())
+
+		val = reflect.ValueOf(pMemoryRequirements).Elem()
+		require.Equal(t, uint64(1000146003), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2
+
+		val = val.FieldByName("memoryRequirements")
+		*(*driver.VkDeviceSize)(unsafe.Pointer(val.FieldByName("size").UnsafeAddr())) = driver.VkDeviceSize(8192)
+		*(*driver.VkDeviceSize)(unsafe.Pointer(val.FieldByName("alignment").UnsafeAddr())) = driver.VkDeviceSize(256)
+		*(*driver.Uint32)(unsafe.Pointer(val.FieldByName("memoryTypeBits").UnsafeAddr())) = driver.Uint32(3)
+	})
+
+	var outData core1_1.MemoryRequirements2
+	err := device.DeviceImageMemoryRequirements(core1_3.DeviceImageMemoryRequirements{
+		CreateInfo: core1_0.ImageCreateInfo{
+			ImageType:   core1_0.ImageType2D,
+			Format:      core1_0.FormatR8G8B8A8UnsignedNormalized,
+			Extent:      core1_0.Extent3D{Width: 64, Height: 32, Depth: 1},
+			MipLevels:   1,
+			ArrayLayers: 1,
+			Samples:     core1_0.Samples1,
+		},
+		PlaneAspect: core1_1.ImageAspectPlane1,
+	}, &outData)
+	require.NoError(t, err)
+
+	require.Equal(t, 8192, outData.MemoryRequirements.Size)
+	require.Equal(t, 256, outData.MemoryRequirements.Alignment)
+	require.Equal(t, uint32(3), outData.MemoryRequirements.MemoryTypeBits)
+}
@@ -0,0 +1,120 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import "github.com/vkngwrapper/core/v2/core1_0"
+
+const (
+	// DynamicStateCullMode specifies that PipelineRasterizationStateCreateInfo.CullMode will be
+	// ignored and must be set dynamically with CommandBuffer.CmdSetCullMode before any drawing
+	// commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateCullMode core1_0.DynamicState = C.VK_DYNAMIC_STATE_CULL_MODE
+	// DynamicStateFrontFace specifies that PipelineRasterizationStateCreateInfo.FrontFace will be
+	// ignored and must be set dynamically with CommandBuffer.CmdSetFrontFace before any drawing
+	// commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateFrontFace core1_0.DynamicState = C.VK_DYNAMIC_STATE_FRONT_FACE
+	// DynamicStatePrimitiveTopology specifies that PipelineInputAssemblyStateCreateInfo.Topology
+	// will be ignored and must be set dynamically with CommandBuffer.CmdSetPrimitiveTopology before
+	// any drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStatePrimitiveTopology core1_0.DynamicState = C.VK_DYNAMIC_STATE_PRIMITIVE_TOPOLOGY
+	// DynamicStateViewportWithCount specifies that the viewport count and viewports will be
+	// ignored and must be set dynamically with CommandBuffer.CmdSetViewportWithCount before any
+	// drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateViewportWithCount core1_0.DynamicState = C.VK_DYNAMIC_STATE_VIEWPORT_WITH_COUNT
+	// DynamicStateScissorWithCount specifies that the scissor count and scissor rectangles will be
+	// ignored and must be set dynamically with CommandBuffer.CmdSetScissorWithCount before any
+	// drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateScissorWithCount core1_0.DynamicState = C.VK_DYNAMIC_STATE_SCISSOR_WITH_COUNT
+	// DynamicStateVertexInputBindingStride specifies that VertexInputBindingDescription.Stride
+	// will be ignored and must be set dynamically with CommandBuffer.CmdBindVertexBuffers2 before
+	// any drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateVertexInputBindingStride core1_0.DynamicState = C.VK_DYNAMIC_STATE_VERTEX_INPUT_BINDING_STRIDE
+	// DynamicStateDepthTestEnable specifies that PipelineDepthStencilStateCreateInfo.DepthTestEnable
+	// will be ignored and must be set dynamically with CommandBuffer.CmdSetDepthTestEnable before
+	// any drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateDepthTestEnable core1_0.DynamicState = C.VK_DYNAMIC_STATE_DEPTH_TEST_ENABLE
+	// DynamicStateDepthWriteEnable specifies that PipelineDepthStencilStateCreateInfo.DepthWriteEnable
+	// will be ignored and must be set dynamically with CommandBuffer.CmdSetDepthWriteEnable before
+	// any drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateDepthWriteEnable core1_0.DynamicState = C.VK_DYNAMIC_STATE_DEPTH_WRITE_ENABLE
+	// DynamicStateDepthCompareOp specifies that PipelineDepthStencilStateCreateInfo.DepthCompareOp
+	// will be ignored and must be set dynamically with CommandBuffer.CmdSetDepthCompareOp before
+	// any drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateDepthCompareOp core1_0.DynamicState = C.VK_DYNAMIC_STATE_DEPTH_COMPARE_OP
+	// DynamicStateDepthBoundsTestEnable specifies that
+	// PipelineDepthStencilStateCreateInfo.DepthBoundsTestEnable will be ignored and must be set
+	// dynamically with CommandBuffer.CmdSetDepthBoundsTestEnable before any drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateDepthBoundsTestEnable core1_0.DynamicState = C.VK_DYNAMIC_STATE_DEPTH_BOUNDS_TEST_ENABLE
+	// DynamicStateStencilTestEnable specifies that PipelineDepthStencilStateCreateInfo.StencilTestEnable
+	// will be ignored and must be set dynamically with CommandBuffer.CmdSetStencilTestEnable before
+	// any drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateStencilTestEnable core1_0.DynamicState = C.VK_DYNAMIC_STATE_STENCIL_TEST_ENABLE
+	// DynamicStateStencilOp specifies that the FailOp, PassOp, DepthFailOp, and CompareOp fields of
+	// PipelineDepthStencilStateCreateInfo.Front and PipelineDepthStencilStateCreateInfo.Back will be
+	// ignored and must be set dynamically with CommandBuffer.CmdSetStencilOp before any drawing
+	// commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateStencilOp core1_0.DynamicState = C.VK_DYNAMIC_STATE_STENCIL_OP
+	// DynamicStateRasterizerDiscardEnable specifies that
+	// PipelineRasterizationStateCreateInfo.RasterizerDiscardEnable will be ignored and must be set
+	// dynamically with CommandBuffer.CmdSetRasterizerDiscardEnable before any drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateRasterizerDiscardEnable core1_0.DynamicState = C.VK_DYNAMIC_STATE_RASTERIZER_DISCARD_ENABLE
+	// DynamicStateDepthBiasEnable specifies that PipelineRasterizationStateCreateInfo.DepthBiasEnable
+	// will be ignored and must be set dynamically with CommandBuffer.CmdSetDepthBiasEnable before
+	// any drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStateDepthBiasEnable core1_0.DynamicState = C.VK_DYNAMIC_STATE_DEPTH_BIAS_ENABLE
+	// DynamicStatePrimitiveRestartEnable specifies that
+	// PipelineInputAssemblyStateCreateInfo.PrimitiveRestartEnable will be ignored and must be set
+	// dynamically with CommandBuffer.CmdSetPrimitiveRestartEnable before any drawing commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+	DynamicStatePrimitiveRestartEnable core1_0.DynamicState = C.VK_DYNAMIC_STATE_PRIMITIVE_RESTART_ENABLE
+)
+
+func init() {
+	DynamicStateCullMode.Register("Cull Mode")
+	DynamicStateFrontFace.Register("Front Face")
+	DynamicStatePrimitiveTopology.Register("Primitive Topology")
+	DynamicStateViewportWithCount.Register("Viewport With Count")
+	DynamicStateScissorWithCount.Register("Scissor With Count")
+	DynamicStateVertexInputBindingStride.Register("Vertex Input Binding Stride")
+	DynamicStateDepthTestEnable.Register("Depth Test Enable")
+	DynamicStateDepthWriteEnable.Register("Depth Write Enable")
+	DynamicStateDepthCompareOp.Register("Depth Compare Op")
+	DynamicStateDepthBoundsTestEnable.Register("Depth Bounds Test Enable")
+	DynamicStateStencilTestEnable.Register("Stencil Test Enable")
+	DynamicStateStencilOp.Register("Stencil Op")
+	DynamicStateRasterizerDiscardEnable.Register("Rasterizer Discard Enable")
+	DynamicStateDepthBiasEnable.Register("Depth Bias Enable")
+	DynamicStatePrimitiveRestartEnable.Register("Primitive Restart Enable")
+}
@@ -0,0 +1,12 @@
+package core1_3_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"testing"
+)
+
+func TestDynamicState_String(t *testing.T) {
+	require.Equal(t, "Cull Mode", core1_3.DynamicStateCullMode.String())
+	require.Equal(t, "Primitive Restart Enable", core1_3.DynamicStatePrimitiveRestartEnable.String())
+}
@@ -0,0 +1,36 @@
+package core1_3
+
+import "github.com/vkngwrapper/core/v2/core1_0"
+
+// deviceExtensions are the Device extensions that provide core 1.3 Device commands under an alias,
+// which PromoteDeviceFromExtensions accepts in place of core 1.3
+var deviceExtensions = []string{
+	"VK_KHR_maintenance4",
+}
+
+// commandBufferExtensions are the Device extensions that provide core 1.3 CommandBuffer commands
+// under an alias, which PromoteCommandBufferFromExtensions accepts in place of core 1.3
+var commandBufferExtensions = []string{
+	"VK_KHR_dynamic_rendering",
+	"VK_KHR_synchronization2",
+	"VK_EXT_extended_dynamic_state",
+	"VK_EXT_extended_dynamic_state2",
+	"VK_KHR_copy_commands2",
+}
+
+// queueExtensions are the Device extensions that provide core 1.3 Queue commands under an alias,
+// which PromoteQueueFromExtensions accepts in place of core 1.3
+var queueExtensions = []string{
+	"VK_KHR_synchronization2",
+}
+
+// anyExtensionActive returns true if the Device enabled any of the provided extensions
+func anyExtensionActive(device core1_0.Device, extensionNames []string) bool {
+	for _, extensionName := range extensionNames {
+		if device.IsDeviceExtensionActive(extensionName) {
+			return true
+		}
+	}
+
+	return false
+}
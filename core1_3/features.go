@@ -0,0 +1,250 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/vkngwrapper/core/v2/common"
+	"unsafe"
+)
+
+// PhysicalDeviceVulkan13Features describes the Vulkan 1.3 features that can be supported by
+// an implementation
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPhysicalDeviceVulkan13Features.html
+type PhysicalDeviceVulkan13Features struct {
+	// RobustImageAccess indicates whether image accesses are tightly bounds-checked against
+	// the dimensions of the ImageView
+	RobustImageAccess bool
+	// InlineUniformBlock indicates whether the implementation supports inline uniform block
+	// descriptors
+	InlineUniformBlock bool
+	// DescriptorBindingInlineUniformBlockUpdateAfterBind indicates whether the implementation supports updating
+	// inline uniform block descriptors after a DescriptorSet has been bound
+	DescriptorBindingInlineUniformBlockUpdateAfterBind bool
+	// PipelineCreationCacheControl indicates whether Pipeline creation can be told not to
+	// compile a Pipeline that is not already in the PipelineCache, and whether PipelineCache objects
+	// can be created without internal synchronization
+	PipelineCreationCacheControl bool
+	// PrivateData indicates whether the implementation supports PrivateDataSlot objects
+	PrivateData bool
+	// ShaderDemoteToHelperInvocation indicates whether the implementation supports the SPIR-V
+	// DemoteToHelperInvocationEXT capability
+	ShaderDemoteToHelperInvocation bool
+	// ShaderTerminateInvocation specifies whether the implementation supports SPIR-V modules
+	// that use the SPV_KHR_terminate_invocation extension
+	ShaderTerminateInvocation bool
+	// SubgroupSizeControl indicates whether the implementation supports controlling shader
+	// subgroup sizes
+	SubgroupSizeControl bool
+	// ComputeFullSubgroups indicates whether the implementation supports requiring full
+	// subgroups in compute shaders
+	ComputeFullSubgroups bool
+	// Synchronization2 indicates whether the implementation supports the new set of
+	// synchronization commands introduced in Vulkan 1.3
+	Synchronization2 bool
+	// TextureCompressionASTC_HDR indicates whether all of the ASTC HDR compressed texture
+	// formats are supported
+	TextureCompressionASTC_HDR bool
+	// ShaderZeroInitializeWorkgroupMemory specifies whether the implementation supports
+	// initializing a variable in Workgroup storage class
+	ShaderZeroInitializeWorkgroupMemory bool
+	// DynamicRendering specifies that the implementation supports dynamic render pass
+	// instances using CmdBeginRendering
+	DynamicRendering bool
+	// ShaderIntegerDotProduct specifies whether shader modules can declare the
+	// DotProductInputAllKHR, DotProductInput4x8BitKHR, DotProductInput4x8BitPackedKHR, and DotProductKHR
+	// capabilities
+	ShaderIntegerDotProduct bool
+	// Maintenance4 indicates that the implementation supports the functionality
+	// introduced in Vulkan 1.3 by khr_maintenance4
+	Maintenance4 bool
+
+	common.NextOptions
+	common.NextOutData
+}
+
+func (o *PhysicalDeviceVulkan13Features) PopulateHeader(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(C.sizeof_struct_VkPhysicalDeviceVulkan13Features)
+	}
+
+	info := (*C.VkPhysicalDeviceVulkan13Features)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_FEATURES
+	info.pNext = next
+
+	return preallocatedPointer, nil
+}
+
+func (o *PhysicalDeviceVulkan13Features) PopulateOutData(cDataPointer unsafe.Pointer, helpers ...any) (next unsafe.Pointer, err error) {
+	info := (*C.VkPhysicalDeviceVulkan13Features)(cDataPointer)
+
+	o.RobustImageAccess = info.robustImageAccess != C.VkBool32(0)
+	o.InlineUniformBlock = info.inlineUniformBlock != C.VkBool32(0)
+	o.DescriptorBindingInlineUniformBlockUpdateAfterBind = info.descriptorBindingInlineUniformBlockUpdateAfterBind != C.VkBool32(0)
+	o.PipelineCreationCacheControl = info.pipelineCreationCacheControl != C.VkBool32(0)
+	o.PrivateData = info.privateData != C.VkBool32(0)
+	o.ShaderDemoteToHelperInvocation = info.shaderDemoteToHelperInvocation != C.VkBool32(0)
+	o.ShaderTerminateInvocation = info.shaderTerminateInvocation != C.VkBool32(0)
+	o.SubgroupSizeControl = info.subgroupSizeControl != C.VkBool32(0)
+	o.ComputeFullSubgroups = info.computeFullSubgroups != C.VkBool32(0)
+	o.Synchronization2 = info.synchronization2 != C.VkBool32(0)
+	o.TextureCompressionASTC_HDR = info.textureCompressionASTC_HDR != C.VkBool32(0)
+	o.ShaderZeroInitializeWorkgroupMemory = info.shaderZeroInitializeWorkgroupMemory != C.VkBool32(0)
+	o.DynamicRendering = info.dynamicRendering != C.VkBool32(0)
+	o.ShaderIntegerDotProduct = info.shaderIntegerDotProduct != C.VkBool32(0)
+	o.Maintenance4 = info.maintenance4 != C.VkBool32(0)
+
+	return info.pNext, nil
+}
+
+func (o PhysicalDeviceVulkan13Features) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(C.sizeof_struct_VkPhysicalDeviceVulkan13Features)
+	}
+
+	info := (*C.VkPhysicalDeviceVulkan13Features)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_FEATURES
+	info.pNext = next
+	info.robustImageAccess = C.VkBool32(0)
+	info.inlineUniformBlock = C.VkBool32(0)
+	info.descriptorBindingInlineUniformBlockUpdateAfterBind = C.VkBool32(0)
+	info.pipelineCreationCacheControl = C.VkBool32(0)
+	info.privateData = C.VkBool32(0)
+	info.shaderDemoteToHelperInvocation = C.VkBool32(0)
+	info.shaderTerminateInvocation = C.VkBool32(0)
+	info.subgroupSizeControl = C.VkBool32(0)
+	info.computeFullSubgroups = C.VkBool32(0)
+	info.synchronization2 = C.VkBool32(0)
+	info.textureCompressionASTC_HDR = C.VkBool32(0)
+	info.shaderZeroInitializeWorkgroupMemory = C.VkBool32(0)
+	info.dynamicRendering = C.VkBool32(0)
+	info.shaderIntegerDotProduct = C.VkBool32(0)
+	info.maintenance4 = C.VkBool32(0)
+
+	if o.RobustImageAccess {
+		info.robustImageAccess = C.VkBool32(1)
+	}
+
+	if o.InlineUniformBlock {
+		info.inlineUniformBlock = C.VkBool32(1)
+	}
+
+	if o.DescriptorBindingInlineUniformBlockUpdateAfterBind {
+		info.descriptorBindingInlineUniformBlockUpdateAfterBind = C.VkBool32(1)
+	}
+
+	if o.PipelineCreationCacheControl {
+		info.pipelineCreationCacheControl = C.VkBool32(1)
+	}
+
+	if o.PrivateData {
+		info.privateData = C.VkBool32(1)
+	}
+
+	if o.ShaderDemoteToHelperInvocation {
+		info.shaderDemoteToHelperInvocation = C.VkBool32(1)
+	}
+
+	if o.ShaderTerminateInvocation {
+		info.shaderTerminateInvocation = C.VkBool32(1)
+	}
+
+	if o.SubgroupSizeControl {
+		info.subgroupSizeControl = C.VkBool32(1)
+	}
+
+	if o.ComputeFullSubgroups {
+		info.computeFullSubgroups = C.VkBool32(1)
+	}
+
+	if o.Synchronization2 {
+		info.synchronization2 = C.VkBool32(1)
+	}
+
+	if o.TextureCompressionASTC_HDR {
+		info.textureCompressionASTC_HDR = C.VkBool32(1)
+	}
+
+	if o.ShaderZeroInitializeWorkgroupMemory {
+		info.shaderZeroInitializeWorkgroupMemory = C.VkBool32(1)
+	}
+
+	if o.DynamicRendering {
+		info.dynamicRendering = C.VkBool32(1)
+	}
+
+	if o.ShaderIntegerDotProduct {
+		info.shaderIntegerDotProduct = C.VkBool32(1)
+	}
+
+	if o.Maintenance4 {
+		info.maintenance4 = C.VkBool32(1)
+	}
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// PhysicalDeviceInlineUniformBlockFeatures describes inline uniform block features that can
+// be supported by an implementation
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPhysicalDeviceInlineUniformBlockFeatures.html
+type PhysicalDeviceInlineUniformBlockFeatures struct {
+	// InlineUniformBlock indicates whether the implementation supports inline uniform block
+	// descriptors
+	InlineUniformBlock bool
+	// DescriptorBindingInlineUniformBlockUpdateAfterBind indicates whether the implementation supports updating
+	// inline uniform block descriptors after a DescriptorSet has been bound
+	DescriptorBindingInlineUniformBlockUpdateAfterBind bool
+
+	common.NextOptions
+	common.NextOutData
+}
+
+func (o *PhysicalDeviceInlineUniformBlockFeatures) PopulateHeader(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(C.sizeof_struct_VkPhysicalDeviceInlineUniformBlockFeatures)
+	}
+
+	info := (*C.VkPhysicalDeviceInlineUniformBlockFeatures)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_FEATURES
+	info.pNext = next
+
+	return preallocatedPointer, nil
+}
+
+func (o *PhysicalDeviceInlineUniformBlockFeatures) PopulateOutData(cDataPointer unsafe.Pointer, helpers ...any) (next unsafe.Pointer, err error) {
+	info := (*C.VkPhysicalDeviceInlineUniformBlockFeatures)(cDataPointer)
+
+	o.InlineUniformBlock = info.inlineUniformBlock != C.VkBool32(0)
+	o.DescriptorBindingInlineUniformBlockUpdateAfterBind = info.descriptorBindingInlineUniformBlockUpdateAfterBind != C.VkBool32(0)
+
+	return info.pNext, nil
+}
+
+func (o PhysicalDeviceInlineUniformBlockFeatures) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(C.sizeof_struct_VkPhysicalDeviceInlineUniformBlockFeatures)
+	}
+
+	info := (*C.VkPhysicalDeviceInlineUniformBlockFeatures)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_FEATURES
+	info.pNext = next
+	info.inlineUniformBlock = C.VkBool32(0)
+	info.descriptorBindingInlineUniformBlockUpdateAfterBind = C.VkBool32(0)
+
+	if o.InlineUniformBlock {
+		info.inlineUniformBlock = C.VkBool32(1)
+	}
+
+	if o.DescriptorBindingInlineUniformBlockUpdateAfterBind {
+		info.descriptorBindingInlineUniformBlockUpdateAfterBind = C.VkBool32(1)
+	}
+
+	return preallocatedPointer, nil
+}
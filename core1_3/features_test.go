@@ -0,0 +1,150 @@
+package core1_3_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/common/extensions"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"github.com/vkngwrapper/core/v2/driver"
+	mock_driver "github.com/vkngwrapper/core/v2/driver/mocks"
+	"github.com/vkngwrapper/core/v2/internal/dummies"
+	"github.com/vkngwrapper/core/v2/mocks"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+func TestPhysicalDeviceVulkan13FeaturesOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	coreDriver.EXPECT().CreateDeviceDriver(gomock.Any()).Return(coreDriver, nil)
+	instance := mocks.EasyMockInstance(ctrl, coreDriver)
+	physicalDevice := extensions.CreatePhysicalDeviceObject(coreDriver, instance.Handle(), mocks.NewFakePhysicalDeviceHandle(), common.Vulkan1_0, common.Vulkan1_0)
+	mockDevice := mocks.EasyMockDevice(ctrl, coreDriver)
+
+	coreDriver.EXPECT().VkCreateDevice(
+		physicalDevice.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Nil(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(
+		func(physicalDevice driver.VkPhysicalDevice,
+			pCreateInfo *driver.VkDeviceCreateInfo,
+			pAllocator *driver.VkAllocationCallbacks,
+			pDevice *driver.VkDevice) (common.VkResult, error) {
+
+			*pDevice = mockDevice.Handle()
+
+			val := reflect.ValueOf(pCreateInfo).Elem()
+			require.Equal(t, uint64(3), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_DEVICE_CREATE_INFO
+
+			featuresPtr := (*driver.VkPhysicalDeviceVulkan13Features)(val.FieldByName("pNext").UnsafePointer())
+			val = reflect.ValueOf(featuresPtr).Elem()
+
+			require.Equal(t, uint64(53), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_FEATURES
+			require.True(t, val.FieldByName("pNext").IsNil())
+			require.Equal(t, uint64(1), val.FieldByName("robustImageAccess").Uint())
+			require.Equal(t, uint64(0), val.FieldByName("inlineUniformBlock").Uint())
+			require.Equal(t, uint64(1), val.FieldByName("descriptorBindingInlineUniformBlockUpdateAfterBind").Uint())
+			require.Equal(t, uint64(0), val.FieldByName("pipelineCreationCacheControl").Uint())
+			require.Equal(t, uint64(1), val.FieldByName("privateData").Uint())
+			require.Equal(t, uint64(0), val.FieldByName("shaderDemoteToHelperInvocation").Uint())
+			require.Equal(t, uint64(1), val.FieldByName("shaderTerminateInvocation").Uint())
+			require.Equal(t, uint64(0), val.FieldByName("subgroupSizeControl").Uint())
+			require.Equal(t, uint64(1), val.FieldByName("computeFullSubgroups").Uint())
+			require.Equal(t, uint64(0), val.FieldByName("synchronization2").Uint())
+			require.Equal(t, uint64(1), val.FieldByName("textureCompressionASTC_HDR").Uint())
+			require.Equal(t, uint64(0), val.FieldByName("shaderZeroInitializeWorkgroupMemory").Uint())
+			require.Equal(t, uint64(1), val.FieldByName("dynamicRendering").Uint())
+			require.Equal(t, uint64(0), val.FieldByName("shaderIntegerDotProduct").Uint())
+			require.Equal(t, uint64(1), val.FieldByName("maintenance4").Uint())
+
+			return core1_0.VKSuccess, nil
+		})
+
+	device, _, err := physicalDevice.CreateDevice(nil, core1_0.DeviceCreateInfo{
+		QueueCreateInfos: []core1_0.DeviceQueueCreateInfo{
+			{
+				QueuePriorities: []float32{0},
+			},
+		},
+
+		NextOptions: common.NextOptions{Next: core1_3.PhysicalDeviceVulkan13Features{
+			RobustImageAccess: true,
+			DescriptorBindingInlineUniformBlockUpdateAfterBind: true,
+			PrivateData:                true,
+			ShaderTerminateInvocation:  true,
+			ComputeFullSubgroups:       true,
+			TextureCompressionASTC_HDR: true,
+			DynamicRendering:           true,
+			Maintenance4:               true,
+		}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, device)
+	require.Equal(t, mockDevice.Handle(), device.Handle())
+}
+
+func TestPhysicalDeviceVulkan13FeaturesOutData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	instance := mocks.EasyMockInstance(ctrl, coreDriver)
+	physicalDevice := core1_3.PromoteInstanceScopedPhysicalDevice(dummies.EasyDummyPhysicalDevice(coreDriver, instance))
+
+	coreDriver.EXPECT().VkGetPhysicalDeviceFeatures2(
+		physicalDevice.Handle(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(
+		func(
+			physicalDevice driver.VkPhysicalDevice,
+			pFeatures *driver.VkPhysicalDeviceFeatures2,
+		) {
+			val := reflect.ValueOf(pFeatures).Elem()
+
+			require.Equal(t, uint64(1000059000), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2
+
+			outData := (*driver.VkPhysicalDeviceVulkan13Features)(val.FieldByName("pNext").UnsafePointer())
+			val = reflect.ValueOf(outData).Elem()
+
+			require.Equal(t, uint64(53), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_FEATURES
+			require.True(t, val.FieldByName("pNext").IsNil())
+
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("robustImageAccess").UnsafeAddr())) = driver.VkBool32(0)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("inlineUniformBlock").UnsafeAddr())) = driver.VkBool32(1)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("descriptorBindingInlineUniformBlockUpdateAfterBind").UnsafeAddr())) = driver.VkBool32(0)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("pipelineCreationCacheControl").UnsafeAddr())) = driver.VkBool32(1)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("privateData").UnsafeAddr())) = driver.VkBool32(0)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("shaderDemoteToHelperInvocation").UnsafeAddr())) = driver.VkBool32(1)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("shaderTerminateInvocation").UnsafeAddr())) = driver.VkBool32(0)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("subgroupSizeControl").UnsafeAddr())) = driver.VkBool32(1)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("computeFullSubgroups").UnsafeAddr())) = driver.VkBool32(0)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("synchronization2").UnsafeAddr())) = driver.VkBool32(1)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("textureCompressionASTC_HDR").UnsafeAddr())) = driver.VkBool32(0)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("shaderZeroInitializeWorkgroupMemory").UnsafeAddr())) = driver.VkBool32(1)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("dynamicRendering").UnsafeAddr())) = driver.VkBool32(0)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("shaderIntegerDotProduct").UnsafeAddr())) = driver.VkBool32(1)
+			*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("maintenance4").UnsafeAddr())) = driver.VkBool32(0)
+		})
+
+	var outData core1_3.PhysicalDeviceVulkan13Features
+	err := physicalDevice.Features2(&core1_1.PhysicalDeviceFeatures2{
+		NextOutData: common.NextOutData{Next: &outData},
+	})
+	require.NoError(t, err)
+	require.Equal(t, core1_3.PhysicalDeviceVulkan13Features{
+		InlineUniformBlock:                  true,
+		PipelineCreationCacheControl:        true,
+		ShaderDemoteToHelperInvocation:      true,
+		SubgroupSizeControl:                 true,
+		Synchronization2:                    true,
+		ShaderZeroInitializeWorkgroupMemory: true,
+		ShaderIntegerDotProduct:             true,
+	}, outData)
+}
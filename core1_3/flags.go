@@ -0,0 +1,97 @@
+package core1_3
+
+import "github.com/vkngwrapper/core/v2/common"
+
+// AccessFlags2 specifies 64-bit memory access types that will participate in a memory dependency
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAccessFlagBits2.html
+type AccessFlags2 uint64
+
+var accessFlags2Mapping = common.NewFlagStringMapping[AccessFlags2]()
+
+func (f AccessFlags2) Register(str string) {
+	accessFlags2Mapping.Register(f, str)
+}
+func (f AccessFlags2) String() string {
+	return accessFlags2Mapping.FlagsToString(f)
+}
+
+////
+
+// PipelineStageFlags2 specifies 64-bit pipeline stages
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+type PipelineStageFlags2 uint64
+
+var pipelineStageFlags2Mapping = common.NewFlagStringMapping[PipelineStageFlags2]()
+
+func (f PipelineStageFlags2) Register(str string) {
+	pipelineStageFlags2Mapping.Register(f, str)
+}
+func (f PipelineStageFlags2) String() string {
+	return pipelineStageFlags2Mapping.FlagsToString(f)
+}
+
+////
+
+// SubmitFlags specifies behavior of a submission
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkSubmitFlagBits.html
+type SubmitFlags int32
+
+var submitFlagsMapping = common.NewFlagStringMapping[SubmitFlags]()
+
+func (f SubmitFlags) Register(str string) {
+	submitFlagsMapping.Register(f, str)
+}
+func (f SubmitFlags) String() string {
+	return submitFlagsMapping.FlagsToString(f)
+}
+
+////
+
+// ToolPurposeFlags specifies the purposes of an active tool
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkToolPurposeFlagBits.html
+type ToolPurposeFlags int32
+
+var toolPurposeFlagsMapping = common.NewFlagStringMapping[ToolPurposeFlags]()
+
+func (f ToolPurposeFlags) Register(str string) {
+	toolPurposeFlagsMapping.Register(f, str)
+}
+func (f ToolPurposeFlags) String() string {
+	return toolPurposeFlagsMapping.FlagsToString(f)
+}
+
+////
+
+// PipelineCreationFeedbackFlags specifies pipeline or pipeline stage creation feedback
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineCreationFeedbackFlagBits.html
+type PipelineCreationFeedbackFlags int32
+
+var pipelineCreationFeedbackFlagsMapping = common.NewFlagStringMapping[PipelineCreationFeedbackFlags]()
+
+func (f PipelineCreationFeedbackFlags) Register(str string) {
+	pipelineCreationFeedbackFlagsMapping.Register(f, str)
+}
+func (f PipelineCreationFeedbackFlags) String() string {
+	return pipelineCreationFeedbackFlagsMapping.FlagsToString(f)
+}
+
+////
+
+// FormatFeatureFlags2 specifies 64-bit features supported by a Buffer or Image format
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+type FormatFeatureFlags2 uint64
+
+var formatFeatureFlags2Mapping = common.NewFlagStringMapping[FormatFeatureFlags2]()
+
+func (f FormatFeatureFlags2) Register(str string) {
+	formatFeatureFlags2Mapping.Register(f, str)
+}
+func (f FormatFeatureFlags2) String() string {
+	return formatFeatureFlags2Mapping.FlagsToString(f)
+}
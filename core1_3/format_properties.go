@@ -0,0 +1,226 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/vkngwrapper/core/v2/common"
+	"unsafe"
+)
+
+const (
+	// FormatFeature2SampledImage specifies that an ImageView can be sampled from
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2SampledImage FormatFeatureFlags2 = 0x1
+	// FormatFeature2StorageImage specifies that an ImageView can be used as a storage Image
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2StorageImage FormatFeatureFlags2 = 0x2
+	// FormatFeature2StorageImageAtomic specifies that an ImageView can be used as a storage Image
+	// that supports atomic operations
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2StorageImageAtomic FormatFeatureFlags2 = 0x4
+	// FormatFeature2UniformTexelBuffer specifies that the format can be used to create a BufferView
+	// that can be bound to a DescriptorTypeUniformTexelBuffer descriptor
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2UniformTexelBuffer FormatFeatureFlags2 = 0x8
+	// FormatFeature2StorageTexelBuffer specifies that the format can be used to create a BufferView
+	// that can be bound to a DescriptorTypeStorageTexelBuffer descriptor
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2StorageTexelBuffer FormatFeatureFlags2 = 0x10
+	// FormatFeature2StorageTexelBufferAtomic specifies that atomic operations are supported on
+	// DescriptorTypeStorageTexelBuffer with this format
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2StorageTexelBufferAtomic FormatFeatureFlags2 = 0x20
+	// FormatFeature2VertexBuffer specifies that the format can be used as a vertex attribute
+	// format
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2VertexBuffer FormatFeatureFlags2 = 0x40
+	// FormatFeature2ColorAttachment specifies that an ImageView can be used as a Framebuffer color
+	// attachment and as an input attachment
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2ColorAttachment FormatFeatureFlags2 = 0x80
+	// FormatFeature2ColorAttachmentBlend specifies that an ImageView can be used as a Framebuffer
+	// color attachment that supports blending
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2ColorAttachmentBlend FormatFeatureFlags2 = 0x100
+	// FormatFeature2DepthStencilAttachment specifies that an ImageView can be used as a Framebuffer
+	// depth/stencil attachment and as an input attachment
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2DepthStencilAttachment FormatFeatureFlags2 = 0x200
+	// FormatFeature2BlitSource specifies that an Image can be used as a source Image in a blit
+	// operation
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2BlitSource FormatFeatureFlags2 = 0x400
+	// FormatFeature2BlitDestination specifies that an Image can be used as a destination Image in a
+	// blit operation
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2BlitDestination FormatFeatureFlags2 = 0x800
+	// FormatFeature2SampledImageFilterLinear specifies that an ImageView can be used with a Sampler
+	// that has either of magFilter or minFilter set to FilterLinear, or mipmapMode set to
+	// SamplerMipmapModeLinear
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2SampledImageFilterLinear FormatFeatureFlags2 = 0x1000
+	// FormatFeature2SampledImageFilterCubic specifies that an ImageView can be used with a Sampler
+	// that has either of magFilter or minFilter set to a cubic filter
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2SampledImageFilterCubic FormatFeatureFlags2 = 0x2000
+	// FormatFeature2TransferSrc specifies that an Image can be used as a source Image for copy
+	// commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2TransferSrc FormatFeatureFlags2 = 0x4000
+	// FormatFeature2TransferDst specifies that an Image can be used as a destination Image for copy
+	// commands and clear commands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2TransferDst FormatFeatureFlags2 = 0x8000
+	// FormatFeature2SampledImageFilterMinmax specifies that an Image can be used as a sampled Image
+	// with a min or max SamplerReductionMode
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2SampledImageFilterMinmax FormatFeatureFlags2 = 0x10000
+	// FormatFeature2MidpointChromaSamples specifies that an application can define a
+	// SamplerYcbcrConversion using this format as a source, and that an Image of this format
+	// can be used with a SamplerYcbcrConversionCreateInfo.XChromaOffset and/or
+	// SamplerYcbcrConversionCreateInfo.YChromaOffset of ChromaLocationMidpoint
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2MidpointChromaSamples FormatFeatureFlags2 = 0x20000
+	// FormatFeature2SampledImageYcbcrConversionLinearFilter specifies that an application can
+	// define a SamplerYcbcrConversion using this format as a source with ChromaFilter set to
+	// FilterLinear
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2SampledImageYcbcrConversionLinearFilter FormatFeatureFlags2 = 0x40000
+	// FormatFeature2SampledImageYcbcrConversionSeparateReconstructionFilter specifies that the
+	// format can have different chroma, min, and mag filters
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2SampledImageYcbcrConversionSeparateReconstructionFilter FormatFeatureFlags2 = 0x80000
+	// FormatFeature2SampledImageYcbcrConversionChromaReconstructionExplicit specifies that
+	// reconstruction is explicit
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2SampledImageYcbcrConversionChromaReconstructionExplicit FormatFeatureFlags2 = 0x100000
+	// FormatFeature2SampledImageYcbcrConversionChromaReconstructionExplicitForceable specifies
+	// that reconstruction can be forcibly made explicit by setting
+	// SamplerYcbcrConversionCreateInfo.ForceExplicitReconstruction to true
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2SampledImageYcbcrConversionChromaReconstructionExplicitForceable FormatFeatureFlags2 = 0x200000
+	// FormatFeature2Disjoint specifies that a multi-planar Image can have ImageCreateDisjoint set
+	// during Image creation
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2Disjoint FormatFeatureFlags2 = 0x400000
+	// FormatFeature2CositedChromaSamples specifies that an application can define a
+	// SamplerYcbcrConversion using this format as a source, and that an Image of this format
+	// can be used with a SamplerYcbcrConversionCreateInfo.XChromaOffset and/or
+	// SamplerYcbcrConversionCreateInfo.YChromaOffset of ChromaLocationCositedEven
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2CositedChromaSamples FormatFeatureFlags2 = 0x800000
+	// FormatFeature2StorageReadWithoutFormat specifies that an ImageView or BufferView can be
+	// used as a storage Image or storage texel Buffer for read operations without specifying
+	// a format
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2StorageReadWithoutFormat FormatFeatureFlags2 = 0x80000000
+	// FormatFeature2StorageWriteWithoutFormat specifies that an ImageView or BufferView can be
+	// used as a storage Image or storage texel Buffer for write operations without specifying
+	// a format
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2StorageWriteWithoutFormat FormatFeatureFlags2 = 0x100000000
+	// FormatFeature2SampledImageDepthComparison specifies that an ImageView can be used as a
+	// sampled Image with depth comparison using a Sampler with CompareEnable set to true
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatFeatureFlagBits2.html
+	FormatFeature2SampledImageDepthComparison FormatFeatureFlags2 = 0x200000000
+)
+
+func init() {
+	FormatFeature2SampledImage.Register("Sampled Image")
+	FormatFeature2StorageImage.Register("Storage Image")
+	FormatFeature2StorageImageAtomic.Register("Storage Image, Atomic")
+	FormatFeature2UniformTexelBuffer.Register("Uniform Texel Buffer")
+	FormatFeature2StorageTexelBuffer.Register("Storage Texel Buffer")
+	FormatFeature2StorageTexelBufferAtomic.Register("Storage Texel Buffer, Atomic")
+	FormatFeature2VertexBuffer.Register("Vertex Buffer")
+	FormatFeature2ColorAttachment.Register("Color Attachment")
+	FormatFeature2ColorAttachmentBlend.Register("Color Attachment Blend")
+	FormatFeature2DepthStencilAttachment.Register("Depth Stencil Attachment")
+	FormatFeature2BlitSource.Register("Blit Source")
+	FormatFeature2BlitDestination.Register("Blit Destination")
+	FormatFeature2SampledImageFilterLinear.Register("Sampled Image, Linear Filter")
+	FormatFeature2SampledImageFilterCubic.Register("Sampled Image, Cubic Filter")
+	FormatFeature2TransferSrc.Register("Transfer Source")
+	FormatFeature2TransferDst.Register("Transfer Destination")
+	FormatFeature2SampledImageFilterMinmax.Register("Sampled Image Filter Min-Max")
+	FormatFeature2MidpointChromaSamples.Register("Midpoint Chroma Samples")
+	FormatFeature2SampledImageYcbcrConversionLinearFilter.Register("Sampled Image Ycbcr Conversion - Linear Filter")
+	FormatFeature2SampledImageYcbcrConversionSeparateReconstructionFilter.Register("Sampled Image Ycbcr Conversion - Separate Reconstruction Filter")
+	FormatFeature2SampledImageYcbcrConversionChromaReconstructionExplicit.Register("Sampled Image Ycbcr Conversion - Chroma Reconstruction (Explicit)")
+	FormatFeature2SampledImageYcbcrConversionChromaReconstructionExplicitForceable.Register("Sampled Image Ycbcr Conversion - Chroma Reconstruction (Explicit, Forceable)")
+	FormatFeature2Disjoint.Register("Disjoint")
+	FormatFeature2CositedChromaSamples.Register("Cosited Chroma Samples")
+	FormatFeature2StorageReadWithoutFormat.Register("Storage Read Without Format")
+	FormatFeature2StorageWriteWithoutFormat.Register("Storage Write Without Format")
+	FormatFeature2SampledImageDepthComparison.Register("Sampled Image Depth Comparison")
+}
+
+// FormatProperties3 specifies the extended Image format properties. It can be chained onto
+// core1_1.FormatProperties2 to retrieve format features that do not fit in
+// core1_0.FormatFeatureFlags
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkFormatProperties3.html
+type FormatProperties3 struct {
+	// LinearTilingFeatures specifies features supported by Image objects created with a tiling
+	// parameter of ImageTilingLinear
+	LinearTilingFeatures FormatFeatureFlags2
+	// OptimalTilingFeatures specifies features supported by Image objects created with a tiling
+	// parameter of ImageTilingOptimal
+	OptimalTilingFeatures FormatFeatureFlags2
+	// BufferFeatures specifies features supported by Buffer objects
+	BufferFeatures FormatFeatureFlags2
+
+	common.NextOutData
+}
+
+func (o *FormatProperties3) PopulateHeader(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkFormatProperties3{})))
+	}
+
+	outData := (*C.VkFormatProperties3)(preallocatedPointer)
+	outData.sType = C.VK_STRUCTURE_TYPE_FORMAT_PROPERTIES_3
+	outData.pNext = next
+
+	return preallocatedPointer, nil
+}
+
+func (o *FormatProperties3) PopulateOutData(cDataPointer unsafe.Pointer, helpers ...any) (next unsafe.Pointer, err error) {
+	outData := (*C.VkFormatProperties3)(cDataPointer)
+	o.LinearTilingFeatures = FormatFeatureFlags2(outData.linearTilingFeatures)
+	o.OptimalTilingFeatures = FormatFeatureFlags2(outData.optimalTilingFeatures)
+	o.BufferFeatures = FormatFeatureFlags2(outData.bufferFeatures)
+
+	return outData.pNext, nil
+}
@@ -0,0 +1,430 @@
+package core1_3
+
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/core1_2"
+	"github.com/vkngwrapper/core/v2/driver"
+)
+
+//go:generate mockgen -source ./iface.go -destination ../mocks/core1_3_mocks.go -package mocks -mock_names CommandBuffer=CommandBuffer1_3,Device=Device1_3,Instance=Instance1_3,PhysicalDevice=PhysicalDevice1_3,PrivateDataSlot=PrivateDataSlot1_3,Queue=Queue1_3,InstanceScopedPhysicalDevice=InstanceScopedPhysicalDevice1_3
+
+// CommandBuffer is an object used to record commands which can be subsequently submitted to
+// a device queue for execution.
+//
+// This interface includes all commands included in Vulkan 1.3.
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkCommandBuffer.html
+type CommandBuffer interface {
+	core1_2.CommandBuffer
+
+	// CmdBeginRendering begins a dynamic render pass instance, which renders directly to the
+	// ImageView objects in renderingInfo without a RenderPass or Framebuffer
+	//
+	// renderingInfo - Specifies details of the render pass instance to begin
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdBeginRendering.html
+	CmdBeginRendering(renderingInfo RenderingInfo) error
+	// CmdEndRendering ends the current dynamic render pass instance
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdEndRendering.html
+	CmdEndRendering()
+
+	// CmdPipelineBarrier2 inserts a memory dependency into the recorded commands
+	//
+	// dependencyInfo - Specifies the memory, buffer, and image barriers that make up the
+	// dependency
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdPipelineBarrier2.html
+	CmdPipelineBarrier2(dependencyInfo DependencyInfo) error
+	// CmdSetEvent2 sets an Event object to the signaled state
+	//
+	// event - The Event that will be signaled
+	//
+	// dependencyInfo - Specifies the dependency that must be satisfied before the Event is signaled
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetEvent2.html
+	CmdSetEvent2(event core1_0.Event, dependencyInfo DependencyInfo) error
+	// CmdResetEvent2 resets an Event object to non-signaled state
+	//
+	// event - The Event that will be unsignaled
+	//
+	// stageMask - Specifies the source stage mask used to determine when the Event is unsignaled
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdResetEvent2.html
+	CmdResetEvent2(event core1_0.Event, stageMask PipelineStageFlags2)
+	// CmdWaitEvents2 waits for one or more events to enter the signaled state
+	//
+	// events - A slice of Event objects to wait on
+	//
+	// dependencyInfos - A slice of DependencyInfo structures, one for each Event in events,
+	// which must match the DependencyInfo the Event was signaled with
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdWaitEvents2.html
+	CmdWaitEvents2(events []core1_0.Event, dependencyInfos []DependencyInfo) error
+	// CmdWriteTimestamp2 writes a device timestamp into a query object
+	//
+	// stage - Specifies a stage of the pipeline
+	//
+	// queryPool - The QueryPool that will manage the timestamp
+	//
+	// query - The query within the QueryPool that will contain the timestamp
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdWriteTimestamp2.html
+	CmdWriteTimestamp2(stage PipelineStageFlags2, queryPool core1_0.QueryPool, query int)
+
+	// CmdSetCullMode sets the cull mode dynamically for the CommandBuffer
+	//
+	// cullMode - Specifies the cull mode property to use for drawing
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetCullMode.html
+	CmdSetCullMode(cullMode core1_0.CullModeFlags)
+	// CmdSetFrontFace sets the front face orientation dynamically for the CommandBuffer
+	//
+	// frontFace - Specifies the front-facing triangle orientation to be used for culling
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetFrontFace.html
+	CmdSetFrontFace(frontFace core1_0.FrontFace)
+	// CmdSetPrimitiveTopology sets the primitive topology dynamically for the CommandBuffer
+	//
+	// primitiveTopology - Specifies the primitive topology to use for drawing
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetPrimitiveTopology.html
+	CmdSetPrimitiveTopology(primitiveTopology core1_0.PrimitiveTopology)
+	// CmdSetViewportWithCount sets the viewport count and viewports dynamically for the CommandBuffer
+	//
+	// viewports - A slice of Viewport structures specifying viewport parameters
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetViewportWithCount.html
+	CmdSetViewportWithCount(viewports []core1_0.Viewport)
+	// CmdSetScissorWithCount sets the scissor count and scissor rectangles dynamically for the
+	// CommandBuffer
+	//
+	// scissors - A slice of Rect2D structures specifying scissor rectangles
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetScissorWithCount.html
+	CmdSetScissorWithCount(scissors []core1_0.Rect2D)
+	// CmdBindVertexBuffers2 binds vertex Buffers to this CommandBuffer, along with optional sizes
+	// and strides
+	//
+	// firstBinding - The index of the first input binding whose state is updated by the command
+	//
+	// buffers - A slice of Buffer objects
+	//
+	// bufferOffsets - A slice of Buffer offsets
+	//
+	// sizes - An optional slice of the size in bytes of vertex data bound from each Buffer. If nil,
+	// the whole Buffer past its offset is bound
+	//
+	// strides - An optional slice of byte strides between consecutive elements within each Buffer.
+	// If nil, the strides are taken from the bound Pipeline
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdBindVertexBuffers2.html
+	CmdBindVertexBuffers2(firstBinding int, buffers []core1_0.Buffer, bufferOffsets []int, sizes []int, strides []int) error
+	// CmdSetDepthTestEnable sets the depth test enable dynamically for the CommandBuffer
+	//
+	// depthTestEnable - Specifies if the depth test is enabled
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetDepthTestEnable.html
+	CmdSetDepthTestEnable(depthTestEnable bool)
+	// CmdSetDepthWriteEnable sets the depth write enable dynamically for the CommandBuffer
+	//
+	// depthWriteEnable - Specifies if depth writes are enabled
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetDepthWriteEnable.html
+	CmdSetDepthWriteEnable(depthWriteEnable bool)
+	// CmdSetDepthCompareOp sets the depth comparison operator dynamically for the CommandBuffer
+	//
+	// depthCompareOp - Specifies the comparison operator used in the depth test
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetDepthCompareOp.html
+	CmdSetDepthCompareOp(depthCompareOp core1_0.CompareOp)
+	// CmdSetDepthBoundsTestEnable sets the depth bounds enable dynamically for the CommandBuffer
+	//
+	// depthBoundsTestEnable - Specifies if the depth bounds test is enabled
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetDepthBoundsTestEnable.html
+	CmdSetDepthBoundsTestEnable(depthBoundsTestEnable bool)
+	// CmdSetStencilTestEnable sets the stencil test enable dynamically for the CommandBuffer
+	//
+	// stencilTestEnable - Specifies if the stencil test is enabled
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetStencilTestEnable.html
+	CmdSetStencilTestEnable(stencilTestEnable bool)
+	// CmdSetStencilOp sets the stencil operations dynamically for the CommandBuffer
+	//
+	// faceMask - Specifies the set of stencil state for which to update the stencil operation
+	//
+	// failOp - Specifies the action performed on samples that fail the stencil test
+	//
+	// passOp - Specifies the action performed on samples that pass both the depth and stencil tests
+	//
+	// depthFailOp - Specifies the action performed on samples that pass the stencil test and fail
+	// the depth test
+	//
+	// compareOp - Specifies the comparison operator used in the stencil test
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetStencilOp.html
+	CmdSetStencilOp(faceMask core1_0.StencilFaceFlags, failOp core1_0.StencilOp, passOp core1_0.StencilOp, depthFailOp core1_0.StencilOp, compareOp core1_0.CompareOp)
+	// CmdSetRasterizerDiscardEnable controls whether primitives are discarded before the
+	// rasterization stage dynamically for the CommandBuffer
+	//
+	// rasterizerDiscardEnable - Specifies if primitives are discarded before rasterization
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetRasterizerDiscardEnable.html
+	CmdSetRasterizerDiscardEnable(rasterizerDiscardEnable bool)
+	// CmdSetDepthBiasEnable controls whether to bias fragment depth values dynamically for the
+	// CommandBuffer
+	//
+	// depthBiasEnable - Specifies if fragment depth values are biased
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetDepthBiasEnable.html
+	CmdSetDepthBiasEnable(depthBiasEnable bool)
+	// CmdSetPrimitiveRestartEnable sets the primitive restart enable dynamically for the
+	// CommandBuffer
+	//
+	// primitiveRestartEnable - Specifies if a special vertex index value is treated as restarting
+	// the assembly of primitives
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdSetPrimitiveRestartEnable.html
+	CmdSetPrimitiveRestartEnable(primitiveRestartEnable bool)
+
+	// CmdCopyBuffer2 copies data between Buffer regions
+	//
+	// copyBufferInfo - Specifies the source and destination Buffer objects and the regions to copy
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdCopyBuffer2.html
+	CmdCopyBuffer2(copyBufferInfo CopyBufferInfo2) error
+	// CmdCopyImage2 copies data between Image objects
+	//
+	// copyImageInfo - Specifies the source and destination Image objects and the regions to copy
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdCopyImage2.html
+	CmdCopyImage2(copyImageInfo CopyImageInfo2) error
+	// CmdCopyBufferToImage2 copies data from a Buffer to an Image
+	//
+	// copyBufferToImageInfo - Specifies the source Buffer, destination Image, and the regions to copy
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdCopyBufferToImage2.html
+	CmdCopyBufferToImage2(copyBufferToImageInfo CopyBufferToImageInfo2) error
+	// CmdCopyImageToBuffer2 copies data from an Image to a Buffer
+	//
+	// copyImageToBufferInfo - Specifies the source Image, destination Buffer, and the regions to copy
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdCopyImageToBuffer2.html
+	CmdCopyImageToBuffer2(copyImageToBufferInfo CopyImageToBufferInfo2) error
+	// CmdBlitImage2 copies regions of an Image, potentially performing format conversion
+	//
+	// blitImageInfo - Specifies the source and destination Image objects, the regions to blit, and the filter to apply
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdBlitImage2.html
+	CmdBlitImage2(blitImageInfo BlitImageInfo2) error
+	// CmdResolveImage2 resolves a multisample Image to a non-multisample Image
+	//
+	// resolveImageInfo - Specifies the source and destination Image objects and the regions to resolve
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCmdResolveImage2.html
+	CmdResolveImage2(resolveImageInfo ResolveImageInfo2) error
+}
+
+// Device represents a logical device on the host
+//
+// This interface includes all commands included in Vulkan 1.3.
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDevice.html
+type Device interface {
+	core1_2.Device
+
+	// DeviceBufferMemoryRequirements returns the memory requirements for a Buffer without
+	// creating it
+	//
+	// o - Describes the Buffer whose memory requirements are queried
+	//
+	// out - A pre-allocated object in which the memory requirements of the Buffer will be
+	// populated. It should include any desired chained OutData objects
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkGetDeviceBufferMemoryRequirements.html
+	DeviceBufferMemoryRequirements(o DeviceBufferMemoryRequirements, out *core1_1.MemoryRequirements2) error
+	// DeviceImageMemoryRequirements returns the memory requirements for an Image without
+	// creating it
+	//
+	// o - Describes the Image whose memory requirements are queried
+	//
+	// out - A pre-allocated object in which the memory requirements of the Image will be
+	// populated. It should include any desired chained OutData objects
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkGetDeviceImageMemoryRequirements.html
+	DeviceImageMemoryRequirements(o DeviceImageMemoryRequirements, out *core1_1.MemoryRequirements2) error
+	// DeviceImageSparseMemoryRequirements queries the memory requirements for a sparse Image
+	// without creating it
+	//
+	// o - Describes the Image whose memory requirements are queried
+	//
+	// outDataFactory - This method can be provided to allocate each SparseImageMemoryRequirements2 object
+	// that is returned, along with any chained OutData structures. It can also be left nil, in which case
+	// SparseImageMemoryRequirements2 will be allocated with no chained structures.
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkGetDeviceImageSparseMemoryRequirements.html
+	DeviceImageSparseMemoryRequirements(o DeviceImageMemoryRequirements, outDataFactory func() *core1_1.SparseImageMemoryRequirements2) ([]*core1_1.SparseImageMemoryRequirements2, error)
+
+	// CreatePrivateDataSlot creates a slot for private data storage
+	//
+	// o - Parameters controlling the creation of the PrivateDataSlot
+	//
+	// allocator - Controls host memory allocation
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkCreatePrivateDataSlot.html
+	CreatePrivateDataSlot(o PrivateDataSlotCreateInfo, allocator *driver.AllocationCallbacks) (PrivateDataSlot, common.VkResult, error)
+	// SetPrivateData associates data with a Vulkan object
+	//
+	// objectType - The type of the object to associate data with
+	//
+	// objectHandle - The handle of the object to associate data with. It does not need to belong
+	// to an object wrapped by this library, so objects created by unwrapped extensions may be used
+	//
+	// privateDataSlot - The PrivateDataSlot to store the data in
+	//
+	// data - The data to associate with the object
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkSetPrivateData.html
+	SetPrivateData(objectType core1_0.ObjectType, objectHandle driver.VulkanHandle, privateDataSlot PrivateDataSlot, data uint64) (common.VkResult, error)
+	// GetPrivateData retrieves data associated with a Vulkan object. If no data has been set
+	// for the object in this PrivateDataSlot, 0 is returned
+	//
+	// objectType - The type of the object to retrieve data for
+	//
+	// objectHandle - The handle of the object to retrieve data for
+	//
+	// privateDataSlot - The PrivateDataSlot the data was stored in
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkGetPrivateData.html
+	GetPrivateData(objectType core1_0.ObjectType, objectHandle driver.VulkanHandle, privateDataSlot PrivateDataSlot) uint64
+}
+
+// PrivateDataSlot is an opaque handle to a slot that can be used to attach a 64-bit value to
+// any Vulkan object owned by a Device
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPrivateDataSlot.html
+type PrivateDataSlot interface {
+	// Handle is the internal Vulkan object handle for this PrivateDataSlot
+	Handle() driver.VkPrivateDataSlot
+	// DeviceHandle is the internal Vulkan object handle for the Device this PrivateDataSlot
+	// belongs to
+	DeviceHandle() driver.VkDevice
+	// Driver is the Vulkan wrapper driver used by this PrivateDataSlot
+	Driver() driver.Driver
+	// APIVersion is the maximum Vulkan API version supported by this PrivateDataSlot
+	APIVersion() common.APIVersion
+
+	// Destroy destroys the PrivateDataSlot object and the underlying structures. **Warning** after
+	// destruction, this object will continue to exist, but the Vulkan object handle that backs it will
+	// be invalid. Do not call further methods on this object.
+	//
+	// allocator - Controls host memory deallocation
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/vkDestroyPrivateDataSlot.html
+	Destroy(allocator *driver.AllocationCallbacks)
+}
+
+// Instance stores per-application state for Vulkan
+//
+// This interface includes all commands included in Vulkan 1.3.
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkInstance.html
+type Instance interface {
+	core1_2.Instance
+}
+
+// InstanceScopedPhysicalDevice represents the instance-scoped functionality of a single complete
+// implementation of Vulkan available to the host, of which there are a finite number.
+//
+// This interface includes all instance-scoped commands included in Vulkan 1.3.
+//
+// PhysicalDevice objects are unusual in that they exist between the Instance and (logical) Device level.
+// As a result, PhysicalDevice is the only object that can be extended by both Instance and Device
+// extensions. Consequently, there are some unusual cases in which a higher core version may be available
+// for some PhysicalDevice functionality but not others. In order to represent this, physical devices
+// are split into two objects at core1.1+, the PhysicalDevice and the "instance-scoped" PhysicalDevice.
+//
+// The InstanceScopedPhysicalDevice is usually available at the same core versions as PhysicalDevice, but
+// in rare cases, a higher core version may be available.
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPhysicalDevice.html
+type InstanceScopedPhysicalDevice interface {
+	core1_2.InstanceScopedPhysicalDevice
+}
+
+// PhysicalDevice represents the device-scoped functionality of a single complete
+// implementation of Vulkan available to the host, of which there are a finite number.
+//
+// This interface includes all commands included in Vulkan 1.3.
+//
+// PhysicalDevice objects are unusual in that they exist between the Instance and (logical) Device level.
+// As a result, PhysicalDevice is the only object that can be extended by both Instance and Device
+// extensions. Consequently, there are some unusual cases in which a higher core version may be available
+// for some PhysicalDevice functionality but not others. In order to represent this, physical devices
+// are split into two objects at core1.1+, the PhysicalDevice and the "instance-scoped" PhysicalDevice.
+//
+// The InstanceScopedPhysicalDevice is usually available at the same core versions as PhysicalDevice, but
+// in rare cases, a higher core version may be available.
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPhysicalDevice.html
+type PhysicalDevice interface {
+	core1_2.PhysicalDevice
+
+	// InstanceScopedPhysicalDevice1_3 returns the InstanceScopedPhysicalDevice that represents the
+	// instance-scoped portion of this PhysicalDevice object's functionality. Since the instance-scoped
+	// support is always equal-to-or-greater-than the device-scoped support, this method will always
+	// return a functioning InstanceScopedPhysicalDevice
+	InstanceScopedPhysicalDevice1_3() InstanceScopedPhysicalDevice
+
+	// ToolProperties reports the tools, such as validation layers and capture tools, that are
+	// currently active for this PhysicalDevice
+	//
+	// outDataFactory - This method can be provided to allocate each PhysicalDeviceToolProperties object
+	// that is returned, along with any chained OutData structures. It can also be left nil, in which case
+	// PhysicalDeviceToolProperties will be allocated with no chained structures.
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkGetPhysicalDeviceToolProperties.html
+	ToolProperties(outDataFactory func() *PhysicalDeviceToolProperties) ([]*PhysicalDeviceToolProperties, common.VkResult, error)
+	// MergedFormatProperties lists the PhysicalDevice object's format capabilities as 64-bit
+	// FormatFeatureFlags2, combining the legacy core1_0.FormatFeatureFlags results with those
+	// reported through FormatProperties3
+	//
+	// format - The format whose properties are queried
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkFormatProperties3.html
+	MergedFormatProperties(format core1_0.Format) (*FormatProperties3, error)
+	// SupportsFormatFeatures reports whether Image objects of the provided format and tiling
+	// support all of the requested features. Only core1_0.ImageTilingLinear and
+	// core1_0.ImageTilingOptimal are supported
+	//
+	// format - The format whose properties are queried
+	//
+	// tiling - The Image tiling whose features should be checked
+	//
+	// features - The features that must all be supported
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkFormatProperties3.html
+	SupportsFormatFeatures(format core1_0.Format, tiling core1_0.ImageTiling, features FormatFeatureFlags2) (bool, error)
+}
+
+// Queue represents a Device resource on which work is performed
+//
+// This interface includes all commands included in Vulkan 1.3.
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkQueue.html
+type Queue interface {
+	core1_2.Queue
+
+	// Submit2 submits a sequence of Semaphore or CommandBuffer objects to this queue
+	//
+	// fence - An optional Fence object to be signaled once all submitted CommandBuffer objects have
+	// completed execution.
+	//
+	// submits - A slice of SubmitInfo2 structures, each specifying a CommandBuffer submission batch
+	//
+	// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkQueueSubmit2.html
+	Submit2(fence core1_0.Fence, submits []SubmitInfo2) (common.VkResult, error)
+}
@@ -0,0 +1,38 @@
+package core1_3
+
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_2"
+	"github.com/vkngwrapper/core/v2/driver"
+)
+
+// VulkanInstance is an implementation of the Instance interface that actually communicates with Vulkan. This
+// is the default implementation. See the interface for more documentation.
+type VulkanInstance struct {
+	core1_2.Instance
+}
+
+// PromoteInstance accepts an Instance object from any core version. If provided an instance that supports
+// at least core 1.3, it will return a core1_3.Instance. Otherwise, it will return nil. This method
+// will always return a core1_3.VulkanInstance, even if it is provided a VulkanInstance from a higher
+// core version. Two Vulkan 1.3 compatible Instance objects with the same Instance.Handle will
+// return the same interface value when passed to this method.
+func PromoteInstance(instance core1_0.Instance) Instance {
+	if instance == nil {
+		return nil
+	}
+	if !instance.APIVersion().IsAtLeast(common.Vulkan1_3) {
+		return nil
+	}
+
+	promotedInstance := core1_2.PromoteInstance(instance)
+	return instance.Driver().ObjectStore().GetOrCreate(
+		driver.VulkanHandle(instance.Handle()),
+		driver.Core1_3,
+		func() any {
+			return &VulkanInstance{
+				Instance: promotedInstance,
+			}
+		}).(Instance)
+}
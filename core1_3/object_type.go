@@ -0,0 +1,19 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import "github.com/vkngwrapper/core/v2/core1_0"
+
+const (
+	// ObjectTypePrivateDataSlot specifies a PrivateDataSlot handle
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkObjectType.html
+	ObjectTypePrivateDataSlot core1_0.ObjectType = C.VK_OBJECT_TYPE_PRIVATE_DATA_SLOT
+)
+
+func init() {
+	ObjectTypePrivateDataSlot.Register("Private Data Slot")
+}
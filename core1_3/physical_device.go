@@ -0,0 +1,187 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/core1_2"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+// VulkanPhysicalDevice is an implementation of the PhysicalDevice interface that actually communicates with Vulkan. This
+// is the default implementation. See the interface for more documentation.
+type VulkanPhysicalDevice struct {
+	core1_2.PhysicalDevice
+
+	InstanceScoped1_3 InstanceScopedPhysicalDevice
+}
+
+func (p *VulkanPhysicalDevice) InstanceScopedPhysicalDevice1_3() InstanceScopedPhysicalDevice {
+	return p.InstanceScoped1_3
+}
+
+// PromotePhysicalDevice accepts a PhysicalDevice object from any core version. If provided a physical device that supports
+// at least core 1.3 for its device-scoped functionality, it will return a core1_3.PhysicalDevice. Otherwise, it will return nil. This method
+// will always return a core1_3.VulkanPhysicalDevice, even if it is provided a VulkanPhysicalDevice from a higher
+// core version. Two Vulkan 1.3 compatible PhysicalDevice objects with the same PhysicalDevice.Handle will
+// return the same interface value when passed to this method.
+func PromotePhysicalDevice(physicalDevice core1_0.PhysicalDevice) PhysicalDevice {
+	if physicalDevice == nil {
+		return nil
+	}
+	if !physicalDevice.DeviceAPIVersion().IsAtLeast(common.Vulkan1_3) {
+		return nil
+	}
+
+	instanceScoped := PromoteInstanceScopedPhysicalDevice(physicalDevice)
+	promotedPhysicalDevice := core1_2.PromotePhysicalDevice(physicalDevice)
+
+	return physicalDevice.Driver().ObjectStore().GetOrCreate(
+		driver.VulkanHandle(physicalDevice.Handle()),
+		driver.Core1_3,
+		func() any {
+			return &VulkanPhysicalDevice{
+				PhysicalDevice: promotedPhysicalDevice,
+
+				InstanceScoped1_3: instanceScoped,
+			}
+		}).(PhysicalDevice)
+}
+
+// VulkanInstanceScopedPhysicalDevice is an implementation of the InstanceScopedPhysicalDevice interface that actually communicates with Vulkan. This
+// is the default implementation. See the interface for more documentation.
+type VulkanInstanceScopedPhysicalDevice struct {
+	core1_2.InstanceScopedPhysicalDevice
+}
+
+// PromoteInstanceScopedPhysicalDevice accepts a InstanceScopedPhysicalDevice object from any core version. If provided an instance-scoped physical device that supports
+// at least core 1.3 for its instance-scoped functionality, it will return a core1_3.InstanceScopedPhysicalDevice. Otherwise, it will return nil. This method
+// will always return a core1_3.VulkanInstanceScopedPhysicalDevice, even if it is provided a VulkanInstanceScopedPhysicalDevice from a higher
+// core version. Two Vulkan 1.3 compatible InstanceScopedPhysicalDevice objects with the same InstanceScopedPhysicalDevice.Handle will
+// return the same interface value when passed to this method.
+func PromoteInstanceScopedPhysicalDevice(physicalDevice core1_0.PhysicalDevice) InstanceScopedPhysicalDevice {
+	if physicalDevice == nil {
+		return nil
+	}
+	if !physicalDevice.InstanceAPIVersion().IsAtLeast(common.Vulkan1_3) {
+		return nil
+	}
+
+	promotedPhysicalDevice := core1_2.PromoteInstanceScopedPhysicalDevice(physicalDevice)
+	return physicalDevice.Driver().ObjectStore().GetOrCreate(
+		driver.VulkanHandle(physicalDevice.Handle()),
+		driver.Core1_3InstanceScope,
+		func() any {
+			return &VulkanInstanceScopedPhysicalDevice{
+				InstanceScopedPhysicalDevice: promotedPhysicalDevice,
+			}
+		}).(InstanceScopedPhysicalDevice)
+}
+
+func (p *VulkanPhysicalDevice) ToolProperties(outDataFactory func() *PhysicalDeviceToolProperties) ([]*PhysicalDeviceToolProperties, common.VkResult, error) {
+	var outData []*PhysicalDeviceToolProperties
+	var result common.VkResult
+	var err error
+
+	for doWhile := true; doWhile; doWhile = (result == core1_0.VKIncomplete) {
+		outData, result, err = p.attemptToolProperties(outDataFactory)
+	}
+	return outData, result, err
+}
+
+func (p *VulkanPhysicalDevice) attemptToolProperties(outDataFactory func() *PhysicalDeviceToolProperties) ([]*PhysicalDeviceToolProperties, common.VkResult, error) {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	countPtr := (*driver.Uint32)(arena.Malloc(int(unsafe.Sizeof(C.uint32_t(0)))))
+
+	res, err := p.Driver().VkGetPhysicalDeviceToolProperties(
+		p.Handle(),
+		countPtr,
+		nil,
+	)
+	if err != nil {
+		return nil, res, err
+	}
+
+	count := int(*countPtr)
+	if count == 0 {
+		return nil, core1_0.VKSuccess, nil
+	}
+
+	outDataSlice := make([]*PhysicalDeviceToolProperties, count)
+	for i := 0; i < count; i++ {
+		if outDataFactory != nil {
+			outDataSlice[i] = outDataFactory()
+		} else {
+			outDataSlice[i] = &PhysicalDeviceToolProperties{}
+		}
+	}
+
+	outData, err := common.AllocOutDataHeaderSlice[C.VkPhysicalDeviceToolProperties, *PhysicalDeviceToolProperties](arena, outDataSlice)
+	if err != nil {
+		return nil, core1_0.VKErrorUnknown, err
+	}
+
+	res, err = p.Driver().VkGetPhysicalDeviceToolProperties(
+		p.Handle(),
+		countPtr,
+		(*driver.VkPhysicalDeviceToolProperties)(unsafe.Pointer(outData)),
+	)
+	if err != nil {
+		return nil, res, err
+	}
+
+	err = common.PopulateOutDataSlice[C.VkPhysicalDeviceToolProperties, *PhysicalDeviceToolProperties](outDataSlice, unsafe.Pointer(outData))
+	if err != nil {
+		return nil, core1_0.VKErrorUnknown, err
+	}
+
+	return outDataSlice, res, nil
+}
+
+func (p *VulkanPhysicalDevice) MergedFormatProperties(format core1_0.Format) (*FormatProperties3, error) {
+	properties3 := &FormatProperties3{}
+	properties2 := &core1_1.FormatProperties2{
+		NextOutData: common.NextOutData{Next: properties3},
+	}
+
+	err := p.InstanceScopedPhysicalDevice1_3().FormatProperties2(format, properties2)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every legacy bit has the same value in FormatFeatureFlags2, so the legacy results can be
+	// folded in directly. This covers implementations that leave FormatProperties3 unpopulated.
+	properties3.LinearTilingFeatures |= FormatFeatureFlags2(uint32(properties2.FormatProperties.LinearTilingFeatures))
+	properties3.OptimalTilingFeatures |= FormatFeatureFlags2(uint32(properties2.FormatProperties.OptimalTilingFeatures))
+	properties3.BufferFeatures |= FormatFeatureFlags2(uint32(properties2.FormatProperties.BufferFeatures))
+
+	return properties3, nil
+}
+
+func (p *VulkanPhysicalDevice) SupportsFormatFeatures(format core1_0.Format, tiling core1_0.ImageTiling, features FormatFeatureFlags2) (bool, error) {
+	if tiling != core1_0.ImageTilingLinear && tiling != core1_0.ImageTilingOptimal {
+		return false, errors.Newf("cannot query format features for unknown image tiling %s", tiling)
+	}
+
+	properties, err := p.MergedFormatProperties(format)
+	if err != nil {
+		return false, err
+	}
+
+	supported := properties.OptimalTilingFeatures
+	if tiling == core1_0.ImageTilingLinear {
+		supported = properties.LinearTilingFeatures
+	}
+
+	return supported&features == features, nil
+}
@@ -0,0 +1,281 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"unsafe"
+)
+
+// PhysicalDeviceVulkan13Properties specifies PhysicalDevice properties for functionality
+// promoted to Vulkan 1.3
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPhysicalDeviceVulkan13Properties.html
+type PhysicalDeviceVulkan13Properties struct {
+	// MinSubgroupSize is the minimum subgroup size supported by this device
+	MinSubgroupSize int
+	// MaxSubgroupSize is the maximum subgroup size supported by this device
+	MaxSubgroupSize int
+	// MaxComputeWorkgroupSubgroups is the maximum number of subgroups supported by the
+	// implementation within a workgroup
+	MaxComputeWorkgroupSubgroups int
+	// RequiredSubgroupSizeStages is a bitfield of which shader stages support having a
+	// required subgroup size specified
+	RequiredSubgroupSizeStages core1_0.ShaderStageFlags
+
+	// MaxInlineUniformBlockSize is the maximum size in bytes of an inline uniform block
+	// binding
+	MaxInlineUniformBlockSize int
+	// MaxPerStageDescriptorInlineUniformBlocks is the maximum number of inline uniform block
+	// bindings that can be accessible to a single shader stage in a PipelineLayout
+	MaxPerStageDescriptorInlineUniformBlocks int
+	// MaxPerStageDescriptorUpdateAfterBindInlineUniformBlocks is similar to
+	// MaxPerStageDescriptorInlineUniformBlocks but counts descriptor bindings from DescriptorSet objects
+	// created with or without DescriptorSetLayoutCreateUpdateAfterBindPool
+	MaxPerStageDescriptorUpdateAfterBindInlineUniformBlocks int
+	// MaxDescriptorSetInlineUniformBlocks is the maximum number of inline uniform block
+	// bindings that can be included in descriptor bindings in a PipelineLayout across all pipeline
+	// shader stages and DescriptorSet numbers
+	MaxDescriptorSetInlineUniformBlocks int
+	// MaxDescriptorSetUpdateAfterBindInlineUniformBlocks is similar to
+	// MaxDescriptorSetInlineUniformBlocks but counts descriptor bindings from DescriptorSet objects
+	// created with or without DescriptorSetLayoutCreateUpdateAfterBindPool
+	MaxDescriptorSetUpdateAfterBindInlineUniformBlocks int
+	// MaxInlineUniformTotalSize is the maximum total size in bytes of all inline uniform
+	// block bindings, across all pipeline shader stages and DescriptorSet numbers, that can be included
+	// in a PipelineLayout
+	MaxInlineUniformTotalSize int
+
+	// IntegerDotProduct8BitUnsignedAccelerated indicates whether unsigned dot product operations
+	// on 8-bit vectors are accelerated
+	IntegerDotProduct8BitUnsignedAccelerated bool
+	// IntegerDotProduct8BitSignedAccelerated indicates whether signed dot product operations
+	// on 8-bit vectors are accelerated
+	IntegerDotProduct8BitSignedAccelerated bool
+	// IntegerDotProduct8BitMixedSignednessAccelerated indicates whether mixed signedness dot product operations
+	// on 8-bit vectors are accelerated
+	IntegerDotProduct8BitMixedSignednessAccelerated bool
+	// IntegerDotProduct4x8BitPackedUnsignedAccelerated indicates whether unsigned dot product operations
+	// on 4x8-bit packed vectors are accelerated
+	IntegerDotProduct4x8BitPackedUnsignedAccelerated bool
+	// IntegerDotProduct4x8BitPackedSignedAccelerated indicates whether signed dot product operations
+	// on 4x8-bit packed vectors are accelerated
+	IntegerDotProduct4x8BitPackedSignedAccelerated bool
+	// IntegerDotProduct4x8BitPackedMixedSignednessAccelerated indicates whether mixed signedness dot product operations
+	// on 4x8-bit packed vectors are accelerated
+	IntegerDotProduct4x8BitPackedMixedSignednessAccelerated bool
+	// IntegerDotProduct16BitUnsignedAccelerated indicates whether unsigned dot product operations
+	// on 16-bit vectors are accelerated
+	IntegerDotProduct16BitUnsignedAccelerated bool
+	// IntegerDotProduct16BitSignedAccelerated indicates whether signed dot product operations
+	// on 16-bit vectors are accelerated
+	IntegerDotProduct16BitSignedAccelerated bool
+	// IntegerDotProduct16BitMixedSignednessAccelerated indicates whether mixed signedness dot product operations
+	// on 16-bit vectors are accelerated
+	IntegerDotProduct16BitMixedSignednessAccelerated bool
+	// IntegerDotProduct32BitUnsignedAccelerated indicates whether unsigned dot product operations
+	// on 32-bit vectors are accelerated
+	IntegerDotProduct32BitUnsignedAccelerated bool
+	// IntegerDotProduct32BitSignedAccelerated indicates whether signed dot product operations
+	// on 32-bit vectors are accelerated
+	IntegerDotProduct32BitSignedAccelerated bool
+	// IntegerDotProduct32BitMixedSignednessAccelerated indicates whether mixed signedness dot product operations
+	// on 32-bit vectors are accelerated
+	IntegerDotProduct32BitMixedSignednessAccelerated bool
+	// IntegerDotProduct64BitUnsignedAccelerated indicates whether unsigned dot product operations
+	// on 64-bit vectors are accelerated
+	IntegerDotProduct64BitUnsignedAccelerated bool
+	// IntegerDotProduct64BitSignedAccelerated indicates whether signed dot product operations
+	// on 64-bit vectors are accelerated
+	IntegerDotProduct64BitSignedAccelerated bool
+	// IntegerDotProduct64BitMixedSignednessAccelerated indicates whether mixed signedness dot product operations
+	// on 64-bit vectors are accelerated
+	IntegerDotProduct64BitMixedSignednessAccelerated bool
+	// IntegerDotProductAccumulatingSaturating8BitUnsignedAccelerated indicates whether unsigned accumulating saturating dot product operations
+	// on 8-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating8BitUnsignedAccelerated bool
+	// IntegerDotProductAccumulatingSaturating8BitSignedAccelerated indicates whether signed accumulating saturating dot product operations
+	// on 8-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating8BitSignedAccelerated bool
+	// IntegerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated indicates whether mixed signedness accumulating saturating dot product operations
+	// on 8-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated bool
+	// IntegerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated indicates whether unsigned accumulating saturating dot product operations
+	// on 4x8-bit packed vectors are accelerated
+	IntegerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated bool
+	// IntegerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated indicates whether signed accumulating saturating dot product operations
+	// on 4x8-bit packed vectors are accelerated
+	IntegerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated bool
+	// IntegerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated indicates whether mixed signedness accumulating saturating dot product operations
+	// on 4x8-bit packed vectors are accelerated
+	IntegerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated bool
+	// IntegerDotProductAccumulatingSaturating16BitUnsignedAccelerated indicates whether unsigned accumulating saturating dot product operations
+	// on 16-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating16BitUnsignedAccelerated bool
+	// IntegerDotProductAccumulatingSaturating16BitSignedAccelerated indicates whether signed accumulating saturating dot product operations
+	// on 16-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating16BitSignedAccelerated bool
+	// IntegerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated indicates whether mixed signedness accumulating saturating dot product operations
+	// on 16-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated bool
+	// IntegerDotProductAccumulatingSaturating32BitUnsignedAccelerated indicates whether unsigned accumulating saturating dot product operations
+	// on 32-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating32BitUnsignedAccelerated bool
+	// IntegerDotProductAccumulatingSaturating32BitSignedAccelerated indicates whether signed accumulating saturating dot product operations
+	// on 32-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating32BitSignedAccelerated bool
+	// IntegerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated indicates whether mixed signedness accumulating saturating dot product operations
+	// on 32-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated bool
+	// IntegerDotProductAccumulatingSaturating64BitUnsignedAccelerated indicates whether unsigned accumulating saturating dot product operations
+	// on 64-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating64BitUnsignedAccelerated bool
+	// IntegerDotProductAccumulatingSaturating64BitSignedAccelerated indicates whether signed accumulating saturating dot product operations
+	// on 64-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating64BitSignedAccelerated bool
+	// IntegerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated indicates whether mixed signedness accumulating saturating dot product operations
+	// on 64-bit vectors are accelerated
+	IntegerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated bool
+
+	// StorageTexelBufferOffsetAlignmentBytes is a byte alignment that is sufficient for
+	// a storage texel buffer of any format
+	StorageTexelBufferOffsetAlignmentBytes uint64
+	// StorageTexelBufferOffsetSingleTexelAlignment indicates whether single texel alignment
+	// is sufficient for a storage texel buffer of any format
+	StorageTexelBufferOffsetSingleTexelAlignment bool
+	// UniformTexelBufferOffsetAlignmentBytes is a byte alignment that is sufficient for
+	// a uniform texel buffer of any format
+	UniformTexelBufferOffsetAlignmentBytes uint64
+	// UniformTexelBufferOffsetSingleTexelAlignment indicates whether single texel alignment
+	// is sufficient for a uniform texel buffer of any format
+	UniformTexelBufferOffsetSingleTexelAlignment bool
+	// MaxBufferSize is the maximum size of a Buffer that can be created
+	MaxBufferSize uint64
+
+	common.NextOutData
+}
+
+func (o *PhysicalDeviceVulkan13Properties) PopulateHeader(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(C.sizeof_struct_VkPhysicalDeviceVulkan13Properties)
+	}
+
+	outData := (*C.VkPhysicalDeviceVulkan13Properties)(preallocatedPointer)
+	outData.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_PROPERTIES
+	outData.pNext = next
+
+	return preallocatedPointer, nil
+}
+
+func (o *PhysicalDeviceVulkan13Properties) PopulateOutData(cDataPointer unsafe.Pointer, helpers ...any) (next unsafe.Pointer, err error) {
+	outData := (*C.VkPhysicalDeviceVulkan13Properties)(cDataPointer)
+
+	o.MinSubgroupSize = int(outData.minSubgroupSize)
+	o.MaxSubgroupSize = int(outData.maxSubgroupSize)
+	o.MaxComputeWorkgroupSubgroups = int(outData.maxComputeWorkgroupSubgroups)
+	o.RequiredSubgroupSizeStages = core1_0.ShaderStageFlags(outData.requiredSubgroupSizeStages)
+
+	o.MaxInlineUniformBlockSize = int(outData.maxInlineUniformBlockSize)
+	o.MaxPerStageDescriptorInlineUniformBlocks = int(outData.maxPerStageDescriptorInlineUniformBlocks)
+	o.MaxPerStageDescriptorUpdateAfterBindInlineUniformBlocks = int(outData.maxPerStageDescriptorUpdateAfterBindInlineUniformBlocks)
+	o.MaxDescriptorSetInlineUniformBlocks = int(outData.maxDescriptorSetInlineUniformBlocks)
+	o.MaxDescriptorSetUpdateAfterBindInlineUniformBlocks = int(outData.maxDescriptorSetUpdateAfterBindInlineUniformBlocks)
+	o.MaxInlineUniformTotalSize = int(outData.maxInlineUniformTotalSize)
+
+	o.IntegerDotProduct8BitUnsignedAccelerated = outData.integerDotProduct8BitUnsignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct8BitSignedAccelerated = outData.integerDotProduct8BitSignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct8BitMixedSignednessAccelerated = outData.integerDotProduct8BitMixedSignednessAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct4x8BitPackedUnsignedAccelerated = outData.integerDotProduct4x8BitPackedUnsignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct4x8BitPackedSignedAccelerated = outData.integerDotProduct4x8BitPackedSignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct4x8BitPackedMixedSignednessAccelerated = outData.integerDotProduct4x8BitPackedMixedSignednessAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct16BitUnsignedAccelerated = outData.integerDotProduct16BitUnsignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct16BitSignedAccelerated = outData.integerDotProduct16BitSignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct16BitMixedSignednessAccelerated = outData.integerDotProduct16BitMixedSignednessAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct32BitUnsignedAccelerated = outData.integerDotProduct32BitUnsignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct32BitSignedAccelerated = outData.integerDotProduct32BitSignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct32BitMixedSignednessAccelerated = outData.integerDotProduct32BitMixedSignednessAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct64BitUnsignedAccelerated = outData.integerDotProduct64BitUnsignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct64BitSignedAccelerated = outData.integerDotProduct64BitSignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProduct64BitMixedSignednessAccelerated = outData.integerDotProduct64BitMixedSignednessAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating8BitUnsignedAccelerated = outData.integerDotProductAccumulatingSaturating8BitUnsignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating8BitSignedAccelerated = outData.integerDotProductAccumulatingSaturating8BitSignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated = outData.integerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated = outData.integerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated = outData.integerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated = outData.integerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating16BitUnsignedAccelerated = outData.integerDotProductAccumulatingSaturating16BitUnsignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating16BitSignedAccelerated = outData.integerDotProductAccumulatingSaturating16BitSignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated = outData.integerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating32BitUnsignedAccelerated = outData.integerDotProductAccumulatingSaturating32BitUnsignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating32BitSignedAccelerated = outData.integerDotProductAccumulatingSaturating32BitSignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated = outData.integerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating64BitUnsignedAccelerated = outData.integerDotProductAccumulatingSaturating64BitUnsignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating64BitSignedAccelerated = outData.integerDotProductAccumulatingSaturating64BitSignedAccelerated != C.VkBool32(0)
+	o.IntegerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated = outData.integerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated != C.VkBool32(0)
+
+	o.StorageTexelBufferOffsetAlignmentBytes = uint64(outData.storageTexelBufferOffsetAlignmentBytes)
+	o.StorageTexelBufferOffsetSingleTexelAlignment = outData.storageTexelBufferOffsetSingleTexelAlignment != C.VkBool32(0)
+	o.UniformTexelBufferOffsetAlignmentBytes = uint64(outData.uniformTexelBufferOffsetAlignmentBytes)
+	o.UniformTexelBufferOffsetSingleTexelAlignment = outData.uniformTexelBufferOffsetSingleTexelAlignment != C.VkBool32(0)
+	o.MaxBufferSize = uint64(outData.maxBufferSize)
+
+	return outData.pNext, nil
+}
+
+////
+
+// PhysicalDeviceInlineUniformBlockProperties describes inline uniform block properties that can
+// be supported by an implementation
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPhysicalDeviceInlineUniformBlockProperties.html
+type PhysicalDeviceInlineUniformBlockProperties struct {
+	// MaxInlineUniformBlockSize is the maximum size in bytes of an inline uniform block
+	// binding
+	MaxInlineUniformBlockSize int
+	// MaxPerStageDescriptorInlineUniformBlocks is the maximum number of inline uniform block
+	// bindings that can be accessible to a single shader stage in a PipelineLayout
+	MaxPerStageDescriptorInlineUniformBlocks int
+	// MaxPerStageDescriptorUpdateAfterBindInlineUniformBlocks is similar to
+	// MaxPerStageDescriptorInlineUniformBlocks but counts descriptor bindings from DescriptorSet objects
+	// created with or without DescriptorSetLayoutCreateUpdateAfterBindPool
+	MaxPerStageDescriptorUpdateAfterBindInlineUniformBlocks int
+	// MaxDescriptorSetInlineUniformBlocks is the maximum number of inline uniform block
+	// bindings that can be included in descriptor bindings in a PipelineLayout across all pipeline
+	// shader stages and DescriptorSet numbers
+	MaxDescriptorSetInlineUniformBlocks int
+	// MaxDescriptorSetUpdateAfterBindInlineUniformBlocks is similar to
+	// MaxDescriptorSetInlineUniformBlocks but counts descriptor bindings from DescriptorSet objects
+	// created with or without DescriptorSetLayoutCreateUpdateAfterBindPool
+	MaxDescriptorSetUpdateAfterBindInlineUniformBlocks int
+
+	common.NextOutData
+}
+
+func (o *PhysicalDeviceInlineUniformBlockProperties) PopulateHeader(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(C.sizeof_struct_VkPhysicalDeviceInlineUniformBlockProperties)
+	}
+
+	outData := (*C.VkPhysicalDeviceInlineUniformBlockProperties)(preallocatedPointer)
+	outData.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_PROPERTIES
+	outData.pNext = next
+
+	return preallocatedPointer, nil
+}
+
+func (o *PhysicalDeviceInlineUniformBlockProperties) PopulateOutData(cDataPointer unsafe.Pointer, helpers ...any) (next unsafe.Pointer, err error) {
+	outData := (*C.VkPhysicalDeviceInlineUniformBlockProperties)(cDataPointer)
+
+	o.MaxInlineUniformBlockSize = int(outData.maxInlineUniformBlockSize)
+	o.MaxPerStageDescriptorInlineUniformBlocks = int(outData.maxPerStageDescriptorInlineUniformBlocks)
+	o.MaxPerStageDescriptorUpdateAfterBindInlineUniformBlocks = int(outData.maxPerStageDescriptorUpdateAfterBindInlineUniformBlocks)
+	o.MaxDescriptorSetInlineUniformBlocks = int(outData.maxDescriptorSetInlineUniformBlocks)
+	o.MaxDescriptorSetUpdateAfterBindInlineUniformBlocks = int(outData.maxDescriptorSetUpdateAfterBindInlineUniformBlocks)
+
+	return outData.pNext, nil
+}
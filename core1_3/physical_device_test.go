@@ -0,0 +1,302 @@
+package core1_3_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/common/extensions"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"github.com/vkngwrapper/core/v2/driver"
+	mock_driver "github.com/vkngwrapper/core/v2/driver/mocks"
+	"github.com/vkngwrapper/core/v2/internal/dummies"
+	"github.com/vkngwrapper/core/v2/mocks"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+func TestPromotePhysicalDevice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	instance := mocks.EasyMockInstance(ctrl, coreDriver)
+	physicalDevice := extensions.CreatePhysicalDeviceObject(coreDriver, instance.Handle(), mocks.NewFakePhysicalDeviceHandle(), common.Vulkan1_3, common.Vulkan1_3)
+
+	promoted := core1_3.PromotePhysicalDevice(physicalDevice)
+	require.NotNil(t, promoted)
+	require.Equal(t, physicalDevice.Handle(), promoted.Handle())
+	require.Same(t, promoted, core1_3.PromotePhysicalDevice(physicalDevice))
+	require.Same(t, core1_3.PromoteInstanceScopedPhysicalDevice(physicalDevice), promoted.InstanceScopedPhysicalDevice1_3())
+}
+
+func TestPromotePhysicalDevice_InstanceScopeOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	instance := mocks.EasyMockInstance(ctrl, coreDriver)
+	physicalDevice := extensions.CreatePhysicalDeviceObject(coreDriver, instance.Handle(), mocks.NewFakePhysicalDeviceHandle(), common.Vulkan1_3, common.Vulkan1_2)
+
+	require.Nil(t, core1_3.PromotePhysicalDevice(physicalDevice))
+	require.NotNil(t, core1_3.PromoteInstanceScopedPhysicalDevice(physicalDevice))
+}
+
+func TestPhysicalDeviceVulkan13OutData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	instance := mocks.EasyMockInstance(ctrl, coreDriver)
+	physicalDevice := core1_3.PromoteInstanceScopedPhysicalDevice(dummies.EasyDummyPhysicalDevice(coreDriver, instance))
+
+	coreDriver.EXPECT().VkGetPhysicalDeviceProperties2(
+		physicalDevice.Handle(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(physicalDevice driver.VkPhysicalDevice, pProperties *driver.VkPhysicalDeviceProperties2) {
+		val := reflect.ValueOf(pProperties).Elem()
+		require.Equal(t, uint64(1000059001), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2
+
+		next := (*driver.VkPhysicalDeviceVulkan13Properties)(val.FieldByName("pNext").UnsafePointer())
+		val = reflect.ValueOf(next).Elem()
+
+		require.Equal(t, uint64(54), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_PROPERTIES
+		require.True(t, val.FieldByName("pNext").IsNil())
+		*(*driver.Uint32)(unsafe.Pointer(val.FieldByName("minSubgroupSize").UnsafeAddr())) = driver.Uint32(3)
+		*(*driver.Uint32)(unsafe.Pointer(val.FieldByName("maxSubgroupSize").UnsafeAddr())) = driver.Uint32(5)
+		*(*driver.Uint32)(unsafe.Pointer(val.FieldByName("maxComputeWorkgroupSubgroups").UnsafeAddr())) = driver.Uint32(7)
+		*(*driver.VkShaderStageFlags)(unsafe.Pointer(val.FieldByName("requiredSubgroupSizeStages").UnsafeAddr())) = driver.VkShaderStageFlags(0x20) // VK_SHADER_STAGE_COMPUTE_BIT
+		*(*driver.Uint32)(unsafe.Pointer(val.FieldByName("maxInlineUniformBlockSize").UnsafeAddr())) = driver.Uint32(13)
+		*(*driver.Uint32)(unsafe.Pointer(val.FieldByName("maxPerStageDescriptorInlineUniformBlocks").UnsafeAddr())) = driver.Uint32(17)
+		*(*driver.Uint32)(unsafe.Pointer(val.FieldByName("maxPerStageDescriptorUpdateAfterBindInlineUniformBlocks").UnsafeAddr())) = driver.Uint32(19)
+		*(*driver.Uint32)(unsafe.Pointer(val.FieldByName("maxDescriptorSetInlineUniformBlocks").UnsafeAddr())) = driver.Uint32(23)
+		*(*driver.Uint32)(unsafe.Pointer(val.FieldByName("maxDescriptorSetUpdateAfterBindInlineUniformBlocks").UnsafeAddr())) = driver.Uint32(29)
+		*(*driver.Uint32)(unsafe.Pointer(val.FieldByName("maxInlineUniformTotalSize").UnsafeAddr())) = driver.Uint32(31)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct8BitUnsignedAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct8BitSignedAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct8BitMixedSignednessAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct4x8BitPackedUnsignedAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct4x8BitPackedSignedAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct4x8BitPackedMixedSignednessAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct16BitUnsignedAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct16BitSignedAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct16BitMixedSignednessAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct32BitUnsignedAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct32BitSignedAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct32BitMixedSignednessAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct64BitUnsignedAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct64BitSignedAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProduct64BitMixedSignednessAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating8BitUnsignedAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating8BitSignedAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating8BitMixedSignednessAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating4x8BitPackedSignedAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating16BitUnsignedAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating16BitSignedAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating16BitMixedSignednessAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating32BitUnsignedAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating32BitSignedAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating64BitUnsignedAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating64BitSignedAccelerated").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("integerDotProductAccumulatingSaturating64BitMixedSignednessAccelerated").UnsafeAddr())) = driver.VkBool32(0)
+		*(*driver.VkDeviceSize)(unsafe.Pointer(val.FieldByName("storageTexelBufferOffsetAlignmentBytes").UnsafeAddr())) = driver.VkDeviceSize(37)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("storageTexelBufferOffsetSingleTexelAlignment").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkDeviceSize)(unsafe.Pointer(val.FieldByName("uniformTexelBufferOffsetAlignmentBytes").UnsafeAddr())) = driver.VkDeviceSize(41)
+		*(*driver.VkBool32)(unsafe.Pointer(val.FieldByName("uniformTexelBufferOffsetSingleTexelAlignment").UnsafeAddr())) = driver.VkBool32(1)
+		*(*driver.VkDeviceSize)(unsafe.Pointer(val.FieldByName("maxBufferSize").UnsafeAddr())) = driver.VkDeviceSize(43)
+	})
+
+	var outData core1_3.PhysicalDeviceVulkan13Properties
+	err := physicalDevice.Properties2(
+		&core1_1.PhysicalDeviceProperties2{
+			NextOutData: common.NextOutData{Next: &outData},
+		})
+	require.NoError(t, err)
+	require.Equal(t, core1_3.PhysicalDeviceVulkan13Properties{
+		MinSubgroupSize:                                                               3,
+		MaxSubgroupSize:                                                               5,
+		MaxComputeWorkgroupSubgroups:                                                  7,
+		RequiredSubgroupSizeStages:                                                    core1_0.StageCompute,
+		MaxInlineUniformBlockSize:                                                     13,
+		MaxPerStageDescriptorInlineUniformBlocks:                                      17,
+		MaxPerStageDescriptorUpdateAfterBindInlineUniformBlocks:                       19,
+		MaxDescriptorSetInlineUniformBlocks:                                           23,
+		MaxDescriptorSetUpdateAfterBindInlineUniformBlocks:                            29,
+		MaxInlineUniformTotalSize:                                                     31,
+		IntegerDotProduct8BitUnsignedAccelerated:                                      true,
+		IntegerDotProduct8BitMixedSignednessAccelerated:                               true,
+		IntegerDotProduct4x8BitPackedSignedAccelerated:                                true,
+		IntegerDotProduct16BitUnsignedAccelerated:                                     true,
+		IntegerDotProduct16BitMixedSignednessAccelerated:                              true,
+		IntegerDotProduct32BitSignedAccelerated:                                       true,
+		IntegerDotProduct64BitUnsignedAccelerated:                                     true,
+		IntegerDotProduct64BitMixedSignednessAccelerated:                              true,
+		IntegerDotProductAccumulatingSaturating8BitSignedAccelerated:                  true,
+		IntegerDotProductAccumulatingSaturating4x8BitPackedUnsignedAccelerated:        true,
+		IntegerDotProductAccumulatingSaturating4x8BitPackedMixedSignednessAccelerated: true,
+		IntegerDotProductAccumulatingSaturating16BitSignedAccelerated:                 true,
+		IntegerDotProductAccumulatingSaturating32BitUnsignedAccelerated:               true,
+		IntegerDotProductAccumulatingSaturating32BitMixedSignednessAccelerated:        true,
+		IntegerDotProductAccumulatingSaturating64BitSignedAccelerated:                 true,
+		StorageTexelBufferOffsetAlignmentBytes:                                        37,
+		StorageTexelBufferOffsetSingleTexelAlignment:                                  true,
+		UniformTexelBufferOffsetAlignmentBytes:                                        41,
+		UniformTexelBufferOffsetSingleTexelAlignment:                                  true,
+		MaxBufferSize: 43,
+	}, outData)
+}
+
+func TestVulkanPhysicalDevice_ToolProperties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	instance := mocks.EasyMockInstance(ctrl, coreDriver)
+	physicalDevice := core1_3.PromotePhysicalDevice(dummies.EasyDummyPhysicalDevice(coreDriver, instance))
+
+	writeString := func(val reflect.Value, field string, str string) {
+		strPtr := (*driver.Char)(unsafe.Pointer(val.FieldByName(field).UnsafeAddr()))
+		strSlice := ([]driver.Char)(unsafe.Slice(strPtr, val.FieldByName(field).Len()))
+		for i, r := range []byte(str) {
+			strSlice[i] = driver.Char(r)
+		}
+		strSlice[len(str)] = 0
+	}
+
+	coreDriver.EXPECT().VkGetPhysicalDeviceToolProperties(
+		physicalDevice.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Nil(),
+	).DoAndReturn(func(physicalDevice driver.VkPhysicalDevice, pToolCount *driver.Uint32, pToolProperties *driver.VkPhysicalDeviceToolProperties) (common.VkResult, error) {
+		*pToolCount = 1
+		return core1_0.VKSuccess, nil
+	})
+	coreDriver.EXPECT().VkGetPhysicalDeviceToolProperties(
+		physicalDevice.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(physicalDevice driver.VkPhysicalDevice, pToolCount *driver.Uint32, pToolProperties *driver.VkPhysicalDeviceToolProperties) (common.VkResult, error) {
+		require.Equal(t, driver.Uint32(1), *pToolCount)
+
+		val := reflect.ValueOf(pToolProperties).Elem()
+		require.Equal(t, uint64(1000245000), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TOOL_PROPERTIES
+		require.True(t, val.FieldByName("pNext").IsNil())
+
+		writeString(val, "name", "Khronos Validation Layer")
+		writeString(val, "version", "1.3.231")
+		writeString(val, "description", "Validates API usage")
+		writeString(val, "layer", "VK_LAYER_KHRONOS_validation")
+		*(*uint32)(unsafe.Pointer(val.FieldByName("purposes").UnsafeAddr())) = uint32(0x21) // VK_TOOL_PURPOSE_VALIDATION_BIT|VK_TOOL_PURPOSE_DEBUG_REPORTING_BIT_EXT
+
+		return core1_0.VKSuccess, nil
+	})
+
+	tools, _, err := physicalDevice.ToolProperties(nil)
+	require.NoError(t, err)
+	require.Equal(t, []*core1_3.PhysicalDeviceToolProperties{
+		{
+			Name:        "Khronos Validation Layer",
+			Version:     "1.3.231",
+			Purposes:    core1_3.ToolPurposeValidation | core1_3.ToolPurposeFlags(0x20),
+			Description: "Validates API usage",
+			Layer:       "VK_LAYER_KHRONOS_validation",
+		},
+	}, tools)
+}
+
+func TestVulkanPhysicalDevice_ToolProperties_Incomplete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	instance := mocks.EasyMockInstance(ctrl, coreDriver)
+	physicalDevice := core1_3.PromotePhysicalDevice(dummies.EasyDummyPhysicalDevice(coreDriver, instance))
+
+	coreDriver.EXPECT().VkGetPhysicalDeviceToolProperties(
+		physicalDevice.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Nil(),
+	).DoAndReturn(func(physicalDevice driver.VkPhysicalDevice, pToolCount *driver.Uint32, pToolProperties *driver.VkPhysicalDeviceToolProperties) (common.VkResult, error) {
+		*pToolCount = 1
+		return core1_0.VKSuccess, nil
+	})
+	coreDriver.EXPECT().VkGetPhysicalDeviceToolProperties(
+		physicalDevice.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Not(gomock.Nil()),
+	).Return(core1_0.VKIncomplete, nil)
+	coreDriver.EXPECT().VkGetPhysicalDeviceToolProperties(
+		physicalDevice.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Nil(),
+	).DoAndReturn(func(physicalDevice driver.VkPhysicalDevice, pToolCount *driver.Uint32, pToolProperties *driver.VkPhysicalDeviceToolProperties) (common.VkResult, error) {
+		*pToolCount = 0
+		return core1_0.VKSuccess, nil
+	})
+
+	tools, res, err := physicalDevice.ToolProperties(nil)
+	require.NoError(t, err)
+	require.Equal(t, core1_0.VKSuccess, res)
+	require.Empty(t, tools)
+}
+
+func TestVulkanPhysicalDevice_FormatProperties3(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	instance := mocks.EasyMockInstance(ctrl, coreDriver)
+	physicalDevice := core1_3.PromotePhysicalDevice(dummies.EasyDummyPhysicalDevice(coreDriver, instance))
+
+	coreDriver.EXPECT().VkGetPhysicalDeviceFormatProperties2(
+		physicalDevice.Handle(),
+		driver.VkFormat(37), // VK_FORMAT_R8G8B8A8_UNORM
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(physicalDevice driver.VkPhysicalDevice,
+		format driver.VkFormat,
+		pFormatProperties *driver.VkFormatProperties2) {
+
+		val := reflect.ValueOf(pFormatProperties).Elem()
+		require.Equal(t, uint64(1000059002), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_FORMAT_PROPERTIES_2
+
+		properties := val.FieldByName("formatProperties")
+		*(*uint32)(unsafe.Pointer(properties.FieldByName("optimalTilingFeatures").UnsafeAddr())) = uint32(0x00000001) // VK_FORMAT_FEATURE_SAMPLED_IMAGE_BIT
+		*(*uint32)(unsafe.Pointer(properties.FieldByName("linearTilingFeatures").UnsafeAddr())) = uint32(0x00000400)  // VK_FORMAT_FEATURE_BLIT_SRC_BIT
+		*(*uint32)(unsafe.Pointer(properties.FieldByName("bufferFeatures").UnsafeAddr())) = uint32(0x00000040)        // VK_FORMAT_FEATURE_VERTEX_BUFFER_BIT
+
+		next := (*driver.VkFormatProperties3)(val.FieldByName("pNext").UnsafePointer())
+		val = reflect.ValueOf(next).Elem()
+		require.Equal(t, uint64(1000360000), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_FORMAT_PROPERTIES_3
+		require.True(t, val.FieldByName("pNext").IsNil())
+
+		*(*uint64)(unsafe.Pointer(val.FieldByName("optimalTilingFeatures").UnsafeAddr())) = uint64(0x180000002) // VK_FORMAT_FEATURE_2_STORAGE_IMAGE_BIT|VK_FORMAT_FEATURE_2_STORAGE_READ_WITHOUT_FORMAT_BIT|VK_FORMAT_FEATURE_2_STORAGE_WRITE_WITHOUT_FORMAT_BIT
+		*(*uint64)(unsafe.Pointer(val.FieldByName("linearTilingFeatures").UnsafeAddr())) = uint64(0x00004000)   // VK_FORMAT_FEATURE_2_TRANSFER_SRC_BIT
+		*(*uint64)(unsafe.Pointer(val.FieldByName("bufferFeatures").UnsafeAddr())) = uint64(0x00000008)         // VK_FORMAT_FEATURE_2_UNIFORM_TEXEL_BUFFER_BIT
+	}).Times(3)
+
+	properties, err := physicalDevice.MergedFormatProperties(core1_0.FormatR8G8B8A8UnsignedNormalized)
+	require.NoError(t, err)
+	require.Equal(t, core1_3.FormatFeature2SampledImage|core1_3.FormatFeature2StorageImage|core1_3.FormatFeature2StorageReadWithoutFormat|core1_3.FormatFeature2StorageWriteWithoutFormat, properties.OptimalTilingFeatures)
+	require.Equal(t, core1_3.FormatFeature2BlitSource|core1_3.FormatFeature2TransferSrc, properties.LinearTilingFeatures)
+	require.Equal(t, core1_3.FormatFeature2VertexBuffer|core1_3.FormatFeature2UniformTexelBuffer, properties.BufferFeatures)
+
+	supported, err := physicalDevice.SupportsFormatFeatures(core1_0.FormatR8G8B8A8UnsignedNormalized, core1_0.ImageTilingOptimal, core1_3.FormatFeature2SampledImage|core1_3.FormatFeature2StorageWriteWithoutFormat)
+	require.NoError(t, err)
+	require.True(t, supported)
+
+	supported, err = physicalDevice.SupportsFormatFeatures(core1_0.FormatR8G8B8A8UnsignedNormalized, core1_0.ImageTilingLinear, core1_3.FormatFeature2StorageWriteWithoutFormat)
+	require.NoError(t, err)
+	require.False(t, supported)
+
+	_, err = physicalDevice.SupportsFormatFeatures(core1_0.FormatR8G8B8A8UnsignedNormalized, core1_0.ImageTiling(7), core1_3.FormatFeature2SampledImage)
+	require.Error(t, err)
+}
+
+func TestFormatFeatureFlags2_String(t *testing.T) {
+	require.Equal(t, "Sampled Image|Storage Read Without Format|Storage Write Without Format", (core1_3.FormatFeature2SampledImage | core1_3.FormatFeature2StorageReadWithoutFormat | core1_3.FormatFeature2StorageWriteWithoutFormat).String())
+}
@@ -0,0 +1,125 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"time"
+	"unsafe"
+)
+
+const (
+	// PipelineCreationFeedbackValid indicates that the feedback information is valid
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineCreationFeedbackFlagBits.html
+	PipelineCreationFeedbackValid PipelineCreationFeedbackFlags = C.VK_PIPELINE_CREATION_FEEDBACK_VALID_BIT
+	// PipelineCreationFeedbackApplicationPipelineCacheHit indicates that a readily usable Pipeline
+	// or pipeline stage was found in the PipelineCache specified by the application in the
+	// Pipeline creation command
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineCreationFeedbackFlagBits.html
+	PipelineCreationFeedbackApplicationPipelineCacheHit PipelineCreationFeedbackFlags = C.VK_PIPELINE_CREATION_FEEDBACK_APPLICATION_PIPELINE_CACHE_HIT_BIT
+	// PipelineCreationFeedbackBasePipelineAcceleration indicates that the base Pipeline specified
+	// by the BasePipeline field in the Pipeline creation info was used to accelerate the creation
+	// of the Pipeline
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineCreationFeedbackFlagBits.html
+	PipelineCreationFeedbackBasePipelineAcceleration PipelineCreationFeedbackFlags = C.VK_PIPELINE_CREATION_FEEDBACK_BASE_PIPELINE_ACCELERATION_BIT
+)
+
+func init() {
+	PipelineCreationFeedbackValid.Register("Valid")
+	PipelineCreationFeedbackApplicationPipelineCacheHit.Register("Application Pipeline Cache Hit")
+	PipelineCreationFeedbackBasePipelineAcceleration.Register("Base Pipeline Acceleration")
+}
+
+// PipelineCreationFeedback receives feedback about the creation of a Pipeline or pipeline stage
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineCreationFeedback.html
+type PipelineCreationFeedback struct {
+	// Flags is a bitmask of PipelineCreationFeedbackFlags providing feedback about the creation
+	// of a Pipeline or pipeline stage. If it does not include PipelineCreationFeedbackValid,
+	// the other fields are not valid
+	Flags PipelineCreationFeedbackFlags
+	// Duration is the time spent creating the Pipeline or pipeline stage
+	Duration time.Duration
+}
+
+////
+
+// PipelineCreationFeedbackCreateInfo requests feedback about the creation of a Pipeline. It can be
+// added to the option chain of a core1_0.GraphicsPipelineCreateInfo or core1_0.ComputePipelineCreateInfo,
+// and the feedback is written to PipelineCreationFeedback and PipelineStageCreationFeedbacks when
+// the Pipeline is created.
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineCreationFeedbackCreateInfo.html
+type PipelineCreationFeedbackCreateInfo struct {
+	// PipelineCreationFeedback receives feedback about the Pipeline as a whole
+	PipelineCreationFeedback *PipelineCreationFeedback
+	// PipelineStageCreationFeedbacks receives feedback about each pipeline stage. It must
+	// either be empty, or have one element for each stage in the create info: one element for
+	// each element in a core1_0.GraphicsPipelineCreateInfo's Stages, or one element for a
+	// core1_0.ComputePipelineCreateInfo
+	PipelineStageCreationFeedbacks []PipelineCreationFeedback
+
+	common.NextOptions
+}
+
+func (o PipelineCreationFeedbackCreateInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.PipelineCreationFeedback == nil {
+		return nil, errors.New("core1_3.PipelineCreationFeedbackCreateInfo.PipelineCreationFeedback cannot be nil")
+	}
+
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkPipelineCreationFeedbackCreateInfo{})))
+	}
+
+	info := (*C.VkPipelineCreationFeedbackCreateInfo)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_PIPELINE_CREATION_FEEDBACK_CREATE_INFO
+	info.pNext = next
+	info.pPipelineCreationFeedback = (*C.VkPipelineCreationFeedback)(allocator.Malloc(int(unsafe.Sizeof(C.VkPipelineCreationFeedback{}))))
+
+	stageCount := len(o.PipelineStageCreationFeedbacks)
+	info.pipelineStageCreationFeedbackCount = C.uint32_t(stageCount)
+	info.pPipelineStageCreationFeedbacks = nil
+
+	if stageCount > 0 {
+		info.pPipelineStageCreationFeedbacks = (*C.VkPipelineCreationFeedback)(allocator.Malloc(stageCount * int(unsafe.Sizeof([1]C.VkPipelineCreationFeedback{}))))
+	}
+
+	return preallocatedPointer, nil
+}
+
+func (o PipelineCreationFeedbackCreateInfo) ValidateStageCount(stageCount int) error {
+	feedbackCount := len(o.PipelineStageCreationFeedbacks)
+	if feedbackCount > 0 && feedbackCount != stageCount {
+		return errors.Newf("core1_3.PipelineCreationFeedbackCreateInfo.PipelineStageCreationFeedbacks has %d elements, but the pipeline create info has %d stages", feedbackCount, stageCount)
+	}
+
+	return nil
+}
+
+func (o PipelineCreationFeedbackCreateInfo) PopulateOptionsOutData(cDataPointer unsafe.Pointer) error {
+	info := (*C.VkPipelineCreationFeedbackCreateInfo)(cDataPointer)
+
+	o.PipelineCreationFeedback.Flags = PipelineCreationFeedbackFlags(info.pPipelineCreationFeedback.flags)
+	o.PipelineCreationFeedback.Duration = time.Duration(info.pPipelineCreationFeedback.duration)
+
+	stageCount := int(info.pipelineStageCreationFeedbackCount)
+	if stageCount == 0 {
+		return nil
+	}
+
+	stageFeedbacks := unsafe.Slice(info.pPipelineStageCreationFeedbacks, stageCount)
+	for i := 0; i < stageCount; i++ {
+		o.PipelineStageCreationFeedbacks[i].Flags = PipelineCreationFeedbackFlags(stageFeedbacks[i].flags)
+		o.PipelineStageCreationFeedbacks[i].Duration = time.Duration(stageFeedbacks[i].duration)
+	}
+
+	return nil
+}
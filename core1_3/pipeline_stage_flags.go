@@ -0,0 +1,149 @@
+package core1_3
+
+const (
+	// PipelineStage2None specifies no stages of execution
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2None PipelineStageFlags2 = 0
+	// PipelineStage2TopOfPipe is equivalent to PipelineStage2None in the second synchronization scope. In the
+	// first scope it is equivalent to PipelineStage2AllCommands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2TopOfPipe PipelineStageFlags2 = 0x1
+	// PipelineStage2DrawIndirect specifies the stage of the Pipeline where indirect command parameters are consumed
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2DrawIndirect PipelineStageFlags2 = 0x2
+	// PipelineStage2VertexInput is equivalent to the logical OR of PipelineStage2IndexInput and
+	// PipelineStage2VertexAttributeInput
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2VertexInput PipelineStageFlags2 = 0x4
+	// PipelineStage2VertexShader specifies the vertex shader stage
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2VertexShader PipelineStageFlags2 = 0x8
+	// PipelineStage2TessellationControlShader specifies the tessellation control shader stage
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2TessellationControlShader PipelineStageFlags2 = 0x10
+	// PipelineStage2TessellationEvaluationShader specifies the tessellation evaluation shader stage
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2TessellationEvaluationShader PipelineStageFlags2 = 0x20
+	// PipelineStage2GeometryShader specifies the geometry shader stage
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2GeometryShader PipelineStageFlags2 = 0x40
+	// PipelineStage2FragmentShader specifies the fragment shader stage
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2FragmentShader PipelineStageFlags2 = 0x80
+	// PipelineStage2EarlyFragmentTests specifies the stage of the Pipeline where early fragment tests
+	// (depth and stencil tests before fragment shading) are performed, including subpass load
+	// operations for depth/stencil attachments
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2EarlyFragmentTests PipelineStageFlags2 = 0x100
+	// PipelineStage2LateFragmentTests specifies the stage of the Pipeline where late fragment tests
+	// (depth and stencil tests after fragment shading) are performed, including subpass store
+	// operations for depth/stencil attachments
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2LateFragmentTests PipelineStageFlags2 = 0x200
+	// PipelineStage2ColorAttachmentOutput specifies the stage of the Pipeline where final color values are
+	// output from the Pipeline, including blending and subpass load and store operations
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2ColorAttachmentOutput PipelineStageFlags2 = 0x400
+	// PipelineStage2ComputeShader specifies the execution of a compute shader
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2ComputeShader PipelineStageFlags2 = 0x800
+	// PipelineStage2AllTransfer is equivalent to the logical OR of PipelineStage2Copy, PipelineStage2Blit,
+	// PipelineStage2Resolve, and PipelineStage2Clear
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2AllTransfer PipelineStageFlags2 = 0x1000
+	// PipelineStage2BottomOfPipe is equivalent to PipelineStage2None in the first synchronization scope. In the
+	// second scope it is equivalent to PipelineStage2AllCommands
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2BottomOfPipe PipelineStageFlags2 = 0x2000
+	// PipelineStage2Host specifies a pseudo-stage indicating execution on the host of reads/writes of
+	// DeviceMemory. This stage is not invoked by any commands recorded in a CommandBuffer
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2Host PipelineStageFlags2 = 0x4000
+	// PipelineStage2AllGraphics specifies the execution of all graphics Pipeline stages
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2AllGraphics PipelineStageFlags2 = 0x8000
+	// PipelineStage2AllCommands specifies all operations performed by all commands supported on the
+	// Queue it is used with
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2AllCommands PipelineStageFlags2 = 0x10000
+	// PipelineStage2Copy specifies the execution of all copy commands, including
+	// CommandBuffer.CmdCopyQueryPoolResults
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2Copy PipelineStageFlags2 = 0x100000000
+	// PipelineStage2Resolve specifies the execution of CommandBuffer.CmdResolveImage
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2Resolve PipelineStageFlags2 = 0x200000000
+	// PipelineStage2Blit specifies the execution of CommandBuffer.CmdBlitImage
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2Blit PipelineStageFlags2 = 0x400000000
+	// PipelineStage2Clear specifies the execution of clear commands, with the exception of
+	// CommandBuffer.CmdClearAttachments
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2Clear PipelineStageFlags2 = 0x800000000
+	// PipelineStage2IndexInput specifies the stage of the Pipeline where index buffers are consumed
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2IndexInput PipelineStageFlags2 = 0x1000000000
+	// PipelineStage2VertexAttributeInput specifies the stage of the Pipeline where vertex buffers are consumed
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2VertexAttributeInput PipelineStageFlags2 = 0x2000000000
+	// PipelineStage2PreRasterizationShaders is equivalent to the logical OR of every shader stage that runs
+	// before rasterization: the vertex, tessellation, and geometry shader stages
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2PreRasterizationShaders PipelineStageFlags2 = 0x4000000000
+	// PipelineStage2Transfer is an alias for PipelineStage2AllTransfer
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineStageFlagBits2.html
+	PipelineStage2Transfer = PipelineStage2AllTransfer
+)
+
+func init() {
+	PipelineStage2TopOfPipe.Register("Top Of Pipe")
+	PipelineStage2DrawIndirect.Register("Draw Indirect")
+	PipelineStage2VertexInput.Register("Vertex Input")
+	PipelineStage2VertexShader.Register("Vertex Shader")
+	PipelineStage2TessellationControlShader.Register("Tessellation Control Shader")
+	PipelineStage2TessellationEvaluationShader.Register("Tessellation Evaluation Shader")
+	PipelineStage2GeometryShader.Register("Geometry Shader")
+	PipelineStage2FragmentShader.Register("Fragment Shader")
+	PipelineStage2EarlyFragmentTests.Register("Early Fragment Tests")
+	PipelineStage2LateFragmentTests.Register("Late Fragment Tests")
+	PipelineStage2ColorAttachmentOutput.Register("Color Attachment Output")
+	PipelineStage2ComputeShader.Register("Compute Shader")
+	PipelineStage2AllTransfer.Register("All Transfer")
+	PipelineStage2BottomOfPipe.Register("Bottom Of Pipe")
+	PipelineStage2Host.Register("Host")
+	PipelineStage2AllGraphics.Register("All Graphics")
+	PipelineStage2AllCommands.Register("All Commands")
+	PipelineStage2Copy.Register("Copy")
+	PipelineStage2Resolve.Register("Resolve")
+	PipelineStage2Blit.Register("Blit")
+	PipelineStage2Clear.Register("Clear")
+	PipelineStage2IndexInput.Register("Index Input")
+	PipelineStage2VertexAttributeInput.Register("Vertex Attribute Input")
+	PipelineStage2PreRasterizationShaders.Register("Pre-Rasterization Shaders")
+}
@@ -0,0 +1,188 @@
+package core1_3_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"github.com/vkngwrapper/core/v2/driver"
+	mock_driver "github.com/vkngwrapper/core/v2/driver/mocks"
+	"github.com/vkngwrapper/core/v2/internal/dummies"
+	"github.com/vkngwrapper/core/v2/mocks"
+	"reflect"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+func TestPipelineRenderingCreateInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := dummies.EasyDummyDevice(coreDriver)
+	expectedPipeline := mocks.EasyMockPipeline(ctrl)
+
+	coreDriver.EXPECT().VkCreateGraphicsPipelines(device.Handle(), driver.VkPipelineCache(0), driver.Uint32(1), gomock.Not(gomock.Nil()), gomock.Nil(), gomock.Not(gomock.Nil())).
+		DoAndReturn(func(device driver.VkDevice, pipelineCache driver.VkPipelineCache, createInfoCount driver.Uint32, pCreateInfos *driver.VkGraphicsPipelineCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelines *driver.VkPipeline) (common.VkResult, error) {
+			pipelineSlice := ([]driver.VkPipeline)(unsafe.Slice(pPipelines, 1))
+			pipelineSlice[0] = expectedPipeline.Handle()
+
+			val := reflect.ValueOf(pCreateInfos).Elem()
+			require.Equal(t, uint64(28), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_GRAPHICS_PIPELINE_CREATE_INFO
+			require.True(t, val.FieldByName("renderPass").IsNil())
+
+			rendering := (*driver.VkPipelineRenderingCreateInfo)(val.FieldByName("pNext").UnsafePointer())
+			val = reflect.ValueOf(rendering).Elem()
+
+			require.Equal(t, uint64(1000044002), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_PIPELINE_RENDERING_CREATE_INFO
+			require.True(t, val.FieldByName("pNext").IsNil())
+			require.Equal(t, uint64(3), val.FieldByName("viewMask").Uint())
+			require.Equal(t, uint64(2), val.FieldByName("colorAttachmentCount").Uint())
+			require.Equal(t, uint64(126), val.FieldByName("depthAttachmentFormat").Uint()) // VK_FORMAT_D32_SFLOAT
+			require.Equal(t, uint64(0), val.FieldByName("stencilAttachmentFormat").Uint())
+
+			formats := (*driver.Uint32)(val.FieldByName("pColorAttachmentFormats").UnsafePointer())
+			formatSlice := ([]driver.Uint32)(unsafe.Slice(formats, 2))
+			require.Equal(t, []driver.Uint32{50, 37}, formatSlice) // VK_FORMAT_B8G8R8A8_SRGB, VK_FORMAT_R8G8B8A8_UNORM
+
+			return core1_0.VKSuccess, nil
+		})
+
+	pipelines, _, err := device.CreateGraphicsPipelines(nil, nil, []core1_0.GraphicsPipelineCreateInfo{
+		{
+			NextOptions: common.NextOptions{Next: core1_3.PipelineRenderingCreateInfo{
+				ViewMask:               3,
+				ColorAttachmentFormats: []core1_0.Format{core1_0.FormatB8G8R8A8SRGB, core1_0.FormatR8G8B8A8UnsignedNormalized},
+				DepthAttachmentFormat:  core1_0.FormatD32SignedFloat,
+			}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, pipelines, 1)
+	require.Equal(t, expectedPipeline.Handle(), pipelines[0].Handle())
+}
+
+func TestPipelineCreationFeedbackCreateInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := dummies.EasyDummyDevice(coreDriver)
+	layout := mocks.EasyMockPipelineLayout(ctrl)
+	shaderModule := mocks.EasyMockShaderModule(ctrl)
+
+	coreDriver.EXPECT().VkCreateComputePipelines(device.Handle(), driver.VkPipelineCache(0), driver.Uint32(2), gomock.Not(gomock.Nil()), gomock.Nil(), gomock.Not(gomock.Nil())).
+		DoAndReturn(func(device driver.VkDevice, pipelineCache driver.VkPipelineCache, createInfoCount driver.Uint32, pCreateInfos *driver.VkComputePipelineCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelines *driver.VkPipeline) (common.VkResult, error) {
+			pipelineSlice := ([]driver.VkPipeline)(unsafe.Slice(pPipelines, 2))
+			pipelineSlice[0] = mocks.NewFakePipeline()
+			pipelineSlice[1] = mocks.NewFakePipeline()
+
+			val := reflect.ValueOf(pCreateInfos).Elem()
+			require.Equal(t, uint64(29), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_COMPUTE_PIPELINE_CREATE_INFO
+			require.True(t, val.FieldByName("pNext").IsNil())
+
+			val = reflect.NewAt(val.Type(), unsafe.Add(val.Addr().UnsafePointer(), val.Type().Size())).Elem()
+			require.Equal(t, uint64(29), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_COMPUTE_PIPELINE_CREATE_INFO
+
+			feedback := (*driver.VkPipelineCreationFeedbackCreateInfo)(val.FieldByName("pNext").UnsafePointer())
+			val = reflect.ValueOf(feedback).Elem()
+			require.Equal(t, uint64(1000192000), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_PIPELINE_CREATION_FEEDBACK_CREATE_INFO
+			require.True(t, val.FieldByName("pNext").IsNil())
+			require.Equal(t, uint64(1), val.FieldByName("pipelineStageCreationFeedbackCount").Uint())
+
+			pipelineFeedback := val.FieldByName("pPipelineCreationFeedback").Elem()
+			*(*uint32)(unsafe.Pointer(pipelineFeedback.FieldByName("flags").UnsafeAddr())) = uint32(3) // VK_PIPELINE_CREATION_FEEDBACK_VALID_BIT|VK_PIPELINE_CREATION_FEEDBACK_APPLICATION_PIPELINE_CACHE_HIT_BIT
+			*(*uint64)(unsafe.Pointer(pipelineFeedback.FieldByName("duration").UnsafeAddr())) = uint64(1500000)
+
+			stageFeedback := val.FieldByName("pPipelineStageCreationFeedbacks").Elem()
+			*(*uint32)(unsafe.Pointer(stageFeedback.FieldByName("flags").UnsafeAddr())) = uint32(1) // VK_PIPELINE_CREATION_FEEDBACK_VALID_BIT
+			*(*uint64)(unsafe.Pointer(stageFeedback.FieldByName("duration").UnsafeAddr())) = uint64(700000)
+
+			return core1_0.VKSuccess, nil
+		})
+
+	var pipelineFeedback core1_3.PipelineCreationFeedback
+	stageFeedbacks := make([]core1_3.PipelineCreationFeedback, 1)
+
+	stage := core1_0.PipelineShaderStageCreateInfo{
+		Name:   "main",
+		Stage:  core1_0.StageCompute,
+		Module: shaderModule,
+	}
+	pipelines, _, err := device.CreateComputePipelines(nil, nil, []core1_0.ComputePipelineCreateInfo{
+		{
+			Stage:  stage,
+			Layout: layout,
+		},
+		{
+			Stage:  stage,
+			Layout: layout,
+			NextOptions: common.NextOptions{Next: core1_3.PipelineCreationFeedbackCreateInfo{
+				PipelineCreationFeedback:       &pipelineFeedback,
+				PipelineStageCreationFeedbacks: stageFeedbacks,
+			}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, pipelines, 2)
+
+	require.Equal(t, core1_3.PipelineCreationFeedback{
+		Flags:    core1_3.PipelineCreationFeedbackValid | core1_3.PipelineCreationFeedbackApplicationPipelineCacheHit,
+		Duration: 1500 * time.Microsecond,
+	}, pipelineFeedback)
+	require.Equal(t, []core1_3.PipelineCreationFeedback{
+		{
+			Flags:    core1_3.PipelineCreationFeedbackValid,
+			Duration: 700 * time.Microsecond,
+		},
+	}, stageFeedbacks)
+}
+
+func TestPipelineCreationFeedbackCreateInfo_GraphicsStageCountMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := dummies.EasyDummyDevice(coreDriver)
+	shaderModule := mocks.EasyMockShaderModule(ctrl)
+
+	var pipelineFeedback core1_3.PipelineCreationFeedback
+	_, _, err := device.CreateGraphicsPipelines(nil, nil, []core1_0.GraphicsPipelineCreateInfo{
+		{
+			Stages: []core1_0.PipelineShaderStageCreateInfo{
+				{Name: "main", Stage: core1_0.StageVertex, Module: shaderModule},
+				{Name: "main", Stage: core1_0.StageFragment, Module: shaderModule},
+			},
+			NextOptions: common.NextOptions{Next: core1_3.PipelineCreationFeedbackCreateInfo{
+				PipelineCreationFeedback:       &pipelineFeedback,
+				PipelineStageCreationFeedbacks: make([]core1_3.PipelineCreationFeedback, 1),
+			}},
+		},
+	})
+	require.EqualError(t, err, "core1_3.PipelineCreationFeedbackCreateInfo.PipelineStageCreationFeedbacks has 1 elements, but the pipeline create info has 2 stages")
+}
+
+func TestPipelineCreationFeedbackCreateInfo_ComputeStageCountMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := dummies.EasyDummyDevice(coreDriver)
+	layout := mocks.EasyMockPipelineLayout(ctrl)
+	shaderModule := mocks.EasyMockShaderModule(ctrl)
+
+	var pipelineFeedback core1_3.PipelineCreationFeedback
+	_, _, err := device.CreateComputePipelines(nil, nil, []core1_0.ComputePipelineCreateInfo{
+		{
+			Stage:  core1_0.PipelineShaderStageCreateInfo{Name: "main", Stage: core1_0.StageCompute, Module: shaderModule},
+			Layout: layout,
+			NextOptions: common.NextOptions{Next: core1_3.PipelineCreationFeedbackCreateInfo{
+				PipelineCreationFeedback:       &pipelineFeedback,
+				PipelineStageCreationFeedbacks: make([]core1_3.PipelineCreationFeedback, 2),
+			}},
+		},
+	})
+	require.EqualError(t, err, "core1_3.PipelineCreationFeedbackCreateInfo.PipelineStageCreationFeedbacks has 2 elements, but the pipeline create info has 1 stages")
+}
@@ -0,0 +1,112 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+// VulkanPrivateDataSlot is an implementation of the PrivateDataSlot interface that actually communicates with Vulkan. This
+// is the default implementation. See the interface for more documentation.
+type VulkanPrivateDataSlot struct {
+	DeviceDriver          driver.Driver
+	Device                driver.VkDevice
+	PrivateDataSlotHandle driver.VkPrivateDataSlot
+
+	MaximumAPIVersion common.APIVersion
+}
+
+func (s *VulkanPrivateDataSlot) Handle() driver.VkPrivateDataSlot {
+	return s.PrivateDataSlotHandle
+}
+
+func (s *VulkanPrivateDataSlot) Driver() driver.Driver {
+	return s.DeviceDriver
+}
+
+func (s *VulkanPrivateDataSlot) DeviceHandle() driver.VkDevice {
+	return s.Device
+}
+
+func (s *VulkanPrivateDataSlot) APIVersion() common.APIVersion {
+	return s.MaximumAPIVersion
+}
+
+func (s *VulkanPrivateDataSlot) Destroy(allocator *driver.AllocationCallbacks) {
+	s.DeviceDriver.VkDestroyPrivateDataSlot(s.Device, s.PrivateDataSlotHandle, allocator.Handle())
+	s.DeviceDriver.ObjectStore().Delete(driver.VulkanHandle(s.PrivateDataSlotHandle))
+}
+
+////
+
+// PrivateDataSlotCreateFlags is reserved for future use
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPrivateDataSlotCreateFlags.html
+type PrivateDataSlotCreateFlags int32
+
+var privateDataSlotCreateFlagsMapping = common.NewFlagStringMapping[PrivateDataSlotCreateFlags]()
+
+func (f PrivateDataSlotCreateFlags) Register(str string) {
+	privateDataSlotCreateFlagsMapping.Register(f, str)
+}
+func (f PrivateDataSlotCreateFlags) String() string {
+	return privateDataSlotCreateFlagsMapping.FlagsToString(f)
+}
+
+////
+
+// PrivateDataSlotCreateInfo specifies the parameters of a PrivateDataSlot
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPrivateDataSlotCreateInfo.html
+type PrivateDataSlotCreateInfo struct {
+	// Flags is reserved for future use
+	Flags PrivateDataSlotCreateFlags
+
+	common.NextOptions
+}
+
+func (o PrivateDataSlotCreateInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkPrivateDataSlotCreateInfo{})))
+	}
+
+	info := (*C.VkPrivateDataSlotCreateInfo)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_PRIVATE_DATA_SLOT_CREATE_INFO
+	info.pNext = next
+	info.flags = C.VkPrivateDataSlotCreateFlags(o.Flags)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// DevicePrivateDataCreateInfo reserves private data slots when creating a Device. Add it to
+// the DeviceCreateInfo option chain.
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkDevicePrivateDataCreateInfo.html
+type DevicePrivateDataCreateInfo struct {
+	// PrivateDataSlotRequestCount is the number of PrivateDataSlot objects the application
+	// will create, which allows the implementation to reserve storage for them ahead of time
+	PrivateDataSlotRequestCount int
+
+	common.NextOptions
+}
+
+func (o DevicePrivateDataCreateInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkDevicePrivateDataCreateInfo{})))
+	}
+
+	info := (*C.VkDevicePrivateDataCreateInfo)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_DEVICE_PRIVATE_DATA_CREATE_INFO
+	info.pNext = next
+	info.privateDataSlotRequestCount = C.uint32_t(o.PrivateDataSlotRequestCount)
+
+	return preallocatedPointer, nil
+}
@@ -0,0 +1,142 @@
+package core1_3_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"github.com/vkngwrapper/core/v2/driver"
+	mock_driver "github.com/vkngwrapper/core/v2/driver/mocks"
+	"github.com/vkngwrapper/core/v2/internal/dummies"
+	"github.com/vkngwrapper/core/v2/mocks"
+	"reflect"
+	"testing"
+)
+
+func TestVulkanDevice_CreatePrivateDataSlot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := core1_3.PromoteDevice(dummies.EasyDummyDevice(coreDriver))
+	slotHandle := mocks.NewFakePrivateDataSlot()
+
+	coreDriver.EXPECT().VkCreatePrivateDataSlot(
+		device.Handle(),
+		gomock.Not(gomock.Nil()),
+		gomock.Nil(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(device driver.VkDevice, pCreateInfo *driver.VkPrivateDataSlotCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPrivateDataSlot *driver.VkPrivateDataSlot) (common.VkResult, error) {
+		val := reflect.ValueOf(pCreateInfo).Elem()
+		require.Equal(t, uint64(1000295002), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_PRIVATE_DATA_SLOT_CREATE_INFO
+		require.True(t, val.FieldByName("pNext").IsNil())
+		require.Equal(t, uint64(0), val.FieldByName("flags").Uint())
+
+		*pPrivateDataSlot = slotHandle
+		return core1_0.VKSuccess, nil
+	})
+
+	slot, _, err := device.CreatePrivateDataSlot(core1_3.PrivateDataSlotCreateInfo{}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, slot)
+	require.Equal(t, slotHandle, slot.Handle())
+	require.Equal(t, device.Handle(), slot.DeviceHandle())
+	require.Equal(t, common.Vulkan1_3, slot.APIVersion())
+	require.Same(t, slot, core1_3.CreatePrivateDataSlot(coreDriver, device.Handle(), slotHandle, common.Vulkan1_3))
+
+	coreDriver.EXPECT().VkDestroyPrivateDataSlot(device.Handle(), slotHandle, nil)
+
+	slot.Destroy(nil)
+	require.NotSame(t, slot, core1_3.CreatePrivateDataSlot(coreDriver, device.Handle(), slotHandle, common.Vulkan1_3))
+}
+
+func TestVulkanDevice_SetGetPrivateData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := core1_3.PromoteDevice(dummies.EasyDummyDevice(coreDriver))
+	slot := mocks.EasyMockPrivateDataSlot(ctrl)
+	buffer := mocks.EasyMockBuffer(ctrl)
+
+	coreDriver.EXPECT().VkSetPrivateData(
+		device.Handle(),
+		driver.VkObjectType(9), // VK_OBJECT_TYPE_BUFFER
+		driver.Uint64(buffer.Handle()),
+		slot.Handle(),
+		driver.Uint64(31),
+	).Return(core1_0.VKSuccess, nil)
+
+	_, err := device.SetPrivateData(core1_0.ObjectTypeBuffer, driver.VulkanHandle(buffer.Handle()), slot, 31)
+	require.NoError(t, err)
+
+	coreDriver.EXPECT().VkGetPrivateData(
+		device.Handle(),
+		driver.VkObjectType(9), // VK_OBJECT_TYPE_BUFFER
+		driver.Uint64(buffer.Handle()),
+		slot.Handle(),
+		gomock.Not(gomock.Nil()),
+	).DoAndReturn(func(device driver.VkDevice, objectType driver.VkObjectType, objectHandle driver.Uint64, privateDataSlot driver.VkPrivateDataSlot, pData *driver.Uint64) {
+		*pData = 31
+	})
+
+	require.Equal(t, uint64(31), device.GetPrivateData(core1_0.ObjectTypeBuffer, driver.VulkanHandle(buffer.Handle()), slot))
+
+	_, err = device.SetPrivateData(core1_0.ObjectTypeBuffer, driver.VulkanHandle(buffer.Handle()), nil, 31)
+	require.ErrorIs(t, err, common.ErrNilArgument)
+}
+
+func TestPrivateDataStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type objectKey struct {
+		objectType core1_0.ObjectType
+		handle     driver.VulkanHandle
+	}
+	slotData := make(map[objectKey]uint64)
+
+	slot := mocks.EasyMockPrivateDataSlot(ctrl)
+	device := mocks.NewDevice1_3(ctrl)
+	device.EXPECT().GetPrivateData(gomock.Any(), gomock.Any(), slot).DoAndReturn(
+		func(objectType core1_0.ObjectType, objectHandle driver.VulkanHandle, privateDataSlot core1_3.PrivateDataSlot) uint64 {
+			return slotData[objectKey{objectType, objectHandle}]
+		}).AnyTimes()
+	device.EXPECT().SetPrivateData(gomock.Any(), gomock.Any(), slot, gomock.Any()).DoAndReturn(
+		func(objectType core1_0.ObjectType, objectHandle driver.VulkanHandle, privateDataSlot core1_3.PrivateDataSlot, data uint64) (common.VkResult, error) {
+			slotData[objectKey{objectType, objectHandle}] = data
+			return core1_0.VKSuccess, nil
+		}).Times(3)
+
+	store, err := core1_3.NewPrivateDataStore[string](device, slot)
+	require.NoError(t, err)
+	require.Same(t, slot, store.Slot())
+
+	buffer := driver.VulkanHandle(mocks.NewFakeBufferHandle())
+	image := driver.VulkanHandle(mocks.NewFakeImageHandle())
+
+	_, err = store.Set(core1_0.ObjectTypeBuffer, buffer, "vertices")
+	require.NoError(t, err)
+	_, err = store.Set(core1_0.ObjectTypeImage, image, "albedo")
+	require.NoError(t, err)
+	_, err = store.Set(core1_0.ObjectTypeBuffer, buffer, "indices")
+	require.NoError(t, err)
+	require.Equal(t, 2, store.Len())
+
+	value, ok := store.Get(core1_0.ObjectTypeBuffer, buffer)
+	require.True(t, ok)
+	require.Equal(t, "indices", value)
+
+	value, ok = store.Get(core1_0.ObjectTypeBuffer, image)
+	require.False(t, ok)
+	require.Equal(t, "", value)
+
+	_, err = store.Delete(core1_0.ObjectTypeImage, image)
+	require.NoError(t, err)
+	require.Equal(t, 1, store.Len())
+	require.Equal(t, uint64(0), slotData[objectKey{core1_0.ObjectTypeImage, image}])
+
+	_, ok = store.Get(core1_0.ObjectTypeImage, image)
+	require.False(t, ok)
+}
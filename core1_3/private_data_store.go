@@ -0,0 +1,120 @@
+package core1_3
+
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"sync"
+)
+
+// PrivateDataStore attaches arbitrary Go values to Vulkan objects using a PrivateDataSlot. Values
+// are held in a Go map, and the 64-bit key for each value is stored in the PrivateDataSlot. Because
+// it is keyed by object type and handle, PrivateDataStore can be used with objects that were not
+// created through this library, such as those returned by unwrapped extensions.
+//
+// PrivateDataStore is safe for concurrent use.
+type PrivateDataStore[T any] struct {
+	device Device
+	slot   PrivateDataSlot
+
+	lock    sync.RWMutex
+	nextKey uint64
+	values  map[uint64]T
+}
+
+// NewPrivateDataStore creates a PrivateDataStore that stores keys in the provided PrivateDataSlot.
+// The slot should not be used for anything else while the PrivateDataStore is in use.
+//
+// device - The Device that owns slot and any objects values will be attached to
+//
+// slot - The PrivateDataSlot to store value keys in
+func NewPrivateDataStore[T any](device Device, slot PrivateDataSlot) (*PrivateDataStore[T], error) {
+	if device == nil {
+		return nil, common.NilArgumentError("NewPrivateDataStore", "device")
+	}
+	if slot == nil {
+		return nil, common.NilArgumentError("NewPrivateDataStore", "slot")
+	}
+
+	return &PrivateDataStore[T]{
+		device: device,
+		slot:   slot,
+		values: make(map[uint64]T),
+	}, nil
+}
+
+// Slot returns the PrivateDataSlot this PrivateDataStore stores keys in
+func (s *PrivateDataStore[T]) Slot() PrivateDataSlot {
+	return s.slot
+}
+
+// Set attaches a value to a Vulkan object, replacing any value previously attached
+//
+// objectType - The type of the object to attach the value to
+//
+// objectHandle - The handle of the object to attach the value to
+//
+// value - The value to attach
+func (s *PrivateDataStore[T]) Set(objectType core1_0.ObjectType, objectHandle driver.VulkanHandle, value T) (common.VkResult, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := s.device.GetPrivateData(objectType, objectHandle, s.slot)
+	if _, ok := s.values[key]; key != 0 && ok {
+		s.values[key] = value
+		return core1_0.VKSuccess, nil
+	}
+
+	s.nextKey++
+	key = s.nextKey
+
+	res, err := s.device.SetPrivateData(objectType, objectHandle, s.slot, key)
+	if err != nil {
+		return res, err
+	}
+
+	s.values[key] = value
+	return res, nil
+}
+
+// Get retrieves the value attached to a Vulkan object. If no value is attached, the zero value
+// of T and false are returned.
+//
+// objectType - The type of the object to retrieve the value for
+//
+// objectHandle - The handle of the object to retrieve the value for
+func (s *PrivateDataStore[T]) Get(objectType core1_0.ObjectType, objectHandle driver.VulkanHandle) (T, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	key := s.device.GetPrivateData(objectType, objectHandle, s.slot)
+	value, ok := s.values[key]
+	return value, ok
+}
+
+// Delete detaches the value attached to a Vulkan object, if any. This should be called before
+// the object is destroyed, or the value will be held until the PrivateDataStore is discarded.
+//
+// objectType - The type of the object to detach the value from
+//
+// objectHandle - The handle of the object to detach the value from
+func (s *PrivateDataStore[T]) Delete(objectType core1_0.ObjectType, objectHandle driver.VulkanHandle) (common.VkResult, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := s.device.GetPrivateData(objectType, objectHandle, s.slot)
+	if key == 0 {
+		return core1_0.VKSuccess, nil
+	}
+
+	delete(s.values, key)
+	return s.device.SetPrivateData(objectType, objectHandle, s.slot, 0)
+}
+
+// Len returns the number of values currently held by this PrivateDataStore
+func (s *PrivateDataStore[T]) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return len(s.values)
+}
@@ -0,0 +1,93 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_2"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+// VulkanQueue is an implementation of the Queue interface that actually communicates with Vulkan. This
+// is the default implementation. See the interface for more documentation.
+type VulkanQueue struct {
+	core1_2.Queue
+
+	DeviceDriver driver.Driver
+	QueueHandle  driver.VkQueue
+}
+
+// PromoteQueue accepts a Queue object from any core version. If provided a queue that supports
+// at least core 1.3, it will return a core1_3.Queue. Otherwise, it will return nil. This method
+// will always return a core1_3.VulkanQueue, even if it is provided a VulkanQueue from a higher
+// core version. Two Vulkan 1.3 compatible Queue objects with the same Queue.Handle will
+// return the same interface value when passed to this method.
+func PromoteQueue(queue core1_0.Queue) Queue {
+	if queue == nil {
+		return nil
+	}
+	if !queue.APIVersion().IsAtLeast(common.Vulkan1_3) {
+		return nil
+	}
+
+	return promoteQueue(queue)
+}
+
+// PromoteQueueFromExtensions accepts a Queue object from any core version, along with the Device it
+// belongs to. If provided a queue that supports at least core 1.3, it behaves like PromoteQueue. If
+// provided a queue that supports core 1.2, from a Device that enabled VK_KHR_synchronization2, it will
+// also return a core1_3.Queue, whose commands are loaded from the extension. Otherwise, it will return nil.
+func PromoteQueueFromExtensions(queue core1_0.Queue, device core1_0.Device) Queue {
+	if queue == nil || device == nil {
+		return nil
+	}
+	if queue.APIVersion().IsAtLeast(common.Vulkan1_3) {
+		return promoteQueue(queue)
+	}
+	if !queue.APIVersion().IsAtLeast(common.Vulkan1_2) ||
+		queue.DeviceHandle() != device.Handle() ||
+		!anyExtensionActive(device, queueExtensions) {
+		return nil
+	}
+
+	return promoteQueue(queue)
+}
+
+func promoteQueue(queue core1_0.Queue) Queue {
+	promotedQueue := core1_2.PromoteQueue(queue)
+	return queue.Driver().ObjectStore().GetOrCreate(
+		driver.VulkanHandle(queue.Handle()),
+		driver.Core1_3,
+		func() any {
+			return &VulkanQueue{
+				Queue: promotedQueue,
+
+				DeviceDriver: queue.Driver(),
+				QueueHandle:  queue.Handle(),
+			}
+		}).(Queue)
+}
+
+func (q *VulkanQueue) Submit2(fence core1_0.Fence, submits []SubmitInfo2) (common.VkResult, error) {
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	submitCount := len(submits)
+	submitPtr, err := common.AllocOptionSlice[C.VkSubmitInfo2, SubmitInfo2](arena, submits)
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	var fenceHandle driver.VkFence
+	if fence != nil {
+		fenceHandle = fence.Handle()
+	}
+
+	return q.DeviceDriver.VkQueueSubmit2(q.QueueHandle, driver.Uint32(submitCount), (*driver.VkSubmitInfo2)(unsafe.Pointer(submitPtr)), fenceHandle)
+}
@@ -0,0 +1,150 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"unsafe"
+)
+
+// SemaphoreSubmitInfo specifies a Semaphore signal or wait operation
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkSemaphoreSubmitInfo.html
+type SemaphoreSubmitInfo struct {
+	// Semaphore is the Semaphore affected by this operation
+	Semaphore core1_0.Semaphore
+	// Value is either the value used to signal Semaphore or the value waited on by Semaphore, if
+	// Semaphore is a timeline Semaphore. Otherwise, it is ignored
+	Value uint64
+	// StageMask limits the first synchronization scope of a signal operation, or the second
+	// synchronization scope of a wait operation
+	StageMask PipelineStageFlags2
+	// DeviceIndex is the index of the device within a device group that executes the operation
+	DeviceIndex int
+
+	common.NextOptions
+}
+
+func (o SemaphoreSubmitInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.Semaphore == nil {
+		return nil, errors.New("core1_3.SemaphoreSubmitInfo.Semaphore cannot be nil")
+	}
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkSemaphoreSubmitInfo{})))
+	}
+
+	info := (*C.VkSemaphoreSubmitInfo)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_SEMAPHORE_SUBMIT_INFO
+	info.pNext = next
+	info.semaphore = C.VkSemaphore(unsafe.Pointer(o.Semaphore.Handle()))
+	info.value = C.uint64_t(o.Value)
+	info.stageMask = C.VkPipelineStageFlags2(o.StageMask)
+	info.deviceIndex = C.uint32_t(o.DeviceIndex)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// CommandBufferSubmitInfo specifies a CommandBuffer to be submitted in a batch
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkCommandBufferSubmitInfo.html
+type CommandBufferSubmitInfo struct {
+	// CommandBuffer is the CommandBuffer to be submitted for execution
+	CommandBuffer core1_0.CommandBuffer
+	// DeviceMask is a bitmask indicating which devices in a device group execute CommandBuffer.
+	// A value of 0 is equivalent to setting all bits corresponding to valid devices in the group
+	DeviceMask uint32
+
+	common.NextOptions
+}
+
+func (o CommandBufferSubmitInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if o.CommandBuffer == nil {
+		return nil, errors.New("core1_3.CommandBufferSubmitInfo.CommandBuffer cannot be nil")
+	}
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkCommandBufferSubmitInfo{})))
+	}
+
+	info := (*C.VkCommandBufferSubmitInfo)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_SUBMIT_INFO
+	info.pNext = next
+	info.commandBuffer = C.VkCommandBuffer(unsafe.Pointer(o.CommandBuffer.Handle()))
+	info.deviceMask = C.uint32_t(o.DeviceMask)
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// SubmitInfo2 specifies a Queue submit operation
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkSubmitInfo2.html
+type SubmitInfo2 struct {
+	// Flags specifies the behavior of the submission
+	Flags SubmitFlags
+	// WaitSemaphoreInfos is a slice of SemaphoreSubmitInfo structures defining Semaphore wait
+	// operations
+	WaitSemaphoreInfos []SemaphoreSubmitInfo
+	// CommandBufferInfos is a slice of CommandBufferSubmitInfo structures describing the
+	// CommandBuffer objects to execute in the batch
+	CommandBufferInfos []CommandBufferSubmitInfo
+	// SignalSemaphoreInfos is a slice of SemaphoreSubmitInfo structures defining Semaphore
+	// signal operations
+	SignalSemaphoreInfos []SemaphoreSubmitInfo
+
+	common.NextOptions
+}
+
+func (o SubmitInfo2) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkSubmitInfo2{})))
+	}
+
+	info := (*C.VkSubmitInfo2)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_SUBMIT_INFO_2
+	info.pNext = next
+	info.flags = C.VkSubmitFlags(o.Flags)
+
+	waitSemaphoreInfoCount := len(o.WaitSemaphoreInfos)
+	commandBufferInfoCount := len(o.CommandBufferInfos)
+	signalSemaphoreInfoCount := len(o.SignalSemaphoreInfos)
+
+	info.waitSemaphoreInfoCount = C.uint32_t(waitSemaphoreInfoCount)
+	info.pWaitSemaphoreInfos = nil
+	info.commandBufferInfoCount = C.uint32_t(commandBufferInfoCount)
+	info.pCommandBufferInfos = nil
+	info.signalSemaphoreInfoCount = C.uint32_t(signalSemaphoreInfoCount)
+	info.pSignalSemaphoreInfos = nil
+
+	var err error
+	if waitSemaphoreInfoCount > 0 {
+		info.pWaitSemaphoreInfos, err = common.AllocOptionSlice[C.VkSemaphoreSubmitInfo, SemaphoreSubmitInfo](allocator, o.WaitSemaphoreInfos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if commandBufferInfoCount > 0 {
+		info.pCommandBufferInfos, err = common.AllocOptionSlice[C.VkCommandBufferSubmitInfo, CommandBufferSubmitInfo](allocator, o.CommandBufferInfos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if signalSemaphoreInfoCount > 0 {
+		info.pSignalSemaphoreInfos, err = common.AllocOptionSlice[C.VkSemaphoreSubmitInfo, SemaphoreSubmitInfo](allocator, o.SignalSemaphoreInfos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return preallocatedPointer, nil
+}
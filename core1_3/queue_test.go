@@ -0,0 +1,136 @@
+package core1_3_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"github.com/vkngwrapper/core/v2/driver"
+	mock_driver "github.com/vkngwrapper/core/v2/driver/mocks"
+	"github.com/vkngwrapper/core/v2/internal/dummies"
+	"github.com/vkngwrapper/core/v2/mocks"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+func TestQueue_Submit2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	queue := core1_3.PromoteQueue(dummies.EasyDummyQueue(coreDriver, device))
+	require.NotNil(t, queue)
+	require.Same(t, queue, core1_3.PromoteQueue(queue))
+
+	fence := mocks.EasyMockFence(ctrl)
+	commandBuffer := mocks.EasyMockCommandBuffer(ctrl)
+	waitSemaphore := mocks.EasyMockSemaphore(ctrl)
+	signalSemaphore := mocks.EasyMockSemaphore(ctrl)
+
+	coreDriver.EXPECT().VkQueueSubmit2(
+		queue.Handle(),
+		driver.Uint32(1),
+		gomock.Not(gomock.Nil()),
+		fence.Handle(),
+	).DoAndReturn(func(queue driver.VkQueue, submitCount driver.Uint32, pSubmits *driver.VkSubmitInfo2, fence driver.VkFence) (common.VkResult, error) {
+		val := reflect.ValueOf(pSubmits).Elem()
+		require.Equal(t, uint64(1000314004), val.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_SUBMIT_INFO_2
+		require.True(t, val.FieldByName("pNext").IsNil())
+		require.Equal(t, uint64(1), val.FieldByName("flags").Uint()) // VK_SUBMIT_PROTECTED_BIT
+		require.Equal(t, uint64(1), val.FieldByName("waitSemaphoreInfoCount").Uint())
+		require.Equal(t, uint64(1), val.FieldByName("commandBufferInfoCount").Uint())
+		require.Equal(t, uint64(1), val.FieldByName("signalSemaphoreInfoCount").Uint())
+
+		wait := val.FieldByName("pWaitSemaphoreInfos").Elem()
+		require.Equal(t, uint64(1000314005), wait.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_SEMAPHORE_SUBMIT_INFO
+		require.Equal(t, waitSemaphore.Handle(), driver.VkSemaphore(wait.FieldByName("semaphore").UnsafePointer()))
+		require.Equal(t, uint64(3), wait.FieldByName("value").Uint())
+		require.Equal(t, uint64(0x100000000), wait.FieldByName("stageMask").Uint()) // VK_PIPELINE_STAGE_2_COPY_BIT
+		require.Equal(t, uint64(0), wait.FieldByName("deviceIndex").Uint())
+
+		commandBufferInfo := val.FieldByName("pCommandBufferInfos").Elem()
+		require.Equal(t, uint64(1000314006), commandBufferInfo.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_COMMAND_BUFFER_SUBMIT_INFO
+		require.Equal(t, commandBuffer.Handle(), driver.VkCommandBuffer(commandBufferInfo.FieldByName("commandBuffer").UnsafePointer()))
+		require.Equal(t, uint64(1), commandBufferInfo.FieldByName("deviceMask").Uint())
+
+		signal := val.FieldByName("pSignalSemaphoreInfos").Elem()
+		require.Equal(t, uint64(1000314005), signal.FieldByName("sType").Uint()) // VK_STRUCTURE_TYPE_SEMAPHORE_SUBMIT_INFO
+		require.Equal(t, signalSemaphore.Handle(), driver.VkSemaphore(signal.FieldByName("semaphore").UnsafePointer()))
+		require.Equal(t, uint64(5), signal.FieldByName("value").Uint())
+		require.Equal(t, uint64(0x10000), signal.FieldByName("stageMask").Uint()) // VK_PIPELINE_STAGE_2_ALL_COMMANDS_BIT
+
+		return core1_0.VKSuccess, nil
+	})
+
+	_, err := queue.Submit2(fence, []core1_3.SubmitInfo2{
+		{
+			Flags: core1_3.SubmitProtected,
+			WaitSemaphoreInfos: []core1_3.SemaphoreSubmitInfo{
+				{Semaphore: waitSemaphore, Value: 3, StageMask: core1_3.PipelineStage2Copy},
+			},
+			CommandBufferInfos: []core1_3.CommandBufferSubmitInfo{
+				{CommandBuffer: commandBuffer, DeviceMask: 1},
+			},
+			SignalSemaphoreInfos: []core1_3.SemaphoreSubmitInfo{
+				{Semaphore: signalSemaphore, Value: 5, StageMask: core1_3.PipelineStage2AllCommands},
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestQueue_Submit2_NoFence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_3)
+	device := mocks.EasyMockDevice(ctrl, coreDriver)
+	queue := core1_3.PromoteQueue(dummies.EasyDummyQueue(coreDriver, device))
+
+	coreDriver.EXPECT().VkQueueSubmit2(
+		queue.Handle(),
+		driver.Uint32(0),
+		gomock.Any(),
+		driver.VkFence(unsafe.Pointer(nil)),
+	).Return(core1_0.VKSuccess, nil)
+
+	_, err := queue.Submit2(nil, nil)
+	require.NoError(t, err)
+}
+
+func TestPromoteQueueFromExtensions_Synchronization2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreDriver := mock_driver.DriverForVersion(ctrl, common.Vulkan1_2)
+	device := extensionDevice(ctrl, coreDriver, "VK_KHR_synchronization2")
+	baseQueue := dummies.EasyDummyQueue(coreDriver, device)
+
+	require.Nil(t, core1_3.PromoteQueue(baseQueue))
+	queue := core1_3.PromoteQueueFromExtensions(baseQueue, device)
+	require.NotNil(t, queue)
+	require.Same(t, queue, core1_3.PromoteQueueFromExtensions(baseQueue, device))
+
+	coreDriver.EXPECT().VkQueueSubmit2(
+		queue.Handle(),
+		driver.Uint32(0),
+		gomock.Any(),
+		driver.VkFence(unsafe.Pointer(nil)),
+	).Return(core1_0.VKSuccess, nil)
+
+	_, err := queue.Submit2(nil, nil)
+	require.NoError(t, err)
+
+	// VK_KHR_dynamic_rendering does not provide any Queue commands
+	renderingDevice := extensionDevice(ctrl, coreDriver, "VK_KHR_dynamic_rendering")
+	require.Nil(t, core1_3.PromoteQueueFromExtensions(dummies.EasyDummyQueue(coreDriver, renderingDevice), renderingDevice))
+	require.Nil(t, core1_3.PromoteQueueFromExtensions(dummies.EasyDummyQueue(coreDriver, renderingDevice), device))
+}
+
+func TestPipelineStageFlags2_String(t *testing.T) {
+	require.Equal(t, "All Commands|Copy", (core1_3.PipelineStage2Copy | core1_3.PipelineStage2AllCommands).String())
+	require.Equal(t, "Shader Storage Write", core1_3.Access2ShaderStorageWrite.String())
+}
@@ -0,0 +1,309 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_2"
+	"unsafe"
+)
+
+// RenderingFlags specifies additional properties of a dynamic render pass instance
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkRenderingFlagBits.html
+type RenderingFlags int32
+
+var renderingFlagsMapping = common.NewFlagStringMapping[RenderingFlags]()
+
+func (f RenderingFlags) Register(str string) {
+	renderingFlagsMapping.Register(f, str)
+}
+func (f RenderingFlags) String() string {
+	return renderingFlagsMapping.FlagsToString(f)
+}
+
+////
+
+const (
+	// RenderingContentsSecondaryCommandBuffers specifies that draw calls for the render pass
+	// instance will be recorded in secondary CommandBuffer objects
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkRenderingFlagBits.html
+	RenderingContentsSecondaryCommandBuffers RenderingFlags = C.VK_RENDERING_CONTENTS_SECONDARY_COMMAND_BUFFERS_BIT
+	// RenderingSuspending specifies that the render pass instance will be suspended
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkRenderingFlagBits.html
+	RenderingSuspending RenderingFlags = C.VK_RENDERING_SUSPENDING_BIT
+	// RenderingResuming specifies that the render pass instance is resuming an earlier
+	// suspended render pass instance
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkRenderingFlagBits.html
+	RenderingResuming RenderingFlags = C.VK_RENDERING_RESUMING_BIT
+
+	// AttachmentStoreOpNone specifies the contents within the render area are not accessed by
+	// the store operation as long as no values are written to the attachment during the render
+	// pass
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkAttachmentStoreOp.html
+	AttachmentStoreOpNone core1_0.AttachmentStoreOp = C.VK_ATTACHMENT_STORE_OP_NONE
+)
+
+func init() {
+	RenderingContentsSecondaryCommandBuffers.Register("Contents Secondary Command Buffers")
+	RenderingSuspending.Register("Suspending")
+	RenderingResuming.Register("Resuming")
+
+	AttachmentStoreOpNone.Register("None")
+}
+
+////
+
+// RenderingAttachmentInfo specifies attachment information used by a dynamic render pass instance
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkRenderingAttachmentInfo.html
+type RenderingAttachmentInfo struct {
+	// ImageView is the ImageView that will be used for rendering, or nil if the attachment
+	// is not used
+	ImageView core1_0.ImageView
+	// ImageLayout is the layout that ImageView will be in during rendering
+	ImageLayout core1_0.ImageLayout
+	// ResolveMode defines how data written to ImageView will be resolved into ResolveImageView
+	ResolveMode core1_2.ResolveModeFlags
+	// ResolveImageView is an ImageView used to write resolved data at the end of rendering
+	ResolveImageView core1_0.ImageView
+	// ResolveImageLayout is the layout that ResolveImageView will be in during rendering
+	ResolveImageLayout core1_0.ImageLayout
+	// LoadOp specifies how the contents of ImageView are treated at the start of the render
+	// pass instance
+	LoadOp core1_0.AttachmentLoadOp
+	// StoreOp specifies how the contents of ImageView are treated at the end of the render
+	// pass instance
+	StoreOp core1_0.AttachmentStoreOp
+	// ClearValue is the value ImageView will be cleared to if LoadOp is
+	// core1_0.AttachmentLoadOpClear
+	ClearValue core1_0.ClearValue
+
+	common.NextOptions
+}
+
+func (o RenderingAttachmentInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkRenderingAttachmentInfo{})))
+	}
+
+	info := (*C.VkRenderingAttachmentInfo)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_RENDERING_ATTACHMENT_INFO
+	info.pNext = next
+	info.imageView = nil
+	info.imageLayout = C.VkImageLayout(o.ImageLayout)
+	info.resolveMode = C.VkResolveModeFlagBits(o.ResolveMode)
+	info.resolveImageView = nil
+	info.resolveImageLayout = C.VkImageLayout(o.ResolveImageLayout)
+	info.loadOp = C.VkAttachmentLoadOp(o.LoadOp)
+	info.storeOp = C.VkAttachmentStoreOp(o.StoreOp)
+
+	if o.ImageView != nil {
+		info.imageView = C.VkImageView(unsafe.Pointer(o.ImageView.Handle()))
+	}
+
+	if o.ResolveImageView != nil {
+		info.resolveImageView = C.VkImageView(unsafe.Pointer(o.ResolveImageView.Handle()))
+	}
+
+	info.clearValue = C.VkClearValue{}
+	if o.ClearValue != nil {
+		o.ClearValue.PopulateValueUnion(unsafe.Pointer(&info.clearValue))
+	}
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// RenderingInfo specifies the attachments and other parameters of a dynamic render pass instance
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkRenderingInfo.html
+type RenderingInfo struct {
+	// Flags specifies additional properties of the render pass instance
+	Flags RenderingFlags
+	// RenderArea is the render area that is affected by the render pass instance
+	RenderArea core1_0.Rect2D
+	// LayerCount is the number of layers rendered to in each attachment when ViewMask is 0
+	LayerCount int
+	// ViewMask is the view mask indicating the indices of attachment layers that will be
+	// rendered when it is not 0
+	ViewMask uint32
+	// ColorAttachments is a slice of RenderingAttachmentInfo structures describing the color
+	// attachments used during rendering
+	ColorAttachments []RenderingAttachmentInfo
+	// DepthAttachment describes the depth attachment used during rendering, or nil if there is
+	// no depth attachment
+	DepthAttachment *RenderingAttachmentInfo
+	// StencilAttachment describes the stencil attachment used during rendering, or nil if there
+	// is no stencil attachment
+	StencilAttachment *RenderingAttachmentInfo
+
+	common.NextOptions
+}
+
+func (o RenderingInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkRenderingInfo{})))
+	}
+
+	info := (*C.VkRenderingInfo)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_RENDERING_INFO
+	info.pNext = next
+	info.flags = C.VkRenderingFlags(o.Flags)
+	info.renderArea.offset.x = C.int32_t(o.RenderArea.Offset.X)
+	info.renderArea.offset.y = C.int32_t(o.RenderArea.Offset.Y)
+	info.renderArea.extent.width = C.uint32_t(o.RenderArea.Extent.Width)
+	info.renderArea.extent.height = C.uint32_t(o.RenderArea.Extent.Height)
+	info.layerCount = C.uint32_t(o.LayerCount)
+	info.viewMask = C.uint32_t(o.ViewMask)
+
+	colorAttachmentCount := len(o.ColorAttachments)
+	info.colorAttachmentCount = C.uint32_t(colorAttachmentCount)
+	info.pColorAttachments = nil
+	info.pDepthAttachment = nil
+	info.pStencilAttachment = nil
+
+	var err error
+	if colorAttachmentCount > 0 {
+		info.pColorAttachments, err = common.AllocOptionSlice[C.VkRenderingAttachmentInfo, RenderingAttachmentInfo](allocator, o.ColorAttachments)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if o.DepthAttachment != nil {
+		depthPtr, err := common.AllocOptions(allocator, o.DepthAttachment)
+		if err != nil {
+			return nil, err
+		}
+
+		info.pDepthAttachment = (*C.VkRenderingAttachmentInfo)(depthPtr)
+	}
+
+	if o.StencilAttachment != nil {
+		stencilPtr, err := common.AllocOptions(allocator, o.StencilAttachment)
+		if err != nil {
+			return nil, err
+		}
+
+		info.pStencilAttachment = (*C.VkRenderingAttachmentInfo)(stencilPtr)
+	}
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// PipelineRenderingCreateInfo specifies the attachment formats used with dynamic rendering.
+// It can be chained onto core1_0.GraphicsPipelineCreateInfo to create a Pipeline without a
+// RenderPass.
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPipelineRenderingCreateInfo.html
+type PipelineRenderingCreateInfo struct {
+	// ViewMask is the view mask used for rendering
+	ViewMask uint32
+	// ColorAttachmentFormats is a slice of core1_0.Format values defining the format of color
+	// attachments used in this Pipeline
+	ColorAttachmentFormats []core1_0.Format
+	// DepthAttachmentFormat is the format of the depth attachment used in this Pipeline
+	DepthAttachmentFormat core1_0.Format
+	// StencilAttachmentFormat is the format of the stencil attachment used in this Pipeline
+	StencilAttachmentFormat core1_0.Format
+
+	common.NextOptions
+}
+
+func (o PipelineRenderingCreateInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkPipelineRenderingCreateInfo{})))
+	}
+
+	info := (*C.VkPipelineRenderingCreateInfo)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_PIPELINE_RENDERING_CREATE_INFO
+	info.pNext = next
+	info.viewMask = C.uint32_t(o.ViewMask)
+	info.depthAttachmentFormat = C.VkFormat(o.DepthAttachmentFormat)
+	info.stencilAttachmentFormat = C.VkFormat(o.StencilAttachmentFormat)
+
+	colorAttachmentCount := len(o.ColorAttachmentFormats)
+	info.colorAttachmentCount = C.uint32_t(colorAttachmentCount)
+	info.pColorAttachmentFormats = nil
+
+	if colorAttachmentCount > 0 {
+		formatsPtr := (*C.VkFormat)(allocator.Malloc(colorAttachmentCount * int(unsafe.Sizeof(C.VkFormat(0)))))
+		formatsSlice := unsafe.Slice(formatsPtr, colorAttachmentCount)
+		for i := 0; i < colorAttachmentCount; i++ {
+			formatsSlice[i] = C.VkFormat(o.ColorAttachmentFormats[i])
+		}
+		info.pColorAttachmentFormats = formatsPtr
+	}
+
+	return preallocatedPointer, nil
+}
+
+////
+
+// CommandBufferInheritanceRenderingInfo specifies the attachment formats of the dynamic render
+// pass instance a secondary CommandBuffer will be executed in. It can be chained onto
+// core1_0.CommandBufferInheritanceInfo.
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkCommandBufferInheritanceRenderingInfo.html
+type CommandBufferInheritanceRenderingInfo struct {
+	// Flags are used by the secondary CommandBuffer and must match the Flags of the
+	// RenderingInfo passed to CommandBuffer.CmdBeginRendering, other than
+	// RenderingContentsSecondaryCommandBuffers
+	Flags RenderingFlags
+	// ViewMask is the view mask used for rendering
+	ViewMask uint32
+	// ColorAttachmentFormats is a slice of core1_0.Format values defining the format of color
+	// attachments
+	ColorAttachmentFormats []core1_0.Format
+	// DepthAttachmentFormat is the format of the depth attachment
+	DepthAttachmentFormat core1_0.Format
+	// StencilAttachmentFormat is the format of the stencil attachment
+	StencilAttachmentFormat core1_0.Format
+	// RasterizationSamples specifies the number of samples used in rasterization
+	RasterizationSamples core1_0.SampleCountFlags
+
+	common.NextOptions
+}
+
+func (o CommandBufferInheritanceRenderingInfo) PopulateCPointer(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkCommandBufferInheritanceRenderingInfo{})))
+	}
+
+	info := (*C.VkCommandBufferInheritanceRenderingInfo)(preallocatedPointer)
+	info.sType = C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_RENDERING_INFO
+	info.pNext = next
+	info.flags = C.VkRenderingFlags(o.Flags)
+	info.viewMask = C.uint32_t(o.ViewMask)
+	info.depthAttachmentFormat = C.VkFormat(o.DepthAttachmentFormat)
+	info.stencilAttachmentFormat = C.VkFormat(o.StencilAttachmentFormat)
+	info.rasterizationSamples = C.VkSampleCountFlagBits(o.RasterizationSamples)
+
+	colorAttachmentCount := len(o.ColorAttachmentFormats)
+	info.colorAttachmentCount = C.uint32_t(colorAttachmentCount)
+	info.pColorAttachmentFormats = nil
+
+	if colorAttachmentCount > 0 {
+		formatsPtr := (*C.VkFormat)(allocator.Malloc(colorAttachmentCount * int(unsafe.Sizeof(C.VkFormat(0)))))
+		formatsSlice := unsafe.Slice(formatsPtr, colorAttachmentCount)
+		for i := 0; i < colorAttachmentCount; i++ {
+			formatsSlice[i] = C.VkFormat(o.ColorAttachmentFormats[i])
+		}
+		info.pColorAttachmentFormats = formatsPtr
+	}
+
+	return preallocatedPointer, nil
+}
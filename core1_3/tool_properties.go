@@ -0,0 +1,89 @@
+package core1_3
+
+/*
+#include <stdlib.h>
+#include "../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/vkngwrapper/core/v2/common"
+	"unsafe"
+)
+
+const (
+	// ToolPurposeValidation specifies that the tool provides validation of API usage
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkToolPurposeFlagBits.html
+	ToolPurposeValidation ToolPurposeFlags = C.VK_TOOL_PURPOSE_VALIDATION_BIT
+	// ToolPurposeProfiling specifies that the tool provides profiling of API usage
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkToolPurposeFlagBits.html
+	ToolPurposeProfiling ToolPurposeFlags = C.VK_TOOL_PURPOSE_PROFILING_BIT
+	// ToolPurposeTracing specifies that the tool is capturing data about the application's API
+	// usage, including anything from simple logging to capturing data for later replay
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkToolPurposeFlagBits.html
+	ToolPurposeTracing ToolPurposeFlags = C.VK_TOOL_PURPOSE_TRACING_BIT
+	// ToolPurposeAdditionalFeatures specifies that the tool provides additional API features or
+	// extensions on top of the underlying implementation
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkToolPurposeFlagBits.html
+	ToolPurposeAdditionalFeatures ToolPurposeFlags = C.VK_TOOL_PURPOSE_ADDITIONAL_FEATURES_BIT
+	// ToolPurposeModifyingFeatures specifies that the tool modifies the API features, limits,
+	// or extensions presented to the application
+	//
+	// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkToolPurposeFlagBits.html
+	ToolPurposeModifyingFeatures ToolPurposeFlags = C.VK_TOOL_PURPOSE_MODIFYING_FEATURES_BIT
+)
+
+func init() {
+	ToolPurposeValidation.Register("Validation")
+	ToolPurposeProfiling.Register("Profiling")
+	ToolPurposeTracing.Register("Tracing")
+	ToolPurposeAdditionalFeatures.Register("Additional Features")
+	ToolPurposeModifyingFeatures.Register("Modifying Features")
+}
+
+// PhysicalDeviceToolProperties describes a tool, such as a validation layer or capture tool,
+// that is active for a PhysicalDevice
+//
+// https://registry.khronos.org/vulkan/specs/1.3-extensions/man/html/VkPhysicalDeviceToolProperties.html
+type PhysicalDeviceToolProperties struct {
+	// Name is the name of the tool
+	Name string
+	// Version is the version of the tool
+	Version string
+	// Purposes is a bitmask of ToolPurposeFlags indicating what the tool does
+	Purposes ToolPurposeFlags
+	// Description is a description of the tool
+	Description string
+	// Layer is the name of the layer implementing the tool, if the tool is implemented
+	// in a layer. Otherwise, it is empty
+	Layer string
+
+	common.NextOutData
+}
+
+func (o *PhysicalDeviceToolProperties) PopulateHeader(allocator *cgoparam.Allocator, preallocatedPointer unsafe.Pointer, next unsafe.Pointer) (unsafe.Pointer, error) {
+	if preallocatedPointer == nil {
+		preallocatedPointer = allocator.Malloc(int(unsafe.Sizeof(C.VkPhysicalDeviceToolProperties{})))
+	}
+
+	outData := (*C.VkPhysicalDeviceToolProperties)(preallocatedPointer)
+	outData.sType = C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TOOL_PROPERTIES
+	outData.pNext = next
+
+	return preallocatedPointer, nil
+}
+
+func (o *PhysicalDeviceToolProperties) PopulateOutData(cDataPointer unsafe.Pointer, helpers ...any) (next unsafe.Pointer, err error) {
+	outData := (*C.VkPhysicalDeviceToolProperties)(cDataPointer)
+	o.Name = C.GoString(&outData.name[0])
+	o.Version = C.GoString(&outData.version[0])
+	o.Purposes = ToolPurposeFlags(outData.purposes)
+	o.Description = C.GoString(&outData.description[0])
+	o.Layer = C.GoString(&outData.layer[0])
+
+	return outData.pNext, nil
+}
@@ -1,7 +1,6 @@
 package driver
 
 /*
-#cgo LDFLAGS: -lvulkan
 #include "driver.h"
 */
 import "C"
@@ -11,6 +10,10 @@ import (
 )
 
 func (l *vulkanDriver) VkEnumerateInstanceExtensionProperties(pLayerName *Char, pPropertyCount *Uint32, pProperties *VkExtensionProperties) (common.VkResult, error) {
+	if l.funcPtrs.vkEnumerateInstanceExtensionProperties == nil {
+		return vkErrorUnknown, missingCommand("vkEnumerateInstanceExtensionProperties")
+	}
+
 	res := common.VkResult(C.cgoEnumerateInstanceExtensionProperties(l.funcPtrs.vkEnumerateInstanceExtensionProperties,
 		(*C.char)(pLayerName),
 		(*C.uint32_t)(pPropertyCount),
@@ -19,6 +22,10 @@ func (l *vulkanDriver) VkEnumerateInstanceExtensionProperties(pLayerName *Char,
 }
 
 func (l *vulkanDriver) VkEnumerateInstanceLayerProperties(pPropertyCount *Uint32, pProperties *VkLayerProperties) (common.VkResult, error) {
+	if l.funcPtrs.vkEnumerateInstanceLayerProperties == nil {
+		return vkErrorUnknown, missingCommand("vkEnumerateInstanceLayerProperties")
+	}
+
 	res := common.VkResult(C.cgoEnumerateInstanceLayerProperties(l.funcPtrs.vkEnumerateInstanceLayerProperties,
 		(*C.uint32_t)(pPropertyCount),
 		(*C.VkLayerProperties)(pProperties)))
@@ -26,6 +33,10 @@ func (l *vulkanDriver) VkEnumerateInstanceLayerProperties(pPropertyCount *Uint32
 }
 
 func (l *vulkanDriver) VkCreateInstance(pCreateInfo *VkInstanceCreateInfo, pAllocator *VkAllocationCallbacks, pInstance *VkInstance) (common.VkResult, error) {
+	if l.funcPtrs.vkCreateInstance == nil {
+		return vkErrorUnknown, missingCommand("vkCreateInstance")
+	}
+
 	res := common.VkResult(C.cgoCreateInstance(l.funcPtrs.vkCreateInstance,
 		(*C.VkInstanceCreateInfo)(pCreateInfo),
 		(*C.VkAllocationCallbacks)(pAllocator),
@@ -35,7 +46,11 @@ func (l *vulkanDriver) VkCreateInstance(pCreateInfo *VkInstanceCreateInfo, pAllo
 
 func (l *vulkanDriver) VkEnumeratePhysicalDevices(instance VkInstance, pPhysicalDeviceCount *Uint32, pPhysicalDevices *VkPhysicalDevice) (common.VkResult, error) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkEnumeratePhysicalDevices", Err: ErrNotInstanceDriver}
+	}
+
+	if l.funcPtrs.vkEnumeratePhysicalDevices == nil {
+		return vkErrorUnknown, missingCommand("vkEnumeratePhysicalDevices")
 	}
 
 	res := common.VkResult(C.cgoEnumeratePhysicalDevices(l.funcPtrs.vkEnumeratePhysicalDevices,
@@ -47,7 +62,11 @@ func (l *vulkanDriver) VkEnumeratePhysicalDevices(instance VkInstance, pPhysical
 
 func (l *vulkanDriver) VkDestroyInstance(instance VkInstance, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		panic(&common.FunctionError{Function: "vkDestroyInstance", Err: ErrNotInstanceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyInstance == nil {
+		panic(missingCommand("vkDestroyInstance"))
 	}
 
 	C.cgoDestroyInstance(l.funcPtrs.vkDestroyInstance,
@@ -57,7 +76,11 @@ func (l *vulkanDriver) VkDestroyInstance(instance VkInstance, pAllocator *VkAllo
 
 func (l *vulkanDriver) VkGetPhysicalDeviceFeatures(physicalDevice VkPhysicalDevice, pFeatures *VkPhysicalDeviceFeatures) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		panic(&common.FunctionError{Function: "vkGetPhysicalDeviceFeatures", Err: ErrNotInstanceDriver})
+	}
+
+	if l.funcPtrs.vkGetPhysicalDeviceFeatures == nil {
+		panic(missingCommand("vkGetPhysicalDeviceFeatures"))
 	}
 
 	C.cgoGetPhysicalDeviceFeatures(l.funcPtrs.vkGetPhysicalDeviceFeatures,
@@ -67,7 +90,11 @@ func (l *vulkanDriver) VkGetPhysicalDeviceFeatures(physicalDevice VkPhysicalDevi
 
 func (l *vulkanDriver) VkGetPhysicalDeviceFormatProperties(physicalDevice VkPhysicalDevice, format VkFormat, pFormatProperties *VkFormatProperties) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		panic(&common.FunctionError{Function: "vkGetPhysicalDeviceFormatProperties", Err: ErrNotInstanceDriver})
+	}
+
+	if l.funcPtrs.vkGetPhysicalDeviceFormatProperties == nil {
+		panic(missingCommand("vkGetPhysicalDeviceFormatProperties"))
 	}
 
 	C.cgoGetPhysicalDeviceFormatProperties(l.funcPtrs.vkGetPhysicalDeviceFormatProperties,
@@ -78,7 +105,11 @@ func (l *vulkanDriver) VkGetPhysicalDeviceFormatProperties(physicalDevice VkPhys
 
 func (l *vulkanDriver) VkGetPhysicalDeviceImageFormatProperties(physicalDevice VkPhysicalDevice, format VkFormat, t VkImageType, tiling VkImageTiling, usage VkImageUsageFlags, flags VkImageCreateFlags, pImageFormatProperties *VkImageFormatProperties) (common.VkResult, error) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkGetPhysicalDeviceImageFormatProperties", Err: ErrNotInstanceDriver}
+	}
+
+	if l.funcPtrs.vkGetPhysicalDeviceImageFormatProperties == nil {
+		return vkErrorUnknown, missingCommand("vkGetPhysicalDeviceImageFormatProperties")
 	}
 
 	res := common.VkResult(C.cgoGetPhysicalDeviceImageFormatProperties(l.funcPtrs.vkGetPhysicalDeviceImageFormatProperties,
@@ -94,7 +125,11 @@ func (l *vulkanDriver) VkGetPhysicalDeviceImageFormatProperties(physicalDevice V
 
 func (l *vulkanDriver) VkGetPhysicalDeviceProperties(physicalDevice VkPhysicalDevice, pProperties *VkPhysicalDeviceProperties) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		panic(&common.FunctionError{Function: "vkGetPhysicalDeviceProperties", Err: ErrNotInstanceDriver})
+	}
+
+	if l.funcPtrs.vkGetPhysicalDeviceProperties == nil {
+		panic(missingCommand("vkGetPhysicalDeviceProperties"))
 	}
 
 	C.cgoGetPhysicalDeviceProperties(l.funcPtrs.vkGetPhysicalDeviceProperties,
@@ -104,7 +139,11 @@ func (l *vulkanDriver) VkGetPhysicalDeviceProperties(physicalDevice VkPhysicalDe
 
 func (l *vulkanDriver) VkGetPhysicalDeviceQueueFamilyProperties(physicalDevice VkPhysicalDevice, pQueueFamilyPropertyCount *Uint32, pQueueFamilyProperties *VkQueueFamilyProperties) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		panic(&common.FunctionError{Function: "vkGetPhysicalDeviceQueueFamilyProperties", Err: ErrNotInstanceDriver})
+	}
+
+	if l.funcPtrs.vkGetPhysicalDeviceQueueFamilyProperties == nil {
+		panic(missingCommand("vkGetPhysicalDeviceQueueFamilyProperties"))
 	}
 
 	C.cgoGetPhysicalDeviceQueueFamilyProperties(l.funcPtrs.vkGetPhysicalDeviceQueueFamilyProperties,
@@ -115,7 +154,11 @@ func (l *vulkanDriver) VkGetPhysicalDeviceQueueFamilyProperties(physicalDevice V
 
 func (l *vulkanDriver) VkGetPhysicalDeviceMemoryProperties(physicalDevice VkPhysicalDevice, pMemoryProperties *VkPhysicalDeviceMemoryProperties) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		panic(&common.FunctionError{Function: "vkGetPhysicalDeviceMemoryProperties", Err: ErrNotInstanceDriver})
+	}
+
+	if l.funcPtrs.vkGetPhysicalDeviceMemoryProperties == nil {
+		panic(missingCommand("vkGetPhysicalDeviceMemoryProperties"))
 	}
 
 	C.cgoGetPhysicalDeviceMemoryProperties(l.funcPtrs.vkGetPhysicalDeviceMemoryProperties,
@@ -125,7 +168,11 @@ func (l *vulkanDriver) VkGetPhysicalDeviceMemoryProperties(physicalDevice VkPhys
 
 func (l *vulkanDriver) VkEnumerateDeviceExtensionProperties(physicalDevice VkPhysicalDevice, pLayerName *Char, pPropertyCount *Uint32, pProperties *VkExtensionProperties) (common.VkResult, error) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkEnumerateDeviceExtensionProperties", Err: ErrNotInstanceDriver}
+	}
+
+	if l.funcPtrs.vkEnumerateDeviceExtensionProperties == nil {
+		return vkErrorUnknown, missingCommand("vkEnumerateDeviceExtensionProperties")
 	}
 
 	res := common.VkResult(C.cgoEnumerateDeviceExtensionProperties(l.funcPtrs.vkEnumerateDeviceExtensionProperties,
@@ -138,7 +185,11 @@ func (l *vulkanDriver) VkEnumerateDeviceExtensionProperties(physicalDevice VkPhy
 
 func (l *vulkanDriver) VkEnumerateDeviceLayerProperties(physicalDevice VkPhysicalDevice, pPropertyCount *Uint32, pProperties *VkLayerProperties) (common.VkResult, error) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkEnumerateDeviceLayerProperties", Err: ErrNotInstanceDriver}
+	}
+
+	if l.funcPtrs.vkEnumerateDeviceLayerProperties == nil {
+		return vkErrorUnknown, missingCommand("vkEnumerateDeviceLayerProperties")
 	}
 
 	res := common.VkResult(C.cgoEnumerateDeviceLayerProperties(l.funcPtrs.vkEnumerateDeviceLayerProperties,
@@ -150,7 +201,11 @@ func (l *vulkanDriver) VkEnumerateDeviceLayerProperties(physicalDevice VkPhysica
 
 func (l *vulkanDriver) VkGetPhysicalDeviceSparseImageFormatProperties(physicalDevice VkPhysicalDevice, format VkFormat, t VkImageType, samples VkSampleCountFlagBits, usage VkImageUsageFlags, tiling VkImageTiling, pPropertyCount *Uint32, pProperties *VkSparseImageFormatProperties) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		panic(&common.FunctionError{Function: "vkGetPhysicalDeviceSparseImageFormatProperties", Err: ErrNotInstanceDriver})
+	}
+
+	if l.funcPtrs.vkGetPhysicalDeviceSparseImageFormatProperties == nil {
+		panic(missingCommand("vkGetPhysicalDeviceSparseImageFormatProperties"))
 	}
 
 	C.cgoGetPhysicalDeviceSparseImageFormatProperties(l.funcPtrs.vkGetPhysicalDeviceSparseImageFormatProperties,
@@ -166,7 +221,11 @@ func (l *vulkanDriver) VkGetPhysicalDeviceSparseImageFormatProperties(physicalDe
 
 func (l *vulkanDriver) VkCreateDevice(physicalDevice VkPhysicalDevice, pCreateInfo *VkDeviceCreateInfo, pAllocator *VkAllocationCallbacks, pDevice *VkDevice) (common.VkResult, error) {
 	if VulkanHandle(l.instance) == NullHandle {
-		panic("attempted to call instance driver function on a basic driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateDevice", Err: ErrNotInstanceDriver}
+	}
+
+	if l.funcPtrs.vkCreateDevice == nil {
+		return vkErrorUnknown, missingCommand("vkCreateDevice")
 	}
 
 	res := common.VkResult(C.cgoCreateDevice(l.funcPtrs.vkCreateDevice,
@@ -179,7 +238,11 @@ func (l *vulkanDriver) VkCreateDevice(physicalDevice VkPhysicalDevice, pCreateIn
 
 func (l *vulkanDriver) VkDestroyDevice(device VkDevice, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyDevice", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyDevice == nil {
+		panic(missingCommand("vkDestroyDevice"))
 	}
 
 	C.cgoDestroyDevice(l.funcPtrs.vkDestroyDevice,
@@ -189,7 +252,11 @@ func (l *vulkanDriver) VkDestroyDevice(device VkDevice, pAllocator *VkAllocation
 
 func (l *vulkanDriver) VkGetDeviceQueue(device VkDevice, queueFamilyIndex Uint32, queueIndex Uint32, pQueue *VkQueue) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkGetDeviceQueue", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkGetDeviceQueue == nil {
+		panic(missingCommand("vkGetDeviceQueue"))
 	}
 
 	C.cgoGetDeviceQueue(l.funcPtrs.vkGetDeviceQueue,
@@ -201,7 +268,11 @@ func (l *vulkanDriver) VkGetDeviceQueue(device VkDevice, queueFamilyIndex Uint32
 
 func (l *vulkanDriver) VkQueueSubmit(queue VkQueue, submitCount Uint32, pSubmits *VkSubmitInfo, fence VkFence) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkQueueSubmit", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkQueueSubmit == nil {
+		return vkErrorUnknown, missingCommand("vkQueueSubmit")
 	}
 
 	res := common.VkResult(C.cgoQueueSubmit(l.funcPtrs.vkQueueSubmit,
@@ -214,7 +285,11 @@ func (l *vulkanDriver) VkQueueSubmit(queue VkQueue, submitCount Uint32, pSubmits
 
 func (l *vulkanDriver) VkQueueWaitIdle(queue VkQueue) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkQueueWaitIdle", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkQueueWaitIdle == nil {
+		return vkErrorUnknown, missingCommand("vkQueueWaitIdle")
 	}
 
 	res := common.VkResult(C.cgoQueueWaitIdle(l.funcPtrs.vkQueueWaitIdle,
@@ -224,7 +299,11 @@ func (l *vulkanDriver) VkQueueWaitIdle(queue VkQueue) (common.VkResult, error) {
 
 func (l *vulkanDriver) VkDeviceWaitIdle(device VkDevice) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkDeviceWaitIdle", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkDeviceWaitIdle == nil {
+		return vkErrorUnknown, missingCommand("vkDeviceWaitIdle")
 	}
 
 	res := common.VkResult(C.cgoDeviceWaitIdle(l.funcPtrs.vkDeviceWaitIdle,
@@ -234,7 +313,11 @@ func (l *vulkanDriver) VkDeviceWaitIdle(device VkDevice) (common.VkResult, error
 
 func (l *vulkanDriver) VkAllocateMemory(device VkDevice, pAllocateInfo *VkMemoryAllocateInfo, pAllocator *VkAllocationCallbacks, pMemory *VkDeviceMemory) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkAllocateMemory", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkAllocateMemory == nil {
+		return vkErrorUnknown, missingCommand("vkAllocateMemory")
 	}
 
 	res := common.VkResult(C.cgoAllocateMemory(l.funcPtrs.vkAllocateMemory,
@@ -247,7 +330,11 @@ func (l *vulkanDriver) VkAllocateMemory(device VkDevice, pAllocateInfo *VkMemory
 
 func (l *vulkanDriver) VkFreeMemory(device VkDevice, memory VkDeviceMemory, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkFreeMemory", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkFreeMemory == nil {
+		panic(missingCommand("vkFreeMemory"))
 	}
 
 	C.cgoFreeMemory(l.funcPtrs.vkFreeMemory,
@@ -258,7 +345,11 @@ func (l *vulkanDriver) VkFreeMemory(device VkDevice, memory VkDeviceMemory, pAll
 
 func (l *vulkanDriver) VkMapMemory(device VkDevice, memory VkDeviceMemory, offset VkDeviceSize, size VkDeviceSize, flags VkMemoryMapFlags, ppData *unsafe.Pointer) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkMapMemory", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkMapMemory == nil {
+		return vkErrorUnknown, missingCommand("vkMapMemory")
 	}
 
 	res := common.VkResult(C.cgoMapMemory(l.funcPtrs.vkMapMemory,
@@ -273,7 +364,11 @@ func (l *vulkanDriver) VkMapMemory(device VkDevice, memory VkDeviceMemory, offse
 
 func (l *vulkanDriver) VkUnmapMemory(device VkDevice, memory VkDeviceMemory) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkUnmapMemory", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkUnmapMemory == nil {
+		panic(missingCommand("vkUnmapMemory"))
 	}
 
 	C.cgoUnmapMemory(l.funcPtrs.vkUnmapMemory,
@@ -283,7 +378,11 @@ func (l *vulkanDriver) VkUnmapMemory(device VkDevice, memory VkDeviceMemory) {
 
 func (l *vulkanDriver) VkFlushMappedMemoryRanges(device VkDevice, memoryRangeCount Uint32, pMemoryRanges *VkMappedMemoryRange) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkFlushMappedMemoryRanges", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkFlushMappedMemoryRanges == nil {
+		return vkErrorUnknown, missingCommand("vkFlushMappedMemoryRanges")
 	}
 
 	res := common.VkResult(C.cgoFlushMappedMemoryRanges(l.funcPtrs.vkFlushMappedMemoryRanges,
@@ -295,7 +394,11 @@ func (l *vulkanDriver) VkFlushMappedMemoryRanges(device VkDevice, memoryRangeCou
 
 func (l *vulkanDriver) VkInvalidateMappedMemoryRanges(device VkDevice, memoryRangeCount Uint32, pMemoryRanges *VkMappedMemoryRange) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkInvalidateMappedMemoryRanges", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkInvalidateMappedMemoryRanges == nil {
+		return vkErrorUnknown, missingCommand("vkInvalidateMappedMemoryRanges")
 	}
 
 	res := common.VkResult(C.cgoInvalidateMappedMemoryRanges(l.funcPtrs.vkInvalidateMappedMemoryRanges,
@@ -307,7 +410,11 @@ func (l *vulkanDriver) VkInvalidateMappedMemoryRanges(device VkDevice, memoryRan
 
 func (l *vulkanDriver) VkGetDeviceMemoryCommitment(device VkDevice, memory VkDeviceMemory, pCommittedMemoryInBytes *VkDeviceSize) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkGetDeviceMemoryCommitment", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkGetDeviceMemoryCommitment == nil {
+		panic(missingCommand("vkGetDeviceMemoryCommitment"))
 	}
 
 	C.cgoGetDeviceMemoryCommitment(l.funcPtrs.vkGetDeviceMemoryCommitment,
@@ -318,7 +425,11 @@ func (l *vulkanDriver) VkGetDeviceMemoryCommitment(device VkDevice, memory VkDev
 
 func (l *vulkanDriver) VkBindBufferMemory(device VkDevice, buffer VkBuffer, memory VkDeviceMemory, memoryOffset VkDeviceSize) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkBindBufferMemory", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkBindBufferMemory == nil {
+		return vkErrorUnknown, missingCommand("vkBindBufferMemory")
 	}
 
 	res := common.VkResult(C.cgoBindBufferMemory(l.funcPtrs.vkBindBufferMemory,
@@ -331,7 +442,11 @@ func (l *vulkanDriver) VkBindBufferMemory(device VkDevice, buffer VkBuffer, memo
 
 func (l *vulkanDriver) VkBindImageMemory(device VkDevice, image VkImage, memory VkDeviceMemory, memoryOffset VkDeviceSize) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkBindImageMemory", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkBindImageMemory == nil {
+		return vkErrorUnknown, missingCommand("vkBindImageMemory")
 	}
 
 	res := common.VkResult(C.cgoBindImageMemory(l.funcPtrs.vkBindImageMemory,
@@ -344,7 +459,11 @@ func (l *vulkanDriver) VkBindImageMemory(device VkDevice, image VkImage, memory
 
 func (l *vulkanDriver) VkGetBufferMemoryRequirements(device VkDevice, buffer VkBuffer, pMemoryRequirements *VkMemoryRequirements) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkGetBufferMemoryRequirements", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkGetBufferMemoryRequirements == nil {
+		panic(missingCommand("vkGetBufferMemoryRequirements"))
 	}
 
 	C.cgoGetBufferMemoryRequirements(l.funcPtrs.vkGetBufferMemoryRequirements,
@@ -355,7 +474,11 @@ func (l *vulkanDriver) VkGetBufferMemoryRequirements(device VkDevice, buffer VkB
 
 func (l *vulkanDriver) VkGetImageMemoryRequirements(device VkDevice, image VkImage, pMemoryRequirements *VkMemoryRequirements) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkGetImageMemoryRequirements", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkGetImageMemoryRequirements == nil {
+		panic(missingCommand("vkGetImageMemoryRequirements"))
 	}
 
 	C.cgoGetImageMemoryRequirements(l.funcPtrs.vkGetImageMemoryRequirements,
@@ -366,7 +489,11 @@ func (l *vulkanDriver) VkGetImageMemoryRequirements(device VkDevice, image VkIma
 
 func (l *vulkanDriver) VkGetImageSparseMemoryRequirements(device VkDevice, image VkImage, pSparseMemoryRequirementCount *Uint32, pSparseMemoryRequirements *VkSparseImageMemoryRequirements) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkGetImageSparseMemoryRequirements", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkGetImageSparseMemoryRequirements == nil {
+		panic(missingCommand("vkGetImageSparseMemoryRequirements"))
 	}
 
 	C.cgoGetImageSparseMemoryRequirements(l.funcPtrs.vkGetImageSparseMemoryRequirements,
@@ -378,7 +505,11 @@ func (l *vulkanDriver) VkGetImageSparseMemoryRequirements(device VkDevice, image
 
 func (l *vulkanDriver) VkQueueBindSparse(queue VkQueue, bindInfoCount Uint32, pBindInfo *VkBindSparseInfo, fence VkFence) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkQueueBindSparse", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkQueueBindSparse == nil {
+		return vkErrorUnknown, missingCommand("vkQueueBindSparse")
 	}
 
 	res := common.VkResult(C.cgoQueueBindSparse(l.funcPtrs.vkQueueBindSparse,
@@ -391,7 +522,11 @@ func (l *vulkanDriver) VkQueueBindSparse(queue VkQueue, bindInfoCount Uint32, pB
 
 func (l *vulkanDriver) VkCreateFence(device VkDevice, pCreateInfo *VkFenceCreateInfo, pAllocator *VkAllocationCallbacks, pFence *VkFence) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateFence", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateFence == nil {
+		return vkErrorUnknown, missingCommand("vkCreateFence")
 	}
 
 	res := common.VkResult(C.cgoCreateFence(l.funcPtrs.vkCreateFence,
@@ -404,7 +539,11 @@ func (l *vulkanDriver) VkCreateFence(device VkDevice, pCreateInfo *VkFenceCreate
 
 func (l *vulkanDriver) VkDestroyFence(device VkDevice, fence VkFence, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyFence", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyFence == nil {
+		panic(missingCommand("vkDestroyFence"))
 	}
 
 	C.cgoDestroyFence(l.funcPtrs.vkDestroyFence,
@@ -415,7 +554,11 @@ func (l *vulkanDriver) VkDestroyFence(device VkDevice, fence VkFence, pAllocator
 
 func (l *vulkanDriver) VkResetFences(device VkDevice, fenceCount Uint32, pFences *VkFence) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkResetFences", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkResetFences == nil {
+		return vkErrorUnknown, missingCommand("vkResetFences")
 	}
 
 	res := common.VkResult(C.cgoResetFences(l.funcPtrs.vkResetFences,
@@ -427,7 +570,11 @@ func (l *vulkanDriver) VkResetFences(device VkDevice, fenceCount Uint32, pFences
 
 func (l *vulkanDriver) VkGetFenceStatus(device VkDevice, fence VkFence) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkGetFenceStatus", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkGetFenceStatus == nil {
+		return vkErrorUnknown, missingCommand("vkGetFenceStatus")
 	}
 
 	res := common.VkResult(C.cgoGetFenceStatus(l.funcPtrs.vkGetFenceStatus,
@@ -438,7 +585,11 @@ func (l *vulkanDriver) VkGetFenceStatus(device VkDevice, fence VkFence) (common.
 
 func (l *vulkanDriver) VkWaitForFences(device VkDevice, fenceCount Uint32, pFences *VkFence, waitAll VkBool32, timeout Uint64) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkWaitForFences", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkWaitForFences == nil {
+		return vkErrorUnknown, missingCommand("vkWaitForFences")
 	}
 
 	res := common.VkResult(C.cgoWaitForFences(l.funcPtrs.vkWaitForFences,
@@ -452,7 +603,11 @@ func (l *vulkanDriver) VkWaitForFences(device VkDevice, fenceCount Uint32, pFenc
 
 func (l *vulkanDriver) VkCreateSemaphore(device VkDevice, pCreateInfo *VkSemaphoreCreateInfo, pAllocator *VkAllocationCallbacks, pSemaphore *VkSemaphore) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateSemaphore", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateSemaphore == nil {
+		return vkErrorUnknown, missingCommand("vkCreateSemaphore")
 	}
 
 	res := common.VkResult(C.cgoCreateSemaphore(l.funcPtrs.vkCreateSemaphore,
@@ -465,7 +620,11 @@ func (l *vulkanDriver) VkCreateSemaphore(device VkDevice, pCreateInfo *VkSemapho
 
 func (l *vulkanDriver) VkDestroySemaphore(device VkDevice, semaphore VkSemaphore, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroySemaphore", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroySemaphore == nil {
+		panic(missingCommand("vkDestroySemaphore"))
 	}
 
 	C.cgoDestroySemaphore(l.funcPtrs.vkDestroySemaphore,
@@ -476,7 +635,11 @@ func (l *vulkanDriver) VkDestroySemaphore(device VkDevice, semaphore VkSemaphore
 
 func (l *vulkanDriver) VkCreateEvent(device VkDevice, pCreateInfo *VkEventCreateInfo, pAllocator *VkAllocationCallbacks, pEvent *VkEvent) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateEvent", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateEvent == nil {
+		return vkErrorUnknown, missingCommand("vkCreateEvent")
 	}
 
 	res := common.VkResult(C.cgoCreateEvent(l.funcPtrs.vkCreateEvent,
@@ -489,7 +652,11 @@ func (l *vulkanDriver) VkCreateEvent(device VkDevice, pCreateInfo *VkEventCreate
 
 func (l *vulkanDriver) VkDestroyEvent(device VkDevice, event VkEvent, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyEvent", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyEvent == nil {
+		panic(missingCommand("vkDestroyEvent"))
 	}
 
 	C.cgoDestroyEvent(l.funcPtrs.vkDestroyEvent,
@@ -500,7 +667,11 @@ func (l *vulkanDriver) VkDestroyEvent(device VkDevice, event VkEvent, pAllocator
 
 func (l *vulkanDriver) VkGetEventStatus(device VkDevice, event VkEvent) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkGetEventStatus", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkGetEventStatus == nil {
+		return vkErrorUnknown, missingCommand("vkGetEventStatus")
 	}
 
 	res := common.VkResult(C.cgoGetEventStatus(l.funcPtrs.vkGetEventStatus,
@@ -511,7 +682,11 @@ func (l *vulkanDriver) VkGetEventStatus(device VkDevice, event VkEvent) (common.
 
 func (l *vulkanDriver) VkSetEvent(device VkDevice, event VkEvent) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkSetEvent", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkSetEvent == nil {
+		return vkErrorUnknown, missingCommand("vkSetEvent")
 	}
 
 	res := common.VkResult(C.cgoSetEvent(l.funcPtrs.vkSetEvent,
@@ -522,7 +697,11 @@ func (l *vulkanDriver) VkSetEvent(device VkDevice, event VkEvent) (common.VkResu
 
 func (l *vulkanDriver) VkResetEvent(device VkDevice, event VkEvent) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkResetEvent", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkResetEvent == nil {
+		return vkErrorUnknown, missingCommand("vkResetEvent")
 	}
 
 	res := common.VkResult(C.cgoResetEvent(l.funcPtrs.vkResetEvent,
@@ -533,7 +712,11 @@ func (l *vulkanDriver) VkResetEvent(device VkDevice, event VkEvent) (common.VkRe
 
 func (l *vulkanDriver) VkCreateQueryPool(device VkDevice, pCreateInfo *VkQueryPoolCreateInfo, pAllocator *VkAllocationCallbacks, pQueryPool *VkQueryPool) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateQueryPool", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateQueryPool == nil {
+		return vkErrorUnknown, missingCommand("vkCreateQueryPool")
 	}
 
 	res := common.VkResult(C.cgoCreateQueryPool(l.funcPtrs.vkCreateQueryPool,
@@ -546,7 +729,11 @@ func (l *vulkanDriver) VkCreateQueryPool(device VkDevice, pCreateInfo *VkQueryPo
 
 func (l *vulkanDriver) VkDestroyQueryPool(device VkDevice, queryPool VkQueryPool, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyQueryPool", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyQueryPool == nil {
+		panic(missingCommand("vkDestroyQueryPool"))
 	}
 
 	C.cgoDestroyQueryPool(l.funcPtrs.vkDestroyQueryPool,
@@ -557,7 +744,11 @@ func (l *vulkanDriver) VkDestroyQueryPool(device VkDevice, queryPool VkQueryPool
 
 func (l *vulkanDriver) VkGetQueryPoolResults(device VkDevice, queryPool VkQueryPool, firstQuery Uint32, queryCount Uint32, dataSize Size, pData unsafe.Pointer, stride VkDeviceSize, flags VkQueryResultFlags) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkGetQueryPoolResults", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkGetQueryPoolResults == nil {
+		return vkErrorUnknown, missingCommand("vkGetQueryPoolResults")
 	}
 
 	res := common.VkResult(C.cgoGetQueryPoolResults(l.funcPtrs.vkGetQueryPoolResults,
@@ -574,7 +765,11 @@ func (l *vulkanDriver) VkGetQueryPoolResults(device VkDevice, queryPool VkQueryP
 
 func (l *vulkanDriver) VkCreateBuffer(device VkDevice, pCreateInfo *VkBufferCreateInfo, pAllocator *VkAllocationCallbacks, pBuffer *VkBuffer) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateBuffer", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateBuffer == nil {
+		return vkErrorUnknown, missingCommand("vkCreateBuffer")
 	}
 
 	res := common.VkResult(C.cgoCreateBuffer(l.funcPtrs.vkCreateBuffer,
@@ -587,7 +782,11 @@ func (l *vulkanDriver) VkCreateBuffer(device VkDevice, pCreateInfo *VkBufferCrea
 
 func (l *vulkanDriver) VkDestroyBuffer(device VkDevice, buffer VkBuffer, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyBuffer", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyBuffer == nil {
+		panic(missingCommand("vkDestroyBuffer"))
 	}
 
 	C.cgoDestroyBuffer(l.funcPtrs.vkDestroyBuffer,
@@ -598,7 +797,11 @@ func (l *vulkanDriver) VkDestroyBuffer(device VkDevice, buffer VkBuffer, pAlloca
 
 func (l *vulkanDriver) VkCreateBufferView(device VkDevice, pCreateInfo *VkBufferViewCreateInfo, pAllocator *VkAllocationCallbacks, pView *VkBufferView) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateBufferView", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateBufferView == nil {
+		return vkErrorUnknown, missingCommand("vkCreateBufferView")
 	}
 
 	res := common.VkResult(C.cgoCreateBufferView(l.funcPtrs.vkCreateBufferView,
@@ -611,7 +814,11 @@ func (l *vulkanDriver) VkCreateBufferView(device VkDevice, pCreateInfo *VkBuffer
 
 func (l *vulkanDriver) VkDestroyBufferView(device VkDevice, bufferView VkBufferView, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyBufferView", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyBufferView == nil {
+		panic(missingCommand("vkDestroyBufferView"))
 	}
 
 	C.cgoDestroyBufferView(l.funcPtrs.vkDestroyBufferView,
@@ -622,7 +829,11 @@ func (l *vulkanDriver) VkDestroyBufferView(device VkDevice, bufferView VkBufferV
 
 func (l *vulkanDriver) VkCreateImage(device VkDevice, pCreateInfo *VkImageCreateInfo, pAllocator *VkAllocationCallbacks, pImage *VkImage) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateImage", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateImage == nil {
+		return vkErrorUnknown, missingCommand("vkCreateImage")
 	}
 
 	res := common.VkResult(C.cgoCreateImage(l.funcPtrs.vkCreateImage,
@@ -635,7 +846,11 @@ func (l *vulkanDriver) VkCreateImage(device VkDevice, pCreateInfo *VkImageCreate
 
 func (l *vulkanDriver) VkDestroyImage(device VkDevice, image VkImage, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyImage", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyImage == nil {
+		panic(missingCommand("vkDestroyImage"))
 	}
 
 	C.cgoDestroyImage(l.funcPtrs.vkDestroyImage,
@@ -646,7 +861,11 @@ func (l *vulkanDriver) VkDestroyImage(device VkDevice, image VkImage, pAllocator
 
 func (l *vulkanDriver) VkGetImageSubresourceLayout(device VkDevice, image VkImage, pSubresource *VkImageSubresource, pLayout *VkSubresourceLayout) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkGetImageSubresourceLayout", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkGetImageSubresourceLayout == nil {
+		panic(missingCommand("vkGetImageSubresourceLayout"))
 	}
 
 	C.cgoGetImageSubresourceLayout(l.funcPtrs.vkGetImageSubresourceLayout,
@@ -658,7 +877,11 @@ func (l *vulkanDriver) VkGetImageSubresourceLayout(device VkDevice, image VkImag
 
 func (l *vulkanDriver) VkCreateImageView(device VkDevice, pCreateInfo *VkImageViewCreateInfo, pAllocator *VkAllocationCallbacks, pView *VkImageView) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateImageView", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateImageView == nil {
+		return vkErrorUnknown, missingCommand("vkCreateImageView")
 	}
 
 	res := common.VkResult(C.cgoCreateImageView(l.funcPtrs.vkCreateImageView,
@@ -671,7 +894,11 @@ func (l *vulkanDriver) VkCreateImageView(device VkDevice, pCreateInfo *VkImageVi
 
 func (l *vulkanDriver) VkDestroyImageView(device VkDevice, imageView VkImageView, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyImageView", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyImageView == nil {
+		panic(missingCommand("vkDestroyImageView"))
 	}
 
 	C.cgoDestroyImageView(l.funcPtrs.vkDestroyImageView,
@@ -682,7 +909,11 @@ func (l *vulkanDriver) VkDestroyImageView(device VkDevice, imageView VkImageView
 
 func (l *vulkanDriver) VkCreateShaderModule(device VkDevice, pCreateInfo *VkShaderModuleCreateInfo, pAllocator *VkAllocationCallbacks, pShaderModule *VkShaderModule) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateShaderModule", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateShaderModule == nil {
+		return vkErrorUnknown, missingCommand("vkCreateShaderModule")
 	}
 
 	res := common.VkResult(C.cgoCreateShaderModule(l.funcPtrs.vkCreateShaderModule,
@@ -695,7 +926,11 @@ func (l *vulkanDriver) VkCreateShaderModule(device VkDevice, pCreateInfo *VkShad
 
 func (l *vulkanDriver) VkDestroyShaderModule(device VkDevice, shaderModule VkShaderModule, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyShaderModule", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyShaderModule == nil {
+		panic(missingCommand("vkDestroyShaderModule"))
 	}
 
 	C.cgoDestroyShaderModule(l.funcPtrs.vkDestroyShaderModule,
@@ -706,7 +941,11 @@ func (l *vulkanDriver) VkDestroyShaderModule(device VkDevice, shaderModule VkSha
 
 func (l *vulkanDriver) VkCreatePipelineCache(device VkDevice, pCreateInfo *VkPipelineCacheCreateInfo, pAllocator *VkAllocationCallbacks, pPipelineCache *VkPipelineCache) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreatePipelineCache", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreatePipelineCache == nil {
+		return vkErrorUnknown, missingCommand("vkCreatePipelineCache")
 	}
 
 	res := common.VkResult(C.cgoCreatePipelineCache(l.funcPtrs.vkCreatePipelineCache,
@@ -719,7 +958,11 @@ func (l *vulkanDriver) VkCreatePipelineCache(device VkDevice, pCreateInfo *VkPip
 
 func (l *vulkanDriver) VkDestroyPipelineCache(device VkDevice, pipelineCache VkPipelineCache, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyPipelineCache", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyPipelineCache == nil {
+		panic(missingCommand("vkDestroyPipelineCache"))
 	}
 
 	C.cgoDestroyPipelineCache(l.funcPtrs.vkDestroyPipelineCache,
@@ -730,7 +973,11 @@ func (l *vulkanDriver) VkDestroyPipelineCache(device VkDevice, pipelineCache VkP
 
 func (l *vulkanDriver) VkGetPipelineCacheData(device VkDevice, pipelineCache VkPipelineCache, pDataSize *Size, pData unsafe.Pointer) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkGetPipelineCacheData", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkGetPipelineCacheData == nil {
+		return vkErrorUnknown, missingCommand("vkGetPipelineCacheData")
 	}
 
 	res := common.VkResult(C.cgoGetPipelineCacheData(l.funcPtrs.vkGetPipelineCacheData,
@@ -743,7 +990,11 @@ func (l *vulkanDriver) VkGetPipelineCacheData(device VkDevice, pipelineCache VkP
 
 func (l *vulkanDriver) VkMergePipelineCaches(device VkDevice, dstCache VkPipelineCache, srcCacheCount Uint32, pSrcCaches *VkPipelineCache) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkMergePipelineCaches", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkMergePipelineCaches == nil {
+		return vkErrorUnknown, missingCommand("vkMergePipelineCaches")
 	}
 
 	res := common.VkResult(C.cgoMergePipelineCaches(l.funcPtrs.vkMergePipelineCaches,
@@ -756,7 +1007,11 @@ func (l *vulkanDriver) VkMergePipelineCaches(device VkDevice, dstCache VkPipelin
 
 func (l *vulkanDriver) VkCreateGraphicsPipelines(device VkDevice, pipelineCache VkPipelineCache, createInfoCount Uint32, pCreateInfos *VkGraphicsPipelineCreateInfo, pAllocator *VkAllocationCallbacks, pPipelines *VkPipeline) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateGraphicsPipelines", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateGraphicsPipelines == nil {
+		return vkErrorUnknown, missingCommand("vkCreateGraphicsPipelines")
 	}
 
 	res := common.VkResult(C.cgoCreateGraphicsPipelines(l.funcPtrs.vkCreateGraphicsPipelines,
@@ -771,7 +1026,11 @@ func (l *vulkanDriver) VkCreateGraphicsPipelines(device VkDevice, pipelineCache
 
 func (l *vulkanDriver) VkCreateComputePipelines(device VkDevice, pipelineCache VkPipelineCache, createInfoCount Uint32, pCreateInfos *VkComputePipelineCreateInfo, pAllocator *VkAllocationCallbacks, pPipelines *VkPipeline) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateComputePipelines", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateComputePipelines == nil {
+		return vkErrorUnknown, missingCommand("vkCreateComputePipelines")
 	}
 
 	res := common.VkResult(C.cgoCreateComputePipelines(l.funcPtrs.vkCreateComputePipelines,
@@ -786,7 +1045,11 @@ func (l *vulkanDriver) VkCreateComputePipelines(device VkDevice, pipelineCache V
 
 func (l *vulkanDriver) VkDestroyPipeline(device VkDevice, pipeline VkPipeline, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyPipeline", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyPipeline == nil {
+		panic(missingCommand("vkDestroyPipeline"))
 	}
 
 	C.cgoDestroyPipeline(l.funcPtrs.vkDestroyPipeline,
@@ -797,7 +1060,11 @@ func (l *vulkanDriver) VkDestroyPipeline(device VkDevice, pipeline VkPipeline, p
 
 func (l *vulkanDriver) VkCreatePipelineLayout(device VkDevice, pCreateInfo *VkPipelineLayoutCreateInfo, pAllocator *VkAllocationCallbacks, pPipelineLayout *VkPipelineLayout) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreatePipelineLayout", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreatePipelineLayout == nil {
+		return vkErrorUnknown, missingCommand("vkCreatePipelineLayout")
 	}
 
 	res := common.VkResult(C.cgoCreatePipelineLayout(l.funcPtrs.vkCreatePipelineLayout,
@@ -810,7 +1077,11 @@ func (l *vulkanDriver) VkCreatePipelineLayout(device VkDevice, pCreateInfo *VkPi
 
 func (l *vulkanDriver) VkDestroyPipelineLayout(device VkDevice, pipelineLayout VkPipelineLayout, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyPipelineLayout", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyPipelineLayout == nil {
+		panic(missingCommand("vkDestroyPipelineLayout"))
 	}
 
 	C.cgoDestroyPipelineLayout(l.funcPtrs.vkDestroyPipelineLayout,
@@ -821,7 +1092,11 @@ func (l *vulkanDriver) VkDestroyPipelineLayout(device VkDevice, pipelineLayout V
 
 func (l *vulkanDriver) VkCreateSampler(device VkDevice, pCreateInfo *VkSamplerCreateInfo, pAllocator *VkAllocationCallbacks, pSampler *VkSampler) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateSampler", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateSampler == nil {
+		return vkErrorUnknown, missingCommand("vkCreateSampler")
 	}
 
 	res := common.VkResult(C.cgoCreateSampler(l.funcPtrs.vkCreateSampler,
@@ -834,7 +1109,11 @@ func (l *vulkanDriver) VkCreateSampler(device VkDevice, pCreateInfo *VkSamplerCr
 
 func (l *vulkanDriver) VkDestroySampler(device VkDevice, sampler VkSampler, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroySampler", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroySampler == nil {
+		panic(missingCommand("vkDestroySampler"))
 	}
 
 	C.cgoDestroySampler(l.funcPtrs.vkDestroySampler,
@@ -845,7 +1124,11 @@ func (l *vulkanDriver) VkDestroySampler(device VkDevice, sampler VkSampler, pAll
 
 func (l *vulkanDriver) VkCreateDescriptorSetLayout(device VkDevice, pCreateInfo *VkDescriptorSetLayoutCreateInfo, pAllocator *VkAllocationCallbacks, pSetLayout *VkDescriptorSetLayout) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateDescriptorSetLayout", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateDescriptorSetLayout == nil {
+		return vkErrorUnknown, missingCommand("vkCreateDescriptorSetLayout")
 	}
 
 	res := common.VkResult(C.cgoCreateDescriptorSetLayout(l.funcPtrs.vkCreateDescriptorSetLayout,
@@ -858,7 +1141,11 @@ func (l *vulkanDriver) VkCreateDescriptorSetLayout(device VkDevice, pCreateInfo
 
 func (l *vulkanDriver) VkDestroyDescriptorSetLayout(device VkDevice, descriptorSetLayout VkDescriptorSetLayout, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyDescriptorSetLayout", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyDescriptorSetLayout == nil {
+		panic(missingCommand("vkDestroyDescriptorSetLayout"))
 	}
 
 	C.cgoDestroyDescriptorSetLayout(l.funcPtrs.vkDestroyDescriptorSetLayout,
@@ -869,7 +1156,11 @@ func (l *vulkanDriver) VkDestroyDescriptorSetLayout(device VkDevice, descriptorS
 
 func (l *vulkanDriver) VkCreateDescriptorPool(device VkDevice, pCreateInfo *VkDescriptorPoolCreateInfo, pAllocator *VkAllocationCallbacks, pDescriptorPool *VkDescriptorPool) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateDescriptorPool", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateDescriptorPool == nil {
+		return vkErrorUnknown, missingCommand("vkCreateDescriptorPool")
 	}
 
 	res := common.VkResult(C.cgoCreateDescriptorPool(l.funcPtrs.vkCreateDescriptorPool,
@@ -882,7 +1173,11 @@ func (l *vulkanDriver) VkCreateDescriptorPool(device VkDevice, pCreateInfo *VkDe
 
 func (l *vulkanDriver) VkDestroyDescriptorPool(device VkDevice, descriptorPool VkDescriptorPool, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyDescriptorPool", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyDescriptorPool == nil {
+		panic(missingCommand("vkDestroyDescriptorPool"))
 	}
 
 	C.cgoDestroyDescriptorPool(l.funcPtrs.vkDestroyDescriptorPool,
@@ -893,7 +1188,11 @@ func (l *vulkanDriver) VkDestroyDescriptorPool(device VkDevice, descriptorPool V
 
 func (l *vulkanDriver) VkResetDescriptorPool(device VkDevice, descriptorPool VkDescriptorPool, flags VkDescriptorPoolResetFlags) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkResetDescriptorPool", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkResetDescriptorPool == nil {
+		return vkErrorUnknown, missingCommand("vkResetDescriptorPool")
 	}
 
 	res := common.VkResult(C.cgoResetDescriptorPool(l.funcPtrs.vkResetDescriptorPool,
@@ -905,7 +1204,11 @@ func (l *vulkanDriver) VkResetDescriptorPool(device VkDevice, descriptorPool VkD
 
 func (l *vulkanDriver) VkAllocateDescriptorSets(device VkDevice, pAllocateInfo *VkDescriptorSetAllocateInfo, pDescriptorSets *VkDescriptorSet) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkAllocateDescriptorSets", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkAllocateDescriptorSets == nil {
+		return vkErrorUnknown, missingCommand("vkAllocateDescriptorSets")
 	}
 
 	res := common.VkResult(C.cgoAllocateDescriptorSets(l.funcPtrs.vkAllocateDescriptorSets,
@@ -917,7 +1220,11 @@ func (l *vulkanDriver) VkAllocateDescriptorSets(device VkDevice, pAllocateInfo *
 
 func (l *vulkanDriver) VkFreeDescriptorSets(device VkDevice, descriptorPool VkDescriptorPool, descriptorSetCount Uint32, pDescriptorSets *VkDescriptorSet) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkFreeDescriptorSets", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkFreeDescriptorSets == nil {
+		return vkErrorUnknown, missingCommand("vkFreeDescriptorSets")
 	}
 
 	res := common.VkResult(C.cgoFreeDescriptorSets(l.funcPtrs.vkFreeDescriptorSets,
@@ -930,7 +1237,11 @@ func (l *vulkanDriver) VkFreeDescriptorSets(device VkDevice, descriptorPool VkDe
 
 func (l *vulkanDriver) VkUpdateDescriptorSets(device VkDevice, descriptorWriteCount Uint32, pDescriptorWrites *VkWriteDescriptorSet, descriptorCopyCount Uint32, pDescriptorCopies *VkCopyDescriptorSet) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkUpdateDescriptorSets", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkUpdateDescriptorSets == nil {
+		panic(missingCommand("vkUpdateDescriptorSets"))
 	}
 
 	C.cgoUpdateDescriptorSets(l.funcPtrs.vkUpdateDescriptorSets,
@@ -943,7 +1254,11 @@ func (l *vulkanDriver) VkUpdateDescriptorSets(device VkDevice, descriptorWriteCo
 
 func (l *vulkanDriver) VkCreateFramebuffer(device VkDevice, pCreateInfo *VkFramebufferCreateInfo, pAllocator *VkAllocationCallbacks, pFramebuffer *VkFramebuffer) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateFramebuffer", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateFramebuffer == nil {
+		return vkErrorUnknown, missingCommand("vkCreateFramebuffer")
 	}
 
 	res := common.VkResult(C.cgoCreateFramebuffer(l.funcPtrs.vkCreateFramebuffer,
@@ -956,7 +1271,11 @@ func (l *vulkanDriver) VkCreateFramebuffer(device VkDevice, pCreateInfo *VkFrame
 
 func (l *vulkanDriver) VkDestroyFramebuffer(device VkDevice, framebuffer VkFramebuffer, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyFramebuffer", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyFramebuffer == nil {
+		panic(missingCommand("vkDestroyFramebuffer"))
 	}
 
 	C.cgoDestroyFramebuffer(l.funcPtrs.vkDestroyFramebuffer,
@@ -967,7 +1286,11 @@ func (l *vulkanDriver) VkDestroyFramebuffer(device VkDevice, framebuffer VkFrame
 
 func (l *vulkanDriver) VkCreateRenderPass(device VkDevice, pCreateInfo *VkRenderPassCreateInfo, pAllocator *VkAllocationCallbacks, pRenderPass *VkRenderPass) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateRenderPass", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateRenderPass == nil {
+		return vkErrorUnknown, missingCommand("vkCreateRenderPass")
 	}
 
 	res := common.VkResult(C.cgoCreateRenderPass(l.funcPtrs.vkCreateRenderPass,
@@ -980,7 +1303,11 @@ func (l *vulkanDriver) VkCreateRenderPass(device VkDevice, pCreateInfo *VkRender
 
 func (l *vulkanDriver) VkDestroyRenderPass(device VkDevice, renderPass VkRenderPass, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyRenderPass", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyRenderPass == nil {
+		panic(missingCommand("vkDestroyRenderPass"))
 	}
 
 	C.cgoDestroyRenderPass(l.funcPtrs.vkDestroyRenderPass,
@@ -991,7 +1318,11 @@ func (l *vulkanDriver) VkDestroyRenderPass(device VkDevice, renderPass VkRenderP
 
 func (l *vulkanDriver) VkGetRenderAreaGranularity(device VkDevice, renderPass VkRenderPass, pGranularity *VkExtent2D) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkGetRenderAreaGranularity", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkGetRenderAreaGranularity == nil {
+		panic(missingCommand("vkGetRenderAreaGranularity"))
 	}
 
 	C.cgoGetRenderAreaGranularity(l.funcPtrs.vkGetRenderAreaGranularity,
@@ -1002,7 +1333,11 @@ func (l *vulkanDriver) VkGetRenderAreaGranularity(device VkDevice, renderPass Vk
 
 func (l *vulkanDriver) VkCreateCommandPool(device VkDevice, pCreateInfo *VkCommandPoolCreateInfo, pAllocator *VkAllocationCallbacks, pCommandPool *VkCommandPool) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkCreateCommandPool", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkCreateCommandPool == nil {
+		return vkErrorUnknown, missingCommand("vkCreateCommandPool")
 	}
 
 	res := common.VkResult(C.cgoCreateCommandPool(l.funcPtrs.vkCreateCommandPool,
@@ -1015,7 +1350,11 @@ func (l *vulkanDriver) VkCreateCommandPool(device VkDevice, pCreateInfo *VkComma
 
 func (l *vulkanDriver) VkDestroyCommandPool(device VkDevice, commandPool VkCommandPool, pAllocator *VkAllocationCallbacks) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkDestroyCommandPool", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkDestroyCommandPool == nil {
+		panic(missingCommand("vkDestroyCommandPool"))
 	}
 
 	C.cgoDestroyCommandPool(l.funcPtrs.vkDestroyCommandPool,
@@ -1026,7 +1365,11 @@ func (l *vulkanDriver) VkDestroyCommandPool(device VkDevice, commandPool VkComma
 
 func (l *vulkanDriver) VkResetCommandPool(device VkDevice, commandPool VkCommandPool, flags VkCommandPoolResetFlags) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkResetCommandPool", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkResetCommandPool == nil {
+		return vkErrorUnknown, missingCommand("vkResetCommandPool")
 	}
 
 	res := common.VkResult(C.cgoResetCommandPool(l.funcPtrs.vkResetCommandPool,
@@ -1038,7 +1381,11 @@ func (l *vulkanDriver) VkResetCommandPool(device VkDevice, commandPool VkCommand
 
 func (l *vulkanDriver) VkAllocateCommandBuffers(device VkDevice, pAllocateInfo *VkCommandBufferAllocateInfo, pCommandBuffers *VkCommandBuffer) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkAllocateCommandBuffers", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkAllocateCommandBuffers == nil {
+		return vkErrorUnknown, missingCommand("vkAllocateCommandBuffers")
 	}
 
 	res := common.VkResult(C.cgoAllocateCommandBuffers(l.funcPtrs.vkAllocateCommandBuffers,
@@ -1050,7 +1397,11 @@ func (l *vulkanDriver) VkAllocateCommandBuffers(device VkDevice, pAllocateInfo *
 
 func (l *vulkanDriver) VkFreeCommandBuffers(device VkDevice, commandPool VkCommandPool, commandBufferCount Uint32, pCommandBuffers *VkCommandBuffer) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkFreeCommandBuffers", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkFreeCommandBuffers == nil {
+		panic(missingCommand("vkFreeCommandBuffers"))
 	}
 
 	C.cgoFreeCommandBuffers(l.funcPtrs.vkFreeCommandBuffers,
@@ -1062,7 +1413,11 @@ func (l *vulkanDriver) VkFreeCommandBuffers(device VkDevice, commandPool VkComma
 
 func (l *vulkanDriver) VkBeginCommandBuffer(commandBuffer VkCommandBuffer, pBeginInfo *VkCommandBufferBeginInfo) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkBeginCommandBuffer", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkBeginCommandBuffer == nil {
+		return vkErrorUnknown, missingCommand("vkBeginCommandBuffer")
 	}
 
 	res := common.VkResult(C.cgoBeginCommandBuffer(l.funcPtrs.vkBeginCommandBuffer,
@@ -1073,7 +1428,11 @@ func (l *vulkanDriver) VkBeginCommandBuffer(commandBuffer VkCommandBuffer, pBegi
 
 func (l *vulkanDriver) VkEndCommandBuffer(commandBuffer VkCommandBuffer) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkEndCommandBuffer", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkEndCommandBuffer == nil {
+		return vkErrorUnknown, missingCommand("vkEndCommandBuffer")
 	}
 
 	res := common.VkResult(C.cgoEndCommandBuffer(l.funcPtrs.vkEndCommandBuffer,
@@ -1083,7 +1442,11 @@ func (l *vulkanDriver) VkEndCommandBuffer(commandBuffer VkCommandBuffer) (common
 
 func (l *vulkanDriver) VkResetCommandBuffer(commandBuffer VkCommandBuffer, flags VkCommandBufferResetFlags) (common.VkResult, error) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		return vkErrorUnknown, &common.FunctionError{Function: "vkResetCommandBuffer", Err: ErrNotDeviceDriver}
+	}
+
+	if l.funcPtrs.vkResetCommandBuffer == nil {
+		return vkErrorUnknown, missingCommand("vkResetCommandBuffer")
 	}
 
 	res := common.VkResult(C.cgoResetCommandBuffer(l.funcPtrs.vkResetCommandBuffer,
@@ -1094,7 +1457,11 @@ func (l *vulkanDriver) VkResetCommandBuffer(commandBuffer VkCommandBuffer, flags
 
 func (l *vulkanDriver) VkCmdBindPipeline(commandBuffer VkCommandBuffer, pipelineBindPoint VkPipelineBindPoint, pipeline VkPipeline) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdBindPipeline", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdBindPipeline == nil {
+		panic(missingCommand("vkCmdBindPipeline"))
 	}
 
 	C.cgoCmdBindPipeline(l.funcPtrs.vkCmdBindPipeline,
@@ -1105,7 +1472,11 @@ func (l *vulkanDriver) VkCmdBindPipeline(commandBuffer VkCommandBuffer, pipeline
 
 func (l *vulkanDriver) VkCmdSetViewport(commandBuffer VkCommandBuffer, firstViewport Uint32, viewportCount Uint32, pViewports *VkViewport) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdSetViewport", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdSetViewport == nil {
+		panic(missingCommand("vkCmdSetViewport"))
 	}
 
 	C.cgoCmdSetViewport(l.funcPtrs.vkCmdSetViewport,
@@ -1117,7 +1488,11 @@ func (l *vulkanDriver) VkCmdSetViewport(commandBuffer VkCommandBuffer, firstView
 
 func (l *vulkanDriver) VkCmdSetScissor(commandBuffer VkCommandBuffer, firstScissor Uint32, scissorCount Uint32, pScissors *VkRect2D) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdSetScissor", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdSetScissor == nil {
+		panic(missingCommand("vkCmdSetScissor"))
 	}
 
 	C.cgoCmdSetScissor(l.funcPtrs.vkCmdSetScissor,
@@ -1129,8 +1504,13 @@ func (l *vulkanDriver) VkCmdSetScissor(commandBuffer VkCommandBuffer, firstSciss
 
 func (l *vulkanDriver) VkCmdSetLineWidth(commandBuffer VkCommandBuffer, lineWidth Float) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdSetLineWidth", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdSetLineWidth == nil {
+		panic(missingCommand("vkCmdSetLineWidth"))
 	}
+
 	C.cgoCmdSetLineWidth(l.funcPtrs.vkCmdSetLineWidth,
 		(C.VkCommandBuffer)(unsafe.Pointer(commandBuffer)),
 		(C.float)(lineWidth))
@@ -1138,7 +1518,11 @@ func (l *vulkanDriver) VkCmdSetLineWidth(commandBuffer VkCommandBuffer, lineWidt
 
 func (l *vulkanDriver) VkCmdSetDepthBias(commandBuffer VkCommandBuffer, depthBiasConstantFactor Float, depthBiasClamp Float, depthBiasSlopeFactor Float) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdSetDepthBias", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdSetDepthBias == nil {
+		panic(missingCommand("vkCmdSetDepthBias"))
 	}
 
 	C.cgoCmdSetDepthBias(l.funcPtrs.vkCmdSetDepthBias,
@@ -1150,7 +1534,11 @@ func (l *vulkanDriver) VkCmdSetDepthBias(commandBuffer VkCommandBuffer, depthBia
 
 func (l *vulkanDriver) VkCmdSetBlendConstants(commandBuffer VkCommandBuffer, blendConstants *Float) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdSetBlendConstants", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdSetBlendConstants == nil {
+		panic(missingCommand("vkCmdSetBlendConstants"))
 	}
 
 	C.cgoCmdSetBlendConstants(l.funcPtrs.vkCmdSetBlendConstants,
@@ -1161,7 +1549,11 @@ func (l *vulkanDriver) VkCmdSetBlendConstants(commandBuffer VkCommandBuffer, ble
 
 func (l *vulkanDriver) VkCmdSetDepthBounds(commandBuffer VkCommandBuffer, minDepthBounds Float, maxDepthBounds Float) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdSetDepthBounds", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdSetDepthBounds == nil {
+		panic(missingCommand("vkCmdSetDepthBounds"))
 	}
 
 	C.cgoCmdSetDepthBounds(l.funcPtrs.vkCmdSetDepthBounds,
@@ -1172,7 +1564,11 @@ func (l *vulkanDriver) VkCmdSetDepthBounds(commandBuffer VkCommandBuffer, minDep
 
 func (l *vulkanDriver) VkCmdSetStencilCompareMask(commandBuffer VkCommandBuffer, faceMask VkStencilFaceFlags, compareMask Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdSetStencilCompareMask", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdSetStencilCompareMask == nil {
+		panic(missingCommand("vkCmdSetStencilCompareMask"))
 	}
 
 	C.cgoCmdSetStencilCompareMask(l.funcPtrs.vkCmdSetStencilCompareMask,
@@ -1183,7 +1579,11 @@ func (l *vulkanDriver) VkCmdSetStencilCompareMask(commandBuffer VkCommandBuffer,
 
 func (l *vulkanDriver) VkCmdSetStencilWriteMask(commandBuffer VkCommandBuffer, faceMask VkStencilFaceFlags, writeMask Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdSetStencilWriteMask", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdSetStencilWriteMask == nil {
+		panic(missingCommand("vkCmdSetStencilWriteMask"))
 	}
 
 	C.cgoCmdSetStencilWriteMask(l.funcPtrs.vkCmdSetStencilWriteMask,
@@ -1194,7 +1594,11 @@ func (l *vulkanDriver) VkCmdSetStencilWriteMask(commandBuffer VkCommandBuffer, f
 
 func (l *vulkanDriver) VkCmdSetStencilReference(commandBuffer VkCommandBuffer, faceMask VkStencilFaceFlags, reference Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdSetStencilReference", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdSetStencilReference == nil {
+		panic(missingCommand("vkCmdSetStencilReference"))
 	}
 
 	C.cgoCmdSetStencilReference(l.funcPtrs.vkCmdSetStencilReference,
@@ -1205,7 +1609,11 @@ func (l *vulkanDriver) VkCmdSetStencilReference(commandBuffer VkCommandBuffer, f
 
 func (l *vulkanDriver) VkCmdBindDescriptorSets(commandBuffer VkCommandBuffer, pipelineBindPoint VkPipelineBindPoint, layout VkPipelineLayout, firstSet Uint32, descriptorSetCount Uint32, pDescriptorSets *VkDescriptorSet, dynamicOffsetCount Uint32, pDynamicOffsets *Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdBindDescriptorSets", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdBindDescriptorSets == nil {
+		panic(missingCommand("vkCmdBindDescriptorSets"))
 	}
 
 	C.cgoCmdBindDescriptorSets(l.funcPtrs.vkCmdBindDescriptorSets,
@@ -1221,7 +1629,11 @@ func (l *vulkanDriver) VkCmdBindDescriptorSets(commandBuffer VkCommandBuffer, pi
 
 func (l *vulkanDriver) VkCmdBindIndexBuffer(commandBuffer VkCommandBuffer, buffer VkBuffer, offset VkDeviceSize, indexType VkIndexType) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdBindIndexBuffer", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdBindIndexBuffer == nil {
+		panic(missingCommand("vkCmdBindIndexBuffer"))
 	}
 
 	C.cgoCmdBindIndexBuffer(l.funcPtrs.vkCmdBindIndexBuffer,
@@ -1233,7 +1645,11 @@ func (l *vulkanDriver) VkCmdBindIndexBuffer(commandBuffer VkCommandBuffer, buffe
 
 func (l *vulkanDriver) VkCmdBindVertexBuffers(commandBuffer VkCommandBuffer, firstBinding Uint32, bindingCount Uint32, pBuffers *VkBuffer, pOffsets *VkDeviceSize) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdBindVertexBuffers", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdBindVertexBuffers == nil {
+		panic(missingCommand("vkCmdBindVertexBuffers"))
 	}
 
 	C.cgoCmdBindVertexBuffers(l.funcPtrs.vkCmdBindVertexBuffers,
@@ -1246,7 +1662,11 @@ func (l *vulkanDriver) VkCmdBindVertexBuffers(commandBuffer VkCommandBuffer, fir
 
 func (l *vulkanDriver) VkCmdDraw(commandBuffer VkCommandBuffer, vertexCount Uint32, instanceCount Uint32, firstVertex Uint32, firstInstance Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdDraw", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdDraw == nil {
+		panic(missingCommand("vkCmdDraw"))
 	}
 
 	C.cgoCmdDraw(l.funcPtrs.vkCmdDraw,
@@ -1259,7 +1679,11 @@ func (l *vulkanDriver) VkCmdDraw(commandBuffer VkCommandBuffer, vertexCount Uint
 
 func (l *vulkanDriver) VkCmdDrawIndexed(commandBuffer VkCommandBuffer, indexCount Uint32, instanceCount Uint32, firstIndex Uint32, vertexOffset Int32, firstInstance Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdDrawIndexed", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdDrawIndexed == nil {
+		panic(missingCommand("vkCmdDrawIndexed"))
 	}
 
 	C.cgoCmdDrawIndexed(l.funcPtrs.vkCmdDrawIndexed,
@@ -1273,7 +1697,11 @@ func (l *vulkanDriver) VkCmdDrawIndexed(commandBuffer VkCommandBuffer, indexCoun
 
 func (l *vulkanDriver) VkCmdDrawIndirect(commandBuffer VkCommandBuffer, buffer VkBuffer, offset VkDeviceSize, drawCount Uint32, stride Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdDrawIndirect", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdDrawIndirect == nil {
+		panic(missingCommand("vkCmdDrawIndirect"))
 	}
 
 	C.cgoCmdDrawIndirect(l.funcPtrs.vkCmdDrawIndirect,
@@ -1286,7 +1714,11 @@ func (l *vulkanDriver) VkCmdDrawIndirect(commandBuffer VkCommandBuffer, buffer V
 
 func (l *vulkanDriver) VkCmdDrawIndexedIndirect(commandBuffer VkCommandBuffer, buffer VkBuffer, offset VkDeviceSize, drawCount Uint32, stride Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdDrawIndexedIndirect", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdDrawIndexedIndirect == nil {
+		panic(missingCommand("vkCmdDrawIndexedIndirect"))
 	}
 
 	C.cgoCmdDrawIndexedIndirect(l.funcPtrs.vkCmdDrawIndexedIndirect,
@@ -1299,7 +1731,11 @@ func (l *vulkanDriver) VkCmdDrawIndexedIndirect(commandBuffer VkCommandBuffer, b
 
 func (l *vulkanDriver) VkCmdDispatch(commandBuffer VkCommandBuffer, groupCountX Uint32, groupCountY Uint32, groupCountZ Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdDispatch", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdDispatch == nil {
+		panic(missingCommand("vkCmdDispatch"))
 	}
 
 	C.cgoCmdDispatch(l.funcPtrs.vkCmdDispatch,
@@ -1311,7 +1747,11 @@ func (l *vulkanDriver) VkCmdDispatch(commandBuffer VkCommandBuffer, groupCountX
 
 func (l *vulkanDriver) VkCmdDispatchIndirect(commandBuffer VkCommandBuffer, buffer VkBuffer, offset VkDeviceSize) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdDispatchIndirect", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdDispatchIndirect == nil {
+		panic(missingCommand("vkCmdDispatchIndirect"))
 	}
 
 	C.cgoCmdDispatchIndirect(l.funcPtrs.vkCmdDispatchIndirect,
@@ -1322,7 +1762,11 @@ func (l *vulkanDriver) VkCmdDispatchIndirect(commandBuffer VkCommandBuffer, buff
 
 func (l *vulkanDriver) VkCmdCopyBuffer(commandBuffer VkCommandBuffer, srcBuffer VkBuffer, dstBuffer VkBuffer, regionCount Uint32, pRegions *VkBufferCopy) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdCopyBuffer", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdCopyBuffer == nil {
+		panic(missingCommand("vkCmdCopyBuffer"))
 	}
 
 	C.cgoCmdCopyBuffer(l.funcPtrs.vkCmdCopyBuffer,
@@ -1335,7 +1779,11 @@ func (l *vulkanDriver) VkCmdCopyBuffer(commandBuffer VkCommandBuffer, srcBuffer
 
 func (l *vulkanDriver) VkCmdCopyImage(commandBuffer VkCommandBuffer, srcImage VkImage, srcImageLayout VkImageLayout, dstImage VkImage, dstImageLayout VkImageLayout, regionCount Uint32, pRegions *VkImageCopy) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdCopyImage", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdCopyImage == nil {
+		panic(missingCommand("vkCmdCopyImage"))
 	}
 
 	C.cgoCmdCopyImage(l.funcPtrs.vkCmdCopyImage,
@@ -1350,7 +1798,11 @@ func (l *vulkanDriver) VkCmdCopyImage(commandBuffer VkCommandBuffer, srcImage Vk
 
 func (l *vulkanDriver) VkCmdBlitImage(commandBuffer VkCommandBuffer, srcImage VkImage, srcImageLayout VkImageLayout, dstImage VkImage, dstImageLayout VkImageLayout, regionCount Uint32, pRegions *VkImageBlit, filter VkFilter) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdBlitImage", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdBlitImage == nil {
+		panic(missingCommand("vkCmdBlitImage"))
 	}
 
 	C.cgoCmdBlitImage(l.funcPtrs.vkCmdBlitImage,
@@ -1367,7 +1819,11 @@ func (l *vulkanDriver) VkCmdBlitImage(commandBuffer VkCommandBuffer, srcImage Vk
 
 func (l *vulkanDriver) VkCmdCopyBufferToImage(commandBuffer VkCommandBuffer, srcBuffer VkBuffer, dstImage VkImage, dstImageLayout VkImageLayout, regionCount Uint32, pRegions *VkBufferImageCopy) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdCopyBufferToImage", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdCopyBufferToImage == nil {
+		panic(missingCommand("vkCmdCopyBufferToImage"))
 	}
 
 	C.cgoCmdCopyBufferToImage(l.funcPtrs.vkCmdCopyBufferToImage,
@@ -1381,7 +1837,11 @@ func (l *vulkanDriver) VkCmdCopyBufferToImage(commandBuffer VkCommandBuffer, src
 
 func (l *vulkanDriver) VkCmdCopyImageToBuffer(commandBuffer VkCommandBuffer, srcImage VkImage, srcImageLayout VkImageLayout, dstBuffer VkBuffer, regionCount Uint32, pRegions *VkBufferImageCopy) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdCopyImageToBuffer", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdCopyImageToBuffer == nil {
+		panic(missingCommand("vkCmdCopyImageToBuffer"))
 	}
 
 	C.cgoCmdCopyImageToBuffer(l.funcPtrs.vkCmdCopyImageToBuffer,
@@ -1395,7 +1855,11 @@ func (l *vulkanDriver) VkCmdCopyImageToBuffer(commandBuffer VkCommandBuffer, src
 
 func (l *vulkanDriver) VkCmdUpdateBuffer(commandBuffer VkCommandBuffer, dstBuffer VkBuffer, dstOffset VkDeviceSize, dataSize VkDeviceSize, pData unsafe.Pointer) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdUpdateBuffer", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdUpdateBuffer == nil {
+		panic(missingCommand("vkCmdUpdateBuffer"))
 	}
 
 	C.cgoCmdUpdateBuffer(l.funcPtrs.vkCmdUpdateBuffer,
@@ -1408,7 +1872,11 @@ func (l *vulkanDriver) VkCmdUpdateBuffer(commandBuffer VkCommandBuffer, dstBuffe
 
 func (l *vulkanDriver) VkCmdFillBuffer(commandBuffer VkCommandBuffer, dstBuffer VkBuffer, dstOffset VkDeviceSize, size VkDeviceSize, data Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdFillBuffer", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdFillBuffer == nil {
+		panic(missingCommand("vkCmdFillBuffer"))
 	}
 
 	C.cgoCmdFillBuffer(l.funcPtrs.vkCmdFillBuffer,
@@ -1421,7 +1889,11 @@ func (l *vulkanDriver) VkCmdFillBuffer(commandBuffer VkCommandBuffer, dstBuffer
 
 func (l *vulkanDriver) VkCmdClearColorImage(commandBuffer VkCommandBuffer, image VkImage, imageLayout VkImageLayout, pColor *VkClearColorValue, rangeCount Uint32, pRanges *VkImageSubresourceRange) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdClearColorImage", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdClearColorImage == nil {
+		panic(missingCommand("vkCmdClearColorImage"))
 	}
 
 	C.cgoCmdClearColorImage(l.funcPtrs.vkCmdClearColorImage,
@@ -1435,7 +1907,11 @@ func (l *vulkanDriver) VkCmdClearColorImage(commandBuffer VkCommandBuffer, image
 
 func (l *vulkanDriver) VkCmdClearDepthStencilImage(commandBuffer VkCommandBuffer, image VkImage, imageLayout VkImageLayout, pDepthStencil *VkClearDepthStencilValue, rangeCount Uint32, pRanges *VkImageSubresourceRange) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdClearDepthStencilImage", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdClearDepthStencilImage == nil {
+		panic(missingCommand("vkCmdClearDepthStencilImage"))
 	}
 
 	C.cgoCmdClearDepthStencilImage(l.funcPtrs.vkCmdClearDepthStencilImage,
@@ -1449,7 +1925,11 @@ func (l *vulkanDriver) VkCmdClearDepthStencilImage(commandBuffer VkCommandBuffer
 
 func (l *vulkanDriver) VkCmdClearAttachments(commandBuffer VkCommandBuffer, attachmentCount Uint32, pAttachments *VkClearAttachment, rectCount Uint32, pRects *VkClearRect) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdClearAttachments", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdClearAttachments == nil {
+		panic(missingCommand("vkCmdClearAttachments"))
 	}
 
 	C.cgoCmdClearAttachments(l.funcPtrs.vkCmdClearAttachments,
@@ -1462,7 +1942,11 @@ func (l *vulkanDriver) VkCmdClearAttachments(commandBuffer VkCommandBuffer, atta
 
 func (l *vulkanDriver) VkCmdResolveImage(commandBuffer VkCommandBuffer, srcImage VkImage, srcImageLayout VkImageLayout, dstImage VkImage, dstImageLayout VkImageLayout, regionCount Uint32, pRegions *VkImageResolve) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdResolveImage", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdResolveImage == nil {
+		panic(missingCommand("vkCmdResolveImage"))
 	}
 
 	C.cgoCmdResolveImage(l.funcPtrs.vkCmdResolveImage,
@@ -1477,7 +1961,11 @@ func (l *vulkanDriver) VkCmdResolveImage(commandBuffer VkCommandBuffer, srcImage
 
 func (l *vulkanDriver) VkCmdSetEvent(commandBuffer VkCommandBuffer, event VkEvent, stageMask VkPipelineStageFlags) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdSetEvent", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdSetEvent == nil {
+		panic(missingCommand("vkCmdSetEvent"))
 	}
 
 	C.cgoCmdSetEvent(l.funcPtrs.vkCmdSetEvent,
@@ -1488,7 +1976,11 @@ func (l *vulkanDriver) VkCmdSetEvent(commandBuffer VkCommandBuffer, event VkEven
 
 func (l *vulkanDriver) VkCmdResetEvent(commandBuffer VkCommandBuffer, event VkEvent, stageMask VkPipelineStageFlags) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdResetEvent", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdResetEvent == nil {
+		panic(missingCommand("vkCmdResetEvent"))
 	}
 
 	C.cgoCmdResetEvent(l.funcPtrs.vkCmdResetEvent,
@@ -1499,7 +1991,11 @@ func (l *vulkanDriver) VkCmdResetEvent(commandBuffer VkCommandBuffer, event VkEv
 
 func (l *vulkanDriver) VkCmdWaitEvents(commandBuffer VkCommandBuffer, eventCount Uint32, pEvents *VkEvent, srcStageMask VkPipelineStageFlags, dstStageMask VkPipelineStageFlags, memoryBarrierCount Uint32, pMemoryBarriers *VkMemoryBarrier, bufferMemoryBarrierCount Uint32, pBufferMemoryBarriers *VkBufferMemoryBarrier, imageMemoryBarrierCount Uint32, pImageMemoryBarriers *VkImageMemoryBarrier) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdWaitEvents", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdWaitEvents == nil {
+		panic(missingCommand("vkCmdWaitEvents"))
 	}
 
 	C.cgoCmdWaitEvents(l.funcPtrs.vkCmdWaitEvents,
@@ -1518,7 +2014,11 @@ func (l *vulkanDriver) VkCmdWaitEvents(commandBuffer VkCommandBuffer, eventCount
 
 func (l *vulkanDriver) VkCmdPipelineBarrier(commandBuffer VkCommandBuffer, srcStageMask VkPipelineStageFlags, dstStageMask VkPipelineStageFlags, dependencyFlags VkDependencyFlags, memoryBarrierCount Uint32, pMemoryBarriers *VkMemoryBarrier, bufferMemoryBarrierCount Uint32, pBufferMemoryBarriers *VkBufferMemoryBarrier, imageMemoryBarrierCount Uint32, pImageMemoryBarriers *VkImageMemoryBarrier) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdPipelineBarrier", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdPipelineBarrier == nil {
+		panic(missingCommand("vkCmdPipelineBarrier"))
 	}
 
 	C.cgoCmdPipelineBarrier(l.funcPtrs.vkCmdPipelineBarrier,
@@ -1536,7 +2036,11 @@ func (l *vulkanDriver) VkCmdPipelineBarrier(commandBuffer VkCommandBuffer, srcSt
 
 func (l *vulkanDriver) VkCmdBeginQuery(commandBuffer VkCommandBuffer, queryPool VkQueryPool, query Uint32, flags VkQueryControlFlags) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdBeginQuery", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdBeginQuery == nil {
+		panic(missingCommand("vkCmdBeginQuery"))
 	}
 
 	C.cgoCmdBeginQuery(l.funcPtrs.vkCmdBeginQuery,
@@ -1548,7 +2052,11 @@ func (l *vulkanDriver) VkCmdBeginQuery(commandBuffer VkCommandBuffer, queryPool
 
 func (l *vulkanDriver) VkCmdEndQuery(commandBuffer VkCommandBuffer, queryPool VkQueryPool, query Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdEndQuery", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdEndQuery == nil {
+		panic(missingCommand("vkCmdEndQuery"))
 	}
 
 	C.cgoCmdEndQuery(l.funcPtrs.vkCmdEndQuery,
@@ -1559,7 +2067,11 @@ func (l *vulkanDriver) VkCmdEndQuery(commandBuffer VkCommandBuffer, queryPool Vk
 
 func (l *vulkanDriver) VkCmdResetQueryPool(commandBuffer VkCommandBuffer, queryPool VkQueryPool, firstQuery Uint32, queryCount Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdResetQueryPool", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdResetQueryPool == nil {
+		panic(missingCommand("vkCmdResetQueryPool"))
 	}
 
 	C.cgoCmdResetQueryPool(l.funcPtrs.vkCmdResetQueryPool,
@@ -1571,7 +2083,11 @@ func (l *vulkanDriver) VkCmdResetQueryPool(commandBuffer VkCommandBuffer, queryP
 
 func (l *vulkanDriver) VkCmdWriteTimestamp(commandBuffer VkCommandBuffer, pipelineStage VkPipelineStageFlags, queryPool VkQueryPool, query Uint32) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdWriteTimestamp", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdWriteTimestamp == nil {
+		panic(missingCommand("vkCmdWriteTimestamp"))
 	}
 
 	C.cgoCmdWriteTimestamp(l.funcPtrs.vkCmdWriteTimestamp,
@@ -1583,7 +2099,11 @@ func (l *vulkanDriver) VkCmdWriteTimestamp(commandBuffer VkCommandBuffer, pipeli
 
 func (l *vulkanDriver) VkCmdCopyQueryPoolResults(commandBuffer VkCommandBuffer, queryPool VkQueryPool, firstQuery Uint32, queryCount Uint32, dstBuffer VkBuffer, dstOffset VkDeviceSize, stride VkDeviceSize, flags VkQueryResultFlags) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdCopyQueryPoolResults", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdCopyQueryPoolResults == nil {
+		panic(missingCommand("vkCmdCopyQueryPoolResults"))
 	}
 
 	C.cgoCmdCopyQueryPoolResults(l.funcPtrs.vkCmdCopyQueryPoolResults,
@@ -1599,7 +2119,11 @@ func (l *vulkanDriver) VkCmdCopyQueryPoolResults(commandBuffer VkCommandBuffer,
 
 func (l *vulkanDriver) VkCmdPushConstants(commandBuffer VkCommandBuffer, layout VkPipelineLayout, stageFlags VkShaderStageFlags, offset Uint32, size Uint32, pValues unsafe.Pointer) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdPushConstants", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdPushConstants == nil {
+		panic(missingCommand("vkCmdPushConstants"))
 	}
 
 	C.cgoCmdPushConstants(l.funcPtrs.vkCmdPushConstants,
@@ -1613,7 +2137,11 @@ func (l *vulkanDriver) VkCmdPushConstants(commandBuffer VkCommandBuffer, layout
 
 func (l *vulkanDriver) VkCmdBeginRenderPass(commandBuffer VkCommandBuffer, pRenderPassBegin *VkRenderPassBeginInfo, contents VkSubpassContents) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdBeginRenderPass", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdBeginRenderPass == nil {
+		panic(missingCommand("vkCmdBeginRenderPass"))
 	}
 
 	C.cgoCmdBeginRenderPass(l.funcPtrs.vkCmdBeginRenderPass,
@@ -1624,7 +2152,11 @@ func (l *vulkanDriver) VkCmdBeginRenderPass(commandBuffer VkCommandBuffer, pRend
 
 func (l *vulkanDriver) VkCmdNextSubpass(commandBuffer VkCommandBuffer, contents VkSubpassContents) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdNextSubpass", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdNextSubpass == nil {
+		panic(missingCommand("vkCmdNextSubpass"))
 	}
 
 	C.cgoCmdNextSubpass(l.funcPtrs.vkCmdNextSubpass,
@@ -1634,7 +2166,11 @@ func (l *vulkanDriver) VkCmdNextSubpass(commandBuffer VkCommandBuffer, contents
 
 func (l *vulkanDriver) VkCmdEndRenderPass(commandBuffer VkCommandBuffer) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdEndRenderPass", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdEndRenderPass == nil {
+		panic(missingCommand("vkCmdEndRenderPass"))
 	}
 
 	C.cgoCmdEndRenderPass(l.funcPtrs.vkCmdEndRenderPass,
@@ -1643,7 +2179,11 @@ func (l *vulkanDriver) VkCmdEndRenderPass(commandBuffer VkCommandBuffer) {
 
 func (l *vulkanDriver) VkCmdExecuteCommands(commandBuffer VkCommandBuffer, commandBufferCount Uint32, pCommandBuffers *VkCommandBuffer) {
 	if VulkanHandle(l.device) == NullHandle {
-		panic("attempted device driver function on a non-device driver")
+		panic(&common.FunctionError{Function: "vkCmdExecuteCommands", Err: ErrNotDeviceDriver})
+	}
+
+	if l.funcPtrs.vkCmdExecuteCommands == nil {
+		panic(missingCommand("vkCmdExecuteCommands"))
 	}
 
 	C.cgoCmdExecuteCommands(l.funcPtrs.vkCmdExecuteCommands,
@@ -1654,7 +2194,7 @@ func (l *vulkanDriver) VkCmdExecuteCommands(commandBuffer VkCommandBuffer, comma
 
 func (l *vulkanDriver) VkEnumerateInstanceVersion(pApiVersion *Uint32) (common.VkResult, error) {
 	if l.funcPtrs.vkEnumerateInstanceVersion == nil {
-		panic("attempted to call method 'vkEnumerateInstanceVersion' which is not present on this driver")
+		return vkErrorUnknown, missingCommand("vkEnumerateInstanceVersion")
 	}
 
 	res := common.VkResult(C.cgoEnumerateInstanceVersion(l.funcPtrs.vkEnumerateInstanceVersion,
@@ -1665,7 +2205,7 @@ func (l *vulkanDriver) VkEnumerateInstanceVersion(pApiVersion *Uint32) (common.V
 
 func (l *vulkanDriver) VkEnumeratePhysicalDeviceGroups(instance VkInstance, pPhysicalDeviceGroupCount *Uint32, pPhysicalDeviceGroupProperties *VkPhysicalDeviceGroupProperties) (common.VkResult, error) {
 	if l.funcPtrs.vkEnumeratePhysicalDeviceGroups == nil {
-		panic("attempted to call method 'vkEnumeratePhysicalDeviceGroups' which is not present on this driver")
+		return vkErrorUnknown, missingCommand("vkEnumeratePhysicalDeviceGroups")
 	}
 
 	res := common.VkResult(C.cgoEnumeratePhysicalDeviceGroups(l.funcPtrs.vkEnumeratePhysicalDeviceGroups,
@@ -1678,7 +2218,7 @@ func (l *vulkanDriver) VkEnumeratePhysicalDeviceGroups(instance VkInstance, pPhy
 
 func (l *vulkanDriver) VkGetPhysicalDeviceFeatures2(physicalDevice VkPhysicalDevice, pFeatures *VkPhysicalDeviceFeatures2) {
 	if l.funcPtrs.vkGetPhysicalDeviceFeatures2 == nil {
-		panic("attempted to call method 'vkGetPhysicalDeviceFeatures2' which is not present on this driver")
+		panic(missingCommand("vkGetPhysicalDeviceFeatures2"))
 	}
 
 	C.cgoGetPhysicalDeviceFeatures2(l.funcPtrs.vkGetPhysicalDeviceFeatures2,
@@ -1688,7 +2228,7 @@ func (l *vulkanDriver) VkGetPhysicalDeviceFeatures2(physicalDevice VkPhysicalDev
 
 func (l *vulkanDriver) VkGetPhysicalDeviceProperties2(physicalDevice VkPhysicalDevice, pProperties *VkPhysicalDeviceProperties2) {
 	if l.funcPtrs.vkGetPhysicalDeviceProperties2 == nil {
-		panic("attempted to call method 'vkGetPhysicalDeviceProperties2' which is not present on this driver")
+		panic(missingCommand("vkGetPhysicalDeviceProperties2"))
 	}
 
 	C.cgoGetPhysicalDeviceProperties2(l.funcPtrs.vkGetPhysicalDeviceProperties2,
@@ -1698,7 +2238,7 @@ func (l *vulkanDriver) VkGetPhysicalDeviceProperties2(physicalDevice VkPhysicalD
 
 func (l *vulkanDriver) VkGetPhysicalDeviceFormatProperties2(physicalDevice VkPhysicalDevice, format VkFormat, pFormatProperties *VkFormatProperties2) {
 	if l.funcPtrs.vkGetPhysicalDeviceFormatProperties2 == nil {
-		panic("attempted to call method 'vkGetPhysicalDeviceFormatProperties2' which is not present on this driver")
+		panic(missingCommand("vkGetPhysicalDeviceFormatProperties2"))
 	}
 
 	C.cgoGetPhysicalDeviceFormatProperties2(l.funcPtrs.vkGetPhysicalDeviceFormatProperties2,
@@ -1709,7 +2249,7 @@ func (l *vulkanDriver) VkGetPhysicalDeviceFormatProperties2(physicalDevice VkPhy
 
 func (l *vulkanDriver) VkGetPhysicalDeviceImageFormatProperties2(physicalDevice VkPhysicalDevice, pImageFormatInfo *VkPhysicalDeviceImageFormatInfo2, pImageFormatProperties *VkImageFormatProperties2) (common.VkResult, error) {
 	if l.funcPtrs.vkGetPhysicalDeviceImageFormatProperties2 == nil {
-		panic("attempted to call method 'vkGetPhysicalDeviceImageFormatProperties2' which is not present on this driver")
+		return vkErrorUnknown, missingCommand("vkGetPhysicalDeviceImageFormatProperties2")
 	}
 
 	res := common.VkResult(C.cgoGetPhysicalDeviceImageFormatProperties2(l.funcPtrs.vkGetPhysicalDeviceImageFormatProperties2,
@@ -1722,7 +2262,7 @@ func (l *vulkanDriver) VkGetPhysicalDeviceImageFormatProperties2(physicalDevice
 
 func (l *vulkanDriver) VkGetPhysicalDeviceQueueFamilyProperties2(physicalDevice VkPhysicalDevice, pQueueFamilyPropertyCount *Uint32, pQueueFamilyProperties *VkQueueFamilyProperties2) {
 	if l.funcPtrs.vkGetPhysicalDeviceQueueFamilyProperties2 == nil {
-		panic("attempted to call method 'vkGetPhysicalDeviceQueueFamilyProperties2' which is not present on this driver")
+		panic(missingCommand("vkGetPhysicalDeviceQueueFamilyProperties2"))
 	}
 
 	C.cgoGetPhysicalDeviceQueueFamilyProperties2(l.funcPtrs.vkGetPhysicalDeviceQueueFamilyProperties2,
@@ -1733,7 +2273,7 @@ func (l *vulkanDriver) VkGetPhysicalDeviceQueueFamilyProperties2(physicalDevice
 
 func (l *vulkanDriver) VkGetPhysicalDeviceMemoryProperties2(physicalDevice VkPhysicalDevice, pMemoryProperties *VkPhysicalDeviceMemoryProperties2) {
 	if l.funcPtrs.vkGetPhysicalDeviceMemoryProperties2 == nil {
-		panic("attempted to call method 'vkGetPhysicalDeviceMemoryProperties2' which is not present on this driver")
+		panic(missingCommand("vkGetPhysicalDeviceMemoryProperties2"))
 	}
 
 	C.cgoGetPhysicalDeviceMemoryProperties2(l.funcPtrs.vkGetPhysicalDeviceMemoryProperties2,
@@ -1743,7 +2283,7 @@ func (l *vulkanDriver) VkGetPhysicalDeviceMemoryProperties2(physicalDevice VkPhy
 
 func (l *vulkanDriver) VkGetPhysicalDeviceSparseImageFormatProperties2(physicalDevice VkPhysicalDevice, pFormatInfo *VkPhysicalDeviceSparseImageFormatInfo2, pPropertyCount *Uint32, pProperties *VkSparseImageFormatProperties2) {
 	if l.funcPtrs.vkGetPhysicalDeviceSparseImageFormatProperties2 == nil {
-		panic("attempted to call method 'vkGetPhysicalDeviceSparseImageFormatProperties2' which is not present on this driver")
+		panic(missingCommand("vkGetPhysicalDeviceSparseImageFormatProperties2"))
 	}
 
 	C.cgoGetPhysicalDeviceSparseImageFormatProperties2(l.funcPtrs.vkGetPhysicalDeviceSparseImageFormatProperties2,
@@ -1755,7 +2295,7 @@ func (l *vulkanDriver) VkGetPhysicalDeviceSparseImageFormatProperties2(physicalD
 
 func (l *vulkanDriver) VkGetPhysicalDeviceExternalBufferProperties(physicalDevice VkPhysicalDevice, pExternalBufferInfo *VkPhysicalDeviceExternalBufferInfo, pExternalBufferProperties *VkExternalBufferProperties) {
 	if l.funcPtrs.vkGetPhysicalDeviceExternalBufferProperties == nil {
-		panic("attempted to call method 'vkGetPhysicalDeviceExternalBufferProperties' which is not present on this driver")
+		panic(missingCommand("vkGetPhysicalDeviceExternalBufferProperties"))
 	}
 
 	C.cgoGetPhysicalDeviceExternalBufferProperties(l.funcPtrs.vkGetPhysicalDeviceExternalBufferProperties,
@@ -1766,7 +2306,7 @@ func (l *vulkanDriver) VkGetPhysicalDeviceExternalBufferProperties(physicalDevic
 
 func (l *vulkanDriver) VkGetPhysicalDeviceExternalFenceProperties(physicalDevice VkPhysicalDevice, pExternalFenceInfo *VkPhysicalDeviceExternalFenceInfo, pExternalFenceProperties *VkExternalFenceProperties) {
 	if l.funcPtrs.vkGetPhysicalDeviceExternalFenceProperties == nil {
-		panic("attempted to call method 'vkGetPhysicalDeviceExternalFenceProperties' which is not present on this driver")
+		panic(missingCommand("vkGetPhysicalDeviceExternalFenceProperties"))
 	}
 
 	C.cgoGetPhysicalDeviceExternalFenceProperties(l.funcPtrs.vkGetPhysicalDeviceExternalFenceProperties,
@@ -1777,7 +2317,7 @@ func (l *vulkanDriver) VkGetPhysicalDeviceExternalFenceProperties(physicalDevice
 
 func (l *vulkanDriver) VkGetPhysicalDeviceExternalSemaphoreProperties(physicalDevice VkPhysicalDevice, pExternalSemaphoreInfo *VkPhysicalDeviceExternalSemaphoreInfo, pExternalSemaphoreProperties *VkExternalSemaphoreProperties) {
 	if l.funcPtrs.vkGetPhysicalDeviceExternalSemaphoreProperties == nil {
-		panic("attempted to call method 'vkGetPhysicalDeviceExternalSemaphoreProperties' which is not present on this driver")
+		panic(missingCommand("vkGetPhysicalDeviceExternalSemaphoreProperties"))
 	}
 
 	C.cgoGetPhysicalDeviceExternalSemaphoreProperties(l.funcPtrs.vkGetPhysicalDeviceExternalSemaphoreProperties,
@@ -1788,7 +2328,7 @@ func (l *vulkanDriver) VkGetPhysicalDeviceExternalSemaphoreProperties(physicalDe
 
 func (l *vulkanDriver) VkBindBufferMemory2(device VkDevice, bindInfoCount Uint32, pBindInfos *VkBindBufferMemoryInfo) (common.VkResult, error) {
 	if l.funcPtrs.vkBindBufferMemory2 == nil {
-		panic("attempted to call method 'vkBindBufferMemory2' which is not present on this driver")
+		return vkErrorUnknown, missingCommand("vkBindBufferMemory2")
 	}
 
 	res := common.VkResult(C.cgoBindBufferMemory2(l.funcPtrs.vkBindBufferMemory2,
@@ -1801,7 +2341,7 @@ func (l *vulkanDriver) VkBindBufferMemory2(device VkDevice, bindInfoCount Uint32
 
 func (l *vulkanDriver) VkBindImageMemory2(device VkDevice, bindInfoCount Uint32, pBindInfos *VkBindImageMemoryInfo) (common.VkResult, error) {
 	if l.funcPtrs.vkBindImageMemory2 == nil {
-		panic("attempted to call method 'vkBindImageMemory2' which is not present on this driver")
+		return vkErrorUnknown, missingCommand("vkBindImageMemory2")
 	}
 
 	res := common.VkResult(C.cgoBindImageMemory2(l.funcPtrs.vkBindImageMemory2,
@@ -1814,7 +2354,7 @@ func (l *vulkanDriver) VkBindImageMemory2(device VkDevice, bindInfoCount Uint32,
 
 func (l *vulkanDriver) VkGetDeviceGroupPeerMemoryFeatures(device VkDevice, heapIndex Uint32, localDeviceIndex Uint32, remoteDeviceIndex Uint32, pPeerMemoryFeatures *VkPeerMemoryFeatureFlags) {
 	if l.funcPtrs.vkGetDeviceGroupPeerMemoryFeatures == nil {
-		panic("attempted to call method 'vkGetDeviceGroupPeerMemoryFeatures' which is not present on this driver")
+		panic(missingCommand("vkGetDeviceGroupPeerMemoryFeatures"))
 	}
 
 	C.cgoGetDeviceGroupPeerMemoryFeatures(l.funcPtrs.vkGetDeviceGroupPeerMemoryFeatures,
@@ -1827,7 +2367,7 @@ func (l *vulkanDriver) VkGetDeviceGroupPeerMemoryFeatures(device VkDevice, heapI
 
 func (l *vulkanDriver) VkCmdSetDeviceMask(commandBuffer VkCommandBuffer, deviceMask Uint32) {
 	if l.funcPtrs.vkCmdSetDeviceMask == nil {
-		panic("attempted to call method 'vkCmdSetDeviceMask' which is not present on this driver")
+		panic(missingCommand("vkCmdSetDeviceMask"))
 	}
 
 	C.cgoCmdSetDeviceMask(l.funcPtrs.vkCmdSetDeviceMask,
@@ -1837,7 +2377,7 @@ func (l *vulkanDriver) VkCmdSetDeviceMask(commandBuffer VkCommandBuffer, deviceM
 
 func (l *vulkanDriver) VkCmdDispatchBase(commandBuffer VkCommandBuffer, baseGroupX Uint32, baseGroupY Uint32, baseGroupZ Uint32, groupCountX Uint32, groupCountY Uint32, groupCountZ Uint32) {
 	if l.funcPtrs.vkCmdDispatchBase == nil {
-		panic("attempted to call method 'vkCmdDispatchBase' which is not present on this driver")
+		panic(missingCommand("vkCmdDispatchBase"))
 	}
 
 	C.cgoCmdDispatchBase(l.funcPtrs.vkCmdDispatchBase,
@@ -1852,7 +2392,7 @@ func (l *vulkanDriver) VkCmdDispatchBase(commandBuffer VkCommandBuffer, baseGrou
 
 func (l *vulkanDriver) VkGetImageMemoryRequirements2(device VkDevice, pInfo *VkImageMemoryRequirementsInfo2, pMemoryRequirements *VkMemoryRequirements2) {
 	if l.funcPtrs.vkGetImageMemoryRequirements2 == nil {
-		panic("attempted to call method 'vkGetImageMemoryRequirements2' which is not present on this driver")
+		panic(missingCommand("vkGetImageMemoryRequirements2"))
 	}
 
 	C.cgoGetImageMemoryRequirements2(l.funcPtrs.vkGetImageMemoryRequirements2,
@@ -1863,7 +2403,7 @@ func (l *vulkanDriver) VkGetImageMemoryRequirements2(device VkDevice, pInfo *VkI
 
 func (l *vulkanDriver) VkGetBufferMemoryRequirements2(device VkDevice, pInfo *VkBufferMemoryRequirementsInfo2, pMemoryRequirements *VkMemoryRequirements2) {
 	if l.funcPtrs.vkGetBufferMemoryRequirements2 == nil {
-		panic("attempted to call method 'vkGetBufferMemoryRequirements2' which is not present on this driver")
+		panic(missingCommand("vkGetBufferMemoryRequirements2"))
 	}
 
 	C.cgoGetBufferMemoryRequirements2(l.funcPtrs.vkGetBufferMemoryRequirements2,
@@ -1874,7 +2414,7 @@ func (l *vulkanDriver) VkGetBufferMemoryRequirements2(device VkDevice, pInfo *Vk
 
 func (l *vulkanDriver) VkGetImageSparseMemoryRequirements2(device VkDevice, pInfo *VkImageSparseMemoryRequirementsInfo2, pSparseMemoryRequirementCount *Uint32, pSparseMemoryRequirements *VkSparseImageMemoryRequirements2) {
 	if l.funcPtrs.vkGetImageSparseMemoryRequirements2 == nil {
-		panic("attempted to call method 'vkGetImageSparseMemoryRequirements2' which is not present on this driver")
+		panic(missingCommand("vkGetImageSparseMemoryRequirements2"))
 	}
 
 	C.cgoGetImageSparseMemoryRequirements2(l.funcPtrs.vkGetImageSparseMemoryRequirements2,
@@ -1886,7 +2426,7 @@ func (l *vulkanDriver) VkGetImageSparseMemoryRequirements2(device VkDevice, pInf
 
 func (l *vulkanDriver) VkTrimCommandPool(device VkDevice, commandPool VkCommandPool, flags VkCommandPoolTrimFlags) {
 	if l.funcPtrs.vkTrimCommandPool == nil {
-		panic("attempted to call method 'vkTrimCommandPool' which is not present on this driver")
+		panic(missingCommand("vkTrimCommandPool"))
 	}
 
 	C.cgoTrimCommandPool(l.funcPtrs.vkTrimCommandPool,
@@ -1897,7 +2437,7 @@ func (l *vulkanDriver) VkTrimCommandPool(device VkDevice, commandPool VkCommandP
 
 func (l *vulkanDriver) VkGetDeviceQueue2(device VkDevice, pQueueInfo *VkDeviceQueueInfo2, pQueue *VkQueue) {
 	if l.funcPtrs.vkGetDeviceQueue2 == nil {
-		panic("attempted to call method 'vkGetDeviceQueue2' which is not present on this driver")
+		panic(missingCommand("vkGetDeviceQueue2"))
 	}
 
 	C.cgoGetDeviceQueue2(l.funcPtrs.vkGetDeviceQueue2,
@@ -1908,7 +2448,7 @@ func (l *vulkanDriver) VkGetDeviceQueue2(device VkDevice, pQueueInfo *VkDeviceQu
 
 func (l *vulkanDriver) VkCreateSamplerYcbcrConversion(device VkDevice, pCreateInfo *VkSamplerYcbcrConversionCreateInfo, pAllocator *VkAllocationCallbacks, pYcbcrConversion *VkSamplerYcbcrConversion) (common.VkResult, error) {
 	if l.funcPtrs.vkCreateSamplerYcbcrConversion == nil {
-		panic("attempted to call method 'vkCreateSamplerYcbcrConversion' which is not present on this driver")
+		return vkErrorUnknown, missingCommand("vkCreateSamplerYcbcrConversion")
 	}
 
 	res := common.VkResult(C.cgoCreateSamplerYcbcrConversion(l.funcPtrs.vkCreateSamplerYcbcrConversion,
@@ -1922,7 +2462,7 @@ func (l *vulkanDriver) VkCreateSamplerYcbcrConversion(device VkDevice, pCreateIn
 
 func (l *vulkanDriver) VkDestroySamplerYcbcrConversion(device VkDevice, ycbcrConversion VkSamplerYcbcrConversion, pAllocator *VkAllocationCallbacks) {
 	if l.funcPtrs.vkDestroySamplerYcbcrConversion == nil {
-		panic("attempted to call method 'vkDestroySamplerYcbcrConversion' which is not present on this driver")
+		panic(missingCommand("vkDestroySamplerYcbcrConversion"))
 	}
 
 	C.cgoDestroySamplerYcbcrConversion(l.funcPtrs.vkDestroySamplerYcbcrConversion,
@@ -1933,7 +2473,7 @@ func (l *vulkanDriver) VkDestroySamplerYcbcrConversion(device VkDevice, ycbcrCon
 
 func (l *vulkanDriver) VkCreateDescriptorUpdateTemplate(device VkDevice, pCreateInfo *VkDescriptorUpdateTemplateCreateInfo, pAllocator *VkAllocationCallbacks, pDescriptorUpdateTemplate *VkDescriptorUpdateTemplate) (common.VkResult, error) {
 	if l.funcPtrs.vkCreateDescriptorUpdateTemplate == nil {
-		panic("attempted to call method 'vkCreateDescriptorUpdateTemplate' which is not present on this driver")
+		return vkErrorUnknown, missingCommand("vkCreateDescriptorUpdateTemplate")
 	}
 
 	res := common.VkResult(C.cgoCreateDescriptorUpdateTemplate(l.funcPtrs.vkCreateDescriptorUpdateTemplate,
@@ -1946,7 +2486,7 @@ func (l *vulkanDriver) VkCreateDescriptorUpdateTemplate(device VkDevice, pCreate
 }
 func (l *vulkanDriver) VkDestroyDescriptorUpdateTemplate(device VkDevice, descriptorUpdateTemplate VkDescriptorUpdateTemplate, pAllocator *VkAllocationCallbacks) {
 	if l.funcPtrs.vkDestroyDescriptorUpdateTemplate == nil {
-		panic("attempted to call method 'vkDestroyDescriptorUpdateTemplate' which is not present on this driver")
+		panic(missingCommand("vkDestroyDescriptorUpdateTemplate"))
 	}
 
 	C.cgoDestroyDescriptorUpdateTemplate(l.funcPtrs.vkDestroyDescriptorUpdateTemplate,
@@ -1957,7 +2497,7 @@ func (l *vulkanDriver) VkDestroyDescriptorUpdateTemplate(device VkDevice, descri
 
 func (l *vulkanDriver) VkUpdateDescriptorSetWithTemplate(device VkDevice, descriptorSet VkDescriptorSet, descriptorUpdateTemplate VkDescriptorUpdateTemplate, pData unsafe.Pointer) {
 	if l.funcPtrs.vkUpdateDescriptorSetWithTemplate == nil {
-		panic("attempted to call method 'vkUpdateDescriptorSetWithTemplate' which is not present on this driver")
+		panic(missingCommand("vkUpdateDescriptorSetWithTemplate"))
 	}
 
 	C.cgoUpdateDescriptorSetWithTemplate(l.funcPtrs.vkUpdateDescriptorSetWithTemplate,
@@ -1969,7 +2509,7 @@ func (l *vulkanDriver) VkUpdateDescriptorSetWithTemplate(device VkDevice, descri
 
 func (l *vulkanDriver) VkGetDescriptorSetLayoutSupport(device VkDevice, pCreateInfo *VkDescriptorSetLayoutCreateInfo, pSupport *VkDescriptorSetLayoutSupport) {
 	if l.funcPtrs.vkGetDescriptorSetLayoutSupport == nil {
-		panic("attempted to call method 'vkGetDescriptorSetLayoutSupport' which is not present on this driver")
+		panic(missingCommand("vkGetDescriptorSetLayoutSupport"))
 	}
 
 	C.cgoGetDescriptorSetLayoutSupport(l.funcPtrs.vkGetDescriptorSetLayoutSupport,
@@ -1980,7 +2520,7 @@ func (l *vulkanDriver) VkGetDescriptorSetLayoutSupport(device VkDevice, pCreateI
 
 func (l *vulkanDriver) VkCmdDrawIndirectCount(commandBuffer VkCommandBuffer, buffer VkBuffer, offset VkDeviceSize, countBuffer VkBuffer, countBufferOffset VkDeviceSize, maxDrawCount Uint32, stride Uint32) {
 	if l.funcPtrs.vkCmdDrawIndirectCount == nil {
-		panic("attempted to call method 'vkCmdDrawIndirectCount' which is not present on this driver")
+		panic(missingCommand("vkCmdDrawIndirectCount"))
 	}
 
 	C.cgoCmdDrawIndirectCount(l.funcPtrs.vkCmdDrawIndirectCount,
@@ -1995,7 +2535,7 @@ func (l *vulkanDriver) VkCmdDrawIndirectCount(commandBuffer VkCommandBuffer, buf
 
 func (l *vulkanDriver) VkCmdDrawIndexedIndirectCount(commandBuffer VkCommandBuffer, buffer VkBuffer, offset VkDeviceSize, countBuffer VkBuffer, countBufferOffset VkDeviceSize, maxDrawCount Uint32, stride Uint32) {
 	if l.funcPtrs.vkCmdDrawIndexedIndirectCount == nil {
-		panic("attempted to call method 'vkCmdDrawIndexedIndirectCount' which is not present on this driver")
+		panic(missingCommand("vkCmdDrawIndexedIndirectCount"))
 	}
 
 	C.cgoCmdDrawIndexedIndirectCount(l.funcPtrs.vkCmdDrawIndexedIndirectCount,
@@ -2010,7 +2550,7 @@ func (l *vulkanDriver) VkCmdDrawIndexedIndirectCount(commandBuffer VkCommandBuff
 
 func (l *vulkanDriver) VkCreateRenderPass2(device VkDevice, pCreateInfo *VkRenderPassCreateInfo2, pAllocator *VkAllocationCallbacks, pRenderPass *VkRenderPass) (common.VkResult, error) {
 	if l.funcPtrs.vkCreateRenderPass2 == nil {
-		panic("attempted to call method 'vkCreateRenderPass2' which is not present on this driver")
+		return vkErrorUnknown, missingCommand("vkCreateRenderPass2")
 	}
 
 	res := common.VkResult(C.cgoCreateRenderPass2(l.funcPtrs.vkCreateRenderPass2,
@@ -2024,7 +2564,7 @@ func (l *vulkanDriver) VkCreateRenderPass2(device VkDevice, pCreateInfo *VkRende
 
 func (l *vulkanDriver) VkCmdBeginRenderPass2(commandBuffer VkCommandBuffer, pRenderPassBegin *VkRenderPassBeginInfo, pSubpassBeginInfo *VkSubpassBeginInfo) {
 	if l.funcPtrs.vkCmdBeginRenderPass2 == nil {
-		panic("attempted to call method 'vkCmdBeginRenderPass2' which is not present on this driver")
+		panic(missingCommand("vkCmdBeginRenderPass2"))
 	}
 
 	C.cgoCmdBeginRenderPass2(l.funcPtrs.vkCmdBeginRenderPass2,
@@ -2035,7 +2575,7 @@ func (l *vulkanDriver) VkCmdBeginRenderPass2(commandBuffer VkCommandBuffer, pRen
 
 func (l *vulkanDriver) VkCmdNextSubpass2(commandBuffer VkCommandBuffer, pSubpassBeginInfo *VkSubpassBeginInfo, pSubpassEndInfo *VkSubpassEndInfo) {
 	if l.funcPtrs.vkCmdNextSubpass2 == nil {
-		panic("attempted to call method 'vkCmdNextSubpass2' which is not present on this driver")
+		panic(missingCommand("vkCmdNextSubpass2"))
 	}
 
 	C.cgoCmdNextSubpass2(l.funcPtrs.vkCmdNextSubpass2,
@@ -2046,7 +2586,7 @@ func (l *vulkanDriver) VkCmdNextSubpass2(commandBuffer VkCommandBuffer, pSubpass
 
 func (l *vulkanDriver) VkCmdEndRenderPass2(commandBuffer VkCommandBuffer, pSubpassEndInfo *VkSubpassEndInfo) {
 	if l.funcPtrs.vkCmdEndRenderPass2 == nil {
-		panic("attempted to call method 'vkCmdEndRenderPass2' which is not present on this driver")
+		panic(missingCommand("vkCmdEndRenderPass2"))
 	}
 
 	C.cgoCmdEndRenderPass2(l.funcPtrs.vkCmdEndRenderPass2,
@@ -2056,7 +2596,7 @@ func (l *vulkanDriver) VkCmdEndRenderPass2(commandBuffer VkCommandBuffer, pSubpa
 
 func (l *vulkanDriver) VkResetQueryPool(device VkDevice, queryPool VkQueryPool, firstQuery Uint32, queryCount Uint32) {
 	if l.funcPtrs.vkResetQueryPool == nil {
-		panic("attempted to call method 'vkResetQueryPool' which is not present on this driver")
+		panic(missingCommand("vkResetQueryPool"))
 	}
 
 	C.cgoResetQueryPool(l.funcPtrs.vkResetQueryPool,
@@ -2068,7 +2608,7 @@ func (l *vulkanDriver) VkResetQueryPool(device VkDevice, queryPool VkQueryPool,
 
 func (l *vulkanDriver) VkGetSemaphoreCounterValue(device VkDevice, semaphore VkSemaphore, pValue *Uint64) (common.VkResult, error) {
 	if l.funcPtrs.vkGetSemaphoreCounterValue == nil {
-		panic("attempted to call method 'vkGetSemaphoreCounterValue' which is not present on this driver")
+		return vkErrorUnknown, missingCommand("vkGetSemaphoreCounterValue")
 	}
 
 	res := common.VkResult(C.cgoGetSemaphoreCounterValue(l.funcPtrs.vkGetSemaphoreCounterValue,
@@ -2081,7 +2621,7 @@ func (l *vulkanDriver) VkGetSemaphoreCounterValue(device VkDevice, semaphore VkS
 
 func (l *vulkanDriver) VkWaitSemaphores(device VkDevice, pWaitInfo *VkSemaphoreWaitInfo, timeout Uint64) (common.VkResult, error) {
 	if l.funcPtrs.vkWaitSemaphores == nil {
-		panic("attempted to call method 'vkWaitSemaphores' which is not present on this driver")
+		return vkErrorUnknown, missingCommand("vkWaitSemaphores")
 	}
 
 	res := common.VkResult(C.cgoWaitSemaphores(l.funcPtrs.vkWaitSemaphores,
@@ -2094,7 +2634,7 @@ func (l *vulkanDriver) VkWaitSemaphores(device VkDevice, pWaitInfo *VkSemaphoreW
 
 func (l *vulkanDriver) VkSignalSemaphore(device VkDevice, pSignalInfo *VkSemaphoreSignalInfo) (common.VkResult, error) {
 	if l.funcPtrs.vkSignalSemaphore == nil {
-		panic("attempted to call method 'vkSignalSemaphore' which is not present on this driver")
+		return vkErrorUnknown, missingCommand("vkSignalSemaphore")
 	}
 
 	res := common.VkResult(C.cgoSignalSemaphore(l.funcPtrs.vkSignalSemaphore,
@@ -2106,7 +2646,7 @@ func (l *vulkanDriver) VkSignalSemaphore(device VkDevice, pSignalInfo *VkSemapho
 
 func (l *vulkanDriver) VkGetBufferDeviceAddress(device VkDevice, pInfo *VkBufferDeviceAddressInfo) VkDeviceAddress {
 	if l.funcPtrs.vkGetBufferDeviceAddress == nil {
-		panic("attempted to call method 'vkGetBufferDeviceAddress' which is not present on this driver")
+		panic(missingCommand("vkGetBufferDeviceAddress"))
 	}
 
 	address := VkDeviceAddress(C.cgoGetBufferDeviceAddress(l.funcPtrs.vkGetBufferDeviceAddress,
@@ -2118,7 +2658,7 @@ func (l *vulkanDriver) VkGetBufferDeviceAddress(device VkDevice, pInfo *VkBuffer
 
 func (l *vulkanDriver) VkGetBufferOpaqueCaptureAddress(device VkDevice, pInfo *VkBufferDeviceAddressInfo) Uint64 {
 	if l.funcPtrs.vkGetBufferOpaqueCaptureAddress == nil {
-		panic("attempted to call method 'vkGetBufferOpaqueCaptureAddress' which is not present on this driver")
+		panic(missingCommand("vkGetBufferOpaqueCaptureAddress"))
 	}
 
 	address := Uint64(C.cgoGetBufferOpaqueCaptureAddress(l.funcPtrs.vkGetBufferOpaqueCaptureAddress,
@@ -2130,7 +2670,7 @@ func (l *vulkanDriver) VkGetBufferOpaqueCaptureAddress(device VkDevice, pInfo *V
 
 func (l *vulkanDriver) VkGetDeviceMemoryOpaqueCaptureAddress(device VkDevice, pInfo *VkDeviceMemoryOpaqueCaptureAddressInfo) Uint64 {
 	if l.funcPtrs.vkGetDeviceMemoryOpaqueCaptureAddress == nil {
-		panic("attempted to call method 'vkGetDeviceMemoryOpaqueCaptureAddress' which is not present on this driver")
+		panic(missingCommand("vkGetDeviceMemoryOpaqueCaptureAddress"))
 	}
 
 	address := Uint64(C.cgoGetDeviceMemoryOpaqueCaptureAddress(l.funcPtrs.vkGetDeviceMemoryOpaqueCaptureAddress,
@@ -2139,3 +2679,413 @@ func (l *vulkanDriver) VkGetDeviceMemoryOpaqueCaptureAddress(device VkDevice, pI
 
 	return address
 }
+
+func (l *vulkanDriver) VkCmdBeginRendering(commandBuffer VkCommandBuffer, pRenderingInfo *VkRenderingInfo) {
+	if l.funcPtrs.vkCmdBeginRendering == nil {
+		panic(missingCommand("vkCmdBeginRendering"))
+	}
+
+	C.cgoCmdBeginRendering(l.funcPtrs.vkCmdBeginRendering,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		(*C.VkRenderingInfo)(pRenderingInfo))
+}
+
+func (l *vulkanDriver) VkCmdEndRendering(commandBuffer VkCommandBuffer) {
+	if l.funcPtrs.vkCmdEndRendering == nil {
+		panic(missingCommand("vkCmdEndRendering"))
+	}
+
+	C.cgoCmdEndRendering(l.funcPtrs.vkCmdEndRendering,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)))
+}
+
+func (l *vulkanDriver) VkCmdSetEvent2(commandBuffer VkCommandBuffer, event VkEvent, pDependencyInfo *VkDependencyInfo) {
+	if l.funcPtrs.vkCmdSetEvent2 == nil {
+		panic(missingCommand("vkCmdSetEvent2"))
+	}
+
+	C.cgoCmdSetEvent2(l.funcPtrs.vkCmdSetEvent2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkEvent(unsafe.Pointer(event)),
+		(*C.VkDependencyInfo)(pDependencyInfo))
+}
+
+func (l *vulkanDriver) VkCmdResetEvent2(commandBuffer VkCommandBuffer, event VkEvent, stageMask VkPipelineStageFlags2) {
+	if l.funcPtrs.vkCmdResetEvent2 == nil {
+		panic(missingCommand("vkCmdResetEvent2"))
+	}
+
+	C.cgoCmdResetEvent2(l.funcPtrs.vkCmdResetEvent2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkEvent(unsafe.Pointer(event)),
+		C.VkPipelineStageFlags2(stageMask))
+}
+
+func (l *vulkanDriver) VkCmdWaitEvents2(commandBuffer VkCommandBuffer, eventCount Uint32, pEvents *VkEvent, pDependencyInfos *VkDependencyInfo) {
+	if l.funcPtrs.vkCmdWaitEvents2 == nil {
+		panic(missingCommand("vkCmdWaitEvents2"))
+	}
+
+	C.cgoCmdWaitEvents2(l.funcPtrs.vkCmdWaitEvents2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.uint32_t(eventCount),
+		(*C.VkEvent)(unsafe.Pointer(pEvents)),
+		(*C.VkDependencyInfo)(pDependencyInfos))
+}
+
+func (l *vulkanDriver) VkCmdPipelineBarrier2(commandBuffer VkCommandBuffer, pDependencyInfo *VkDependencyInfo) {
+	if l.funcPtrs.vkCmdPipelineBarrier2 == nil {
+		panic(missingCommand("vkCmdPipelineBarrier2"))
+	}
+
+	C.cgoCmdPipelineBarrier2(l.funcPtrs.vkCmdPipelineBarrier2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		(*C.VkDependencyInfo)(pDependencyInfo))
+}
+
+func (l *vulkanDriver) VkCmdWriteTimestamp2(commandBuffer VkCommandBuffer, stage VkPipelineStageFlags2, queryPool VkQueryPool, query Uint32) {
+	if l.funcPtrs.vkCmdWriteTimestamp2 == nil {
+		panic(missingCommand("vkCmdWriteTimestamp2"))
+	}
+
+	C.cgoCmdWriteTimestamp2(l.funcPtrs.vkCmdWriteTimestamp2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkPipelineStageFlags2(stage),
+		C.VkQueryPool(unsafe.Pointer(queryPool)),
+		C.uint32_t(query))
+}
+
+func (l *vulkanDriver) VkQueueSubmit2(queue VkQueue, submitCount Uint32, pSubmits *VkSubmitInfo2, fence VkFence) (common.VkResult, error) {
+	if l.funcPtrs.vkQueueSubmit2 == nil {
+		return vkErrorUnknown, missingCommand("vkQueueSubmit2")
+	}
+
+	res := common.VkResult(C.cgoQueueSubmit2(l.funcPtrs.vkQueueSubmit2,
+		C.VkQueue(unsafe.Pointer(queue)),
+		C.uint32_t(submitCount),
+		(*C.VkSubmitInfo2)(pSubmits),
+		C.VkFence(unsafe.Pointer(fence))))
+
+	return res, res.ToError()
+}
+
+func (l *vulkanDriver) VkCmdSetCullMode(commandBuffer VkCommandBuffer, cullMode VkCullModeFlags) {
+	if l.funcPtrs.vkCmdSetCullMode == nil {
+		panic(missingCommand("vkCmdSetCullMode"))
+	}
+
+	C.cgoCmdSetCullMode(l.funcPtrs.vkCmdSetCullMode,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkCullModeFlags(cullMode))
+}
+
+func (l *vulkanDriver) VkCmdSetFrontFace(commandBuffer VkCommandBuffer, frontFace VkFrontFace) {
+	if l.funcPtrs.vkCmdSetFrontFace == nil {
+		panic(missingCommand("vkCmdSetFrontFace"))
+	}
+
+	C.cgoCmdSetFrontFace(l.funcPtrs.vkCmdSetFrontFace,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkFrontFace(frontFace))
+}
+
+func (l *vulkanDriver) VkCmdSetPrimitiveTopology(commandBuffer VkCommandBuffer, primitiveTopology VkPrimitiveTopology) {
+	if l.funcPtrs.vkCmdSetPrimitiveTopology == nil {
+		panic(missingCommand("vkCmdSetPrimitiveTopology"))
+	}
+
+	C.cgoCmdSetPrimitiveTopology(l.funcPtrs.vkCmdSetPrimitiveTopology,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkPrimitiveTopology(primitiveTopology))
+}
+
+func (l *vulkanDriver) VkCmdSetViewportWithCount(commandBuffer VkCommandBuffer, viewportCount Uint32, pViewports *VkViewport) {
+	if l.funcPtrs.vkCmdSetViewportWithCount == nil {
+		panic(missingCommand("vkCmdSetViewportWithCount"))
+	}
+
+	C.cgoCmdSetViewportWithCount(l.funcPtrs.vkCmdSetViewportWithCount,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.uint32_t(viewportCount),
+		(*C.VkViewport)(pViewports))
+}
+
+func (l *vulkanDriver) VkCmdSetScissorWithCount(commandBuffer VkCommandBuffer, scissorCount Uint32, pScissors *VkRect2D) {
+	if l.funcPtrs.vkCmdSetScissorWithCount == nil {
+		panic(missingCommand("vkCmdSetScissorWithCount"))
+	}
+
+	C.cgoCmdSetScissorWithCount(l.funcPtrs.vkCmdSetScissorWithCount,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.uint32_t(scissorCount),
+		(*C.VkRect2D)(pScissors))
+}
+
+func (l *vulkanDriver) VkCmdBindVertexBuffers2(commandBuffer VkCommandBuffer, firstBinding Uint32, bindingCount Uint32, pBuffers *VkBuffer, pOffsets *VkDeviceSize, pSizes *VkDeviceSize, pStrides *VkDeviceSize) {
+	if l.funcPtrs.vkCmdBindVertexBuffers2 == nil {
+		panic(missingCommand("vkCmdBindVertexBuffers2"))
+	}
+
+	C.cgoCmdBindVertexBuffers2(l.funcPtrs.vkCmdBindVertexBuffers2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.uint32_t(firstBinding),
+		C.uint32_t(bindingCount),
+		(*C.VkBuffer)(unsafe.Pointer(pBuffers)),
+		(*C.VkDeviceSize)(pOffsets),
+		(*C.VkDeviceSize)(pSizes),
+		(*C.VkDeviceSize)(pStrides))
+}
+
+func (l *vulkanDriver) VkCmdSetDepthTestEnable(commandBuffer VkCommandBuffer, depthTestEnable VkBool32) {
+	if l.funcPtrs.vkCmdSetDepthTestEnable == nil {
+		panic(missingCommand("vkCmdSetDepthTestEnable"))
+	}
+
+	C.cgoCmdSetDepthTestEnable(l.funcPtrs.vkCmdSetDepthTestEnable,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkBool32(depthTestEnable))
+}
+
+func (l *vulkanDriver) VkCmdSetDepthWriteEnable(commandBuffer VkCommandBuffer, depthWriteEnable VkBool32) {
+	if l.funcPtrs.vkCmdSetDepthWriteEnable == nil {
+		panic(missingCommand("vkCmdSetDepthWriteEnable"))
+	}
+
+	C.cgoCmdSetDepthWriteEnable(l.funcPtrs.vkCmdSetDepthWriteEnable,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkBool32(depthWriteEnable))
+}
+
+func (l *vulkanDriver) VkCmdSetDepthCompareOp(commandBuffer VkCommandBuffer, depthCompareOp VkCompareOp) {
+	if l.funcPtrs.vkCmdSetDepthCompareOp == nil {
+		panic(missingCommand("vkCmdSetDepthCompareOp"))
+	}
+
+	C.cgoCmdSetDepthCompareOp(l.funcPtrs.vkCmdSetDepthCompareOp,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkCompareOp(depthCompareOp))
+}
+
+func (l *vulkanDriver) VkCmdSetDepthBoundsTestEnable(commandBuffer VkCommandBuffer, depthBoundsTestEnable VkBool32) {
+	if l.funcPtrs.vkCmdSetDepthBoundsTestEnable == nil {
+		panic(missingCommand("vkCmdSetDepthBoundsTestEnable"))
+	}
+
+	C.cgoCmdSetDepthBoundsTestEnable(l.funcPtrs.vkCmdSetDepthBoundsTestEnable,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkBool32(depthBoundsTestEnable))
+}
+
+func (l *vulkanDriver) VkCmdSetStencilTestEnable(commandBuffer VkCommandBuffer, stencilTestEnable VkBool32) {
+	if l.funcPtrs.vkCmdSetStencilTestEnable == nil {
+		panic(missingCommand("vkCmdSetStencilTestEnable"))
+	}
+
+	C.cgoCmdSetStencilTestEnable(l.funcPtrs.vkCmdSetStencilTestEnable,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkBool32(stencilTestEnable))
+}
+
+func (l *vulkanDriver) VkCmdSetStencilOp(commandBuffer VkCommandBuffer, faceMask VkStencilFaceFlags, failOp VkStencilOp, passOp VkStencilOp, depthFailOp VkStencilOp, compareOp VkCompareOp) {
+	if l.funcPtrs.vkCmdSetStencilOp == nil {
+		panic(missingCommand("vkCmdSetStencilOp"))
+	}
+
+	C.cgoCmdSetStencilOp(l.funcPtrs.vkCmdSetStencilOp,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkStencilFaceFlags(faceMask),
+		C.VkStencilOp(failOp),
+		C.VkStencilOp(passOp),
+		C.VkStencilOp(depthFailOp),
+		C.VkCompareOp(compareOp))
+}
+
+func (l *vulkanDriver) VkCmdSetRasterizerDiscardEnable(commandBuffer VkCommandBuffer, rasterizerDiscardEnable VkBool32) {
+	if l.funcPtrs.vkCmdSetRasterizerDiscardEnable == nil {
+		panic(missingCommand("vkCmdSetRasterizerDiscardEnable"))
+	}
+
+	C.cgoCmdSetRasterizerDiscardEnable(l.funcPtrs.vkCmdSetRasterizerDiscardEnable,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkBool32(rasterizerDiscardEnable))
+}
+
+func (l *vulkanDriver) VkCmdSetDepthBiasEnable(commandBuffer VkCommandBuffer, depthBiasEnable VkBool32) {
+	if l.funcPtrs.vkCmdSetDepthBiasEnable == nil {
+		panic(missingCommand("vkCmdSetDepthBiasEnable"))
+	}
+
+	C.cgoCmdSetDepthBiasEnable(l.funcPtrs.vkCmdSetDepthBiasEnable,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkBool32(depthBiasEnable))
+}
+
+func (l *vulkanDriver) VkCmdSetPrimitiveRestartEnable(commandBuffer VkCommandBuffer, primitiveRestartEnable VkBool32) {
+	if l.funcPtrs.vkCmdSetPrimitiveRestartEnable == nil {
+		panic(missingCommand("vkCmdSetPrimitiveRestartEnable"))
+	}
+
+	C.cgoCmdSetPrimitiveRestartEnable(l.funcPtrs.vkCmdSetPrimitiveRestartEnable,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		C.VkBool32(primitiveRestartEnable))
+}
+
+func (l *vulkanDriver) VkCmdCopyBuffer2(commandBuffer VkCommandBuffer, pCopyBufferInfo *VkCopyBufferInfo2) {
+	if l.funcPtrs.vkCmdCopyBuffer2 == nil {
+		panic(missingCommand("vkCmdCopyBuffer2"))
+	}
+
+	C.cgoCmdCopyBuffer2(l.funcPtrs.vkCmdCopyBuffer2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		(*C.VkCopyBufferInfo2)(pCopyBufferInfo))
+}
+
+func (l *vulkanDriver) VkCmdCopyImage2(commandBuffer VkCommandBuffer, pCopyImageInfo *VkCopyImageInfo2) {
+	if l.funcPtrs.vkCmdCopyImage2 == nil {
+		panic(missingCommand("vkCmdCopyImage2"))
+	}
+
+	C.cgoCmdCopyImage2(l.funcPtrs.vkCmdCopyImage2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		(*C.VkCopyImageInfo2)(pCopyImageInfo))
+}
+
+func (l *vulkanDriver) VkCmdCopyBufferToImage2(commandBuffer VkCommandBuffer, pCopyBufferToImageInfo *VkCopyBufferToImageInfo2) {
+	if l.funcPtrs.vkCmdCopyBufferToImage2 == nil {
+		panic(missingCommand("vkCmdCopyBufferToImage2"))
+	}
+
+	C.cgoCmdCopyBufferToImage2(l.funcPtrs.vkCmdCopyBufferToImage2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		(*C.VkCopyBufferToImageInfo2)(pCopyBufferToImageInfo))
+}
+
+func (l *vulkanDriver) VkCmdCopyImageToBuffer2(commandBuffer VkCommandBuffer, pCopyImageToBufferInfo *VkCopyImageToBufferInfo2) {
+	if l.funcPtrs.vkCmdCopyImageToBuffer2 == nil {
+		panic(missingCommand("vkCmdCopyImageToBuffer2"))
+	}
+
+	C.cgoCmdCopyImageToBuffer2(l.funcPtrs.vkCmdCopyImageToBuffer2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		(*C.VkCopyImageToBufferInfo2)(pCopyImageToBufferInfo))
+}
+
+func (l *vulkanDriver) VkCmdBlitImage2(commandBuffer VkCommandBuffer, pBlitImageInfo *VkBlitImageInfo2) {
+	if l.funcPtrs.vkCmdBlitImage2 == nil {
+		panic(missingCommand("vkCmdBlitImage2"))
+	}
+
+	C.cgoCmdBlitImage2(l.funcPtrs.vkCmdBlitImage2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		(*C.VkBlitImageInfo2)(pBlitImageInfo))
+}
+
+func (l *vulkanDriver) VkCmdResolveImage2(commandBuffer VkCommandBuffer, pResolveImageInfo *VkResolveImageInfo2) {
+	if l.funcPtrs.vkCmdResolveImage2 == nil {
+		panic(missingCommand("vkCmdResolveImage2"))
+	}
+
+	C.cgoCmdResolveImage2(l.funcPtrs.vkCmdResolveImage2,
+		C.VkCommandBuffer(unsafe.Pointer(commandBuffer)),
+		(*C.VkResolveImageInfo2)(pResolveImageInfo))
+}
+
+func (l *vulkanDriver) VkGetDeviceBufferMemoryRequirements(device VkDevice, pInfo *VkDeviceBufferMemoryRequirements, pMemoryRequirements *VkMemoryRequirements2) {
+	if l.funcPtrs.vkGetDeviceBufferMemoryRequirements == nil {
+		panic(missingCommand("vkGetDeviceBufferMemoryRequirements"))
+	}
+
+	C.cgoGetDeviceBufferMemoryRequirements(l.funcPtrs.vkGetDeviceBufferMemoryRequirements,
+		C.VkDevice(unsafe.Pointer(device)),
+		(*C.VkDeviceBufferMemoryRequirements)(pInfo),
+		(*C.VkMemoryRequirements2)(pMemoryRequirements))
+}
+
+func (l *vulkanDriver) VkGetDeviceImageMemoryRequirements(device VkDevice, pInfo *VkDeviceImageMemoryRequirements, pMemoryRequirements *VkMemoryRequirements2) {
+	if l.funcPtrs.vkGetDeviceImageMemoryRequirements == nil {
+		panic(missingCommand("vkGetDeviceImageMemoryRequirements"))
+	}
+
+	C.cgoGetDeviceImageMemoryRequirements(l.funcPtrs.vkGetDeviceImageMemoryRequirements,
+		C.VkDevice(unsafe.Pointer(device)),
+		(*C.VkDeviceImageMemoryRequirements)(pInfo),
+		(*C.VkMemoryRequirements2)(pMemoryRequirements))
+}
+
+func (l *vulkanDriver) VkGetDeviceImageSparseMemoryRequirements(device VkDevice, pInfo *VkDeviceImageMemoryRequirements, pSparseMemoryRequirementCount *Uint32, pSparseMemoryRequirements *VkSparseImageMemoryRequirements2) {
+	if l.funcPtrs.vkGetDeviceImageSparseMemoryRequirements == nil {
+		panic(missingCommand("vkGetDeviceImageSparseMemoryRequirements"))
+	}
+
+	C.cgoGetDeviceImageSparseMemoryRequirements(l.funcPtrs.vkGetDeviceImageSparseMemoryRequirements,
+		C.VkDevice(unsafe.Pointer(device)),
+		(*C.VkDeviceImageMemoryRequirements)(pInfo),
+		(*C.uint32_t)(pSparseMemoryRequirementCount),
+		(*C.VkSparseImageMemoryRequirements2)(pSparseMemoryRequirements))
+}
+
+func (l *vulkanDriver) VkCreatePrivateDataSlot(device VkDevice, pCreateInfo *VkPrivateDataSlotCreateInfo, pAllocator *VkAllocationCallbacks, pPrivateDataSlot *VkPrivateDataSlot) (common.VkResult, error) {
+	if l.funcPtrs.vkCreatePrivateDataSlot == nil {
+		return vkErrorUnknown, missingCommand("vkCreatePrivateDataSlot")
+	}
+
+	res := common.VkResult(C.cgoCreatePrivateDataSlot(l.funcPtrs.vkCreatePrivateDataSlot,
+		C.VkDevice(unsafe.Pointer(device)),
+		(*C.VkPrivateDataSlotCreateInfo)(pCreateInfo),
+		(*C.VkAllocationCallbacks)(pAllocator),
+		(*C.VkPrivateDataSlot)(unsafe.Pointer(pPrivateDataSlot))))
+
+	return res, res.ToError()
+}
+
+func (l *vulkanDriver) VkDestroyPrivateDataSlot(device VkDevice, privateDataSlot VkPrivateDataSlot, pAllocator *VkAllocationCallbacks) {
+	if l.funcPtrs.vkDestroyPrivateDataSlot == nil {
+		panic(missingCommand("vkDestroyPrivateDataSlot"))
+	}
+
+	C.cgoDestroyPrivateDataSlot(l.funcPtrs.vkDestroyPrivateDataSlot,
+		C.VkDevice(unsafe.Pointer(device)),
+		C.VkPrivateDataSlot(unsafe.Pointer(privateDataSlot)),
+		(*C.VkAllocationCallbacks)(pAllocator))
+}
+
+func (l *vulkanDriver) VkSetPrivateData(device VkDevice, objectType VkObjectType, objectHandle Uint64, privateDataSlot VkPrivateDataSlot, data Uint64) (common.VkResult, error) {
+	if l.funcPtrs.vkSetPrivateData == nil {
+		return vkErrorUnknown, missingCommand("vkSetPrivateData")
+	}
+
+	res := common.VkResult(C.cgoSetPrivateData(l.funcPtrs.vkSetPrivateData,
+		C.VkDevice(unsafe.Pointer(device)),
+		C.VkObjectType(objectType),
+		C.uint64_t(objectHandle),
+		C.VkPrivateDataSlot(unsafe.Pointer(privateDataSlot)),
+		C.uint64_t(data)))
+
+	return res, res.ToError()
+}
+
+func (l *vulkanDriver) VkGetPrivateData(device VkDevice, objectType VkObjectType, objectHandle Uint64, privateDataSlot VkPrivateDataSlot, pData *Uint64) {
+	if l.funcPtrs.vkGetPrivateData == nil {
+		panic(missingCommand("vkGetPrivateData"))
+	}
+
+	C.cgoGetPrivateData(l.funcPtrs.vkGetPrivateData,
+		C.VkDevice(unsafe.Pointer(device)),
+		C.VkObjectType(objectType),
+		C.uint64_t(objectHandle),
+		C.VkPrivateDataSlot(unsafe.Pointer(privateDataSlot)),
+		(*C.uint64_t)(pData))
+}
+
+func (l *vulkanDriver) VkGetPhysicalDeviceToolProperties(physicalDevice VkPhysicalDevice, pToolCount *Uint32, pToolProperties *VkPhysicalDeviceToolProperties) (common.VkResult, error) {
+	if l.funcPtrs.vkGetPhysicalDeviceToolProperties == nil {
+		return vkErrorUnknown, missingCommand("vkGetPhysicalDeviceToolProperties")
+	}
+
+	res := common.VkResult(C.cgoGetPhysicalDeviceToolProperties(l.funcPtrs.vkGetPhysicalDeviceToolProperties,
+		C.VkPhysicalDevice(unsafe.Pointer(physicalDevice)),
+		(*C.uint32_t)(pToolCount),
+		(*C.VkPhysicalDeviceToolProperties)(pToolProperties)))
+
+	return res, res.ToError()
+}
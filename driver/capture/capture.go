@@ -0,0 +1,283 @@
+package capture
+
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/driver"
+	"io"
+	"math"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// Driver is a driver.Driver that forwards every call to another driver.Driver, writing each Vulkan
+// command to a capture that can be passed to Replay. The contents of every structure and array passed
+// to a command are captured, including pNext chains, as are writes made by the application to mapped
+// memory before it is unmapped, flushed, or submitted. Instance and Device drivers created from a
+// capture Driver write to the same capture.
+//
+// The data passed to vkUpdateDescriptorSetWithTemplate is only captured for templates created through
+// the same capture, and structures that are not defined in vulkan_core.h are dropped from pNext chains.
+type Driver struct {
+	inner    driver.Driver
+	recorder *recorder
+	id       uint64
+}
+
+var _ driver.Driver = &Driver{}
+
+type mapping struct {
+	data   unsafe.Pointer
+	offset uint64
+	size   uint64
+}
+
+type recorder struct {
+	lock      sync.Mutex
+	writer    io.Writer
+	err       error
+	nextID    uint64
+	sizes     map[driver.VkDeviceMemory]uint64
+	mappings  map[driver.VkDeviceMemory]mapping
+	templates map[driver.VkDescriptorUpdateTemplate]templateLayout
+}
+
+type call struct {
+	encoder
+	command command
+	args    []reflect.Value
+}
+
+// NewDriver creates a Driver that forwards calls to inner and writes a capture of them to writer.
+// writer is not buffered, so callers writing to a file should wrap it in a bufio.Writer, and flush
+// it once they are finished.
+func NewDriver(inner driver.Driver, writer io.Writer) (*Driver, error) {
+	header := encoder{buf: append([]byte{}, magic...)}
+	header.uvarint(formatVersion)
+
+	_, err := writer.Write(header.buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Driver{
+		inner: inner,
+		recorder: &recorder{
+			writer:    writer,
+			sizes:     make(map[driver.VkDeviceMemory]uint64),
+			mappings:  make(map[driver.VkDeviceMemory]mapping),
+			templates: make(map[driver.VkDescriptorUpdateTemplate]templateLayout),
+		},
+	}, nil
+}
+
+// Err returns the first error encountered while writing the capture, if any. Once an error has
+// been encountered, no further records are written, but calls are still forwarded.
+func (d *Driver) Err() error {
+	d.recorder.lock.Lock()
+	defer d.recorder.lock.Unlock()
+
+	return d.recorder.err
+}
+
+func (d *Driver) Destroy() {
+	d.inner.Destroy()
+}
+
+func (d *Driver) createDriver(inner driver.Driver, kind byte, handle driver.VulkanHandle) *Driver {
+	d.recorder.lock.Lock()
+	defer d.recorder.lock.Unlock()
+
+	d.recorder.nextID++
+	child := &Driver{
+		inner:    inner,
+		recorder: d.recorder,
+		id:       d.recorder.nextID,
+	}
+
+	var record encoder
+	record.byte(recordDriver)
+	record.uvarint(child.id)
+	record.uvarint(d.id)
+	record.byte(kind)
+	record.uvarint(uint64(handle))
+	d.recorder.write(record.buf)
+
+	return child
+}
+
+func (d *Driver) CreateInstanceDriver(instance driver.VkInstance) (driver.Driver, error) {
+	instanceDriver, err := d.inner.CreateInstanceDriver(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.createDriver(instanceDriver, driverInstance, driver.VulkanHandle(instance)), nil
+}
+
+func (d *Driver) CreateDeviceDriver(device driver.VkDevice) (driver.Driver, error) {
+	deviceDriver, err := d.inner.CreateDeviceDriver(device)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.createDriver(deviceDriver, driverDevice, driver.VulkanHandle(device)), nil
+}
+
+func (d *Driver) LoadProcAddr(name *driver.Char) unsafe.Pointer {
+	return d.inner.LoadProcAddr(name)
+}
+
+func (d *Driver) Version() common.APIVersion {
+	return d.inner.Version()
+}
+
+func (d *Driver) HasCommand(name string) bool {
+	return d.inner.HasCommand(name)
+}
+
+func (d *Driver) ObjectStore() *driver.VulkanObjectStore {
+	return d.inner.ObjectStore()
+}
+
+// write writes a single record to the capture. The recorder lock must be held.
+func (r *recorder) write(record []byte) {
+	if r.err != nil {
+		return
+	}
+
+	_, r.err = r.writer.Write(record)
+}
+
+func scalarBits(value reflect.Value) uint64 {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(value.Int())
+	case reflect.Float32:
+		return uint64(math.Float32bits(float32(value.Float())))
+	case reflect.Float64:
+		return math.Float64bits(value.Float())
+	default:
+		return value.Uint()
+	}
+}
+
+func elemOf(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+
+	return nil
+}
+
+// length resolves the length of an array parameter, returning -1 for strings
+func (d *Driver) length(c *call, l length) int {
+	switch l.kind {
+	case lengthFixed:
+		return l.param
+	case lengthParam:
+		return int(scalarBits(c.args[l.param]))
+	case lengthParamRef:
+		ref := c.args[l.param]
+		if ref.IsNil() {
+			return 0
+		}
+		return int(readScalar(ref.UnsafePointer(), ref.Type().Elem().Size()))
+	case lengthField:
+		ref := c.args[l.param]
+		if ref.IsNil() {
+			return 0
+		}
+		f, _ := ref.Type().Elem().FieldByName(l.field)
+		return int(readScalar(unsafe.Add(ref.UnsafePointer(), f.Offset), f.Type.Size()))
+	case lengthString:
+		return -1
+	case lengthTemplate:
+		d.recorder.lock.Lock()
+		defer d.recorder.lock.Unlock()
+
+		layout, ok := d.recorder.templates[driver.VkDescriptorUpdateTemplate(c.args[l.param].Uint())]
+		if !ok {
+			return -1
+		}
+		return layout.size()
+	}
+
+	return 1
+}
+
+// begin captures every parameter of a command before it is called, since the command may
+// overwrite them
+func (d *Driver) begin(name string, args ...any) *call {
+	c := &call{
+		command: commands[name],
+		args:    make([]reflect.Value, len(args)),
+	}
+	for i, arg := range args {
+		c.args[i] = reflect.ValueOf(arg)
+	}
+
+	c.byte(recordCall)
+	c.uvarint(d.id)
+	c.string(name)
+
+	for i, p := range c.command {
+		arg := c.args[i]
+
+		switch p.kind {
+		case paramValue:
+			c.byte(tagScalar)
+			c.uvarint(scalarBits(arg))
+		case paramHandle:
+			c.byte(tagHandle)
+			c.uvarint(scalarBits(arg))
+		case paramNull:
+			c.byte(tagNull)
+		case paramIn:
+			c.pointer(elemOf(arg.Type()), arg.UnsafePointer(), d.length(c, p.length), p.length.bytes, p.handles)
+		case paramOut:
+			elem := elemOf(arg.Type())
+			n := d.length(c, p.length)
+
+			if arg.IsNil() {
+				c.byte(tagNull)
+			} else if hasStructureType(elem) {
+				// The sType and pNext chain of output structures are set by the caller
+				c.pointer(elem, arg.UnsafePointer(), n, false, false)
+			} else {
+				c.byte(tagAlloc)
+				c.uvarint(uint64(n))
+			}
+		}
+	}
+
+	return c
+}
+
+// end captures the result of a command and any handles it created, then writes the command
+// to the capture
+func (d *Driver) end(c *call, res common.VkResult) {
+	c.varint(int64(res))
+
+	for i, p := range c.command {
+		if p.kind != paramOut || !p.handles {
+			continue
+		}
+
+		arg := c.args[i]
+		n := d.length(c, p.length)
+		if arg.IsNil() || res < 0 {
+			n = 0
+		}
+
+		c.uvarint(uint64(n))
+		for j := 0; j < n; j++ {
+			c.uvarint(readScalar(unsafe.Add(arg.UnsafePointer(), j*8), 8))
+		}
+	}
+
+	d.recorder.lock.Lock()
+	defer d.recorder.lock.Unlock()
+
+	d.recorder.write(c.buf)
+}
@@ -0,0 +1,169 @@
+package capture_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_2"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/driver/capture"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"testing"
+	"unsafe"
+)
+
+func record(t *testing.T, d driver.Driver) {
+	loader, err := core.CreateLoaderFromDriver(d)
+	require.NoError(t, err)
+
+	instance, _, err := loader.CreateInstance(nil, core1_0.InstanceCreateInfo{
+		APIVersion:      common.Vulkan1_2,
+		ApplicationName: "capture test",
+	})
+	require.NoError(t, err)
+
+	physicalDevices, _, err := instance.EnumeratePhysicalDevices()
+	require.NoError(t, err)
+
+	device, _, err := physicalDevices[0].CreateDevice(nil, core1_0.DeviceCreateInfo{
+		QueueCreateInfos: []core1_0.DeviceQueueCreateInfo{
+			{
+				QueueFamilyIndex: 0,
+				QueuePriorities:  []float32{1, 0.5},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:               1000,
+		Usage:              core1_0.BufferUsageTransferSrc,
+		SharingMode:        core1_0.SharingModeConcurrent,
+		QueueFamilyIndices: []int{0, 1},
+	})
+	require.NoError(t, err)
+
+	memory, _, err := device.AllocateMemory(nil, core1_0.MemoryAllocateInfo{
+		AllocationSize:  1024,
+		MemoryTypeIndex: 1,
+	})
+	require.NoError(t, err)
+
+	_, err = buffer.BindBufferMemory(memory, 0)
+	require.NoError(t, err)
+
+	ptr, _, err := memory.Map(0, -1, 0)
+	require.NoError(t, err)
+	copy(unsafe.Slice((*byte)(ptr), 4), []byte{1, 2, 3, 4})
+	memory.Unmap()
+
+	_, _, err = device.CreateSemaphore(nil, core1_0.SemaphoreCreateInfo{
+		NextOptions: common.NextOptions{Next: core1_2.SemaphoreTypeCreateInfo{
+			SemaphoreType: core1_2.SemaphoreTypeTimeline,
+			InitialValue:  5,
+		}},
+	})
+	require.NoError(t, err)
+
+	commandPool, _, err := device.CreateCommandPool(nil, core1_0.CommandPoolCreateInfo{})
+	require.NoError(t, err)
+
+	commandBuffers, _, err := device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        commandPool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 2,
+	})
+	require.NoError(t, err)
+
+	_, err = commandBuffers[1].Begin(core1_0.CommandBufferBeginInfo{})
+	require.NoError(t, err)
+	commandBuffers[1].CmdFillBuffer(buffer, 0, 1000, 0)
+	_, err = commandBuffers[1].End()
+	require.NoError(t, err)
+
+	fence, _, err := device.CreateFence(nil, core1_0.FenceCreateInfo{})
+	require.NoError(t, err)
+
+	_, err = device.GetQueue(0, 1).Submit(fence, []core1_0.SubmitInfo{
+		{CommandBuffers: commandBuffers[1:]},
+	})
+	require.NoError(t, err)
+
+	fence.Destroy(nil)
+	buffer.Destroy(nil)
+}
+
+func TestCaptureReplay(t *testing.T) {
+	var file bytes.Buffer
+	captured := fake.NewDriver(fake.Config{})
+	captureDriver, err := capture.NewDriver(captured, &file)
+	require.NoError(t, err)
+
+	record(t, captureDriver)
+	require.NoError(t, captureDriver.Err())
+	require.Empty(t, captured.Errors())
+
+	replayed := fake.NewDriver(fake.Config{})
+	// Create an unrelated instance first, so that replayed handles differ from captured ones
+	var instance driver.VkInstance
+	_, err = replayed.VkCreateInstance(nil, nil, &instance)
+	require.NoError(t, err)
+
+	require.NoError(t, capture.Replay(bytes.NewReader(file.Bytes()), replayed))
+	require.Empty(t, replayed.Errors())
+
+	capturedBuffers := captured.Objects(core1_0.ObjectTypeBuffer)
+	replayedBuffers := replayed.Objects(core1_0.ObjectTypeBuffer)
+	require.Len(t, replayedBuffers, 1)
+	require.NotEqual(t, capturedBuffers[0].Handle, replayedBuffers[0].Handle)
+	require.Equal(t, capturedBuffers[0].CreateInfo, replayedBuffers[0].CreateInfo)
+	require.True(t, replayedBuffers[0].Destroyed)
+
+	replayedMemory := replayed.Objects(core1_0.ObjectTypeDeviceMemory)
+	require.Len(t, replayedMemory, 1)
+	require.Equal(t, driver.VkDeviceMemory(replayedMemory[0].Handle), replayedBuffers[0].Memory)
+	require.Equal(t, []byte{1, 2, 3, 4}, replayed.MemoryBytes(driver.VkDeviceMemory(replayedMemory[0].Handle))[:4])
+
+	submissions := replayed.Submissions()
+	require.Len(t, submissions, 1)
+	require.Len(t, submissions[0].CommandBuffers, 1)
+	require.Equal(t, 1, replayed.CommandCount(submissions[0].CommandBuffers[0]))
+	require.True(t, replayed.FenceSignaled(submissions[0].Fence))
+
+	commandBuffers := replayed.Objects(core1_0.ObjectTypeCommandBuffer)
+	require.Len(t, commandBuffers, 2)
+	require.Equal(t, driver.VkCommandBuffer(commandBuffers[1].Handle), submissions[0].CommandBuffers[0])
+
+	devices := replayed.Objects(core1_0.ObjectTypeDevice)
+	semaphores := replayed.Objects(core1_0.ObjectTypeSemaphore)
+	require.Len(t, semaphores, 1)
+
+	var value driver.Uint64
+	_, err = replayed.VkGetSemaphoreCounterValue(driver.VkDevice(devices[0].Handle), driver.VkSemaphore(semaphores[0].Handle), &value)
+	require.NoError(t, err)
+	require.Equal(t, driver.Uint64(5), value)
+}
+
+func TestReplay_ResultMismatch(t *testing.T) {
+	var file bytes.Buffer
+	captureDriver, err := capture.NewDriver(fake.NewDriver(fake.Config{}), &file)
+	require.NoError(t, err)
+
+	record(t, captureDriver)
+
+	// Memory type 1 doesn't exist on a device with a single memory type
+	physicalDevice := fake.DefaultPhysicalDevice()
+	physicalDevice.MemoryProperties.MemoryTypes = physicalDevice.MemoryProperties.MemoryTypes[:1]
+	replayed := fake.NewDriver(fake.Config{PhysicalDevices: []fake.PhysicalDevice{physicalDevice}})
+
+	err = capture.Replay(bytes.NewReader(file.Bytes()), replayed)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "VkAllocateMemory")
+}
+
+func TestReplay_NotCapture(t *testing.T) {
+	err := capture.Replay(bytes.NewReader([]byte("not a capture file")), fake.NewDriver(fake.Config{}))
+	require.Error(t, err)
+}
@@ -0,0 +1,110 @@
+package capture
+
+type paramKind int
+
+const (
+	// paramValue is a scalar passed by value
+	paramValue paramKind = iota
+	// paramHandle is a handle passed by value, which must be remapped during replay
+	paramHandle
+	// paramNull is a pointer that is neither captured nor replayed, such as pAllocator
+	paramNull
+	// paramIn is a pointer to data read by the command, which is captured in full
+	paramIn
+	// paramOut is a pointer to memory written by the command. Only the size of the memory is captured,
+	// unless its elements carry an sType, in which case they are captured so that their pNext
+	// chains can be rebuilt.
+	paramOut
+)
+
+type lengthKind int
+
+const (
+	// lengthOne indicates that a pointer refers to a single element
+	lengthOne lengthKind = iota
+	// lengthFixed indicates that a pointer refers to a fixed-size array
+	lengthFixed
+	// lengthParam indicates that the length of an array is the value of another parameter
+	lengthParam
+	// lengthParamRef indicates that the length of an array is pointed to by another parameter
+	lengthParamRef
+	// lengthField indicates that the length of an array is a field of the structure pointed to by
+	// another parameter
+	lengthField
+	// lengthString indicates that a pointer refers to a null-terminated string
+	lengthString
+	// lengthTemplate indicates that a pointer refers to the data consumed by a VkDescriptorUpdateTemplate
+	// passed as another parameter
+	lengthTemplate
+)
+
+type length struct {
+	kind  lengthKind
+	param int
+	field string
+	// bytes indicates that the length is measured in bytes rather than elements
+	bytes bool
+}
+
+type param struct {
+	kind   paramKind
+	length length
+	// handles indicates that the elements pointed to by this parameter are handles
+	handles bool
+}
+
+// command describes each parameter of a Vulkan command, in order
+type command []param
+
+var (
+	valueParam  = param{kind: paramValue}
+	handleParam = param{kind: paramHandle}
+	nullParam   = param{kind: paramNull}
+
+	one = length{kind: lengthOne}
+	str = length{kind: lengthString}
+)
+
+func in(l length) param {
+	return param{kind: paramIn, length: l}
+}
+
+func inHandles(l length) param {
+	return param{kind: paramIn, length: l, handles: true}
+}
+
+func out(l length) param {
+	return param{kind: paramOut, length: l}
+}
+
+func outHandles(l length) param {
+	return param{kind: paramOut, length: l, handles: true}
+}
+
+func fixed(n int) length {
+	return length{kind: lengthFixed, param: n}
+}
+
+func count(index int) length {
+	return length{kind: lengthParam, param: index}
+}
+
+func countRef(index int) length {
+	return length{kind: lengthParamRef, param: index}
+}
+
+func bytes(index int) length {
+	return length{kind: lengthParam, param: index, bytes: true}
+}
+
+func bytesRef(index int) length {
+	return length{kind: lengthParamRef, param: index, bytes: true}
+}
+
+func field(index int, name string) length {
+	return length{kind: lengthField, param: index, field: name}
+}
+
+func template(index int) length {
+	return length{kind: lengthTemplate, param: index, bytes: true}
+}
@@ -0,0 +1,1520 @@
+package capture
+
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+// commands describes the parameters of every Vulkan command in driver.Driver, keyed by method name
+var commands = map[string]command{
+	"VkEnumerateInstanceVersion":                      {out(one)},
+	"VkEnumerateInstanceExtensionProperties":          {in(str), in(one), out(countRef(1))},
+	"VkEnumerateInstanceLayerProperties":              {in(one), out(countRef(0))},
+	"VkCreateInstance":                                {in(one), nullParam, outHandles(one)},
+	"VkEnumeratePhysicalDevices":                      {handleParam, in(one), outHandles(countRef(1))},
+	"VkDestroyInstance":                               {handleParam, nullParam},
+	"VkGetPhysicalDeviceFeatures":                     {handleParam, out(one)},
+	"VkGetPhysicalDeviceFormatProperties":             {handleParam, valueParam, out(one)},
+	"VkGetPhysicalDeviceImageFormatProperties":        {handleParam, valueParam, valueParam, valueParam, valueParam, valueParam, out(one)},
+	"VkGetPhysicalDeviceProperties":                   {handleParam, out(one)},
+	"VkGetPhysicalDeviceQueueFamilyProperties":        {handleParam, in(one), out(countRef(1))},
+	"VkGetPhysicalDeviceMemoryProperties":             {handleParam, out(one)},
+	"VkEnumerateDeviceExtensionProperties":            {handleParam, in(str), in(one), out(countRef(2))},
+	"VkEnumerateDeviceLayerProperties":                {handleParam, in(one), out(countRef(1))},
+	"VkGetPhysicalDeviceSparseImageFormatProperties":  {handleParam, valueParam, valueParam, valueParam, valueParam, valueParam, in(one), out(countRef(6))},
+	"VkCreateDevice":                                  {handleParam, in(one), nullParam, outHandles(one)},
+	"VkEnumeratePhysicalDeviceGroups":                 {handleParam, in(one), out(countRef(1))},
+	"VkGetPhysicalDeviceFeatures2":                    {handleParam, out(one)},
+	"VkGetPhysicalDeviceProperties2":                  {handleParam, out(one)},
+	"VkGetPhysicalDeviceFormatProperties2":            {handleParam, valueParam, out(one)},
+	"VkGetPhysicalDeviceImageFormatProperties2":       {handleParam, in(one), out(one)},
+	"VkGetPhysicalDeviceQueueFamilyProperties2":       {handleParam, in(one), out(countRef(1))},
+	"VkGetPhysicalDeviceMemoryProperties2":            {handleParam, out(one)},
+	"VkGetPhysicalDeviceSparseImageFormatProperties2": {handleParam, in(one), in(one), out(countRef(2))},
+	"VkGetPhysicalDeviceExternalBufferProperties":     {handleParam, in(one), out(one)},
+	"VkGetPhysicalDeviceExternalFenceProperties":      {handleParam, in(one), out(one)},
+	"VkGetPhysicalDeviceExternalSemaphoreProperties":  {handleParam, in(one), out(one)},
+	"VkDestroyDevice":                                 {handleParam, nullParam},
+	"VkGetDeviceQueue":                                {handleParam, valueParam, valueParam, outHandles(one)},
+	"VkQueueSubmit":                                   {handleParam, valueParam, in(count(1)), handleParam},
+	"VkQueueWaitIdle":                                 {handleParam},
+	"VkDeviceWaitIdle":                                {handleParam},
+	"VkAllocateMemory":                                {handleParam, in(one), nullParam, outHandles(one)},
+	"VkFreeMemory":                                    {handleParam, handleParam, nullParam},
+	"VkMapMemory":                                     {handleParam, handleParam, valueParam, valueParam, valueParam, out(one)},
+	"VkUnmapMemory":                                   {handleParam, handleParam},
+	"VkFlushMappedMemoryRanges":                       {handleParam, valueParam, in(count(1))},
+	"VkInvalidateMappedMemoryRanges":                  {handleParam, valueParam, in(count(1))},
+	"VkGetDeviceMemoryCommitment":                     {handleParam, handleParam, out(one)},
+	"VkBindBufferMemory":                              {handleParam, handleParam, handleParam, valueParam},
+	"VkBindImageMemory":                               {handleParam, handleParam, handleParam, valueParam},
+	"VkGetBufferMemoryRequirements":                   {handleParam, handleParam, out(one)},
+	"VkGetImageMemoryRequirements":                    {handleParam, handleParam, out(one)},
+	"VkGetImageSparseMemoryRequirements":              {handleParam, handleParam, in(one), out(countRef(2))},
+	"VkQueueBindSparse":                               {handleParam, valueParam, in(count(1)), handleParam},
+	"VkCreateFence":                                   {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyFence":                                  {handleParam, handleParam, nullParam},
+	"VkResetFences":                                   {handleParam, valueParam, inHandles(count(1))},
+	"VkGetFenceStatus":                                {handleParam, handleParam},
+	"VkWaitForFences":                                 {handleParam, valueParam, inHandles(count(1)), valueParam, valueParam},
+	"VkCreateSemaphore":                               {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroySemaphore":                              {handleParam, handleParam, nullParam},
+	"VkCreateEvent":                                   {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyEvent":                                  {handleParam, handleParam, nullParam},
+	"VkGetEventStatus":                                {handleParam, handleParam},
+	"VkSetEvent":                                      {handleParam, handleParam},
+	"VkResetEvent":                                    {handleParam, handleParam},
+	"VkCreateQueryPool":                               {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyQueryPool":                              {handleParam, handleParam, nullParam},
+	"VkGetQueryPoolResults":                           {handleParam, handleParam, valueParam, valueParam, valueParam, out(bytes(4)), valueParam, valueParam},
+	"VkCreateBuffer":                                  {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyBuffer":                                 {handleParam, handleParam, nullParam},
+	"VkCreateBufferView":                              {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyBufferView":                             {handleParam, handleParam, nullParam},
+	"VkCreateImage":                                   {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyImage":                                  {handleParam, handleParam, nullParam},
+	"VkGetImageSubresourceLayout":                     {handleParam, handleParam, in(one), out(one)},
+	"VkCreateImageView":                               {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyImageView":                              {handleParam, handleParam, nullParam},
+	"VkCreateShaderModule":                            {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyShaderModule":                           {handleParam, handleParam, nullParam},
+	"VkCreatePipelineCache":                           {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyPipelineCache":                          {handleParam, handleParam, nullParam},
+	"VkGetPipelineCacheData":                          {handleParam, handleParam, in(one), out(bytesRef(2))},
+	"VkMergePipelineCaches":                           {handleParam, handleParam, valueParam, inHandles(count(2))},
+	"VkCreateGraphicsPipelines":                       {handleParam, handleParam, valueParam, in(count(2)), nullParam, outHandles(count(2))},
+	"VkCreateComputePipelines":                        {handleParam, handleParam, valueParam, in(count(2)), nullParam, outHandles(count(2))},
+	"VkDestroyPipeline":                               {handleParam, handleParam, nullParam},
+	"VkCreatePipelineLayout":                          {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyPipelineLayout":                         {handleParam, handleParam, nullParam},
+	"VkCreateSampler":                                 {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroySampler":                                {handleParam, handleParam, nullParam},
+	"VkCreateDescriptorSetLayout":                     {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyDescriptorSetLayout":                    {handleParam, handleParam, nullParam},
+	"VkCreateDescriptorPool":                          {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyDescriptorPool":                         {handleParam, handleParam, nullParam},
+	"VkResetDescriptorPool":                           {handleParam, handleParam, valueParam},
+	"VkAllocateDescriptorSets":                        {handleParam, in(one), outHandles(field(1, "descriptorSetCount"))},
+	"VkFreeDescriptorSets":                            {handleParam, handleParam, valueParam, inHandles(count(2))},
+	"VkUpdateDescriptorSets":                          {handleParam, valueParam, in(count(1)), valueParam, in(count(3))},
+	"VkCreateFramebuffer":                             {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyFramebuffer":                            {handleParam, handleParam, nullParam},
+	"VkCreateRenderPass":                              {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyRenderPass":                             {handleParam, handleParam, nullParam},
+	"VkGetRenderAreaGranularity":                      {handleParam, handleParam, out(one)},
+	"VkCreateCommandPool":                             {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyCommandPool":                            {handleParam, handleParam, nullParam},
+	"VkResetCommandPool":                              {handleParam, handleParam, valueParam},
+	"VkAllocateCommandBuffers":                        {handleParam, in(one), outHandles(field(1, "commandBufferCount"))},
+	"VkFreeCommandBuffers":                            {handleParam, handleParam, valueParam, inHandles(count(2))},
+	"VkBeginCommandBuffer":                            {handleParam, in(one)},
+	"VkEndCommandBuffer":                              {handleParam},
+	"VkResetCommandBuffer":                            {handleParam, valueParam},
+	"VkCmdBindPipeline":                               {handleParam, valueParam, handleParam},
+	"VkCmdSetViewport":                                {handleParam, valueParam, valueParam, in(count(2))},
+	"VkCmdSetScissor":                                 {handleParam, valueParam, valueParam, in(count(2))},
+	"VkCmdSetLineWidth":                               {handleParam, valueParam},
+	"VkCmdSetDepthBias":                               {handleParam, valueParam, valueParam, valueParam},
+	"VkCmdSetBlendConstants":                          {handleParam, in(fixed(4))},
+	"VkCmdSetDepthBounds":                             {handleParam, valueParam, valueParam},
+	"VkCmdSetStencilCompareMask":                      {handleParam, valueParam, valueParam},
+	"VkCmdSetStencilWriteMask":                        {handleParam, valueParam, valueParam},
+	"VkCmdSetStencilReference":                        {handleParam, valueParam, valueParam},
+	"VkCmdBindDescriptorSets":                         {handleParam, valueParam, handleParam, valueParam, valueParam, inHandles(count(4)), valueParam, in(count(6))},
+	"VkCmdBindIndexBuffer":                            {handleParam, handleParam, valueParam, valueParam},
+	"VkCmdBindVertexBuffers":                          {handleParam, valueParam, valueParam, inHandles(count(2)), in(count(2))},
+	"VkCmdDraw":                                       {handleParam, valueParam, valueParam, valueParam, valueParam},
+	"VkCmdDrawIndexed":                                {handleParam, valueParam, valueParam, valueParam, valueParam, valueParam},
+	"VkCmdDrawIndirect":                               {handleParam, handleParam, valueParam, valueParam, valueParam},
+	"VkCmdDrawIndexedIndirect":                        {handleParam, handleParam, valueParam, valueParam, valueParam},
+	"VkCmdDispatch":                                   {handleParam, valueParam, valueParam, valueParam},
+	"VkCmdDispatchIndirect":                           {handleParam, handleParam, valueParam},
+	"VkCmdCopyBuffer":                                 {handleParam, handleParam, handleParam, valueParam, in(count(3))},
+	"VkCmdCopyImage":                                  {handleParam, handleParam, valueParam, handleParam, valueParam, valueParam, in(count(5))},
+	"VkCmdBlitImage":                                  {handleParam, handleParam, valueParam, handleParam, valueParam, valueParam, in(count(5)), valueParam},
+	"VkCmdCopyBufferToImage":                          {handleParam, handleParam, handleParam, valueParam, valueParam, in(count(4))},
+	"VkCmdCopyImageToBuffer":                          {handleParam, handleParam, valueParam, handleParam, valueParam, in(count(4))},
+	"VkCmdUpdateBuffer":                               {handleParam, handleParam, valueParam, valueParam, in(bytes(3))},
+	"VkCmdFillBuffer":                                 {handleParam, handleParam, valueParam, valueParam, valueParam},
+	"VkCmdClearColorImage":                            {handleParam, handleParam, valueParam, in(one), valueParam, in(count(4))},
+	"VkCmdClearDepthStencilImage":                     {handleParam, handleParam, valueParam, in(one), valueParam, in(count(4))},
+	"VkCmdClearAttachments":                           {handleParam, valueParam, in(count(1)), valueParam, in(count(3))},
+	"VkCmdResolveImage":                               {handleParam, handleParam, valueParam, handleParam, valueParam, valueParam, in(count(5))},
+	"VkCmdSetEvent":                                   {handleParam, handleParam, valueParam},
+	"VkCmdResetEvent":                                 {handleParam, handleParam, valueParam},
+	"VkCmdWaitEvents":                                 {handleParam, valueParam, inHandles(count(1)), valueParam, valueParam, valueParam, in(count(5)), valueParam, in(count(7)), valueParam, in(count(9))},
+	"VkCmdPipelineBarrier":                            {handleParam, valueParam, valueParam, valueParam, valueParam, in(count(4)), valueParam, in(count(6)), valueParam, in(count(8))},
+	"VkCmdBeginQuery":                                 {handleParam, handleParam, valueParam, valueParam},
+	"VkCmdEndQuery":                                   {handleParam, handleParam, valueParam},
+	"VkCmdResetQueryPool":                             {handleParam, handleParam, valueParam, valueParam},
+	"VkCmdWriteTimestamp":                             {handleParam, valueParam, handleParam, valueParam},
+	"VkCmdCopyQueryPoolResults":                       {handleParam, handleParam, valueParam, valueParam, handleParam, valueParam, valueParam, valueParam},
+	"VkCmdPushConstants":                              {handleParam, handleParam, valueParam, valueParam, valueParam, in(bytes(4))},
+	"VkCmdBeginRenderPass":                            {handleParam, in(one), valueParam},
+	"VkCmdNextSubpass":                                {handleParam, valueParam},
+	"VkCmdEndRenderPass":                              {handleParam},
+	"VkCmdExecuteCommands":                            {handleParam, valueParam, inHandles(count(1))},
+	"VkBindBufferMemory2":                             {handleParam, valueParam, in(count(1))},
+	"VkBindImageMemory2":                              {handleParam, valueParam, in(count(1))},
+	"VkGetDeviceGroupPeerMemoryFeatures":              {handleParam, valueParam, valueParam, valueParam, out(one)},
+	"VkCmdSetDeviceMask":                              {handleParam, valueParam},
+	"VkCmdDispatchBase":                               {handleParam, valueParam, valueParam, valueParam, valueParam, valueParam, valueParam},
+	"VkGetImageMemoryRequirements2":                   {handleParam, in(one), out(one)},
+	"VkGetBufferMemoryRequirements2":                  {handleParam, in(one), out(one)},
+	"VkGetImageSparseMemoryRequirements2":             {handleParam, in(one), in(one), out(countRef(2))},
+	"VkTrimCommandPool":                               {handleParam, handleParam, valueParam},
+	"VkGetDeviceQueue2":                               {handleParam, in(one), outHandles(one)},
+	"VkCreateSamplerYcbcrConversion":                  {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroySamplerYcbcrConversion":                 {handleParam, handleParam, nullParam},
+	"VkCreateDescriptorUpdateTemplate":                {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyDescriptorUpdateTemplate":               {handleParam, handleParam, nullParam},
+	"VkUpdateDescriptorSetWithTemplate":               {handleParam, handleParam, handleParam, in(template(2))},
+	"VkGetDescriptorSetLayoutSupport":                 {handleParam, in(one), out(one)},
+	"VkCmdDrawIndirectCount":                          {handleParam, handleParam, valueParam, handleParam, valueParam, valueParam, valueParam},
+	"VkCmdDrawIndexedIndirectCount":                   {handleParam, handleParam, valueParam, handleParam, valueParam, valueParam, valueParam},
+	"VkCreateRenderPass2":                             {handleParam, in(one), nullParam, outHandles(one)},
+	"VkCmdBeginRenderPass2":                           {handleParam, in(one), in(one)},
+	"VkCmdNextSubpass2":                               {handleParam, in(one), in(one)},
+	"VkCmdEndRenderPass2":                             {handleParam, in(one)},
+	"VkResetQueryPool":                                {handleParam, handleParam, valueParam, valueParam},
+	"VkGetSemaphoreCounterValue":                      {handleParam, handleParam, out(one)},
+	"VkWaitSemaphores":                                {handleParam, in(one), valueParam},
+	"VkSignalSemaphore":                               {handleParam, in(one)},
+	"VkGetBufferDeviceAddress":                        {handleParam, in(one)},
+	"VkGetBufferOpaqueCaptureAddress":                 {handleParam, in(one)},
+	"VkGetDeviceMemoryOpaqueCaptureAddress":           {handleParam, in(one)},
+	"VkCmdBeginRendering":                             {handleParam, in(one)},
+	"VkCmdEndRendering":                               {handleParam},
+	"VkCmdSetEvent2":                                  {handleParam, handleParam, in(one)},
+	"VkCmdResetEvent2":                                {handleParam, handleParam, valueParam},
+	"VkCmdWaitEvents2":                                {handleParam, valueParam, inHandles(count(1)), in(count(1))},
+	"VkCmdPipelineBarrier2":                           {handleParam, in(one)},
+	"VkCmdWriteTimestamp2":                            {handleParam, valueParam, handleParam, valueParam},
+	"VkQueueSubmit2":                                  {handleParam, valueParam, in(count(1)), handleParam},
+	"VkCmdSetCullMode":                                {handleParam, valueParam},
+	"VkCmdSetFrontFace":                               {handleParam, valueParam},
+	"VkCmdSetPrimitiveTopology":                       {handleParam, valueParam},
+	"VkCmdSetViewportWithCount":                       {handleParam, valueParam, in(count(1))},
+	"VkCmdSetScissorWithCount":                        {handleParam, valueParam, in(count(1))},
+	"VkCmdBindVertexBuffers2":                         {handleParam, valueParam, valueParam, inHandles(count(2)), in(count(2)), in(count(2)), in(count(2))},
+	"VkCmdSetDepthTestEnable":                         {handleParam, valueParam},
+	"VkCmdSetDepthWriteEnable":                        {handleParam, valueParam},
+	"VkCmdSetDepthCompareOp":                          {handleParam, valueParam},
+	"VkCmdSetDepthBoundsTestEnable":                   {handleParam, valueParam},
+	"VkCmdSetStencilTestEnable":                       {handleParam, valueParam},
+	"VkCmdSetStencilOp":                               {handleParam, valueParam, valueParam, valueParam, valueParam, valueParam},
+	"VkCmdSetRasterizerDiscardEnable":                 {handleParam, valueParam},
+	"VkCmdSetDepthBiasEnable":                         {handleParam, valueParam},
+	"VkCmdSetPrimitiveRestartEnable":                  {handleParam, valueParam},
+	"VkCmdCopyBuffer2":                                {handleParam, in(one)},
+	"VkCmdCopyImage2":                                 {handleParam, in(one)},
+	"VkCmdCopyBufferToImage2":                         {handleParam, in(one)},
+	"VkCmdCopyImageToBuffer2":                         {handleParam, in(one)},
+	"VkCmdBlitImage2":                                 {handleParam, in(one)},
+	"VkCmdResolveImage2":                              {handleParam, in(one)},
+	"VkGetDeviceBufferMemoryRequirements":             {handleParam, in(one), out(one)},
+	"VkGetDeviceImageMemoryRequirements":              {handleParam, in(one), out(one)},
+	"VkGetDeviceImageSparseMemoryRequirements":        {handleParam, in(one), in(one), out(countRef(2))},
+	"VkCreatePrivateDataSlot":                         {handleParam, in(one), nullParam, outHandles(one)},
+	"VkDestroyPrivateDataSlot":                        {handleParam, handleParam, nullParam},
+	"VkSetPrivateData":                                {handleParam, valueParam, valueParam, handleParam, valueParam},
+	"VkGetPrivateData":                                {handleParam, valueParam, valueParam, handleParam, out(one)},
+	"VkGetPhysicalDeviceToolProperties":               {handleParam, in(one), out(countRef(1))},
+}
+
+func (d *Driver) VkEnumerateInstanceVersion(pApiVersion *driver.Uint32) (common.VkResult, error) {
+	call := d.begin("VkEnumerateInstanceVersion", pApiVersion)
+	res, err := d.inner.VkEnumerateInstanceVersion(pApiVersion)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEnumerateInstanceExtensionProperties(pLayerName *driver.Char, pPropertyCount *driver.Uint32, pProperties *driver.VkExtensionProperties) (common.VkResult, error) {
+	call := d.begin("VkEnumerateInstanceExtensionProperties", pLayerName, pPropertyCount, pProperties)
+	res, err := d.inner.VkEnumerateInstanceExtensionProperties(pLayerName, pPropertyCount, pProperties)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEnumerateInstanceLayerProperties(pPropertyCount *driver.Uint32, pProperties *driver.VkLayerProperties) (common.VkResult, error) {
+	call := d.begin("VkEnumerateInstanceLayerProperties", pPropertyCount, pProperties)
+	res, err := d.inner.VkEnumerateInstanceLayerProperties(pPropertyCount, pProperties)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateInstance(pCreateInfo *driver.VkInstanceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pInstance *driver.VkInstance) (common.VkResult, error) {
+	call := d.begin("VkCreateInstance", pCreateInfo, pAllocator, pInstance)
+	res, err := d.inner.VkCreateInstance(pCreateInfo, pAllocator, pInstance)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEnumeratePhysicalDevices(instance driver.VkInstance, pPhysicalDeviceCount *driver.Uint32, pPhysicalDevices *driver.VkPhysicalDevice) (common.VkResult, error) {
+	call := d.begin("VkEnumeratePhysicalDevices", instance, pPhysicalDeviceCount, pPhysicalDevices)
+	res, err := d.inner.VkEnumeratePhysicalDevices(instance, pPhysicalDeviceCount, pPhysicalDevices)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyInstance(instance driver.VkInstance, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyInstance", instance, pAllocator)
+	d.inner.VkDestroyInstance(instance, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceFeatures(physicalDevice driver.VkPhysicalDevice, pFeatures *driver.VkPhysicalDeviceFeatures) {
+	call := d.begin("VkGetPhysicalDeviceFeatures", physicalDevice, pFeatures)
+	d.inner.VkGetPhysicalDeviceFeatures(physicalDevice, pFeatures)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, pFormatProperties *driver.VkFormatProperties) {
+	call := d.begin("VkGetPhysicalDeviceFormatProperties", physicalDevice, format, pFormatProperties)
+	d.inner.VkGetPhysicalDeviceFormatProperties(physicalDevice, format, pFormatProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceImageFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, t driver.VkImageType, tiling driver.VkImageTiling, usage driver.VkImageUsageFlags, flags driver.VkImageCreateFlags, pImageFormatProperties *driver.VkImageFormatProperties) (common.VkResult, error) {
+	call := d.begin("VkGetPhysicalDeviceImageFormatProperties", physicalDevice, format, t, tiling, usage, flags, pImageFormatProperties)
+	res, err := d.inner.VkGetPhysicalDeviceImageFormatProperties(physicalDevice, format, t, tiling, usage, flags, pImageFormatProperties)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetPhysicalDeviceProperties(physicalDevice driver.VkPhysicalDevice, pProperties *driver.VkPhysicalDeviceProperties) {
+	call := d.begin("VkGetPhysicalDeviceProperties", physicalDevice, pProperties)
+	d.inner.VkGetPhysicalDeviceProperties(physicalDevice, pProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceQueueFamilyProperties(physicalDevice driver.VkPhysicalDevice, pQueueFamilyPropertyCount *driver.Uint32, pQueueFamilyProperties *driver.VkQueueFamilyProperties) {
+	call := d.begin("VkGetPhysicalDeviceQueueFamilyProperties", physicalDevice, pQueueFamilyPropertyCount, pQueueFamilyProperties)
+	d.inner.VkGetPhysicalDeviceQueueFamilyProperties(physicalDevice, pQueueFamilyPropertyCount, pQueueFamilyProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceMemoryProperties(physicalDevice driver.VkPhysicalDevice, pMemoryProperties *driver.VkPhysicalDeviceMemoryProperties) {
+	call := d.begin("VkGetPhysicalDeviceMemoryProperties", physicalDevice, pMemoryProperties)
+	d.inner.VkGetPhysicalDeviceMemoryProperties(physicalDevice, pMemoryProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkEnumerateDeviceExtensionProperties(physicalDevice driver.VkPhysicalDevice, pLayerName *driver.Char, pPropertyCount *driver.Uint32, pProperties *driver.VkExtensionProperties) (common.VkResult, error) {
+	call := d.begin("VkEnumerateDeviceExtensionProperties", physicalDevice, pLayerName, pPropertyCount, pProperties)
+	res, err := d.inner.VkEnumerateDeviceExtensionProperties(physicalDevice, pLayerName, pPropertyCount, pProperties)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEnumerateDeviceLayerProperties(physicalDevice driver.VkPhysicalDevice, pPropertyCount *driver.Uint32, pProperties *driver.VkLayerProperties) (common.VkResult, error) {
+	call := d.begin("VkEnumerateDeviceLayerProperties", physicalDevice, pPropertyCount, pProperties)
+	res, err := d.inner.VkEnumerateDeviceLayerProperties(physicalDevice, pPropertyCount, pProperties)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetPhysicalDeviceSparseImageFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, t driver.VkImageType, samples driver.VkSampleCountFlagBits, usage driver.VkImageUsageFlags, tiling driver.VkImageTiling, pPropertyCount *driver.Uint32, pProperties *driver.VkSparseImageFormatProperties) {
+	call := d.begin("VkGetPhysicalDeviceSparseImageFormatProperties", physicalDevice, format, t, samples, usage, tiling, pPropertyCount, pProperties)
+	d.inner.VkGetPhysicalDeviceSparseImageFormatProperties(physicalDevice, format, t, samples, usage, tiling, pPropertyCount, pProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateDevice(physicalDevice driver.VkPhysicalDevice, pCreateInfo *driver.VkDeviceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDevice *driver.VkDevice) (common.VkResult, error) {
+	call := d.begin("VkCreateDevice", physicalDevice, pCreateInfo, pAllocator, pDevice)
+	res, err := d.inner.VkCreateDevice(physicalDevice, pCreateInfo, pAllocator, pDevice)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEnumeratePhysicalDeviceGroups(instance driver.VkInstance, pPhysicalDeviceGroupCount *driver.Uint32, pPhysicalDeviceGroupProperties *driver.VkPhysicalDeviceGroupProperties) (common.VkResult, error) {
+	call := d.begin("VkEnumeratePhysicalDeviceGroups", instance, pPhysicalDeviceGroupCount, pPhysicalDeviceGroupProperties)
+	res, err := d.inner.VkEnumeratePhysicalDeviceGroups(instance, pPhysicalDeviceGroupCount, pPhysicalDeviceGroupProperties)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetPhysicalDeviceFeatures2(physicalDevice driver.VkPhysicalDevice, pFeatures *driver.VkPhysicalDeviceFeatures2) {
+	call := d.begin("VkGetPhysicalDeviceFeatures2", physicalDevice, pFeatures)
+	d.inner.VkGetPhysicalDeviceFeatures2(physicalDevice, pFeatures)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceProperties2(physicalDevice driver.VkPhysicalDevice, pProperties *driver.VkPhysicalDeviceProperties2) {
+	call := d.begin("VkGetPhysicalDeviceProperties2", physicalDevice, pProperties)
+	d.inner.VkGetPhysicalDeviceProperties2(physicalDevice, pProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceFormatProperties2(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, pFormatProperties *driver.VkFormatProperties2) {
+	call := d.begin("VkGetPhysicalDeviceFormatProperties2", physicalDevice, format, pFormatProperties)
+	d.inner.VkGetPhysicalDeviceFormatProperties2(physicalDevice, format, pFormatProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceImageFormatProperties2(physicalDevice driver.VkPhysicalDevice, pImageFormatInfo *driver.VkPhysicalDeviceImageFormatInfo2, pImageFormatProperties *driver.VkImageFormatProperties2) (common.VkResult, error) {
+	call := d.begin("VkGetPhysicalDeviceImageFormatProperties2", physicalDevice, pImageFormatInfo, pImageFormatProperties)
+	res, err := d.inner.VkGetPhysicalDeviceImageFormatProperties2(physicalDevice, pImageFormatInfo, pImageFormatProperties)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetPhysicalDeviceQueueFamilyProperties2(physicalDevice driver.VkPhysicalDevice, pQueueFamilyPropertyCount *driver.Uint32, pQueueFamilyProperties *driver.VkQueueFamilyProperties2) {
+	call := d.begin("VkGetPhysicalDeviceQueueFamilyProperties2", physicalDevice, pQueueFamilyPropertyCount, pQueueFamilyProperties)
+	d.inner.VkGetPhysicalDeviceQueueFamilyProperties2(physicalDevice, pQueueFamilyPropertyCount, pQueueFamilyProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceMemoryProperties2(physicalDevice driver.VkPhysicalDevice, pMemoryProperties *driver.VkPhysicalDeviceMemoryProperties2) {
+	call := d.begin("VkGetPhysicalDeviceMemoryProperties2", physicalDevice, pMemoryProperties)
+	d.inner.VkGetPhysicalDeviceMemoryProperties2(physicalDevice, pMemoryProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceSparseImageFormatProperties2(physicalDevice driver.VkPhysicalDevice, pFormatInfo *driver.VkPhysicalDeviceSparseImageFormatInfo2, pPropertyCount *driver.Uint32, pProperties *driver.VkSparseImageFormatProperties2) {
+	call := d.begin("VkGetPhysicalDeviceSparseImageFormatProperties2", physicalDevice, pFormatInfo, pPropertyCount, pProperties)
+	d.inner.VkGetPhysicalDeviceSparseImageFormatProperties2(physicalDevice, pFormatInfo, pPropertyCount, pProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalBufferProperties(physicalDevice driver.VkPhysicalDevice, pExternalBufferInfo *driver.VkPhysicalDeviceExternalBufferInfo, pExternalBufferProperties *driver.VkExternalBufferProperties) {
+	call := d.begin("VkGetPhysicalDeviceExternalBufferProperties", physicalDevice, pExternalBufferInfo, pExternalBufferProperties)
+	d.inner.VkGetPhysicalDeviceExternalBufferProperties(physicalDevice, pExternalBufferInfo, pExternalBufferProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalFenceProperties(physicalDevice driver.VkPhysicalDevice, pExternalFenceInfo *driver.VkPhysicalDeviceExternalFenceInfo, pExternalFenceProperties *driver.VkExternalFenceProperties) {
+	call := d.begin("VkGetPhysicalDeviceExternalFenceProperties", physicalDevice, pExternalFenceInfo, pExternalFenceProperties)
+	d.inner.VkGetPhysicalDeviceExternalFenceProperties(physicalDevice, pExternalFenceInfo, pExternalFenceProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalSemaphoreProperties(physicalDevice driver.VkPhysicalDevice, pExternalSemaphoreInfo *driver.VkPhysicalDeviceExternalSemaphoreInfo, pExternalSemaphoreProperties *driver.VkExternalSemaphoreProperties) {
+	call := d.begin("VkGetPhysicalDeviceExternalSemaphoreProperties", physicalDevice, pExternalSemaphoreInfo, pExternalSemaphoreProperties)
+	d.inner.VkGetPhysicalDeviceExternalSemaphoreProperties(physicalDevice, pExternalSemaphoreInfo, pExternalSemaphoreProperties)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkDestroyDevice(device driver.VkDevice, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyDevice", device, pAllocator)
+	d.inner.VkDestroyDevice(device, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetDeviceQueue(device driver.VkDevice, queueFamilyIndex driver.Uint32, queueIndex driver.Uint32, pQueue *driver.VkQueue) {
+	call := d.begin("VkGetDeviceQueue", device, queueFamilyIndex, queueIndex, pQueue)
+	d.inner.VkGetDeviceQueue(device, queueFamilyIndex, queueIndex, pQueue)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkQueueWaitIdle(queue driver.VkQueue) (common.VkResult, error) {
+	call := d.begin("VkQueueWaitIdle", queue)
+	res, err := d.inner.VkQueueWaitIdle(queue)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDeviceWaitIdle(device driver.VkDevice) (common.VkResult, error) {
+	call := d.begin("VkDeviceWaitIdle", device)
+	res, err := d.inner.VkDeviceWaitIdle(device)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkInvalidateMappedMemoryRanges(device driver.VkDevice, memoryRangeCount driver.Uint32, pMemoryRanges *driver.VkMappedMemoryRange) (common.VkResult, error) {
+	call := d.begin("VkInvalidateMappedMemoryRanges", device, memoryRangeCount, pMemoryRanges)
+	res, err := d.inner.VkInvalidateMappedMemoryRanges(device, memoryRangeCount, pMemoryRanges)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetDeviceMemoryCommitment(device driver.VkDevice, memory driver.VkDeviceMemory, pCommittedMemoryInBytes *driver.VkDeviceSize) {
+	call := d.begin("VkGetDeviceMemoryCommitment", device, memory, pCommittedMemoryInBytes)
+	d.inner.VkGetDeviceMemoryCommitment(device, memory, pCommittedMemoryInBytes)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkBindBufferMemory(device driver.VkDevice, buffer driver.VkBuffer, memory driver.VkDeviceMemory, memoryOffset driver.VkDeviceSize) (common.VkResult, error) {
+	call := d.begin("VkBindBufferMemory", device, buffer, memory, memoryOffset)
+	res, err := d.inner.VkBindBufferMemory(device, buffer, memory, memoryOffset)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkBindImageMemory(device driver.VkDevice, image driver.VkImage, memory driver.VkDeviceMemory, memoryOffset driver.VkDeviceSize) (common.VkResult, error) {
+	call := d.begin("VkBindImageMemory", device, image, memory, memoryOffset)
+	res, err := d.inner.VkBindImageMemory(device, image, memory, memoryOffset)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetBufferMemoryRequirements(device driver.VkDevice, buffer driver.VkBuffer, pMemoryRequirements *driver.VkMemoryRequirements) {
+	call := d.begin("VkGetBufferMemoryRequirements", device, buffer, pMemoryRequirements)
+	d.inner.VkGetBufferMemoryRequirements(device, buffer, pMemoryRequirements)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetImageMemoryRequirements(device driver.VkDevice, image driver.VkImage, pMemoryRequirements *driver.VkMemoryRequirements) {
+	call := d.begin("VkGetImageMemoryRequirements", device, image, pMemoryRequirements)
+	d.inner.VkGetImageMemoryRequirements(device, image, pMemoryRequirements)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetImageSparseMemoryRequirements(device driver.VkDevice, image driver.VkImage, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements) {
+	call := d.begin("VkGetImageSparseMemoryRequirements", device, image, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+	d.inner.VkGetImageSparseMemoryRequirements(device, image, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkQueueBindSparse(queue driver.VkQueue, bindInfoCount driver.Uint32, pBindInfo *driver.VkBindSparseInfo, fence driver.VkFence) (common.VkResult, error) {
+	call := d.begin("VkQueueBindSparse", queue, bindInfoCount, pBindInfo, fence)
+	res, err := d.inner.VkQueueBindSparse(queue, bindInfoCount, pBindInfo, fence)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateFence(device driver.VkDevice, pCreateInfo *driver.VkFenceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pFence *driver.VkFence) (common.VkResult, error) {
+	call := d.begin("VkCreateFence", device, pCreateInfo, pAllocator, pFence)
+	res, err := d.inner.VkCreateFence(device, pCreateInfo, pAllocator, pFence)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyFence(device driver.VkDevice, fence driver.VkFence, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyFence", device, fence, pAllocator)
+	d.inner.VkDestroyFence(device, fence, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkResetFences(device driver.VkDevice, fenceCount driver.Uint32, pFences *driver.VkFence) (common.VkResult, error) {
+	call := d.begin("VkResetFences", device, fenceCount, pFences)
+	res, err := d.inner.VkResetFences(device, fenceCount, pFences)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetFenceStatus(device driver.VkDevice, fence driver.VkFence) (common.VkResult, error) {
+	call := d.begin("VkGetFenceStatus", device, fence)
+	res, err := d.inner.VkGetFenceStatus(device, fence)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkWaitForFences(device driver.VkDevice, fenceCount driver.Uint32, pFences *driver.VkFence, waitAll driver.VkBool32, timeout driver.Uint64) (common.VkResult, error) {
+	call := d.begin("VkWaitForFences", device, fenceCount, pFences, waitAll, timeout)
+	res, err := d.inner.VkWaitForFences(device, fenceCount, pFences, waitAll, timeout)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateSemaphore(device driver.VkDevice, pCreateInfo *driver.VkSemaphoreCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSemaphore *driver.VkSemaphore) (common.VkResult, error) {
+	call := d.begin("VkCreateSemaphore", device, pCreateInfo, pAllocator, pSemaphore)
+	res, err := d.inner.VkCreateSemaphore(device, pCreateInfo, pAllocator, pSemaphore)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroySemaphore(device driver.VkDevice, semaphore driver.VkSemaphore, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroySemaphore", device, semaphore, pAllocator)
+	d.inner.VkDestroySemaphore(device, semaphore, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateEvent(device driver.VkDevice, pCreateInfo *driver.VkEventCreateInfo, pAllocator *driver.VkAllocationCallbacks, pEvent *driver.VkEvent) (common.VkResult, error) {
+	call := d.begin("VkCreateEvent", device, pCreateInfo, pAllocator, pEvent)
+	res, err := d.inner.VkCreateEvent(device, pCreateInfo, pAllocator, pEvent)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyEvent(device driver.VkDevice, event driver.VkEvent, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyEvent", device, event, pAllocator)
+	d.inner.VkDestroyEvent(device, event, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetEventStatus(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	call := d.begin("VkGetEventStatus", device, event)
+	res, err := d.inner.VkGetEventStatus(device, event)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkSetEvent(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	call := d.begin("VkSetEvent", device, event)
+	res, err := d.inner.VkSetEvent(device, event)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkResetEvent(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	call := d.begin("VkResetEvent", device, event)
+	res, err := d.inner.VkResetEvent(device, event)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateQueryPool(device driver.VkDevice, pCreateInfo *driver.VkQueryPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pQueryPool *driver.VkQueryPool) (common.VkResult, error) {
+	call := d.begin("VkCreateQueryPool", device, pCreateInfo, pAllocator, pQueryPool)
+	res, err := d.inner.VkCreateQueryPool(device, pCreateInfo, pAllocator, pQueryPool)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyQueryPool(device driver.VkDevice, queryPool driver.VkQueryPool, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyQueryPool", device, queryPool, pAllocator)
+	d.inner.VkDestroyQueryPool(device, queryPool, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetQueryPoolResults(device driver.VkDevice, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32, dataSize driver.Size, pData unsafe.Pointer, stride driver.VkDeviceSize, flags driver.VkQueryResultFlags) (common.VkResult, error) {
+	call := d.begin("VkGetQueryPoolResults", device, queryPool, firstQuery, queryCount, dataSize, pData, stride, flags)
+	res, err := d.inner.VkGetQueryPoolResults(device, queryPool, firstQuery, queryCount, dataSize, pData, stride, flags)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateBuffer(device driver.VkDevice, pCreateInfo *driver.VkBufferCreateInfo, pAllocator *driver.VkAllocationCallbacks, pBuffer *driver.VkBuffer) (common.VkResult, error) {
+	call := d.begin("VkCreateBuffer", device, pCreateInfo, pAllocator, pBuffer)
+	res, err := d.inner.VkCreateBuffer(device, pCreateInfo, pAllocator, pBuffer)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyBuffer(device driver.VkDevice, buffer driver.VkBuffer, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyBuffer", device, buffer, pAllocator)
+	d.inner.VkDestroyBuffer(device, buffer, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateBufferView(device driver.VkDevice, pCreateInfo *driver.VkBufferViewCreateInfo, pAllocator *driver.VkAllocationCallbacks, pView *driver.VkBufferView) (common.VkResult, error) {
+	call := d.begin("VkCreateBufferView", device, pCreateInfo, pAllocator, pView)
+	res, err := d.inner.VkCreateBufferView(device, pCreateInfo, pAllocator, pView)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyBufferView(device driver.VkDevice, bufferView driver.VkBufferView, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyBufferView", device, bufferView, pAllocator)
+	d.inner.VkDestroyBufferView(device, bufferView, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateImage(device driver.VkDevice, pCreateInfo *driver.VkImageCreateInfo, pAllocator *driver.VkAllocationCallbacks, pImage *driver.VkImage) (common.VkResult, error) {
+	call := d.begin("VkCreateImage", device, pCreateInfo, pAllocator, pImage)
+	res, err := d.inner.VkCreateImage(device, pCreateInfo, pAllocator, pImage)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyImage(device driver.VkDevice, image driver.VkImage, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyImage", device, image, pAllocator)
+	d.inner.VkDestroyImage(device, image, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetImageSubresourceLayout(device driver.VkDevice, image driver.VkImage, pSubresource *driver.VkImageSubresource, pLayout *driver.VkSubresourceLayout) {
+	call := d.begin("VkGetImageSubresourceLayout", device, image, pSubresource, pLayout)
+	d.inner.VkGetImageSubresourceLayout(device, image, pSubresource, pLayout)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateImageView(device driver.VkDevice, pCreateInfo *driver.VkImageViewCreateInfo, pAllocator *driver.VkAllocationCallbacks, pView *driver.VkImageView) (common.VkResult, error) {
+	call := d.begin("VkCreateImageView", device, pCreateInfo, pAllocator, pView)
+	res, err := d.inner.VkCreateImageView(device, pCreateInfo, pAllocator, pView)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyImageView(device driver.VkDevice, imageView driver.VkImageView, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyImageView", device, imageView, pAllocator)
+	d.inner.VkDestroyImageView(device, imageView, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateShaderModule(device driver.VkDevice, pCreateInfo *driver.VkShaderModuleCreateInfo, pAllocator *driver.VkAllocationCallbacks, pShaderModule *driver.VkShaderModule) (common.VkResult, error) {
+	call := d.begin("VkCreateShaderModule", device, pCreateInfo, pAllocator, pShaderModule)
+	res, err := d.inner.VkCreateShaderModule(device, pCreateInfo, pAllocator, pShaderModule)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyShaderModule(device driver.VkDevice, shaderModule driver.VkShaderModule, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyShaderModule", device, shaderModule, pAllocator)
+	d.inner.VkDestroyShaderModule(device, shaderModule, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreatePipelineCache(device driver.VkDevice, pCreateInfo *driver.VkPipelineCacheCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelineCache *driver.VkPipelineCache) (common.VkResult, error) {
+	call := d.begin("VkCreatePipelineCache", device, pCreateInfo, pAllocator, pPipelineCache)
+	res, err := d.inner.VkCreatePipelineCache(device, pCreateInfo, pAllocator, pPipelineCache)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyPipelineCache(device driver.VkDevice, pipelineCache driver.VkPipelineCache, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyPipelineCache", device, pipelineCache, pAllocator)
+	d.inner.VkDestroyPipelineCache(device, pipelineCache, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPipelineCacheData(device driver.VkDevice, pipelineCache driver.VkPipelineCache, pDataSize *driver.Size, pData unsafe.Pointer) (common.VkResult, error) {
+	call := d.begin("VkGetPipelineCacheData", device, pipelineCache, pDataSize, pData)
+	res, err := d.inner.VkGetPipelineCacheData(device, pipelineCache, pDataSize, pData)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkMergePipelineCaches(device driver.VkDevice, dstCache driver.VkPipelineCache, srcCacheCount driver.Uint32, pSrcCaches *driver.VkPipelineCache) (common.VkResult, error) {
+	call := d.begin("VkMergePipelineCaches", device, dstCache, srcCacheCount, pSrcCaches)
+	res, err := d.inner.VkMergePipelineCaches(device, dstCache, srcCacheCount, pSrcCaches)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateGraphicsPipelines(device driver.VkDevice, pipelineCache driver.VkPipelineCache, createInfoCount driver.Uint32, pCreateInfos *driver.VkGraphicsPipelineCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelines *driver.VkPipeline) (common.VkResult, error) {
+	call := d.begin("VkCreateGraphicsPipelines", device, pipelineCache, createInfoCount, pCreateInfos, pAllocator, pPipelines)
+	res, err := d.inner.VkCreateGraphicsPipelines(device, pipelineCache, createInfoCount, pCreateInfos, pAllocator, pPipelines)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateComputePipelines(device driver.VkDevice, pipelineCache driver.VkPipelineCache, createInfoCount driver.Uint32, pCreateInfos *driver.VkComputePipelineCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelines *driver.VkPipeline) (common.VkResult, error) {
+	call := d.begin("VkCreateComputePipelines", device, pipelineCache, createInfoCount, pCreateInfos, pAllocator, pPipelines)
+	res, err := d.inner.VkCreateComputePipelines(device, pipelineCache, createInfoCount, pCreateInfos, pAllocator, pPipelines)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyPipeline(device driver.VkDevice, pipeline driver.VkPipeline, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyPipeline", device, pipeline, pAllocator)
+	d.inner.VkDestroyPipeline(device, pipeline, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreatePipelineLayout(device driver.VkDevice, pCreateInfo *driver.VkPipelineLayoutCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelineLayout *driver.VkPipelineLayout) (common.VkResult, error) {
+	call := d.begin("VkCreatePipelineLayout", device, pCreateInfo, pAllocator, pPipelineLayout)
+	res, err := d.inner.VkCreatePipelineLayout(device, pCreateInfo, pAllocator, pPipelineLayout)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyPipelineLayout(device driver.VkDevice, pipelineLayout driver.VkPipelineLayout, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyPipelineLayout", device, pipelineLayout, pAllocator)
+	d.inner.VkDestroyPipelineLayout(device, pipelineLayout, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateSampler(device driver.VkDevice, pCreateInfo *driver.VkSamplerCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSampler *driver.VkSampler) (common.VkResult, error) {
+	call := d.begin("VkCreateSampler", device, pCreateInfo, pAllocator, pSampler)
+	res, err := d.inner.VkCreateSampler(device, pCreateInfo, pAllocator, pSampler)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroySampler(device driver.VkDevice, sampler driver.VkSampler, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroySampler", device, sampler, pAllocator)
+	d.inner.VkDestroySampler(device, sampler, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateDescriptorSetLayout(device driver.VkDevice, pCreateInfo *driver.VkDescriptorSetLayoutCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSetLayout *driver.VkDescriptorSetLayout) (common.VkResult, error) {
+	call := d.begin("VkCreateDescriptorSetLayout", device, pCreateInfo, pAllocator, pSetLayout)
+	res, err := d.inner.VkCreateDescriptorSetLayout(device, pCreateInfo, pAllocator, pSetLayout)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyDescriptorSetLayout(device driver.VkDevice, descriptorSetLayout driver.VkDescriptorSetLayout, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyDescriptorSetLayout", device, descriptorSetLayout, pAllocator)
+	d.inner.VkDestroyDescriptorSetLayout(device, descriptorSetLayout, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateDescriptorPool(device driver.VkDevice, pCreateInfo *driver.VkDescriptorPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDescriptorPool *driver.VkDescriptorPool) (common.VkResult, error) {
+	call := d.begin("VkCreateDescriptorPool", device, pCreateInfo, pAllocator, pDescriptorPool)
+	res, err := d.inner.VkCreateDescriptorPool(device, pCreateInfo, pAllocator, pDescriptorPool)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyDescriptorPool(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyDescriptorPool", device, descriptorPool, pAllocator)
+	d.inner.VkDestroyDescriptorPool(device, descriptorPool, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkResetDescriptorPool(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, flags driver.VkDescriptorPoolResetFlags) (common.VkResult, error) {
+	call := d.begin("VkResetDescriptorPool", device, descriptorPool, flags)
+	res, err := d.inner.VkResetDescriptorPool(device, descriptorPool, flags)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkAllocateDescriptorSets(device driver.VkDevice, pAllocateInfo *driver.VkDescriptorSetAllocateInfo, pDescriptorSets *driver.VkDescriptorSet) (common.VkResult, error) {
+	call := d.begin("VkAllocateDescriptorSets", device, pAllocateInfo, pDescriptorSets)
+	res, err := d.inner.VkAllocateDescriptorSets(device, pAllocateInfo, pDescriptorSets)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkFreeDescriptorSets(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, descriptorSetCount driver.Uint32, pDescriptorSets *driver.VkDescriptorSet) (common.VkResult, error) {
+	call := d.begin("VkFreeDescriptorSets", device, descriptorPool, descriptorSetCount, pDescriptorSets)
+	res, err := d.inner.VkFreeDescriptorSets(device, descriptorPool, descriptorSetCount, pDescriptorSets)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkUpdateDescriptorSets(device driver.VkDevice, descriptorWriteCount driver.Uint32, pDescriptorWrites *driver.VkWriteDescriptorSet, descriptorCopyCount driver.Uint32, pDescriptorCopies *driver.VkCopyDescriptorSet) {
+	call := d.begin("VkUpdateDescriptorSets", device, descriptorWriteCount, pDescriptorWrites, descriptorCopyCount, pDescriptorCopies)
+	d.inner.VkUpdateDescriptorSets(device, descriptorWriteCount, pDescriptorWrites, descriptorCopyCount, pDescriptorCopies)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateFramebuffer(device driver.VkDevice, pCreateInfo *driver.VkFramebufferCreateInfo, pAllocator *driver.VkAllocationCallbacks, pFramebuffer *driver.VkFramebuffer) (common.VkResult, error) {
+	call := d.begin("VkCreateFramebuffer", device, pCreateInfo, pAllocator, pFramebuffer)
+	res, err := d.inner.VkCreateFramebuffer(device, pCreateInfo, pAllocator, pFramebuffer)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyFramebuffer(device driver.VkDevice, framebuffer driver.VkFramebuffer, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyFramebuffer", device, framebuffer, pAllocator)
+	d.inner.VkDestroyFramebuffer(device, framebuffer, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateRenderPass(device driver.VkDevice, pCreateInfo *driver.VkRenderPassCreateInfo, pAllocator *driver.VkAllocationCallbacks, pRenderPass *driver.VkRenderPass) (common.VkResult, error) {
+	call := d.begin("VkCreateRenderPass", device, pCreateInfo, pAllocator, pRenderPass)
+	res, err := d.inner.VkCreateRenderPass(device, pCreateInfo, pAllocator, pRenderPass)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyRenderPass(device driver.VkDevice, renderPass driver.VkRenderPass, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyRenderPass", device, renderPass, pAllocator)
+	d.inner.VkDestroyRenderPass(device, renderPass, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetRenderAreaGranularity(device driver.VkDevice, renderPass driver.VkRenderPass, pGranularity *driver.VkExtent2D) {
+	call := d.begin("VkGetRenderAreaGranularity", device, renderPass, pGranularity)
+	d.inner.VkGetRenderAreaGranularity(device, renderPass, pGranularity)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateCommandPool(device driver.VkDevice, pCreateInfo *driver.VkCommandPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pCommandPool *driver.VkCommandPool) (common.VkResult, error) {
+	call := d.begin("VkCreateCommandPool", device, pCreateInfo, pAllocator, pCommandPool)
+	res, err := d.inner.VkCreateCommandPool(device, pCreateInfo, pAllocator, pCommandPool)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyCommandPool", device, commandPool, pAllocator)
+	d.inner.VkDestroyCommandPool(device, commandPool, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkResetCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, flags driver.VkCommandPoolResetFlags) (common.VkResult, error) {
+	call := d.begin("VkResetCommandPool", device, commandPool, flags)
+	res, err := d.inner.VkResetCommandPool(device, commandPool, flags)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkAllocateCommandBuffers(device driver.VkDevice, pAllocateInfo *driver.VkCommandBufferAllocateInfo, pCommandBuffers *driver.VkCommandBuffer) (common.VkResult, error) {
+	call := d.begin("VkAllocateCommandBuffers", device, pAllocateInfo, pCommandBuffers)
+	res, err := d.inner.VkAllocateCommandBuffers(device, pAllocateInfo, pCommandBuffers)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkFreeCommandBuffers(device driver.VkDevice, commandPool driver.VkCommandPool, commandBufferCount driver.Uint32, pCommandBuffers *driver.VkCommandBuffer) {
+	call := d.begin("VkFreeCommandBuffers", device, commandPool, commandBufferCount, pCommandBuffers)
+	d.inner.VkFreeCommandBuffers(device, commandPool, commandBufferCount, pCommandBuffers)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkBeginCommandBuffer(commandBuffer driver.VkCommandBuffer, pBeginInfo *driver.VkCommandBufferBeginInfo) (common.VkResult, error) {
+	call := d.begin("VkBeginCommandBuffer", commandBuffer, pBeginInfo)
+	res, err := d.inner.VkBeginCommandBuffer(commandBuffer, pBeginInfo)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEndCommandBuffer(commandBuffer driver.VkCommandBuffer) (common.VkResult, error) {
+	call := d.begin("VkEndCommandBuffer", commandBuffer)
+	res, err := d.inner.VkEndCommandBuffer(commandBuffer)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkResetCommandBuffer(commandBuffer driver.VkCommandBuffer, flags driver.VkCommandBufferResetFlags) (common.VkResult, error) {
+	call := d.begin("VkResetCommandBuffer", commandBuffer, flags)
+	res, err := d.inner.VkResetCommandBuffer(commandBuffer, flags)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCmdBindPipeline(commandBuffer driver.VkCommandBuffer, pipelineBindPoint driver.VkPipelineBindPoint, pipeline driver.VkPipeline) {
+	call := d.begin("VkCmdBindPipeline", commandBuffer, pipelineBindPoint, pipeline)
+	d.inner.VkCmdBindPipeline(commandBuffer, pipelineBindPoint, pipeline)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetViewport(commandBuffer driver.VkCommandBuffer, firstViewport driver.Uint32, viewportCount driver.Uint32, pViewports *driver.VkViewport) {
+	call := d.begin("VkCmdSetViewport", commandBuffer, firstViewport, viewportCount, pViewports)
+	d.inner.VkCmdSetViewport(commandBuffer, firstViewport, viewportCount, pViewports)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetScissor(commandBuffer driver.VkCommandBuffer, firstScissor driver.Uint32, scissorCount driver.Uint32, pScissors *driver.VkRect2D) {
+	call := d.begin("VkCmdSetScissor", commandBuffer, firstScissor, scissorCount, pScissors)
+	d.inner.VkCmdSetScissor(commandBuffer, firstScissor, scissorCount, pScissors)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetLineWidth(commandBuffer driver.VkCommandBuffer, lineWidth driver.Float) {
+	call := d.begin("VkCmdSetLineWidth", commandBuffer, lineWidth)
+	d.inner.VkCmdSetLineWidth(commandBuffer, lineWidth)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthBias(commandBuffer driver.VkCommandBuffer, depthBiasConstantFactor driver.Float, depthBiasClamp driver.Float, depthBiasSlopeFactor driver.Float) {
+	call := d.begin("VkCmdSetDepthBias", commandBuffer, depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor)
+	d.inner.VkCmdSetDepthBias(commandBuffer, depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetBlendConstants(commandBuffer driver.VkCommandBuffer, blendConstants *driver.Float) {
+	call := d.begin("VkCmdSetBlendConstants", commandBuffer, blendConstants)
+	d.inner.VkCmdSetBlendConstants(commandBuffer, blendConstants)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthBounds(commandBuffer driver.VkCommandBuffer, minDepthBounds driver.Float, maxDepthBounds driver.Float) {
+	call := d.begin("VkCmdSetDepthBounds", commandBuffer, minDepthBounds, maxDepthBounds)
+	d.inner.VkCmdSetDepthBounds(commandBuffer, minDepthBounds, maxDepthBounds)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetStencilCompareMask(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, compareMask driver.Uint32) {
+	call := d.begin("VkCmdSetStencilCompareMask", commandBuffer, faceMask, compareMask)
+	d.inner.VkCmdSetStencilCompareMask(commandBuffer, faceMask, compareMask)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetStencilWriteMask(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, writeMask driver.Uint32) {
+	call := d.begin("VkCmdSetStencilWriteMask", commandBuffer, faceMask, writeMask)
+	d.inner.VkCmdSetStencilWriteMask(commandBuffer, faceMask, writeMask)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetStencilReference(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, reference driver.Uint32) {
+	call := d.begin("VkCmdSetStencilReference", commandBuffer, faceMask, reference)
+	d.inner.VkCmdSetStencilReference(commandBuffer, faceMask, reference)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdBindDescriptorSets(commandBuffer driver.VkCommandBuffer, pipelineBindPoint driver.VkPipelineBindPoint, layout driver.VkPipelineLayout, firstSet driver.Uint32, descriptorSetCount driver.Uint32, pDescriptorSets *driver.VkDescriptorSet, dynamicOffsetCount driver.Uint32, pDynamicOffsets *driver.Uint32) {
+	call := d.begin("VkCmdBindDescriptorSets", commandBuffer, pipelineBindPoint, layout, firstSet, descriptorSetCount, pDescriptorSets, dynamicOffsetCount, pDynamicOffsets)
+	d.inner.VkCmdBindDescriptorSets(commandBuffer, pipelineBindPoint, layout, firstSet, descriptorSetCount, pDescriptorSets, dynamicOffsetCount, pDynamicOffsets)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdBindIndexBuffer(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, indexType driver.VkIndexType) {
+	call := d.begin("VkCmdBindIndexBuffer", commandBuffer, buffer, offset, indexType)
+	d.inner.VkCmdBindIndexBuffer(commandBuffer, buffer, offset, indexType)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdBindVertexBuffers(commandBuffer driver.VkCommandBuffer, firstBinding driver.Uint32, bindingCount driver.Uint32, pBuffers *driver.VkBuffer, pOffsets *driver.VkDeviceSize) {
+	call := d.begin("VkCmdBindVertexBuffers", commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets)
+	d.inner.VkCmdBindVertexBuffers(commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdDraw(commandBuffer driver.VkCommandBuffer, vertexCount driver.Uint32, instanceCount driver.Uint32, firstVertex driver.Uint32, firstInstance driver.Uint32) {
+	call := d.begin("VkCmdDraw", commandBuffer, vertexCount, instanceCount, firstVertex, firstInstance)
+	d.inner.VkCmdDraw(commandBuffer, vertexCount, instanceCount, firstVertex, firstInstance)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdDrawIndexed(commandBuffer driver.VkCommandBuffer, indexCount driver.Uint32, instanceCount driver.Uint32, firstIndex driver.Uint32, vertexOffset driver.Int32, firstInstance driver.Uint32) {
+	call := d.begin("VkCmdDrawIndexed", commandBuffer, indexCount, instanceCount, firstIndex, vertexOffset, firstInstance)
+	d.inner.VkCmdDrawIndexed(commandBuffer, indexCount, instanceCount, firstIndex, vertexOffset, firstInstance)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdDrawIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, drawCount driver.Uint32, stride driver.Uint32) {
+	call := d.begin("VkCmdDrawIndirect", commandBuffer, buffer, offset, drawCount, stride)
+	d.inner.VkCmdDrawIndirect(commandBuffer, buffer, offset, drawCount, stride)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdDrawIndexedIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, drawCount driver.Uint32, stride driver.Uint32) {
+	call := d.begin("VkCmdDrawIndexedIndirect", commandBuffer, buffer, offset, drawCount, stride)
+	d.inner.VkCmdDrawIndexedIndirect(commandBuffer, buffer, offset, drawCount, stride)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdDispatch(commandBuffer driver.VkCommandBuffer, groupCountX driver.Uint32, groupCountY driver.Uint32, groupCountZ driver.Uint32) {
+	call := d.begin("VkCmdDispatch", commandBuffer, groupCountX, groupCountY, groupCountZ)
+	d.inner.VkCmdDispatch(commandBuffer, groupCountX, groupCountY, groupCountZ)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdDispatchIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize) {
+	call := d.begin("VkCmdDispatchIndirect", commandBuffer, buffer, offset)
+	d.inner.VkCmdDispatchIndirect(commandBuffer, buffer, offset)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdCopyBuffer(commandBuffer driver.VkCommandBuffer, srcBuffer driver.VkBuffer, dstBuffer driver.VkBuffer, regionCount driver.Uint32, pRegions *driver.VkBufferCopy) {
+	call := d.begin("VkCmdCopyBuffer", commandBuffer, srcBuffer, dstBuffer, regionCount, pRegions)
+	d.inner.VkCmdCopyBuffer(commandBuffer, srcBuffer, dstBuffer, regionCount, pRegions)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdCopyImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageCopy) {
+	call := d.begin("VkCmdCopyImage", commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)
+	d.inner.VkCmdCopyImage(commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdBlitImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageBlit, filter driver.VkFilter) {
+	call := d.begin("VkCmdBlitImage", commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions, filter)
+	d.inner.VkCmdBlitImage(commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions, filter)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdCopyBufferToImage(commandBuffer driver.VkCommandBuffer, srcBuffer driver.VkBuffer, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkBufferImageCopy) {
+	call := d.begin("VkCmdCopyBufferToImage", commandBuffer, srcBuffer, dstImage, dstImageLayout, regionCount, pRegions)
+	d.inner.VkCmdCopyBufferToImage(commandBuffer, srcBuffer, dstImage, dstImageLayout, regionCount, pRegions)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdCopyImageToBuffer(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstBuffer driver.VkBuffer, regionCount driver.Uint32, pRegions *driver.VkBufferImageCopy) {
+	call := d.begin("VkCmdCopyImageToBuffer", commandBuffer, srcImage, srcImageLayout, dstBuffer, regionCount, pRegions)
+	d.inner.VkCmdCopyImageToBuffer(commandBuffer, srcImage, srcImageLayout, dstBuffer, regionCount, pRegions)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdUpdateBuffer(commandBuffer driver.VkCommandBuffer, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, dataSize driver.VkDeviceSize, pData unsafe.Pointer) {
+	call := d.begin("VkCmdUpdateBuffer", commandBuffer, dstBuffer, dstOffset, dataSize, pData)
+	d.inner.VkCmdUpdateBuffer(commandBuffer, dstBuffer, dstOffset, dataSize, pData)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdFillBuffer(commandBuffer driver.VkCommandBuffer, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, size driver.VkDeviceSize, data driver.Uint32) {
+	call := d.begin("VkCmdFillBuffer", commandBuffer, dstBuffer, dstOffset, size, data)
+	d.inner.VkCmdFillBuffer(commandBuffer, dstBuffer, dstOffset, size, data)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdClearColorImage(commandBuffer driver.VkCommandBuffer, image driver.VkImage, imageLayout driver.VkImageLayout, pColor *driver.VkClearColorValue, rangeCount driver.Uint32, pRanges *driver.VkImageSubresourceRange) {
+	call := d.begin("VkCmdClearColorImage", commandBuffer, image, imageLayout, pColor, rangeCount, pRanges)
+	d.inner.VkCmdClearColorImage(commandBuffer, image, imageLayout, pColor, rangeCount, pRanges)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdClearDepthStencilImage(commandBuffer driver.VkCommandBuffer, image driver.VkImage, imageLayout driver.VkImageLayout, pDepthStencil *driver.VkClearDepthStencilValue, rangeCount driver.Uint32, pRanges *driver.VkImageSubresourceRange) {
+	call := d.begin("VkCmdClearDepthStencilImage", commandBuffer, image, imageLayout, pDepthStencil, rangeCount, pRanges)
+	d.inner.VkCmdClearDepthStencilImage(commandBuffer, image, imageLayout, pDepthStencil, rangeCount, pRanges)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdClearAttachments(commandBuffer driver.VkCommandBuffer, attachmentCount driver.Uint32, pAttachments *driver.VkClearAttachment, rectCount driver.Uint32, pRects *driver.VkClearRect) {
+	call := d.begin("VkCmdClearAttachments", commandBuffer, attachmentCount, pAttachments, rectCount, pRects)
+	d.inner.VkCmdClearAttachments(commandBuffer, attachmentCount, pAttachments, rectCount, pRects)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdResolveImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageResolve) {
+	call := d.begin("VkCmdResolveImage", commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)
+	d.inner.VkCmdResolveImage(commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetEvent(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags) {
+	call := d.begin("VkCmdSetEvent", commandBuffer, event, stageMask)
+	d.inner.VkCmdSetEvent(commandBuffer, event, stageMask)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdResetEvent(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags) {
+	call := d.begin("VkCmdResetEvent", commandBuffer, event, stageMask)
+	d.inner.VkCmdResetEvent(commandBuffer, event, stageMask)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdWaitEvents(commandBuffer driver.VkCommandBuffer, eventCount driver.Uint32, pEvents *driver.VkEvent, srcStageMask driver.VkPipelineStageFlags, dstStageMask driver.VkPipelineStageFlags, memoryBarrierCount driver.Uint32, pMemoryBarriers *driver.VkMemoryBarrier, bufferMemoryBarrierCount driver.Uint32, pBufferMemoryBarriers *driver.VkBufferMemoryBarrier, imageMemoryBarrierCount driver.Uint32, pImageMemoryBarriers *driver.VkImageMemoryBarrier) {
+	call := d.begin("VkCmdWaitEvents", commandBuffer, eventCount, pEvents, srcStageMask, dstStageMask, memoryBarrierCount, pMemoryBarriers, bufferMemoryBarrierCount, pBufferMemoryBarriers, imageMemoryBarrierCount, pImageMemoryBarriers)
+	d.inner.VkCmdWaitEvents(commandBuffer, eventCount, pEvents, srcStageMask, dstStageMask, memoryBarrierCount, pMemoryBarriers, bufferMemoryBarrierCount, pBufferMemoryBarriers, imageMemoryBarrierCount, pImageMemoryBarriers)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdPipelineBarrier(commandBuffer driver.VkCommandBuffer, srcStageMask driver.VkPipelineStageFlags, dstStageMask driver.VkPipelineStageFlags, dependencyFlags driver.VkDependencyFlags, memoryBarrierCount driver.Uint32, pMemoryBarriers *driver.VkMemoryBarrier, bufferMemoryBarrierCount driver.Uint32, pBufferMemoryBarriers *driver.VkBufferMemoryBarrier, imageMemoryBarrierCount driver.Uint32, pImageMemoryBarriers *driver.VkImageMemoryBarrier) {
+	call := d.begin("VkCmdPipelineBarrier", commandBuffer, srcStageMask, dstStageMask, dependencyFlags, memoryBarrierCount, pMemoryBarriers, bufferMemoryBarrierCount, pBufferMemoryBarriers, imageMemoryBarrierCount, pImageMemoryBarriers)
+	d.inner.VkCmdPipelineBarrier(commandBuffer, srcStageMask, dstStageMask, dependencyFlags, memoryBarrierCount, pMemoryBarriers, bufferMemoryBarrierCount, pBufferMemoryBarriers, imageMemoryBarrierCount, pImageMemoryBarriers)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdBeginQuery(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, query driver.Uint32, flags driver.VkQueryControlFlags) {
+	call := d.begin("VkCmdBeginQuery", commandBuffer, queryPool, query, flags)
+	d.inner.VkCmdBeginQuery(commandBuffer, queryPool, query, flags)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdEndQuery(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, query driver.Uint32) {
+	call := d.begin("VkCmdEndQuery", commandBuffer, queryPool, query)
+	d.inner.VkCmdEndQuery(commandBuffer, queryPool, query)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdResetQueryPool(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32) {
+	call := d.begin("VkCmdResetQueryPool", commandBuffer, queryPool, firstQuery, queryCount)
+	d.inner.VkCmdResetQueryPool(commandBuffer, queryPool, firstQuery, queryCount)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdWriteTimestamp(commandBuffer driver.VkCommandBuffer, pipelineStage driver.VkPipelineStageFlags, queryPool driver.VkQueryPool, query driver.Uint32) {
+	call := d.begin("VkCmdWriteTimestamp", commandBuffer, pipelineStage, queryPool, query)
+	d.inner.VkCmdWriteTimestamp(commandBuffer, pipelineStage, queryPool, query)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdCopyQueryPoolResults(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, stride driver.VkDeviceSize, flags driver.VkQueryResultFlags) {
+	call := d.begin("VkCmdCopyQueryPoolResults", commandBuffer, queryPool, firstQuery, queryCount, dstBuffer, dstOffset, stride, flags)
+	d.inner.VkCmdCopyQueryPoolResults(commandBuffer, queryPool, firstQuery, queryCount, dstBuffer, dstOffset, stride, flags)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdPushConstants(commandBuffer driver.VkCommandBuffer, layout driver.VkPipelineLayout, stageFlags driver.VkShaderStageFlags, offset driver.Uint32, size driver.Uint32, pValues unsafe.Pointer) {
+	call := d.begin("VkCmdPushConstants", commandBuffer, layout, stageFlags, offset, size, pValues)
+	d.inner.VkCmdPushConstants(commandBuffer, layout, stageFlags, offset, size, pValues)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdBeginRenderPass(commandBuffer driver.VkCommandBuffer, pRenderPassBegin *driver.VkRenderPassBeginInfo, contents driver.VkSubpassContents) {
+	call := d.begin("VkCmdBeginRenderPass", commandBuffer, pRenderPassBegin, contents)
+	d.inner.VkCmdBeginRenderPass(commandBuffer, pRenderPassBegin, contents)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdNextSubpass(commandBuffer driver.VkCommandBuffer, contents driver.VkSubpassContents) {
+	call := d.begin("VkCmdNextSubpass", commandBuffer, contents)
+	d.inner.VkCmdNextSubpass(commandBuffer, contents)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdEndRenderPass(commandBuffer driver.VkCommandBuffer) {
+	call := d.begin("VkCmdEndRenderPass", commandBuffer)
+	d.inner.VkCmdEndRenderPass(commandBuffer)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdExecuteCommands(commandBuffer driver.VkCommandBuffer, commandBufferCount driver.Uint32, pCommandBuffers *driver.VkCommandBuffer) {
+	call := d.begin("VkCmdExecuteCommands", commandBuffer, commandBufferCount, pCommandBuffers)
+	d.inner.VkCmdExecuteCommands(commandBuffer, commandBufferCount, pCommandBuffers)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkBindBufferMemory2(device driver.VkDevice, bindInfoCount driver.Uint32, pBindInfos *driver.VkBindBufferMemoryInfo) (common.VkResult, error) {
+	call := d.begin("VkBindBufferMemory2", device, bindInfoCount, pBindInfos)
+	res, err := d.inner.VkBindBufferMemory2(device, bindInfoCount, pBindInfos)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkBindImageMemory2(device driver.VkDevice, bindInfoCount driver.Uint32, pBindInfos *driver.VkBindImageMemoryInfo) (common.VkResult, error) {
+	call := d.begin("VkBindImageMemory2", device, bindInfoCount, pBindInfos)
+	res, err := d.inner.VkBindImageMemory2(device, bindInfoCount, pBindInfos)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetDeviceGroupPeerMemoryFeatures(device driver.VkDevice, heapIndex driver.Uint32, localDeviceIndex driver.Uint32, remoteDeviceIndex driver.Uint32, pPeerMemoryFeatures *driver.VkPeerMemoryFeatureFlags) {
+	call := d.begin("VkGetDeviceGroupPeerMemoryFeatures", device, heapIndex, localDeviceIndex, remoteDeviceIndex, pPeerMemoryFeatures)
+	d.inner.VkGetDeviceGroupPeerMemoryFeatures(device, heapIndex, localDeviceIndex, remoteDeviceIndex, pPeerMemoryFeatures)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetDeviceMask(commandBuffer driver.VkCommandBuffer, deviceMask driver.Uint32) {
+	call := d.begin("VkCmdSetDeviceMask", commandBuffer, deviceMask)
+	d.inner.VkCmdSetDeviceMask(commandBuffer, deviceMask)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdDispatchBase(commandBuffer driver.VkCommandBuffer, baseGroupX driver.Uint32, baseGroupY driver.Uint32, baseGroupZ driver.Uint32, groupCountX driver.Uint32, groupCountY driver.Uint32, groupCountZ driver.Uint32) {
+	call := d.begin("VkCmdDispatchBase", commandBuffer, baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ)
+	d.inner.VkCmdDispatchBase(commandBuffer, baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetImageMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkImageMemoryRequirementsInfo2, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	call := d.begin("VkGetImageMemoryRequirements2", device, pInfo, pMemoryRequirements)
+	d.inner.VkGetImageMemoryRequirements2(device, pInfo, pMemoryRequirements)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetBufferMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkBufferMemoryRequirementsInfo2, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	call := d.begin("VkGetBufferMemoryRequirements2", device, pInfo, pMemoryRequirements)
+	d.inner.VkGetBufferMemoryRequirements2(device, pInfo, pMemoryRequirements)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetImageSparseMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkImageSparseMemoryRequirementsInfo2, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements2) {
+	call := d.begin("VkGetImageSparseMemoryRequirements2", device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+	d.inner.VkGetImageSparseMemoryRequirements2(device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkTrimCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, flags driver.VkCommandPoolTrimFlags) {
+	call := d.begin("VkTrimCommandPool", device, commandPool, flags)
+	d.inner.VkTrimCommandPool(device, commandPool, flags)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetDeviceQueue2(device driver.VkDevice, pQueueInfo *driver.VkDeviceQueueInfo2, pQueue *driver.VkQueue) {
+	call := d.begin("VkGetDeviceQueue2", device, pQueueInfo, pQueue)
+	d.inner.VkGetDeviceQueue2(device, pQueueInfo, pQueue)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateSamplerYcbcrConversion(device driver.VkDevice, pCreateInfo *driver.VkSamplerYcbcrConversionCreateInfo, pAllocator *driver.VkAllocationCallbacks, pYcbcrConversion *driver.VkSamplerYcbcrConversion) (common.VkResult, error) {
+	call := d.begin("VkCreateSamplerYcbcrConversion", device, pCreateInfo, pAllocator, pYcbcrConversion)
+	res, err := d.inner.VkCreateSamplerYcbcrConversion(device, pCreateInfo, pAllocator, pYcbcrConversion)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroySamplerYcbcrConversion(device driver.VkDevice, ycbcrConversion driver.VkSamplerYcbcrConversion, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroySamplerYcbcrConversion", device, ycbcrConversion, pAllocator)
+	d.inner.VkDestroySamplerYcbcrConversion(device, ycbcrConversion, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkDestroyDescriptorUpdateTemplate(device driver.VkDevice, descriptorUpdateTemplate driver.VkDescriptorUpdateTemplate, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyDescriptorUpdateTemplate", device, descriptorUpdateTemplate, pAllocator)
+	d.inner.VkDestroyDescriptorUpdateTemplate(device, descriptorUpdateTemplate, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkUpdateDescriptorSetWithTemplate(device driver.VkDevice, descriptorSet driver.VkDescriptorSet, descriptorUpdateTemplate driver.VkDescriptorUpdateTemplate, pData unsafe.Pointer) {
+	call := d.begin("VkUpdateDescriptorSetWithTemplate", device, descriptorSet, descriptorUpdateTemplate, pData)
+	d.inner.VkUpdateDescriptorSetWithTemplate(device, descriptorSet, descriptorUpdateTemplate, pData)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetDescriptorSetLayoutSupport(device driver.VkDevice, pCreateInfo *driver.VkDescriptorSetLayoutCreateInfo, pSupport *driver.VkDescriptorSetLayoutSupport) {
+	call := d.begin("VkGetDescriptorSetLayoutSupport", device, pCreateInfo, pSupport)
+	d.inner.VkGetDescriptorSetLayoutSupport(device, pCreateInfo, pSupport)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdDrawIndirectCount(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, countBuffer driver.VkBuffer, countBufferOffset driver.VkDeviceSize, maxDrawCount driver.Uint32, stride driver.Uint32) {
+	call := d.begin("VkCmdDrawIndirectCount", commandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+	d.inner.VkCmdDrawIndirectCount(commandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdDrawIndexedIndirectCount(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, countBuffer driver.VkBuffer, countBufferOffset driver.VkDeviceSize, maxDrawCount driver.Uint32, stride driver.Uint32) {
+	call := d.begin("VkCmdDrawIndexedIndirectCount", commandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+	d.inner.VkCmdDrawIndexedIndirectCount(commandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreateRenderPass2(device driver.VkDevice, pCreateInfo *driver.VkRenderPassCreateInfo2, pAllocator *driver.VkAllocationCallbacks, pRenderPass *driver.VkRenderPass) (common.VkResult, error) {
+	call := d.begin("VkCreateRenderPass2", device, pCreateInfo, pAllocator, pRenderPass)
+	res, err := d.inner.VkCreateRenderPass2(device, pCreateInfo, pAllocator, pRenderPass)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCmdBeginRenderPass2(commandBuffer driver.VkCommandBuffer, pRenderPassBegin *driver.VkRenderPassBeginInfo, pSubpassBeginInfo *driver.VkSubpassBeginInfo) {
+	call := d.begin("VkCmdBeginRenderPass2", commandBuffer, pRenderPassBegin, pSubpassBeginInfo)
+	d.inner.VkCmdBeginRenderPass2(commandBuffer, pRenderPassBegin, pSubpassBeginInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdNextSubpass2(commandBuffer driver.VkCommandBuffer, pSubpassBeginInfo *driver.VkSubpassBeginInfo, pSubpassEndInfo *driver.VkSubpassEndInfo) {
+	call := d.begin("VkCmdNextSubpass2", commandBuffer, pSubpassBeginInfo, pSubpassEndInfo)
+	d.inner.VkCmdNextSubpass2(commandBuffer, pSubpassBeginInfo, pSubpassEndInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdEndRenderPass2(commandBuffer driver.VkCommandBuffer, pSubpassEndInfo *driver.VkSubpassEndInfo) {
+	call := d.begin("VkCmdEndRenderPass2", commandBuffer, pSubpassEndInfo)
+	d.inner.VkCmdEndRenderPass2(commandBuffer, pSubpassEndInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkResetQueryPool(device driver.VkDevice, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32) {
+	call := d.begin("VkResetQueryPool", device, queryPool, firstQuery, queryCount)
+	d.inner.VkResetQueryPool(device, queryPool, firstQuery, queryCount)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetSemaphoreCounterValue(device driver.VkDevice, semaphore driver.VkSemaphore, pValue *driver.Uint64) (common.VkResult, error) {
+	call := d.begin("VkGetSemaphoreCounterValue", device, semaphore, pValue)
+	res, err := d.inner.VkGetSemaphoreCounterValue(device, semaphore, pValue)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkWaitSemaphores(device driver.VkDevice, pWaitInfo *driver.VkSemaphoreWaitInfo, timeout driver.Uint64) (common.VkResult, error) {
+	call := d.begin("VkWaitSemaphores", device, pWaitInfo, timeout)
+	res, err := d.inner.VkWaitSemaphores(device, pWaitInfo, timeout)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkSignalSemaphore(device driver.VkDevice, pSignalInfo *driver.VkSemaphoreSignalInfo) (common.VkResult, error) {
+	call := d.begin("VkSignalSemaphore", device, pSignalInfo)
+	res, err := d.inner.VkSignalSemaphore(device, pSignalInfo)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetBufferDeviceAddress(device driver.VkDevice, pInfo *driver.VkBufferDeviceAddressInfo) driver.VkDeviceAddress {
+	call := d.begin("VkGetBufferDeviceAddress", device, pInfo)
+	ret := d.inner.VkGetBufferDeviceAddress(device, pInfo)
+	d.end(call, 0)
+	return ret
+}
+
+func (d *Driver) VkGetBufferOpaqueCaptureAddress(device driver.VkDevice, pInfo *driver.VkBufferDeviceAddressInfo) driver.Uint64 {
+	call := d.begin("VkGetBufferOpaqueCaptureAddress", device, pInfo)
+	ret := d.inner.VkGetBufferOpaqueCaptureAddress(device, pInfo)
+	d.end(call, 0)
+	return ret
+}
+
+func (d *Driver) VkGetDeviceMemoryOpaqueCaptureAddress(device driver.VkDevice, pInfo *driver.VkDeviceMemoryOpaqueCaptureAddressInfo) driver.Uint64 {
+	call := d.begin("VkGetDeviceMemoryOpaqueCaptureAddress", device, pInfo)
+	ret := d.inner.VkGetDeviceMemoryOpaqueCaptureAddress(device, pInfo)
+	d.end(call, 0)
+	return ret
+}
+
+func (d *Driver) VkCmdBeginRendering(commandBuffer driver.VkCommandBuffer, pRenderingInfo *driver.VkRenderingInfo) {
+	call := d.begin("VkCmdBeginRendering", commandBuffer, pRenderingInfo)
+	d.inner.VkCmdBeginRendering(commandBuffer, pRenderingInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdEndRendering(commandBuffer driver.VkCommandBuffer) {
+	call := d.begin("VkCmdEndRendering", commandBuffer)
+	d.inner.VkCmdEndRendering(commandBuffer)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetEvent2(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, pDependencyInfo *driver.VkDependencyInfo) {
+	call := d.begin("VkCmdSetEvent2", commandBuffer, event, pDependencyInfo)
+	d.inner.VkCmdSetEvent2(commandBuffer, event, pDependencyInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdResetEvent2(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags2) {
+	call := d.begin("VkCmdResetEvent2", commandBuffer, event, stageMask)
+	d.inner.VkCmdResetEvent2(commandBuffer, event, stageMask)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdWaitEvents2(commandBuffer driver.VkCommandBuffer, eventCount driver.Uint32, pEvents *driver.VkEvent, pDependencyInfos *driver.VkDependencyInfo) {
+	call := d.begin("VkCmdWaitEvents2", commandBuffer, eventCount, pEvents, pDependencyInfos)
+	d.inner.VkCmdWaitEvents2(commandBuffer, eventCount, pEvents, pDependencyInfos)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdPipelineBarrier2(commandBuffer driver.VkCommandBuffer, pDependencyInfo *driver.VkDependencyInfo) {
+	call := d.begin("VkCmdPipelineBarrier2", commandBuffer, pDependencyInfo)
+	d.inner.VkCmdPipelineBarrier2(commandBuffer, pDependencyInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdWriteTimestamp2(commandBuffer driver.VkCommandBuffer, stage driver.VkPipelineStageFlags2, queryPool driver.VkQueryPool, query driver.Uint32) {
+	call := d.begin("VkCmdWriteTimestamp2", commandBuffer, stage, queryPool, query)
+	d.inner.VkCmdWriteTimestamp2(commandBuffer, stage, queryPool, query)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetCullMode(commandBuffer driver.VkCommandBuffer, cullMode driver.VkCullModeFlags) {
+	call := d.begin("VkCmdSetCullMode", commandBuffer, cullMode)
+	d.inner.VkCmdSetCullMode(commandBuffer, cullMode)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetFrontFace(commandBuffer driver.VkCommandBuffer, frontFace driver.VkFrontFace) {
+	call := d.begin("VkCmdSetFrontFace", commandBuffer, frontFace)
+	d.inner.VkCmdSetFrontFace(commandBuffer, frontFace)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetPrimitiveTopology(commandBuffer driver.VkCommandBuffer, primitiveTopology driver.VkPrimitiveTopology) {
+	call := d.begin("VkCmdSetPrimitiveTopology", commandBuffer, primitiveTopology)
+	d.inner.VkCmdSetPrimitiveTopology(commandBuffer, primitiveTopology)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetViewportWithCount(commandBuffer driver.VkCommandBuffer, viewportCount driver.Uint32, pViewports *driver.VkViewport) {
+	call := d.begin("VkCmdSetViewportWithCount", commandBuffer, viewportCount, pViewports)
+	d.inner.VkCmdSetViewportWithCount(commandBuffer, viewportCount, pViewports)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetScissorWithCount(commandBuffer driver.VkCommandBuffer, scissorCount driver.Uint32, pScissors *driver.VkRect2D) {
+	call := d.begin("VkCmdSetScissorWithCount", commandBuffer, scissorCount, pScissors)
+	d.inner.VkCmdSetScissorWithCount(commandBuffer, scissorCount, pScissors)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdBindVertexBuffers2(commandBuffer driver.VkCommandBuffer, firstBinding driver.Uint32, bindingCount driver.Uint32, pBuffers *driver.VkBuffer, pOffsets *driver.VkDeviceSize, pSizes *driver.VkDeviceSize, pStrides *driver.VkDeviceSize) {
+	call := d.begin("VkCmdBindVertexBuffers2", commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets, pSizes, pStrides)
+	d.inner.VkCmdBindVertexBuffers2(commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets, pSizes, pStrides)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthTestEnable(commandBuffer driver.VkCommandBuffer, depthTestEnable driver.VkBool32) {
+	call := d.begin("VkCmdSetDepthTestEnable", commandBuffer, depthTestEnable)
+	d.inner.VkCmdSetDepthTestEnable(commandBuffer, depthTestEnable)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthWriteEnable(commandBuffer driver.VkCommandBuffer, depthWriteEnable driver.VkBool32) {
+	call := d.begin("VkCmdSetDepthWriteEnable", commandBuffer, depthWriteEnable)
+	d.inner.VkCmdSetDepthWriteEnable(commandBuffer, depthWriteEnable)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthCompareOp(commandBuffer driver.VkCommandBuffer, depthCompareOp driver.VkCompareOp) {
+	call := d.begin("VkCmdSetDepthCompareOp", commandBuffer, depthCompareOp)
+	d.inner.VkCmdSetDepthCompareOp(commandBuffer, depthCompareOp)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthBoundsTestEnable(commandBuffer driver.VkCommandBuffer, depthBoundsTestEnable driver.VkBool32) {
+	call := d.begin("VkCmdSetDepthBoundsTestEnable", commandBuffer, depthBoundsTestEnable)
+	d.inner.VkCmdSetDepthBoundsTestEnable(commandBuffer, depthBoundsTestEnable)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetStencilTestEnable(commandBuffer driver.VkCommandBuffer, stencilTestEnable driver.VkBool32) {
+	call := d.begin("VkCmdSetStencilTestEnable", commandBuffer, stencilTestEnable)
+	d.inner.VkCmdSetStencilTestEnable(commandBuffer, stencilTestEnable)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetStencilOp(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, failOp driver.VkStencilOp, passOp driver.VkStencilOp, depthFailOp driver.VkStencilOp, compareOp driver.VkCompareOp) {
+	call := d.begin("VkCmdSetStencilOp", commandBuffer, faceMask, failOp, passOp, depthFailOp, compareOp)
+	d.inner.VkCmdSetStencilOp(commandBuffer, faceMask, failOp, passOp, depthFailOp, compareOp)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetRasterizerDiscardEnable(commandBuffer driver.VkCommandBuffer, rasterizerDiscardEnable driver.VkBool32) {
+	call := d.begin("VkCmdSetRasterizerDiscardEnable", commandBuffer, rasterizerDiscardEnable)
+	d.inner.VkCmdSetRasterizerDiscardEnable(commandBuffer, rasterizerDiscardEnable)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthBiasEnable(commandBuffer driver.VkCommandBuffer, depthBiasEnable driver.VkBool32) {
+	call := d.begin("VkCmdSetDepthBiasEnable", commandBuffer, depthBiasEnable)
+	d.inner.VkCmdSetDepthBiasEnable(commandBuffer, depthBiasEnable)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdSetPrimitiveRestartEnable(commandBuffer driver.VkCommandBuffer, primitiveRestartEnable driver.VkBool32) {
+	call := d.begin("VkCmdSetPrimitiveRestartEnable", commandBuffer, primitiveRestartEnable)
+	d.inner.VkCmdSetPrimitiveRestartEnable(commandBuffer, primitiveRestartEnable)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdCopyBuffer2(commandBuffer driver.VkCommandBuffer, pCopyBufferInfo *driver.VkCopyBufferInfo2) {
+	call := d.begin("VkCmdCopyBuffer2", commandBuffer, pCopyBufferInfo)
+	d.inner.VkCmdCopyBuffer2(commandBuffer, pCopyBufferInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdCopyImage2(commandBuffer driver.VkCommandBuffer, pCopyImageInfo *driver.VkCopyImageInfo2) {
+	call := d.begin("VkCmdCopyImage2", commandBuffer, pCopyImageInfo)
+	d.inner.VkCmdCopyImage2(commandBuffer, pCopyImageInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdCopyBufferToImage2(commandBuffer driver.VkCommandBuffer, pCopyBufferToImageInfo *driver.VkCopyBufferToImageInfo2) {
+	call := d.begin("VkCmdCopyBufferToImage2", commandBuffer, pCopyBufferToImageInfo)
+	d.inner.VkCmdCopyBufferToImage2(commandBuffer, pCopyBufferToImageInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdCopyImageToBuffer2(commandBuffer driver.VkCommandBuffer, pCopyImageToBufferInfo *driver.VkCopyImageToBufferInfo2) {
+	call := d.begin("VkCmdCopyImageToBuffer2", commandBuffer, pCopyImageToBufferInfo)
+	d.inner.VkCmdCopyImageToBuffer2(commandBuffer, pCopyImageToBufferInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdBlitImage2(commandBuffer driver.VkCommandBuffer, pBlitImageInfo *driver.VkBlitImageInfo2) {
+	call := d.begin("VkCmdBlitImage2", commandBuffer, pBlitImageInfo)
+	d.inner.VkCmdBlitImage2(commandBuffer, pBlitImageInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCmdResolveImage2(commandBuffer driver.VkCommandBuffer, pResolveImageInfo *driver.VkResolveImageInfo2) {
+	call := d.begin("VkCmdResolveImage2", commandBuffer, pResolveImageInfo)
+	d.inner.VkCmdResolveImage2(commandBuffer, pResolveImageInfo)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetDeviceBufferMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceBufferMemoryRequirements, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	call := d.begin("VkGetDeviceBufferMemoryRequirements", device, pInfo, pMemoryRequirements)
+	d.inner.VkGetDeviceBufferMemoryRequirements(device, pInfo, pMemoryRequirements)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetDeviceImageMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceImageMemoryRequirements, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	call := d.begin("VkGetDeviceImageMemoryRequirements", device, pInfo, pMemoryRequirements)
+	d.inner.VkGetDeviceImageMemoryRequirements(device, pInfo, pMemoryRequirements)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetDeviceImageSparseMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceImageMemoryRequirements, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements2) {
+	call := d.begin("VkGetDeviceImageSparseMemoryRequirements", device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+	d.inner.VkGetDeviceImageSparseMemoryRequirements(device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkCreatePrivateDataSlot(device driver.VkDevice, pCreateInfo *driver.VkPrivateDataSlotCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPrivateDataSlot *driver.VkPrivateDataSlot) (common.VkResult, error) {
+	call := d.begin("VkCreatePrivateDataSlot", device, pCreateInfo, pAllocator, pPrivateDataSlot)
+	res, err := d.inner.VkCreatePrivateDataSlot(device, pCreateInfo, pAllocator, pPrivateDataSlot)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyPrivateDataSlot(device driver.VkDevice, privateDataSlot driver.VkPrivateDataSlot, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("VkDestroyPrivateDataSlot", device, privateDataSlot, pAllocator)
+	d.inner.VkDestroyPrivateDataSlot(device, privateDataSlot, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkSetPrivateData(device driver.VkDevice, objectType driver.VkObjectType, objectHandle driver.Uint64, privateDataSlot driver.VkPrivateDataSlot, data driver.Uint64) (common.VkResult, error) {
+	call := d.begin("VkSetPrivateData", device, objectType, objectHandle, privateDataSlot, data)
+	res, err := d.inner.VkSetPrivateData(device, objectType, objectHandle, privateDataSlot, data)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetPrivateData(device driver.VkDevice, objectType driver.VkObjectType, objectHandle driver.Uint64, privateDataSlot driver.VkPrivateDataSlot, pData *driver.Uint64) {
+	call := d.begin("VkGetPrivateData", device, objectType, objectHandle, privateDataSlot, pData)
+	d.inner.VkGetPrivateData(device, objectType, objectHandle, privateDataSlot, pData)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceToolProperties(physicalDevice driver.VkPhysicalDevice, pToolCount *driver.Uint32, pToolProperties *driver.VkPhysicalDeviceToolProperties) (common.VkResult, error) {
+	call := d.begin("VkGetPhysicalDeviceToolProperties", physicalDevice, pToolCount, pToolProperties)
+	res, err := d.inner.VkGetPhysicalDeviceToolProperties(physicalDevice, pToolCount, pToolProperties)
+	d.end(call, res)
+	return res, err
+}
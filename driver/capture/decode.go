@@ -0,0 +1,168 @@
+package capture
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"github.com/cockroachdb/errors"
+	"reflect"
+	"unsafe"
+)
+
+// replayDecoder rebuilds captured values in C memory, remapping captured handles to the handles
+// created during replay
+type replayDecoder struct {
+	*decoder
+	handles   map[uint64]uint64
+	allocated []unsafe.Pointer
+}
+
+func (d *replayDecoder) alloc(size uintptr) unsafe.Pointer {
+	if size == 0 {
+		size = 1
+	}
+
+	p := C.calloc(1, C.size_t(size))
+	d.allocated = append(d.allocated, p)
+	return p
+}
+
+func (d *replayDecoder) free() {
+	for _, p := range d.allocated {
+		C.free(p)
+	}
+	d.allocated = d.allocated[:0]
+}
+
+func (d *replayDecoder) remap(handle uint64) uint64 {
+	if replayed, ok := d.handles[handle]; ok {
+		return replayed
+	}
+
+	return handle
+}
+
+func writeScalar(p unsafe.Pointer, size uintptr, value uint64) {
+	switch size {
+	case 1:
+		*(*uint8)(p) = uint8(value)
+	case 2:
+		*(*uint16)(p) = uint16(value)
+	case 4:
+		*(*uint32)(p) = uint32(value)
+	default:
+		*(*uint64)(p) = value
+	}
+}
+
+func elemSize(t reflect.Type) uintptr {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem().Size()
+	}
+
+	return 1
+}
+
+// value decodes a value of type t into the memory at p, which must be zeroed
+func (d *replayDecoder) value(t reflect.Type, p unsafe.Pointer) error {
+	tag, err := d.byte()
+	if err != nil {
+		return err
+	}
+
+	switch tag {
+	case tagNull:
+		return nil
+	case tagScalar, tagHandle:
+		value, err := d.uvarint()
+		if err != nil {
+			return err
+		}
+		if tag == tagHandle {
+			value = d.remap(value)
+		}
+
+		writeScalar(p, t.Size(), value)
+		return nil
+	case tagStruct:
+		n, err := d.uvarint()
+		if err != nil {
+			return err
+		}
+		if t.Kind() != reflect.Struct || int(n) != t.NumField() {
+			return errors.Newf("captured structure with %d fields does not match %s", n, structName(t))
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			err = d.value(t.Field(i).Type, unsafe.Add(p, t.Field(i).Offset))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case tagArray:
+		n, err := d.uvarint()
+		if err != nil {
+			return err
+		}
+		if t.Kind() != reflect.Array && t.Kind() != reflect.Ptr {
+			return errors.Newf("captured array does not match %s", t)
+		}
+
+		elem := t.Elem()
+		target := p
+		if t.Kind() == reflect.Ptr {
+			target = d.alloc(uintptr(n) * elem.Size())
+			*(*unsafe.Pointer)(p) = target
+		}
+
+		for i := 0; i < int(n); i++ {
+			err = d.value(elem, unsafe.Add(target, uintptr(i)*elem.Size()))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case tagBytes, tagString:
+		data, err := d.bytes()
+		if err != nil {
+			return err
+		}
+
+		if t.Kind() == reflect.Array {
+			copy(unsafe.Slice((*byte)(p), t.Size()), data)
+			return nil
+		}
+
+		// Strings are followed by a null terminator, which alloc has already zeroed
+		target := d.alloc(uintptr(len(data)) + 1)
+		copy(unsafe.Slice((*byte)(target), len(data)), data)
+		*(*unsafe.Pointer)(p) = target
+		return nil
+	case tagChain:
+		sType, err := d.uvarint()
+		if err != nil {
+			return err
+		}
+
+		structType, ok := structureTypes[uint32(sType)]
+		if !ok {
+			return errors.Newf("capture contains unknown structure type %d", sType)
+		}
+
+		target := d.alloc(structType.Size())
+		*(*unsafe.Pointer)(p) = target
+		return d.value(structType, target)
+	case tagAlloc:
+		n, err := d.uvarint()
+		if err != nil {
+			return err
+		}
+
+		*(*unsafe.Pointer)(p) = d.alloc(uintptr(n) * elemSize(t))
+		return nil
+	}
+
+	return errors.Newf("capture contains unknown value tag %d", tag)
+}
@@ -0,0 +1,252 @@
+package capture
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// lengthOverrides names the field holding the length of each array field whose name does not
+// share a stem with its length field
+var lengthOverrides = map[string]string{
+	"VkSubmitInfo.pWaitDstStageMask":                                       "waitSemaphoreCount",
+	"VkDescriptorSetAllocateInfo.pSetLayouts":                              "descriptorSetCount",
+	"VkDescriptorSetLayoutBinding.pImmutableSamplers":                      "descriptorCount",
+	"VkWriteDescriptorSet.pImageInfo":                                      "descriptorCount",
+	"VkWriteDescriptorSet.pBufferInfo":                                     "descriptorCount",
+	"VkWriteDescriptorSet.pTexelBufferView":                                "descriptorCount",
+	"VkSubpassDescription.pResolveAttachments":                             "colorAttachmentCount",
+	"VkSubpassDescription2.pResolveAttachments":                            "colorAttachmentCount",
+	"VkRenderPassMultiviewCreateInfo.pViewMasks":                           "subpassCount",
+	"VkRenderPassMultiviewCreateInfo.pViewOffsets":                         "dependencyCount",
+	"VkDescriptorSetVariableDescriptorCountAllocateInfo.pDescriptorCounts": "descriptorSetCount",
+	"VkSemaphoreWaitInfo.pValues":                                          "semaphoreCount",
+}
+
+type fieldKey struct {
+	structType reflect.Type
+	field      int
+}
+
+type fieldLength struct {
+	// field is the index of the field holding the length, or -1 if the pointer refers to a single
+	// element or a null-terminated string
+	field int
+	bytes bool
+}
+
+var fieldLengths sync.Map
+
+// baseStructure matches the layout of VkBaseInStructure
+type baseStructure struct {
+	sType uint32
+	pNext unsafe.Pointer
+}
+
+func structName(t reflect.Type) string {
+	name := strings.TrimPrefix(t.Name(), "_Ctype_")
+	return strings.TrimPrefix(name, "struct_")
+}
+
+func isHandle(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct && strings.HasSuffix(t.Elem().Name(), "_T")
+}
+
+func isChar(t reflect.Type) bool {
+	return t != nil && (t.Name() == "_Ctype_char" || t.Name() == "Char")
+}
+
+func isPointer(t reflect.Type) bool {
+	return t.Kind() == reflect.UnsafePointer || (t.Kind() == reflect.Ptr && !isHandle(t))
+}
+
+func stemMatches(stem, prefix string) bool {
+	if prefix == "" {
+		return false
+	}
+
+	if strings.HasPrefix(stem, prefix) {
+		return true
+	}
+	if strings.HasSuffix(prefix, "y") && strings.HasPrefix(stem, strings.TrimSuffix(prefix, "y")+"ies") {
+		return true
+	}
+	return strings.HasSuffix(prefix, "ex") && strings.HasPrefix(stem, strings.TrimSuffix(prefix, "ex")+"ices")
+}
+
+// lengthOf finds the field holding the length of the array pointed to by field i of t. Vulkan names
+// array fields after their length field, i.e. pQueueFamilyIndices follows queueFamilyIndexCount,
+// so the nearest preceding Count or Size field that shares a stem with the array is used.
+func lengthOf(t reflect.Type, i int) fieldLength {
+	key := fieldKey{structType: t, field: i}
+	if cached, ok := fieldLengths.Load(key); ok {
+		return cached.(fieldLength)
+	}
+
+	result := findLength(t, i)
+	fieldLengths.Store(key, result)
+	return result
+}
+
+func findLength(t reflect.Type, i int) fieldLength {
+	name := t.Field(i).Name
+	if override, ok := lengthOverrides[structName(t)+"."+name]; ok {
+		f, _ := t.FieldByName(override)
+		return fieldLength{field: f.Index[0]}
+	}
+
+	stem := strings.TrimLeft(name, "p")
+	if stem == "" {
+		return fieldLength{field: -1}
+	}
+	stem = strings.ToLower(stem[:1]) + stem[1:]
+
+	for j := i - 1; j >= 0; j-- {
+		f := t.Field(j)
+		if isPointer(f.Type) || f.Type.Kind() == reflect.Struct || f.Type.Kind() == reflect.Array {
+			continue
+		}
+
+		if strings.HasSuffix(f.Name, "Count") && stemMatches(stem, strings.TrimSuffix(f.Name, "Count")) {
+			return fieldLength{field: j}
+		}
+		if strings.HasSuffix(f.Name, "Size") && stemMatches(stem, strings.TrimSuffix(f.Name, "Size")) {
+			return fieldLength{field: j, bytes: true}
+		}
+	}
+
+	return fieldLength{field: -1}
+}
+
+func readScalar(p unsafe.Pointer, size uintptr) uint64 {
+	switch size {
+	case 1:
+		return uint64(*(*uint8)(p))
+	case 2:
+		return uint64(*(*uint16)(p))
+	case 4:
+		return uint64(*(*uint32)(p))
+	default:
+		return *(*uint64)(p)
+	}
+}
+
+func cString(p unsafe.Pointer) string {
+	n := 0
+	for *(*byte)(unsafe.Add(p, n)) != 0 {
+		n++
+	}
+
+	return string(unsafe.Slice((*byte)(p), n))
+}
+
+// value encodes the value of type t stored at p. If handles is true, scalar values are encoded
+// as handles.
+func (e *encoder) value(t reflect.Type, p unsafe.Pointer, handles bool) {
+	switch {
+	case isHandle(t) || handles:
+		e.byte(tagHandle)
+		e.uvarint(readScalar(p, t.Size()))
+	case t.Kind() == reflect.Struct:
+		e.byte(tagStruct)
+		e.uvarint(uint64(t.NumField()))
+		for i := 0; i < t.NumField(); i++ {
+			e.field(t, i, p)
+		}
+	case t.Kind() == reflect.Array && t.Elem().Size() == 1:
+		e.byte(tagBytes)
+		e.bytes(unsafe.Slice((*byte)(p), t.Len()))
+	case t.Kind() == reflect.Array:
+		e.byte(tagArray)
+		e.uvarint(uint64(t.Len()))
+		for i := 0; i < t.Len(); i++ {
+			e.value(t.Elem(), unsafe.Add(p, uintptr(i)*t.Elem().Size()), false)
+		}
+	case t.Kind() == reflect.Ptr:
+		e.pointer(t.Elem(), *(*unsafe.Pointer)(p), -1, false, false)
+	case t.Kind() == reflect.UnsafePointer:
+		e.byte(tagNull)
+	default:
+		e.byte(tagScalar)
+		e.uvarint(readScalar(p, t.Size()))
+	}
+}
+
+// pointer encodes n elements of type elem stored at ptr, or n bytes if bytes is true. If n is
+// negative, ptr refers to a null-terminated string if elem is a character, and a single element
+// otherwise. A nil elem indicates a void pointer, which is only captured when its size is known.
+func (e *encoder) pointer(elem reflect.Type, ptr unsafe.Pointer, n int, bytes bool, handles bool) {
+	switch {
+	case ptr == nil:
+		e.byte(tagNull)
+	case bytes && n >= 0:
+		e.byte(tagBytes)
+		e.bytes(unsafe.Slice((*byte)(ptr), n))
+	case elem == nil:
+		e.byte(tagNull)
+	case n < 0 && isChar(elem):
+		e.byte(tagString)
+		e.string(cString(ptr))
+	default:
+		if n < 0 {
+			n = 1
+		}
+
+		e.byte(tagArray)
+		e.uvarint(uint64(n))
+		for i := 0; i < n; i++ {
+			e.value(elem, unsafe.Add(ptr, uintptr(i)*elem.Size()), handles)
+		}
+	}
+}
+
+func (e *encoder) field(t reflect.Type, i int, base unsafe.Pointer) {
+	f := t.Field(i)
+	p := unsafe.Add(base, f.Offset)
+
+	if f.Name == "pNext" {
+		e.chain(*(*unsafe.Pointer)(p))
+		return
+	}
+	if !isPointer(f.Type) {
+		e.value(f.Type, p, false)
+		return
+	}
+
+	n := -1
+	l := lengthOf(t, i)
+	if l.field >= 0 {
+		lengthField := t.Field(l.field)
+		n = int(readScalar(unsafe.Add(base, lengthField.Offset), lengthField.Type.Size()))
+	}
+
+	var elem reflect.Type
+	if f.Type.Kind() == reflect.Ptr {
+		elem = f.Type.Elem()
+	}
+	e.pointer(elem, *(*unsafe.Pointer)(p), n, l.bytes, false)
+}
+
+// chain encodes the first structure in a pNext chain. Structures that are not defined in
+// vulkan_core.h are skipped.
+func (e *encoder) chain(next unsafe.Pointer) {
+	for next != nil {
+		base := (*baseStructure)(next)
+		if t, ok := structureTypes[base.sType]; ok {
+			e.byte(tagChain)
+			e.uvarint(uint64(base.sType))
+			e.value(t, next, false)
+			return
+		}
+
+		next = base.pNext
+	}
+
+	e.byte(tagNull)
+}
+
+// hasStructureType returns true if t is a structure that begins with an sType
+func hasStructureType(t reflect.Type) bool {
+	return t != nil && t.Kind() == reflect.Struct && t.NumField() > 1 && t.Field(0).Name == "sType"
+}
@@ -0,0 +1,141 @@
+package capture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"github.com/cockroachdb/errors"
+	"io"
+)
+
+// A capture file begins with the 8-byte magic string "VKNGCAPT" followed by the format version as
+// a uvarint. The remainder of the file is a sequence of records, each of which begins with a single
+// byte identifying its kind:
+//
+//   - recordDriver: a Driver was created by CreateInstanceDriver or CreateDeviceDriver. Contains the
+//     new driver's id, the id of the driver it was created from, and the instance or device handle.
+//   - recordCall: a Vulkan command was called. Contains the id of the driver it was called on, the
+//     driver.Driver method name, one value per parameter, the VkResult returned (0 for commands
+//     that do not return one), and the handles written to each handle output parameter.
+//   - recordMemory: the application wrote to mapped memory. Contains the VkDeviceMemory, the offset
+//     into the allocation, and the bytes written.
+//
+// Integers are uvarints, except VkResult which is a zig-zag varint. Strings and byte slices are
+// prefixed by their length. Parameter values are trees of tagged values, described by the tag
+// constants below, which mirror the layout of the C structures they were read from.
+
+var magic = []byte("VKNGCAPT")
+
+const formatVersion = 1
+
+const (
+	recordDriver byte = iota + 1
+	recordCall
+	recordMemory
+)
+
+const (
+	driverInstance byte = iota
+	driverDevice
+)
+
+const (
+	// tagNull is a null pointer, or a pointer that was not captured
+	tagNull byte = iota
+	// tagScalar is followed by the raw bits of a scalar value
+	tagScalar
+	// tagHandle is followed by a handle, which is remapped during replay
+	tagHandle
+	// tagStruct is followed by the number of fields, then each field
+	tagStruct
+	// tagArray is followed by the number of elements, then each element
+	tagArray
+	// tagBytes is followed by a length-prefixed byte slice
+	tagBytes
+	// tagString is followed by a length-prefixed string, without its null terminator
+	tagString
+	// tagChain is followed by a VkStructureType, then the structure it identifies
+	tagChain
+	// tagAlloc is followed by a number of elements, which should be allocated but not filled
+	tagAlloc
+)
+
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) byte(b byte) {
+	e.buf = append(e.buf, b)
+}
+
+func (e *encoder) uvarint(v uint64) {
+	var scratch [binary.MaxVarintLen64]byte
+	e.buf = append(e.buf, scratch[:binary.PutUvarint(scratch[:], v)]...)
+}
+
+func (e *encoder) varint(v int64) {
+	var scratch [binary.MaxVarintLen64]byte
+	e.buf = append(e.buf, scratch[:binary.PutVarint(scratch[:], v)]...)
+}
+
+func (e *encoder) bytes(b []byte) {
+	e.uvarint(uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+func (e *encoder) string(s string) {
+	e.uvarint(uint64(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+type decoder struct {
+	reader *bufio.Reader
+}
+
+func (d *decoder) byte() (byte, error) {
+	return d.reader.ReadByte()
+}
+
+func (d *decoder) uvarint() (uint64, error) {
+	return binary.ReadUvarint(d.reader)
+}
+
+func (d *decoder) varint() (int64, error) {
+	return binary.ReadVarint(d.reader)
+}
+
+func (d *decoder) bytes() ([]byte, error) {
+	n, err := d.uvarint()
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, n)
+	_, err = io.ReadFull(d.reader, b)
+	return b, err
+}
+
+func (d *decoder) string() (string, error) {
+	b, err := d.bytes()
+	return string(b), err
+}
+
+func (d *decoder) header() error {
+	header := make([]byte, len(magic))
+	_, err := io.ReadFull(d.reader, header)
+	if err != nil {
+		return err
+	}
+	if string(header) != string(magic) {
+		return errors.New("not a capture file")
+	}
+
+	version, err := d.uvarint()
+	if err != nil {
+		return err
+	}
+	if version != formatVersion {
+		return errors.Newf("unsupported capture format version %d", version)
+	}
+
+	return nil
+}
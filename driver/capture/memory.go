@@ -0,0 +1,144 @@
+package capture
+
+/*
+#include "../../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+// writeMapping writes the current contents of a mapped range to the capture. The recorder lock
+// must be held.
+func (r *recorder) writeMapping(memory driver.VkDeviceMemory) {
+	m, ok := r.mappings[memory]
+	if !ok {
+		return
+	}
+
+	var record encoder
+	record.byte(recordMemory)
+	record.uvarint(uint64(memory))
+	record.uvarint(m.offset)
+	record.bytes(unsafe.Slice((*byte)(m.data), m.size))
+	r.write(record.buf)
+}
+
+func (d *Driver) VkAllocateMemory(device driver.VkDevice, pAllocateInfo *driver.VkMemoryAllocateInfo, pAllocator *driver.VkAllocationCallbacks, pMemory *driver.VkDeviceMemory) (common.VkResult, error) {
+	call := d.begin("VkAllocateMemory", device, pAllocateInfo, pAllocator, pMemory)
+	res, err := d.inner.VkAllocateMemory(device, pAllocateInfo, pAllocator, pMemory)
+	d.end(call, res)
+
+	if res == core1_0.VKSuccess {
+		d.recorder.lock.Lock()
+		defer d.recorder.lock.Unlock()
+
+		d.recorder.sizes[*pMemory] = uint64((*C.VkMemoryAllocateInfo)(unsafe.Pointer(pAllocateInfo)).allocationSize)
+	}
+	return res, err
+}
+
+func (d *Driver) VkFreeMemory(device driver.VkDevice, memory driver.VkDeviceMemory, pAllocator *driver.VkAllocationCallbacks) {
+	d.recorder.lock.Lock()
+	delete(d.recorder.sizes, memory)
+	delete(d.recorder.mappings, memory)
+	d.recorder.lock.Unlock()
+
+	call := d.begin("VkFreeMemory", device, memory, pAllocator)
+	d.inner.VkFreeMemory(device, memory, pAllocator)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkMapMemory(device driver.VkDevice, memory driver.VkDeviceMemory, offset driver.VkDeviceSize, size driver.VkDeviceSize, flags driver.VkMemoryMapFlags, ppData *unsafe.Pointer) (common.VkResult, error) {
+	call := d.begin("VkMapMemory", device, memory, offset, size, flags, ppData)
+	res, err := d.inner.VkMapMemory(device, memory, offset, size, flags, ppData)
+	d.end(call, res)
+
+	if res == core1_0.VKSuccess {
+		d.recorder.lock.Lock()
+		defer d.recorder.lock.Unlock()
+
+		mappedSize := uint64(size)
+		if size == C.VK_WHOLE_SIZE {
+			mappedSize = d.recorder.sizes[memory] - uint64(offset)
+		}
+
+		d.recorder.mappings[memory] = mapping{
+			data:   *ppData,
+			offset: uint64(offset),
+			size:   mappedSize,
+		}
+	}
+	return res, err
+}
+
+func (d *Driver) VkUnmapMemory(device driver.VkDevice, memory driver.VkDeviceMemory) {
+	d.recorder.lock.Lock()
+	d.recorder.writeMapping(memory)
+	delete(d.recorder.mappings, memory)
+	d.recorder.lock.Unlock()
+
+	call := d.begin("VkUnmapMemory", device, memory)
+	d.inner.VkUnmapMemory(device, memory)
+	d.end(call, 0)
+}
+
+func (d *Driver) VkFlushMappedMemoryRanges(device driver.VkDevice, memoryRangeCount driver.Uint32, pMemoryRanges *driver.VkMappedMemoryRange) (common.VkResult, error) {
+	d.recorder.lock.Lock()
+	ranges := unsafe.Slice((*C.VkMappedMemoryRange)(unsafe.Pointer(pMemoryRanges)), memoryRangeCount)
+	for _, memoryRange := range ranges {
+		d.recorder.writeMapping(*(*driver.VkDeviceMemory)(unsafe.Pointer(&memoryRange.memory)))
+	}
+	d.recorder.lock.Unlock()
+
+	call := d.begin("VkFlushMappedMemoryRanges", device, memoryRangeCount, pMemoryRanges)
+	res, err := d.inner.VkFlushMappedMemoryRanges(device, memoryRangeCount, pMemoryRanges)
+	d.end(call, res)
+	return res, err
+}
+
+// captureMappings records the contents of every mapped range. Memory may be persistently mapped,
+// so anything the device could read must be captured before work is submitted.
+func (d *Driver) captureMappings() {
+	d.recorder.lock.Lock()
+	defer d.recorder.lock.Unlock()
+
+	for memory := range d.recorder.mappings {
+		d.recorder.writeMapping(memory)
+	}
+}
+
+func (d *Driver) VkQueueSubmit(queue driver.VkQueue, submitCount driver.Uint32, pSubmits *driver.VkSubmitInfo, fence driver.VkFence) (common.VkResult, error) {
+	d.captureMappings()
+
+	call := d.begin("VkQueueSubmit", queue, submitCount, pSubmits, fence)
+	res, err := d.inner.VkQueueSubmit(queue, submitCount, pSubmits, fence)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkQueueSubmit2(queue driver.VkQueue, submitCount driver.Uint32, pSubmits *driver.VkSubmitInfo2, fence driver.VkFence) (common.VkResult, error) {
+	d.captureMappings()
+
+	call := d.begin("VkQueueSubmit2", queue, submitCount, pSubmits, fence)
+	res, err := d.inner.VkQueueSubmit2(queue, submitCount, pSubmits, fence)
+	d.end(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateDescriptorUpdateTemplate(device driver.VkDevice, pCreateInfo *driver.VkDescriptorUpdateTemplateCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDescriptorUpdateTemplate *driver.VkDescriptorUpdateTemplate) (common.VkResult, error) {
+	call := d.begin("VkCreateDescriptorUpdateTemplate", device, pCreateInfo, pAllocator, pDescriptorUpdateTemplate)
+	res, err := d.inner.VkCreateDescriptorUpdateTemplate(device, pCreateInfo, pAllocator, pDescriptorUpdateTemplate)
+	d.end(call, res)
+
+	if res == core1_0.VKSuccess {
+		d.recorder.lock.Lock()
+		defer d.recorder.lock.Unlock()
+
+		d.recorder.templates[*pDescriptorUpdateTemplate] = newTemplateLayout(unsafe.Pointer(pCreateInfo))
+	}
+	return res, err
+}
@@ -0,0 +1,234 @@
+package capture
+
+import (
+	"bufio"
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"io"
+	"reflect"
+	"unsafe"
+)
+
+type replayMapping struct {
+	data   unsafe.Pointer
+	offset uint64
+}
+
+type replayer struct {
+	replayDecoder
+	drivers   map[uint64]driver.Driver
+	created   []driver.Driver
+	mappings  map[uint64]replayMapping
+	templates map[uint64]templateLayout
+	calls     int
+}
+
+// Replay reads a capture written by a capture Driver from reader and calls each captured command on
+// target, in the order they were captured. Handles created during replay are substituted for the
+// handles that were created during capture, and captured writes to mapped memory are repeated.
+//
+// Replay stops and returns an error if a command returns a different VkResult than it returned
+// during capture.
+func Replay(reader io.Reader, target driver.Driver) error {
+	r := &replayer{
+		replayDecoder: replayDecoder{
+			decoder: &decoder{reader: bufio.NewReader(reader)},
+			handles: make(map[uint64]uint64),
+		},
+		drivers:   map[uint64]driver.Driver{0: target},
+		mappings:  make(map[uint64]replayMapping),
+		templates: make(map[uint64]templateLayout),
+	}
+	defer r.destroy()
+
+	err := r.header()
+	if err != nil {
+		return errors.Wrap(err, "could not read capture header")
+	}
+
+	for {
+		kind, err := r.byte()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		switch kind {
+		case recordDriver:
+			err = r.replayDriver()
+		case recordCall:
+			err = r.replayCall()
+		case recordMemory:
+			err = r.replayMemory()
+		default:
+			err = errors.Newf("capture contains unknown record kind %d", kind)
+		}
+
+		r.free()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (r *replayer) destroy() {
+	for _, created := range r.created {
+		created.Destroy()
+	}
+}
+
+func (r *replayer) replayDriver() error {
+	id, err := r.uvarint()
+	if err != nil {
+		return err
+	}
+	parentID, err := r.uvarint()
+	if err != nil {
+		return err
+	}
+	kind, err := r.byte()
+	if err != nil {
+		return err
+	}
+	handle, err := r.uvarint()
+	if err != nil {
+		return err
+	}
+
+	parent, ok := r.drivers[parentID]
+	if !ok {
+		return errors.Newf("capture refers to unknown driver %d", parentID)
+	}
+
+	var created driver.Driver
+	if kind == driverInstance {
+		created, err = parent.CreateInstanceDriver(driver.VkInstance(r.remap(handle)))
+	} else {
+		created, err = parent.CreateDeviceDriver(driver.VkDevice(r.remap(handle)))
+	}
+	if err != nil {
+		return err
+	}
+
+	r.drivers[id] = created
+	r.created = append(r.created, created)
+	return nil
+}
+
+func (r *replayer) replayMemory() error {
+	memory, err := r.uvarint()
+	if err != nil {
+		return err
+	}
+	offset, err := r.uvarint()
+	if err != nil {
+		return err
+	}
+	data, err := r.bytes()
+	if err != nil {
+		return err
+	}
+
+	m, ok := r.mappings[r.remap(memory)]
+	if !ok || offset < m.offset {
+		return errors.Newf("capture wrote to VkDeviceMemory 0x%x, which is not mapped during replay", memory)
+	}
+
+	copy(unsafe.Slice((*byte)(unsafe.Add(m.data, offset-m.offset)), len(data)), data)
+	return nil
+}
+
+func (r *replayer) replayCall() error {
+	id, err := r.uvarint()
+	if err != nil {
+		return err
+	}
+	name, err := r.string()
+	if err != nil {
+		return err
+	}
+
+	target, ok := r.drivers[id]
+	if !ok {
+		return errors.Newf("capture refers to unknown driver %d", id)
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		return errors.Newf("capture contains unknown command %s", name)
+	}
+
+	r.calls++
+	method := reflect.ValueOf(target).MethodByName(name)
+	args := make([]reflect.Value, len(cmd))
+	for i := range cmd {
+		argType := method.Type().In(i)
+		slot := r.alloc(argType.Size())
+
+		err = r.value(argType, slot)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode %s call %d", name, r.calls)
+		}
+		args[i] = reflect.NewAt(argType, slot).Elem()
+	}
+
+	if name == "VkUpdateDescriptorSetWithTemplate" {
+		layout, ok := r.templates[args[2].Uint()]
+		if ok && !args[3].IsNil() {
+			layout.remapHandles(args[3].UnsafePointer(), r.remap)
+		}
+	}
+
+	results := method.Call(args)
+
+	recorded, err := r.varint()
+	if err != nil {
+		return err
+	}
+
+	var res common.VkResult
+	if len(results) == 2 {
+		res = common.VkResult(results[0].Int())
+	}
+	if res != common.VkResult(recorded) {
+		return errors.Newf("%s call %d returned %s during replay, but %s during capture", name, r.calls, res, common.VkResult(recorded))
+	}
+
+	for i, p := range cmd {
+		if p.kind != paramOut || !p.handles {
+			continue
+		}
+
+		n, err := r.uvarint()
+		if err != nil {
+			return err
+		}
+		for j := 0; j < int(n); j++ {
+			captured, err := r.uvarint()
+			if err != nil {
+				return err
+			}
+			r.handles[captured] = readScalar(unsafe.Add(args[i].UnsafePointer(), j*8), 8)
+		}
+	}
+
+	if res != core1_0.VKSuccess {
+		return nil
+	}
+
+	switch name {
+	case "VkMapMemory":
+		r.mappings[args[1].Uint()] = replayMapping{
+			data:   *(*unsafe.Pointer)(args[5].UnsafePointer()),
+			offset: args[2].Uint(),
+		}
+	case "VkUnmapMemory", "VkFreeMemory":
+		delete(r.mappings, args[1].Uint())
+	case "VkCreateDescriptorUpdateTemplate":
+		r.templates[readScalar(args[3].UnsafePointer(), 8)] = newTemplateLayout(args[1].UnsafePointer())
+	}
+
+	return nil
+}
@@ -0,0 +1,565 @@
+package capture
+
+/*
+#include "../../common/vulkan.h"
+*/
+import "C"
+import "reflect"
+
+// structureTypes maps each VkStructureType defined by vulkan_core.h to the structure it identifies,
+// so that pNext chains can be walked without knowing their contents in advance
+var structureTypes = map[uint32]reflect.Type{
+	C.VK_STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER:                                              reflect.TypeOf(C.VkBufferMemoryBarrier{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER:                                               reflect.TypeOf(C.VkImageMemoryBarrier{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_BARRIER:                                                     reflect.TypeOf(C.VkMemoryBarrier{}),
+	C.VK_STRUCTURE_TYPE_APPLICATION_INFO:                                                   reflect.TypeOf(C.VkApplicationInfo{}),
+	C.VK_STRUCTURE_TYPE_INSTANCE_CREATE_INFO:                                               reflect.TypeOf(C.VkInstanceCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_QUEUE_CREATE_INFO:                                           reflect.TypeOf(C.VkDeviceQueueCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_CREATE_INFO:                                                 reflect.TypeOf(C.VkDeviceCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_SUBMIT_INFO:                                                        reflect.TypeOf(C.VkSubmitInfo{}),
+	C.VK_STRUCTURE_TYPE_MAPPED_MEMORY_RANGE:                                                reflect.TypeOf(C.VkMappedMemoryRange{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_ALLOCATE_INFO:                                               reflect.TypeOf(C.VkMemoryAllocateInfo{}),
+	C.VK_STRUCTURE_TYPE_BIND_SPARSE_INFO:                                                   reflect.TypeOf(C.VkBindSparseInfo{}),
+	C.VK_STRUCTURE_TYPE_FENCE_CREATE_INFO:                                                  reflect.TypeOf(C.VkFenceCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_SEMAPHORE_CREATE_INFO:                                              reflect.TypeOf(C.VkSemaphoreCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_EVENT_CREATE_INFO:                                                  reflect.TypeOf(C.VkEventCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_QUERY_POOL_CREATE_INFO:                                             reflect.TypeOf(C.VkQueryPoolCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_BUFFER_CREATE_INFO:                                                 reflect.TypeOf(C.VkBufferCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_BUFFER_VIEW_CREATE_INFO:                                            reflect.TypeOf(C.VkBufferViewCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_CREATE_INFO:                                                  reflect.TypeOf(C.VkImageCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_VIEW_CREATE_INFO:                                             reflect.TypeOf(C.VkImageViewCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_SHADER_MODULE_CREATE_INFO:                                          reflect.TypeOf(C.VkShaderModuleCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_CACHE_CREATE_INFO:                                         reflect.TypeOf(C.VkPipelineCacheCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_CREATE_INFO:                                  reflect.TypeOf(C.VkPipelineShaderStageCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_COMPUTE_PIPELINE_CREATE_INFO:                                       reflect.TypeOf(C.VkComputePipelineCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_STATE_CREATE_INFO:                            reflect.TypeOf(C.VkPipelineVertexInputStateCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_INPUT_ASSEMBLY_STATE_CREATE_INFO:                          reflect.TypeOf(C.VkPipelineInputAssemblyStateCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_TESSELLATION_STATE_CREATE_INFO:                            reflect.TypeOf(C.VkPipelineTessellationStateCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_STATE_CREATE_INFO:                                reflect.TypeOf(C.VkPipelineViewportStateCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_CREATE_INFO:                           reflect.TypeOf(C.VkPipelineRasterizationStateCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_MULTISAMPLE_STATE_CREATE_INFO:                             reflect.TypeOf(C.VkPipelineMultisampleStateCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_DEPTH_STENCIL_STATE_CREATE_INFO:                           reflect.TypeOf(C.VkPipelineDepthStencilStateCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_COLOR_BLEND_STATE_CREATE_INFO:                             reflect.TypeOf(C.VkPipelineColorBlendStateCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_DYNAMIC_STATE_CREATE_INFO:                                 reflect.TypeOf(C.VkPipelineDynamicStateCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_GRAPHICS_PIPELINE_CREATE_INFO:                                      reflect.TypeOf(C.VkGraphicsPipelineCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_LAYOUT_CREATE_INFO:                                        reflect.TypeOf(C.VkPipelineLayoutCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_SAMPLER_CREATE_INFO:                                                reflect.TypeOf(C.VkSamplerCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_COPY_DESCRIPTOR_SET:                                                reflect.TypeOf(C.VkCopyDescriptorSet{}),
+	C.VK_STRUCTURE_TYPE_DESCRIPTOR_POOL_CREATE_INFO:                                        reflect.TypeOf(C.VkDescriptorPoolCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_ALLOCATE_INFO:                                       reflect.TypeOf(C.VkDescriptorSetAllocateInfo{}),
+	C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_CREATE_INFO:                                  reflect.TypeOf(C.VkDescriptorSetLayoutCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET:                                               reflect.TypeOf(C.VkWriteDescriptorSet{}),
+	C.VK_STRUCTURE_TYPE_FRAMEBUFFER_CREATE_INFO:                                            reflect.TypeOf(C.VkFramebufferCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO:                                            reflect.TypeOf(C.VkRenderPassCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_COMMAND_POOL_CREATE_INFO:                                           reflect.TypeOf(C.VkCommandPoolCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_ALLOCATE_INFO:                                       reflect.TypeOf(C.VkCommandBufferAllocateInfo{}),
+	C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_INFO:                                    reflect.TypeOf(C.VkCommandBufferInheritanceInfo{}),
+	C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_BEGIN_INFO:                                          reflect.TypeOf(C.VkCommandBufferBeginInfo{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_BEGIN_INFO:                                             reflect.TypeOf(C.VkRenderPassBeginInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_PROPERTIES:                                reflect.TypeOf(C.VkPhysicalDeviceSubgroupProperties{}),
+	C.VK_STRUCTURE_TYPE_BIND_BUFFER_MEMORY_INFO:                                            reflect.TypeOf(C.VkBindBufferMemoryInfo{}),
+	C.VK_STRUCTURE_TYPE_BIND_IMAGE_MEMORY_INFO:                                             reflect.TypeOf(C.VkBindImageMemoryInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_16BIT_STORAGE_FEATURES:                             reflect.TypeOf(C.VkPhysicalDevice16BitStorageFeatures{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_DEDICATED_REQUIREMENTS:                                      reflect.TypeOf(C.VkMemoryDedicatedRequirements{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_DEDICATED_ALLOCATE_INFO:                                     reflect.TypeOf(C.VkMemoryDedicatedAllocateInfo{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_ALLOCATE_FLAGS_INFO:                                         reflect.TypeOf(C.VkMemoryAllocateFlagsInfo{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_GROUP_RENDER_PASS_BEGIN_INFO:                                reflect.TypeOf(C.VkDeviceGroupRenderPassBeginInfo{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_GROUP_COMMAND_BUFFER_BEGIN_INFO:                             reflect.TypeOf(C.VkDeviceGroupCommandBufferBeginInfo{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_GROUP_SUBMIT_INFO:                                           reflect.TypeOf(C.VkDeviceGroupSubmitInfo{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_GROUP_BIND_SPARSE_INFO:                                      reflect.TypeOf(C.VkDeviceGroupBindSparseInfo{}),
+	C.VK_STRUCTURE_TYPE_BIND_BUFFER_MEMORY_DEVICE_GROUP_INFO:                               reflect.TypeOf(C.VkBindBufferMemoryDeviceGroupInfo{}),
+	C.VK_STRUCTURE_TYPE_BIND_IMAGE_MEMORY_DEVICE_GROUP_INFO:                                reflect.TypeOf(C.VkBindImageMemoryDeviceGroupInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_GROUP_PROPERTIES:                                   reflect.TypeOf(C.VkPhysicalDeviceGroupProperties{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_GROUP_DEVICE_CREATE_INFO:                                    reflect.TypeOf(C.VkDeviceGroupDeviceCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_BUFFER_MEMORY_REQUIREMENTS_INFO_2:                                  reflect.TypeOf(C.VkBufferMemoryRequirementsInfo2{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_MEMORY_REQUIREMENTS_INFO_2:                                   reflect.TypeOf(C.VkImageMemoryRequirementsInfo2{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_SPARSE_MEMORY_REQUIREMENTS_INFO_2:                            reflect.TypeOf(C.VkImageSparseMemoryRequirementsInfo2{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2:                                              reflect.TypeOf(C.VkMemoryRequirements2{}),
+	C.VK_STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2:                                 reflect.TypeOf(C.VkSparseImageMemoryRequirements2{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2:                                         reflect.TypeOf(C.VkPhysicalDeviceFeatures2{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2:                                       reflect.TypeOf(C.VkPhysicalDeviceProperties2{}),
+	C.VK_STRUCTURE_TYPE_FORMAT_PROPERTIES_2:                                                reflect.TypeOf(C.VkFormatProperties2{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_FORMAT_PROPERTIES_2:                                          reflect.TypeOf(C.VkImageFormatProperties2{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_FORMAT_INFO_2:                                reflect.TypeOf(C.VkPhysicalDeviceImageFormatInfo2{}),
+	C.VK_STRUCTURE_TYPE_QUEUE_FAMILY_PROPERTIES_2:                                          reflect.TypeOf(C.VkQueueFamilyProperties2{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PROPERTIES_2:                                reflect.TypeOf(C.VkPhysicalDeviceMemoryProperties2{}),
+	C.VK_STRUCTURE_TYPE_SPARSE_IMAGE_FORMAT_PROPERTIES_2:                                   reflect.TypeOf(C.VkSparseImageFormatProperties2{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SPARSE_IMAGE_FORMAT_INFO_2:                         reflect.TypeOf(C.VkPhysicalDeviceSparseImageFormatInfo2{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_POINT_CLIPPING_PROPERTIES:                          reflect.TypeOf(C.VkPhysicalDevicePointClippingProperties{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_INPUT_ATTACHMENT_ASPECT_CREATE_INFO:                    reflect.TypeOf(C.VkRenderPassInputAttachmentAspectCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_VIEW_USAGE_CREATE_INFO:                                       reflect.TypeOf(C.VkImageViewUsageCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_TESSELLATION_DOMAIN_ORIGIN_STATE_CREATE_INFO:              reflect.TypeOf(C.VkPipelineTessellationDomainOriginStateCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_MULTIVIEW_CREATE_INFO:                                  reflect.TypeOf(C.VkRenderPassMultiviewCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_FEATURES:                                 reflect.TypeOf(C.VkPhysicalDeviceMultiviewFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PROPERTIES:                               reflect.TypeOf(C.VkPhysicalDeviceMultiviewProperties{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES:                         reflect.TypeOf(C.VkPhysicalDeviceVariablePointersFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROTECTED_MEMORY_FEATURES:                          reflect.TypeOf(C.VkPhysicalDeviceProtectedMemoryFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROTECTED_MEMORY_PROPERTIES:                        reflect.TypeOf(C.VkPhysicalDeviceProtectedMemoryProperties{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_QUEUE_INFO_2:                                                reflect.TypeOf(C.VkDeviceQueueInfo2{}),
+	C.VK_STRUCTURE_TYPE_PROTECTED_SUBMIT_INFO:                                              reflect.TypeOf(C.VkProtectedSubmitInfo{}),
+	C.VK_STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_CREATE_INFO:                               reflect.TypeOf(C.VkSamplerYcbcrConversionCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_INFO:                                      reflect.TypeOf(C.VkSamplerYcbcrConversionInfo{}),
+	C.VK_STRUCTURE_TYPE_BIND_IMAGE_PLANE_MEMORY_INFO:                                       reflect.TypeOf(C.VkBindImagePlaneMemoryInfo{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_PLANE_MEMORY_REQUIREMENTS_INFO:                               reflect.TypeOf(C.VkImagePlaneMemoryRequirementsInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_YCBCR_CONVERSION_FEATURES:                  reflect.TypeOf(C.VkPhysicalDeviceSamplerYcbcrConversionFeatures{}),
+	C.VK_STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_IMAGE_FORMAT_PROPERTIES:                   reflect.TypeOf(C.VkSamplerYcbcrConversionImageFormatProperties{}),
+	C.VK_STRUCTURE_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_CREATE_INFO:                             reflect.TypeOf(C.VkDescriptorUpdateTemplateCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_IMAGE_FORMAT_INFO:                         reflect.TypeOf(C.VkPhysicalDeviceExternalImageFormatInfo{}),
+	C.VK_STRUCTURE_TYPE_EXTERNAL_IMAGE_FORMAT_PROPERTIES:                                   reflect.TypeOf(C.VkExternalImageFormatProperties{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_BUFFER_INFO:                               reflect.TypeOf(C.VkPhysicalDeviceExternalBufferInfo{}),
+	C.VK_STRUCTURE_TYPE_EXTERNAL_BUFFER_PROPERTIES:                                         reflect.TypeOf(C.VkExternalBufferProperties{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ID_PROPERTIES:                                      reflect.TypeOf(C.VkPhysicalDeviceIDProperties{}),
+	C.VK_STRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO:                                  reflect.TypeOf(C.VkExternalMemoryImageCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_EXTERNAL_MEMORY_BUFFER_CREATE_INFO:                                 reflect.TypeOf(C.VkExternalMemoryBufferCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO:                                        reflect.TypeOf(C.VkExportMemoryAllocateInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_FENCE_INFO:                                reflect.TypeOf(C.VkPhysicalDeviceExternalFenceInfo{}),
+	C.VK_STRUCTURE_TYPE_EXTERNAL_FENCE_PROPERTIES:                                          reflect.TypeOf(C.VkExternalFenceProperties{}),
+	C.VK_STRUCTURE_TYPE_EXPORT_FENCE_CREATE_INFO:                                           reflect.TypeOf(C.VkExportFenceCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_EXPORT_SEMAPHORE_CREATE_INFO:                                       reflect.TypeOf(C.VkExportSemaphoreCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_SEMAPHORE_INFO:                            reflect.TypeOf(C.VkPhysicalDeviceExternalSemaphoreInfo{}),
+	C.VK_STRUCTURE_TYPE_EXTERNAL_SEMAPHORE_PROPERTIES:                                      reflect.TypeOf(C.VkExternalSemaphoreProperties{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_3_PROPERTIES:                           reflect.TypeOf(C.VkPhysicalDeviceMaintenance3Properties{}),
+	C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_SUPPORT:                                      reflect.TypeOf(C.VkDescriptorSetLayoutSupport{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DRAW_PARAMETERS_FEATURES:                    reflect.TypeOf(C.VkPhysicalDeviceShaderDrawParametersFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_FEATURES:                                reflect.TypeOf(C.VkPhysicalDeviceVulkan11Features{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_PROPERTIES:                              reflect.TypeOf(C.VkPhysicalDeviceVulkan11Properties{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_FEATURES:                                reflect.TypeOf(C.VkPhysicalDeviceVulkan12Features{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_PROPERTIES:                              reflect.TypeOf(C.VkPhysicalDeviceVulkan12Properties{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_FORMAT_LIST_CREATE_INFO:                                      reflect.TypeOf(C.VkImageFormatListCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_2:                                           reflect.TypeOf(C.VkAttachmentDescription2{}),
+	C.VK_STRUCTURE_TYPE_ATTACHMENT_REFERENCE_2:                                             reflect.TypeOf(C.VkAttachmentReference2{}),
+	C.VK_STRUCTURE_TYPE_SUBPASS_DESCRIPTION_2:                                              reflect.TypeOf(C.VkSubpassDescription2{}),
+	C.VK_STRUCTURE_TYPE_SUBPASS_DEPENDENCY_2:                                               reflect.TypeOf(C.VkSubpassDependency2{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO_2:                                          reflect.TypeOf(C.VkRenderPassCreateInfo2{}),
+	C.VK_STRUCTURE_TYPE_SUBPASS_BEGIN_INFO:                                                 reflect.TypeOf(C.VkSubpassBeginInfo{}),
+	C.VK_STRUCTURE_TYPE_SUBPASS_END_INFO:                                                   reflect.TypeOf(C.VkSubpassEndInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_8BIT_STORAGE_FEATURES:                              reflect.TypeOf(C.VkPhysicalDevice8BitStorageFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DRIVER_PROPERTIES:                                  reflect.TypeOf(C.VkPhysicalDeviceDriverProperties{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_INT64_FEATURES:                       reflect.TypeOf(C.VkPhysicalDeviceShaderAtomicInt64Features{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURES:                       reflect.TypeOf(C.VkPhysicalDeviceShaderFloat16Int8Features{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FLOAT_CONTROLS_PROPERTIES:                          reflect.TypeOf(C.VkPhysicalDeviceFloatControlsProperties{}),
+	C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFO:                    reflect.TypeOf(C.VkDescriptorSetLayoutBindingFlagsCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_FEATURES:                       reflect.TypeOf(C.VkPhysicalDeviceDescriptorIndexingFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_PROPERTIES:                     reflect.TypeOf(C.VkPhysicalDeviceDescriptorIndexingProperties{}),
+	C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_ALLOCATE_INFO:             reflect.TypeOf(C.VkDescriptorSetVariableDescriptorCountAllocateInfo{}),
+	C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_LAYOUT_SUPPORT:            reflect.TypeOf(C.VkDescriptorSetVariableDescriptorCountLayoutSupport{}),
+	C.VK_STRUCTURE_TYPE_SUBPASS_DESCRIPTION_DEPTH_STENCIL_RESOLVE:                          reflect.TypeOf(C.VkSubpassDescriptionDepthStencilResolve{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_STENCIL_RESOLVE_PROPERTIES:                   reflect.TypeOf(C.VkPhysicalDeviceDepthStencilResolveProperties{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SCALAR_BLOCK_LAYOUT_FEATURES:                       reflect.TypeOf(C.VkPhysicalDeviceScalarBlockLayoutFeatures{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_STENCIL_USAGE_CREATE_INFO:                                    reflect.TypeOf(C.VkImageStencilUsageCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_SAMPLER_REDUCTION_MODE_CREATE_INFO:                                 reflect.TypeOf(C.VkSamplerReductionModeCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_FILTER_MINMAX_PROPERTIES:                   reflect.TypeOf(C.VkPhysicalDeviceSamplerFilterMinmaxProperties{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_MEMORY_MODEL_FEATURES:                       reflect.TypeOf(C.VkPhysicalDeviceVulkanMemoryModelFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGELESS_FRAMEBUFFER_FEATURES:                     reflect.TypeOf(C.VkPhysicalDeviceImagelessFramebufferFeatures{}),
+	C.VK_STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENT_IMAGE_INFO:                                  reflect.TypeOf(C.VkFramebufferAttachmentImageInfo{}),
+	C.VK_STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENTS_CREATE_INFO:                                reflect.TypeOf(C.VkFramebufferAttachmentsCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_ATTACHMENT_BEGIN_INFO:                                  reflect.TypeOf(C.VkRenderPassAttachmentBeginInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_UNIFORM_BUFFER_STANDARD_LAYOUT_FEATURES:            reflect.TypeOf(C.VkPhysicalDeviceUniformBufferStandardLayoutFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_EXTENDED_TYPES_FEATURES:            reflect.TypeOf(C.VkPhysicalDeviceShaderSubgroupExtendedTypesFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SEPARATE_DEPTH_STENCIL_LAYOUTS_FEATURES:            reflect.TypeOf(C.VkPhysicalDeviceSeparateDepthStencilLayoutsFeatures{}),
+	C.VK_STRUCTURE_TYPE_ATTACHMENT_REFERENCE_STENCIL_LAYOUT:                                reflect.TypeOf(C.VkAttachmentReferenceStencilLayout{}),
+	C.VK_STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_STENCIL_LAYOUT:                              reflect.TypeOf(C.VkAttachmentDescriptionStencilLayout{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_HOST_QUERY_RESET_FEATURES:                          reflect.TypeOf(C.VkPhysicalDeviceHostQueryResetFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_FEATURES:                        reflect.TypeOf(C.VkPhysicalDeviceTimelineSemaphoreFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_PROPERTIES:                      reflect.TypeOf(C.VkPhysicalDeviceTimelineSemaphoreProperties{}),
+	C.VK_STRUCTURE_TYPE_SEMAPHORE_TYPE_CREATE_INFO:                                         reflect.TypeOf(C.VkSemaphoreTypeCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_TIMELINE_SEMAPHORE_SUBMIT_INFO:                                     reflect.TypeOf(C.VkTimelineSemaphoreSubmitInfo{}),
+	C.VK_STRUCTURE_TYPE_SEMAPHORE_WAIT_INFO:                                                reflect.TypeOf(C.VkSemaphoreWaitInfo{}),
+	C.VK_STRUCTURE_TYPE_SEMAPHORE_SIGNAL_INFO:                                              reflect.TypeOf(C.VkSemaphoreSignalInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES:                     reflect.TypeOf(C.VkPhysicalDeviceBufferDeviceAddressFeatures{}),
+	C.VK_STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFO:                                         reflect.TypeOf(C.VkBufferDeviceAddressInfo{}),
+	C.VK_STRUCTURE_TYPE_BUFFER_OPAQUE_CAPTURE_ADDRESS_CREATE_INFO:                          reflect.TypeOf(C.VkBufferOpaqueCaptureAddressCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_OPAQUE_CAPTURE_ADDRESS_ALLOCATE_INFO:                        reflect.TypeOf(C.VkMemoryOpaqueCaptureAddressAllocateInfo{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_MEMORY_OPAQUE_CAPTURE_ADDRESS_INFO:                          reflect.TypeOf(C.VkDeviceMemoryOpaqueCaptureAddressInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_FEATURES:                                reflect.TypeOf(C.VkPhysicalDeviceVulkan13Features{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_3_PROPERTIES:                              reflect.TypeOf(C.VkPhysicalDeviceVulkan13Properties{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_CREATION_FEEDBACK_CREATE_INFO:                             reflect.TypeOf(C.VkPipelineCreationFeedbackCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_TERMINATE_INVOCATION_FEATURES:               reflect.TypeOf(C.VkPhysicalDeviceShaderTerminateInvocationFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TOOL_PROPERTIES:                                    reflect.TypeOf(C.VkPhysicalDeviceToolProperties{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DEMOTE_TO_HELPER_INVOCATION_FEATURES:        reflect.TypeOf(C.VkPhysicalDeviceShaderDemoteToHelperInvocationFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRIVATE_DATA_FEATURES:                              reflect.TypeOf(C.VkPhysicalDevicePrivateDataFeatures{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_PRIVATE_DATA_CREATE_INFO:                                    reflect.TypeOf(C.VkDevicePrivateDataCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PRIVATE_DATA_SLOT_CREATE_INFO:                                      reflect.TypeOf(C.VkPrivateDataSlotCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_CREATION_CACHE_CONTROL_FEATURES:           reflect.TypeOf(C.VkPhysicalDevicePipelineCreationCacheControlFeatures{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_BARRIER_2:                                                   reflect.TypeOf(C.VkMemoryBarrier2{}),
+	C.VK_STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER_2:                                            reflect.TypeOf(C.VkBufferMemoryBarrier2{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER_2:                                             reflect.TypeOf(C.VkImageMemoryBarrier2{}),
+	C.VK_STRUCTURE_TYPE_DEPENDENCY_INFO:                                                    reflect.TypeOf(C.VkDependencyInfo{}),
+	C.VK_STRUCTURE_TYPE_SEMAPHORE_SUBMIT_INFO:                                              reflect.TypeOf(C.VkSemaphoreSubmitInfo{}),
+	C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_SUBMIT_INFO:                                         reflect.TypeOf(C.VkCommandBufferSubmitInfo{}),
+	C.VK_STRUCTURE_TYPE_SUBMIT_INFO_2:                                                      reflect.TypeOf(C.VkSubmitInfo2{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SYNCHRONIZATION_2_FEATURES:                         reflect.TypeOf(C.VkPhysicalDeviceSynchronization2Features{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ZERO_INITIALIZE_WORKGROUP_MEMORY_FEATURES:          reflect.TypeOf(C.VkPhysicalDeviceZeroInitializeWorkgroupMemoryFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_ROBUSTNESS_FEATURES:                          reflect.TypeOf(C.VkPhysicalDeviceImageRobustnessFeatures{}),
+	C.VK_STRUCTURE_TYPE_BUFFER_COPY_2:                                                      reflect.TypeOf(C.VkBufferCopy2{}),
+	C.VK_STRUCTURE_TYPE_COPY_BUFFER_INFO_2:                                                 reflect.TypeOf(C.VkCopyBufferInfo2{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_COPY_2:                                                       reflect.TypeOf(C.VkImageCopy2{}),
+	C.VK_STRUCTURE_TYPE_COPY_IMAGE_INFO_2:                                                  reflect.TypeOf(C.VkCopyImageInfo2{}),
+	C.VK_STRUCTURE_TYPE_BUFFER_IMAGE_COPY_2:                                                reflect.TypeOf(C.VkBufferImageCopy2{}),
+	C.VK_STRUCTURE_TYPE_COPY_BUFFER_TO_IMAGE_INFO_2:                                        reflect.TypeOf(C.VkCopyBufferToImageInfo2{}),
+	C.VK_STRUCTURE_TYPE_COPY_IMAGE_TO_BUFFER_INFO_2:                                        reflect.TypeOf(C.VkCopyImageToBufferInfo2{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_BLIT_2:                                                       reflect.TypeOf(C.VkImageBlit2{}),
+	C.VK_STRUCTURE_TYPE_BLIT_IMAGE_INFO_2:                                                  reflect.TypeOf(C.VkBlitImageInfo2{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_RESOLVE_2:                                                    reflect.TypeOf(C.VkImageResolve2{}),
+	C.VK_STRUCTURE_TYPE_RESOLVE_IMAGE_INFO_2:                                               reflect.TypeOf(C.VkResolveImageInfo2{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_FEATURES:                     reflect.TypeOf(C.VkPhysicalDeviceSubgroupSizeControlFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_PROPERTIES:                   reflect.TypeOf(C.VkPhysicalDeviceSubgroupSizeControlProperties{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_REQUIRED_SUBGROUP_SIZE_CREATE_INFO:           reflect.TypeOf(C.VkPipelineShaderStageRequiredSubgroupSizeCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_FEATURES:                      reflect.TypeOf(C.VkPhysicalDeviceInlineUniformBlockFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_PROPERTIES:                    reflect.TypeOf(C.VkPhysicalDeviceInlineUniformBlockProperties{}),
+	C.VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_INLINE_UNIFORM_BLOCK:                          reflect.TypeOf(C.VkWriteDescriptorSetInlineUniformBlock{}),
+	C.VK_STRUCTURE_TYPE_DESCRIPTOR_POOL_INLINE_UNIFORM_BLOCK_CREATE_INFO:                   reflect.TypeOf(C.VkDescriptorPoolInlineUniformBlockCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXTURE_COMPRESSION_ASTC_HDR_FEATURES:              reflect.TypeOf(C.VkPhysicalDeviceTextureCompressionASTCHDRFeatures{}),
+	C.VK_STRUCTURE_TYPE_RENDERING_ATTACHMENT_INFO:                                          reflect.TypeOf(C.VkRenderingAttachmentInfo{}),
+	C.VK_STRUCTURE_TYPE_RENDERING_INFO:                                                     reflect.TypeOf(C.VkRenderingInfo{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_RENDERING_CREATE_INFO:                                     reflect.TypeOf(C.VkPipelineRenderingCreateInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DYNAMIC_RENDERING_FEATURES:                         reflect.TypeOf(C.VkPhysicalDeviceDynamicRenderingFeatures{}),
+	C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_RENDERING_INFO:                          reflect.TypeOf(C.VkCommandBufferInheritanceRenderingInfo{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_FEATURES:                reflect.TypeOf(C.VkPhysicalDeviceShaderIntegerDotProductFeatures{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_PROPERTIES:              reflect.TypeOf(C.VkPhysicalDeviceShaderIntegerDotProductProperties{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_PROPERTIES:                  reflect.TypeOf(C.VkPhysicalDeviceTexelBufferAlignmentProperties{}),
+	C.VK_STRUCTURE_TYPE_FORMAT_PROPERTIES_3:                                                reflect.TypeOf(C.VkFormatProperties3{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_4_FEATURES:                             reflect.TypeOf(C.VkPhysicalDeviceMaintenance4Features{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_4_PROPERTIES:                           reflect.TypeOf(C.VkPhysicalDeviceMaintenance4Properties{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_BUFFER_MEMORY_REQUIREMENTS:                                  reflect.TypeOf(C.VkDeviceBufferMemoryRequirements{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_IMAGE_MEMORY_REQUIREMENTS:                                   reflect.TypeOf(C.VkDeviceImageMemoryRequirements{}),
+	C.VK_STRUCTURE_TYPE_SWAPCHAIN_CREATE_INFO_KHR:                                          reflect.TypeOf(C.VkSwapchainCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PRESENT_INFO_KHR:                                                   reflect.TypeOf(C.VkPresentInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_SWAPCHAIN_CREATE_INFO_KHR:                                    reflect.TypeOf(C.VkImageSwapchainCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_BIND_IMAGE_MEMORY_SWAPCHAIN_INFO_KHR:                               reflect.TypeOf(C.VkBindImageMemorySwapchainInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_ACQUIRE_NEXT_IMAGE_INFO_KHR:                                        reflect.TypeOf(C.VkAcquireNextImageInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_GROUP_PRESENT_CAPABILITIES_KHR:                              reflect.TypeOf(C.VkDeviceGroupPresentCapabilitiesKHR{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_GROUP_PRESENT_INFO_KHR:                                      reflect.TypeOf(C.VkDeviceGroupPresentInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_GROUP_SWAPCHAIN_CREATE_INFO_KHR:                             reflect.TypeOf(C.VkDeviceGroupSwapchainCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_DISPLAY_MODE_CREATE_INFO_KHR:                                       reflect.TypeOf(C.VkDisplayModeCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_DISPLAY_SURFACE_CREATE_INFO_KHR:                                    reflect.TypeOf(C.VkDisplaySurfaceCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_DISPLAY_PRESENT_INFO_KHR:                                           reflect.TypeOf(C.VkDisplayPresentInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_RENDERING_FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR:                reflect.TypeOf(C.VkRenderingFragmentShadingRateAttachmentInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_RENDERING_FRAGMENT_DENSITY_MAP_ATTACHMENT_INFO_EXT:                 reflect.TypeOf(C.VkRenderingFragmentDensityMapAttachmentInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_ATTACHMENT_SAMPLE_COUNT_INFO_AMD:                                   reflect.TypeOf(C.VkAttachmentSampleCountInfoAMD{}),
+	C.VK_STRUCTURE_TYPE_MULTIVIEW_PER_VIEW_ATTRIBUTES_INFO_NVX:                             reflect.TypeOf(C.VkMultiviewPerViewAttributesInfoNVX{}),
+	C.VK_STRUCTURE_TYPE_IMPORT_MEMORY_FD_INFO_KHR:                                          reflect.TypeOf(C.VkImportMemoryFdInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_FD_PROPERTIES_KHR:                                           reflect.TypeOf(C.VkMemoryFdPropertiesKHR{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_GET_FD_INFO_KHR:                                             reflect.TypeOf(C.VkMemoryGetFdInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_IMPORT_SEMAPHORE_FD_INFO_KHR:                                       reflect.TypeOf(C.VkImportSemaphoreFdInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_SEMAPHORE_GET_FD_INFO_KHR:                                          reflect.TypeOf(C.VkSemaphoreGetFdInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PUSH_DESCRIPTOR_PROPERTIES_KHR:                     reflect.TypeOf(C.VkPhysicalDevicePushDescriptorPropertiesKHR{}),
+	C.VK_STRUCTURE_TYPE_PRESENT_REGIONS_KHR:                                                reflect.TypeOf(C.VkPresentRegionsKHR{}),
+	C.VK_STRUCTURE_TYPE_SHARED_PRESENT_SURFACE_CAPABILITIES_KHR:                            reflect.TypeOf(C.VkSharedPresentSurfaceCapabilitiesKHR{}),
+	C.VK_STRUCTURE_TYPE_IMPORT_FENCE_FD_INFO_KHR:                                           reflect.TypeOf(C.VkImportFenceFdInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_FENCE_GET_FD_INFO_KHR:                                              reflect.TypeOf(C.VkFenceGetFdInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PERFORMANCE_QUERY_FEATURES_KHR:                     reflect.TypeOf(C.VkPhysicalDevicePerformanceQueryFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PERFORMANCE_QUERY_PROPERTIES_KHR:                   reflect.TypeOf(C.VkPhysicalDevicePerformanceQueryPropertiesKHR{}),
+	C.VK_STRUCTURE_TYPE_PERFORMANCE_COUNTER_KHR:                                            reflect.TypeOf(C.VkPerformanceCounterKHR{}),
+	C.VK_STRUCTURE_TYPE_PERFORMANCE_COUNTER_DESCRIPTION_KHR:                                reflect.TypeOf(C.VkPerformanceCounterDescriptionKHR{}),
+	C.VK_STRUCTURE_TYPE_QUERY_POOL_PERFORMANCE_CREATE_INFO_KHR:                             reflect.TypeOf(C.VkQueryPoolPerformanceCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_ACQUIRE_PROFILING_LOCK_INFO_KHR:                                    reflect.TypeOf(C.VkAcquireProfilingLockInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PERFORMANCE_QUERY_SUBMIT_INFO_KHR:                                  reflect.TypeOf(C.VkPerformanceQuerySubmitInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SURFACE_INFO_2_KHR:                                 reflect.TypeOf(C.VkPhysicalDeviceSurfaceInfo2KHR{}),
+	C.VK_STRUCTURE_TYPE_SURFACE_CAPABILITIES_2_KHR:                                         reflect.TypeOf(C.VkSurfaceCapabilities2KHR{}),
+	C.VK_STRUCTURE_TYPE_SURFACE_FORMAT_2_KHR:                                               reflect.TypeOf(C.VkSurfaceFormat2KHR{}),
+	C.VK_STRUCTURE_TYPE_DISPLAY_PROPERTIES_2_KHR:                                           reflect.TypeOf(C.VkDisplayProperties2KHR{}),
+	C.VK_STRUCTURE_TYPE_DISPLAY_PLANE_PROPERTIES_2_KHR:                                     reflect.TypeOf(C.VkDisplayPlaneProperties2KHR{}),
+	C.VK_STRUCTURE_TYPE_DISPLAY_MODE_PROPERTIES_2_KHR:                                      reflect.TypeOf(C.VkDisplayModeProperties2KHR{}),
+	C.VK_STRUCTURE_TYPE_DISPLAY_PLANE_INFO_2_KHR:                                           reflect.TypeOf(C.VkDisplayPlaneInfo2KHR{}),
+	C.VK_STRUCTURE_TYPE_DISPLAY_PLANE_CAPABILITIES_2_KHR:                                   reflect.TypeOf(C.VkDisplayPlaneCapabilities2KHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CLOCK_FEATURES_KHR:                          reflect.TypeOf(C.VkPhysicalDeviceShaderClockFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_QUEUE_GLOBAL_PRIORITY_CREATE_INFO_KHR:                       reflect.TypeOf(C.VkDeviceQueueGlobalPriorityCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_GLOBAL_PRIORITY_QUERY_FEATURES_KHR:                 reflect.TypeOf(C.VkPhysicalDeviceGlobalPriorityQueryFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_QUEUE_FAMILY_GLOBAL_PRIORITY_PROPERTIES_KHR:                        reflect.TypeOf(C.VkQueueFamilyGlobalPriorityPropertiesKHR{}),
+	C.VK_STRUCTURE_TYPE_FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR:                          reflect.TypeOf(C.VkFragmentShadingRateAttachmentInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_FRAGMENT_SHADING_RATE_STATE_CREATE_INFO_KHR:               reflect.TypeOf(C.VkPipelineFragmentShadingRateStateCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_FEATURES_KHR:                 reflect.TypeOf(C.VkPhysicalDeviceFragmentShadingRateFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_PROPERTIES_KHR:               reflect.TypeOf(C.VkPhysicalDeviceFragmentShadingRatePropertiesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_KHR:                          reflect.TypeOf(C.VkPhysicalDeviceFragmentShadingRateKHR{}),
+	C.VK_STRUCTURE_TYPE_SURFACE_PROTECTED_CAPABILITIES_KHR:                                 reflect.TypeOf(C.VkSurfaceProtectedCapabilitiesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_WAIT_FEATURES_KHR:                          reflect.TypeOf(C.VkPhysicalDevicePresentWaitFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_EXECUTABLE_PROPERTIES_FEATURES_KHR:        reflect.TypeOf(C.VkPhysicalDevicePipelineExecutablePropertiesFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_INFO_KHR:                                                  reflect.TypeOf(C.VkPipelineInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_EXECUTABLE_PROPERTIES_KHR:                                 reflect.TypeOf(C.VkPipelineExecutablePropertiesKHR{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_EXECUTABLE_INFO_KHR:                                       reflect.TypeOf(C.VkPipelineExecutableInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_EXECUTABLE_STATISTIC_KHR:                                  reflect.TypeOf(C.VkPipelineExecutableStatisticKHR{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_EXECUTABLE_INTERNAL_REPRESENTATION_KHR:                    reflect.TypeOf(C.VkPipelineExecutableInternalRepresentationKHR{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_LIBRARY_CREATE_INFO_KHR:                                   reflect.TypeOf(C.VkPipelineLibraryCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PRESENT_ID_KHR:                                                     reflect.TypeOf(C.VkPresentIdKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_ID_FEATURES_KHR:                            reflect.TypeOf(C.VkPhysicalDevicePresentIdFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_QUEUE_FAMILY_CHECKPOINT_PROPERTIES_2_NV:                            reflect.TypeOf(C.VkQueueFamilyCheckpointProperties2NV{}),
+	C.VK_STRUCTURE_TYPE_CHECKPOINT_DATA_2_NV:                                               reflect.TypeOf(C.VkCheckpointData2NV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_FEATURES_KHR:           reflect.TypeOf(C.VkPhysicalDeviceFragmentShaderBarycentricFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_PROPERTIES_KHR:         reflect.TypeOf(C.VkPhysicalDeviceFragmentShaderBarycentricPropertiesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_UNIFORM_CONTROL_FLOW_FEATURES_KHR:  reflect.TypeOf(C.VkPhysicalDeviceShaderSubgroupUniformControlFlowFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_WORKGROUP_MEMORY_EXPLICIT_LAYOUT_FEATURES_KHR:      reflect.TypeOf(C.VkPhysicalDeviceWorkgroupMemoryExplicitLayoutFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_MAINTENANCE_1_FEATURES_KHR:             reflect.TypeOf(C.VkPhysicalDeviceRayTracingMaintenance1FeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_DEBUG_REPORT_CALLBACK_CREATE_INFO_EXT:                              reflect.TypeOf(C.VkDebugReportCallbackCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_RASTERIZATION_ORDER_AMD:               reflect.TypeOf(C.VkPipelineRasterizationStateRasterizationOrderAMD{}),
+	C.VK_STRUCTURE_TYPE_DEBUG_MARKER_OBJECT_NAME_INFO_EXT:                                  reflect.TypeOf(C.VkDebugMarkerObjectNameInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_DEBUG_MARKER_OBJECT_TAG_INFO_EXT:                                   reflect.TypeOf(C.VkDebugMarkerObjectTagInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_DEBUG_MARKER_MARKER_INFO_EXT:                                       reflect.TypeOf(C.VkDebugMarkerMarkerInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_DEDICATED_ALLOCATION_IMAGE_CREATE_INFO_NV:                          reflect.TypeOf(C.VkDedicatedAllocationImageCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_DEDICATED_ALLOCATION_BUFFER_CREATE_INFO_NV:                         reflect.TypeOf(C.VkDedicatedAllocationBufferCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_DEDICATED_ALLOCATION_MEMORY_ALLOCATE_INFO_NV:                       reflect.TypeOf(C.VkDedicatedAllocationMemoryAllocateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_FEATURES_EXT:                    reflect.TypeOf(C.VkPhysicalDeviceTransformFeedbackFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_PROPERTIES_EXT:                  reflect.TypeOf(C.VkPhysicalDeviceTransformFeedbackPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_STREAM_CREATE_INFO_EXT:                reflect.TypeOf(C.VkPipelineRasterizationStateStreamCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_CU_MODULE_CREATE_INFO_NVX:                                          reflect.TypeOf(C.VkCuModuleCreateInfoNVX{}),
+	C.VK_STRUCTURE_TYPE_CU_FUNCTION_CREATE_INFO_NVX:                                        reflect.TypeOf(C.VkCuFunctionCreateInfoNVX{}),
+	C.VK_STRUCTURE_TYPE_CU_LAUNCH_INFO_NVX:                                                 reflect.TypeOf(C.VkCuLaunchInfoNVX{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_VIEW_HANDLE_INFO_NVX:                                         reflect.TypeOf(C.VkImageViewHandleInfoNVX{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_VIEW_ADDRESS_PROPERTIES_NVX:                                  reflect.TypeOf(C.VkImageViewAddressPropertiesNVX{}),
+	C.VK_STRUCTURE_TYPE_TEXTURE_LOD_GATHER_FORMAT_PROPERTIES_AMD:                           reflect.TypeOf(C.VkTextureLODGatherFormatPropertiesAMD{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CORNER_SAMPLED_IMAGE_FEATURES_NV:                   reflect.TypeOf(C.VkPhysicalDeviceCornerSampledImageFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO_NV:                               reflect.TypeOf(C.VkExternalMemoryImageCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO_NV:                                     reflect.TypeOf(C.VkExportMemoryAllocateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_VALIDATION_FLAGS_EXT:                                               reflect.TypeOf(C.VkValidationFlagsEXT{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_VIEW_ASTC_DECODE_MODE_EXT:                                    reflect.TypeOf(C.VkImageViewASTCDecodeModeEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ASTC_DECODE_FEATURES_EXT:                           reflect.TypeOf(C.VkPhysicalDeviceASTCDecodeFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_ROBUSTNESS_FEATURES_EXT:                   reflect.TypeOf(C.VkPhysicalDevicePipelineRobustnessFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_ROBUSTNESS_PROPERTIES_EXT:                 reflect.TypeOf(C.VkPhysicalDevicePipelineRobustnessPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_ROBUSTNESS_CREATE_INFO_EXT:                                reflect.TypeOf(C.VkPipelineRobustnessCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_CONDITIONAL_RENDERING_BEGIN_INFO_EXT:                               reflect.TypeOf(C.VkConditionalRenderingBeginInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CONDITIONAL_RENDERING_FEATURES_EXT:                 reflect.TypeOf(C.VkPhysicalDeviceConditionalRenderingFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_CONDITIONAL_RENDERING_INFO_EXT:          reflect.TypeOf(C.VkCommandBufferInheritanceConditionalRenderingInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_W_SCALING_STATE_CREATE_INFO_NV:                   reflect.TypeOf(C.VkPipelineViewportWScalingStateCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_SURFACE_CAPABILITIES_2_EXT:                                         reflect.TypeOf(C.VkSurfaceCapabilities2EXT{}),
+	C.VK_STRUCTURE_TYPE_DISPLAY_POWER_INFO_EXT:                                             reflect.TypeOf(C.VkDisplayPowerInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_EVENT_INFO_EXT:                                              reflect.TypeOf(C.VkDeviceEventInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_DISPLAY_EVENT_INFO_EXT:                                             reflect.TypeOf(C.VkDisplayEventInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_SWAPCHAIN_COUNTER_CREATE_INFO_EXT:                                  reflect.TypeOf(C.VkSwapchainCounterCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PRESENT_TIMES_INFO_GOOGLE:                                          reflect.TypeOf(C.VkPresentTimesInfoGOOGLE{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_ATTRIBUTES_PROPERTIES_NVX:       reflect.TypeOf(C.VkPhysicalDeviceMultiviewPerViewAttributesPropertiesNVX{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_SWIZZLE_STATE_CREATE_INFO_NV:                     reflect.TypeOf(C.VkPipelineViewportSwizzleStateCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DISCARD_RECTANGLE_PROPERTIES_EXT:                   reflect.TypeOf(C.VkPhysicalDeviceDiscardRectanglePropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_DISCARD_RECTANGLE_STATE_CREATE_INFO_EXT:                   reflect.TypeOf(C.VkPipelineDiscardRectangleStateCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CONSERVATIVE_RASTERIZATION_PROPERTIES_EXT:          reflect.TypeOf(C.VkPhysicalDeviceConservativeRasterizationPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_CONSERVATIVE_STATE_CREATE_INFO_EXT:          reflect.TypeOf(C.VkPipelineRasterizationConservativeStateCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_CLIP_ENABLE_FEATURES_EXT:                     reflect.TypeOf(C.VkPhysicalDeviceDepthClipEnableFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_DEPTH_CLIP_STATE_CREATE_INFO_EXT:            reflect.TypeOf(C.VkPipelineRasterizationDepthClipStateCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_HDR_METADATA_EXT:                                                   reflect.TypeOf(C.VkHdrMetadataEXT{}),
+	C.VK_STRUCTURE_TYPE_DEBUG_UTILS_LABEL_EXT:                                              reflect.TypeOf(C.VkDebugUtilsLabelEXT{}),
+	C.VK_STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_NAME_INFO_EXT:                                   reflect.TypeOf(C.VkDebugUtilsObjectNameInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CALLBACK_DATA_EXT:                            reflect.TypeOf(C.VkDebugUtilsMessengerCallbackDataEXT{}),
+	C.VK_STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CREATE_INFO_EXT:                              reflect.TypeOf(C.VkDebugUtilsMessengerCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_TAG_INFO_EXT:                                    reflect.TypeOf(C.VkDebugUtilsObjectTagInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_SAMPLE_LOCATIONS_INFO_EXT:                                          reflect.TypeOf(C.VkSampleLocationsInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_SAMPLE_LOCATIONS_BEGIN_INFO_EXT:                        reflect.TypeOf(C.VkRenderPassSampleLocationsBeginInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_SAMPLE_LOCATIONS_STATE_CREATE_INFO_EXT:                    reflect.TypeOf(C.VkPipelineSampleLocationsStateCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLE_LOCATIONS_PROPERTIES_EXT:                    reflect.TypeOf(C.VkPhysicalDeviceSampleLocationsPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_MULTISAMPLE_PROPERTIES_EXT:                                         reflect.TypeOf(C.VkMultisamplePropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_FEATURES_EXT:              reflect.TypeOf(C.VkPhysicalDeviceBlendOperationAdvancedFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_PROPERTIES_EXT:            reflect.TypeOf(C.VkPhysicalDeviceBlendOperationAdvancedPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_COLOR_BLEND_ADVANCED_STATE_CREATE_INFO_EXT:                reflect.TypeOf(C.VkPipelineColorBlendAdvancedStateCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_COVERAGE_TO_COLOR_STATE_CREATE_INFO_NV:                    reflect.TypeOf(C.VkPipelineCoverageToColorStateCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_COVERAGE_MODULATION_STATE_CREATE_INFO_NV:                  reflect.TypeOf(C.VkPipelineCoverageModulationStateCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SM_BUILTINS_PROPERTIES_NV:                   reflect.TypeOf(C.VkPhysicalDeviceShaderSMBuiltinsPropertiesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SM_BUILTINS_FEATURES_NV:                     reflect.TypeOf(C.VkPhysicalDeviceShaderSMBuiltinsFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_DRM_FORMAT_MODIFIER_PROPERTIES_LIST_EXT:                            reflect.TypeOf(C.VkDrmFormatModifierPropertiesListEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_DRM_FORMAT_MODIFIER_INFO_EXT:                 reflect.TypeOf(C.VkPhysicalDeviceImageDrmFormatModifierInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_LIST_CREATE_INFO_EXT:                     reflect.TypeOf(C.VkImageDrmFormatModifierListCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_EXPLICIT_CREATE_INFO_EXT:                 reflect.TypeOf(C.VkImageDrmFormatModifierExplicitCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_PROPERTIES_EXT:                           reflect.TypeOf(C.VkImageDrmFormatModifierPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_DRM_FORMAT_MODIFIER_PROPERTIES_LIST_2_EXT:                          reflect.TypeOf(C.VkDrmFormatModifierPropertiesList2EXT{}),
+	C.VK_STRUCTURE_TYPE_VALIDATION_CACHE_CREATE_INFO_EXT:                                   reflect.TypeOf(C.VkValidationCacheCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_SHADER_MODULE_VALIDATION_CACHE_CREATE_INFO_EXT:                     reflect.TypeOf(C.VkShaderModuleValidationCacheCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_SHADING_RATE_IMAGE_STATE_CREATE_INFO_NV:          reflect.TypeOf(C.VkPipelineViewportShadingRateImageStateCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADING_RATE_IMAGE_FEATURES_NV:                     reflect.TypeOf(C.VkPhysicalDeviceShadingRateImageFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADING_RATE_IMAGE_PROPERTIES_NV:                   reflect.TypeOf(C.VkPhysicalDeviceShadingRateImagePropertiesNV{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_COARSE_SAMPLE_ORDER_STATE_CREATE_INFO_NV:         reflect.TypeOf(C.VkPipelineViewportCoarseSampleOrderStateCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_RAY_TRACING_SHADER_GROUP_CREATE_INFO_NV:                            reflect.TypeOf(C.VkRayTracingShaderGroupCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_RAY_TRACING_PIPELINE_CREATE_INFO_NV:                                reflect.TypeOf(C.VkRayTracingPipelineCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_GEOMETRY_TRIANGLES_NV:                                              reflect.TypeOf(C.VkGeometryTrianglesNV{}),
+	C.VK_STRUCTURE_TYPE_GEOMETRY_AABB_NV:                                                   reflect.TypeOf(C.VkGeometryAABBNV{}),
+	C.VK_STRUCTURE_TYPE_GEOMETRY_NV:                                                        reflect.TypeOf(C.VkGeometryNV{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_INFO_NV:                                     reflect.TypeOf(C.VkAccelerationStructureInfoNV{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_CREATE_INFO_NV:                              reflect.TypeOf(C.VkAccelerationStructureCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_BIND_ACCELERATION_STRUCTURE_MEMORY_INFO_NV:                         reflect.TypeOf(C.VkBindAccelerationStructureMemoryInfoNV{}),
+	C.VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_NV:                     reflect.TypeOf(C.VkWriteDescriptorSetAccelerationStructureNV{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_INFO_NV:                 reflect.TypeOf(C.VkAccelerationStructureMemoryRequirementsInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PROPERTIES_NV:                          reflect.TypeOf(C.VkPhysicalDeviceRayTracingPropertiesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_REPRESENTATIVE_FRAGMENT_TEST_FEATURES_NV:           reflect.TypeOf(C.VkPhysicalDeviceRepresentativeFragmentTestFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_REPRESENTATIVE_FRAGMENT_TEST_STATE_CREATE_INFO_NV:         reflect.TypeOf(C.VkPipelineRepresentativeFragmentTestStateCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_VIEW_IMAGE_FORMAT_INFO_EXT:                   reflect.TypeOf(C.VkPhysicalDeviceImageViewImageFormatInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_FILTER_CUBIC_IMAGE_VIEW_IMAGE_FORMAT_PROPERTIES_EXT:                reflect.TypeOf(C.VkFilterCubicImageViewImageFormatPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_IMPORT_MEMORY_HOST_POINTER_INFO_EXT:                                reflect.TypeOf(C.VkImportMemoryHostPointerInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_HOST_POINTER_PROPERTIES_EXT:                                 reflect.TypeOf(C.VkMemoryHostPointerPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_MEMORY_HOST_PROPERTIES_EXT:                reflect.TypeOf(C.VkPhysicalDeviceExternalMemoryHostPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_COMPILER_CONTROL_CREATE_INFO_AMD:                          reflect.TypeOf(C.VkPipelineCompilerControlCreateInfoAMD{}),
+	C.VK_STRUCTURE_TYPE_CALIBRATED_TIMESTAMP_INFO_EXT:                                      reflect.TypeOf(C.VkCalibratedTimestampInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_AMD:                         reflect.TypeOf(C.VkPhysicalDeviceShaderCorePropertiesAMD{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_MEMORY_OVERALLOCATION_CREATE_INFO_AMD:                       reflect.TypeOf(C.VkDeviceMemoryOverallocationCreateInfoAMD{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES_EXT:            reflect.TypeOf(C.VkPhysicalDeviceVertexAttributeDivisorPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_DIVISOR_STATE_CREATE_INFO_EXT:                reflect.TypeOf(C.VkPipelineVertexInputDivisorStateCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_FEATURES_EXT:              reflect.TypeOf(C.VkPhysicalDeviceVertexAttributeDivisorFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_FEATURES_NV:             reflect.TypeOf(C.VkPhysicalDeviceComputeShaderDerivativesFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_FEATURES_NV:                            reflect.TypeOf(C.VkPhysicalDeviceMeshShaderFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_NV:                          reflect.TypeOf(C.VkPhysicalDeviceMeshShaderPropertiesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_IMAGE_FOOTPRINT_FEATURES_NV:                 reflect.TypeOf(C.VkPhysicalDeviceShaderImageFootprintFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_EXCLUSIVE_SCISSOR_STATE_CREATE_INFO_NV:           reflect.TypeOf(C.VkPipelineViewportExclusiveScissorStateCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXCLUSIVE_SCISSOR_FEATURES_NV:                      reflect.TypeOf(C.VkPhysicalDeviceExclusiveScissorFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_QUEUE_FAMILY_CHECKPOINT_PROPERTIES_NV:                              reflect.TypeOf(C.VkQueueFamilyCheckpointPropertiesNV{}),
+	C.VK_STRUCTURE_TYPE_CHECKPOINT_DATA_NV:                                                 reflect.TypeOf(C.VkCheckpointDataNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_FUNCTIONS_2_FEATURES_INTEL:          reflect.TypeOf(C.VkPhysicalDeviceShaderIntegerFunctions2FeaturesINTEL{}),
+	C.VK_STRUCTURE_TYPE_INITIALIZE_PERFORMANCE_API_INFO_INTEL:                              reflect.TypeOf(C.VkInitializePerformanceApiInfoINTEL{}),
+	C.VK_STRUCTURE_TYPE_QUERY_POOL_PERFORMANCE_QUERY_CREATE_INFO_INTEL:                     reflect.TypeOf(C.VkQueryPoolPerformanceQueryCreateInfoINTEL{}),
+	C.VK_STRUCTURE_TYPE_PERFORMANCE_MARKER_INFO_INTEL:                                      reflect.TypeOf(C.VkPerformanceMarkerInfoINTEL{}),
+	C.VK_STRUCTURE_TYPE_PERFORMANCE_STREAM_MARKER_INFO_INTEL:                               reflect.TypeOf(C.VkPerformanceStreamMarkerInfoINTEL{}),
+	C.VK_STRUCTURE_TYPE_PERFORMANCE_OVERRIDE_INFO_INTEL:                                    reflect.TypeOf(C.VkPerformanceOverrideInfoINTEL{}),
+	C.VK_STRUCTURE_TYPE_PERFORMANCE_CONFIGURATION_ACQUIRE_INFO_INTEL:                       reflect.TypeOf(C.VkPerformanceConfigurationAcquireInfoINTEL{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PCI_BUS_INFO_PROPERTIES_EXT:                        reflect.TypeOf(C.VkPhysicalDevicePCIBusInfoPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_DISPLAY_NATIVE_HDR_SURFACE_CAPABILITIES_AMD:                        reflect.TypeOf(C.VkDisplayNativeHdrSurfaceCapabilitiesAMD{}),
+	C.VK_STRUCTURE_TYPE_SWAPCHAIN_DISPLAY_NATIVE_HDR_CREATE_INFO_AMD:                       reflect.TypeOf(C.VkSwapchainDisplayNativeHdrCreateInfoAMD{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_FEATURES_EXT:                  reflect.TypeOf(C.VkPhysicalDeviceFragmentDensityMapFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_PROPERTIES_EXT:                reflect.TypeOf(C.VkPhysicalDeviceFragmentDensityMapPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_FRAGMENT_DENSITY_MAP_CREATE_INFO_EXT:                   reflect.TypeOf(C.VkRenderPassFragmentDensityMapCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_2_AMD:                       reflect.TypeOf(C.VkPhysicalDeviceShaderCoreProperties2AMD{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COHERENT_MEMORY_FEATURES_AMD:                       reflect.TypeOf(C.VkPhysicalDeviceCoherentMemoryFeaturesAMD{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_IMAGE_ATOMIC_INT64_FEATURES_EXT:             reflect.TypeOf(C.VkPhysicalDeviceShaderImageAtomicInt64FeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_BUDGET_PROPERTIES_EXT:                       reflect.TypeOf(C.VkPhysicalDeviceMemoryBudgetPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PRIORITY_FEATURES_EXT:                       reflect.TypeOf(C.VkPhysicalDeviceMemoryPriorityFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_PRIORITY_ALLOCATE_INFO_EXT:                                  reflect.TypeOf(C.VkMemoryPriorityAllocateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEDICATED_ALLOCATION_IMAGE_ALIASING_FEATURES_NV:    reflect.TypeOf(C.VkPhysicalDeviceDedicatedAllocationImageAliasingFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES_EXT:                 reflect.TypeOf(C.VkPhysicalDeviceBufferDeviceAddressFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_CREATE_INFO_EXT:                              reflect.TypeOf(C.VkBufferDeviceAddressCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_VALIDATION_FEATURES_EXT:                                            reflect.TypeOf(C.VkValidationFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_COOPERATIVE_MATRIX_PROPERTIES_NV:                                   reflect.TypeOf(C.VkCooperativeMatrixPropertiesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_NV:                     reflect.TypeOf(C.VkPhysicalDeviceCooperativeMatrixFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_NV:                   reflect.TypeOf(C.VkPhysicalDeviceCooperativeMatrixPropertiesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COVERAGE_REDUCTION_MODE_FEATURES_NV:                reflect.TypeOf(C.VkPhysicalDeviceCoverageReductionModeFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_COVERAGE_REDUCTION_STATE_CREATE_INFO_NV:                   reflect.TypeOf(C.VkPipelineCoverageReductionStateCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_FRAMEBUFFER_MIXED_SAMPLES_COMBINATION_NV:                           reflect.TypeOf(C.VkFramebufferMixedSamplesCombinationNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_INTERLOCK_FEATURES_EXT:             reflect.TypeOf(C.VkPhysicalDeviceFragmentShaderInterlockFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_YCBCR_IMAGE_ARRAYS_FEATURES_EXT:                    reflect.TypeOf(C.VkPhysicalDeviceYcbcrImageArraysFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROVOKING_VERTEX_FEATURES_EXT:                      reflect.TypeOf(C.VkPhysicalDeviceProvokingVertexFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PROVOKING_VERTEX_PROPERTIES_EXT:                    reflect.TypeOf(C.VkPhysicalDeviceProvokingVertexPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_PROVOKING_VERTEX_STATE_CREATE_INFO_EXT:      reflect.TypeOf(C.VkPipelineRasterizationProvokingVertexStateCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_HEADLESS_SURFACE_CREATE_INFO_EXT:                                   reflect.TypeOf(C.VkHeadlessSurfaceCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LINE_RASTERIZATION_FEATURES_EXT:                    reflect.TypeOf(C.VkPhysicalDeviceLineRasterizationFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LINE_RASTERIZATION_PROPERTIES_EXT:                  reflect.TypeOf(C.VkPhysicalDeviceLineRasterizationPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_RASTERIZATION_LINE_STATE_CREATE_INFO_EXT:                  reflect.TypeOf(C.VkPipelineRasterizationLineStateCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_FEATURES_EXT:                   reflect.TypeOf(C.VkPhysicalDeviceShaderAtomicFloatFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INDEX_TYPE_UINT8_FEATURES_EXT:                      reflect.TypeOf(C.VkPhysicalDeviceIndexTypeUint8FeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_FEATURES_EXT:                reflect.TypeOf(C.VkPhysicalDeviceExtendedDynamicStateFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_2_FEATURES_EXT:                 reflect.TypeOf(C.VkPhysicalDeviceShaderAtomicFloat2FeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_NV:            reflect.TypeOf(C.VkPhysicalDeviceDeviceGeneratedCommandsPropertiesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_NV:              reflect.TypeOf(C.VkPhysicalDeviceDeviceGeneratedCommandsFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_GRAPHICS_SHADER_GROUP_CREATE_INFO_NV:                               reflect.TypeOf(C.VkGraphicsShaderGroupCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_GRAPHICS_PIPELINE_SHADER_GROUPS_CREATE_INFO_NV:                     reflect.TypeOf(C.VkGraphicsPipelineShaderGroupsCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_TOKEN_NV:                                  reflect.TypeOf(C.VkIndirectCommandsLayoutTokenNV{}),
+	C.VK_STRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_NV:                            reflect.TypeOf(C.VkIndirectCommandsLayoutCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_GENERATED_COMMANDS_INFO_NV:                                         reflect.TypeOf(C.VkGeneratedCommandsInfoNV{}),
+	C.VK_STRUCTURE_TYPE_GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_NV:                     reflect.TypeOf(C.VkGeneratedCommandsMemoryRequirementsInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INHERITED_VIEWPORT_SCISSOR_FEATURES_NV:             reflect.TypeOf(C.VkPhysicalDeviceInheritedViewportScissorFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_VIEWPORT_SCISSOR_INFO_NV:                reflect.TypeOf(C.VkCommandBufferInheritanceViewportScissorInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_FEATURES_EXT:                reflect.TypeOf(C.VkPhysicalDeviceTexelBufferAlignmentFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_TRANSFORM_BEGIN_INFO_QCOM:                              reflect.TypeOf(C.VkRenderPassTransformBeginInfoQCOM{}),
+	C.VK_STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_RENDER_PASS_TRANSFORM_INFO_QCOM:         reflect.TypeOf(C.VkCommandBufferInheritanceRenderPassTransformInfoQCOM{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_MEMORY_REPORT_FEATURES_EXT:                  reflect.TypeOf(C.VkPhysicalDeviceDeviceMemoryReportFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_MEMORY_REPORT_CALLBACK_DATA_EXT:                             reflect.TypeOf(C.VkDeviceMemoryReportCallbackDataEXT{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_DEVICE_MEMORY_REPORT_CREATE_INFO_EXT:                        reflect.TypeOf(C.VkDeviceDeviceMemoryReportCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ROBUSTNESS_2_FEATURES_EXT:                          reflect.TypeOf(C.VkPhysicalDeviceRobustness2FeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ROBUSTNESS_2_PROPERTIES_EXT:                        reflect.TypeOf(C.VkPhysicalDeviceRobustness2PropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_SAMPLER_CUSTOM_BORDER_COLOR_CREATE_INFO_EXT:                        reflect.TypeOf(C.VkSamplerCustomBorderColorCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_PROPERTIES_EXT:                 reflect.TypeOf(C.VkPhysicalDeviceCustomBorderColorPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_FEATURES_EXT:                   reflect.TypeOf(C.VkPhysicalDeviceCustomBorderColorFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DIAGNOSTICS_CONFIG_FEATURES_NV:                     reflect.TypeOf(C.VkPhysicalDeviceDiagnosticsConfigFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_DEVICE_DIAGNOSTICS_CONFIG_CREATE_INFO_NV:                           reflect.TypeOf(C.VkDeviceDiagnosticsConfigCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_FEATURES_EXT:             reflect.TypeOf(C.VkPhysicalDeviceGraphicsPipelineLibraryFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_GRAPHICS_PIPELINE_LIBRARY_PROPERTIES_EXT:           reflect.TypeOf(C.VkPhysicalDeviceGraphicsPipelineLibraryPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_GRAPHICS_PIPELINE_LIBRARY_CREATE_INFO_EXT:                          reflect.TypeOf(C.VkGraphicsPipelineLibraryCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_EARLY_AND_LATE_FRAGMENT_TESTS_FEATURES_AMD:  reflect.TypeOf(C.VkPhysicalDeviceShaderEarlyAndLateFragmentTestsFeaturesAMD{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_FEATURES_NV:            reflect.TypeOf(C.VkPhysicalDeviceFragmentShadingRateEnumsFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_PROPERTIES_NV:          reflect.TypeOf(C.VkPhysicalDeviceFragmentShadingRateEnumsPropertiesNV{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_FRAGMENT_SHADING_RATE_ENUM_STATE_CREATE_INFO_NV:           reflect.TypeOf(C.VkPipelineFragmentShadingRateEnumStateCreateInfoNV{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_MOTION_TRIANGLES_DATA_NV:           reflect.TypeOf(C.VkAccelerationStructureGeometryMotionTrianglesDataNV{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_MOTION_INFO_NV:                              reflect.TypeOf(C.VkAccelerationStructureMotionInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_MOTION_BLUR_FEATURES_NV:                reflect.TypeOf(C.VkPhysicalDeviceRayTracingMotionBlurFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_YCBCR_2_PLANE_444_FORMATS_FEATURES_EXT:             reflect.TypeOf(C.VkPhysicalDeviceYcbcr2Plane444FormatsFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_FEATURES_EXT:                reflect.TypeOf(C.VkPhysicalDeviceFragmentDensityMap2FeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_PROPERTIES_EXT:              reflect.TypeOf(C.VkPhysicalDeviceFragmentDensityMap2PropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_COPY_COMMAND_TRANSFORM_INFO_QCOM:                                   reflect.TypeOf(C.VkCopyCommandTransformInfoQCOM{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_COMPRESSION_CONTROL_FEATURES_EXT:             reflect.TypeOf(C.VkPhysicalDeviceImageCompressionControlFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_COMPRESSION_CONTROL_EXT:                                      reflect.TypeOf(C.VkImageCompressionControlEXT{}),
+	C.VK_STRUCTURE_TYPE_SUBRESOURCE_LAYOUT_2_EXT:                                           reflect.TypeOf(C.VkSubresourceLayout2EXT{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_SUBRESOURCE_2_EXT:                                            reflect.TypeOf(C.VkImageSubresource2EXT{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_COMPRESSION_PROPERTIES_EXT:                                   reflect.TypeOf(C.VkImageCompressionPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ATTACHMENT_FEEDBACK_LOOP_LAYOUT_FEATURES_EXT:       reflect.TypeOf(C.VkPhysicalDeviceAttachmentFeedbackLoopLayoutFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_4444_FORMATS_FEATURES_EXT:                          reflect.TypeOf(C.VkPhysicalDevice4444FormatsFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RASTERIZATION_ORDER_ATTACHMENT_ACCESS_FEATURES_ARM: reflect.TypeOf(C.VkPhysicalDeviceRasterizationOrderAttachmentAccessFeaturesARM{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RGBA10X6_FORMATS_FEATURES_EXT:                      reflect.TypeOf(C.VkPhysicalDeviceRGBA10X6FormatsFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MUTABLE_DESCRIPTOR_TYPE_FEATURES_VALVE:             reflect.TypeOf(C.VkPhysicalDeviceMutableDescriptorTypeFeaturesVALVE{}),
+	C.VK_STRUCTURE_TYPE_MUTABLE_DESCRIPTOR_TYPE_CREATE_INFO_VALVE:                          reflect.TypeOf(C.VkMutableDescriptorTypeCreateInfoVALVE{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_INPUT_DYNAMIC_STATE_FEATURES_EXT:            reflect.TypeOf(C.VkPhysicalDeviceVertexInputDynamicStateFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_VERTEX_INPUT_BINDING_DESCRIPTION_2_EXT:                             reflect.TypeOf(C.VkVertexInputBindingDescription2EXT{}),
+	C.VK_STRUCTURE_TYPE_VERTEX_INPUT_ATTRIBUTE_DESCRIPTION_2_EXT:                           reflect.TypeOf(C.VkVertexInputAttributeDescription2EXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DRM_PROPERTIES_EXT:                                 reflect.TypeOf(C.VkPhysicalDeviceDrmPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_CLIP_CONTROL_FEATURES_EXT:                    reflect.TypeOf(C.VkPhysicalDeviceDepthClipControlFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_VIEWPORT_DEPTH_CLIP_CONTROL_CREATE_INFO_EXT:               reflect.TypeOf(C.VkPipelineViewportDepthClipControlCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRIMITIVE_TOPOLOGY_LIST_RESTART_FEATURES_EXT:       reflect.TypeOf(C.VkPhysicalDevicePrimitiveTopologyListRestartFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_SUBPASS_SHADING_PIPELINE_CREATE_INFO_HUAWEI:                        reflect.TypeOf(C.VkSubpassShadingPipelineCreateInfoHUAWEI{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_SHADING_FEATURES_HUAWEI:                    reflect.TypeOf(C.VkPhysicalDeviceSubpassShadingFeaturesHUAWEI{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_SHADING_PROPERTIES_HUAWEI:                  reflect.TypeOf(C.VkPhysicalDeviceSubpassShadingPropertiesHUAWEI{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_INVOCATION_MASK_FEATURES_HUAWEI:                    reflect.TypeOf(C.VkPhysicalDeviceInvocationMaskFeaturesHUAWEI{}),
+	C.VK_STRUCTURE_TYPE_MEMORY_GET_REMOTE_ADDRESS_INFO_NV:                                  reflect.TypeOf(C.VkMemoryGetRemoteAddressInfoNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_MEMORY_RDMA_FEATURES_NV:                   reflect.TypeOf(C.VkPhysicalDeviceExternalMemoryRDMAFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_PROPERTIES_IDENTIFIER_EXT:                                 reflect.TypeOf(C.VkPipelinePropertiesIdentifierEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_PROPERTIES_FEATURES_EXT:                   reflect.TypeOf(C.VkPhysicalDevicePipelinePropertiesFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_FEATURES_EXT: reflect.TypeOf(C.VkPhysicalDeviceMultisampledRenderToSingleSampledFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_SUBPASS_RESOLVE_PERFORMANCE_QUERY_EXT:                              reflect.TypeOf(C.VkSubpassResolvePerformanceQueryEXT{}),
+	C.VK_STRUCTURE_TYPE_MULTISAMPLED_RENDER_TO_SINGLE_SAMPLED_INFO_EXT:                     reflect.TypeOf(C.VkMultisampledRenderToSingleSampledInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_2_FEATURES_EXT:              reflect.TypeOf(C.VkPhysicalDeviceExtendedDynamicState2FeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_COLOR_WRITE_ENABLE_FEATURES_EXT:                    reflect.TypeOf(C.VkPhysicalDeviceColorWriteEnableFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_COLOR_WRITE_CREATE_INFO_EXT:                               reflect.TypeOf(C.VkPipelineColorWriteCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PRIMITIVES_GENERATED_QUERY_FEATURES_EXT:            reflect.TypeOf(C.VkPhysicalDevicePrimitivesGeneratedQueryFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_VIEW_MIN_LOD_FEATURES_EXT:                    reflect.TypeOf(C.VkPhysicalDeviceImageViewMinLodFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_VIEW_MIN_LOD_CREATE_INFO_EXT:                                 reflect.TypeOf(C.VkImageViewMinLodCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTI_DRAW_FEATURES_EXT:                            reflect.TypeOf(C.VkPhysicalDeviceMultiDrawFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTI_DRAW_PROPERTIES_EXT:                          reflect.TypeOf(C.VkPhysicalDeviceMultiDrawPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_2D_VIEW_OF_3D_FEATURES_EXT:                   reflect.TypeOf(C.VkPhysicalDeviceImage2DViewOf3DFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_BORDER_COLOR_SWIZZLE_FEATURES_EXT:                  reflect.TypeOf(C.VkPhysicalDeviceBorderColorSwizzleFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_SAMPLER_BORDER_COLOR_COMPONENT_MAPPING_CREATE_INFO_EXT:             reflect.TypeOf(C.VkSamplerBorderColorComponentMappingCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_PAGEABLE_DEVICE_LOCAL_MEMORY_FEATURES_EXT:          reflect.TypeOf(C.VkPhysicalDevicePageableDeviceLocalMemoryFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_SET_HOST_MAPPING_FEATURES_VALVE:         reflect.TypeOf(C.VkPhysicalDeviceDescriptorSetHostMappingFeaturesVALVE{}),
+	C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_BINDING_REFERENCE_VALVE:                             reflect.TypeOf(C.VkDescriptorSetBindingReferenceVALVE{}),
+	C.VK_STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_HOST_MAPPING_INFO_VALVE:                      reflect.TypeOf(C.VkDescriptorSetLayoutHostMappingInfoVALVE{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_NON_SEAMLESS_CUBE_MAP_FEATURES_EXT:                 reflect.TypeOf(C.VkPhysicalDeviceNonSeamlessCubeMapFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_OFFSET_FEATURES_QCOM:          reflect.TypeOf(C.VkPhysicalDeviceFragmentDensityMapOffsetFeaturesQCOM{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_OFFSET_PROPERTIES_QCOM:        reflect.TypeOf(C.VkPhysicalDeviceFragmentDensityMapOffsetPropertiesQCOM{}),
+	C.VK_STRUCTURE_TYPE_SUBPASS_FRAGMENT_DENSITY_MAP_OFFSET_END_INFO_QCOM:                  reflect.TypeOf(C.VkSubpassFragmentDensityMapOffsetEndInfoQCOM{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_LINEAR_COLOR_ATTACHMENT_FEATURES_NV:                reflect.TypeOf(C.VkPhysicalDeviceLinearColorAttachmentFeaturesNV{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_COMPRESSION_CONTROL_SWAPCHAIN_FEATURES_EXT:   reflect.TypeOf(C.VkPhysicalDeviceImageCompressionControlSwapchainFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_IMAGE_VIEW_SAMPLE_WEIGHT_CREATE_INFO_QCOM:                          reflect.TypeOf(C.VkImageViewSampleWeightCreateInfoQCOM{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_PROCESSING_FEATURES_QCOM:                     reflect.TypeOf(C.VkPhysicalDeviceImageProcessingFeaturesQCOM{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_PROCESSING_PROPERTIES_QCOM:                   reflect.TypeOf(C.VkPhysicalDeviceImageProcessingPropertiesQCOM{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_MERGE_FEEDBACK_FEATURES_EXT:                reflect.TypeOf(C.VkPhysicalDeviceSubpassMergeFeedbackFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_CREATION_CONTROL_EXT:                                   reflect.TypeOf(C.VkRenderPassCreationControlEXT{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_CREATION_FEEDBACK_CREATE_INFO_EXT:                      reflect.TypeOf(C.VkRenderPassCreationFeedbackCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_RENDER_PASS_SUBPASS_FEEDBACK_CREATE_INFO_EXT:                       reflect.TypeOf(C.VkRenderPassSubpassFeedbackCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_MODULE_IDENTIFIER_FEATURES_EXT:              reflect.TypeOf(C.VkPhysicalDeviceShaderModuleIdentifierFeaturesEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_MODULE_IDENTIFIER_PROPERTIES_EXT:            reflect.TypeOf(C.VkPhysicalDeviceShaderModuleIdentifierPropertiesEXT{}),
+	C.VK_STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_MODULE_IDENTIFIER_CREATE_INFO_EXT:            reflect.TypeOf(C.VkPipelineShaderStageModuleIdentifierCreateInfoEXT{}),
+	C.VK_STRUCTURE_TYPE_SHADER_MODULE_IDENTIFIER_EXT:                                       reflect.TypeOf(C.VkShaderModuleIdentifierEXT{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_TILE_PROPERTIES_FEATURES_QCOM:                      reflect.TypeOf(C.VkPhysicalDeviceTilePropertiesFeaturesQCOM{}),
+	C.VK_STRUCTURE_TYPE_TILE_PROPERTIES_QCOM:                                               reflect.TypeOf(C.VkTilePropertiesQCOM{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_AMIGO_PROFILING_FEATURES_SEC:                       reflect.TypeOf(C.VkPhysicalDeviceAmigoProfilingFeaturesSEC{}),
+	C.VK_STRUCTURE_TYPE_AMIGO_PROFILING_SUBMIT_INFO_SEC:                                    reflect.TypeOf(C.VkAmigoProfilingSubmitInfoSEC{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_TRIANGLES_DATA_KHR:                 reflect.TypeOf(C.VkAccelerationStructureGeometryTrianglesDataKHR{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_AABBS_DATA_KHR:                     reflect.TypeOf(C.VkAccelerationStructureGeometryAabbsDataKHR{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_INSTANCES_DATA_KHR:                 reflect.TypeOf(C.VkAccelerationStructureGeometryInstancesDataKHR{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_KHR:                                reflect.TypeOf(C.VkAccelerationStructureGeometryKHR{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_BUILD_GEOMETRY_INFO_KHR:                     reflect.TypeOf(C.VkAccelerationStructureBuildGeometryInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_CREATE_INFO_KHR:                             reflect.TypeOf(C.VkAccelerationStructureCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_KHR:                    reflect.TypeOf(C.VkWriteDescriptorSetAccelerationStructureKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_FEATURES_KHR:                reflect.TypeOf(C.VkPhysicalDeviceAccelerationStructureFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_PROPERTIES_KHR:              reflect.TypeOf(C.VkPhysicalDeviceAccelerationStructurePropertiesKHR{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_DEVICE_ADDRESS_INFO_KHR:                     reflect.TypeOf(C.VkAccelerationStructureDeviceAddressInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_VERSION_INFO_KHR:                            reflect.TypeOf(C.VkAccelerationStructureVersionInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_COPY_ACCELERATION_STRUCTURE_TO_MEMORY_INFO_KHR:                     reflect.TypeOf(C.VkCopyAccelerationStructureToMemoryInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_COPY_MEMORY_TO_ACCELERATION_STRUCTURE_INFO_KHR:                     reflect.TypeOf(C.VkCopyMemoryToAccelerationStructureInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_COPY_ACCELERATION_STRUCTURE_INFO_KHR:                               reflect.TypeOf(C.VkCopyAccelerationStructureInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_ACCELERATION_STRUCTURE_BUILD_SIZES_INFO_KHR:                        reflect.TypeOf(C.VkAccelerationStructureBuildSizesInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_RAY_TRACING_SHADER_GROUP_CREATE_INFO_KHR:                           reflect.TypeOf(C.VkRayTracingShaderGroupCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_RAY_TRACING_PIPELINE_INTERFACE_CREATE_INFO_KHR:                     reflect.TypeOf(C.VkRayTracingPipelineInterfaceCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_RAY_TRACING_PIPELINE_CREATE_INFO_KHR:                               reflect.TypeOf(C.VkRayTracingPipelineCreateInfoKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_FEATURES_KHR:                  reflect.TypeOf(C.VkPhysicalDeviceRayTracingPipelineFeaturesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_PROPERTIES_KHR:                reflect.TypeOf(C.VkPhysicalDeviceRayTracingPipelinePropertiesKHR{}),
+	C.VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_QUERY_FEATURES_KHR:                             reflect.TypeOf(C.VkPhysicalDeviceRayQueryFeaturesKHR{}),
+}
@@ -0,0 +1,91 @@
+package capture
+
+/*
+#include "../../common/vulkan.h"
+*/
+import "C"
+import (
+	"unsafe"
+)
+
+type templateEntry struct {
+	descriptorType C.VkDescriptorType
+	count          uintptr
+	offset         uintptr
+	stride         uintptr
+}
+
+// templateLayout describes the data consumed by vkUpdateDescriptorSetWithTemplate for a single
+// VkDescriptorUpdateTemplate
+type templateLayout []templateEntry
+
+func newTemplateLayout(createInfo unsafe.Pointer) templateLayout {
+	info := (*C.VkDescriptorUpdateTemplateCreateInfo)(createInfo)
+	entries := unsafe.Slice(info.pDescriptorUpdateEntries, info.descriptorUpdateEntryCount)
+
+	layout := make(templateLayout, 0, len(entries))
+	for _, entry := range entries {
+		layout = append(layout, templateEntry{
+			descriptorType: entry.descriptorType,
+			count:          uintptr(entry.descriptorCount),
+			offset:         uintptr(entry.offset),
+			stride:         uintptr(entry.stride),
+		})
+	}
+
+	return layout
+}
+
+func (e templateEntry) elementSize() uintptr {
+	switch e.descriptorType {
+	case C.VK_DESCRIPTOR_TYPE_SAMPLER, C.VK_DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER, C.VK_DESCRIPTOR_TYPE_SAMPLED_IMAGE,
+		C.VK_DESCRIPTOR_TYPE_STORAGE_IMAGE, C.VK_DESCRIPTOR_TYPE_INPUT_ATTACHMENT:
+		return C.sizeof_VkDescriptorImageInfo
+	case C.VK_DESCRIPTOR_TYPE_UNIFORM_TEXEL_BUFFER, C.VK_DESCRIPTOR_TYPE_STORAGE_TEXEL_BUFFER:
+		return C.sizeof_VkBufferView
+	default:
+		return C.sizeof_VkDescriptorBufferInfo
+	}
+}
+
+func (l templateLayout) size() int {
+	var size uintptr
+	for _, entry := range l {
+		var end uintptr
+		if entry.descriptorType == C.VK_DESCRIPTOR_TYPE_INLINE_UNIFORM_BLOCK {
+			// Inline uniform blocks are a single run of descriptorCount bytes
+			end = entry.offset + entry.count
+		} else if entry.count > 0 {
+			end = entry.offset + entry.stride*(entry.count-1) + entry.elementSize()
+		}
+
+		if end > size {
+			size = end
+		}
+	}
+
+	return int(size)
+}
+
+// remapHandles replaces the handles in data, which was captured for this layout, with the
+// handles returned by remap
+func (l templateLayout) remapHandles(data unsafe.Pointer, remap func(uint64) uint64) {
+	for _, entry := range l {
+		if entry.descriptorType == C.VK_DESCRIPTOR_TYPE_INLINE_UNIFORM_BLOCK {
+			continue
+		}
+
+		for i := uintptr(0); i < entry.count; i++ {
+			element := unsafe.Add(data, entry.offset+i*entry.stride)
+
+			switch entry.elementSize() {
+			case C.sizeof_VkDescriptorImageInfo:
+				info := (*[2]uint64)(element)
+				info[0] = remap(info[0])
+				info[1] = remap(info[1])
+			default:
+				*(*uint64)(element) = remap(*(*uint64)(element))
+			}
+		}
+	}
+}
@@ -0,0 +1,784 @@
+package driver
+
+import (
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"sort"
+	"strings"
+)
+
+// ErrMissingCommand is matched by the *MissingCommandError wrapped in the error returned, or
+// panicked with, when a command that the driver did not load is called
+var ErrMissingCommand = errors.New("command is not present on this driver")
+
+// MissingCommandError indicates that a command was called that the driver's loader did not
+// provide, usually because the instance or device does not support the version that provides it.
+// It is wrapped in a *common.FunctionError whose Function is the name of the command.
+type MissingCommandError struct {
+	// Command is the name of the missing command, i.e. "vkTrimCommandPool"
+	Command string
+	// Version is the core version that provides Command
+	Version common.APIVersion
+	// Extensions are the extensions that provide Command under an aliased name, which the driver
+	// falls back to when the core command is not present, i.e. "VK_KHR_dynamic_rendering" for
+	// "vkCmdBeginRendering". It is empty for commands that are only provided by a core version.
+	Extensions []string
+}
+
+func (e *MissingCommandError) Error() string {
+	if len(e.Extensions) > 0 {
+		return fmt.Sprintf("command is not present on this driver; it is provided by Vulkan %d.%d or %s", e.Version.Major(), e.Version.Minor(), strings.Join(e.Extensions, " or "))
+	}
+
+	return fmt.Sprintf("command is not present on this driver; it is provided by Vulkan %d.%d", e.Version.Major(), e.Version.Minor())
+}
+
+func (e *MissingCommandError) Is(target error) bool {
+	return target == ErrMissingCommand
+}
+
+func missingCommand(name string) *common.FunctionError {
+	return &common.FunctionError{
+		Function: name,
+		Err: &MissingCommandError{
+			Command:    name,
+			Version:    commandVersions[name],
+			Extensions: commandExtensions[name],
+		},
+	}
+}
+
+// CommandVersion retrieves the core version that provides the command with the provided name,
+// i.e. common.Vulkan1_1 for "vkTrimCommandPool". The second return value is false if name is not
+// a command that this package can load.
+func CommandVersion(name string) (common.APIVersion, bool) {
+	version, ok := commandVersions[name]
+	return version, ok
+}
+
+// CommandExtensions retrieves the extensions that provide the command with the provided name
+// under an aliased name, which the driver falls back to when the core command is not present,
+// i.e. []string{"VK_KHR_dynamic_rendering"} for "vkCmdBeginRendering". It returns nil for commands
+// that are only provided by a core version.
+func CommandExtensions(name string) []string {
+	extensions := commandExtensions[name]
+	if extensions == nil {
+		return nil
+	}
+
+	return append([]string(nil), extensions...)
+}
+
+// SupportedCommands returns the sorted names of every command that d reports it can call with
+// Driver.HasCommand
+func SupportedCommands(d Driver) []string {
+	var commands []string
+	for name := range commandVersions {
+		if d.HasCommand(name) {
+			commands = append(commands, name)
+		}
+	}
+
+	sort.Strings(commands)
+	return commands
+}
+
+// commandExtensions maps the name of each command that the driver also loads under an extension
+// alias, as listed in func_ptrs_def.h, to the extensions that provide that alias
+var commandExtensions = map[string][]string{
+	"vkGetPhysicalDeviceToolProperties":        {"VK_EXT_tooling_info"},
+	"vkCmdBeginRendering":                      {"VK_KHR_dynamic_rendering"},
+	"vkCmdEndRendering":                        {"VK_KHR_dynamic_rendering"},
+	"vkCmdSetEvent2":                           {"VK_KHR_synchronization2"},
+	"vkCmdResetEvent2":                         {"VK_KHR_synchronization2"},
+	"vkCmdWaitEvents2":                         {"VK_KHR_synchronization2"},
+	"vkCmdPipelineBarrier2":                    {"VK_KHR_synchronization2"},
+	"vkCmdWriteTimestamp2":                     {"VK_KHR_synchronization2"},
+	"vkQueueSubmit2":                           {"VK_KHR_synchronization2"},
+	"vkCmdSetCullMode":                         {"VK_EXT_extended_dynamic_state"},
+	"vkCmdSetFrontFace":                        {"VK_EXT_extended_dynamic_state"},
+	"vkCmdSetPrimitiveTopology":                {"VK_EXT_extended_dynamic_state"},
+	"vkCmdSetViewportWithCount":                {"VK_EXT_extended_dynamic_state"},
+	"vkCmdSetScissorWithCount":                 {"VK_EXT_extended_dynamic_state"},
+	"vkCmdBindVertexBuffers2":                  {"VK_EXT_extended_dynamic_state"},
+	"vkCmdSetDepthTestEnable":                  {"VK_EXT_extended_dynamic_state"},
+	"vkCmdSetDepthWriteEnable":                 {"VK_EXT_extended_dynamic_state"},
+	"vkCmdSetDepthCompareOp":                   {"VK_EXT_extended_dynamic_state"},
+	"vkCmdSetDepthBoundsTestEnable":            {"VK_EXT_extended_dynamic_state"},
+	"vkCmdSetStencilTestEnable":                {"VK_EXT_extended_dynamic_state"},
+	"vkCmdSetStencilOp":                        {"VK_EXT_extended_dynamic_state"},
+	"vkCmdSetRasterizerDiscardEnable":          {"VK_EXT_extended_dynamic_state2"},
+	"vkCmdSetDepthBiasEnable":                  {"VK_EXT_extended_dynamic_state2"},
+	"vkCmdSetPrimitiveRestartEnable":           {"VK_EXT_extended_dynamic_state2"},
+	"vkCmdCopyBuffer2":                         {"VK_KHR_copy_commands2"},
+	"vkCmdCopyImage2":                          {"VK_KHR_copy_commands2"},
+	"vkCmdCopyBufferToImage2":                  {"VK_KHR_copy_commands2"},
+	"vkCmdCopyImageToBuffer2":                  {"VK_KHR_copy_commands2"},
+	"vkCmdBlitImage2":                          {"VK_KHR_copy_commands2"},
+	"vkCmdResolveImage2":                       {"VK_KHR_copy_commands2"},
+	"vkGetDeviceBufferMemoryRequirements":      {"VK_KHR_maintenance4"},
+	"vkGetDeviceImageMemoryRequirements":       {"VK_KHR_maintenance4"},
+	"vkGetDeviceImageSparseMemoryRequirements": {"VK_KHR_maintenance4"},
+	"vkCreatePrivateDataSlot":                  {"VK_EXT_private_data"},
+	"vkDestroyPrivateDataSlot":                 {"VK_EXT_private_data"},
+	"vkSetPrivateData":                         {"VK_EXT_private_data"},
+	"vkGetPrivateData":                         {"VK_EXT_private_data"},
+}
+
+// commandVersions maps the name of each command that can be loaded by this package to the core
+// version that provides it
+var commandVersions = map[string]common.APIVersion{
+	"vkGetInstanceProcAddr":                           common.Vulkan1_0,
+	"vkEnumerateInstanceExtensionProperties":          common.Vulkan1_0,
+	"vkEnumerateInstanceLayerProperties":              common.Vulkan1_0,
+	"vkCreateInstance":                                common.Vulkan1_0,
+	"vkCreateDevice":                                  common.Vulkan1_0,
+	"vkDestroyInstance":                               common.Vulkan1_0,
+	"vkEnumerateDeviceExtensionProperties":            common.Vulkan1_0,
+	"vkEnumerateDeviceLayerProperties":                common.Vulkan1_0,
+	"vkEnumeratePhysicalDevices":                      common.Vulkan1_0,
+	"vkGetPhysicalDeviceFeatures":                     common.Vulkan1_0,
+	"vkGetPhysicalDeviceFormatProperties":             common.Vulkan1_0,
+	"vkGetPhysicalDeviceImageFormatProperties":        common.Vulkan1_0,
+	"vkGetPhysicalDeviceMemoryProperties":             common.Vulkan1_0,
+	"vkGetPhysicalDeviceProperties":                   common.Vulkan1_0,
+	"vkGetPhysicalDeviceQueueFamilyProperties":        common.Vulkan1_0,
+	"vkGetPhysicalDeviceSparseImageFormatProperties":  common.Vulkan1_0,
+	"vkGetDeviceProcAddr":                             common.Vulkan1_0,
+	"vkAllocateCommandBuffers":                        common.Vulkan1_0,
+	"vkAllocateDescriptorSets":                        common.Vulkan1_0,
+	"vkAllocateMemory":                                common.Vulkan1_0,
+	"vkBeginCommandBuffer":                            common.Vulkan1_0,
+	"vkBindBufferMemory":                              common.Vulkan1_0,
+	"vkBindImageMemory":                               common.Vulkan1_0,
+	"vkCmdBeginQuery":                                 common.Vulkan1_0,
+	"vkCmdBeginRenderPass":                            common.Vulkan1_0,
+	"vkCmdBindDescriptorSets":                         common.Vulkan1_0,
+	"vkCmdBindIndexBuffer":                            common.Vulkan1_0,
+	"vkCmdBindPipeline":                               common.Vulkan1_0,
+	"vkCmdBindVertexBuffers":                          common.Vulkan1_0,
+	"vkCmdBlitImage":                                  common.Vulkan1_0,
+	"vkCmdClearAttachments":                           common.Vulkan1_0,
+	"vkCmdClearColorImage":                            common.Vulkan1_0,
+	"vkCmdClearDepthStencilImage":                     common.Vulkan1_0,
+	"vkCmdCopyBuffer":                                 common.Vulkan1_0,
+	"vkCmdCopyBufferToImage":                          common.Vulkan1_0,
+	"vkCmdCopyImage":                                  common.Vulkan1_0,
+	"vkCmdCopyImageToBuffer":                          common.Vulkan1_0,
+	"vkCmdCopyQueryPoolResults":                       common.Vulkan1_0,
+	"vkCmdDispatch":                                   common.Vulkan1_0,
+	"vkCmdDispatchIndirect":                           common.Vulkan1_0,
+	"vkCmdDraw":                                       common.Vulkan1_0,
+	"vkCmdDrawIndexed":                                common.Vulkan1_0,
+	"vkCmdDrawIndexedIndirect":                        common.Vulkan1_0,
+	"vkCmdDrawIndirect":                               common.Vulkan1_0,
+	"vkCmdEndQuery":                                   common.Vulkan1_0,
+	"vkCmdEndRenderPass":                              common.Vulkan1_0,
+	"vkCmdExecuteCommands":                            common.Vulkan1_0,
+	"vkCmdFillBuffer":                                 common.Vulkan1_0,
+	"vkCmdNextSubpass":                                common.Vulkan1_0,
+	"vkCmdPipelineBarrier":                            common.Vulkan1_0,
+	"vkCmdPushConstants":                              common.Vulkan1_0,
+	"vkCmdResetEvent":                                 common.Vulkan1_0,
+	"vkCmdResetQueryPool":                             common.Vulkan1_0,
+	"vkCmdResolveImage":                               common.Vulkan1_0,
+	"vkCmdSetBlendConstants":                          common.Vulkan1_0,
+	"vkCmdSetDepthBias":                               common.Vulkan1_0,
+	"vkCmdSetDepthBounds":                             common.Vulkan1_0,
+	"vkCmdSetEvent":                                   common.Vulkan1_0,
+	"vkCmdSetLineWidth":                               common.Vulkan1_0,
+	"vkCmdSetScissor":                                 common.Vulkan1_0,
+	"vkCmdSetStencilCompareMask":                      common.Vulkan1_0,
+	"vkCmdSetStencilReference":                        common.Vulkan1_0,
+	"vkCmdSetStencilWriteMask":                        common.Vulkan1_0,
+	"vkCmdSetViewport":                                common.Vulkan1_0,
+	"vkCmdUpdateBuffer":                               common.Vulkan1_0,
+	"vkCmdWaitEvents":                                 common.Vulkan1_0,
+	"vkCmdWriteTimestamp":                             common.Vulkan1_0,
+	"vkCreateBuffer":                                  common.Vulkan1_0,
+	"vkCreateBufferView":                              common.Vulkan1_0,
+	"vkCreateCommandPool":                             common.Vulkan1_0,
+	"vkCreateComputePipelines":                        common.Vulkan1_0,
+	"vkCreateDescriptorPool":                          common.Vulkan1_0,
+	"vkCreateDescriptorSetLayout":                     common.Vulkan1_0,
+	"vkCreateEvent":                                   common.Vulkan1_0,
+	"vkCreateFence":                                   common.Vulkan1_0,
+	"vkCreateFramebuffer":                             common.Vulkan1_0,
+	"vkCreateGraphicsPipelines":                       common.Vulkan1_0,
+	"vkCreateImage":                                   common.Vulkan1_0,
+	"vkCreateImageView":                               common.Vulkan1_0,
+	"vkCreatePipelineCache":                           common.Vulkan1_0,
+	"vkCreatePipelineLayout":                          common.Vulkan1_0,
+	"vkCreateQueryPool":                               common.Vulkan1_0,
+	"vkCreateRenderPass":                              common.Vulkan1_0,
+	"vkCreateSampler":                                 common.Vulkan1_0,
+	"vkCreateSemaphore":                               common.Vulkan1_0,
+	"vkCreateShaderModule":                            common.Vulkan1_0,
+	"vkDestroyBuffer":                                 common.Vulkan1_0,
+	"vkDestroyBufferView":                             common.Vulkan1_0,
+	"vkDestroyCommandPool":                            common.Vulkan1_0,
+	"vkDestroyDescriptorPool":                         common.Vulkan1_0,
+	"vkDestroyDescriptorSetLayout":                    common.Vulkan1_0,
+	"vkDestroyDevice":                                 common.Vulkan1_0,
+	"vkDestroyEvent":                                  common.Vulkan1_0,
+	"vkDestroyFence":                                  common.Vulkan1_0,
+	"vkDestroyFramebuffer":                            common.Vulkan1_0,
+	"vkDestroyImage":                                  common.Vulkan1_0,
+	"vkDestroyImageView":                              common.Vulkan1_0,
+	"vkDestroyPipeline":                               common.Vulkan1_0,
+	"vkDestroyPipelineCache":                          common.Vulkan1_0,
+	"vkDestroyPipelineLayout":                         common.Vulkan1_0,
+	"vkDestroyQueryPool":                              common.Vulkan1_0,
+	"vkDestroyRenderPass":                             common.Vulkan1_0,
+	"vkDestroySampler":                                common.Vulkan1_0,
+	"vkDestroySemaphore":                              common.Vulkan1_0,
+	"vkDestroyShaderModule":                           common.Vulkan1_0,
+	"vkDeviceWaitIdle":                                common.Vulkan1_0,
+	"vkEndCommandBuffer":                              common.Vulkan1_0,
+	"vkFlushMappedMemoryRanges":                       common.Vulkan1_0,
+	"vkFreeCommandBuffers":                            common.Vulkan1_0,
+	"vkFreeDescriptorSets":                            common.Vulkan1_0,
+	"vkFreeMemory":                                    common.Vulkan1_0,
+	"vkGetBufferMemoryRequirements":                   common.Vulkan1_0,
+	"vkGetDeviceMemoryCommitment":                     common.Vulkan1_0,
+	"vkGetDeviceQueue":                                common.Vulkan1_0,
+	"vkGetEventStatus":                                common.Vulkan1_0,
+	"vkGetFenceStatus":                                common.Vulkan1_0,
+	"vkGetImageMemoryRequirements":                    common.Vulkan1_0,
+	"vkGetImageSparseMemoryRequirements":              common.Vulkan1_0,
+	"vkGetImageSubresourceLayout":                     common.Vulkan1_0,
+	"vkGetPipelineCacheData":                          common.Vulkan1_0,
+	"vkGetQueryPoolResults":                           common.Vulkan1_0,
+	"vkGetRenderAreaGranularity":                      common.Vulkan1_0,
+	"vkInvalidateMappedMemoryRanges":                  common.Vulkan1_0,
+	"vkMapMemory":                                     common.Vulkan1_0,
+	"vkMergePipelineCaches":                           common.Vulkan1_0,
+	"vkQueueBindSparse":                               common.Vulkan1_0,
+	"vkQueueSubmit":                                   common.Vulkan1_0,
+	"vkQueueWaitIdle":                                 common.Vulkan1_0,
+	"vkResetCommandBuffer":                            common.Vulkan1_0,
+	"vkResetCommandPool":                              common.Vulkan1_0,
+	"vkResetDescriptorPool":                           common.Vulkan1_0,
+	"vkResetEvent":                                    common.Vulkan1_0,
+	"vkResetFences":                                   common.Vulkan1_0,
+	"vkSetEvent":                                      common.Vulkan1_0,
+	"vkUnmapMemory":                                   common.Vulkan1_0,
+	"vkUpdateDescriptorSets":                          common.Vulkan1_0,
+	"vkWaitForFences":                                 common.Vulkan1_0,
+	"vkEnumerateInstanceVersion":                      common.Vulkan1_1,
+	"vkEnumeratePhysicalDeviceGroups":                 common.Vulkan1_1,
+	"vkGetPhysicalDeviceFeatures2":                    common.Vulkan1_1,
+	"vkGetPhysicalDeviceProperties2":                  common.Vulkan1_1,
+	"vkGetPhysicalDeviceFormatProperties2":            common.Vulkan1_1,
+	"vkGetPhysicalDeviceImageFormatProperties2":       common.Vulkan1_1,
+	"vkGetPhysicalDeviceQueueFamilyProperties2":       common.Vulkan1_1,
+	"vkGetPhysicalDeviceMemoryProperties2":            common.Vulkan1_1,
+	"vkGetPhysicalDeviceSparseImageFormatProperties2": common.Vulkan1_1,
+	"vkGetPhysicalDeviceExternalBufferProperties":     common.Vulkan1_1,
+	"vkGetPhysicalDeviceExternalFenceProperties":      common.Vulkan1_1,
+	"vkGetPhysicalDeviceExternalSemaphoreProperties":  common.Vulkan1_1,
+	"vkBindBufferMemory2":                             common.Vulkan1_1,
+	"vkBindImageMemory2":                              common.Vulkan1_1,
+	"vkGetDeviceGroupPeerMemoryFeatures":              common.Vulkan1_1,
+	"vkCmdSetDeviceMask":                              common.Vulkan1_1,
+	"vkCmdDispatchBase":                               common.Vulkan1_1,
+	"vkGetImageMemoryRequirements2":                   common.Vulkan1_1,
+	"vkGetBufferMemoryRequirements2":                  common.Vulkan1_1,
+	"vkGetImageSparseMemoryRequirements2":             common.Vulkan1_1,
+	"vkTrimCommandPool":                               common.Vulkan1_1,
+	"vkGetDeviceQueue2":                               common.Vulkan1_1,
+	"vkCreateSamplerYcbcrConversion":                  common.Vulkan1_1,
+	"vkDestroySamplerYcbcrConversion":                 common.Vulkan1_1,
+	"vkCreateDescriptorUpdateTemplate":                common.Vulkan1_1,
+	"vkDestroyDescriptorUpdateTemplate":               common.Vulkan1_1,
+	"vkUpdateDescriptorSetWithTemplate":               common.Vulkan1_1,
+	"vkGetDescriptorSetLayoutSupport":                 common.Vulkan1_1,
+	"vkCmdDrawIndirectCount":                          common.Vulkan1_2,
+	"vkCmdDrawIndexedIndirectCount":                   common.Vulkan1_2,
+	"vkCreateRenderPass2":                             common.Vulkan1_2,
+	"vkCmdBeginRenderPass2":                           common.Vulkan1_2,
+	"vkCmdNextSubpass2":                               common.Vulkan1_2,
+	"vkCmdEndRenderPass2":                             common.Vulkan1_2,
+	"vkResetQueryPool":                                common.Vulkan1_2,
+	"vkGetSemaphoreCounterValue":                      common.Vulkan1_2,
+	"vkWaitSemaphores":                                common.Vulkan1_2,
+	"vkSignalSemaphore":                               common.Vulkan1_2,
+	"vkGetBufferDeviceAddress":                        common.Vulkan1_2,
+	"vkGetBufferOpaqueCaptureAddress":                 common.Vulkan1_2,
+	"vkGetDeviceMemoryOpaqueCaptureAddress":           common.Vulkan1_2,
+	"vkCmdBeginRendering":                             common.Vulkan1_3,
+	"vkCmdEndRendering":                               common.Vulkan1_3,
+	"vkCmdSetEvent2":                                  common.Vulkan1_3,
+	"vkCmdResetEvent2":                                common.Vulkan1_3,
+	"vkCmdWaitEvents2":                                common.Vulkan1_3,
+	"vkCmdPipelineBarrier2":                           common.Vulkan1_3,
+	"vkCmdWriteTimestamp2":                            common.Vulkan1_3,
+	"vkQueueSubmit2":                                  common.Vulkan1_3,
+	"vkCmdSetCullMode":                                common.Vulkan1_3,
+	"vkCmdSetFrontFace":                               common.Vulkan1_3,
+	"vkCmdSetPrimitiveTopology":                       common.Vulkan1_3,
+	"vkCmdSetViewportWithCount":                       common.Vulkan1_3,
+	"vkCmdSetScissorWithCount":                        common.Vulkan1_3,
+	"vkCmdBindVertexBuffers2":                         common.Vulkan1_3,
+	"vkCmdSetDepthTestEnable":                         common.Vulkan1_3,
+	"vkCmdSetDepthWriteEnable":                        common.Vulkan1_3,
+	"vkCmdSetDepthCompareOp":                          common.Vulkan1_3,
+	"vkCmdSetDepthBoundsTestEnable":                   common.Vulkan1_3,
+	"vkCmdSetStencilTestEnable":                       common.Vulkan1_3,
+	"vkCmdSetStencilOp":                               common.Vulkan1_3,
+	"vkCmdSetRasterizerDiscardEnable":                 common.Vulkan1_3,
+	"vkCmdSetDepthBiasEnable":                         common.Vulkan1_3,
+	"vkCmdSetPrimitiveRestartEnable":                  common.Vulkan1_3,
+	"vkCmdCopyBuffer2":                                common.Vulkan1_3,
+	"vkCmdCopyImage2":                                 common.Vulkan1_3,
+	"vkCmdCopyBufferToImage2":                         common.Vulkan1_3,
+	"vkCmdCopyImageToBuffer2":                         common.Vulkan1_3,
+	"vkCmdBlitImage2":                                 common.Vulkan1_3,
+	"vkCmdResolveImage2":                              common.Vulkan1_3,
+	"vkGetDeviceBufferMemoryRequirements":             common.Vulkan1_3,
+	"vkGetDeviceImageMemoryRequirements":              common.Vulkan1_3,
+	"vkGetDeviceImageSparseMemoryRequirements":        common.Vulkan1_3,
+	"vkCreatePrivateDataSlot":                         common.Vulkan1_3,
+	"vkDestroyPrivateDataSlot":                        common.Vulkan1_3,
+	"vkSetPrivateData":                                common.Vulkan1_3,
+	"vkGetPrivateData":                                common.Vulkan1_3,
+	"vkGetPhysicalDeviceToolProperties":               common.Vulkan1_3,
+}
+
+func (l *vulkanDriver) HasCommand(name string) bool {
+	switch name {
+	case "vkGetInstanceProcAddr":
+		return l.funcPtrs.vkGetInstanceProcAddr != nil
+	case "vkEnumerateInstanceExtensionProperties":
+		return l.funcPtrs.vkEnumerateInstanceExtensionProperties != nil
+	case "vkEnumerateInstanceLayerProperties":
+		return l.funcPtrs.vkEnumerateInstanceLayerProperties != nil
+	case "vkCreateInstance":
+		return l.funcPtrs.vkCreateInstance != nil
+	case "vkCreateDevice":
+		return l.funcPtrs.vkCreateDevice != nil
+	case "vkDestroyInstance":
+		return l.funcPtrs.vkDestroyInstance != nil
+	case "vkEnumerateDeviceExtensionProperties":
+		return l.funcPtrs.vkEnumerateDeviceExtensionProperties != nil
+	case "vkEnumerateDeviceLayerProperties":
+		return l.funcPtrs.vkEnumerateDeviceLayerProperties != nil
+	case "vkEnumeratePhysicalDevices":
+		return l.funcPtrs.vkEnumeratePhysicalDevices != nil
+	case "vkGetPhysicalDeviceFeatures":
+		return l.funcPtrs.vkGetPhysicalDeviceFeatures != nil
+	case "vkGetPhysicalDeviceFormatProperties":
+		return l.funcPtrs.vkGetPhysicalDeviceFormatProperties != nil
+	case "vkGetPhysicalDeviceImageFormatProperties":
+		return l.funcPtrs.vkGetPhysicalDeviceImageFormatProperties != nil
+	case "vkGetPhysicalDeviceMemoryProperties":
+		return l.funcPtrs.vkGetPhysicalDeviceMemoryProperties != nil
+	case "vkGetPhysicalDeviceProperties":
+		return l.funcPtrs.vkGetPhysicalDeviceProperties != nil
+	case "vkGetPhysicalDeviceQueueFamilyProperties":
+		return l.funcPtrs.vkGetPhysicalDeviceQueueFamilyProperties != nil
+	case "vkGetPhysicalDeviceSparseImageFormatProperties":
+		return l.funcPtrs.vkGetPhysicalDeviceSparseImageFormatProperties != nil
+	case "vkGetDeviceProcAddr":
+		return l.funcPtrs.vkGetDeviceProcAddr != nil
+	case "vkAllocateCommandBuffers":
+		return l.funcPtrs.vkAllocateCommandBuffers != nil
+	case "vkAllocateDescriptorSets":
+		return l.funcPtrs.vkAllocateDescriptorSets != nil
+	case "vkAllocateMemory":
+		return l.funcPtrs.vkAllocateMemory != nil
+	case "vkBeginCommandBuffer":
+		return l.funcPtrs.vkBeginCommandBuffer != nil
+	case "vkBindBufferMemory":
+		return l.funcPtrs.vkBindBufferMemory != nil
+	case "vkBindImageMemory":
+		return l.funcPtrs.vkBindImageMemory != nil
+	case "vkCmdBeginQuery":
+		return l.funcPtrs.vkCmdBeginQuery != nil
+	case "vkCmdBeginRenderPass":
+		return l.funcPtrs.vkCmdBeginRenderPass != nil
+	case "vkCmdBindDescriptorSets":
+		return l.funcPtrs.vkCmdBindDescriptorSets != nil
+	case "vkCmdBindIndexBuffer":
+		return l.funcPtrs.vkCmdBindIndexBuffer != nil
+	case "vkCmdBindPipeline":
+		return l.funcPtrs.vkCmdBindPipeline != nil
+	case "vkCmdBindVertexBuffers":
+		return l.funcPtrs.vkCmdBindVertexBuffers != nil
+	case "vkCmdBlitImage":
+		return l.funcPtrs.vkCmdBlitImage != nil
+	case "vkCmdClearAttachments":
+		return l.funcPtrs.vkCmdClearAttachments != nil
+	case "vkCmdClearColorImage":
+		return l.funcPtrs.vkCmdClearColorImage != nil
+	case "vkCmdClearDepthStencilImage":
+		return l.funcPtrs.vkCmdClearDepthStencilImage != nil
+	case "vkCmdCopyBuffer":
+		return l.funcPtrs.vkCmdCopyBuffer != nil
+	case "vkCmdCopyBufferToImage":
+		return l.funcPtrs.vkCmdCopyBufferToImage != nil
+	case "vkCmdCopyImage":
+		return l.funcPtrs.vkCmdCopyImage != nil
+	case "vkCmdCopyImageToBuffer":
+		return l.funcPtrs.vkCmdCopyImageToBuffer != nil
+	case "vkCmdCopyQueryPoolResults":
+		return l.funcPtrs.vkCmdCopyQueryPoolResults != nil
+	case "vkCmdDispatch":
+		return l.funcPtrs.vkCmdDispatch != nil
+	case "vkCmdDispatchIndirect":
+		return l.funcPtrs.vkCmdDispatchIndirect != nil
+	case "vkCmdDraw":
+		return l.funcPtrs.vkCmdDraw != nil
+	case "vkCmdDrawIndexed":
+		return l.funcPtrs.vkCmdDrawIndexed != nil
+	case "vkCmdDrawIndexedIndirect":
+		return l.funcPtrs.vkCmdDrawIndexedIndirect != nil
+	case "vkCmdDrawIndirect":
+		return l.funcPtrs.vkCmdDrawIndirect != nil
+	case "vkCmdEndQuery":
+		return l.funcPtrs.vkCmdEndQuery != nil
+	case "vkCmdEndRenderPass":
+		return l.funcPtrs.vkCmdEndRenderPass != nil
+	case "vkCmdExecuteCommands":
+		return l.funcPtrs.vkCmdExecuteCommands != nil
+	case "vkCmdFillBuffer":
+		return l.funcPtrs.vkCmdFillBuffer != nil
+	case "vkCmdNextSubpass":
+		return l.funcPtrs.vkCmdNextSubpass != nil
+	case "vkCmdPipelineBarrier":
+		return l.funcPtrs.vkCmdPipelineBarrier != nil
+	case "vkCmdPushConstants":
+		return l.funcPtrs.vkCmdPushConstants != nil
+	case "vkCmdResetEvent":
+		return l.funcPtrs.vkCmdResetEvent != nil
+	case "vkCmdResetQueryPool":
+		return l.funcPtrs.vkCmdResetQueryPool != nil
+	case "vkCmdResolveImage":
+		return l.funcPtrs.vkCmdResolveImage != nil
+	case "vkCmdSetBlendConstants":
+		return l.funcPtrs.vkCmdSetBlendConstants != nil
+	case "vkCmdSetDepthBias":
+		return l.funcPtrs.vkCmdSetDepthBias != nil
+	case "vkCmdSetDepthBounds":
+		return l.funcPtrs.vkCmdSetDepthBounds != nil
+	case "vkCmdSetEvent":
+		return l.funcPtrs.vkCmdSetEvent != nil
+	case "vkCmdSetLineWidth":
+		return l.funcPtrs.vkCmdSetLineWidth != nil
+	case "vkCmdSetScissor":
+		return l.funcPtrs.vkCmdSetScissor != nil
+	case "vkCmdSetStencilCompareMask":
+		return l.funcPtrs.vkCmdSetStencilCompareMask != nil
+	case "vkCmdSetStencilReference":
+		return l.funcPtrs.vkCmdSetStencilReference != nil
+	case "vkCmdSetStencilWriteMask":
+		return l.funcPtrs.vkCmdSetStencilWriteMask != nil
+	case "vkCmdSetViewport":
+		return l.funcPtrs.vkCmdSetViewport != nil
+	case "vkCmdUpdateBuffer":
+		return l.funcPtrs.vkCmdUpdateBuffer != nil
+	case "vkCmdWaitEvents":
+		return l.funcPtrs.vkCmdWaitEvents != nil
+	case "vkCmdWriteTimestamp":
+		return l.funcPtrs.vkCmdWriteTimestamp != nil
+	case "vkCreateBuffer":
+		return l.funcPtrs.vkCreateBuffer != nil
+	case "vkCreateBufferView":
+		return l.funcPtrs.vkCreateBufferView != nil
+	case "vkCreateCommandPool":
+		return l.funcPtrs.vkCreateCommandPool != nil
+	case "vkCreateComputePipelines":
+		return l.funcPtrs.vkCreateComputePipelines != nil
+	case "vkCreateDescriptorPool":
+		return l.funcPtrs.vkCreateDescriptorPool != nil
+	case "vkCreateDescriptorSetLayout":
+		return l.funcPtrs.vkCreateDescriptorSetLayout != nil
+	case "vkCreateEvent":
+		return l.funcPtrs.vkCreateEvent != nil
+	case "vkCreateFence":
+		return l.funcPtrs.vkCreateFence != nil
+	case "vkCreateFramebuffer":
+		return l.funcPtrs.vkCreateFramebuffer != nil
+	case "vkCreateGraphicsPipelines":
+		return l.funcPtrs.vkCreateGraphicsPipelines != nil
+	case "vkCreateImage":
+		return l.funcPtrs.vkCreateImage != nil
+	case "vkCreateImageView":
+		return l.funcPtrs.vkCreateImageView != nil
+	case "vkCreatePipelineCache":
+		return l.funcPtrs.vkCreatePipelineCache != nil
+	case "vkCreatePipelineLayout":
+		return l.funcPtrs.vkCreatePipelineLayout != nil
+	case "vkCreateQueryPool":
+		return l.funcPtrs.vkCreateQueryPool != nil
+	case "vkCreateRenderPass":
+		return l.funcPtrs.vkCreateRenderPass != nil
+	case "vkCreateSampler":
+		return l.funcPtrs.vkCreateSampler != nil
+	case "vkCreateSemaphore":
+		return l.funcPtrs.vkCreateSemaphore != nil
+	case "vkCreateShaderModule":
+		return l.funcPtrs.vkCreateShaderModule != nil
+	case "vkDestroyBuffer":
+		return l.funcPtrs.vkDestroyBuffer != nil
+	case "vkDestroyBufferView":
+		return l.funcPtrs.vkDestroyBufferView != nil
+	case "vkDestroyCommandPool":
+		return l.funcPtrs.vkDestroyCommandPool != nil
+	case "vkDestroyDescriptorPool":
+		return l.funcPtrs.vkDestroyDescriptorPool != nil
+	case "vkDestroyDescriptorSetLayout":
+		return l.funcPtrs.vkDestroyDescriptorSetLayout != nil
+	case "vkDestroyDevice":
+		return l.funcPtrs.vkDestroyDevice != nil
+	case "vkDestroyEvent":
+		return l.funcPtrs.vkDestroyEvent != nil
+	case "vkDestroyFence":
+		return l.funcPtrs.vkDestroyFence != nil
+	case "vkDestroyFramebuffer":
+		return l.funcPtrs.vkDestroyFramebuffer != nil
+	case "vkDestroyImage":
+		return l.funcPtrs.vkDestroyImage != nil
+	case "vkDestroyImageView":
+		return l.funcPtrs.vkDestroyImageView != nil
+	case "vkDestroyPipeline":
+		return l.funcPtrs.vkDestroyPipeline != nil
+	case "vkDestroyPipelineCache":
+		return l.funcPtrs.vkDestroyPipelineCache != nil
+	case "vkDestroyPipelineLayout":
+		return l.funcPtrs.vkDestroyPipelineLayout != nil
+	case "vkDestroyQueryPool":
+		return l.funcPtrs.vkDestroyQueryPool != nil
+	case "vkDestroyRenderPass":
+		return l.funcPtrs.vkDestroyRenderPass != nil
+	case "vkDestroySampler":
+		return l.funcPtrs.vkDestroySampler != nil
+	case "vkDestroySemaphore":
+		return l.funcPtrs.vkDestroySemaphore != nil
+	case "vkDestroyShaderModule":
+		return l.funcPtrs.vkDestroyShaderModule != nil
+	case "vkDeviceWaitIdle":
+		return l.funcPtrs.vkDeviceWaitIdle != nil
+	case "vkEndCommandBuffer":
+		return l.funcPtrs.vkEndCommandBuffer != nil
+	case "vkFlushMappedMemoryRanges":
+		return l.funcPtrs.vkFlushMappedMemoryRanges != nil
+	case "vkFreeCommandBuffers":
+		return l.funcPtrs.vkFreeCommandBuffers != nil
+	case "vkFreeDescriptorSets":
+		return l.funcPtrs.vkFreeDescriptorSets != nil
+	case "vkFreeMemory":
+		return l.funcPtrs.vkFreeMemory != nil
+	case "vkGetBufferMemoryRequirements":
+		return l.funcPtrs.vkGetBufferMemoryRequirements != nil
+	case "vkGetDeviceMemoryCommitment":
+		return l.funcPtrs.vkGetDeviceMemoryCommitment != nil
+	case "vkGetDeviceQueue":
+		return l.funcPtrs.vkGetDeviceQueue != nil
+	case "vkGetEventStatus":
+		return l.funcPtrs.vkGetEventStatus != nil
+	case "vkGetFenceStatus":
+		return l.funcPtrs.vkGetFenceStatus != nil
+	case "vkGetImageMemoryRequirements":
+		return l.funcPtrs.vkGetImageMemoryRequirements != nil
+	case "vkGetImageSparseMemoryRequirements":
+		return l.funcPtrs.vkGetImageSparseMemoryRequirements != nil
+	case "vkGetImageSubresourceLayout":
+		return l.funcPtrs.vkGetImageSubresourceLayout != nil
+	case "vkGetPipelineCacheData":
+		return l.funcPtrs.vkGetPipelineCacheData != nil
+	case "vkGetQueryPoolResults":
+		return l.funcPtrs.vkGetQueryPoolResults != nil
+	case "vkGetRenderAreaGranularity":
+		return l.funcPtrs.vkGetRenderAreaGranularity != nil
+	case "vkInvalidateMappedMemoryRanges":
+		return l.funcPtrs.vkInvalidateMappedMemoryRanges != nil
+	case "vkMapMemory":
+		return l.funcPtrs.vkMapMemory != nil
+	case "vkMergePipelineCaches":
+		return l.funcPtrs.vkMergePipelineCaches != nil
+	case "vkQueueBindSparse":
+		return l.funcPtrs.vkQueueBindSparse != nil
+	case "vkQueueSubmit":
+		return l.funcPtrs.vkQueueSubmit != nil
+	case "vkQueueWaitIdle":
+		return l.funcPtrs.vkQueueWaitIdle != nil
+	case "vkResetCommandBuffer":
+		return l.funcPtrs.vkResetCommandBuffer != nil
+	case "vkResetCommandPool":
+		return l.funcPtrs.vkResetCommandPool != nil
+	case "vkResetDescriptorPool":
+		return l.funcPtrs.vkResetDescriptorPool != nil
+	case "vkResetEvent":
+		return l.funcPtrs.vkResetEvent != nil
+	case "vkResetFences":
+		return l.funcPtrs.vkResetFences != nil
+	case "vkSetEvent":
+		return l.funcPtrs.vkSetEvent != nil
+	case "vkUnmapMemory":
+		return l.funcPtrs.vkUnmapMemory != nil
+	case "vkUpdateDescriptorSets":
+		return l.funcPtrs.vkUpdateDescriptorSets != nil
+	case "vkWaitForFences":
+		return l.funcPtrs.vkWaitForFences != nil
+	case "vkEnumerateInstanceVersion":
+		return l.funcPtrs.vkEnumerateInstanceVersion != nil
+	case "vkEnumeratePhysicalDeviceGroups":
+		return l.funcPtrs.vkEnumeratePhysicalDeviceGroups != nil
+	case "vkGetPhysicalDeviceFeatures2":
+		return l.funcPtrs.vkGetPhysicalDeviceFeatures2 != nil
+	case "vkGetPhysicalDeviceProperties2":
+		return l.funcPtrs.vkGetPhysicalDeviceProperties2 != nil
+	case "vkGetPhysicalDeviceFormatProperties2":
+		return l.funcPtrs.vkGetPhysicalDeviceFormatProperties2 != nil
+	case "vkGetPhysicalDeviceImageFormatProperties2":
+		return l.funcPtrs.vkGetPhysicalDeviceImageFormatProperties2 != nil
+	case "vkGetPhysicalDeviceQueueFamilyProperties2":
+		return l.funcPtrs.vkGetPhysicalDeviceQueueFamilyProperties2 != nil
+	case "vkGetPhysicalDeviceMemoryProperties2":
+		return l.funcPtrs.vkGetPhysicalDeviceMemoryProperties2 != nil
+	case "vkGetPhysicalDeviceSparseImageFormatProperties2":
+		return l.funcPtrs.vkGetPhysicalDeviceSparseImageFormatProperties2 != nil
+	case "vkGetPhysicalDeviceExternalBufferProperties":
+		return l.funcPtrs.vkGetPhysicalDeviceExternalBufferProperties != nil
+	case "vkGetPhysicalDeviceExternalFenceProperties":
+		return l.funcPtrs.vkGetPhysicalDeviceExternalFenceProperties != nil
+	case "vkGetPhysicalDeviceExternalSemaphoreProperties":
+		return l.funcPtrs.vkGetPhysicalDeviceExternalSemaphoreProperties != nil
+	case "vkBindBufferMemory2":
+		return l.funcPtrs.vkBindBufferMemory2 != nil
+	case "vkBindImageMemory2":
+		return l.funcPtrs.vkBindImageMemory2 != nil
+	case "vkGetDeviceGroupPeerMemoryFeatures":
+		return l.funcPtrs.vkGetDeviceGroupPeerMemoryFeatures != nil
+	case "vkCmdSetDeviceMask":
+		return l.funcPtrs.vkCmdSetDeviceMask != nil
+	case "vkCmdDispatchBase":
+		return l.funcPtrs.vkCmdDispatchBase != nil
+	case "vkGetImageMemoryRequirements2":
+		return l.funcPtrs.vkGetImageMemoryRequirements2 != nil
+	case "vkGetBufferMemoryRequirements2":
+		return l.funcPtrs.vkGetBufferMemoryRequirements2 != nil
+	case "vkGetImageSparseMemoryRequirements2":
+		return l.funcPtrs.vkGetImageSparseMemoryRequirements2 != nil
+	case "vkTrimCommandPool":
+		return l.funcPtrs.vkTrimCommandPool != nil
+	case "vkGetDeviceQueue2":
+		return l.funcPtrs.vkGetDeviceQueue2 != nil
+	case "vkCreateSamplerYcbcrConversion":
+		return l.funcPtrs.vkCreateSamplerYcbcrConversion != nil
+	case "vkDestroySamplerYcbcrConversion":
+		return l.funcPtrs.vkDestroySamplerYcbcrConversion != nil
+	case "vkCreateDescriptorUpdateTemplate":
+		return l.funcPtrs.vkCreateDescriptorUpdateTemplate != nil
+	case "vkDestroyDescriptorUpdateTemplate":
+		return l.funcPtrs.vkDestroyDescriptorUpdateTemplate != nil
+	case "vkUpdateDescriptorSetWithTemplate":
+		return l.funcPtrs.vkUpdateDescriptorSetWithTemplate != nil
+	case "vkGetDescriptorSetLayoutSupport":
+		return l.funcPtrs.vkGetDescriptorSetLayoutSupport != nil
+	case "vkCmdDrawIndirectCount":
+		return l.funcPtrs.vkCmdDrawIndirectCount != nil
+	case "vkCmdDrawIndexedIndirectCount":
+		return l.funcPtrs.vkCmdDrawIndexedIndirectCount != nil
+	case "vkCreateRenderPass2":
+		return l.funcPtrs.vkCreateRenderPass2 != nil
+	case "vkCmdBeginRenderPass2":
+		return l.funcPtrs.vkCmdBeginRenderPass2 != nil
+	case "vkCmdNextSubpass2":
+		return l.funcPtrs.vkCmdNextSubpass2 != nil
+	case "vkCmdEndRenderPass2":
+		return l.funcPtrs.vkCmdEndRenderPass2 != nil
+	case "vkResetQueryPool":
+		return l.funcPtrs.vkResetQueryPool != nil
+	case "vkGetSemaphoreCounterValue":
+		return l.funcPtrs.vkGetSemaphoreCounterValue != nil
+	case "vkWaitSemaphores":
+		return l.funcPtrs.vkWaitSemaphores != nil
+	case "vkSignalSemaphore":
+		return l.funcPtrs.vkSignalSemaphore != nil
+	case "vkGetBufferDeviceAddress":
+		return l.funcPtrs.vkGetBufferDeviceAddress != nil
+	case "vkGetBufferOpaqueCaptureAddress":
+		return l.funcPtrs.vkGetBufferOpaqueCaptureAddress != nil
+	case "vkGetDeviceMemoryOpaqueCaptureAddress":
+		return l.funcPtrs.vkGetDeviceMemoryOpaqueCaptureAddress != nil
+	case "vkCmdBeginRendering":
+		return l.funcPtrs.vkCmdBeginRendering != nil
+	case "vkCmdEndRendering":
+		return l.funcPtrs.vkCmdEndRendering != nil
+	case "vkCmdSetEvent2":
+		return l.funcPtrs.vkCmdSetEvent2 != nil
+	case "vkCmdResetEvent2":
+		return l.funcPtrs.vkCmdResetEvent2 != nil
+	case "vkCmdWaitEvents2":
+		return l.funcPtrs.vkCmdWaitEvents2 != nil
+	case "vkCmdPipelineBarrier2":
+		return l.funcPtrs.vkCmdPipelineBarrier2 != nil
+	case "vkCmdWriteTimestamp2":
+		return l.funcPtrs.vkCmdWriteTimestamp2 != nil
+	case "vkQueueSubmit2":
+		return l.funcPtrs.vkQueueSubmit2 != nil
+	case "vkCmdSetCullMode":
+		return l.funcPtrs.vkCmdSetCullMode != nil
+	case "vkCmdSetFrontFace":
+		return l.funcPtrs.vkCmdSetFrontFace != nil
+	case "vkCmdSetPrimitiveTopology":
+		return l.funcPtrs.vkCmdSetPrimitiveTopology != nil
+	case "vkCmdSetViewportWithCount":
+		return l.funcPtrs.vkCmdSetViewportWithCount != nil
+	case "vkCmdSetScissorWithCount":
+		return l.funcPtrs.vkCmdSetScissorWithCount != nil
+	case "vkCmdBindVertexBuffers2":
+		return l.funcPtrs.vkCmdBindVertexBuffers2 != nil
+	case "vkCmdSetDepthTestEnable":
+		return l.funcPtrs.vkCmdSetDepthTestEnable != nil
+	case "vkCmdSetDepthWriteEnable":
+		return l.funcPtrs.vkCmdSetDepthWriteEnable != nil
+	case "vkCmdSetDepthCompareOp":
+		return l.funcPtrs.vkCmdSetDepthCompareOp != nil
+	case "vkCmdSetDepthBoundsTestEnable":
+		return l.funcPtrs.vkCmdSetDepthBoundsTestEnable != nil
+	case "vkCmdSetStencilTestEnable":
+		return l.funcPtrs.vkCmdSetStencilTestEnable != nil
+	case "vkCmdSetStencilOp":
+		return l.funcPtrs.vkCmdSetStencilOp != nil
+	case "vkCmdSetRasterizerDiscardEnable":
+		return l.funcPtrs.vkCmdSetRasterizerDiscardEnable != nil
+	case "vkCmdSetDepthBiasEnable":
+		return l.funcPtrs.vkCmdSetDepthBiasEnable != nil
+	case "vkCmdSetPrimitiveRestartEnable":
+		return l.funcPtrs.vkCmdSetPrimitiveRestartEnable != nil
+	case "vkCmdCopyBuffer2":
+		return l.funcPtrs.vkCmdCopyBuffer2 != nil
+	case "vkCmdCopyImage2":
+		return l.funcPtrs.vkCmdCopyImage2 != nil
+	case "vkCmdCopyBufferToImage2":
+		return l.funcPtrs.vkCmdCopyBufferToImage2 != nil
+	case "vkCmdCopyImageToBuffer2":
+		return l.funcPtrs.vkCmdCopyImageToBuffer2 != nil
+	case "vkCmdBlitImage2":
+		return l.funcPtrs.vkCmdBlitImage2 != nil
+	case "vkCmdResolveImage2":
+		return l.funcPtrs.vkCmdResolveImage2 != nil
+	case "vkGetDeviceBufferMemoryRequirements":
+		return l.funcPtrs.vkGetDeviceBufferMemoryRequirements != nil
+	case "vkGetDeviceImageMemoryRequirements":
+		return l.funcPtrs.vkGetDeviceImageMemoryRequirements != nil
+	case "vkGetDeviceImageSparseMemoryRequirements":
+		return l.funcPtrs.vkGetDeviceImageSparseMemoryRequirements != nil
+	case "vkCreatePrivateDataSlot":
+		return l.funcPtrs.vkCreatePrivateDataSlot != nil
+	case "vkDestroyPrivateDataSlot":
+		return l.funcPtrs.vkDestroyPrivateDataSlot != nil
+	case "vkSetPrivateData":
+		return l.funcPtrs.vkSetPrivateData != nil
+	case "vkGetPrivateData":
+		return l.funcPtrs.vkGetPrivateData != nil
+	case "vkGetPhysicalDeviceToolProperties":
+		return l.funcPtrs.vkGetPhysicalDeviceToolProperties != nil
+	}
+
+	return false
+}
@@ -0,0 +1,79 @@
+package driver_test
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"testing"
+)
+
+func TestCommandVersion(t *testing.T) {
+	version, ok := driver.CommandVersion("vkCreateBuffer")
+	require.True(t, ok)
+	require.Equal(t, common.Vulkan1_0, version)
+
+	version, ok = driver.CommandVersion("vkTrimCommandPool")
+	require.True(t, ok)
+	require.Equal(t, common.Vulkan1_1, version)
+
+	version, ok = driver.CommandVersion("vkWaitSemaphores")
+	require.True(t, ok)
+	require.Equal(t, common.Vulkan1_2, version)
+
+	_, ok = driver.CommandVersion("vkNotACommand")
+	require.False(t, ok)
+}
+
+func TestCommandExtensions(t *testing.T) {
+	require.Equal(t, []string{"VK_KHR_dynamic_rendering"}, driver.CommandExtensions("vkCmdBeginRendering"))
+	require.Equal(t, []string{"VK_EXT_extended_dynamic_state2"}, driver.CommandExtensions("vkCmdSetDepthBiasEnable"))
+	require.Nil(t, driver.CommandExtensions("vkTrimCommandPool"))
+	require.Nil(t, driver.CommandExtensions("vkNotACommand"))
+}
+
+func TestSupportedCommands(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{APIVersion: common.Vulkan1_0})
+
+	commands := driver.SupportedCommands(fakeDriver)
+	require.Contains(t, commands, "vkCreateBuffer")
+	require.NotContains(t, commands, "vkTrimCommandPool")
+	require.True(t, fakeDriver.HasCommand("vkCreateBuffer"))
+	require.False(t, fakeDriver.HasCommand("vkTrimCommandPool"))
+	require.False(t, fakeDriver.HasCommand("vkNotACommand"))
+
+	fakeDriver = fake.NewDriver(fake.Config{APIVersion: common.Vulkan1_2})
+	require.Contains(t, driver.SupportedCommands(fakeDriver), "vkTrimCommandPool")
+}
+
+func TestMissingCommandError(t *testing.T) {
+	var err error = &common.FunctionError{
+		Function: "vkTrimCommandPool",
+		Err: &driver.MissingCommandError{
+			Command: "vkTrimCommandPool",
+			Version: common.Vulkan1_1,
+		},
+	}
+
+	require.True(t, errors.Is(err, driver.ErrMissingCommand))
+	require.EqualError(t, err, "vkTrimCommandPool: command is not present on this driver; it is provided by Vulkan 1.1")
+
+	var missing *driver.MissingCommandError
+	require.True(t, errors.As(err, &missing))
+	require.Equal(t, common.Vulkan1_1, missing.Version)
+}
+
+func TestMissingCommandError_Extensions(t *testing.T) {
+	var err error = &common.FunctionError{
+		Function: "vkCmdBeginRendering",
+		Err: &driver.MissingCommandError{
+			Command:    "vkCmdBeginRendering",
+			Version:    common.Vulkan1_3,
+			Extensions: []string{"VK_KHR_dynamic_rendering"},
+		},
+	}
+
+	require.True(t, errors.Is(err, driver.ErrMissingCommand))
+	require.EqualError(t, err, "vkCmdBeginRendering: command is not present on this driver; it is provided by Vulkan 1.3 or VK_KHR_dynamic_rendering")
+}
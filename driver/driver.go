@@ -18,7 +18,6 @@ PFN_vkVoidFunction device_proc_addr(DriverFuncPtrs *funcPtrs, VkDevice device, c
 */
 import "C"
 import (
-	"github.com/cockroachdb/errors"
 	"github.com/vkngwrapper/core/v2/common"
 	"unsafe"
 )
@@ -82,7 +81,7 @@ func (l *vulkanDriver) CreateInstanceDriver(instance VkInstance) (Driver, error)
 
 func (l *vulkanDriver) CreateDeviceDriver(device VkDevice) (Driver, error) {
 	if l.instance == VkInstance(NullHandle) {
-		return nil, errors.New("attempted to call instance driver function on a basic driver")
+		return nil, &common.FunctionError{Function: "CreateDeviceDriver", Err: ErrNotInstanceDriver}
 	}
 
 	deviceFuncPtrs := (*C.DriverFuncPtrs)(C.malloc(C.sizeof_struct_DriverFuncPtrs))
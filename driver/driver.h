@@ -704,4 +704,152 @@ uint64_t cgoGetDeviceMemoryOpaqueCaptureAddress(PFN_vkGetDeviceMemoryOpaqueCaptu
     return fn(device, pInfo);
 }
 
+void cgoCmdBeginRendering(PFN_vkCmdBeginRendering fn, VkCommandBuffer commandBuffer, VkRenderingInfo* pRenderingInfo) {
+    fn(commandBuffer, pRenderingInfo);
+}
+
+void cgoCmdEndRendering(PFN_vkCmdEndRendering fn, VkCommandBuffer commandBuffer) {
+    fn(commandBuffer);
+}
+
+void cgoCmdSetEvent2(PFN_vkCmdSetEvent2 fn, VkCommandBuffer commandBuffer, VkEvent event, VkDependencyInfo* pDependencyInfo) {
+    fn(commandBuffer, event, pDependencyInfo);
+}
+
+void cgoCmdResetEvent2(PFN_vkCmdResetEvent2 fn, VkCommandBuffer commandBuffer, VkEvent event, VkPipelineStageFlags2 stageMask) {
+    fn(commandBuffer, event, stageMask);
+}
+
+void cgoCmdWaitEvents2(PFN_vkCmdWaitEvents2 fn, VkCommandBuffer commandBuffer, uint32_t eventCount, VkEvent* pEvents, VkDependencyInfo* pDependencyInfos) {
+    fn(commandBuffer, eventCount, pEvents, pDependencyInfos);
+}
+
+void cgoCmdPipelineBarrier2(PFN_vkCmdPipelineBarrier2 fn, VkCommandBuffer commandBuffer, VkDependencyInfo* pDependencyInfo) {
+    fn(commandBuffer, pDependencyInfo);
+}
+
+void cgoCmdWriteTimestamp2(PFN_vkCmdWriteTimestamp2 fn, VkCommandBuffer commandBuffer, VkPipelineStageFlags2 stage, VkQueryPool queryPool, uint32_t query) {
+    fn(commandBuffer, stage, queryPool, query);
+}
+
+VkResult cgoQueueSubmit2(PFN_vkQueueSubmit2 fn, VkQueue queue, uint32_t submitCount, VkSubmitInfo2* pSubmits, VkFence fence) {
+    return fn(queue, submitCount, pSubmits, fence);
+}
+
+void cgoCmdSetCullMode(PFN_vkCmdSetCullMode fn, VkCommandBuffer commandBuffer, VkCullModeFlags cullMode) {
+    fn(commandBuffer, cullMode);
+}
+
+void cgoCmdSetFrontFace(PFN_vkCmdSetFrontFace fn, VkCommandBuffer commandBuffer, VkFrontFace frontFace) {
+    fn(commandBuffer, frontFace);
+}
+
+void cgoCmdSetPrimitiveTopology(PFN_vkCmdSetPrimitiveTopology fn, VkCommandBuffer commandBuffer, VkPrimitiveTopology primitiveTopology) {
+    fn(commandBuffer, primitiveTopology);
+}
+
+void cgoCmdSetViewportWithCount(PFN_vkCmdSetViewportWithCount fn, VkCommandBuffer commandBuffer, uint32_t viewportCount, VkViewport* pViewports) {
+    fn(commandBuffer, viewportCount, pViewports);
+}
+
+void cgoCmdSetScissorWithCount(PFN_vkCmdSetScissorWithCount fn, VkCommandBuffer commandBuffer, uint32_t scissorCount, VkRect2D* pScissors) {
+    fn(commandBuffer, scissorCount, pScissors);
+}
+
+void cgoCmdBindVertexBuffers2(PFN_vkCmdBindVertexBuffers2 fn, VkCommandBuffer commandBuffer, uint32_t firstBinding, uint32_t bindingCount, VkBuffer* pBuffers, VkDeviceSize* pOffsets, VkDeviceSize* pSizes, VkDeviceSize* pStrides) {
+    fn(commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets, pSizes, pStrides);
+}
+
+void cgoCmdSetDepthTestEnable(PFN_vkCmdSetDepthTestEnable fn, VkCommandBuffer commandBuffer, VkBool32 depthTestEnable) {
+    fn(commandBuffer, depthTestEnable);
+}
+
+void cgoCmdSetDepthWriteEnable(PFN_vkCmdSetDepthWriteEnable fn, VkCommandBuffer commandBuffer, VkBool32 depthWriteEnable) {
+    fn(commandBuffer, depthWriteEnable);
+}
+
+void cgoCmdSetDepthCompareOp(PFN_vkCmdSetDepthCompareOp fn, VkCommandBuffer commandBuffer, VkCompareOp depthCompareOp) {
+    fn(commandBuffer, depthCompareOp);
+}
+
+void cgoCmdSetDepthBoundsTestEnable(PFN_vkCmdSetDepthBoundsTestEnable fn, VkCommandBuffer commandBuffer, VkBool32 depthBoundsTestEnable) {
+    fn(commandBuffer, depthBoundsTestEnable);
+}
+
+void cgoCmdSetStencilTestEnable(PFN_vkCmdSetStencilTestEnable fn, VkCommandBuffer commandBuffer, VkBool32 stencilTestEnable) {
+    fn(commandBuffer, stencilTestEnable);
+}
+
+void cgoCmdSetStencilOp(PFN_vkCmdSetStencilOp fn, VkCommandBuffer commandBuffer, VkStencilFaceFlags faceMask, VkStencilOp failOp, VkStencilOp passOp, VkStencilOp depthFailOp, VkCompareOp compareOp) {
+    fn(commandBuffer, faceMask, failOp, passOp, depthFailOp, compareOp);
+}
+
+void cgoCmdSetRasterizerDiscardEnable(PFN_vkCmdSetRasterizerDiscardEnable fn, VkCommandBuffer commandBuffer, VkBool32 rasterizerDiscardEnable) {
+    fn(commandBuffer, rasterizerDiscardEnable);
+}
+
+void cgoCmdSetDepthBiasEnable(PFN_vkCmdSetDepthBiasEnable fn, VkCommandBuffer commandBuffer, VkBool32 depthBiasEnable) {
+    fn(commandBuffer, depthBiasEnable);
+}
+
+void cgoCmdSetPrimitiveRestartEnable(PFN_vkCmdSetPrimitiveRestartEnable fn, VkCommandBuffer commandBuffer, VkBool32 primitiveRestartEnable) {
+    fn(commandBuffer, primitiveRestartEnable);
+}
+
+void cgoCmdCopyBuffer2(PFN_vkCmdCopyBuffer2 fn, VkCommandBuffer commandBuffer, VkCopyBufferInfo2* pCopyBufferInfo) {
+    fn(commandBuffer, pCopyBufferInfo);
+}
+
+void cgoCmdCopyImage2(PFN_vkCmdCopyImage2 fn, VkCommandBuffer commandBuffer, VkCopyImageInfo2* pCopyImageInfo) {
+    fn(commandBuffer, pCopyImageInfo);
+}
+
+void cgoCmdCopyBufferToImage2(PFN_vkCmdCopyBufferToImage2 fn, VkCommandBuffer commandBuffer, VkCopyBufferToImageInfo2* pCopyBufferToImageInfo) {
+    fn(commandBuffer, pCopyBufferToImageInfo);
+}
+
+void cgoCmdCopyImageToBuffer2(PFN_vkCmdCopyImageToBuffer2 fn, VkCommandBuffer commandBuffer, VkCopyImageToBufferInfo2* pCopyImageToBufferInfo) {
+    fn(commandBuffer, pCopyImageToBufferInfo);
+}
+
+void cgoCmdBlitImage2(PFN_vkCmdBlitImage2 fn, VkCommandBuffer commandBuffer, VkBlitImageInfo2* pBlitImageInfo) {
+    fn(commandBuffer, pBlitImageInfo);
+}
+
+void cgoCmdResolveImage2(PFN_vkCmdResolveImage2 fn, VkCommandBuffer commandBuffer, VkResolveImageInfo2* pResolveImageInfo) {
+    fn(commandBuffer, pResolveImageInfo);
+}
+
+void cgoGetDeviceBufferMemoryRequirements(PFN_vkGetDeviceBufferMemoryRequirements fn, VkDevice device, VkDeviceBufferMemoryRequirements* pInfo, VkMemoryRequirements2* pMemoryRequirements) {
+    fn(device, pInfo, pMemoryRequirements);
+}
+
+void cgoGetDeviceImageMemoryRequirements(PFN_vkGetDeviceImageMemoryRequirements fn, VkDevice device, VkDeviceImageMemoryRequirements* pInfo, VkMemoryRequirements2* pMemoryRequirements) {
+    fn(device, pInfo, pMemoryRequirements);
+}
+
+void cgoGetDeviceImageSparseMemoryRequirements(PFN_vkGetDeviceImageSparseMemoryRequirements fn, VkDevice device, VkDeviceImageMemoryRequirements* pInfo, uint32_t* pSparseMemoryRequirementCount, VkSparseImageMemoryRequirements2* pSparseMemoryRequirements) {
+    fn(device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements);
+}
+
+VkResult cgoCreatePrivateDataSlot(PFN_vkCreatePrivateDataSlot fn, VkDevice device, VkPrivateDataSlotCreateInfo* pCreateInfo, VkAllocationCallbacks* pAllocator, VkPrivateDataSlot* pPrivateDataSlot) {
+    return fn(device, pCreateInfo, pAllocator, pPrivateDataSlot);
+}
+
+void cgoDestroyPrivateDataSlot(PFN_vkDestroyPrivateDataSlot fn, VkDevice device, VkPrivateDataSlot privateDataSlot, VkAllocationCallbacks* pAllocator) {
+    fn(device, privateDataSlot, pAllocator);
+}
+
+VkResult cgoSetPrivateData(PFN_vkSetPrivateData fn, VkDevice device, VkObjectType objectType, uint64_t objectHandle, VkPrivateDataSlot privateDataSlot, uint64_t data) {
+    return fn(device, objectType, objectHandle, privateDataSlot, data);
+}
+
+void cgoGetPrivateData(PFN_vkGetPrivateData fn, VkDevice device, VkObjectType objectType, uint64_t objectHandle, VkPrivateDataSlot privateDataSlot, uint64_t* pData) {
+    fn(device, objectType, objectHandle, privateDataSlot, pData);
+}
+
+VkResult cgoGetPhysicalDeviceToolProperties(PFN_vkGetPhysicalDeviceToolProperties fn, VkPhysicalDevice physicalDevice, uint32_t* pToolCount, VkPhysicalDeviceToolProperties* pToolProperties) {
+    return fn(physicalDevice, pToolCount, pToolProperties);
+}
+
 
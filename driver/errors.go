@@ -0,0 +1,19 @@
+package driver
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+)
+
+// vkErrorUnknown is the value of VK_ERROR_UNKNOWN, which is returned alongside Go-side errors
+const vkErrorUnknown common.VkResult = -13
+
+var (
+	// ErrNotInstanceDriver is returned, wrapped in a *common.FunctionError, when an instance-level
+	// command is called on a Driver that was not created with CreateInstanceDriver or
+	// CreateDeviceDriver
+	ErrNotInstanceDriver = errors.New("attempted to call instance driver function on a basic driver")
+	// ErrNotDeviceDriver is returned, wrapped in a *common.FunctionError, when a device-level
+	// command is called on a Driver that was not created with CreateDeviceDriver
+	ErrNotDeviceDriver = errors.New("attempted device driver function on a non-device driver")
+)
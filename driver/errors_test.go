@@ -0,0 +1,101 @@
+package driver_test
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// createStubDevice creates a Device from testdata/stub_vulkan_extensions.c, so that the loader,
+// Instance, and Device each have a real driver of their own scope
+func createStubDevice(t *testing.T) (*core.VulkanLoader, core1_0.Instance, core1_0.Device) {
+	compiler := os.Getenv("CC")
+	if compiler == "" {
+		compiler = "cc"
+	}
+
+	_, err := exec.LookPath(compiler)
+	if err != nil {
+		t.Skip("a C compiler is required to build the stub Vulkan library")
+	}
+
+	library := filepath.Join(t.TempDir(), "lib.so")
+	output, err := exec.Command(compiler, "-shared", "-fPIC", "-o", library, filepath.Join("..", "testdata", "stub_vulkan_extensions.c")).CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	loader, err := core.CreateLoaderFromLibrary(library)
+	require.NoError(t, err)
+	instance, _, err := loader.CreateInstance(nil, core1_0.InstanceCreateInfo{})
+	require.NoError(t, err)
+	physicalDevices, _, err := instance.EnumeratePhysicalDevices()
+	require.NoError(t, err)
+	require.Len(t, physicalDevices, 1)
+
+	device, _, err := physicalDevices[0].CreateDevice(nil, core1_0.DeviceCreateInfo{
+		QueueCreateInfos: []core1_0.DeviceQueueCreateInfo{
+			{QueueFamilyIndex: 0, QueuePriorities: []float32{1}},
+		},
+	})
+	require.NoError(t, err)
+
+	return loader, instance, device
+}
+
+func TestErrNotInstanceDriver(t *testing.T) {
+	loader, instance, device := createStubDevice(t)
+	basicDriver := loader.Driver()
+
+	var count driver.Uint32
+	_, err := basicDriver.VkEnumeratePhysicalDevices(instance.Handle(), &count, nil)
+	require.True(t, errors.Is(err, driver.ErrNotInstanceDriver))
+
+	var functionErr *common.FunctionError
+	require.True(t, errors.As(err, &functionErr))
+	require.Equal(t, "vkEnumeratePhysicalDevices", functionErr.Function)
+
+	err = func() (err error) {
+		defer common.RecoverFunctionError(&err)
+
+		basicDriver.VkDestroyInstance(instance.Handle(), nil)
+		return nil
+	}()
+	require.True(t, errors.Is(err, driver.ErrNotInstanceDriver))
+
+	_, err = basicDriver.CreateDeviceDriver(device.Handle())
+	require.True(t, errors.Is(err, driver.ErrNotInstanceDriver))
+
+	// A device driver is also an instance driver: it loads instance-level commands from the
+	// Instance that its Device was created from
+	_, err = device.Driver().VkEnumeratePhysicalDevices(instance.Handle(), &count, nil)
+	require.NoError(t, err)
+	require.Equal(t, driver.Uint32(1), count)
+}
+
+func TestErrNotDeviceDriver(t *testing.T) {
+	loader, instance, device := createStubDevice(t)
+
+	for _, nonDeviceDriver := range []driver.Driver{loader.Driver(), instance.Driver()} {
+		_, err := nonDeviceDriver.VkDeviceWaitIdle(device.Handle())
+		require.True(t, errors.Is(err, driver.ErrNotDeviceDriver))
+
+		var functionErr *common.FunctionError
+		require.True(t, errors.As(err, &functionErr))
+		require.Equal(t, "vkDeviceWaitIdle", functionErr.Function)
+
+		err = func() (err error) {
+			defer common.RecoverFunctionError(&err)
+
+			var queue driver.VkQueue
+			nonDeviceDriver.VkGetDeviceQueue(device.Handle(), 0, 0, &queue)
+			return nil
+		}()
+		require.True(t, errors.Is(err, driver.ErrNotDeviceDriver))
+	}
+}
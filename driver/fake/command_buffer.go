@@ -0,0 +1,349 @@
+package fake
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+// recordCommand counts a command recorded to the provided CommandBuffer
+func (d *Driver) recordCommand(commandBuffer driver.VkCommandBuffer) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(commandBuffer), core1_0.ObjectTypeCommandBuffer, "VkCommandBuffer")
+	if obj == nil {
+		return
+	}
+
+	if !obj.recording {
+		d.state.recordError(errors.Newf("VkCommandBuffer 0x%x had a command recorded while not recording", obj.Handle))
+	}
+	obj.commands++
+}
+
+func (d *Driver) VkCmdBindPipeline(commandBuffer driver.VkCommandBuffer, pipelineBindPoint driver.VkPipelineBindPoint, pipeline driver.VkPipeline) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetViewport(commandBuffer driver.VkCommandBuffer, firstViewport driver.Uint32, viewportCount driver.Uint32, pViewports *driver.VkViewport) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetScissor(commandBuffer driver.VkCommandBuffer, firstScissor driver.Uint32, scissorCount driver.Uint32, pScissors *driver.VkRect2D) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetLineWidth(commandBuffer driver.VkCommandBuffer, lineWidth driver.Float) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetDepthBias(commandBuffer driver.VkCommandBuffer, depthBiasConstantFactor driver.Float, depthBiasClamp driver.Float, depthBiasSlopeFactor driver.Float) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetBlendConstants(commandBuffer driver.VkCommandBuffer, blendConstants *driver.Float) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetDepthBounds(commandBuffer driver.VkCommandBuffer, minDepthBounds driver.Float, maxDepthBounds driver.Float) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetStencilCompareMask(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, compareMask driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetStencilWriteMask(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, writeMask driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetStencilReference(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, reference driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdBindDescriptorSets(commandBuffer driver.VkCommandBuffer, pipelineBindPoint driver.VkPipelineBindPoint, layout driver.VkPipelineLayout, firstSet driver.Uint32, descriptorSetCount driver.Uint32, pDescriptorSets *driver.VkDescriptorSet, dynamicOffsetCount driver.Uint32, pDynamicOffsets *driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdBindIndexBuffer(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, indexType driver.VkIndexType) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdBindVertexBuffers(commandBuffer driver.VkCommandBuffer, firstBinding driver.Uint32, bindingCount driver.Uint32, pBuffers *driver.VkBuffer, pOffsets *driver.VkDeviceSize) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdDraw(commandBuffer driver.VkCommandBuffer, vertexCount driver.Uint32, instanceCount driver.Uint32, firstVertex driver.Uint32, firstInstance driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdDrawIndexed(commandBuffer driver.VkCommandBuffer, indexCount driver.Uint32, instanceCount driver.Uint32, firstIndex driver.Uint32, vertexOffset driver.Int32, firstInstance driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdDrawIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, drawCount driver.Uint32, stride driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdDrawIndexedIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, drawCount driver.Uint32, stride driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdDispatch(commandBuffer driver.VkCommandBuffer, groupCountX driver.Uint32, groupCountY driver.Uint32, groupCountZ driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdDispatchIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdCopyBuffer(commandBuffer driver.VkCommandBuffer, srcBuffer driver.VkBuffer, dstBuffer driver.VkBuffer, regionCount driver.Uint32, pRegions *driver.VkBufferCopy) {
+	regions := bufferCopyRegions(regionCount, pRegions)
+	d.recordTransfer(commandBuffer, func() {
+		d.copyBuffer(srcBuffer, dstBuffer, regions)
+	})
+}
+
+func (d *Driver) VkCmdCopyImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageCopy) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdBlitImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageBlit, filter driver.VkFilter) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdCopyBufferToImage(commandBuffer driver.VkCommandBuffer, srcBuffer driver.VkBuffer, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkBufferImageCopy) {
+	regions := bufferImageCopyRegions(regionCount, pRegions)
+	d.recordTransfer(commandBuffer, func() {
+		d.copyBufferToImage(srcBuffer, dstImage, regions)
+	})
+}
+
+func (d *Driver) VkCmdCopyImageToBuffer(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstBuffer driver.VkBuffer, regionCount driver.Uint32, pRegions *driver.VkBufferImageCopy) {
+	regions := bufferImageCopyRegions(regionCount, pRegions)
+	d.recordTransfer(commandBuffer, func() {
+		d.copyImageToBuffer(srcImage, dstBuffer, regions)
+	})
+}
+
+func (d *Driver) VkCmdUpdateBuffer(commandBuffer driver.VkCommandBuffer, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, dataSize driver.VkDeviceSize, pData unsafe.Pointer) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdFillBuffer(commandBuffer driver.VkCommandBuffer, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, size driver.VkDeviceSize, data driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdClearColorImage(commandBuffer driver.VkCommandBuffer, image driver.VkImage, imageLayout driver.VkImageLayout, pColor *driver.VkClearColorValue, rangeCount driver.Uint32, pRanges *driver.VkImageSubresourceRange) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdClearDepthStencilImage(commandBuffer driver.VkCommandBuffer, image driver.VkImage, imageLayout driver.VkImageLayout, pDepthStencil *driver.VkClearDepthStencilValue, rangeCount driver.Uint32, pRanges *driver.VkImageSubresourceRange) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdClearAttachments(commandBuffer driver.VkCommandBuffer, attachmentCount driver.Uint32, pAttachments *driver.VkClearAttachment, rectCount driver.Uint32, pRects *driver.VkClearRect) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdResolveImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageResolve) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetEvent(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdResetEvent(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdWaitEvents(commandBuffer driver.VkCommandBuffer, eventCount driver.Uint32, pEvents *driver.VkEvent, srcStageMask driver.VkPipelineStageFlags, dstStageMask driver.VkPipelineStageFlags, memoryBarrierCount driver.Uint32, pMemoryBarriers *driver.VkMemoryBarrier, bufferMemoryBarrierCount driver.Uint32, pBufferMemoryBarriers *driver.VkBufferMemoryBarrier, imageMemoryBarrierCount driver.Uint32, pImageMemoryBarriers *driver.VkImageMemoryBarrier) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdPipelineBarrier(commandBuffer driver.VkCommandBuffer, srcStageMask driver.VkPipelineStageFlags, dstStageMask driver.VkPipelineStageFlags, dependencyFlags driver.VkDependencyFlags, memoryBarrierCount driver.Uint32, pMemoryBarriers *driver.VkMemoryBarrier, bufferMemoryBarrierCount driver.Uint32, pBufferMemoryBarriers *driver.VkBufferMemoryBarrier, imageMemoryBarrierCount driver.Uint32, pImageMemoryBarriers *driver.VkImageMemoryBarrier) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdBeginQuery(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, query driver.Uint32, flags driver.VkQueryControlFlags) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdEndQuery(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, query driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdResetQueryPool(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdWriteTimestamp(commandBuffer driver.VkCommandBuffer, pipelineStage driver.VkPipelineStageFlags, queryPool driver.VkQueryPool, query driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdCopyQueryPoolResults(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, stride driver.VkDeviceSize, flags driver.VkQueryResultFlags) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdPushConstants(commandBuffer driver.VkCommandBuffer, layout driver.VkPipelineLayout, stageFlags driver.VkShaderStageFlags, offset driver.Uint32, size driver.Uint32, pValues unsafe.Pointer) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdBeginRenderPass(commandBuffer driver.VkCommandBuffer, pRenderPassBegin *driver.VkRenderPassBeginInfo, contents driver.VkSubpassContents) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdNextSubpass(commandBuffer driver.VkCommandBuffer, contents driver.VkSubpassContents) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdEndRenderPass(commandBuffer driver.VkCommandBuffer) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdExecuteCommands(commandBuffer driver.VkCommandBuffer, commandBufferCount driver.Uint32, pCommandBuffers *driver.VkCommandBuffer) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetDeviceMask(commandBuffer driver.VkCommandBuffer, deviceMask driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdDispatchBase(commandBuffer driver.VkCommandBuffer, baseGroupX driver.Uint32, baseGroupY driver.Uint32, baseGroupZ driver.Uint32, groupCountX driver.Uint32, groupCountY driver.Uint32, groupCountZ driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdDrawIndirectCount(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, countBuffer driver.VkBuffer, countBufferOffset driver.VkDeviceSize, maxDrawCount driver.Uint32, stride driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdDrawIndexedIndirectCount(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, countBuffer driver.VkBuffer, countBufferOffset driver.VkDeviceSize, maxDrawCount driver.Uint32, stride driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdBeginRenderPass2(commandBuffer driver.VkCommandBuffer, pRenderPassBegin *driver.VkRenderPassBeginInfo, pSubpassBeginInfo *driver.VkSubpassBeginInfo) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdNextSubpass2(commandBuffer driver.VkCommandBuffer, pSubpassBeginInfo *driver.VkSubpassBeginInfo, pSubpassEndInfo *driver.VkSubpassEndInfo) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdEndRenderPass2(commandBuffer driver.VkCommandBuffer, pSubpassEndInfo *driver.VkSubpassEndInfo) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdBeginRendering(commandBuffer driver.VkCommandBuffer, pRenderingInfo *driver.VkRenderingInfo) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdEndRendering(commandBuffer driver.VkCommandBuffer) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetEvent2(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, pDependencyInfo *driver.VkDependencyInfo) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdResetEvent2(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags2) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdWaitEvents2(commandBuffer driver.VkCommandBuffer, eventCount driver.Uint32, pEvents *driver.VkEvent, pDependencyInfos *driver.VkDependencyInfo) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdPipelineBarrier2(commandBuffer driver.VkCommandBuffer, pDependencyInfo *driver.VkDependencyInfo) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdWriteTimestamp2(commandBuffer driver.VkCommandBuffer, stage driver.VkPipelineStageFlags2, queryPool driver.VkQueryPool, query driver.Uint32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetCullMode(commandBuffer driver.VkCommandBuffer, cullMode driver.VkCullModeFlags) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetFrontFace(commandBuffer driver.VkCommandBuffer, frontFace driver.VkFrontFace) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetPrimitiveTopology(commandBuffer driver.VkCommandBuffer, primitiveTopology driver.VkPrimitiveTopology) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetViewportWithCount(commandBuffer driver.VkCommandBuffer, viewportCount driver.Uint32, pViewports *driver.VkViewport) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetScissorWithCount(commandBuffer driver.VkCommandBuffer, scissorCount driver.Uint32, pScissors *driver.VkRect2D) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdBindVertexBuffers2(commandBuffer driver.VkCommandBuffer, firstBinding driver.Uint32, bindingCount driver.Uint32, pBuffers *driver.VkBuffer, pOffsets *driver.VkDeviceSize, pSizes *driver.VkDeviceSize, pStrides *driver.VkDeviceSize) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetDepthTestEnable(commandBuffer driver.VkCommandBuffer, depthTestEnable driver.VkBool32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetDepthWriteEnable(commandBuffer driver.VkCommandBuffer, depthWriteEnable driver.VkBool32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetDepthCompareOp(commandBuffer driver.VkCommandBuffer, depthCompareOp driver.VkCompareOp) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetDepthBoundsTestEnable(commandBuffer driver.VkCommandBuffer, depthBoundsTestEnable driver.VkBool32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetStencilTestEnable(commandBuffer driver.VkCommandBuffer, stencilTestEnable driver.VkBool32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetStencilOp(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, failOp driver.VkStencilOp, passOp driver.VkStencilOp, depthFailOp driver.VkStencilOp, compareOp driver.VkCompareOp) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetRasterizerDiscardEnable(commandBuffer driver.VkCommandBuffer, rasterizerDiscardEnable driver.VkBool32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetDepthBiasEnable(commandBuffer driver.VkCommandBuffer, depthBiasEnable driver.VkBool32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetPrimitiveRestartEnable(commandBuffer driver.VkCommandBuffer, primitiveRestartEnable driver.VkBool32) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdCopyBuffer2(commandBuffer driver.VkCommandBuffer, pCopyBufferInfo *driver.VkCopyBufferInfo2) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdCopyImage2(commandBuffer driver.VkCommandBuffer, pCopyImageInfo *driver.VkCopyImageInfo2) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdCopyBufferToImage2(commandBuffer driver.VkCommandBuffer, pCopyBufferToImageInfo *driver.VkCopyBufferToImageInfo2) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdCopyImageToBuffer2(commandBuffer driver.VkCommandBuffer, pCopyImageToBufferInfo *driver.VkCopyImageToBufferInfo2) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdBlitImage2(commandBuffer driver.VkCommandBuffer, pBlitImageInfo *driver.VkBlitImageInfo2) {
+	d.recordCommand(commandBuffer)
+}
+
+func (d *Driver) VkCmdResolveImage2(commandBuffer driver.VkCommandBuffer, pResolveImageInfo *driver.VkResolveImageInfo2) {
+	d.recordCommand(commandBuffer)
+}
@@ -0,0 +1,128 @@
+package fake
+
+/*
+#include <stdlib.h>
+#include "../../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+func (d *Driver) VkCreateCommandPool(device driver.VkDevice, pCreateInfo *driver.VkCommandPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pCommandPool *driver.VkCommandPool) (common.VkResult, error) {
+	info := (*C.VkCommandPoolCreateInfo)(unsafe.Pointer(pCreateInfo))
+	createInfo := core1_0.CommandPoolCreateInfo{
+		Flags:            core1_0.CommandPoolCreateFlags(info.flags),
+		QueueFamilyIndex: int(info.queueFamilyIndex),
+	}
+
+	*pCommandPool = driver.VkCommandPool(d.create(device, core1_0.ObjectTypeCommandPool, createInfo))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(commandPool), core1_0.ObjectTypeCommandPool, "VkCommandPool")
+}
+
+func (d *Driver) VkResetCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, flags driver.VkCommandPoolResetFlags) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	pool := d.state.liveObject(driver.VulkanHandle(commandPool), core1_0.ObjectTypeCommandPool, "VkCommandPool")
+	if pool == nil {
+		return core1_0.VKSuccess, nil
+	}
+
+	for _, commandBuffer := range d.state.children(pool.Handle) {
+		commandBuffer.recording = false
+		commandBuffer.commands = 0
+		commandBuffer.transfers = nil
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkTrimCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, flags driver.VkCommandPoolTrimFlags) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(commandPool), core1_0.ObjectTypeCommandPool, "VkCommandPool")
+}
+
+func (d *Driver) VkAllocateCommandBuffers(device driver.VkDevice, pAllocateInfo *driver.VkCommandBufferAllocateInfo, pCommandBuffers *driver.VkCommandBuffer) (common.VkResult, error) {
+	info := (*C.VkCommandBufferAllocateInfo)(unsafe.Pointer(pAllocateInfo))
+	pool := readHandle(unsafe.Pointer(&info.commandPool))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	commandBuffers := unsafe.Slice(pCommandBuffers, int(info.commandBufferCount))
+	for i := range commandBuffers {
+		commandBuffers[i] = driver.VkCommandBuffer(d.createLocked(pool, core1_0.ObjectTypeCommandPool, "VkCommandPool", core1_0.ObjectTypeCommandBuffer, nil).Handle)
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkFreeCommandBuffers(device driver.VkDevice, commandPool driver.VkCommandPool, commandBufferCount driver.Uint32, pCommandBuffers *driver.VkCommandBuffer) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	for _, commandBuffer := range handleSlice(pCommandBuffers, int(commandBufferCount)) {
+		d.state.destroyObject(driver.VulkanHandle(commandBuffer), core1_0.ObjectTypeCommandBuffer, "VkCommandBuffer")
+	}
+}
+
+func (d *Driver) VkBeginCommandBuffer(commandBuffer driver.VkCommandBuffer, pBeginInfo *driver.VkCommandBufferBeginInfo) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(commandBuffer), core1_0.ObjectTypeCommandBuffer, "VkCommandBuffer")
+	if obj == nil {
+		return core1_0.VKSuccess, nil
+	}
+
+	if obj.recording {
+		d.state.recordError(errors.Newf("VkCommandBuffer 0x%x was begun while already recording", obj.Handle))
+	}
+	obj.recording = true
+	obj.commands = 0
+	obj.transfers = nil
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkEndCommandBuffer(commandBuffer driver.VkCommandBuffer) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(commandBuffer), core1_0.ObjectTypeCommandBuffer, "VkCommandBuffer")
+	if obj == nil {
+		return core1_0.VKSuccess, nil
+	}
+
+	if !obj.recording {
+		d.state.recordError(errors.Newf("VkCommandBuffer 0x%x was ended while not recording", obj.Handle))
+	}
+	obj.recording = false
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkResetCommandBuffer(commandBuffer driver.VkCommandBuffer, flags driver.VkCommandBufferResetFlags) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(commandBuffer), core1_0.ObjectTypeCommandBuffer, "VkCommandBuffer")
+	if obj != nil {
+		obj.recording = false
+		obj.commands = 0
+		obj.transfers = nil
+	}
+
+	return core1_0.VKSuccess, nil
+}
@@ -0,0 +1,472 @@
+package fake
+
+/*
+#include <stdlib.h>
+#include <string.h>
+#include "../../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+func (d *Driver) VkCreateDevice(physicalDevice driver.VkPhysicalDevice, pCreateInfo *driver.VkDeviceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDevice *driver.VkDevice) (common.VkResult, error) {
+	info := (*C.VkDeviceCreateInfo)(unsafe.Pointer(pCreateInfo))
+
+	createInfo := core1_0.DeviceCreateInfo{
+		Flags: core1_0.DeviceCreateFlags(info.flags),
+	}
+
+	queueInfos := unsafe.Slice(info.pQueueCreateInfos, int(info.queueCreateInfoCount))
+	for _, queueInfo := range queueInfos {
+		priorities := unsafe.Slice((*float32)(unsafe.Pointer(queueInfo.pQueuePriorities)), int(queueInfo.queueCount))
+		createInfo.QueueCreateInfos = append(createInfo.QueueCreateInfos, core1_0.DeviceQueueCreateInfo{
+			Flags:            core1_0.DeviceQueueCreateFlags(queueInfo.flags),
+			QueueFamilyIndex: int(queueInfo.queueFamilyIndex),
+			QueuePriorities:  append([]float32(nil), priorities...),
+		})
+	}
+
+	extensionNames := unsafe.Slice(info.ppEnabledExtensionNames, int(info.enabledExtensionCount))
+	for _, extensionName := range extensionNames {
+		createInfo.EnabledExtensionNames = append(createInfo.EnabledExtensionNames, C.GoString(extensionName))
+	}
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	parent := d.state.liveObject(driver.VulkanHandle(physicalDevice), core1_0.ObjectTypePhysicalDevice, "VkPhysicalDevice")
+	if parent == nil {
+		return core1_0.VKErrorInitializationFailed, core1_0.VKErrorInitializationFailed.ToError()
+	}
+
+	for _, queueInfo := range createInfo.QueueCreateInfos {
+		if queueInfo.QueueFamilyIndex >= len(parent.physicalDevice.QueueFamilies) {
+			d.state.recordError(errors.Newf("VkDevice requested queues from nonexistent queue family %d", queueInfo.QueueFamilyIndex))
+			return core1_0.VKErrorInitializationFailed, core1_0.VKErrorInitializationFailed.ToError()
+		}
+	}
+
+	device := d.state.createObject(core1_0.ObjectTypeDevice, parent.Handle, createInfo)
+	device.physicalDevice = parent.physicalDevice
+	device.queues = make(map[[2]int]driver.VkQueue)
+
+	*pDevice = driver.VkDevice(device.Handle)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyDevice(device driver.VkDevice, pAllocator *driver.VkAllocationCallbacks) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	if device == 0 {
+		return
+	}
+
+	deviceObj := d.state.liveObject(driver.VulkanHandle(device), core1_0.ObjectTypeDevice, "VkDevice")
+	if deviceObj == nil {
+		return
+	}
+
+	for _, child := range d.state.children(deviceObj.Handle) {
+		if child.Type != core1_0.ObjectTypeQueue {
+			d.state.recordError(errors.Newf("VkDevice 0x%x was destroyed while %s 0x%x was still alive", deviceObj.Handle, child.Type, child.Handle))
+		}
+	}
+
+	d.state.destroyTree(deviceObj)
+}
+
+func (d *Driver) deviceQueue(device driver.VkDevice, queueFamilyIndex, queueIndex int) driver.VkQueue {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	deviceObj := d.state.liveObject(driver.VulkanHandle(device), core1_0.ObjectTypeDevice, "VkDevice")
+	if deviceObj == nil {
+		return 0
+	}
+
+	key := [2]int{queueFamilyIndex, queueIndex}
+	queue, ok := deviceObj.queues[key]
+	if ok {
+		return queue
+	}
+
+	requested := 0
+	for _, queueInfo := range deviceObj.CreateInfo.(core1_0.DeviceCreateInfo).QueueCreateInfos {
+		if queueInfo.QueueFamilyIndex == queueFamilyIndex {
+			requested += len(queueInfo.QueuePriorities)
+		}
+	}
+
+	if queueIndex >= requested {
+		d.state.recordError(errors.Newf("VkDevice 0x%x did not request queue %d from queue family %d", deviceObj.Handle, queueIndex, queueFamilyIndex))
+		return 0
+	}
+
+	queue = driver.VkQueue(d.state.createObject(core1_0.ObjectTypeQueue, deviceObj.Handle, nil).Handle)
+	deviceObj.queues[key] = queue
+	return queue
+}
+
+func (d *Driver) VkGetDeviceQueue(device driver.VkDevice, queueFamilyIndex driver.Uint32, queueIndex driver.Uint32, pQueue *driver.VkQueue) {
+	*pQueue = d.deviceQueue(device, int(queueFamilyIndex), int(queueIndex))
+}
+
+func (d *Driver) VkGetDeviceQueue2(device driver.VkDevice, pQueueInfo *driver.VkDeviceQueueInfo2, pQueue *driver.VkQueue) {
+	info := (*C.VkDeviceQueueInfo2)(unsafe.Pointer(pQueueInfo))
+	*pQueue = d.deviceQueue(device, int(info.queueFamilyIndex), int(info.queueIndex))
+}
+
+func (d *Driver) VkDeviceWaitIdle(device driver.VkDevice) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(device), core1_0.ObjectTypeDevice, "VkDevice")
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkQueueWaitIdle(queue driver.VkQueue) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(queue), core1_0.ObjectTypeQueue, "VkQueue")
+	return core1_0.VKSuccess, nil
+}
+
+// create creates a new object of the provided type as a child of the provided device
+func (d *Driver) create(device driver.VkDevice, objectType core1_0.ObjectType, createInfo any) driver.VulkanHandle {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	return d.createLocked(driver.VulkanHandle(device), core1_0.ObjectTypeDevice, "VkDevice", objectType, createInfo).Handle
+}
+
+func (d *Driver) createLocked(parent driver.VulkanHandle, parentType core1_0.ObjectType, parentTypeName string, objectType core1_0.ObjectType, createInfo any) *fakeObject {
+	d.state.liveObject(parent, parentType, parentTypeName)
+	return d.state.createObject(objectType, parent, createInfo)
+}
+
+func (d *Driver) destroy(handle driver.VulkanHandle, objectType core1_0.ObjectType, typeName string) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.destroyObject(handle, objectType, typeName)
+}
+
+func (d *Driver) VkCreateBufferView(device driver.VkDevice, pCreateInfo *driver.VkBufferViewCreateInfo, pAllocator *driver.VkAllocationCallbacks, pView *driver.VkBufferView) (common.VkResult, error) {
+	*pView = driver.VkBufferView(d.create(device, core1_0.ObjectTypeBufferView, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyBufferView(device driver.VkDevice, bufferView driver.VkBufferView, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(bufferView), core1_0.ObjectTypeBufferView, "VkBufferView")
+}
+
+func (d *Driver) VkCreateImageView(device driver.VkDevice, pCreateInfo *driver.VkImageViewCreateInfo, pAllocator *driver.VkAllocationCallbacks, pView *driver.VkImageView) (common.VkResult, error) {
+	*pView = driver.VkImageView(d.create(device, core1_0.ObjectTypeImageView, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyImageView(device driver.VkDevice, imageView driver.VkImageView, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(imageView), core1_0.ObjectTypeImageView, "VkImageView")
+}
+
+func (d *Driver) VkCreateShaderModule(device driver.VkDevice, pCreateInfo *driver.VkShaderModuleCreateInfo, pAllocator *driver.VkAllocationCallbacks, pShaderModule *driver.VkShaderModule) (common.VkResult, error) {
+	*pShaderModule = driver.VkShaderModule(d.create(device, core1_0.ObjectTypeShaderModule, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyShaderModule(device driver.VkDevice, shaderModule driver.VkShaderModule, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(shaderModule), core1_0.ObjectTypeShaderModule, "VkShaderModule")
+}
+
+func (d *Driver) VkCreatePipelineCache(device driver.VkDevice, pCreateInfo *driver.VkPipelineCacheCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelineCache *driver.VkPipelineCache) (common.VkResult, error) {
+	*pPipelineCache = driver.VkPipelineCache(d.create(device, core1_0.ObjectTypePipelineCache, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyPipelineCache(device driver.VkDevice, pipelineCache driver.VkPipelineCache, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(pipelineCache), core1_0.ObjectTypePipelineCache, "VkPipelineCache")
+}
+
+func (d *Driver) VkCreatePipelineLayout(device driver.VkDevice, pCreateInfo *driver.VkPipelineLayoutCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelineLayout *driver.VkPipelineLayout) (common.VkResult, error) {
+	*pPipelineLayout = driver.VkPipelineLayout(d.create(device, core1_0.ObjectTypePipelineLayout, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyPipelineLayout(device driver.VkDevice, pipelineLayout driver.VkPipelineLayout, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(pipelineLayout), core1_0.ObjectTypePipelineLayout, "VkPipelineLayout")
+}
+
+func (d *Driver) VkCreateSampler(device driver.VkDevice, pCreateInfo *driver.VkSamplerCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSampler *driver.VkSampler) (common.VkResult, error) {
+	*pSampler = driver.VkSampler(d.create(device, core1_0.ObjectTypeSampler, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroySampler(device driver.VkDevice, sampler driver.VkSampler, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(sampler), core1_0.ObjectTypeSampler, "VkSampler")
+}
+
+func (d *Driver) VkCreateDescriptorSetLayout(device driver.VkDevice, pCreateInfo *driver.VkDescriptorSetLayoutCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSetLayout *driver.VkDescriptorSetLayout) (common.VkResult, error) {
+	*pSetLayout = driver.VkDescriptorSetLayout(d.create(device, core1_0.ObjectTypeDescriptorSetLayout, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyDescriptorSetLayout(device driver.VkDevice, descriptorSetLayout driver.VkDescriptorSetLayout, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(descriptorSetLayout), core1_0.ObjectTypeDescriptorSetLayout, "VkDescriptorSetLayout")
+}
+
+func (d *Driver) VkCreateFramebuffer(device driver.VkDevice, pCreateInfo *driver.VkFramebufferCreateInfo, pAllocator *driver.VkAllocationCallbacks, pFramebuffer *driver.VkFramebuffer) (common.VkResult, error) {
+	*pFramebuffer = driver.VkFramebuffer(d.create(device, core1_0.ObjectTypeFramebuffer, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyFramebuffer(device driver.VkDevice, framebuffer driver.VkFramebuffer, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(framebuffer), core1_0.ObjectTypeFramebuffer, "VkFramebuffer")
+}
+
+func (d *Driver) VkCreateRenderPass(device driver.VkDevice, pCreateInfo *driver.VkRenderPassCreateInfo, pAllocator *driver.VkAllocationCallbacks, pRenderPass *driver.VkRenderPass) (common.VkResult, error) {
+	*pRenderPass = driver.VkRenderPass(d.create(device, core1_0.ObjectTypeRenderPass, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyRenderPass(device driver.VkDevice, renderPass driver.VkRenderPass, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(renderPass), core1_0.ObjectTypeRenderPass, "VkRenderPass")
+}
+
+func (d *Driver) VkCreateSamplerYcbcrConversion(device driver.VkDevice, pCreateInfo *driver.VkSamplerYcbcrConversionCreateInfo, pAllocator *driver.VkAllocationCallbacks, pYcbcrConversion *driver.VkSamplerYcbcrConversion) (common.VkResult, error) {
+	*pYcbcrConversion = driver.VkSamplerYcbcrConversion(d.create(device, core1_1.ObjectTypeSamplerYcbcrConversion, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroySamplerYcbcrConversion(device driver.VkDevice, ycbcrConversion driver.VkSamplerYcbcrConversion, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(ycbcrConversion), core1_1.ObjectTypeSamplerYcbcrConversion, "VkSamplerYcbcrConversion")
+}
+
+func (d *Driver) VkCreateDescriptorUpdateTemplate(device driver.VkDevice, pCreateInfo *driver.VkDescriptorUpdateTemplateCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDescriptorUpdateTemplate *driver.VkDescriptorUpdateTemplate) (common.VkResult, error) {
+	*pDescriptorUpdateTemplate = driver.VkDescriptorUpdateTemplate(d.create(device, core1_1.ObjectTypeDescriptorUpdateTemplate, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyDescriptorUpdateTemplate(device driver.VkDevice, descriptorUpdateTemplate driver.VkDescriptorUpdateTemplate, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(descriptorUpdateTemplate), core1_1.ObjectTypeDescriptorUpdateTemplate, "VkDescriptorUpdateTemplate")
+}
+
+func (d *Driver) VkCreateRenderPass2(device driver.VkDevice, pCreateInfo *driver.VkRenderPassCreateInfo2, pAllocator *driver.VkAllocationCallbacks, pRenderPass *driver.VkRenderPass) (common.VkResult, error) {
+	*pRenderPass = driver.VkRenderPass(d.create(device, core1_0.ObjectTypeRenderPass, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkGetRenderAreaGranularity(device driver.VkDevice, renderPass driver.VkRenderPass, pGranularity *driver.VkExtent2D) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(renderPass), core1_0.ObjectTypeRenderPass, "VkRenderPass")
+
+	granularity := (*C.VkExtent2D)(unsafe.Pointer(pGranularity))
+	granularity.width = 1
+	granularity.height = 1
+}
+
+func (d *Driver) VkGetPipelineCacheData(device driver.VkDevice, pipelineCache driver.VkPipelineCache, pDataSize *driver.Size, pData unsafe.Pointer) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(pipelineCache), core1_0.ObjectTypePipelineCache, "VkPipelineCache")
+	*pDataSize = 0
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkMergePipelineCaches(device driver.VkDevice, dstCache driver.VkPipelineCache, srcCacheCount driver.Uint32, pSrcCaches *driver.VkPipelineCache) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(dstCache), core1_0.ObjectTypePipelineCache, "VkPipelineCache")
+	for _, srcCache := range handleSlice(pSrcCaches, int(srcCacheCount)) {
+		d.state.liveObject(driver.VulkanHandle(srcCache), core1_0.ObjectTypePipelineCache, "VkPipelineCache")
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) createPipelines(device driver.VkDevice, createInfoCount driver.Uint32, pPipelines *driver.VkPipeline) {
+	pipelines := unsafe.Slice(pPipelines, int(createInfoCount))
+	for i := range pipelines {
+		pipelines[i] = driver.VkPipeline(d.create(device, core1_0.ObjectTypePipeline, nil))
+	}
+}
+
+func (d *Driver) VkCreateGraphicsPipelines(device driver.VkDevice, pipelineCache driver.VkPipelineCache, createInfoCount driver.Uint32, pCreateInfos *driver.VkGraphicsPipelineCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelines *driver.VkPipeline) (common.VkResult, error) {
+	d.createPipelines(device, createInfoCount, pPipelines)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkCreateComputePipelines(device driver.VkDevice, pipelineCache driver.VkPipelineCache, createInfoCount driver.Uint32, pCreateInfos *driver.VkComputePipelineCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelines *driver.VkPipeline) (common.VkResult, error) {
+	d.createPipelines(device, createInfoCount, pPipelines)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyPipeline(device driver.VkDevice, pipeline driver.VkPipeline, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(pipeline), core1_0.ObjectTypePipeline, "VkPipeline")
+}
+
+func (d *Driver) VkCreateQueryPool(device driver.VkDevice, pCreateInfo *driver.VkQueryPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pQueryPool *driver.VkQueryPool) (common.VkResult, error) {
+	info := (*C.VkQueryPoolCreateInfo)(unsafe.Pointer(pCreateInfo))
+	createInfo := core1_0.QueryPoolCreateInfo{
+		Flags:              core1_0.QueryPoolCreateFlags(info.flags),
+		QueryType:          core1_0.QueryType(info.queryType),
+		QueryCount:         int(info.queryCount),
+		PipelineStatistics: core1_0.QueryPipelineStatisticFlags(info.pipelineStatistics),
+	}
+
+	*pQueryPool = driver.VkQueryPool(d.create(device, core1_0.ObjectTypeQueryPool, createInfo))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyQueryPool(device driver.VkDevice, queryPool driver.VkQueryPool, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(queryPool), core1_0.ObjectTypeQueryPool, "VkQueryPool")
+}
+
+// VkGetQueryPoolResults reports every query as available with a result of 0
+func (d *Driver) VkGetQueryPoolResults(device driver.VkDevice, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32, dataSize driver.Size, pData unsafe.Pointer, stride driver.VkDeviceSize, flags driver.VkQueryResultFlags) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(queryPool), core1_0.ObjectTypeQueryPool, "VkQueryPool")
+	C.memset(pData, 0, C.size_t(dataSize))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkResetQueryPool(device driver.VkDevice, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(queryPool), core1_0.ObjectTypeQueryPool, "VkQueryPool")
+}
+
+func (d *Driver) VkCreateDescriptorPool(device driver.VkDevice, pCreateInfo *driver.VkDescriptorPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDescriptorPool *driver.VkDescriptorPool) (common.VkResult, error) {
+	*pDescriptorPool = driver.VkDescriptorPool(d.create(device, core1_0.ObjectTypeDescriptorPool, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyDescriptorPool(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(descriptorPool), core1_0.ObjectTypeDescriptorPool, "VkDescriptorPool")
+}
+
+func (d *Driver) VkResetDescriptorPool(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, flags driver.VkDescriptorPoolResetFlags) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	pool := d.state.liveObject(driver.VulkanHandle(descriptorPool), core1_0.ObjectTypeDescriptorPool, "VkDescriptorPool")
+	if pool == nil {
+		return core1_0.VKSuccess, nil
+	}
+
+	for _, set := range d.state.children(pool.Handle) {
+		d.state.destroyTree(set)
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkAllocateDescriptorSets(device driver.VkDevice, pAllocateInfo *driver.VkDescriptorSetAllocateInfo, pDescriptorSets *driver.VkDescriptorSet) (common.VkResult, error) {
+	info := (*C.VkDescriptorSetAllocateInfo)(unsafe.Pointer(pAllocateInfo))
+	pool := *(*driver.VulkanHandle)(unsafe.Pointer(&info.descriptorPool))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	sets := unsafe.Slice(pDescriptorSets, int(info.descriptorSetCount))
+	for i := range sets {
+		sets[i] = driver.VkDescriptorSet(d.createLocked(pool, core1_0.ObjectTypeDescriptorPool, "VkDescriptorPool", core1_0.ObjectTypeDescriptorSet, nil).Handle)
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkFreeDescriptorSets(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, descriptorSetCount driver.Uint32, pDescriptorSets *driver.VkDescriptorSet) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	for _, set := range handleSlice(pDescriptorSets, int(descriptorSetCount)) {
+		d.state.destroyObject(driver.VulkanHandle(set), core1_0.ObjectTypeDescriptorSet, "VkDescriptorSet")
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkUpdateDescriptorSets(device driver.VkDevice, descriptorWriteCount driver.Uint32, pDescriptorWrites *driver.VkWriteDescriptorSet, descriptorCopyCount driver.Uint32, pDescriptorCopies *driver.VkCopyDescriptorSet) {
+	writes := unsafe.Slice((*C.VkWriteDescriptorSet)(unsafe.Pointer(pDescriptorWrites)), int(descriptorWriteCount))
+	copies := unsafe.Slice((*C.VkCopyDescriptorSet)(unsafe.Pointer(pDescriptorCopies)), int(descriptorCopyCount))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	for i := range writes {
+		set := *(*driver.VulkanHandle)(unsafe.Pointer(&writes[i].dstSet))
+		d.state.liveObject(set, core1_0.ObjectTypeDescriptorSet, "VkDescriptorSet")
+	}
+
+	for i := range copies {
+		srcSet := *(*driver.VulkanHandle)(unsafe.Pointer(&copies[i].srcSet))
+		d.state.liveObject(srcSet, core1_0.ObjectTypeDescriptorSet, "VkDescriptorSet")
+
+		dstSet := *(*driver.VulkanHandle)(unsafe.Pointer(&copies[i].dstSet))
+		d.state.liveObject(dstSet, core1_0.ObjectTypeDescriptorSet, "VkDescriptorSet")
+	}
+}
+
+func (d *Driver) VkUpdateDescriptorSetWithTemplate(device driver.VkDevice, descriptorSet driver.VkDescriptorSet, descriptorUpdateTemplate driver.VkDescriptorUpdateTemplate, pData unsafe.Pointer) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(descriptorSet), core1_0.ObjectTypeDescriptorSet, "VkDescriptorSet")
+	d.state.liveObject(driver.VulkanHandle(descriptorUpdateTemplate), core1_1.ObjectTypeDescriptorUpdateTemplate, "VkDescriptorUpdateTemplate")
+}
+
+func (d *Driver) VkGetDescriptorSetLayoutSupport(device driver.VkDevice, pCreateInfo *driver.VkDescriptorSetLayoutCreateInfo, pSupport *driver.VkDescriptorSetLayoutSupport) {
+	support := (*C.VkDescriptorSetLayoutSupport)(unsafe.Pointer(pSupport))
+	support.supported = C.VK_TRUE
+}
+
+func (d *Driver) VkCreatePrivateDataSlot(device driver.VkDevice, pCreateInfo *driver.VkPrivateDataSlotCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPrivateDataSlot *driver.VkPrivateDataSlot) (common.VkResult, error) {
+	*pPrivateDataSlot = driver.VkPrivateDataSlot(d.create(device, core1_3.ObjectTypePrivateDataSlot, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyPrivateDataSlot(device driver.VkDevice, privateDataSlot driver.VkPrivateDataSlot, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(privateDataSlot), core1_3.ObjectTypePrivateDataSlot, "VkPrivateDataSlot")
+}
+
+func (d *Driver) VkSetPrivateData(device driver.VkDevice, objectType driver.VkObjectType, objectHandle driver.Uint64, privateDataSlot driver.VkPrivateDataSlot, data driver.Uint64) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	slot := d.state.liveObject(driver.VulkanHandle(privateDataSlot), core1_3.ObjectTypePrivateDataSlot, "VkPrivateDataSlot")
+	if slot != nil {
+		if slot.privateData == nil {
+			slot.privateData = make(map[privateDataKey]uint64)
+		}
+		slot.privateData[privateDataKey{core1_0.ObjectType(objectType), driver.VulkanHandle(objectHandle)}] = uint64(data)
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkGetPrivateData(device driver.VkDevice, objectType driver.VkObjectType, objectHandle driver.Uint64, privateDataSlot driver.VkPrivateDataSlot, pData *driver.Uint64) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	*pData = 0
+	slot := d.state.liveObject(driver.VulkanHandle(privateDataSlot), core1_3.ObjectTypePrivateDataSlot, "VkPrivateDataSlot")
+	if slot != nil {
+		*pData = driver.Uint64(slot.privateData[privateDataKey{core1_0.ObjectType(objectType), driver.VulkanHandle(objectHandle)}])
+	}
+}
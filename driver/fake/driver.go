@@ -0,0 +1,131 @@
+package fake
+
+/*
+#include <stdlib.h>
+#include "../../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"sync"
+	"unsafe"
+)
+
+// Config controls the behavior of a fake Driver, including the Vulkan version it reports and the
+// PhysicalDevice objects it exposes.
+type Config struct {
+	// APIVersion is the Vulkan version reported by the Driver. If it is left zero, common.Vulkan1_2
+	// is used.
+	APIVersion common.APIVersion
+	// InstanceExtensions is the list of instance extensions reported as available
+	InstanceExtensions []core1_0.ExtensionProperties
+	// InstanceLayers is the list of instance layers reported as available
+	InstanceLayers []core1_0.LayerProperties
+	// PhysicalDevices is the list of PhysicalDevice objects reported by the Instance. If it is
+	// left empty, a single PhysicalDevice created by DefaultPhysicalDevice is used.
+	PhysicalDevices []PhysicalDevice
+	// MemoryAlignment is the alignment reported in the MemoryRequirements of Buffer and Image
+	// objects. If it is left zero, 256 is used.
+	MemoryAlignment int
+	// PrefersDedicatedSize is the size in bytes at or above which Buffer and Image objects report
+	// that they prefer a dedicated allocation through VkMemoryDedicatedRequirements. If it is
+	// left zero, dedicated allocations are never preferred.
+	PrefersDedicatedSize int
+}
+
+// Driver is a stateful, in-memory implementation of driver.Driver that does not communicate with
+// any Vulkan implementation. It hands out unique handles from create and allocate commands,
+// remembers the create info for the most commonly-inspected object types, tracks destroyed
+// objects, supports mapping host-visible memory, and signals fences on queue submission.
+//
+// It is intended to be passed to core.CreateLoaderFromDriver so that code built on vkngwrapper
+// can be exercised on machines without a GPU. Commands that record into a CommandBuffer are
+// accepted and counted. CmdCopyBuffer, CmdCopyBufferToImage, and CmdCopyImageToBuffer are
+// executed against the bound DeviceMemory when the CommandBuffer is submitted; other commands
+// have no other effect.
+type Driver struct {
+	state *driverState
+
+	instance driver.VkInstance
+	device   driver.VkDevice
+}
+
+var _ driver.Driver = &Driver{}
+
+// NewDriver creates a new fake Driver from the provided Config. Instance and Device drivers created
+// from the returned Driver share its state.
+func NewDriver(config Config) *Driver {
+	if config.APIVersion == 0 {
+		config.APIVersion = common.Vulkan1_2
+	}
+	if len(config.PhysicalDevices) == 0 {
+		config.PhysicalDevices = []PhysicalDevice{DefaultPhysicalDevice()}
+	}
+	if config.MemoryAlignment == 0 {
+		config.MemoryAlignment = 256
+	}
+
+	return &Driver{
+		state: &driverState{
+			config:     config,
+			objStore:   driver.NewObjectStore(),
+			objects:    make(map[driver.VulkanHandle]*fakeObject),
+			nextHandle: 0x1000,
+		},
+	}
+}
+
+type driverState struct {
+	lock sync.Mutex
+
+	config   Config
+	objStore *driver.VulkanObjectStore
+
+	nextHandle  driver.VulkanHandle
+	objects     map[driver.VulkanHandle]*fakeObject
+	order       []driver.VulkanHandle
+	submissions []Submission
+	errors      []error
+}
+
+func (d *Driver) ObjectStore() *driver.VulkanObjectStore {
+	return d.state.objStore
+}
+
+func (d *Driver) Destroy() {}
+
+func (d *Driver) CreateInstanceDriver(instance driver.VkInstance) (driver.Driver, error) {
+	return &Driver{
+		state:    d.state,
+		instance: instance,
+	}, nil
+}
+
+func (d *Driver) CreateDeviceDriver(device driver.VkDevice) (driver.Driver, error) {
+	return &Driver{
+		state:    d.state,
+		instance: d.instance,
+		device:   device,
+	}, nil
+}
+
+func (d *Driver) LoadProcAddr(name *driver.Char) unsafe.Pointer {
+	return nil
+}
+
+func (d *Driver) Version() common.APIVersion {
+	return d.state.config.APIVersion
+}
+
+// HasCommand returns true for every command provided by a core version up to Config.APIVersion
+func (d *Driver) HasCommand(name string) bool {
+	version, ok := driver.CommandVersion(name)
+	return ok && d.state.config.APIVersion.IsAtLeast(version)
+}
+
+func (d *Driver) VkEnumerateInstanceVersion(pApiVersion *driver.Uint32) (common.VkResult, error) {
+	*pApiVersion = driver.Uint32(d.state.config.APIVersion)
+	return core1_0.VKSuccess, nil
+}
@@ -0,0 +1,485 @@
+package fake_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+func createDevice(t *testing.T, fakeDriver *fake.Driver) (core1_0.Instance, core1_0.PhysicalDevice, core1_0.Device) {
+	loader, err := core.CreateLoaderFromDriver(fakeDriver)
+	require.NoError(t, err)
+
+	instance, _, err := loader.CreateInstance(nil, core1_0.InstanceCreateInfo{
+		APIVersion: common.Vulkan1_2,
+	})
+	require.NoError(t, err)
+
+	physicalDevices, _, err := instance.EnumeratePhysicalDevices()
+	require.NoError(t, err)
+	require.Len(t, physicalDevices, 1)
+
+	device, _, err := physicalDevices[0].CreateDevice(nil, core1_0.DeviceCreateInfo{
+		QueueCreateInfos: []core1_0.DeviceQueueCreateInfo{
+			{
+				QueueFamilyIndex: 0,
+				QueuePriorities:  []float32{1},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	return instance, physicalDevices[0], device
+}
+
+func TestDriver_PhysicalDevice(t *testing.T) {
+	physicalDevice := fake.DefaultPhysicalDevice()
+	physicalDevice.Properties.DriverName = "Test Device"
+	physicalDevice.Properties.Limits.MaxImageDimension2D = 4096
+
+	fakeDriver := fake.NewDriver(fake.Config{
+		APIVersion:      common.Vulkan1_1,
+		PhysicalDevices: []fake.PhysicalDevice{physicalDevice},
+	})
+	instance, physical, _ := createDevice(t, fakeDriver)
+	require.Equal(t, common.Vulkan1_1, instance.APIVersion())
+
+	properties, err := physical.Properties()
+	require.NoError(t, err)
+	require.Equal(t, "Test Device", properties.DriverName)
+	require.Equal(t, core1_0.PhysicalDeviceTypeDiscreteGPU, properties.DriverType)
+	require.Equal(t, 4096, properties.Limits.MaxImageDimension2D)
+	require.Equal(t, [3]int{1024, 1024, 64}, properties.Limits.MaxComputeWorkGroupSize)
+	require.True(t, properties.Limits.TimestampComputeAndGraphics)
+
+	memoryProperties := physical.MemoryProperties()
+	require.Equal(t, physicalDevice.MemoryProperties.MemoryTypes, memoryProperties.MemoryTypes)
+	require.Equal(t, physicalDevice.MemoryProperties.MemoryHeaps, memoryProperties.MemoryHeaps)
+
+	queueFamilies := physical.QueueFamilyProperties()
+	require.Len(t, queueFamilies, 1)
+	require.Equal(t, physicalDevice.QueueFamilies[0], *queueFamilies[0])
+}
+
+func TestDriver_ResourceFlow(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	instance, _, device := createDevice(t, fakeDriver)
+
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:  1000,
+		Usage: core1_0.BufferUsageTransferSrc,
+	})
+	require.NoError(t, err)
+
+	bufferObj, ok := fakeDriver.Object(driver.VulkanHandle(buffer.Handle()))
+	require.True(t, ok)
+	require.Equal(t, core1_0.ObjectTypeBuffer, bufferObj.Type)
+	require.Equal(t, driver.VulkanHandle(device.Handle()), bufferObj.Parent)
+	require.Equal(t, 1000, bufferObj.CreateInfo.(core1_0.BufferCreateInfo).Size)
+
+	requirements := buffer.MemoryRequirements()
+	require.Equal(t, 1024, requirements.Size)
+	require.Equal(t, 256, requirements.Alignment)
+
+	memory, _, err := device.AllocateMemory(nil, core1_0.MemoryAllocateInfo{
+		AllocationSize:  requirements.Size,
+		MemoryTypeIndex: 1,
+	})
+	require.NoError(t, err)
+
+	_, err = buffer.BindBufferMemory(memory, 0)
+	require.NoError(t, err)
+
+	bufferObj, _ = fakeDriver.Object(driver.VulkanHandle(buffer.Handle()))
+	require.Equal(t, memory.Handle(), bufferObj.Memory)
+
+	ptr, _, err := memory.Map(0, -1, 0)
+	require.NoError(t, err)
+	copy(unsafe.Slice((*byte)(ptr), 4), []byte{1, 2, 3, 4})
+	memory.Unmap()
+
+	require.Equal(t, []byte{1, 2, 3, 4}, fakeDriver.MemoryBytes(memory.Handle())[:4])
+
+	commandPool, _, err := device.CreateCommandPool(nil, core1_0.CommandPoolCreateInfo{})
+	require.NoError(t, err)
+
+	commandBuffers, _, err := device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        commandPool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 1,
+	})
+	require.NoError(t, err)
+
+	_, err = commandBuffers[0].Begin(core1_0.CommandBufferBeginInfo{})
+	require.NoError(t, err)
+	commandBuffers[0].CmdFillBuffer(buffer, 0, 1000, 0)
+	commandBuffers[0].CmdFillBuffer(buffer, 0, 1000, 1)
+	_, err = commandBuffers[0].End()
+	require.NoError(t, err)
+	require.Equal(t, 2, fakeDriver.CommandCount(commandBuffers[0].Handle()))
+
+	fence, _, err := device.CreateFence(nil, core1_0.FenceCreateInfo{})
+	require.NoError(t, err)
+
+	res, err := device.WaitForFences(true, time.Second, []core1_0.Fence{fence})
+	require.NoError(t, err)
+	require.Equal(t, core1_0.VKTimeout, res)
+
+	queue := device.GetQueue(0, 0)
+	_, err = queue.Submit(fence, []core1_0.SubmitInfo{
+		{
+			CommandBuffers: commandBuffers,
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, fakeDriver.FenceSignaled(fence.Handle()))
+
+	res, err = device.WaitForFences(true, time.Second, []core1_0.Fence{fence})
+	require.NoError(t, err)
+	require.Equal(t, core1_0.VKSuccess, res)
+
+	submissions := fakeDriver.Submissions()
+	require.Len(t, submissions, 1)
+	require.Equal(t, queue.Handle(), submissions[0].Queue)
+	require.Equal(t, []driver.VkCommandBuffer{commandBuffers[0].Handle()}, submissions[0].CommandBuffers)
+	require.Equal(t, fence.Handle(), submissions[0].Fence)
+
+	fence.Destroy(nil)
+	commandPool.Destroy(nil)
+	buffer.Destroy(nil)
+	memory.Free(nil)
+	device.Destroy(nil)
+	instance.Destroy(nil)
+
+	require.Empty(t, fakeDriver.LiveObjects())
+	require.Empty(t, fakeDriver.Errors())
+
+	commandBufferObj, _ := fakeDriver.Object(driver.VulkanHandle(commandBuffers[0].Handle()))
+	require.True(t, commandBufferObj.Destroyed)
+	require.Len(t, fakeDriver.Objects(core1_0.ObjectTypeBuffer), 1)
+}
+
+func TestDriver_MapDeviceLocalMemory(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	_, _, device := createDevice(t, fakeDriver)
+
+	memory, _, err := device.AllocateMemory(nil, core1_0.MemoryAllocateInfo{
+		AllocationSize:  256,
+		MemoryTypeIndex: 0,
+	})
+	require.NoError(t, err)
+
+	_, res, err := memory.Map(0, -1, 0)
+	require.Error(t, err)
+	require.Equal(t, core1_0.VKErrorMemoryMapFailed, res)
+	require.Nil(t, fakeDriver.MemoryBytes(memory.Handle()))
+	require.Len(t, fakeDriver.Errors(), 1)
+}
+
+func TestDriver_FlushMisalignedRange(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	_, _, device := createDevice(t, fakeDriver)
+
+	memory, _, err := device.AllocateMemory(nil, core1_0.MemoryAllocateInfo{
+		AllocationSize:  1000,
+		MemoryTypeIndex: 1,
+	})
+	require.NoError(t, err)
+
+	_, _, err = memory.Map(0, -1, 0)
+	require.NoError(t, err)
+
+	_, err = device.FlushMappedMemoryRanges([]core1_0.MappedMemoryRange{
+		{Memory: memory, Offset: 256, Size: 256},
+		{Memory: memory, Offset: 768, Size: 232},
+		{Memory: memory, Offset: 512, Size: -1},
+	})
+	require.NoError(t, err)
+	require.Empty(t, fakeDriver.Errors())
+
+	_, err = device.InvalidateMappedMemoryRanges([]core1_0.MappedMemoryRange{
+		{Memory: memory, Offset: 64, Size: 256},
+		{Memory: memory, Offset: 256, Size: 100},
+		{Memory: memory, Offset: 768, Size: 256},
+	})
+	require.NoError(t, err)
+	require.Len(t, fakeDriver.Errors(), 3)
+}
+
+func TestDriver_UseAfterDestroy(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	_, _, device := createDevice(t, fakeDriver)
+
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:  256,
+		Usage: core1_0.BufferUsageTransferSrc,
+	})
+	require.NoError(t, err)
+	require.Len(t, fakeDriver.LiveObjects(), 3)
+
+	buffer.Destroy(nil)
+	require.Len(t, fakeDriver.LiveObjects(), 2)
+	require.Empty(t, fakeDriver.Errors())
+
+	buffer.MemoryRequirements()
+	require.Len(t, fakeDriver.Errors(), 1)
+	require.Contains(t, fakeDriver.Errors()[0].Error(), "used after it was destroyed")
+
+	device.Destroy(nil)
+	require.Len(t, fakeDriver.Errors(), 1)
+}
+
+func TestDriver_DestroyDeviceWithLiveChildren(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	_, _, device := createDevice(t, fakeDriver)
+
+	_, _, err := device.CreateFence(nil, core1_0.FenceCreateInfo{
+		Flags: core1_0.FenceCreateSignaled,
+	})
+	require.NoError(t, err)
+
+	device.Destroy(nil)
+	require.Len(t, fakeDriver.Errors(), 1)
+	require.Contains(t, fakeDriver.Errors()[0].Error(), "was still alive")
+}
+
+func TestDriver_DeviceMemoryRequirements(t *testing.T) {
+	physicalDevice := fake.DefaultPhysicalDevice()
+	physicalDevice.Properties.APIVersion = common.Vulkan1_3
+	fakeDriver := fake.NewDriver(fake.Config{
+		PhysicalDevices:      []fake.PhysicalDevice{physicalDevice},
+		APIVersion:           common.Vulkan1_3,
+		MemoryAlignment:      256,
+		PrefersDedicatedSize: 4096,
+	})
+	loader, err := core.CreateLoaderFromDriver(fakeDriver)
+	require.NoError(t, err)
+
+	instance, _, err := loader.CreateInstance(nil, core1_0.InstanceCreateInfo{
+		APIVersion: common.Vulkan1_3,
+	})
+	require.NoError(t, err)
+
+	physicalDevices, _, err := instance.EnumeratePhysicalDevices()
+	require.NoError(t, err)
+
+	coreDevice, _, err := physicalDevices[0].CreateDevice(nil, core1_0.DeviceCreateInfo{
+		QueueCreateInfos: []core1_0.DeviceQueueCreateInfo{
+			{
+				QueueFamilyIndex: 0,
+				QueuePriorities:  []float32{1},
+			},
+		},
+	})
+	require.NoError(t, err)
+	device := core1_3.PromoteDevice(coreDevice)
+	require.NotNil(t, device)
+
+	bufferCreateInfo := core1_0.BufferCreateInfo{
+		Size:  1000,
+		Usage: core1_0.BufferUsageStorageBuffer,
+	}
+	dedicated := &core1_1.MemoryDedicatedRequirements{}
+	requirements := &core1_1.MemoryRequirements2{
+		NextOutData: common.NextOutData{Next: dedicated},
+	}
+	err = device.DeviceBufferMemoryRequirements(core1_3.DeviceBufferMemoryRequirements{CreateInfo: bufferCreateInfo}, requirements)
+	require.NoError(t, err)
+	require.Equal(t, 1024, requirements.MemoryRequirements.Size)
+	require.False(t, dedicated.PrefersDedicatedAllocation)
+	require.False(t, dedicated.RequiresDedicatedAllocation)
+
+	// The requirements match those of a Buffer created with the same create info
+	buffer, _, err := device.CreateBuffer(nil, bufferCreateInfo)
+	require.NoError(t, err)
+	require.Equal(t, requirements.MemoryRequirements, *buffer.MemoryRequirements())
+
+	imageCreateInfo := core1_0.ImageCreateInfo{
+		ImageType:   core1_0.ImageType2D,
+		Format:      core1_0.FormatR8G8B8A8UnsignedNormalized,
+		Extent:      core1_0.Extent3D{Width: 16, Height: 16, Depth: 1},
+		MipLevels:   1,
+		ArrayLayers: 1,
+		Samples:     core1_0.Samples1,
+		Tiling:      core1_0.ImageTilingOptimal,
+		Usage:       core1_0.ImageUsageSampled,
+	}
+	dedicated = &core1_1.MemoryDedicatedRequirements{}
+	requirements = &core1_1.MemoryRequirements2{
+		NextOutData: common.NextOutData{Next: dedicated},
+	}
+	err = device.DeviceImageMemoryRequirements(core1_3.DeviceImageMemoryRequirements{CreateInfo: imageCreateInfo}, requirements)
+	require.NoError(t, err)
+	require.Equal(t, 16*16*16, requirements.MemoryRequirements.Size)
+	require.True(t, dedicated.PrefersDedicatedAllocation)
+	require.False(t, dedicated.RequiresDedicatedAllocation)
+
+	buffer.Destroy(nil)
+	device.Destroy(nil)
+	instance.Destroy(nil)
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestDriver_PrivateData(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	_, _, device := createDevice(t, fakeDriver)
+	deviceDriver := device.Driver()
+
+	var slot driver.VkPrivateDataSlot
+	_, err := deviceDriver.VkCreatePrivateDataSlot(device.Handle(), nil, nil, &slot)
+	require.NoError(t, err)
+
+	_, err = deviceDriver.VkSetPrivateData(device.Handle(), driver.VkObjectType(core1_0.ObjectTypeDevice), driver.Uint64(device.Handle()), slot, 7)
+	require.NoError(t, err)
+
+	var data driver.Uint64
+	deviceDriver.VkGetPrivateData(device.Handle(), driver.VkObjectType(core1_0.ObjectTypeDevice), driver.Uint64(device.Handle()), slot, &data)
+	require.Equal(t, driver.Uint64(7), data)
+
+	deviceDriver.VkGetPrivateData(device.Handle(), driver.VkObjectType(core1_0.ObjectTypeBuffer), driver.Uint64(device.Handle()), slot, &data)
+	require.Equal(t, driver.Uint64(0), data)
+
+	deviceDriver.VkDestroyPrivateDataSlot(device.Handle(), slot, nil)
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestDriver_FormatProperties3(t *testing.T) {
+	physicalDevice := fake.DefaultPhysicalDevice()
+	physicalDevice.FormatProperties = map[core1_0.Format]core1_0.FormatProperties{
+		core1_0.FormatR8G8B8A8UnsignedNormalized: {
+			OptimalTilingFeatures: core1_0.FormatFeatureSampledImage | core1_0.FormatFeatureColorAttachment,
+			BufferFeatures:        core1_0.FormatFeatureVertexBuffer,
+		},
+	}
+
+	fakeDriver := fake.NewDriver(fake.Config{
+		PhysicalDevices: []fake.PhysicalDevice{physicalDevice},
+	})
+	_, physical, _ := createDevice(t, fakeDriver)
+
+	properties3 := &core1_3.FormatProperties3{}
+	err := core1_1.PromoteInstanceScopedPhysicalDevice(physical).FormatProperties2(
+		core1_0.FormatR8G8B8A8UnsignedNormalized,
+		&core1_1.FormatProperties2{
+			NextOutData: common.NextOutData{Next: properties3},
+		})
+	require.NoError(t, err)
+	require.Equal(t, core1_3.FormatFeature2SampledImage|core1_3.FormatFeature2ColorAttachment, properties3.OptimalTilingFeatures)
+	require.Equal(t, core1_3.FormatFeatureFlags2(0), properties3.LinearTilingFeatures)
+	require.Equal(t, core1_3.FormatFeature2VertexBuffer, properties3.BufferFeatures)
+}
+
+func TestDriver_ExecutesCopies(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	_, _, device := createDevice(t, fakeDriver)
+
+	createBuffer := func(memoryTypeIndex int) (core1_0.Buffer, core1_0.DeviceMemory) {
+		buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+			Size:  64,
+			Usage: core1_0.BufferUsageTransferSrc | core1_0.BufferUsageTransferDst,
+		})
+		require.NoError(t, err)
+
+		memory, _, err := device.AllocateMemory(nil, core1_0.MemoryAllocateInfo{
+			AllocationSize:  buffer.MemoryRequirements().Size,
+			MemoryTypeIndex: memoryTypeIndex,
+		})
+		require.NoError(t, err)
+
+		_, err = buffer.BindBufferMemory(memory, 0)
+		require.NoError(t, err)
+		return buffer, memory
+	}
+
+	src, srcMemory := createBuffer(1)
+	deviceLocal, _ := createBuffer(0)
+	dst, dstMemory := createBuffer(1)
+
+	image, _, err := device.CreateImage(nil, core1_0.ImageCreateInfo{
+		ImageType:   core1_0.ImageType2D,
+		Format:      core1_0.FormatR8G8UnsignedNormalized,
+		Extent:      core1_0.Extent3D{Width: 4, Height: 4, Depth: 1},
+		MipLevels:   1,
+		ArrayLayers: 1,
+		Samples:     core1_0.Samples1,
+		Usage:       core1_0.ImageUsageTransferSrc | core1_0.ImageUsageTransferDst,
+	})
+	require.NoError(t, err)
+	imageMemory, _, err := device.AllocateMemory(nil, core1_0.MemoryAllocateInfo{
+		AllocationSize:  image.MemoryRequirements().Size,
+		MemoryTypeIndex: 0,
+	})
+	require.NoError(t, err)
+	_, err = image.BindImageMemory(imageMemory, 0)
+	require.NoError(t, err)
+
+	ptr, _, err := srcMemory.Map(0, -1, 0)
+	require.NoError(t, err)
+	copy(unsafe.Slice((*byte)(ptr), 8), []byte{1, 2, 3, 4, 5, 6, 7, 8})
+	srcMemory.Unmap()
+
+	pool, _, err := device.CreateCommandPool(nil, core1_0.CommandPoolCreateInfo{})
+	require.NoError(t, err)
+	commandBuffers, _, err := device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        pool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 1,
+	})
+	require.NoError(t, err)
+	commandBuffer := commandBuffers[0]
+
+	region := core1_0.BufferImageCopy{
+		ImageSubresource: core1_0.ImageSubresourceLayers{
+			AspectMask: core1_0.ImageAspectColor,
+			LayerCount: 1,
+		},
+		ImageOffset: core1_0.Offset3D{X: 1, Y: 1},
+		ImageExtent: core1_0.Extent3D{Width: 2, Height: 2, Depth: 1},
+	}
+
+	_, err = commandBuffer.Begin(core1_0.CommandBufferBeginInfo{})
+	require.NoError(t, err)
+	// Stage through device-local memory, then through a 2x2 region of the Image, which is read
+	// back into a Buffer with a row length of 4 texels
+	require.NoError(t, commandBuffer.CmdCopyBuffer(src, deviceLocal, []core1_0.BufferCopy{{Size: 8}}))
+	require.NoError(t, commandBuffer.CmdCopyBufferToImage(deviceLocal, image, core1_0.ImageLayoutTransferDstOptimal, []core1_0.BufferImageCopy{region}))
+	region.BufferOffset = 16
+	region.BufferRowLength = 4
+	require.NoError(t, commandBuffer.CmdCopyImageToBuffer(image, core1_0.ImageLayoutTransferSrcOptimal, dst, []core1_0.BufferImageCopy{region}))
+	_, err = commandBuffer.End()
+	require.NoError(t, err)
+
+	// Nothing is copied until the CommandBuffer is submitted
+	require.Equal(t, make([]byte, 64), fakeDriver.MemoryBytes(dstMemory.Handle())[:64])
+
+	_, err = device.GetQueue(0, 0).Submit(nil, []core1_0.SubmitInfo{
+		{CommandBuffers: []core1_0.CommandBuffer{commandBuffer}},
+	})
+	require.NoError(t, err)
+
+	contents := fakeDriver.MemoryBytes(dstMemory.Handle())
+	require.Equal(t, []byte{1, 2, 3, 4, 0, 0, 0, 0, 5, 6, 7, 8}, contents[16:28])
+	require.Equal(t, make([]byte, 16), contents[:16])
+	require.Empty(t, fakeDriver.Errors())
+
+	// Copies past the end of a Buffer are recorded as errors
+	_, err = commandBuffer.Begin(core1_0.CommandBufferBeginInfo{})
+	require.NoError(t, err)
+	require.NoError(t, commandBuffer.CmdCopyBuffer(src, dst, []core1_0.BufferCopy{{SrcOffset: 60, Size: 8}}))
+	_, err = commandBuffer.End()
+	require.NoError(t, err)
+
+	_, err = device.GetQueue(0, 0).Submit(nil, []core1_0.SubmitInfo{
+		{CommandBuffers: []core1_0.CommandBuffer{commandBuffer}},
+	})
+	require.NoError(t, err)
+	require.Len(t, fakeDriver.Errors(), 1)
+}
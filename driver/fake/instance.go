@@ -0,0 +1,105 @@
+package fake
+
+/*
+#include <stdlib.h>
+#include <string.h>
+#include "../../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+func (d *Driver) VkEnumerateInstanceExtensionProperties(pLayerName *driver.Char, pPropertyCount *driver.Uint32, pProperties *driver.VkExtensionProperties) (common.VkResult, error) {
+	return writeExtensionProperties(d.state.config.InstanceExtensions, pPropertyCount, pProperties)
+}
+
+func (d *Driver) VkEnumerateInstanceLayerProperties(pPropertyCount *driver.Uint32, pProperties *driver.VkLayerProperties) (common.VkResult, error) {
+	return writeLayerProperties(d.state.config.InstanceLayers, pPropertyCount, pProperties)
+}
+
+func (d *Driver) VkCreateInstance(pCreateInfo *driver.VkInstanceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pInstance *driver.VkInstance) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	instance := d.state.createObject(core1_0.ObjectTypeInstance, 0, nil)
+	for i := range d.state.config.PhysicalDevices {
+		physicalDevice := d.state.createObject(core1_0.ObjectTypePhysicalDevice, instance.Handle, nil)
+		physicalDevice.physicalDevice = &d.state.config.PhysicalDevices[i]
+	}
+
+	*pInstance = driver.VkInstance(instance.Handle)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyInstance(instance driver.VkInstance, pAllocator *driver.VkAllocationCallbacks) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.destroyObject(driver.VulkanHandle(instance), core1_0.ObjectTypeInstance, "VkInstance")
+}
+
+func (d *Driver) physicalDeviceHandles(instance driver.VkInstance) []driver.VkPhysicalDevice {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	if d.state.liveObject(driver.VulkanHandle(instance), core1_0.ObjectTypeInstance, "VkInstance") == nil {
+		return nil
+	}
+
+	var handles []driver.VkPhysicalDevice
+	for _, child := range d.state.children(driver.VulkanHandle(instance)) {
+		if child.Type == core1_0.ObjectTypePhysicalDevice {
+			handles = append(handles, driver.VkPhysicalDevice(child.Handle))
+		}
+	}
+
+	return handles
+}
+
+func (d *Driver) VkEnumeratePhysicalDevices(instance driver.VkInstance, pPhysicalDeviceCount *driver.Uint32, pPhysicalDevices *driver.VkPhysicalDevice) (common.VkResult, error) {
+	handles := d.physicalDeviceHandles(instance)
+
+	if pPhysicalDevices == nil {
+		*pPhysicalDeviceCount = driver.Uint32(len(handles))
+		return core1_0.VKSuccess, nil
+	}
+
+	count := copy(unsafe.Slice(pPhysicalDevices, int(*pPhysicalDeviceCount)), handles)
+	*pPhysicalDeviceCount = driver.Uint32(count)
+
+	if count < len(handles) {
+		return core1_0.VKIncomplete, nil
+	}
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkEnumeratePhysicalDeviceGroups(instance driver.VkInstance, pPhysicalDeviceGroupCount *driver.Uint32, pPhysicalDeviceGroupProperties *driver.VkPhysicalDeviceGroupProperties) (common.VkResult, error) {
+	handles := d.physicalDeviceHandles(instance)
+
+	if pPhysicalDeviceGroupProperties == nil {
+		*pPhysicalDeviceGroupCount = driver.Uint32(len(handles))
+		return core1_0.VKSuccess, nil
+	}
+
+	count := int(*pPhysicalDeviceGroupCount)
+	if count > len(handles) {
+		count = len(handles)
+	}
+
+	groups := unsafe.Slice((*C.VkPhysicalDeviceGroupProperties)(unsafe.Pointer(pPhysicalDeviceGroupProperties)), count)
+	for i := 0; i < count; i++ {
+		groups[i].physicalDeviceCount = 1
+		*(*driver.VkPhysicalDevice)(unsafe.Pointer(&groups[i].physicalDevices[0])) = handles[i]
+		groups[i].subsetAllocation = C.VK_FALSE
+	}
+	*pPhysicalDeviceGroupCount = driver.Uint32(count)
+
+	if count < len(handles) {
+		return core1_0.VKIncomplete, nil
+	}
+	return core1_0.VKSuccess, nil
+}
@@ -0,0 +1,535 @@
+package fake
+
+/*
+#include <stdlib.h>
+#include <string.h>
+#include "../../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+type fakeMemory struct {
+	size        int
+	hostVisible bool
+	data        unsafe.Pointer
+	mapped      bool
+}
+
+func (m *fakeMemory) free() {
+	if m.data != nil {
+		C.free(m.data)
+		m.data = nil
+	}
+	m.mapped = false
+}
+
+// readHandle reads a handle from a field of a C struct
+func readHandle(field unsafe.Pointer) driver.VulkanHandle {
+	return *(*driver.VulkanHandle)(field)
+}
+
+func (d *Driver) VkAllocateMemory(device driver.VkDevice, pAllocateInfo *driver.VkMemoryAllocateInfo, pAllocator *driver.VkAllocationCallbacks, pMemory *driver.VkDeviceMemory) (common.VkResult, error) {
+	info := (*C.VkMemoryAllocateInfo)(unsafe.Pointer(pAllocateInfo))
+	allocateInfo := core1_0.MemoryAllocateInfo{
+		AllocationSize:  int(info.allocationSize),
+		MemoryTypeIndex: int(info.memoryTypeIndex),
+	}
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	deviceObj := d.state.liveObject(driver.VulkanHandle(device), core1_0.ObjectTypeDevice, "VkDevice")
+	if deviceObj == nil {
+		return core1_0.VKErrorOutOfDeviceMemory, core1_0.VKErrorOutOfDeviceMemory.ToError()
+	}
+
+	memoryTypes := deviceObj.physicalDevice.MemoryProperties.MemoryTypes
+	if allocateInfo.MemoryTypeIndex >= len(memoryTypes) {
+		d.state.recordError(errors.Newf("VkDeviceMemory was allocated from nonexistent memory type %d", allocateInfo.MemoryTypeIndex))
+		return core1_0.VKErrorOutOfDeviceMemory, core1_0.VKErrorOutOfDeviceMemory.ToError()
+	}
+
+	memory := &fakeMemory{
+		size:        allocateInfo.AllocationSize,
+		hostVisible: memoryTypes[allocateInfo.MemoryTypeIndex].PropertyFlags&core1_0.MemoryPropertyHostVisible != 0,
+	}
+
+	// Memory that is not host-visible is still backed so that transfer commands can copy to
+	// and from it
+	memory.data = C.calloc(C.size_t(maxInt(memory.size, 1)), 1)
+	if memory.data == nil {
+		return core1_0.VKErrorOutOfHostMemory, core1_0.VKErrorOutOfHostMemory.ToError()
+	}
+
+	obj := d.state.createObject(core1_0.ObjectTypeDeviceMemory, deviceObj.Handle, allocateInfo)
+	obj.memory = memory
+
+	*pMemory = driver.VkDeviceMemory(obj.Handle)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkFreeMemory(device driver.VkDevice, memory driver.VkDeviceMemory, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(memory), core1_0.ObjectTypeDeviceMemory, "VkDeviceMemory")
+}
+
+// VkMapMemory maps memory allocated from a host-visible memory type. Mapping memory that is not
+// host-visible or that is already mapped records an error and returns VKErrorMemoryMapFailed.
+func (d *Driver) VkMapMemory(device driver.VkDevice, memory driver.VkDeviceMemory, offset driver.VkDeviceSize, size driver.VkDeviceSize, flags driver.VkMemoryMapFlags, ppData *unsafe.Pointer) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(memory), core1_0.ObjectTypeDeviceMemory, "VkDeviceMemory")
+	if obj == nil {
+		return core1_0.VKErrorMemoryMapFailed, core1_0.VKErrorMemoryMapFailed.ToError()
+	}
+
+	if !obj.memory.hostVisible {
+		d.state.recordError(errors.Newf("VkDeviceMemory 0x%x was mapped but is not host-visible", obj.Handle))
+		return core1_0.VKErrorMemoryMapFailed, core1_0.VKErrorMemoryMapFailed.ToError()
+	}
+
+	if obj.memory.mapped {
+		d.state.recordError(errors.Newf("VkDeviceMemory 0x%x was mapped while already mapped", obj.Handle))
+		return core1_0.VKErrorMemoryMapFailed, core1_0.VKErrorMemoryMapFailed.ToError()
+	}
+
+	if int(offset) >= obj.memory.size || (size != C.VK_WHOLE_SIZE && int(offset)+int(size) > obj.memory.size) {
+		d.state.recordError(errors.Newf("VkDeviceMemory 0x%x was mapped at offset %d with size %d, but is only %d bytes", obj.Handle, offset, size, obj.memory.size))
+		return core1_0.VKErrorMemoryMapFailed, core1_0.VKErrorMemoryMapFailed.ToError()
+	}
+
+	obj.memory.mapped = true
+	*ppData = unsafe.Add(obj.memory.data, int(offset))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkUnmapMemory(device driver.VkDevice, memory driver.VkDeviceMemory) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(memory), core1_0.ObjectTypeDeviceMemory, "VkDeviceMemory")
+	if obj == nil {
+		return
+	}
+
+	if !obj.memory.mapped {
+		d.state.recordError(errors.Newf("VkDeviceMemory 0x%x was unmapped but is not mapped", obj.Handle))
+		return
+	}
+
+	obj.memory.mapped = false
+}
+
+func (d *Driver) checkMappedRanges(memoryRangeCount driver.Uint32, pMemoryRanges *driver.VkMappedMemoryRange) {
+	ranges := unsafe.Slice((*C.VkMappedMemoryRange)(unsafe.Pointer(pMemoryRanges)), int(memoryRangeCount))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	for i := range ranges {
+		obj := d.state.liveObject(readHandle(unsafe.Pointer(&ranges[i].memory)), core1_0.ObjectTypeDeviceMemory, "VkDeviceMemory")
+		if obj == nil {
+			continue
+		}
+		if !obj.memory.mapped {
+			d.state.recordError(errors.Newf("VkDeviceMemory 0x%x was flushed or invalidated but is not mapped", obj.Handle))
+			continue
+		}
+
+		atomSize := 1
+		if deviceObj, ok := d.state.objects[obj.Parent]; ok && deviceObj.physicalDevice != nil {
+			if limits := deviceObj.physicalDevice.Properties.Limits; limits != nil && limits.NonCoherentAtomSize > 1 {
+				atomSize = limits.NonCoherentAtomSize
+			}
+		}
+
+		offset := int(ranges[i].offset)
+		if offset%atomSize != 0 {
+			d.state.recordError(errors.Newf("VkDeviceMemory 0x%x was flushed or invalidated at offset %d, which is not a multiple of nonCoherentAtomSize %d", obj.Handle, offset, atomSize))
+		}
+		if ranges[i].size == C.VK_WHOLE_SIZE {
+			continue
+		}
+
+		size := int(ranges[i].size)
+		if offset+size > obj.memory.size {
+			d.state.recordError(errors.Newf("VkDeviceMemory 0x%x was flushed or invalidated at offset %d with size %d, but is only %d bytes", obj.Handle, offset, size, obj.memory.size))
+		} else if size%atomSize != 0 && offset+size != obj.memory.size {
+			d.state.recordError(errors.Newf("VkDeviceMemory 0x%x was flushed or invalidated with size %d, which is not a multiple of nonCoherentAtomSize %d", obj.Handle, size, atomSize))
+		}
+	}
+}
+
+func (d *Driver) VkFlushMappedMemoryRanges(device driver.VkDevice, memoryRangeCount driver.Uint32, pMemoryRanges *driver.VkMappedMemoryRange) (common.VkResult, error) {
+	d.checkMappedRanges(memoryRangeCount, pMemoryRanges)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkInvalidateMappedMemoryRanges(device driver.VkDevice, memoryRangeCount driver.Uint32, pMemoryRanges *driver.VkMappedMemoryRange) (common.VkResult, error) {
+	d.checkMappedRanges(memoryRangeCount, pMemoryRanges)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkGetDeviceMemoryCommitment(device driver.VkDevice, memory driver.VkDeviceMemory, pCommittedMemoryInBytes *driver.VkDeviceSize) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(memory), core1_0.ObjectTypeDeviceMemory, "VkDeviceMemory")
+	if obj == nil {
+		*pCommittedMemoryInBytes = 0
+		return
+	}
+
+	*pCommittedMemoryInBytes = driver.VkDeviceSize(obj.memory.size)
+}
+
+// MemoryBytes retrieves a copy of the contents of host-visible DeviceMemory. It returns nil if the
+// memory is not host-visible or has been freed.
+func (d *Driver) MemoryBytes(memory driver.VkDeviceMemory) []byte {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj, ok := d.state.objects[driver.VulkanHandle(memory)]
+	if !ok || obj.memory == nil || !obj.memory.hostVisible || obj.memory.data == nil {
+		return nil
+	}
+
+	return C.GoBytes(obj.memory.data, C.int(obj.memory.size))
+}
+
+// bufferCreateInfo converts a VkBufferCreateInfo to the core1_0.BufferCreateInfo that is recorded
+// for the Buffer
+func bufferCreateInfo(info *C.VkBufferCreateInfo) core1_0.BufferCreateInfo {
+	createInfo := core1_0.BufferCreateInfo{
+		Flags:       core1_0.BufferCreateFlags(info.flags),
+		Size:        int(info.size),
+		Usage:       core1_0.BufferUsageFlags(info.usage),
+		SharingMode: core1_0.SharingMode(info.sharingMode),
+	}
+
+	queueFamilyIndices := unsafe.Slice((*uint32)(unsafe.Pointer(info.pQueueFamilyIndices)), int(info.queueFamilyIndexCount))
+	for _, index := range queueFamilyIndices {
+		createInfo.QueueFamilyIndices = append(createInfo.QueueFamilyIndices, int(index))
+	}
+
+	return createInfo
+}
+
+func (d *Driver) VkCreateBuffer(device driver.VkDevice, pCreateInfo *driver.VkBufferCreateInfo, pAllocator *driver.VkAllocationCallbacks, pBuffer *driver.VkBuffer) (common.VkResult, error) {
+	createInfo := bufferCreateInfo((*C.VkBufferCreateInfo)(unsafe.Pointer(pCreateInfo)))
+	*pBuffer = driver.VkBuffer(d.create(device, core1_0.ObjectTypeBuffer, createInfo))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyBuffer(device driver.VkDevice, buffer driver.VkBuffer, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(buffer), core1_0.ObjectTypeBuffer, "VkBuffer")
+}
+
+// imageCreateInfo converts a VkImageCreateInfo to the core1_0.ImageCreateInfo that is recorded
+// for the Image
+func imageCreateInfo(info *C.VkImageCreateInfo) core1_0.ImageCreateInfo {
+	createInfo := core1_0.ImageCreateInfo{
+		Flags:     core1_0.ImageCreateFlags(info.flags),
+		ImageType: core1_0.ImageType(info.imageType),
+		Format:    core1_0.Format(info.format),
+		Extent: core1_0.Extent3D{
+			Width:  int(info.extent.width),
+			Height: int(info.extent.height),
+			Depth:  int(info.extent.depth),
+		},
+		MipLevels:     int(info.mipLevels),
+		ArrayLayers:   int(info.arrayLayers),
+		Samples:       core1_0.SampleCountFlags(info.samples),
+		Tiling:        core1_0.ImageTiling(info.tiling),
+		Usage:         core1_0.ImageUsageFlags(info.usage),
+		SharingMode:   core1_0.SharingMode(info.sharingMode),
+		InitialLayout: core1_0.ImageLayout(info.initialLayout),
+	}
+
+	queueFamilyIndices := unsafe.Slice((*uint32)(unsafe.Pointer(info.pQueueFamilyIndices)), int(info.queueFamilyIndexCount))
+	createInfo.QueueFamilyIndices = append(createInfo.QueueFamilyIndices, queueFamilyIndices...)
+
+	return createInfo
+}
+
+func (d *Driver) VkCreateImage(device driver.VkDevice, pCreateInfo *driver.VkImageCreateInfo, pAllocator *driver.VkAllocationCallbacks, pImage *driver.VkImage) (common.VkResult, error) {
+	createInfo := imageCreateInfo((*C.VkImageCreateInfo)(unsafe.Pointer(pCreateInfo)))
+	*pImage = driver.VkImage(d.create(device, core1_0.ObjectTypeImage, createInfo))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyImage(device driver.VkDevice, image driver.VkImage, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(image), core1_0.ObjectTypeImage, "VkImage")
+}
+
+func alignUp(value, alignment int) int {
+	return (value + alignment - 1) / alignment * alignment
+}
+
+// imageSize estimates the size of an Image as though every texel of every mip level occupied
+// 16 bytes, which is enough for any uncompressed format
+func imageSize(createInfo core1_0.ImageCreateInfo) int {
+	samples := int(createInfo.Samples)
+	if samples == 0 {
+		samples = 1
+	}
+
+	width, height, depth := createInfo.Extent.Width, createInfo.Extent.Height, createInfo.Extent.Depth
+	size := 0
+	for level := 0; level < createInfo.MipLevels || level == 0; level++ {
+		size += width * height * depth * 16
+
+		width = maxInt(width/2, 1)
+		height = maxInt(height/2, 1)
+		depth = maxInt(depth/2, 1)
+	}
+
+	return size * samples * maxInt(createInfo.ArrayLayers, 1)
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// requiredSize reports the number of bytes of memory a Buffer or Image requires, rounded up to the
+// configured alignment. The caller must hold the state lock.
+func (s *driverState) requiredSize(obj *fakeObject) int {
+	return s.createInfoSize(obj.CreateInfo)
+}
+
+// createInfoSize reports the number of bytes of memory a Buffer or Image created with the provided
+// create info would require, rounded up to the configured alignment. The caller must hold the
+// state lock.
+func (s *driverState) createInfoSize(createInfo any) int {
+	size := 0
+	switch createInfo := createInfo.(type) {
+	case core1_0.BufferCreateInfo:
+		size = createInfo.Size
+	case core1_0.ImageCreateInfo:
+		size = imageSize(createInfo)
+	}
+
+	return alignUp(size, s.config.MemoryAlignment)
+}
+
+// memoryRequirements reports requirements for a Buffer or Image. Every memory type is reported
+// as supported.
+func (d *Driver) memoryRequirements(handle driver.VulkanHandle, objectType core1_0.ObjectType, typeName string, p *C.VkMemoryRequirements) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	p.size = 0
+	p.alignment = C.VkDeviceSize(d.state.config.MemoryAlignment)
+	p.memoryTypeBits = 0
+
+	obj := d.state.liveObject(handle, objectType, typeName)
+	if obj == nil {
+		return
+	}
+
+	deviceObj := d.state.objects[obj.Parent]
+	p.size = C.VkDeviceSize(d.state.requiredSize(obj))
+	p.memoryTypeBits = C.uint32_t(1<<len(deviceObj.physicalDevice.MemoryProperties.MemoryTypes) - 1)
+}
+
+func (d *Driver) VkGetBufferMemoryRequirements(device driver.VkDevice, buffer driver.VkBuffer, pMemoryRequirements *driver.VkMemoryRequirements) {
+	d.memoryRequirements(driver.VulkanHandle(buffer), core1_0.ObjectTypeBuffer, "VkBuffer", (*C.VkMemoryRequirements)(unsafe.Pointer(pMemoryRequirements)))
+}
+
+func (d *Driver) VkGetImageMemoryRequirements(device driver.VkDevice, image driver.VkImage, pMemoryRequirements *driver.VkMemoryRequirements) {
+	d.memoryRequirements(driver.VulkanHandle(image), core1_0.ObjectTypeImage, "VkImage", (*C.VkMemoryRequirements)(unsafe.Pointer(pMemoryRequirements)))
+}
+
+func (d *Driver) VkGetBufferMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkBufferMemoryRequirementsInfo2, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	info := (*C.VkBufferMemoryRequirementsInfo2)(unsafe.Pointer(pInfo))
+	requirements := (*C.VkMemoryRequirements2)(unsafe.Pointer(pMemoryRequirements))
+	d.memoryRequirements(readHandle(unsafe.Pointer(&info.buffer)), core1_0.ObjectTypeBuffer, "VkBuffer", &requirements.memoryRequirements)
+	d.dedicatedRequirements(requirements)
+}
+
+func (d *Driver) VkGetImageMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkImageMemoryRequirementsInfo2, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	info := (*C.VkImageMemoryRequirementsInfo2)(unsafe.Pointer(pInfo))
+	requirements := (*C.VkMemoryRequirements2)(unsafe.Pointer(pMemoryRequirements))
+	d.memoryRequirements(readHandle(unsafe.Pointer(&info.image)), core1_0.ObjectTypeImage, "VkImage", &requirements.memoryRequirements)
+	d.dedicatedRequirements(requirements)
+}
+
+// dedicatedRequirements populates a VkMemoryDedicatedRequirements in the pNext chain of
+// requirements, if there is one, based on Config.PrefersDedicatedSize
+func (d *Driver) dedicatedRequirements(requirements *C.VkMemoryRequirements2) {
+	dedicated := (*C.VkMemoryDedicatedRequirements)(findNext(requirements.pNext, C.VK_STRUCTURE_TYPE_MEMORY_DEDICATED_REQUIREMENTS))
+	if dedicated == nil {
+		return
+	}
+
+	threshold := d.state.config.PrefersDedicatedSize
+	dedicated.prefersDedicatedAllocation = boolToC(threshold > 0 && int(requirements.memoryRequirements.size) >= threshold)
+	dedicated.requiresDedicatedAllocation = C.VK_FALSE
+}
+
+// deviceMemoryRequirements reports requirements for a Buffer or Image that has not been created
+func (d *Driver) deviceMemoryRequirements(device driver.VkDevice, createInfo any, p *C.VkMemoryRequirements) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	p.size = 0
+	p.alignment = C.VkDeviceSize(d.state.config.MemoryAlignment)
+	p.memoryTypeBits = 0
+
+	deviceObj := d.state.liveObject(driver.VulkanHandle(device), core1_0.ObjectTypeDevice, "VkDevice")
+	if deviceObj == nil {
+		return
+	}
+
+	p.size = C.VkDeviceSize(d.state.createInfoSize(createInfo))
+	p.memoryTypeBits = C.uint32_t(1<<len(deviceObj.physicalDevice.MemoryProperties.MemoryTypes) - 1)
+}
+
+func (d *Driver) VkGetDeviceBufferMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceBufferMemoryRequirements, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	info := (*C.VkDeviceBufferMemoryRequirements)(unsafe.Pointer(pInfo))
+	requirements := (*C.VkMemoryRequirements2)(unsafe.Pointer(pMemoryRequirements))
+	d.deviceMemoryRequirements(device, bufferCreateInfo(info.pCreateInfo), &requirements.memoryRequirements)
+	d.dedicatedRequirements(requirements)
+}
+
+func (d *Driver) VkGetDeviceImageMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceImageMemoryRequirements, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	info := (*C.VkDeviceImageMemoryRequirements)(unsafe.Pointer(pInfo))
+	requirements := (*C.VkMemoryRequirements2)(unsafe.Pointer(pMemoryRequirements))
+	d.deviceMemoryRequirements(device, imageCreateInfo(info.pCreateInfo), &requirements.memoryRequirements)
+	d.dedicatedRequirements(requirements)
+}
+
+func (d *Driver) VkGetDeviceImageSparseMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceImageMemoryRequirements, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements2) {
+	*pSparseMemoryRequirementCount = 0
+}
+
+func (d *Driver) VkGetImageSparseMemoryRequirements(device driver.VkDevice, image driver.VkImage, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements) {
+	*pSparseMemoryRequirementCount = 0
+}
+
+func (d *Driver) VkGetImageSparseMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkImageSparseMemoryRequirementsInfo2, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements2) {
+	*pSparseMemoryRequirementCount = 0
+}
+
+func (d *Driver) VkGetImageSubresourceLayout(device driver.VkDevice, image driver.VkImage, pSubresource *driver.VkImageSubresource, pLayout *driver.VkSubresourceLayout) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	layout := (*C.VkSubresourceLayout)(unsafe.Pointer(pLayout))
+	C.memset(unsafe.Pointer(layout), 0, C.sizeof_struct_VkSubresourceLayout)
+
+	obj := d.state.liveObject(driver.VulkanHandle(image), core1_0.ObjectTypeImage, "VkImage")
+	if obj == nil {
+		return
+	}
+
+	createInfo := obj.CreateInfo.(core1_0.ImageCreateInfo)
+	layout.rowPitch = C.VkDeviceSize(createInfo.Extent.Width * 16)
+	layout.depthPitch = C.VkDeviceSize(createInfo.Extent.Width * createInfo.Extent.Height * 16)
+	layout.arrayPitch = C.VkDeviceSize(createInfo.Extent.Width * createInfo.Extent.Height * createInfo.Extent.Depth * 16)
+	layout.size = layout.arrayPitch
+}
+
+// bindMemory records that a Buffer or Image has been bound to DeviceMemory. The caller must hold
+// the state lock.
+func (d *Driver) bindMemory(handle driver.VulkanHandle, objectType core1_0.ObjectType, typeName string, memory driver.VulkanHandle, offset int) {
+	obj := d.state.liveObject(handle, objectType, typeName)
+	memoryObj := d.state.liveObject(memory, core1_0.ObjectTypeDeviceMemory, "VkDeviceMemory")
+	if obj == nil || memoryObj == nil {
+		return
+	}
+
+	if obj.Memory != 0 {
+		d.state.recordError(errors.Newf("%s 0x%x was bound to memory while already bound", typeName, handle))
+		return
+	}
+
+	if offset%d.state.config.MemoryAlignment != 0 {
+		d.state.recordError(errors.Newf("%s 0x%x was bound to memory at offset %d, which is not aligned to %d", typeName, handle, offset, d.state.config.MemoryAlignment))
+	}
+
+	size := d.state.requiredSize(obj)
+	if offset+size > memoryObj.memory.size {
+		d.state.recordError(errors.Newf("%s 0x%x requires %d bytes but was bound at offset %d of VkDeviceMemory 0x%x, which is only %d bytes", typeName, handle, size, offset, memory, memoryObj.memory.size))
+	}
+
+	obj.Memory = driver.VkDeviceMemory(memory)
+	obj.MemoryOffset = offset
+}
+
+func (d *Driver) VkBindBufferMemory(device driver.VkDevice, buffer driver.VkBuffer, memory driver.VkDeviceMemory, memoryOffset driver.VkDeviceSize) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.bindMemory(driver.VulkanHandle(buffer), core1_0.ObjectTypeBuffer, "VkBuffer", driver.VulkanHandle(memory), int(memoryOffset))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkBindImageMemory(device driver.VkDevice, image driver.VkImage, memory driver.VkDeviceMemory, memoryOffset driver.VkDeviceSize) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.bindMemory(driver.VulkanHandle(image), core1_0.ObjectTypeImage, "VkImage", driver.VulkanHandle(memory), int(memoryOffset))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkBindBufferMemory2(device driver.VkDevice, bindInfoCount driver.Uint32, pBindInfos *driver.VkBindBufferMemoryInfo) (common.VkResult, error) {
+	bindInfos := unsafe.Slice((*C.VkBindBufferMemoryInfo)(unsafe.Pointer(pBindInfos)), int(bindInfoCount))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	for i := range bindInfos {
+		d.bindMemory(readHandle(unsafe.Pointer(&bindInfos[i].buffer)), core1_0.ObjectTypeBuffer, "VkBuffer", readHandle(unsafe.Pointer(&bindInfos[i].memory)), int(bindInfos[i].memoryOffset))
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkBindImageMemory2(device driver.VkDevice, bindInfoCount driver.Uint32, pBindInfos *driver.VkBindImageMemoryInfo) (common.VkResult, error) {
+	bindInfos := unsafe.Slice((*C.VkBindImageMemoryInfo)(unsafe.Pointer(pBindInfos)), int(bindInfoCount))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	for i := range bindInfos {
+		d.bindMemory(readHandle(unsafe.Pointer(&bindInfos[i].image)), core1_0.ObjectTypeImage, "VkImage", readHandle(unsafe.Pointer(&bindInfos[i].memory)), int(bindInfos[i].memoryOffset))
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkGetDeviceGroupPeerMemoryFeatures(device driver.VkDevice, heapIndex driver.Uint32, localDeviceIndex driver.Uint32, remoteDeviceIndex driver.Uint32, pPeerMemoryFeatures *driver.VkPeerMemoryFeatureFlags) {
+	*pPeerMemoryFeatures = C.VK_PEER_MEMORY_FEATURE_COPY_SRC_BIT | C.VK_PEER_MEMORY_FEATURE_COPY_DST_BIT |
+		C.VK_PEER_MEMORY_FEATURE_GENERIC_SRC_BIT | C.VK_PEER_MEMORY_FEATURE_GENERIC_DST_BIT
+}
+
+// VkGetBufferDeviceAddress returns an address derived from the Buffer's handle, so that every
+// Buffer has a distinct, nonzero address
+func (d *Driver) VkGetBufferDeviceAddress(device driver.VkDevice, pInfo *driver.VkBufferDeviceAddressInfo) driver.VkDeviceAddress {
+	info := (*C.VkBufferDeviceAddressInfo)(unsafe.Pointer(pInfo))
+	buffer := readHandle(unsafe.Pointer(&info.buffer))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(buffer, core1_0.ObjectTypeBuffer, "VkBuffer")
+	return driver.VkDeviceAddress(buffer) << 32
+}
+
+func (d *Driver) VkGetBufferOpaqueCaptureAddress(device driver.VkDevice, pInfo *driver.VkBufferDeviceAddressInfo) driver.Uint64 {
+	return 0
+}
+
+func (d *Driver) VkGetDeviceMemoryOpaqueCaptureAddress(device driver.VkDevice, pInfo *driver.VkDeviceMemoryOpaqueCaptureAddressInfo) driver.Uint64 {
+	return 0
+}
@@ -0,0 +1,228 @@
+package fake
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+// Object is a snapshot of an object created or allocated by a fake Driver
+type Object struct {
+	// Handle is the handle that was returned to the caller when the object was created
+	Handle driver.VulkanHandle
+	// Type is the type of the object
+	Type core1_0.ObjectType
+	// Parent is the handle of the object this object was created from: the Instance for
+	// PhysicalDevice objects, the PhysicalDevice for Device objects, the CommandPool for
+	// CommandBuffer objects, the DescriptorPool for DescriptorSet objects, and the Device for
+	// everything else
+	Parent driver.VulkanHandle
+	// CreateInfo is the create info the object was created with, decoded into its core1_0 type.
+	// It is populated for Device (core1_0.DeviceCreateInfo without EnabledFeatures), Buffer
+	// (core1_0.BufferCreateInfo), Image (core1_0.ImageCreateInfo), DeviceMemory
+	// (core1_0.MemoryAllocateInfo), Fence (core1_0.FenceCreateInfo), CommandPool
+	// (core1_0.CommandPoolCreateInfo), and QueryPool (core1_0.QueryPoolCreateInfo) objects,
+	// and is nil for all other object types. pNext chains are not decoded.
+	CreateInfo any
+	// Destroyed is true if the object has been destroyed or freed, either directly or through
+	// the destruction of its parent
+	Destroyed bool
+
+	// Memory is the DeviceMemory a Buffer or Image has been bound to, or 0 if it has not been
+	// bound
+	Memory driver.VkDeviceMemory
+	// MemoryOffset is the offset into Memory that a Buffer or Image has been bound to
+	MemoryOffset int
+}
+
+type fakeObject struct {
+	Object
+
+	physicalDevice *PhysicalDevice
+	queues         map[[2]int]driver.VkQueue
+
+	memory *fakeMemory
+
+	signaled  bool
+	timeline  bool
+	counter   uint64
+	recording bool
+	commands  int
+	// transfers are the transfer commands recorded to a CommandBuffer, which are executed each
+	// time it is submitted
+	transfers []func()
+
+	privateData map[privateDataKey]uint64
+}
+
+type privateDataKey struct {
+	objectType core1_0.ObjectType
+	handle     driver.VulkanHandle
+}
+
+func (s *driverState) createObject(objectType core1_0.ObjectType, parent driver.VulkanHandle, createInfo any) *fakeObject {
+	s.nextHandle++
+	obj := &fakeObject{
+		Object: Object{
+			Handle:     s.nextHandle,
+			Type:       objectType,
+			Parent:     parent,
+			CreateInfo: createInfo,
+		},
+	}
+
+	s.objects[obj.Handle] = obj
+	s.order = append(s.order, obj.Handle)
+
+	return obj
+}
+
+func (s *driverState) recordError(err error) {
+	s.errors = append(s.errors, err)
+}
+
+// liveObject retrieves the object with the provided handle. If the handle is unknown, refers to
+// an object of a different type, or refers to an object that has already been destroyed, an error
+// is recorded and nil is returned.
+func (s *driverState) liveObject(handle driver.VulkanHandle, objectType core1_0.ObjectType, typeName string) *fakeObject {
+	obj, ok := s.objects[handle]
+	if !ok {
+		s.recordError(errors.Newf("%s 0x%x was not created by this driver", typeName, handle))
+		return nil
+	}
+
+	if obj.Type != objectType {
+		s.recordError(errors.Newf("%s 0x%x is a %s", typeName, handle, obj.Type))
+		return nil
+	}
+
+	if obj.Destroyed {
+		s.recordError(errors.Newf("%s 0x%x was used after it was destroyed", typeName, handle))
+		return nil
+	}
+
+	return obj
+}
+
+// destroyObject marks the object with the provided handle as destroyed along with all of its
+// children. Destroying a null handle is a no-op, as it is in Vulkan.
+func (s *driverState) destroyObject(handle driver.VulkanHandle, objectType core1_0.ObjectType, typeName string) {
+	if handle == 0 {
+		return
+	}
+
+	obj := s.liveObject(handle, objectType, typeName)
+	if obj == nil {
+		return
+	}
+
+	s.destroyTree(obj)
+}
+
+func (s *driverState) destroyTree(obj *fakeObject) {
+	for _, child := range s.children(obj.Handle) {
+		s.destroyTree(child)
+	}
+
+	obj.Destroyed = true
+	if obj.memory != nil {
+		obj.memory.free()
+	}
+}
+
+func (s *driverState) children(handle driver.VulkanHandle) []*fakeObject {
+	var children []*fakeObject
+	for _, childHandle := range s.order {
+		child := s.objects[childHandle]
+		if child.Parent == handle && !child.Destroyed {
+			children = append(children, child)
+		}
+	}
+
+	return children
+}
+
+// Object retrieves a snapshot of the object with the provided handle, including objects that have
+// been destroyed. The second return value is false if the handle was never issued by this Driver.
+func (d *Driver) Object(handle driver.VulkanHandle) (Object, bool) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj, ok := d.state.objects[handle]
+	if !ok {
+		return Object{}, false
+	}
+
+	return obj.Object, true
+}
+
+// Objects retrieves snapshots of every object of the provided type that this Driver has created,
+// including objects that have been destroyed, in the order they were created
+func (d *Driver) Objects(objectType core1_0.ObjectType) []Object {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	var objects []Object
+	for _, handle := range d.state.order {
+		obj := d.state.objects[handle]
+		if obj.Type == objectType {
+			objects = append(objects, obj.Object)
+		}
+	}
+
+	return objects
+}
+
+// LiveObjects retrieves snapshots of every object this Driver has created that has not yet been
+// destroyed, in the order they were created. PhysicalDevice and Queue objects, which are never
+// destroyed directly by the caller, are not included.
+func (d *Driver) LiveObjects() []Object {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	var objects []Object
+	for _, handle := range d.state.order {
+		obj := d.state.objects[handle]
+		if obj.Destroyed || obj.Type == core1_0.ObjectTypePhysicalDevice || obj.Type == core1_0.ObjectTypeQueue {
+			continue
+		}
+
+		objects = append(objects, obj.Object)
+	}
+
+	return objects
+}
+
+// Errors retrieves every misuse this Driver has detected so far, such as using or destroying an
+// object after it has been destroyed, mapping memory that is not host-visible, or destroying a
+// Device that still has live children. The fake Driver reports misuse here instead of crashing,
+// so tests can assert that this slice is empty once a flow has completed.
+func (d *Driver) Errors() []error {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	return append([]error(nil), d.state.errors...)
+}
+
+// CommandCount retrieves the number of commands that have been recorded to the provided
+// CommandBuffer since it was last begun or reset
+func (d *Driver) CommandCount(commandBuffer driver.VkCommandBuffer) int {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj, ok := d.state.objects[driver.VulkanHandle(commandBuffer)]
+	if !ok {
+		return 0
+	}
+
+	return obj.commands
+}
+
+func handleSlice[T ~uintptr](p *T, count int) []T {
+	if p == nil || count == 0 {
+		return nil
+	}
+
+	return append([]T(nil), unsafe.Slice(p, count)...)
+}
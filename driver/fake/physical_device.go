@@ -0,0 +1,619 @@
+package fake
+
+/*
+#include <stdlib.h>
+#include <string.h>
+#include "../../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/CannibalVox/cgoparam"
+	"github.com/google/uuid"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"math/bits"
+	"unsafe"
+)
+
+// PhysicalDevice describes a PhysicalDevice reported by a fake Driver
+type PhysicalDevice struct {
+	// Properties is returned from PhysicalDevice.Properties. Properties.Limits and
+	// Properties.SparseProperties may be left nil, in which case they are reported as zero.
+	Properties core1_0.PhysicalDeviceProperties
+	// Features is returned from PhysicalDevice.Features
+	Features core1_0.PhysicalDeviceFeatures
+	// MemoryProperties is returned from PhysicalDevice.MemoryProperties. Memory allocated from
+	// a memory type that includes core1_0.MemoryPropertyHostVisible can be mapped.
+	MemoryProperties core1_0.PhysicalDeviceMemoryProperties
+	// QueueFamilies is returned from PhysicalDevice.QueueFamilyProperties
+	QueueFamilies []core1_0.QueueFamilyProperties
+	// Extensions is the list of device extensions reported as available
+	Extensions []core1_0.ExtensionProperties
+	// FormatProperties is returned from PhysicalDevice.FormatProperties. Formats that are not
+	// present in the map report no supported features.
+	FormatProperties map[core1_0.Format]core1_0.FormatProperties
+}
+
+// DefaultPhysicalDevice returns a PhysicalDevice resembling a modest discrete GPU: it has a
+// device-local heap and a host-visible heap, a single queue family supporting graphics,
+// compute, and transfer, and limits at or above the minimums required by the Vulkan spec.
+func DefaultPhysicalDevice() PhysicalDevice {
+	return PhysicalDevice{
+		Properties: core1_0.PhysicalDeviceProperties{
+			DriverType:        core1_0.PhysicalDeviceTypeDiscreteGPU,
+			DriverName:        "vkngwrapper fake device",
+			APIVersion:        common.Vulkan1_2,
+			DriverVersion:     common.CreateVersion(1, 0, 0),
+			VendorID:          0x10005,
+			DeviceID:          1,
+			PipelineCacheUUID: uuid.MustParse("6b3d9a52-4c1e-4f4b-8a16-66616b656465"),
+			Limits: &core1_0.PhysicalDeviceLimits{
+				MaxImageDimension1D:                   16384,
+				MaxImageDimension2D:                   16384,
+				MaxImageDimension3D:                   2048,
+				MaxImageDimensionCube:                 16384,
+				MaxImageArrayLayers:                   2048,
+				MaxTexelBufferElements:                134217728,
+				MaxUniformBufferRange:                 65536,
+				MaxStorageBufferRange:                 1 << 30,
+				MaxPushConstantsSize:                  256,
+				MaxMemoryAllocationCount:              4096,
+				MaxSamplerAllocationCount:             4000,
+				BufferImageGranularity:                1024,
+				MaxBoundDescriptorSets:                8,
+				MaxPerStageDescriptorSamplers:         16,
+				MaxPerStageDescriptorUniformBuffers:   15,
+				MaxPerStageDescriptorStorageBuffers:   16,
+				MaxPerStageDescriptorSampledImages:    128,
+				MaxPerStageDescriptorStorageImages:    8,
+				MaxPerStageDescriptorInputAttachments: 8,
+				MaxPerStageResources:                  200,
+				MaxDescriptorSetSamplers:              96,
+				MaxDescriptorSetUniformBuffers:        90,
+				MaxDescriptorSetUniformBuffersDynamic: 8,
+				MaxDescriptorSetStorageBuffers:        96,
+				MaxDescriptorSetStorageBuffersDynamic: 8,
+				MaxDescriptorSetSampledImages:         768,
+				MaxDescriptorSetStorageImages:         48,
+				MaxDescriptorSetInputAttachments:      8,
+				MaxVertexInputAttributes:              32,
+				MaxVertexInputBindings:                32,
+				MaxVertexInputAttributeOffset:         2047,
+				MaxVertexInputBindingStride:           2048,
+				MaxVertexOutputComponents:             128,
+				MaxFragmentInputComponents:            128,
+				MaxFragmentOutputAttachments:          8,
+				MaxFragmentDualSrcAttachments:         1,
+				MaxFragmentCombinedOutputResources:    16,
+				MaxComputeSharedMemorySize:            32768,
+				MaxComputeWorkGroupCount:              [3]int{65535, 65535, 65535},
+				MaxComputeWorkGroupInvocations:        1024,
+				MaxComputeWorkGroupSize:               [3]int{1024, 1024, 64},
+				SubPixelPrecisionBits:                 8,
+				SubTexelPrecisionBits:                 8,
+				MipmapPrecisionBits:                   8,
+				MaxDrawIndexedIndexValue:              0xffffffff,
+				MaxDrawIndirectCount:                  0xffffffff,
+				MaxSamplerLodBias:                     15,
+				MaxSamplerAnisotropy:                  16,
+				MaxViewports:                          16,
+				MaxViewportDimensions:                 [2]int{16384, 16384},
+				ViewportBoundsRange:                   [2]float32{-32768, 32767},
+				MinMemoryMapAlignment:                 64,
+				MinTexelBufferOffsetAlignment:         16,
+				MinUniformBufferOffsetAlignment:       256,
+				MinStorageBufferOffsetAlignment:       16,
+				MinTexelOffset:                        -8,
+				MaxTexelOffset:                        7,
+				MinTexelGatherOffset:                  -8,
+				MaxTexelGatherOffset:                  7,
+				MinInterpolationOffset:                -0.5,
+				MaxInterpolationOffset:                0.4375,
+				SubPixelInterpolationOffsetBits:       4,
+				MaxFramebufferWidth:                   16384,
+				MaxFramebufferHeight:                  16384,
+				MaxFramebufferLayers:                  1024,
+				FramebufferColorSampleCounts:          core1_0.Samples1 | core1_0.Samples4,
+				FramebufferDepthSampleCounts:          core1_0.Samples1 | core1_0.Samples4,
+				FramebufferStencilSampleCounts:        core1_0.Samples1 | core1_0.Samples4,
+				FramebufferNoAttachmentsSampleCounts:  core1_0.Samples1 | core1_0.Samples4,
+				MaxColorAttachments:                   8,
+				SampledImageColorSampleCounts:         core1_0.Samples1 | core1_0.Samples4,
+				SampledImageIntegerSampleCounts:       core1_0.Samples1,
+				SampledImageDepthSampleCounts:         core1_0.Samples1 | core1_0.Samples4,
+				SampledImageStencilSampleCounts:       core1_0.Samples1 | core1_0.Samples4,
+				StorageImageSampleCounts:              core1_0.Samples1,
+				MaxSampleMaskWords:                    1,
+				TimestampComputeAndGraphics:           true,
+				TimestampPeriod:                       1,
+				MaxClipDistances:                      8,
+				MaxCullDistances:                      8,
+				MaxCombinedClipAndCullDistances:       8,
+				DiscreteQueuePriorities:               2,
+				PointSizeRange:                        [2]float32{1, 64},
+				LineWidthRange:                        [2]float32{1, 1},
+				PointSizeGranularity:                  1,
+				LineWidthGranularity:                  1,
+				StandardSampleLocations:               true,
+				OptimalBufferCopyOffsetAlignment:      1,
+				OptimalBufferCopyRowPitchAlignment:    1,
+				NonCoherentAtomSize:                   256,
+			},
+			SparseProperties: &core1_0.PhysicalDeviceSparseProperties{},
+		},
+		MemoryProperties: core1_0.PhysicalDeviceMemoryProperties{
+			MemoryTypes: []core1_0.MemoryType{
+				{
+					PropertyFlags: core1_0.MemoryPropertyDeviceLocal,
+					HeapIndex:     0,
+				},
+				{
+					PropertyFlags: core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent,
+					HeapIndex:     1,
+				},
+				{
+					PropertyFlags: core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent | core1_0.MemoryPropertyHostCached,
+					HeapIndex:     1,
+				},
+			},
+			MemoryHeaps: []core1_0.MemoryHeap{
+				{
+					Size:  4 << 30,
+					Flags: core1_0.MemoryHeapDeviceLocal,
+				},
+				{
+					Size: 8 << 30,
+				},
+			},
+		},
+		QueueFamilies: []core1_0.QueueFamilyProperties{
+			{
+				QueueFlags:                  core1_0.QueueGraphics | core1_0.QueueCompute | core1_0.QueueTransfer,
+				QueueCount:                  4,
+				TimestampValidBits:          64,
+				MinImageTransferGranularity: core1_0.Extent3D{Width: 1, Height: 1, Depth: 1},
+			},
+		},
+	}
+}
+
+func boolToC(b bool) C.VkBool32 {
+	if b {
+		return C.VK_TRUE
+	}
+	return C.VK_FALSE
+}
+
+func copyString(dst *C.char, size int, str string) {
+	buffer := unsafe.Slice((*byte)(unsafe.Pointer(dst)), size)
+	n := copy(buffer[:size-1], str)
+	buffer[n] = 0
+}
+
+func (d *Driver) lookupPhysicalDevice(physicalDevice driver.VkPhysicalDevice) *PhysicalDevice {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(physicalDevice), core1_0.ObjectTypePhysicalDevice, "VkPhysicalDevice")
+	if obj == nil {
+		return &PhysicalDevice{}
+	}
+
+	return obj.physicalDevice
+}
+
+func writeLimits(l *C.VkPhysicalDeviceLimits, limits *core1_0.PhysicalDeviceLimits) {
+	if limits == nil {
+		return
+	}
+
+	l.maxImageDimension1D = C.uint32_t(limits.MaxImageDimension1D)
+	l.maxImageDimension2D = C.uint32_t(limits.MaxImageDimension2D)
+	l.maxImageDimension3D = C.uint32_t(limits.MaxImageDimension3D)
+	l.maxImageDimensionCube = C.uint32_t(limits.MaxImageDimensionCube)
+	l.maxImageArrayLayers = C.uint32_t(limits.MaxImageArrayLayers)
+	l.maxTexelBufferElements = C.uint32_t(limits.MaxTexelBufferElements)
+	l.maxUniformBufferRange = C.uint32_t(limits.MaxUniformBufferRange)
+	l.maxStorageBufferRange = C.uint32_t(limits.MaxStorageBufferRange)
+	l.maxPushConstantsSize = C.uint32_t(limits.MaxPushConstantsSize)
+	l.maxMemoryAllocationCount = C.uint32_t(limits.MaxMemoryAllocationCount)
+	l.maxSamplerAllocationCount = C.uint32_t(limits.MaxSamplerAllocationCount)
+	l.bufferImageGranularity = C.VkDeviceSize(limits.BufferImageGranularity)
+	l.sparseAddressSpaceSize = C.VkDeviceSize(limits.SparseAddressSpaceSize)
+	l.maxBoundDescriptorSets = C.uint32_t(limits.MaxBoundDescriptorSets)
+	l.maxPerStageDescriptorSamplers = C.uint32_t(limits.MaxPerStageDescriptorSamplers)
+	l.maxPerStageDescriptorUniformBuffers = C.uint32_t(limits.MaxPerStageDescriptorUniformBuffers)
+	l.maxPerStageDescriptorStorageBuffers = C.uint32_t(limits.MaxPerStageDescriptorStorageBuffers)
+	l.maxPerStageDescriptorSampledImages = C.uint32_t(limits.MaxPerStageDescriptorSampledImages)
+	l.maxPerStageDescriptorStorageImages = C.uint32_t(limits.MaxPerStageDescriptorStorageImages)
+	l.maxPerStageDescriptorInputAttachments = C.uint32_t(limits.MaxPerStageDescriptorInputAttachments)
+	l.maxPerStageResources = C.uint32_t(limits.MaxPerStageResources)
+	l.maxDescriptorSetSamplers = C.uint32_t(limits.MaxDescriptorSetSamplers)
+	l.maxDescriptorSetUniformBuffers = C.uint32_t(limits.MaxDescriptorSetUniformBuffers)
+	l.maxDescriptorSetUniformBuffersDynamic = C.uint32_t(limits.MaxDescriptorSetUniformBuffersDynamic)
+	l.maxDescriptorSetStorageBuffers = C.uint32_t(limits.MaxDescriptorSetStorageBuffers)
+	l.maxDescriptorSetStorageBuffersDynamic = C.uint32_t(limits.MaxDescriptorSetStorageBuffersDynamic)
+	l.maxDescriptorSetSampledImages = C.uint32_t(limits.MaxDescriptorSetSampledImages)
+	l.maxDescriptorSetStorageImages = C.uint32_t(limits.MaxDescriptorSetStorageImages)
+	l.maxDescriptorSetInputAttachments = C.uint32_t(limits.MaxDescriptorSetInputAttachments)
+	l.maxVertexInputAttributes = C.uint32_t(limits.MaxVertexInputAttributes)
+	l.maxVertexInputBindings = C.uint32_t(limits.MaxVertexInputBindings)
+	l.maxVertexInputAttributeOffset = C.uint32_t(limits.MaxVertexInputAttributeOffset)
+	l.maxVertexInputBindingStride = C.uint32_t(limits.MaxVertexInputBindingStride)
+	l.maxVertexOutputComponents = C.uint32_t(limits.MaxVertexOutputComponents)
+	l.maxTessellationGenerationLevel = C.uint32_t(limits.MaxTessellationGenerationLevel)
+	l.maxTessellationPatchSize = C.uint32_t(limits.MaxTessellationPatchSize)
+	l.maxTessellationControlPerVertexInputComponents = C.uint32_t(limits.MaxTessellationControlPerVertexInputComponents)
+	l.maxTessellationControlPerVertexOutputComponents = C.uint32_t(limits.MaxTessellationControlPerVertexOutputComponents)
+	l.maxTessellationControlPerPatchOutputComponents = C.uint32_t(limits.MaxTessellationControlPerPatchOutputComponents)
+	l.maxTessellationControlTotalOutputComponents = C.uint32_t(limits.MaxTessellationControlTotalOutputComponents)
+	l.maxTessellationEvaluationInputComponents = C.uint32_t(limits.MaxTessellationEvaluationInputComponents)
+	l.maxTessellationEvaluationOutputComponents = C.uint32_t(limits.MaxTessellationEvaluationOutputComponents)
+	l.maxGeometryShaderInvocations = C.uint32_t(limits.MaxGeometryShaderInvocations)
+	l.maxGeometryInputComponents = C.uint32_t(limits.MaxGeometryInputComponents)
+	l.maxGeometryOutputComponents = C.uint32_t(limits.MaxGeometryOutputComponents)
+	l.maxGeometryOutputVertices = C.uint32_t(limits.MaxGeometryOutputVertices)
+	l.maxGeometryTotalOutputComponents = C.uint32_t(limits.MaxGeometryTotalOutputComponents)
+	l.maxFragmentInputComponents = C.uint32_t(limits.MaxFragmentInputComponents)
+	l.maxFragmentOutputAttachments = C.uint32_t(limits.MaxFragmentOutputAttachments)
+	l.maxFragmentDualSrcAttachments = C.uint32_t(limits.MaxFragmentDualSrcAttachments)
+	l.maxFragmentCombinedOutputResources = C.uint32_t(limits.MaxFragmentCombinedOutputResources)
+	l.maxComputeSharedMemorySize = C.uint32_t(limits.MaxComputeSharedMemorySize)
+	l.maxComputeWorkGroupInvocations = C.uint32_t(limits.MaxComputeWorkGroupInvocations)
+	l.subPixelPrecisionBits = C.uint32_t(limits.SubPixelPrecisionBits)
+	l.subTexelPrecisionBits = C.uint32_t(limits.SubTexelPrecisionBits)
+	l.mipmapPrecisionBits = C.uint32_t(limits.MipmapPrecisionBits)
+	l.maxDrawIndexedIndexValue = C.uint32_t(limits.MaxDrawIndexedIndexValue)
+	l.maxDrawIndirectCount = C.uint32_t(limits.MaxDrawIndirectCount)
+	l.maxSamplerLodBias = C.float(limits.MaxSamplerLodBias)
+	l.maxSamplerAnisotropy = C.float(limits.MaxSamplerAnisotropy)
+	l.maxViewports = C.uint32_t(limits.MaxViewports)
+	l.viewportSubPixelBits = C.uint32_t(limits.ViewportSubPixelBits)
+	l.minMemoryMapAlignment = C.size_t(limits.MinMemoryMapAlignment)
+	l.minTexelBufferOffsetAlignment = C.VkDeviceSize(limits.MinTexelBufferOffsetAlignment)
+	l.minUniformBufferOffsetAlignment = C.VkDeviceSize(limits.MinUniformBufferOffsetAlignment)
+	l.minStorageBufferOffsetAlignment = C.VkDeviceSize(limits.MinStorageBufferOffsetAlignment)
+	l.minTexelOffset = C.int32_t(limits.MinTexelOffset)
+	l.maxTexelOffset = C.uint32_t(limits.MaxTexelOffset)
+	l.minTexelGatherOffset = C.int32_t(limits.MinTexelGatherOffset)
+	l.maxTexelGatherOffset = C.uint32_t(limits.MaxTexelGatherOffset)
+	l.minInterpolationOffset = C.float(limits.MinInterpolationOffset)
+	l.maxInterpolationOffset = C.float(limits.MaxInterpolationOffset)
+	l.subPixelInterpolationOffsetBits = C.uint32_t(limits.SubPixelInterpolationOffsetBits)
+	l.maxFramebufferWidth = C.uint32_t(limits.MaxFramebufferWidth)
+	l.maxFramebufferHeight = C.uint32_t(limits.MaxFramebufferHeight)
+	l.maxFramebufferLayers = C.uint32_t(limits.MaxFramebufferLayers)
+	l.framebufferColorSampleCounts = C.VkSampleCountFlags(limits.FramebufferColorSampleCounts)
+	l.framebufferDepthSampleCounts = C.VkSampleCountFlags(limits.FramebufferDepthSampleCounts)
+	l.framebufferStencilSampleCounts = C.VkSampleCountFlags(limits.FramebufferStencilSampleCounts)
+	l.framebufferNoAttachmentsSampleCounts = C.VkSampleCountFlags(limits.FramebufferNoAttachmentsSampleCounts)
+	l.maxColorAttachments = C.uint32_t(limits.MaxColorAttachments)
+	l.sampledImageColorSampleCounts = C.VkSampleCountFlags(limits.SampledImageColorSampleCounts)
+	l.sampledImageIntegerSampleCounts = C.VkSampleCountFlags(limits.SampledImageIntegerSampleCounts)
+	l.sampledImageDepthSampleCounts = C.VkSampleCountFlags(limits.SampledImageDepthSampleCounts)
+	l.sampledImageStencilSampleCounts = C.VkSampleCountFlags(limits.SampledImageStencilSampleCounts)
+	l.storageImageSampleCounts = C.VkSampleCountFlags(limits.StorageImageSampleCounts)
+	l.maxSampleMaskWords = C.uint32_t(limits.MaxSampleMaskWords)
+	l.timestampPeriod = C.float(limits.TimestampPeriod)
+	l.maxClipDistances = C.uint32_t(limits.MaxClipDistances)
+	l.maxCullDistances = C.uint32_t(limits.MaxCullDistances)
+	l.maxCombinedClipAndCullDistances = C.uint32_t(limits.MaxCombinedClipAndCullDistances)
+	l.discreteQueuePriorities = C.uint32_t(limits.DiscreteQueuePriorities)
+	l.pointSizeGranularity = C.float(limits.PointSizeGranularity)
+	l.lineWidthGranularity = C.float(limits.LineWidthGranularity)
+	l.optimalBufferCopyOffsetAlignment = C.VkDeviceSize(limits.OptimalBufferCopyOffsetAlignment)
+	l.optimalBufferCopyRowPitchAlignment = C.VkDeviceSize(limits.OptimalBufferCopyRowPitchAlignment)
+	l.nonCoherentAtomSize = C.VkDeviceSize(limits.NonCoherentAtomSize)
+	l.maxComputeWorkGroupCount[0] = C.uint32_t(limits.MaxComputeWorkGroupCount[0])
+	l.maxComputeWorkGroupCount[1] = C.uint32_t(limits.MaxComputeWorkGroupCount[1])
+	l.maxComputeWorkGroupCount[2] = C.uint32_t(limits.MaxComputeWorkGroupCount[2])
+	l.maxComputeWorkGroupSize[0] = C.uint32_t(limits.MaxComputeWorkGroupSize[0])
+	l.maxComputeWorkGroupSize[1] = C.uint32_t(limits.MaxComputeWorkGroupSize[1])
+	l.maxComputeWorkGroupSize[2] = C.uint32_t(limits.MaxComputeWorkGroupSize[2])
+	l.maxViewportDimensions[0] = C.uint32_t(limits.MaxViewportDimensions[0])
+	l.maxViewportDimensions[1] = C.uint32_t(limits.MaxViewportDimensions[1])
+	l.viewportBoundsRange[0] = C.float(limits.ViewportBoundsRange[0])
+	l.viewportBoundsRange[1] = C.float(limits.ViewportBoundsRange[1])
+	l.pointSizeRange[0] = C.float(limits.PointSizeRange[0])
+	l.pointSizeRange[1] = C.float(limits.PointSizeRange[1])
+	l.lineWidthRange[0] = C.float(limits.LineWidthRange[0])
+	l.lineWidthRange[1] = C.float(limits.LineWidthRange[1])
+	l.timestampComputeAndGraphics = boolToC(limits.TimestampComputeAndGraphics)
+	l.strictLines = boolToC(limits.StrictLines)
+	l.standardSampleLocations = boolToC(limits.StandardSampleLocations)
+
+}
+
+func writeProperties(p *C.VkPhysicalDeviceProperties, device *PhysicalDevice) {
+	properties := &device.Properties
+	C.memset(unsafe.Pointer(p), 0, C.sizeof_struct_VkPhysicalDeviceProperties)
+
+	p.apiVersion = C.uint32_t(properties.APIVersion)
+	p.driverVersion = C.uint32_t(properties.DriverVersion)
+	p.vendorID = C.uint32_t(properties.VendorID)
+	p.deviceID = C.uint32_t(properties.DeviceID)
+	p.deviceType = C.VkPhysicalDeviceType(properties.DriverType)
+	copyString(&p.deviceName[0], C.VK_MAX_PHYSICAL_DEVICE_NAME_SIZE, properties.DriverName)
+
+	uuidBytes := unsafe.Slice((*byte)(unsafe.Pointer(&p.pipelineCacheUUID[0])), C.VK_UUID_SIZE)
+	copy(uuidBytes, properties.PipelineCacheUUID[:])
+
+	writeLimits(&p.limits, properties.Limits)
+
+	if properties.SparseProperties != nil {
+		sparse := properties.SparseProperties
+		p.sparseProperties.residencyStandard2DBlockShape = boolToC(sparse.ResidencyStandard2DBlockShape)
+		p.sparseProperties.residencyStandard2DMultisampleBlockShape = boolToC(sparse.ResidencyStandard2DMultisampleBlockShape)
+		p.sparseProperties.residencyStandard3DBlockShape = boolToC(sparse.ResidencyStandard3DBlockShape)
+		p.sparseProperties.residencyNonResidentStrict = boolToC(sparse.ResidencyNonResidentStrict)
+		p.sparseProperties.residencyAlignedMipSize = boolToC(sparse.ResidencyAlignedMipSize)
+	}
+}
+
+func writeQueueFamilyProperties(p *C.VkQueueFamilyProperties, family core1_0.QueueFamilyProperties) {
+	p.queueFlags = C.VkQueueFlags(family.QueueFlags)
+	p.queueCount = C.uint32_t(family.QueueCount)
+	p.timestampValidBits = C.uint32_t(family.TimestampValidBits)
+	p.minImageTransferGranularity.width = C.uint32_t(family.MinImageTransferGranularity.Width)
+	p.minImageTransferGranularity.height = C.uint32_t(family.MinImageTransferGranularity.Height)
+	p.minImageTransferGranularity.depth = C.uint32_t(family.MinImageTransferGranularity.Depth)
+}
+
+func writeMemoryProperties(p *C.VkPhysicalDeviceMemoryProperties, properties core1_0.PhysicalDeviceMemoryProperties) {
+	C.memset(unsafe.Pointer(p), 0, C.sizeof_struct_VkPhysicalDeviceMemoryProperties)
+
+	p.memoryTypeCount = C.uint32_t(len(properties.MemoryTypes))
+	for i, memoryType := range properties.MemoryTypes {
+		p.memoryTypes[i].propertyFlags = C.VkMemoryPropertyFlags(memoryType.PropertyFlags)
+		p.memoryTypes[i].heapIndex = C.uint32_t(memoryType.HeapIndex)
+	}
+
+	p.memoryHeapCount = C.uint32_t(len(properties.MemoryHeaps))
+	for i, heap := range properties.MemoryHeaps {
+		p.memoryHeaps[i].size = C.VkDeviceSize(heap.Size)
+		p.memoryHeaps[i].flags = C.VkMemoryHeapFlags(heap.Flags)
+	}
+}
+
+func writeFormatProperties(p *C.VkFormatProperties, device *PhysicalDevice, format driver.VkFormat) {
+	properties := device.FormatProperties[core1_0.Format(format)]
+	p.linearTilingFeatures = C.VkFormatFeatureFlags(properties.LinearTilingFeatures)
+	p.optimalTilingFeatures = C.VkFormatFeatureFlags(properties.OptimalTilingFeatures)
+	p.bufferFeatures = C.VkFormatFeatureFlags(properties.BufferFeatures)
+}
+
+func writeExtensionProperties(extensions []core1_0.ExtensionProperties, pPropertyCount *driver.Uint32, pProperties *driver.VkExtensionProperties) (common.VkResult, error) {
+	if pProperties == nil {
+		*pPropertyCount = driver.Uint32(len(extensions))
+		return core1_0.VKSuccess, nil
+	}
+
+	count := int(*pPropertyCount)
+	if count > len(extensions) {
+		count = len(extensions)
+	}
+
+	properties := unsafe.Slice((*C.VkExtensionProperties)(unsafe.Pointer(pProperties)), count)
+	for i := 0; i < count; i++ {
+		copyString(&properties[i].extensionName[0], C.VK_MAX_EXTENSION_NAME_SIZE, extensions[i].ExtensionName)
+		properties[i].specVersion = C.uint32_t(extensions[i].SpecVersion)
+	}
+	*pPropertyCount = driver.Uint32(count)
+
+	if count < len(extensions) {
+		return core1_0.VKIncomplete, nil
+	}
+	return core1_0.VKSuccess, nil
+}
+
+func writeLayerProperties(layers []core1_0.LayerProperties, pPropertyCount *driver.Uint32, pProperties *driver.VkLayerProperties) (common.VkResult, error) {
+	if pProperties == nil {
+		*pPropertyCount = driver.Uint32(len(layers))
+		return core1_0.VKSuccess, nil
+	}
+
+	count := int(*pPropertyCount)
+	if count > len(layers) {
+		count = len(layers)
+	}
+
+	properties := unsafe.Slice((*C.VkLayerProperties)(unsafe.Pointer(pProperties)), count)
+	for i := 0; i < count; i++ {
+		copyString(&properties[i].layerName[0], C.VK_MAX_EXTENSION_NAME_SIZE, layers[i].LayerName)
+		properties[i].specVersion = C.uint32_t(layers[i].SpecVersion)
+		properties[i].implementationVersion = C.uint32_t(layers[i].ImplementationVersion)
+		copyString(&properties[i].description[0], C.VK_MAX_DESCRIPTION_SIZE, layers[i].Description)
+	}
+	*pPropertyCount = driver.Uint32(count)
+
+	if count < len(layers) {
+		return core1_0.VKIncomplete, nil
+	}
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkGetPhysicalDeviceFeatures(physicalDevice driver.VkPhysicalDevice, pFeatures *driver.VkPhysicalDeviceFeatures) {
+	device := d.lookupPhysicalDevice(physicalDevice)
+
+	arena := cgoparam.GetAlloc()
+	defer cgoparam.ReturnAlloc(arena)
+
+	_, _ = device.Features.PopulateCPointer(arena, unsafe.Pointer(pFeatures))
+}
+
+func (d *Driver) VkGetPhysicalDeviceFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, pFormatProperties *driver.VkFormatProperties) {
+	device := d.lookupPhysicalDevice(physicalDevice)
+	writeFormatProperties((*C.VkFormatProperties)(unsafe.Pointer(pFormatProperties)), device, format)
+}
+
+func (d *Driver) imageFormatProperties(device *PhysicalDevice, format driver.VkFormat, tiling driver.VkImageTiling, p *C.VkImageFormatProperties) (common.VkResult, error) {
+	C.memset(unsafe.Pointer(p), 0, C.sizeof_struct_VkImageFormatProperties)
+
+	formatProperties := device.FormatProperties[core1_0.Format(format)]
+	features := formatProperties.OptimalTilingFeatures
+	if core1_0.ImageTiling(tiling) == core1_0.ImageTilingLinear {
+		features = formatProperties.LinearTilingFeatures
+	}
+
+	if features == 0 {
+		return core1_0.VKErrorFormatNotSupported, core1_0.VKErrorFormatNotSupported.ToError()
+	}
+
+	limits := device.Properties.Limits
+	if limits == nil {
+		limits = &core1_0.PhysicalDeviceLimits{}
+	}
+
+	p.maxExtent.width = C.uint32_t(limits.MaxImageDimension2D)
+	p.maxExtent.height = C.uint32_t(limits.MaxImageDimension2D)
+	p.maxExtent.depth = C.uint32_t(limits.MaxImageDimension3D)
+	p.maxMipLevels = C.uint32_t(bits.Len(uint(limits.MaxImageDimension2D)))
+	p.maxArrayLayers = C.uint32_t(limits.MaxImageArrayLayers)
+	p.sampleCounts = C.VkSampleCountFlags(limits.SampledImageColorSampleCounts)
+	p.maxResourceSize = C.VkDeviceSize(1 << 31)
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkGetPhysicalDeviceImageFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, t driver.VkImageType, tiling driver.VkImageTiling, usage driver.VkImageUsageFlags, flags driver.VkImageCreateFlags, pImageFormatProperties *driver.VkImageFormatProperties) (common.VkResult, error) {
+	device := d.lookupPhysicalDevice(physicalDevice)
+	return d.imageFormatProperties(device, format, tiling, (*C.VkImageFormatProperties)(unsafe.Pointer(pImageFormatProperties)))
+}
+
+func (d *Driver) VkGetPhysicalDeviceProperties(physicalDevice driver.VkPhysicalDevice, pProperties *driver.VkPhysicalDeviceProperties) {
+	device := d.lookupPhysicalDevice(physicalDevice)
+	writeProperties((*C.VkPhysicalDeviceProperties)(unsafe.Pointer(pProperties)), device)
+}
+
+func (d *Driver) VkGetPhysicalDeviceQueueFamilyProperties(physicalDevice driver.VkPhysicalDevice, pQueueFamilyPropertyCount *driver.Uint32, pQueueFamilyProperties *driver.VkQueueFamilyProperties) {
+	device := d.lookupPhysicalDevice(physicalDevice)
+
+	if pQueueFamilyProperties == nil {
+		*pQueueFamilyPropertyCount = driver.Uint32(len(device.QueueFamilies))
+		return
+	}
+
+	count := int(*pQueueFamilyPropertyCount)
+	if count > len(device.QueueFamilies) {
+		count = len(device.QueueFamilies)
+	}
+
+	families := unsafe.Slice((*C.VkQueueFamilyProperties)(unsafe.Pointer(pQueueFamilyProperties)), count)
+	for i := 0; i < count; i++ {
+		writeQueueFamilyProperties(&families[i], device.QueueFamilies[i])
+	}
+	*pQueueFamilyPropertyCount = driver.Uint32(count)
+}
+
+func (d *Driver) VkGetPhysicalDeviceMemoryProperties(physicalDevice driver.VkPhysicalDevice, pMemoryProperties *driver.VkPhysicalDeviceMemoryProperties) {
+	device := d.lookupPhysicalDevice(physicalDevice)
+	writeMemoryProperties((*C.VkPhysicalDeviceMemoryProperties)(unsafe.Pointer(pMemoryProperties)), device.MemoryProperties)
+}
+
+func (d *Driver) VkEnumerateDeviceExtensionProperties(physicalDevice driver.VkPhysicalDevice, pLayerName *driver.Char, pPropertyCount *driver.Uint32, pProperties *driver.VkExtensionProperties) (common.VkResult, error) {
+	device := d.lookupPhysicalDevice(physicalDevice)
+	return writeExtensionProperties(device.Extensions, pPropertyCount, pProperties)
+}
+
+func (d *Driver) VkEnumerateDeviceLayerProperties(physicalDevice driver.VkPhysicalDevice, pPropertyCount *driver.Uint32, pProperties *driver.VkLayerProperties) (common.VkResult, error) {
+	d.lookupPhysicalDevice(physicalDevice)
+	return writeLayerProperties(nil, pPropertyCount, pProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceSparseImageFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, t driver.VkImageType, samples driver.VkSampleCountFlagBits, usage driver.VkImageUsageFlags, tiling driver.VkImageTiling, pPropertyCount *driver.Uint32, pProperties *driver.VkSparseImageFormatProperties) {
+	d.lookupPhysicalDevice(physicalDevice)
+	*pPropertyCount = 0
+}
+
+func (d *Driver) VkGetPhysicalDeviceFeatures2(physicalDevice driver.VkPhysicalDevice, pFeatures *driver.VkPhysicalDeviceFeatures2) {
+	features := (*C.VkPhysicalDeviceFeatures2)(unsafe.Pointer(pFeatures))
+	d.VkGetPhysicalDeviceFeatures(physicalDevice, (*driver.VkPhysicalDeviceFeatures)(unsafe.Pointer(&features.features)))
+}
+
+func (d *Driver) VkGetPhysicalDeviceProperties2(physicalDevice driver.VkPhysicalDevice, pProperties *driver.VkPhysicalDeviceProperties2) {
+	properties := (*C.VkPhysicalDeviceProperties2)(unsafe.Pointer(pProperties))
+	d.VkGetPhysicalDeviceProperties(physicalDevice, (*driver.VkPhysicalDeviceProperties)(unsafe.Pointer(&properties.properties)))
+}
+
+func (d *Driver) VkGetPhysicalDeviceFormatProperties2(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, pFormatProperties *driver.VkFormatProperties2) {
+	properties := (*C.VkFormatProperties2)(unsafe.Pointer(pFormatProperties))
+	d.VkGetPhysicalDeviceFormatProperties(physicalDevice, format, (*driver.VkFormatProperties)(unsafe.Pointer(&properties.formatProperties)))
+
+	// The fake only tracks legacy format features, which share their values with VkFormatFeatureFlags2
+	properties3 := (*C.VkFormatProperties3)(findNext(properties.pNext, C.VK_STRUCTURE_TYPE_FORMAT_PROPERTIES_3))
+	if properties3 != nil {
+		properties3.linearTilingFeatures = C.VkFormatFeatureFlags2(uint32(properties.formatProperties.linearTilingFeatures))
+		properties3.optimalTilingFeatures = C.VkFormatFeatureFlags2(uint32(properties.formatProperties.optimalTilingFeatures))
+		properties3.bufferFeatures = C.VkFormatFeatureFlags2(uint32(properties.formatProperties.bufferFeatures))
+	}
+}
+
+func (d *Driver) VkGetPhysicalDeviceImageFormatProperties2(physicalDevice driver.VkPhysicalDevice, pImageFormatInfo *driver.VkPhysicalDeviceImageFormatInfo2, pImageFormatProperties *driver.VkImageFormatProperties2) (common.VkResult, error) {
+	device := d.lookupPhysicalDevice(physicalDevice)
+	info := (*C.VkPhysicalDeviceImageFormatInfo2)(unsafe.Pointer(pImageFormatInfo))
+	properties := (*C.VkImageFormatProperties2)(unsafe.Pointer(pImageFormatProperties))
+
+	return d.imageFormatProperties(device, driver.VkFormat(info.format), driver.VkImageTiling(info.tiling), &properties.imageFormatProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceQueueFamilyProperties2(physicalDevice driver.VkPhysicalDevice, pQueueFamilyPropertyCount *driver.Uint32, pQueueFamilyProperties *driver.VkQueueFamilyProperties2) {
+	device := d.lookupPhysicalDevice(physicalDevice)
+
+	if pQueueFamilyProperties == nil {
+		*pQueueFamilyPropertyCount = driver.Uint32(len(device.QueueFamilies))
+		return
+	}
+
+	count := int(*pQueueFamilyPropertyCount)
+	if count > len(device.QueueFamilies) {
+		count = len(device.QueueFamilies)
+	}
+
+	families := unsafe.Slice((*C.VkQueueFamilyProperties2)(unsafe.Pointer(pQueueFamilyProperties)), count)
+	for i := 0; i < count; i++ {
+		writeQueueFamilyProperties(&families[i].queueFamilyProperties, device.QueueFamilies[i])
+	}
+	*pQueueFamilyPropertyCount = driver.Uint32(count)
+}
+
+func (d *Driver) VkGetPhysicalDeviceMemoryProperties2(physicalDevice driver.VkPhysicalDevice, pMemoryProperties *driver.VkPhysicalDeviceMemoryProperties2) {
+	properties := (*C.VkPhysicalDeviceMemoryProperties2)(unsafe.Pointer(pMemoryProperties))
+	d.VkGetPhysicalDeviceMemoryProperties(physicalDevice, (*driver.VkPhysicalDeviceMemoryProperties)(unsafe.Pointer(&properties.memoryProperties)))
+}
+
+func (d *Driver) VkGetPhysicalDeviceSparseImageFormatProperties2(physicalDevice driver.VkPhysicalDevice, pFormatInfo *driver.VkPhysicalDeviceSparseImageFormatInfo2, pPropertyCount *driver.Uint32, pProperties *driver.VkSparseImageFormatProperties2) {
+	d.lookupPhysicalDevice(physicalDevice)
+	*pPropertyCount = 0
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalBufferProperties(physicalDevice driver.VkPhysicalDevice, pExternalBufferInfo *driver.VkPhysicalDeviceExternalBufferInfo, pExternalBufferProperties *driver.VkExternalBufferProperties) {
+	d.lookupPhysicalDevice(physicalDevice)
+
+	properties := (*C.VkExternalBufferProperties)(unsafe.Pointer(pExternalBufferProperties))
+	C.memset(unsafe.Pointer(&properties.externalMemoryProperties), 0, C.sizeof_struct_VkExternalMemoryProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalFenceProperties(physicalDevice driver.VkPhysicalDevice, pExternalFenceInfo *driver.VkPhysicalDeviceExternalFenceInfo, pExternalFenceProperties *driver.VkExternalFenceProperties) {
+	d.lookupPhysicalDevice(physicalDevice)
+
+	properties := (*C.VkExternalFenceProperties)(unsafe.Pointer(pExternalFenceProperties))
+	properties.exportFromImportedHandleTypes = 0
+	properties.compatibleHandleTypes = 0
+	properties.externalFenceFeatures = 0
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalSemaphoreProperties(physicalDevice driver.VkPhysicalDevice, pExternalSemaphoreInfo *driver.VkPhysicalDeviceExternalSemaphoreInfo, pExternalSemaphoreProperties *driver.VkExternalSemaphoreProperties) {
+	d.lookupPhysicalDevice(physicalDevice)
+
+	properties := (*C.VkExternalSemaphoreProperties)(unsafe.Pointer(pExternalSemaphoreProperties))
+	properties.exportFromImportedHandleTypes = 0
+	properties.compatibleHandleTypes = 0
+	properties.externalSemaphoreFeatures = 0
+}
+
+func (d *Driver) VkGetPhysicalDeviceToolProperties(physicalDevice driver.VkPhysicalDevice, pToolCount *driver.Uint32, pToolProperties *driver.VkPhysicalDeviceToolProperties) (common.VkResult, error) {
+	d.lookupPhysicalDevice(physicalDevice)
+	*pToolCount = 0
+	return core1_0.VKSuccess, nil
+}
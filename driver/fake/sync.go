@@ -0,0 +1,448 @@
+package fake
+
+/*
+#include <stdlib.h>
+#include "../../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+// Submission records a single batch submitted to a Queue with VkQueueSubmit, VkQueueSubmit2, or
+// VkQueueBindSparse
+type Submission struct {
+	// Queue is the Queue the batch was submitted to
+	Queue driver.VkQueue
+	// CommandBuffers is the list of CommandBuffer objects submitted in the batch. It is empty
+	// for sparse binding batches.
+	CommandBuffers []driver.VkCommandBuffer
+	// WaitSemaphores is the list of Semaphore objects the batch waited on
+	WaitSemaphores []driver.VkSemaphore
+	// SignalSemaphores is the list of Semaphore objects the batch signaled
+	SignalSemaphores []driver.VkSemaphore
+	// Fence is the Fence signaled by the submission the batch was part of, or 0
+	Fence driver.VkFence
+}
+
+// findNext walks a pNext chain looking for a structure with the provided sType
+func findNext(pNext unsafe.Pointer, sType C.VkStructureType) unsafe.Pointer {
+	for pNext != nil {
+		base := (*C.VkBaseInStructure)(pNext)
+		if base.sType == sType {
+			return pNext
+		}
+
+		pNext = unsafe.Pointer(base.pNext)
+	}
+
+	return nil
+}
+
+func uint64Slice(p *C.uint64_t, count C.uint32_t) []uint64 {
+	if p == nil {
+		return nil
+	}
+
+	return unsafe.Slice((*uint64)(unsafe.Pointer(p)), int(count))
+}
+
+// Submissions retrieves every batch submitted to any Queue, in the order they were submitted
+func (d *Driver) Submissions() []Submission {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	return append([]Submission(nil), d.state.submissions...)
+}
+
+// FenceSignaled reports whether the provided Fence is currently signaled
+func (d *Driver) FenceSignaled(fence driver.VkFence) bool {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj, ok := d.state.objects[driver.VulkanHandle(fence)]
+	return ok && obj.Type == core1_0.ObjectTypeFence && obj.signaled
+}
+
+// waitSemaphores consumes the signal of each binary semaphore in the list. The caller must hold the
+// state lock.
+func (d *Driver) waitSemaphores(semaphores []driver.VkSemaphore) {
+	for _, semaphore := range semaphores {
+		obj := d.state.liveObject(driver.VulkanHandle(semaphore), core1_0.ObjectTypeSemaphore, "VkSemaphore")
+		if obj != nil && !obj.timeline {
+			obj.signaled = false
+		}
+	}
+}
+
+// signalSemaphores signals each semaphore in the list, setting timeline semaphores to the
+// corresponding value. The caller must hold the state lock.
+func (d *Driver) signalSemaphores(semaphores []driver.VkSemaphore, values []uint64) {
+	for i, semaphore := range semaphores {
+		obj := d.state.liveObject(driver.VulkanHandle(semaphore), core1_0.ObjectTypeSemaphore, "VkSemaphore")
+		if obj == nil {
+			continue
+		}
+
+		if !obj.timeline {
+			if obj.signaled {
+				d.state.recordError(errors.Newf("VkSemaphore 0x%x was signaled while already signaled", obj.Handle))
+			}
+			obj.signaled = true
+			continue
+		}
+
+		if i >= len(values) || values[i] <= obj.counter {
+			d.state.recordError(errors.Newf("VkSemaphore 0x%x was signaled without a value greater than its current value %d", obj.Handle, obj.counter))
+			continue
+		}
+
+		obj.counter = values[i]
+	}
+}
+
+// signalFence signals the provided fence if it is not null. The caller must hold the state lock.
+func (d *Driver) signalFence(fence driver.VkFence) {
+	if fence == 0 {
+		return
+	}
+
+	obj := d.state.liveObject(driver.VulkanHandle(fence), core1_0.ObjectTypeFence, "VkFence")
+	if obj == nil {
+		return
+	}
+
+	if obj.signaled {
+		d.state.recordError(errors.Newf("VkFence 0x%x was submitted while already signaled", obj.Handle))
+	}
+	obj.signaled = true
+}
+
+// VkQueueSubmit records each batch as a Submission and completes it immediately: wait semaphores
+// are consumed, and signal semaphores and the fence are signaled.
+func (d *Driver) VkQueueSubmit(queue driver.VkQueue, submitCount driver.Uint32, pSubmits *driver.VkSubmitInfo, fence driver.VkFence) (common.VkResult, error) {
+	submits := unsafe.Slice((*C.VkSubmitInfo)(unsafe.Pointer(pSubmits)), int(submitCount))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(queue), core1_0.ObjectTypeQueue, "VkQueue")
+
+	for i := range submits {
+		submission := Submission{
+			Queue:            queue,
+			CommandBuffers:   handleSlice((*driver.VkCommandBuffer)(unsafe.Pointer(submits[i].pCommandBuffers)), int(submits[i].commandBufferCount)),
+			WaitSemaphores:   handleSlice((*driver.VkSemaphore)(unsafe.Pointer(submits[i].pWaitSemaphores)), int(submits[i].waitSemaphoreCount)),
+			SignalSemaphores: handleSlice((*driver.VkSemaphore)(unsafe.Pointer(submits[i].pSignalSemaphores)), int(submits[i].signalSemaphoreCount)),
+			Fence:            fence,
+		}
+
+		for _, commandBuffer := range submission.CommandBuffers {
+			obj := d.state.liveObject(driver.VulkanHandle(commandBuffer), core1_0.ObjectTypeCommandBuffer, "VkCommandBuffer")
+			if obj != nil && obj.recording {
+				d.state.recordError(errors.Newf("VkCommandBuffer 0x%x was submitted while still recording", obj.Handle))
+			}
+			if obj != nil {
+				for _, transfer := range obj.transfers {
+					transfer()
+				}
+			}
+		}
+
+		var signalValues []uint64
+		timelineInfo := (*C.VkTimelineSemaphoreSubmitInfo)(findNext(unsafe.Pointer(submits[i].pNext), C.VK_STRUCTURE_TYPE_TIMELINE_SEMAPHORE_SUBMIT_INFO))
+		if timelineInfo != nil {
+			signalValues = uint64Slice(timelineInfo.pSignalSemaphoreValues, timelineInfo.signalSemaphoreValueCount)
+		}
+
+		d.waitSemaphores(submission.WaitSemaphores)
+		d.signalSemaphores(submission.SignalSemaphores, signalValues)
+		d.state.submissions = append(d.state.submissions, submission)
+	}
+
+	d.signalFence(fence)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkQueueSubmit2(queue driver.VkQueue, submitCount driver.Uint32, pSubmits *driver.VkSubmitInfo2, fence driver.VkFence) (common.VkResult, error) {
+	submits := unsafe.Slice((*C.VkSubmitInfo2)(unsafe.Pointer(pSubmits)), int(submitCount))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(queue), core1_0.ObjectTypeQueue, "VkQueue")
+
+	for i := range submits {
+		submission := Submission{
+			Queue: queue,
+			Fence: fence,
+		}
+
+		if submits[i].commandBufferInfoCount > 0 {
+			for _, info := range unsafe.Slice(submits[i].pCommandBufferInfos, int(submits[i].commandBufferInfoCount)) {
+				submission.CommandBuffers = append(submission.CommandBuffers, driver.VkCommandBuffer(unsafe.Pointer(info.commandBuffer)))
+			}
+		}
+
+		if submits[i].waitSemaphoreInfoCount > 0 {
+			for _, info := range unsafe.Slice(submits[i].pWaitSemaphoreInfos, int(submits[i].waitSemaphoreInfoCount)) {
+				submission.WaitSemaphores = append(submission.WaitSemaphores, driver.VkSemaphore(unsafe.Pointer(info.semaphore)))
+			}
+		}
+
+		var signalValues []uint64
+		if submits[i].signalSemaphoreInfoCount > 0 {
+			for _, info := range unsafe.Slice(submits[i].pSignalSemaphoreInfos, int(submits[i].signalSemaphoreInfoCount)) {
+				submission.SignalSemaphores = append(submission.SignalSemaphores, driver.VkSemaphore(unsafe.Pointer(info.semaphore)))
+				signalValues = append(signalValues, uint64(info.value))
+			}
+		}
+
+		for _, commandBuffer := range submission.CommandBuffers {
+			obj := d.state.liveObject(driver.VulkanHandle(commandBuffer), core1_0.ObjectTypeCommandBuffer, "VkCommandBuffer")
+			if obj != nil && obj.recording {
+				d.state.recordError(errors.Newf("VkCommandBuffer 0x%x was submitted while still recording", obj.Handle))
+			}
+			if obj != nil {
+				for _, transfer := range obj.transfers {
+					transfer()
+				}
+			}
+		}
+
+		d.waitSemaphores(submission.WaitSemaphores)
+		d.signalSemaphores(submission.SignalSemaphores, signalValues)
+		d.state.submissions = append(d.state.submissions, submission)
+	}
+
+	d.signalFence(fence)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkQueueBindSparse(queue driver.VkQueue, bindInfoCount driver.Uint32, pBindInfo *driver.VkBindSparseInfo, fence driver.VkFence) (common.VkResult, error) {
+	bindInfos := unsafe.Slice((*C.VkBindSparseInfo)(unsafe.Pointer(pBindInfo)), int(bindInfoCount))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	d.state.liveObject(driver.VulkanHandle(queue), core1_0.ObjectTypeQueue, "VkQueue")
+
+	for i := range bindInfos {
+		submission := Submission{
+			Queue:            queue,
+			WaitSemaphores:   handleSlice((*driver.VkSemaphore)(unsafe.Pointer(bindInfos[i].pWaitSemaphores)), int(bindInfos[i].waitSemaphoreCount)),
+			SignalSemaphores: handleSlice((*driver.VkSemaphore)(unsafe.Pointer(bindInfos[i].pSignalSemaphores)), int(bindInfos[i].signalSemaphoreCount)),
+			Fence:            fence,
+		}
+
+		var signalValues []uint64
+		timelineInfo := (*C.VkTimelineSemaphoreSubmitInfo)(findNext(unsafe.Pointer(bindInfos[i].pNext), C.VK_STRUCTURE_TYPE_TIMELINE_SEMAPHORE_SUBMIT_INFO))
+		if timelineInfo != nil {
+			signalValues = uint64Slice(timelineInfo.pSignalSemaphoreValues, timelineInfo.signalSemaphoreValueCount)
+		}
+
+		d.waitSemaphores(submission.WaitSemaphores)
+		d.signalSemaphores(submission.SignalSemaphores, signalValues)
+		d.state.submissions = append(d.state.submissions, submission)
+	}
+
+	d.signalFence(fence)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkCreateFence(device driver.VkDevice, pCreateInfo *driver.VkFenceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pFence *driver.VkFence) (common.VkResult, error) {
+	info := (*C.VkFenceCreateInfo)(unsafe.Pointer(pCreateInfo))
+	createInfo := core1_0.FenceCreateInfo{
+		Flags: core1_0.FenceCreateFlags(info.flags),
+	}
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.createLocked(driver.VulkanHandle(device), core1_0.ObjectTypeDevice, "VkDevice", core1_0.ObjectTypeFence, createInfo)
+	obj.signaled = createInfo.Flags&core1_0.FenceCreateSignaled != 0
+
+	*pFence = driver.VkFence(obj.Handle)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyFence(device driver.VkDevice, fence driver.VkFence, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(fence), core1_0.ObjectTypeFence, "VkFence")
+}
+
+func (d *Driver) VkResetFences(device driver.VkDevice, fenceCount driver.Uint32, pFences *driver.VkFence) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	for _, fence := range handleSlice(pFences, int(fenceCount)) {
+		obj := d.state.liveObject(driver.VulkanHandle(fence), core1_0.ObjectTypeFence, "VkFence")
+		if obj != nil {
+			obj.signaled = false
+		}
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkGetFenceStatus(device driver.VkDevice, fence driver.VkFence) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(fence), core1_0.ObjectTypeFence, "VkFence")
+	if obj == nil || !obj.signaled {
+		return core1_0.VKNotReady, nil
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+// VkWaitForFences returns immediately: VKSuccess if the wait condition is already satisfied, and
+// VKTimeout otherwise, since nothing else could ever signal the fences
+func (d *Driver) VkWaitForFences(device driver.VkDevice, fenceCount driver.Uint32, pFences *driver.VkFence, waitAll driver.VkBool32, timeout driver.Uint64) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	signaledCount := 0
+	fences := handleSlice(pFences, int(fenceCount))
+	for _, fence := range fences {
+		obj := d.state.liveObject(driver.VulkanHandle(fence), core1_0.ObjectTypeFence, "VkFence")
+		if obj != nil && obj.signaled {
+			signaledCount++
+		}
+	}
+
+	if signaledCount == len(fences) || (waitAll == C.VK_FALSE && signaledCount > 0) {
+		return core1_0.VKSuccess, nil
+	}
+
+	return core1_0.VKTimeout, nil
+}
+
+func (d *Driver) VkCreateSemaphore(device driver.VkDevice, pCreateInfo *driver.VkSemaphoreCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSemaphore *driver.VkSemaphore) (common.VkResult, error) {
+	info := (*C.VkSemaphoreCreateInfo)(unsafe.Pointer(pCreateInfo))
+	typeInfo := (*C.VkSemaphoreTypeCreateInfo)(findNext(unsafe.Pointer(info.pNext), C.VK_STRUCTURE_TYPE_SEMAPHORE_TYPE_CREATE_INFO))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.createLocked(driver.VulkanHandle(device), core1_0.ObjectTypeDevice, "VkDevice", core1_0.ObjectTypeSemaphore, nil)
+	if typeInfo != nil && typeInfo.semaphoreType == C.VK_SEMAPHORE_TYPE_TIMELINE {
+		obj.timeline = true
+		obj.counter = uint64(typeInfo.initialValue)
+	}
+
+	*pSemaphore = driver.VkSemaphore(obj.Handle)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroySemaphore(device driver.VkDevice, semaphore driver.VkSemaphore, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(semaphore), core1_0.ObjectTypeSemaphore, "VkSemaphore")
+}
+
+func (d *Driver) timelineSemaphore(semaphore driver.VulkanHandle) *fakeObject {
+	obj := d.state.liveObject(semaphore, core1_0.ObjectTypeSemaphore, "VkSemaphore")
+	if obj != nil && !obj.timeline {
+		d.state.recordError(errors.Newf("VkSemaphore 0x%x is not a timeline semaphore", obj.Handle))
+		return nil
+	}
+
+	return obj
+}
+
+func (d *Driver) VkGetSemaphoreCounterValue(device driver.VkDevice, semaphore driver.VkSemaphore, pValue *driver.Uint64) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	*pValue = 0
+	obj := d.timelineSemaphore(driver.VulkanHandle(semaphore))
+	if obj != nil {
+		*pValue = driver.Uint64(obj.counter)
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+// VkWaitSemaphores returns immediately: VKSuccess if the wait condition is already satisfied, and
+// VKTimeout otherwise
+func (d *Driver) VkWaitSemaphores(device driver.VkDevice, pWaitInfo *driver.VkSemaphoreWaitInfo, timeout driver.Uint64) (common.VkResult, error) {
+	info := (*C.VkSemaphoreWaitInfo)(unsafe.Pointer(pWaitInfo))
+	semaphores := handleSlice((*driver.VulkanHandle)(unsafe.Pointer(info.pSemaphores)), int(info.semaphoreCount))
+	values := uint64Slice(info.pValues, info.semaphoreCount)
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	satisfiedCount := 0
+	for i, semaphore := range semaphores {
+		obj := d.timelineSemaphore(semaphore)
+		if obj != nil && obj.counter >= values[i] {
+			satisfiedCount++
+		}
+	}
+
+	waitAny := info.flags&C.VK_SEMAPHORE_WAIT_ANY_BIT != 0
+	if satisfiedCount == len(semaphores) || (waitAny && satisfiedCount > 0) {
+		return core1_0.VKSuccess, nil
+	}
+
+	return core1_0.VKTimeout, nil
+}
+
+func (d *Driver) VkSignalSemaphore(device driver.VkDevice, pSignalInfo *driver.VkSemaphoreSignalInfo) (common.VkResult, error) {
+	info := (*C.VkSemaphoreSignalInfo)(unsafe.Pointer(pSignalInfo))
+	semaphore := readHandle(unsafe.Pointer(&info.semaphore))
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	if d.timelineSemaphore(semaphore) != nil {
+		d.signalSemaphores([]driver.VkSemaphore{driver.VkSemaphore(semaphore)}, []uint64{uint64(info.value)})
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkCreateEvent(device driver.VkDevice, pCreateInfo *driver.VkEventCreateInfo, pAllocator *driver.VkAllocationCallbacks, pEvent *driver.VkEvent) (common.VkResult, error) {
+	*pEvent = driver.VkEvent(d.create(device, core1_0.ObjectTypeEvent, nil))
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkDestroyEvent(device driver.VkDevice, event driver.VkEvent, pAllocator *driver.VkAllocationCallbacks) {
+	d.destroy(driver.VulkanHandle(event), core1_0.ObjectTypeEvent, "VkEvent")
+}
+
+func (d *Driver) VkGetEventStatus(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(event), core1_0.ObjectTypeEvent, "VkEvent")
+	if obj != nil && obj.signaled {
+		return core1_0.VKEventSet, nil
+	}
+
+	return core1_0.VKEventReset, nil
+}
+
+func (d *Driver) setEvent(event driver.VkEvent, set bool) {
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(event), core1_0.ObjectTypeEvent, "VkEvent")
+	if obj != nil {
+		obj.signaled = set
+	}
+}
+
+func (d *Driver) VkSetEvent(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	d.setEvent(event, true)
+	return core1_0.VKSuccess, nil
+}
+
+func (d *Driver) VkResetEvent(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	d.setEvent(event, false)
+	return core1_0.VKSuccess, nil
+}
@@ -0,0 +1,232 @@
+package fake
+
+/*
+#include <stdlib.h>
+#include "../../common/vulkan.h"
+*/
+import "C"
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/internal/texel"
+	"unsafe"
+)
+
+// texelSlotSize is the number of bytes each texel of an Image occupies in DeviceMemory. It matches
+// the estimate made by imageSize, and leaves room for the depth and stencil aspects of a texel to
+// be stored side by side.
+const texelSlotSize = 16
+
+// stencilSlotOffset is the offset within a texel slot at which the stencil aspect is stored
+const stencilSlotOffset = 8
+
+// recordTransfer records a transfer command to the provided CommandBuffer. The transfer is
+// executed, with the state lock held, each time the CommandBuffer is submitted.
+func (d *Driver) recordTransfer(commandBuffer driver.VkCommandBuffer, transfer func()) {
+	d.recordCommand(commandBuffer)
+
+	d.state.lock.Lock()
+	defer d.state.lock.Unlock()
+
+	obj := d.state.liveObject(driver.VulkanHandle(commandBuffer), core1_0.ObjectTypeCommandBuffer, "VkCommandBuffer")
+	if obj != nil {
+		obj.transfers = append(obj.transfers, transfer)
+	}
+}
+
+// boundBytes returns the DeviceMemory that a Buffer or Image is bound to, starting at the offset
+// it is bound at. The caller must hold the state lock.
+func (d *Driver) boundBytes(handle driver.VulkanHandle, objectType core1_0.ObjectType, typeName string) (*fakeObject, []byte) {
+	obj := d.state.liveObject(handle, objectType, typeName)
+	if obj == nil {
+		return nil, nil
+	}
+
+	if obj.Memory == 0 {
+		d.state.recordError(errors.Newf("%s 0x%x was used in a transfer command but is not bound to memory", typeName, handle))
+		return nil, nil
+	}
+
+	memoryObj := d.state.liveObject(driver.VulkanHandle(obj.Memory), core1_0.ObjectTypeDeviceMemory, "VkDeviceMemory")
+	if memoryObj == nil || memoryObj.memory.data == nil {
+		return nil, nil
+	}
+
+	size := d.state.requiredSize(obj)
+	if obj.MemoryOffset+size > memoryObj.memory.size {
+		return nil, nil
+	}
+
+	return obj, unsafe.Slice((*byte)(unsafe.Add(memoryObj.memory.data, obj.MemoryOffset)), size)
+}
+
+func (d *Driver) copyBuffer(srcBuffer driver.VkBuffer, dstBuffer driver.VkBuffer, regions []core1_0.BufferCopy) {
+	srcObj, src := d.boundBytes(driver.VulkanHandle(srcBuffer), core1_0.ObjectTypeBuffer, "VkBuffer")
+	dstObj, dst := d.boundBytes(driver.VulkanHandle(dstBuffer), core1_0.ObjectTypeBuffer, "VkBuffer")
+	if src == nil || dst == nil {
+		return
+	}
+
+	srcSize := srcObj.CreateInfo.(core1_0.BufferCreateInfo).Size
+	dstSize := dstObj.CreateInfo.(core1_0.BufferCreateInfo).Size
+	for _, region := range regions {
+		if region.SrcOffset+region.Size > srcSize || region.DstOffset+region.Size > dstSize {
+			d.state.recordError(errors.Newf("vkCmdCopyBuffer copied %d bytes from offset %d of VkBuffer 0x%x (%d bytes) to offset %d of VkBuffer 0x%x (%d bytes)", region.Size, region.SrcOffset, srcBuffer, srcSize, region.DstOffset, dstBuffer, dstSize))
+			continue
+		}
+
+		copy(dst[region.DstOffset:region.DstOffset+region.Size], src[region.SrcOffset:region.SrcOffset+region.Size])
+	}
+}
+
+// imageTexelOffsets calls visit with the offset of each texel of an Image covered by a
+// BufferImageCopy, and the offset in the Buffer that the texel is copied to or from. Texels are
+// laid out layer by layer, with each layer's mip levels stored one after another, matching the
+// size reported by imageSize. The caller must hold the state lock.
+func (d *Driver) imageTexelOffsets(image driver.VkImage, createInfo core1_0.ImageCreateInfo, bufferSize int, region core1_0.BufferImageCopy, visit func(imageOffset, bufferOffset, texelSize int)) {
+	aspect := region.ImageSubresource.AspectMask
+	texelSize := texel.Size(createInfo.Format, aspect)
+	if texelSize == 0 {
+		d.state.recordError(errors.Newf("VkImage 0x%x was used in a transfer command with format %s and aspect %s, which the fake driver cannot copy", image, createInfo.Format, aspect))
+		return
+	}
+
+	// Depth and stencil copies only require a multiple of 4
+	if region.BufferOffset%4 != 0 || (aspect == core1_0.ImageAspectColor && region.BufferOffset%texelSize != 0) {
+		d.state.recordError(errors.Newf("VkImage 0x%x was used in a transfer command with buffer offset %d, which is not a multiple of 4 and of its %d-byte texels", image, region.BufferOffset, texelSize))
+		return
+	}
+
+	samples := maxInt(int(createInfo.Samples), 1)
+	width, height, depth := createInfo.Extent.Width, createInfo.Extent.Height, createInfo.Extent.Depth
+	levelOffset, layerSize := 0, 0
+	var levelWidth, levelHeight, levelDepth int
+	for level := 0; level < createInfo.MipLevels || level == 0; level++ {
+		if level == region.ImageSubresource.MipLevel {
+			levelOffset = layerSize
+			levelWidth, levelHeight, levelDepth = width, height, depth
+		}
+		layerSize += width * height * depth * texelSlotSize * samples
+
+		width = maxInt(width/2, 1)
+		height = maxInt(height/2, 1)
+		depth = maxInt(depth/2, 1)
+	}
+
+	offset, extent := region.ImageOffset, region.ImageExtent
+	if region.ImageSubresource.MipLevel >= maxInt(createInfo.MipLevels, 1) ||
+		region.ImageSubresource.BaseArrayLayer+region.ImageSubresource.LayerCount > maxInt(createInfo.ArrayLayers, 1) ||
+		offset.X < 0 || offset.Y < 0 || offset.Z < 0 ||
+		offset.X+extent.Width > levelWidth || offset.Y+extent.Height > levelHeight || offset.Z+extent.Depth > levelDepth {
+		d.state.recordError(errors.Newf("VkImage 0x%x was used in a transfer command with a region outside of the Image", image))
+		return
+	}
+
+	rowLength := region.BufferRowLength
+	if rowLength == 0 {
+		rowLength = extent.Width
+	}
+	imageHeight := region.BufferImageHeight
+	if imageHeight == 0 {
+		imageHeight = extent.Height
+	}
+
+	lastTexel := (((region.ImageSubresource.LayerCount-1)*extent.Depth+extent.Depth-1)*imageHeight+extent.Height-1)*rowLength + extent.Width
+	if region.BufferOffset+lastTexel*texelSize > bufferSize {
+		d.state.recordError(errors.Newf("VkImage 0x%x was used in a transfer command with a region that runs past the end of the Buffer", image))
+		return
+	}
+
+	slotOffset := 0
+	if aspect == core1_0.ImageAspectStencil {
+		slotOffset = stencilSlotOffset
+	}
+
+	for layer := 0; layer < region.ImageSubresource.LayerCount; layer++ {
+		layerOffset := (region.ImageSubresource.BaseArrayLayer+layer)*layerSize + levelOffset
+		for z := 0; z < extent.Depth; z++ {
+			for y := 0; y < extent.Height; y++ {
+				for x := 0; x < extent.Width; x++ {
+					texel := ((offset.Z+z)*levelHeight+offset.Y+y)*levelWidth + offset.X + x
+					bufferTexel := ((layer*extent.Depth+z)*imageHeight+y)*rowLength + x
+					visit(layerOffset+texel*texelSlotSize+slotOffset, region.BufferOffset+bufferTexel*texelSize, texelSize)
+				}
+			}
+		}
+	}
+}
+
+func (d *Driver) copyBufferToImage(srcBuffer driver.VkBuffer, dstImage driver.VkImage, regions []core1_0.BufferImageCopy) {
+	bufferObj, src := d.boundBytes(driver.VulkanHandle(srcBuffer), core1_0.ObjectTypeBuffer, "VkBuffer")
+	imageObj, dst := d.boundBytes(driver.VulkanHandle(dstImage), core1_0.ObjectTypeImage, "VkImage")
+	if src == nil || dst == nil {
+		return
+	}
+
+	bufferSize := bufferObj.CreateInfo.(core1_0.BufferCreateInfo).Size
+	createInfo := imageObj.CreateInfo.(core1_0.ImageCreateInfo)
+	for _, region := range regions {
+		d.imageTexelOffsets(dstImage, createInfo, bufferSize, region, func(imageOffset, bufferOffset, texelSize int) {
+			copy(dst[imageOffset:imageOffset+texelSize], src[bufferOffset:bufferOffset+texelSize])
+		})
+	}
+}
+
+func (d *Driver) copyImageToBuffer(srcImage driver.VkImage, dstBuffer driver.VkBuffer, regions []core1_0.BufferImageCopy) {
+	imageObj, src := d.boundBytes(driver.VulkanHandle(srcImage), core1_0.ObjectTypeImage, "VkImage")
+	bufferObj, dst := d.boundBytes(driver.VulkanHandle(dstBuffer), core1_0.ObjectTypeBuffer, "VkBuffer")
+	if src == nil || dst == nil {
+		return
+	}
+
+	bufferSize := bufferObj.CreateInfo.(core1_0.BufferCreateInfo).Size
+	createInfo := imageObj.CreateInfo.(core1_0.ImageCreateInfo)
+	for _, region := range regions {
+		d.imageTexelOffsets(srcImage, createInfo, bufferSize, region, func(imageOffset, bufferOffset, texelSize int) {
+			copy(dst[bufferOffset:bufferOffset+texelSize], src[imageOffset:imageOffset+texelSize])
+		})
+	}
+}
+
+func bufferCopyRegions(regionCount driver.Uint32, pRegions *driver.VkBufferCopy) []core1_0.BufferCopy {
+	var regions []core1_0.BufferCopy
+	for _, region := range unsafe.Slice((*C.VkBufferCopy)(unsafe.Pointer(pRegions)), int(regionCount)) {
+		regions = append(regions, core1_0.BufferCopy{
+			SrcOffset: int(region.srcOffset),
+			DstOffset: int(region.dstOffset),
+			Size:      int(region.size),
+		})
+	}
+
+	return regions
+}
+
+func bufferImageCopyRegions(regionCount driver.Uint32, pRegions *driver.VkBufferImageCopy) []core1_0.BufferImageCopy {
+	var regions []core1_0.BufferImageCopy
+	for _, region := range unsafe.Slice((*C.VkBufferImageCopy)(unsafe.Pointer(pRegions)), int(regionCount)) {
+		regions = append(regions, core1_0.BufferImageCopy{
+			BufferOffset:      int(region.bufferOffset),
+			BufferRowLength:   int(region.bufferRowLength),
+			BufferImageHeight: int(region.bufferImageHeight),
+			ImageSubresource: core1_0.ImageSubresourceLayers{
+				AspectMask:     core1_0.ImageAspectFlags(region.imageSubresource.aspectMask),
+				MipLevel:       int(region.imageSubresource.mipLevel),
+				BaseArrayLayer: int(region.imageSubresource.baseArrayLayer),
+				LayerCount:     int(region.imageSubresource.layerCount),
+			},
+			ImageOffset: core1_0.Offset3D{
+				X: int(region.imageOffset.x),
+				Y: int(region.imageOffset.y),
+				Z: int(region.imageOffset.z),
+			},
+			ImageExtent: core1_0.Extent3D{
+				Width:  int(region.imageExtent.width),
+				Height: int(region.imageExtent.height),
+				Depth:  int(region.imageExtent.depth),
+			},
+		})
+	}
+
+	return regions
+}
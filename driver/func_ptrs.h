@@ -203,4 +203,44 @@ typedef struct DriverFuncPtrs {
     PFN_vkGetBufferDeviceAddress vkGetBufferDeviceAddress; //Todo
     PFN_vkGetBufferOpaqueCaptureAddress vkGetBufferOpaqueCaptureAddress; //Todo
     PFN_vkGetDeviceMemoryOpaqueCaptureAddress vkGetDeviceMemoryOpaqueCaptureAddress; //Todo
+    
+    // VK 1.3
+    
+    PFN_vkCmdBeginRendering vkCmdBeginRendering;
+    PFN_vkCmdEndRendering vkCmdEndRendering;
+    PFN_vkCmdSetEvent2 vkCmdSetEvent2;
+    PFN_vkCmdResetEvent2 vkCmdResetEvent2;
+    PFN_vkCmdWaitEvents2 vkCmdWaitEvents2;
+    PFN_vkCmdPipelineBarrier2 vkCmdPipelineBarrier2;
+    PFN_vkCmdWriteTimestamp2 vkCmdWriteTimestamp2;
+    PFN_vkQueueSubmit2 vkQueueSubmit2;
+    PFN_vkCmdSetCullMode vkCmdSetCullMode;
+    PFN_vkCmdSetFrontFace vkCmdSetFrontFace;
+    PFN_vkCmdSetPrimitiveTopology vkCmdSetPrimitiveTopology;
+    PFN_vkCmdSetViewportWithCount vkCmdSetViewportWithCount;
+    PFN_vkCmdSetScissorWithCount vkCmdSetScissorWithCount;
+    PFN_vkCmdBindVertexBuffers2 vkCmdBindVertexBuffers2;
+    PFN_vkCmdSetDepthTestEnable vkCmdSetDepthTestEnable;
+    PFN_vkCmdSetDepthWriteEnable vkCmdSetDepthWriteEnable;
+    PFN_vkCmdSetDepthCompareOp vkCmdSetDepthCompareOp;
+    PFN_vkCmdSetDepthBoundsTestEnable vkCmdSetDepthBoundsTestEnable;
+    PFN_vkCmdSetStencilTestEnable vkCmdSetStencilTestEnable;
+    PFN_vkCmdSetStencilOp vkCmdSetStencilOp;
+    PFN_vkCmdSetRasterizerDiscardEnable vkCmdSetRasterizerDiscardEnable;
+    PFN_vkCmdSetDepthBiasEnable vkCmdSetDepthBiasEnable;
+    PFN_vkCmdSetPrimitiveRestartEnable vkCmdSetPrimitiveRestartEnable;
+    PFN_vkCmdCopyBuffer2 vkCmdCopyBuffer2;
+    PFN_vkCmdCopyImage2 vkCmdCopyImage2;
+    PFN_vkCmdCopyBufferToImage2 vkCmdCopyBufferToImage2;
+    PFN_vkCmdCopyImageToBuffer2 vkCmdCopyImageToBuffer2;
+    PFN_vkCmdBlitImage2 vkCmdBlitImage2;
+    PFN_vkCmdResolveImage2 vkCmdResolveImage2;
+    PFN_vkGetDeviceBufferMemoryRequirements vkGetDeviceBufferMemoryRequirements;
+    PFN_vkGetDeviceImageMemoryRequirements vkGetDeviceImageMemoryRequirements;
+    PFN_vkGetDeviceImageSparseMemoryRequirements vkGetDeviceImageSparseMemoryRequirements;
+    PFN_vkCreatePrivateDataSlot vkCreatePrivateDataSlot;
+    PFN_vkDestroyPrivateDataSlot vkDestroyPrivateDataSlot;
+    PFN_vkSetPrivateData vkSetPrivateData;
+    PFN_vkGetPrivateData vkGetPrivateData;
+    PFN_vkGetPhysicalDeviceToolProperties vkGetPhysicalDeviceToolProperties;
 } DriverFuncPtrs;
@@ -186,6 +186,44 @@ void driverFuncPtrs_populate(PFN_vkGetInstanceProcAddr instanceProcAddr, DriverF
     funcPtrs->vkGetBufferDeviceAddress = NULL;
     funcPtrs->vkGetBufferOpaqueCaptureAddress = NULL;
     funcPtrs->vkGetDeviceMemoryOpaqueCaptureAddress = NULL;
+
+    funcPtrs->vkCmdBeginRendering = NULL;
+    funcPtrs->vkCmdEndRendering = NULL;
+    funcPtrs->vkCmdSetEvent2 = NULL;
+    funcPtrs->vkCmdResetEvent2 = NULL;
+    funcPtrs->vkCmdWaitEvents2 = NULL;
+    funcPtrs->vkCmdPipelineBarrier2 = NULL;
+    funcPtrs->vkCmdWriteTimestamp2 = NULL;
+    funcPtrs->vkQueueSubmit2 = NULL;
+    funcPtrs->vkCmdSetCullMode = NULL;
+    funcPtrs->vkCmdSetFrontFace = NULL;
+    funcPtrs->vkCmdSetPrimitiveTopology = NULL;
+    funcPtrs->vkCmdSetViewportWithCount = NULL;
+    funcPtrs->vkCmdSetScissorWithCount = NULL;
+    funcPtrs->vkCmdBindVertexBuffers2 = NULL;
+    funcPtrs->vkCmdSetDepthTestEnable = NULL;
+    funcPtrs->vkCmdSetDepthWriteEnable = NULL;
+    funcPtrs->vkCmdSetDepthCompareOp = NULL;
+    funcPtrs->vkCmdSetDepthBoundsTestEnable = NULL;
+    funcPtrs->vkCmdSetStencilTestEnable = NULL;
+    funcPtrs->vkCmdSetStencilOp = NULL;
+    funcPtrs->vkCmdSetRasterizerDiscardEnable = NULL;
+    funcPtrs->vkCmdSetDepthBiasEnable = NULL;
+    funcPtrs->vkCmdSetPrimitiveRestartEnable = NULL;
+    funcPtrs->vkCmdCopyBuffer2 = NULL;
+    funcPtrs->vkCmdCopyImage2 = NULL;
+    funcPtrs->vkCmdCopyBufferToImage2 = NULL;
+    funcPtrs->vkCmdCopyImageToBuffer2 = NULL;
+    funcPtrs->vkCmdBlitImage2 = NULL;
+    funcPtrs->vkCmdResolveImage2 = NULL;
+    funcPtrs->vkGetDeviceBufferMemoryRequirements = NULL;
+    funcPtrs->vkGetDeviceImageMemoryRequirements = NULL;
+    funcPtrs->vkGetDeviceImageSparseMemoryRequirements = NULL;
+    funcPtrs->vkCreatePrivateDataSlot = NULL;
+    funcPtrs->vkDestroyPrivateDataSlot = NULL;
+    funcPtrs->vkSetPrivateData = NULL;
+    funcPtrs->vkGetPrivateData = NULL;
+    funcPtrs->vkGetPhysicalDeviceToolProperties = NULL;
 }
 
 void instanceFuncPtrs_populate(VkInstance instance, DriverFuncPtrs *src, DriverFuncPtrs *dest) {
@@ -377,6 +415,47 @@ void instanceFuncPtrs_populate(VkInstance instance, DriverFuncPtrs *src, DriverF
     dest->vkGetBufferDeviceAddress = NULL;
     dest->vkGetBufferOpaqueCaptureAddress = NULL;
     dest->vkGetDeviceMemoryOpaqueCaptureAddress = NULL;
+
+    dest->vkCmdBeginRendering = NULL;
+    dest->vkCmdEndRendering = NULL;
+    dest->vkCmdSetEvent2 = NULL;
+    dest->vkCmdResetEvent2 = NULL;
+    dest->vkCmdWaitEvents2 = NULL;
+    dest->vkCmdPipelineBarrier2 = NULL;
+    dest->vkCmdWriteTimestamp2 = NULL;
+    dest->vkQueueSubmit2 = NULL;
+    dest->vkCmdSetCullMode = NULL;
+    dest->vkCmdSetFrontFace = NULL;
+    dest->vkCmdSetPrimitiveTopology = NULL;
+    dest->vkCmdSetViewportWithCount = NULL;
+    dest->vkCmdSetScissorWithCount = NULL;
+    dest->vkCmdBindVertexBuffers2 = NULL;
+    dest->vkCmdSetDepthTestEnable = NULL;
+    dest->vkCmdSetDepthWriteEnable = NULL;
+    dest->vkCmdSetDepthCompareOp = NULL;
+    dest->vkCmdSetDepthBoundsTestEnable = NULL;
+    dest->vkCmdSetStencilTestEnable = NULL;
+    dest->vkCmdSetStencilOp = NULL;
+    dest->vkCmdSetRasterizerDiscardEnable = NULL;
+    dest->vkCmdSetDepthBiasEnable = NULL;
+    dest->vkCmdSetPrimitiveRestartEnable = NULL;
+    dest->vkCmdCopyBuffer2 = NULL;
+    dest->vkCmdCopyImage2 = NULL;
+    dest->vkCmdCopyBufferToImage2 = NULL;
+    dest->vkCmdCopyImageToBuffer2 = NULL;
+    dest->vkCmdBlitImage2 = NULL;
+    dest->vkCmdResolveImage2 = NULL;
+    dest->vkGetDeviceBufferMemoryRequirements = NULL;
+    dest->vkGetDeviceImageMemoryRequirements = NULL;
+    dest->vkGetDeviceImageSparseMemoryRequirements = NULL;
+    dest->vkCreatePrivateDataSlot = NULL;
+    dest->vkDestroyPrivateDataSlot = NULL;
+    dest->vkSetPrivateData = NULL;
+    dest->vkGetPrivateData = NULL;
+    dest->vkGetPhysicalDeviceToolProperties = (PFN_vkGetPhysicalDeviceToolProperties)instanceProcAddr(instance, "vkGetPhysicalDeviceToolProperties");
+    if (dest->vkGetPhysicalDeviceToolProperties == NULL) {
+        dest->vkGetPhysicalDeviceToolProperties = (PFN_vkGetPhysicalDeviceToolProperties)instanceProcAddr(instance, "vkGetPhysicalDeviceToolPropertiesEXT");
+    }
 }
 
 void deviceFuncPtrs_populate(VkDevice device, DriverFuncPtrs *src, DriverFuncPtrs *dest) {
@@ -568,5 +647,151 @@ void deviceFuncPtrs_populate(VkDevice device, DriverFuncPtrs *src, DriverFuncPtr
     dest->vkGetBufferDeviceAddress = (PFN_vkGetBufferDeviceAddress)deviceProcAddr(device, "vkGetBufferDeviceAddress");
     dest->vkGetBufferOpaqueCaptureAddress = (PFN_vkGetBufferOpaqueCaptureAddress)deviceProcAddr(device, "vkGetBufferOpaqueCaptureAddress");
     dest->vkGetDeviceMemoryOpaqueCaptureAddress = (PFN_vkGetDeviceMemoryOpaqueCaptureAddress)deviceProcAddr(device, "vkGetDeviceMemoryOpaqueCaptureAddress");
+
+    dest->vkCmdBeginRendering = (PFN_vkCmdBeginRendering)deviceProcAddr(device, "vkCmdBeginRendering");
+    if (dest->vkCmdBeginRendering == NULL) {
+        dest->vkCmdBeginRendering = (PFN_vkCmdBeginRendering)deviceProcAddr(device, "vkCmdBeginRenderingKHR");
+    }
+    dest->vkCmdEndRendering = (PFN_vkCmdEndRendering)deviceProcAddr(device, "vkCmdEndRendering");
+    if (dest->vkCmdEndRendering == NULL) {
+        dest->vkCmdEndRendering = (PFN_vkCmdEndRendering)deviceProcAddr(device, "vkCmdEndRenderingKHR");
+    }
+    dest->vkCmdSetEvent2 = (PFN_vkCmdSetEvent2)deviceProcAddr(device, "vkCmdSetEvent2");
+    if (dest->vkCmdSetEvent2 == NULL) {
+        dest->vkCmdSetEvent2 = (PFN_vkCmdSetEvent2)deviceProcAddr(device, "vkCmdSetEvent2KHR");
+    }
+    dest->vkCmdResetEvent2 = (PFN_vkCmdResetEvent2)deviceProcAddr(device, "vkCmdResetEvent2");
+    if (dest->vkCmdResetEvent2 == NULL) {
+        dest->vkCmdResetEvent2 = (PFN_vkCmdResetEvent2)deviceProcAddr(device, "vkCmdResetEvent2KHR");
+    }
+    dest->vkCmdWaitEvents2 = (PFN_vkCmdWaitEvents2)deviceProcAddr(device, "vkCmdWaitEvents2");
+    if (dest->vkCmdWaitEvents2 == NULL) {
+        dest->vkCmdWaitEvents2 = (PFN_vkCmdWaitEvents2)deviceProcAddr(device, "vkCmdWaitEvents2KHR");
+    }
+    dest->vkCmdPipelineBarrier2 = (PFN_vkCmdPipelineBarrier2)deviceProcAddr(device, "vkCmdPipelineBarrier2");
+    if (dest->vkCmdPipelineBarrier2 == NULL) {
+        dest->vkCmdPipelineBarrier2 = (PFN_vkCmdPipelineBarrier2)deviceProcAddr(device, "vkCmdPipelineBarrier2KHR");
+    }
+    dest->vkCmdWriteTimestamp2 = (PFN_vkCmdWriteTimestamp2)deviceProcAddr(device, "vkCmdWriteTimestamp2");
+    if (dest->vkCmdWriteTimestamp2 == NULL) {
+        dest->vkCmdWriteTimestamp2 = (PFN_vkCmdWriteTimestamp2)deviceProcAddr(device, "vkCmdWriteTimestamp2KHR");
+    }
+    dest->vkQueueSubmit2 = (PFN_vkQueueSubmit2)deviceProcAddr(device, "vkQueueSubmit2");
+    if (dest->vkQueueSubmit2 == NULL) {
+        dest->vkQueueSubmit2 = (PFN_vkQueueSubmit2)deviceProcAddr(device, "vkQueueSubmit2KHR");
+    }
+    dest->vkCmdSetCullMode = (PFN_vkCmdSetCullMode)deviceProcAddr(device, "vkCmdSetCullMode");
+    if (dest->vkCmdSetCullMode == NULL) {
+        dest->vkCmdSetCullMode = (PFN_vkCmdSetCullMode)deviceProcAddr(device, "vkCmdSetCullModeEXT");
+    }
+    dest->vkCmdSetFrontFace = (PFN_vkCmdSetFrontFace)deviceProcAddr(device, "vkCmdSetFrontFace");
+    if (dest->vkCmdSetFrontFace == NULL) {
+        dest->vkCmdSetFrontFace = (PFN_vkCmdSetFrontFace)deviceProcAddr(device, "vkCmdSetFrontFaceEXT");
+    }
+    dest->vkCmdSetPrimitiveTopology = (PFN_vkCmdSetPrimitiveTopology)deviceProcAddr(device, "vkCmdSetPrimitiveTopology");
+    if (dest->vkCmdSetPrimitiveTopology == NULL) {
+        dest->vkCmdSetPrimitiveTopology = (PFN_vkCmdSetPrimitiveTopology)deviceProcAddr(device, "vkCmdSetPrimitiveTopologyEXT");
+    }
+    dest->vkCmdSetViewportWithCount = (PFN_vkCmdSetViewportWithCount)deviceProcAddr(device, "vkCmdSetViewportWithCount");
+    if (dest->vkCmdSetViewportWithCount == NULL) {
+        dest->vkCmdSetViewportWithCount = (PFN_vkCmdSetViewportWithCount)deviceProcAddr(device, "vkCmdSetViewportWithCountEXT");
+    }
+    dest->vkCmdSetScissorWithCount = (PFN_vkCmdSetScissorWithCount)deviceProcAddr(device, "vkCmdSetScissorWithCount");
+    if (dest->vkCmdSetScissorWithCount == NULL) {
+        dest->vkCmdSetScissorWithCount = (PFN_vkCmdSetScissorWithCount)deviceProcAddr(device, "vkCmdSetScissorWithCountEXT");
+    }
+    dest->vkCmdBindVertexBuffers2 = (PFN_vkCmdBindVertexBuffers2)deviceProcAddr(device, "vkCmdBindVertexBuffers2");
+    if (dest->vkCmdBindVertexBuffers2 == NULL) {
+        dest->vkCmdBindVertexBuffers2 = (PFN_vkCmdBindVertexBuffers2)deviceProcAddr(device, "vkCmdBindVertexBuffers2EXT");
+    }
+    dest->vkCmdSetDepthTestEnable = (PFN_vkCmdSetDepthTestEnable)deviceProcAddr(device, "vkCmdSetDepthTestEnable");
+    if (dest->vkCmdSetDepthTestEnable == NULL) {
+        dest->vkCmdSetDepthTestEnable = (PFN_vkCmdSetDepthTestEnable)deviceProcAddr(device, "vkCmdSetDepthTestEnableEXT");
+    }
+    dest->vkCmdSetDepthWriteEnable = (PFN_vkCmdSetDepthWriteEnable)deviceProcAddr(device, "vkCmdSetDepthWriteEnable");
+    if (dest->vkCmdSetDepthWriteEnable == NULL) {
+        dest->vkCmdSetDepthWriteEnable = (PFN_vkCmdSetDepthWriteEnable)deviceProcAddr(device, "vkCmdSetDepthWriteEnableEXT");
+    }
+    dest->vkCmdSetDepthCompareOp = (PFN_vkCmdSetDepthCompareOp)deviceProcAddr(device, "vkCmdSetDepthCompareOp");
+    if (dest->vkCmdSetDepthCompareOp == NULL) {
+        dest->vkCmdSetDepthCompareOp = (PFN_vkCmdSetDepthCompareOp)deviceProcAddr(device, "vkCmdSetDepthCompareOpEXT");
+    }
+    dest->vkCmdSetDepthBoundsTestEnable = (PFN_vkCmdSetDepthBoundsTestEnable)deviceProcAddr(device, "vkCmdSetDepthBoundsTestEnable");
+    if (dest->vkCmdSetDepthBoundsTestEnable == NULL) {
+        dest->vkCmdSetDepthBoundsTestEnable = (PFN_vkCmdSetDepthBoundsTestEnable)deviceProcAddr(device, "vkCmdSetDepthBoundsTestEnableEXT");
+    }
+    dest->vkCmdSetStencilTestEnable = (PFN_vkCmdSetStencilTestEnable)deviceProcAddr(device, "vkCmdSetStencilTestEnable");
+    if (dest->vkCmdSetStencilTestEnable == NULL) {
+        dest->vkCmdSetStencilTestEnable = (PFN_vkCmdSetStencilTestEnable)deviceProcAddr(device, "vkCmdSetStencilTestEnableEXT");
+    }
+    dest->vkCmdSetStencilOp = (PFN_vkCmdSetStencilOp)deviceProcAddr(device, "vkCmdSetStencilOp");
+    if (dest->vkCmdSetStencilOp == NULL) {
+        dest->vkCmdSetStencilOp = (PFN_vkCmdSetStencilOp)deviceProcAddr(device, "vkCmdSetStencilOpEXT");
+    }
+    dest->vkCmdSetRasterizerDiscardEnable = (PFN_vkCmdSetRasterizerDiscardEnable)deviceProcAddr(device, "vkCmdSetRasterizerDiscardEnable");
+    if (dest->vkCmdSetRasterizerDiscardEnable == NULL) {
+        dest->vkCmdSetRasterizerDiscardEnable = (PFN_vkCmdSetRasterizerDiscardEnable)deviceProcAddr(device, "vkCmdSetRasterizerDiscardEnableEXT");
+    }
+    dest->vkCmdSetDepthBiasEnable = (PFN_vkCmdSetDepthBiasEnable)deviceProcAddr(device, "vkCmdSetDepthBiasEnable");
+    if (dest->vkCmdSetDepthBiasEnable == NULL) {
+        dest->vkCmdSetDepthBiasEnable = (PFN_vkCmdSetDepthBiasEnable)deviceProcAddr(device, "vkCmdSetDepthBiasEnableEXT");
+    }
+    dest->vkCmdSetPrimitiveRestartEnable = (PFN_vkCmdSetPrimitiveRestartEnable)deviceProcAddr(device, "vkCmdSetPrimitiveRestartEnable");
+    if (dest->vkCmdSetPrimitiveRestartEnable == NULL) {
+        dest->vkCmdSetPrimitiveRestartEnable = (PFN_vkCmdSetPrimitiveRestartEnable)deviceProcAddr(device, "vkCmdSetPrimitiveRestartEnableEXT");
+    }
+    dest->vkCmdCopyBuffer2 = (PFN_vkCmdCopyBuffer2)deviceProcAddr(device, "vkCmdCopyBuffer2");
+    if (dest->vkCmdCopyBuffer2 == NULL) {
+        dest->vkCmdCopyBuffer2 = (PFN_vkCmdCopyBuffer2)deviceProcAddr(device, "vkCmdCopyBuffer2KHR");
+    }
+    dest->vkCmdCopyImage2 = (PFN_vkCmdCopyImage2)deviceProcAddr(device, "vkCmdCopyImage2");
+    if (dest->vkCmdCopyImage2 == NULL) {
+        dest->vkCmdCopyImage2 = (PFN_vkCmdCopyImage2)deviceProcAddr(device, "vkCmdCopyImage2KHR");
+    }
+    dest->vkCmdCopyBufferToImage2 = (PFN_vkCmdCopyBufferToImage2)deviceProcAddr(device, "vkCmdCopyBufferToImage2");
+    if (dest->vkCmdCopyBufferToImage2 == NULL) {
+        dest->vkCmdCopyBufferToImage2 = (PFN_vkCmdCopyBufferToImage2)deviceProcAddr(device, "vkCmdCopyBufferToImage2KHR");
+    }
+    dest->vkCmdCopyImageToBuffer2 = (PFN_vkCmdCopyImageToBuffer2)deviceProcAddr(device, "vkCmdCopyImageToBuffer2");
+    if (dest->vkCmdCopyImageToBuffer2 == NULL) {
+        dest->vkCmdCopyImageToBuffer2 = (PFN_vkCmdCopyImageToBuffer2)deviceProcAddr(device, "vkCmdCopyImageToBuffer2KHR");
+    }
+    dest->vkCmdBlitImage2 = (PFN_vkCmdBlitImage2)deviceProcAddr(device, "vkCmdBlitImage2");
+    if (dest->vkCmdBlitImage2 == NULL) {
+        dest->vkCmdBlitImage2 = (PFN_vkCmdBlitImage2)deviceProcAddr(device, "vkCmdBlitImage2KHR");
+    }
+    dest->vkCmdResolveImage2 = (PFN_vkCmdResolveImage2)deviceProcAddr(device, "vkCmdResolveImage2");
+    if (dest->vkCmdResolveImage2 == NULL) {
+        dest->vkCmdResolveImage2 = (PFN_vkCmdResolveImage2)deviceProcAddr(device, "vkCmdResolveImage2KHR");
+    }
+    dest->vkGetDeviceBufferMemoryRequirements = (PFN_vkGetDeviceBufferMemoryRequirements)deviceProcAddr(device, "vkGetDeviceBufferMemoryRequirements");
+    if (dest->vkGetDeviceBufferMemoryRequirements == NULL) {
+        dest->vkGetDeviceBufferMemoryRequirements = (PFN_vkGetDeviceBufferMemoryRequirements)deviceProcAddr(device, "vkGetDeviceBufferMemoryRequirementsKHR");
+    }
+    dest->vkGetDeviceImageMemoryRequirements = (PFN_vkGetDeviceImageMemoryRequirements)deviceProcAddr(device, "vkGetDeviceImageMemoryRequirements");
+    if (dest->vkGetDeviceImageMemoryRequirements == NULL) {
+        dest->vkGetDeviceImageMemoryRequirements = (PFN_vkGetDeviceImageMemoryRequirements)deviceProcAddr(device, "vkGetDeviceImageMemoryRequirementsKHR");
+    }
+    dest->vkGetDeviceImageSparseMemoryRequirements = (PFN_vkGetDeviceImageSparseMemoryRequirements)deviceProcAddr(device, "vkGetDeviceImageSparseMemoryRequirements");
+    if (dest->vkGetDeviceImageSparseMemoryRequirements == NULL) {
+        dest->vkGetDeviceImageSparseMemoryRequirements = (PFN_vkGetDeviceImageSparseMemoryRequirements)deviceProcAddr(device, "vkGetDeviceImageSparseMemoryRequirementsKHR");
+    }
+    dest->vkCreatePrivateDataSlot = (PFN_vkCreatePrivateDataSlot)deviceProcAddr(device, "vkCreatePrivateDataSlot");
+    if (dest->vkCreatePrivateDataSlot == NULL) {
+        dest->vkCreatePrivateDataSlot = (PFN_vkCreatePrivateDataSlot)deviceProcAddr(device, "vkCreatePrivateDataSlotEXT");
+    }
+    dest->vkDestroyPrivateDataSlot = (PFN_vkDestroyPrivateDataSlot)deviceProcAddr(device, "vkDestroyPrivateDataSlot");
+    if (dest->vkDestroyPrivateDataSlot == NULL) {
+        dest->vkDestroyPrivateDataSlot = (PFN_vkDestroyPrivateDataSlot)deviceProcAddr(device, "vkDestroyPrivateDataSlotEXT");
+    }
+    dest->vkSetPrivateData = (PFN_vkSetPrivateData)deviceProcAddr(device, "vkSetPrivateData");
+    if (dest->vkSetPrivateData == NULL) {
+        dest->vkSetPrivateData = (PFN_vkSetPrivateData)deviceProcAddr(device, "vkSetPrivateDataEXT");
+    }
+    dest->vkGetPrivateData = (PFN_vkGetPrivateData)deviceProcAddr(device, "vkGetPrivateData");
+    if (dest->vkGetPrivateData == NULL) {
+        dest->vkGetPrivateData = (PFN_vkGetPrivateData)deviceProcAddr(device, "vkGetPrivateDataEXT");
+    }
+    dest->vkGetPhysicalDeviceToolProperties = src->vkGetPhysicalDeviceToolProperties;
 }
 
@@ -36,6 +36,7 @@ type VkSemaphore VulkanHandle
 type VkShaderModule VulkanHandle
 type VkSamplerYcbcrConversion VulkanHandle
 type VkDescriptorUpdateTemplate VulkanHandle
+type VkPrivateDataSlot VulkanHandle
 
 type VkBufferCreateInfo C.VkBufferCreateInfo
 type VkBufferViewCreateInfo C.VkBufferViewCreateInfo
@@ -228,6 +229,7 @@ type VkPhysicalDeviceUniformBufferStandardLayoutFeatures C.VkPhysicalDeviceUnifo
 type VkPhysicalDeviceVulkanMemoryModelFeatures C.VkPhysicalDeviceVulkanMemoryModelFeatures
 type VkPhysicalDeviceVulkan11Features C.VkPhysicalDeviceVulkan11Features
 type VkPhysicalDeviceVulkan12Features C.VkPhysicalDeviceVulkan12Features
+type VkPhysicalDeviceVulkan13Features C.VkPhysicalDeviceVulkan13Features
 type VkPhysicalDeviceDriverProperties C.VkPhysicalDeviceDriverProperties
 type VkPhysicalDeviceDepthStencilResolveProperties C.VkPhysicalDeviceDepthStencilResolveProperties
 type VkPhysicalDeviceFloatControlsProperties C.VkPhysicalDeviceFloatControlsProperties
@@ -235,8 +237,35 @@ type VkPhysicalDeviceSamplerFilterMinmaxProperties C.VkPhysicalDeviceSamplerFilt
 type VkPhysicalDeviceTimelineSemaphoreProperties C.VkPhysicalDeviceTimelineSemaphoreProperties
 type VkPhysicalDeviceVulkan11Properties C.VkPhysicalDeviceVulkan11Properties
 type VkPhysicalDeviceVulkan12Properties C.VkPhysicalDeviceVulkan12Properties
+type VkPhysicalDeviceVulkan13Properties C.VkPhysicalDeviceVulkan13Properties
 type VkAttachmentDescriptionStencilLayout C.VkAttachmentDescriptionStencilLayout
 type VkAttachmentReferenceStencilLayout C.VkAttachmentReferenceStencilLayout
+type VkRenderingInfo C.VkRenderingInfo
+type VkPipelineRenderingCreateInfo C.VkPipelineRenderingCreateInfo
+type VkCommandBufferInheritanceRenderingInfo C.VkCommandBufferInheritanceRenderingInfo
+type VkDependencyInfo C.VkDependencyInfo
+type VkSubmitInfo2 C.VkSubmitInfo2
+type VkCullModeFlags C.VkCullModeFlags
+type VkFrontFace C.VkFrontFace
+type VkPrimitiveTopology C.VkPrimitiveTopology
+type VkCompareOp C.VkCompareOp
+type VkStencilOp C.VkStencilOp
+type VkCopyBufferInfo2 C.VkCopyBufferInfo2
+type VkCopyImageInfo2 C.VkCopyImageInfo2
+type VkCopyBufferToImageInfo2 C.VkCopyBufferToImageInfo2
+type VkCopyImageToBufferInfo2 C.VkCopyImageToBufferInfo2
+type VkBlitImageInfo2 C.VkBlitImageInfo2
+type VkResolveImageInfo2 C.VkResolveImageInfo2
+type VkDeviceBufferMemoryRequirements C.VkDeviceBufferMemoryRequirements
+type VkDeviceImageMemoryRequirements C.VkDeviceImageMemoryRequirements
+type VkPrivateDataSlotCreateInfo C.VkPrivateDataSlotCreateInfo
+type VkObjectType C.VkObjectType
+type VkPhysicalDeviceToolProperties C.VkPhysicalDeviceToolProperties
+type VkPipelineCreationFeedbackCreateInfo C.VkPipelineCreationFeedbackCreateInfo
+type VkWriteDescriptorSetInlineUniformBlock C.VkWriteDescriptorSetInlineUniformBlock
+type VkDescriptorPoolInlineUniformBlockCreateInfo C.VkDescriptorPoolInlineUniformBlockCreateInfo
+type VkPhysicalDeviceInlineUniformBlockFeatures C.VkPhysicalDeviceInlineUniformBlockFeatures
+type VkFormatProperties3 C.VkFormatProperties3
 
 type VkCommandBufferResetFlags C.VkCommandBufferResetFlags
 type VkCommandPoolResetFlags C.VkCommandPoolResetFlags
@@ -254,6 +283,7 @@ type VkMemoryMapFlags C.VkMemoryMapFlags
 type VkQueryControlFlags C.VkQueryControlFlags
 type VkPipelineBindPoint C.VkPipelineBindPoint
 type VkPipelineStageFlags C.VkPipelineStageFlags
+type VkPipelineStageFlags2 C.VkPipelineStageFlags2
 type VkPiplineStageFlagBits C.VkPipelineStageFlagBits
 type VkQueryResultFlags C.VkQueryResultFlags
 type VkSampleCountFlagBits C.VkSampleCountFlagBits
@@ -14,6 +14,10 @@ type Driver interface {
 	LoadProcAddr(name *Char) unsafe.Pointer
 	Version() common.APIVersion
 	ObjectStore() *VulkanObjectStore
+	// HasCommand returns true if the command with the provided name, i.e. "vkTrimCommandPool", was
+	// loaded by this Driver and can be called. Calling a command that was not loaded returns or panics
+	// with a *common.FunctionError wrapping a *MissingCommandError.
+	HasCommand(name string) bool
 
 	VkEnumerateInstanceVersion(pApiVersion *Uint32) (common.VkResult, error)
 
@@ -198,4 +202,42 @@ type Driver interface {
 	VkGetBufferDeviceAddress(device VkDevice, pInfo *VkBufferDeviceAddressInfo) VkDeviceAddress
 	VkGetBufferOpaqueCaptureAddress(device VkDevice, pInfo *VkBufferDeviceAddressInfo) Uint64
 	VkGetDeviceMemoryOpaqueCaptureAddress(device VkDevice, pInfo *VkDeviceMemoryOpaqueCaptureAddressInfo) Uint64
+
+	VkCmdBeginRendering(commandBuffer VkCommandBuffer, pRenderingInfo *VkRenderingInfo)
+	VkCmdEndRendering(commandBuffer VkCommandBuffer)
+	VkCmdSetEvent2(commandBuffer VkCommandBuffer, event VkEvent, pDependencyInfo *VkDependencyInfo)
+	VkCmdResetEvent2(commandBuffer VkCommandBuffer, event VkEvent, stageMask VkPipelineStageFlags2)
+	VkCmdWaitEvents2(commandBuffer VkCommandBuffer, eventCount Uint32, pEvents *VkEvent, pDependencyInfos *VkDependencyInfo)
+	VkCmdPipelineBarrier2(commandBuffer VkCommandBuffer, pDependencyInfo *VkDependencyInfo)
+	VkCmdWriteTimestamp2(commandBuffer VkCommandBuffer, stage VkPipelineStageFlags2, queryPool VkQueryPool, query Uint32)
+	VkQueueSubmit2(queue VkQueue, submitCount Uint32, pSubmits *VkSubmitInfo2, fence VkFence) (common.VkResult, error)
+	VkCmdSetCullMode(commandBuffer VkCommandBuffer, cullMode VkCullModeFlags)
+	VkCmdSetFrontFace(commandBuffer VkCommandBuffer, frontFace VkFrontFace)
+	VkCmdSetPrimitiveTopology(commandBuffer VkCommandBuffer, primitiveTopology VkPrimitiveTopology)
+	VkCmdSetViewportWithCount(commandBuffer VkCommandBuffer, viewportCount Uint32, pViewports *VkViewport)
+	VkCmdSetScissorWithCount(commandBuffer VkCommandBuffer, scissorCount Uint32, pScissors *VkRect2D)
+	VkCmdBindVertexBuffers2(commandBuffer VkCommandBuffer, firstBinding Uint32, bindingCount Uint32, pBuffers *VkBuffer, pOffsets *VkDeviceSize, pSizes *VkDeviceSize, pStrides *VkDeviceSize)
+	VkCmdSetDepthTestEnable(commandBuffer VkCommandBuffer, depthTestEnable VkBool32)
+	VkCmdSetDepthWriteEnable(commandBuffer VkCommandBuffer, depthWriteEnable VkBool32)
+	VkCmdSetDepthCompareOp(commandBuffer VkCommandBuffer, depthCompareOp VkCompareOp)
+	VkCmdSetDepthBoundsTestEnable(commandBuffer VkCommandBuffer, depthBoundsTestEnable VkBool32)
+	VkCmdSetStencilTestEnable(commandBuffer VkCommandBuffer, stencilTestEnable VkBool32)
+	VkCmdSetStencilOp(commandBuffer VkCommandBuffer, faceMask VkStencilFaceFlags, failOp VkStencilOp, passOp VkStencilOp, depthFailOp VkStencilOp, compareOp VkCompareOp)
+	VkCmdSetRasterizerDiscardEnable(commandBuffer VkCommandBuffer, rasterizerDiscardEnable VkBool32)
+	VkCmdSetDepthBiasEnable(commandBuffer VkCommandBuffer, depthBiasEnable VkBool32)
+	VkCmdSetPrimitiveRestartEnable(commandBuffer VkCommandBuffer, primitiveRestartEnable VkBool32)
+	VkCmdCopyBuffer2(commandBuffer VkCommandBuffer, pCopyBufferInfo *VkCopyBufferInfo2)
+	VkCmdCopyImage2(commandBuffer VkCommandBuffer, pCopyImageInfo *VkCopyImageInfo2)
+	VkCmdCopyBufferToImage2(commandBuffer VkCommandBuffer, pCopyBufferToImageInfo *VkCopyBufferToImageInfo2)
+	VkCmdCopyImageToBuffer2(commandBuffer VkCommandBuffer, pCopyImageToBufferInfo *VkCopyImageToBufferInfo2)
+	VkCmdBlitImage2(commandBuffer VkCommandBuffer, pBlitImageInfo *VkBlitImageInfo2)
+	VkCmdResolveImage2(commandBuffer VkCommandBuffer, pResolveImageInfo *VkResolveImageInfo2)
+	VkGetDeviceBufferMemoryRequirements(device VkDevice, pInfo *VkDeviceBufferMemoryRequirements, pMemoryRequirements *VkMemoryRequirements2)
+	VkGetDeviceImageMemoryRequirements(device VkDevice, pInfo *VkDeviceImageMemoryRequirements, pMemoryRequirements *VkMemoryRequirements2)
+	VkGetDeviceImageSparseMemoryRequirements(device VkDevice, pInfo *VkDeviceImageMemoryRequirements, pSparseMemoryRequirementCount *Uint32, pSparseMemoryRequirements *VkSparseImageMemoryRequirements2)
+	VkCreatePrivateDataSlot(device VkDevice, pCreateInfo *VkPrivateDataSlotCreateInfo, pAllocator *VkAllocationCallbacks, pPrivateDataSlot *VkPrivateDataSlot) (common.VkResult, error)
+	VkDestroyPrivateDataSlot(device VkDevice, privateDataSlot VkPrivateDataSlot, pAllocator *VkAllocationCallbacks)
+	VkSetPrivateData(device VkDevice, objectType VkObjectType, objectHandle Uint64, privateDataSlot VkPrivateDataSlot, data Uint64) (common.VkResult, error)
+	VkGetPrivateData(device VkDevice, objectType VkObjectType, objectHandle Uint64, privateDataSlot VkPrivateDataSlot, pData *Uint64)
+	VkGetPhysicalDeviceToolProperties(physicalDevice VkPhysicalDevice, pToolCount *Uint32, pToolProperties *VkPhysicalDeviceToolProperties) (common.VkResult, error)
 }
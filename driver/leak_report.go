@@ -0,0 +1,109 @@
+package driver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// LiveObject describes a single object that remains in a VulkanObjectStore
+type LiveObject struct {
+	// Handle is the object's Vulkan handle
+	Handle VulkanHandle `json:"handle"`
+	// Type is the type of the wrapper object for the lowest core version it was created at,
+	// i.e. "*core1_0.VulkanBuffer"
+	Type string `json:"type"`
+	// Parents is the chain of handles this object descends from, starting with its immediate
+	// parent. Only parents registered with VulkanObjectStore.SetParent are included, such as the
+	// Instance of a PhysicalDevice or the CommandPool of a CommandBuffer.
+	Parents []VulkanHandle `json:"parents,omitempty"`
+	// Scopes is the set of keys the object has been stored under, such as Core1_0 and Core1_1,
+	// which indicates which core versions it was promoted to
+	Scopes []string `json:"scopes"`
+	// CreatedAt is the stack trace of the goroutine that first stored the object, if
+	// VulkanObjectStore.EnableCreationStacks was called before it was created
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+// LeakReport lists the objects that remain in a VulkanObjectStore. It can be marshalled to JSON
+// with encoding/json.
+type LeakReport struct {
+	// Objects is the list of live objects, ordered by handle
+	Objects []LiveObject `json:"objects"`
+}
+
+// Empty returns true if there are no live objects in the report
+func (r *LeakReport) Empty() bool {
+	return len(r.Objects) == 0
+}
+
+// String formats the report with one line per object, followed by its creation stack if one
+// was captured
+func (r *LeakReport) String() string {
+	var builder strings.Builder
+	for _, obj := range r.Objects {
+		fmt.Fprintf(&builder, "%s 0x%x scopes=%v", obj.Type, uint64(obj.Handle), obj.Scopes)
+		if len(obj.Parents) > 0 {
+			fmt.Fprintf(&builder, " parent=0x%x", uint64(obj.Parents[0]))
+		}
+		builder.WriteString("\n")
+
+		if obj.CreatedAt != "" {
+			builder.WriteString(obj.CreatedAt)
+			builder.WriteString("\n")
+		}
+	}
+
+	return builder.String()
+}
+
+// EnableCreationStacks causes the store to capture the stack trace of the goroutine that creates
+// each object, which is included in LeakReport. Capturing a stack trace is slow, so this should
+// only be enabled while debugging or testing.
+func (s *VulkanObjectStore) EnableCreationStacks() {
+	s.controlMutex.Lock()
+	defer s.controlMutex.Unlock()
+
+	s.recordCreation = true
+	if s.createdAt == nil {
+		s.createdAt = make(map[VulkanHandle]string)
+	}
+}
+
+// LeakReport returns a report of every object that remains in the store. Objects are removed from
+// the store when they are destroyed, so after every object has been destroyed, the report is empty.
+func (s *VulkanObjectStore) LeakReport() *LeakReport {
+	s.controlMutex.Lock()
+	defer s.controlMutex.Unlock()
+
+	report := &LeakReport{
+		Objects: make([]LiveObject, 0, len(s.objStore)),
+	}
+
+	for handle, objects := range s.objStore {
+		obj := LiveObject{
+			Handle:    handle,
+			Type:      objectType(objects),
+			CreatedAt: s.createdAt[handle],
+		}
+
+		for scope := range objects {
+			obj.Scopes = append(obj.Scopes, scope)
+		}
+		sort.Strings(obj.Scopes)
+
+		parent, hasParent := s.objParents[handle]
+		for hasParent {
+			obj.Parents = append(obj.Parents, parent)
+			parent, hasParent = s.objParents[parent]
+		}
+
+		report.Objects = append(report.Objects, obj)
+	}
+
+	sort.Slice(report.Objects, func(i, j int) bool {
+		return report.Objects[i].Handle < report.Objects[j].Handle
+	})
+
+	return report
+}
@@ -0,0 +1,83 @@
+package driver_test
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"testing"
+)
+
+func findLiveObject(t *testing.T, report *driver.LeakReport, handle driver.VulkanHandle) driver.LiveObject {
+	for _, obj := range report.Objects {
+		if obj.Handle == handle {
+			return obj
+		}
+	}
+
+	require.Failf(t, "object not found", "handle 0x%x is not in the leak report", uint64(handle))
+	return driver.LiveObject{}
+}
+
+func TestVulkanObjectStore_LeakReport(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	fakeDriver.ObjectStore().EnableCreationStacks()
+
+	loader, err := core.CreateLoaderFromDriver(fakeDriver)
+	require.NoError(t, err)
+
+	instance, _, err := loader.CreateInstance(nil, core1_0.InstanceCreateInfo{
+		APIVersion: common.Vulkan1_2,
+	})
+	require.NoError(t, err)
+
+	physicalDevices, _, err := instance.EnumeratePhysicalDevices()
+	require.NoError(t, err)
+
+	device, _, err := physicalDevices[0].CreateDevice(nil, core1_0.DeviceCreateInfo{
+		QueueCreateInfos: []core1_0.DeviceQueueCreateInfo{
+			{
+				QueueFamilyIndex: 0,
+				QueuePriorities:  []float32{1},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:  256,
+		Usage: core1_0.BufferUsageTransferSrc,
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, core1_1.PromoteBuffer(buffer))
+
+	report := fakeDriver.ObjectStore().LeakReport()
+	require.False(t, report.Empty())
+
+	bufferObj := findLiveObject(t, report, driver.VulkanHandle(buffer.Handle()))
+	require.Equal(t, "*core1_0.VulkanBuffer", bufferObj.Type)
+	require.Equal(t, []string{driver.Core1_0, driver.Core1_1}, bufferObj.Scopes)
+	require.Empty(t, bufferObj.Parents)
+	require.Contains(t, bufferObj.CreatedAt, "TestVulkanObjectStore_LeakReport")
+
+	physicalDeviceObj := findLiveObject(t, report, driver.VulkanHandle(physicalDevices[0].Handle()))
+	require.Equal(t, []driver.VulkanHandle{driver.VulkanHandle(instance.Handle())}, physicalDeviceObj.Parents)
+
+	data, err := json.Marshal(report)
+	require.NoError(t, err)
+
+	var decoded driver.LeakReport
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, *report, decoded)
+
+	buffer.Destroy(nil)
+	device.Destroy(nil)
+	instance.Destroy(nil)
+
+	require.True(t, fakeDriver.ObjectStore().LeakReport().Empty())
+}
@@ -0,0 +1,11 @@
+//go:build !vulkan_dlopen
+
+package driver
+
+// Unless the vulkan_dlopen build tag is set, binaries link against the Vulkan loader, which
+// provides the vkGetInstanceProcAddr used by core.CreateSystemLoader
+
+/*
+#cgo LDFLAGS: -lvulkan
+*/
+import "C"
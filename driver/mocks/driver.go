@@ -78,6 +78,20 @@ func (mr *MockDriverMockRecorder) Destroy() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockDriver)(nil).Destroy))
 }
 
+// HasCommand mocks base method.
+func (m *MockDriver) HasCommand(name string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasCommand", name)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasCommand indicates an expected call of HasCommand.
+func (mr *MockDriverMockRecorder) HasCommand(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasCommand", reflect.TypeOf((*MockDriver)(nil).HasCommand), name)
+}
+
 // LoadProcAddr mocks base method.
 func (m *MockDriver) LoadProcAddr(name *driver.Char) unsafe.Pointer {
 	m.ctrl.T.Helper()
@@ -276,6 +290,18 @@ func (mr *MockDriverMockRecorder) VkCmdBeginRenderPass2(commandBuffer, pRenderPa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdBeginRenderPass2", reflect.TypeOf((*MockDriver)(nil).VkCmdBeginRenderPass2), commandBuffer, pRenderPassBegin, pSubpassBeginInfo)
 }
 
+// VkCmdBeginRendering mocks base method.
+func (m *MockDriver) VkCmdBeginRendering(commandBuffer driver.VkCommandBuffer, pRenderingInfo *driver.VkRenderingInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdBeginRendering", commandBuffer, pRenderingInfo)
+}
+
+// VkCmdBeginRendering indicates an expected call of VkCmdBeginRendering.
+func (mr *MockDriverMockRecorder) VkCmdBeginRendering(commandBuffer, pRenderingInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdBeginRendering", reflect.TypeOf((*MockDriver)(nil).VkCmdBeginRendering), commandBuffer, pRenderingInfo)
+}
+
 // VkCmdBindDescriptorSets mocks base method.
 func (m *MockDriver) VkCmdBindDescriptorSets(commandBuffer driver.VkCommandBuffer, pipelineBindPoint driver.VkPipelineBindPoint, layout driver.VkPipelineLayout, firstSet, descriptorSetCount driver.Uint32, pDescriptorSets *driver.VkDescriptorSet, dynamicOffsetCount driver.Uint32, pDynamicOffsets *driver.Uint32) {
 	m.ctrl.T.Helper()
@@ -324,6 +350,18 @@ func (mr *MockDriverMockRecorder) VkCmdBindVertexBuffers(commandBuffer, firstBin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdBindVertexBuffers", reflect.TypeOf((*MockDriver)(nil).VkCmdBindVertexBuffers), commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets)
 }
 
+// VkCmdBindVertexBuffers2 mocks base method.
+func (m *MockDriver) VkCmdBindVertexBuffers2(commandBuffer driver.VkCommandBuffer, firstBinding, bindingCount driver.Uint32, pBuffers *driver.VkBuffer, pOffsets, pSizes, pStrides *driver.VkDeviceSize) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdBindVertexBuffers2", commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets, pSizes, pStrides)
+}
+
+// VkCmdBindVertexBuffers2 indicates an expected call of VkCmdBindVertexBuffers2.
+func (mr *MockDriverMockRecorder) VkCmdBindVertexBuffers2(commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets, pSizes, pStrides interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdBindVertexBuffers2", reflect.TypeOf((*MockDriver)(nil).VkCmdBindVertexBuffers2), commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets, pSizes, pStrides)
+}
+
 // VkCmdBlitImage mocks base method.
 func (m *MockDriver) VkCmdBlitImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageBlit, filter driver.VkFilter) {
 	m.ctrl.T.Helper()
@@ -336,6 +374,18 @@ func (mr *MockDriverMockRecorder) VkCmdBlitImage(commandBuffer, srcImage, srcIma
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdBlitImage", reflect.TypeOf((*MockDriver)(nil).VkCmdBlitImage), commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions, filter)
 }
 
+// VkCmdBlitImage2 mocks base method.
+func (m *MockDriver) VkCmdBlitImage2(commandBuffer driver.VkCommandBuffer, pBlitImageInfo *driver.VkBlitImageInfo2) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdBlitImage2", commandBuffer, pBlitImageInfo)
+}
+
+// VkCmdBlitImage2 indicates an expected call of VkCmdBlitImage2.
+func (mr *MockDriverMockRecorder) VkCmdBlitImage2(commandBuffer, pBlitImageInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdBlitImage2", reflect.TypeOf((*MockDriver)(nil).VkCmdBlitImage2), commandBuffer, pBlitImageInfo)
+}
+
 // VkCmdClearAttachments mocks base method.
 func (m *MockDriver) VkCmdClearAttachments(commandBuffer driver.VkCommandBuffer, attachmentCount driver.Uint32, pAttachments *driver.VkClearAttachment, rectCount driver.Uint32, pRects *driver.VkClearRect) {
 	m.ctrl.T.Helper()
@@ -384,6 +434,18 @@ func (mr *MockDriverMockRecorder) VkCmdCopyBuffer(commandBuffer, srcBuffer, dstB
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdCopyBuffer", reflect.TypeOf((*MockDriver)(nil).VkCmdCopyBuffer), commandBuffer, srcBuffer, dstBuffer, regionCount, pRegions)
 }
 
+// VkCmdCopyBuffer2 mocks base method.
+func (m *MockDriver) VkCmdCopyBuffer2(commandBuffer driver.VkCommandBuffer, pCopyBufferInfo *driver.VkCopyBufferInfo2) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdCopyBuffer2", commandBuffer, pCopyBufferInfo)
+}
+
+// VkCmdCopyBuffer2 indicates an expected call of VkCmdCopyBuffer2.
+func (mr *MockDriverMockRecorder) VkCmdCopyBuffer2(commandBuffer, pCopyBufferInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdCopyBuffer2", reflect.TypeOf((*MockDriver)(nil).VkCmdCopyBuffer2), commandBuffer, pCopyBufferInfo)
+}
+
 // VkCmdCopyBufferToImage mocks base method.
 func (m *MockDriver) VkCmdCopyBufferToImage(commandBuffer driver.VkCommandBuffer, srcBuffer driver.VkBuffer, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkBufferImageCopy) {
 	m.ctrl.T.Helper()
@@ -396,6 +458,18 @@ func (mr *MockDriverMockRecorder) VkCmdCopyBufferToImage(commandBuffer, srcBuffe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdCopyBufferToImage", reflect.TypeOf((*MockDriver)(nil).VkCmdCopyBufferToImage), commandBuffer, srcBuffer, dstImage, dstImageLayout, regionCount, pRegions)
 }
 
+// VkCmdCopyBufferToImage2 mocks base method.
+func (m *MockDriver) VkCmdCopyBufferToImage2(commandBuffer driver.VkCommandBuffer, pCopyBufferToImageInfo *driver.VkCopyBufferToImageInfo2) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdCopyBufferToImage2", commandBuffer, pCopyBufferToImageInfo)
+}
+
+// VkCmdCopyBufferToImage2 indicates an expected call of VkCmdCopyBufferToImage2.
+func (mr *MockDriverMockRecorder) VkCmdCopyBufferToImage2(commandBuffer, pCopyBufferToImageInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdCopyBufferToImage2", reflect.TypeOf((*MockDriver)(nil).VkCmdCopyBufferToImage2), commandBuffer, pCopyBufferToImageInfo)
+}
+
 // VkCmdCopyImage mocks base method.
 func (m *MockDriver) VkCmdCopyImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageCopy) {
 	m.ctrl.T.Helper()
@@ -408,6 +482,18 @@ func (mr *MockDriverMockRecorder) VkCmdCopyImage(commandBuffer, srcImage, srcIma
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdCopyImage", reflect.TypeOf((*MockDriver)(nil).VkCmdCopyImage), commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)
 }
 
+// VkCmdCopyImage2 mocks base method.
+func (m *MockDriver) VkCmdCopyImage2(commandBuffer driver.VkCommandBuffer, pCopyImageInfo *driver.VkCopyImageInfo2) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdCopyImage2", commandBuffer, pCopyImageInfo)
+}
+
+// VkCmdCopyImage2 indicates an expected call of VkCmdCopyImage2.
+func (mr *MockDriverMockRecorder) VkCmdCopyImage2(commandBuffer, pCopyImageInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdCopyImage2", reflect.TypeOf((*MockDriver)(nil).VkCmdCopyImage2), commandBuffer, pCopyImageInfo)
+}
+
 // VkCmdCopyImageToBuffer mocks base method.
 func (m *MockDriver) VkCmdCopyImageToBuffer(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstBuffer driver.VkBuffer, regionCount driver.Uint32, pRegions *driver.VkBufferImageCopy) {
 	m.ctrl.T.Helper()
@@ -420,6 +506,18 @@ func (mr *MockDriverMockRecorder) VkCmdCopyImageToBuffer(commandBuffer, srcImage
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdCopyImageToBuffer", reflect.TypeOf((*MockDriver)(nil).VkCmdCopyImageToBuffer), commandBuffer, srcImage, srcImageLayout, dstBuffer, regionCount, pRegions)
 }
 
+// VkCmdCopyImageToBuffer2 mocks base method.
+func (m *MockDriver) VkCmdCopyImageToBuffer2(commandBuffer driver.VkCommandBuffer, pCopyImageToBufferInfo *driver.VkCopyImageToBufferInfo2) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdCopyImageToBuffer2", commandBuffer, pCopyImageToBufferInfo)
+}
+
+// VkCmdCopyImageToBuffer2 indicates an expected call of VkCmdCopyImageToBuffer2.
+func (mr *MockDriverMockRecorder) VkCmdCopyImageToBuffer2(commandBuffer, pCopyImageToBufferInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdCopyImageToBuffer2", reflect.TypeOf((*MockDriver)(nil).VkCmdCopyImageToBuffer2), commandBuffer, pCopyImageToBufferInfo)
+}
+
 // VkCmdCopyQueryPoolResults mocks base method.
 func (m *MockDriver) VkCmdCopyQueryPoolResults(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, firstQuery, queryCount driver.Uint32, dstBuffer driver.VkBuffer, dstOffset, stride driver.VkDeviceSize, flags driver.VkQueryResultFlags) {
 	m.ctrl.T.Helper()
@@ -576,6 +674,18 @@ func (mr *MockDriverMockRecorder) VkCmdEndRenderPass2(commandBuffer, pSubpassEnd
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdEndRenderPass2", reflect.TypeOf((*MockDriver)(nil).VkCmdEndRenderPass2), commandBuffer, pSubpassEndInfo)
 }
 
+// VkCmdEndRendering mocks base method.
+func (m *MockDriver) VkCmdEndRendering(commandBuffer driver.VkCommandBuffer) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdEndRendering", commandBuffer)
+}
+
+// VkCmdEndRendering indicates an expected call of VkCmdEndRendering.
+func (mr *MockDriverMockRecorder) VkCmdEndRendering(commandBuffer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdEndRendering", reflect.TypeOf((*MockDriver)(nil).VkCmdEndRendering), commandBuffer)
+}
+
 // VkCmdExecuteCommands mocks base method.
 func (m *MockDriver) VkCmdExecuteCommands(commandBuffer driver.VkCommandBuffer, commandBufferCount driver.Uint32, pCommandBuffers *driver.VkCommandBuffer) {
 	m.ctrl.T.Helper()
@@ -636,6 +746,18 @@ func (mr *MockDriverMockRecorder) VkCmdPipelineBarrier(commandBuffer, srcStageMa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdPipelineBarrier", reflect.TypeOf((*MockDriver)(nil).VkCmdPipelineBarrier), commandBuffer, srcStageMask, dstStageMask, dependencyFlags, memoryBarrierCount, pMemoryBarriers, bufferMemoryBarrierCount, pBufferMemoryBarriers, imageMemoryBarrierCount, pImageMemoryBarriers)
 }
 
+// VkCmdPipelineBarrier2 mocks base method.
+func (m *MockDriver) VkCmdPipelineBarrier2(commandBuffer driver.VkCommandBuffer, pDependencyInfo *driver.VkDependencyInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdPipelineBarrier2", commandBuffer, pDependencyInfo)
+}
+
+// VkCmdPipelineBarrier2 indicates an expected call of VkCmdPipelineBarrier2.
+func (mr *MockDriverMockRecorder) VkCmdPipelineBarrier2(commandBuffer, pDependencyInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdPipelineBarrier2", reflect.TypeOf((*MockDriver)(nil).VkCmdPipelineBarrier2), commandBuffer, pDependencyInfo)
+}
+
 // VkCmdPushConstants mocks base method.
 func (m *MockDriver) VkCmdPushConstants(commandBuffer driver.VkCommandBuffer, layout driver.VkPipelineLayout, stageFlags driver.VkShaderStageFlags, offset, size driver.Uint32, pValues unsafe.Pointer) {
 	m.ctrl.T.Helper()
@@ -660,6 +782,18 @@ func (mr *MockDriverMockRecorder) VkCmdResetEvent(commandBuffer, event, stageMas
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdResetEvent", reflect.TypeOf((*MockDriver)(nil).VkCmdResetEvent), commandBuffer, event, stageMask)
 }
 
+// VkCmdResetEvent2 mocks base method.
+func (m *MockDriver) VkCmdResetEvent2(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags2) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdResetEvent2", commandBuffer, event, stageMask)
+}
+
+// VkCmdResetEvent2 indicates an expected call of VkCmdResetEvent2.
+func (mr *MockDriverMockRecorder) VkCmdResetEvent2(commandBuffer, event, stageMask interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdResetEvent2", reflect.TypeOf((*MockDriver)(nil).VkCmdResetEvent2), commandBuffer, event, stageMask)
+}
+
 // VkCmdResetQueryPool mocks base method.
 func (m *MockDriver) VkCmdResetQueryPool(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, firstQuery, queryCount driver.Uint32) {
 	m.ctrl.T.Helper()
@@ -684,6 +818,18 @@ func (mr *MockDriverMockRecorder) VkCmdResolveImage(commandBuffer, srcImage, src
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdResolveImage", reflect.TypeOf((*MockDriver)(nil).VkCmdResolveImage), commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)
 }
 
+// VkCmdResolveImage2 mocks base method.
+func (m *MockDriver) VkCmdResolveImage2(commandBuffer driver.VkCommandBuffer, pResolveImageInfo *driver.VkResolveImageInfo2) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdResolveImage2", commandBuffer, pResolveImageInfo)
+}
+
+// VkCmdResolveImage2 indicates an expected call of VkCmdResolveImage2.
+func (mr *MockDriverMockRecorder) VkCmdResolveImage2(commandBuffer, pResolveImageInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdResolveImage2", reflect.TypeOf((*MockDriver)(nil).VkCmdResolveImage2), commandBuffer, pResolveImageInfo)
+}
+
 // VkCmdSetBlendConstants mocks base method.
 func (m *MockDriver) VkCmdSetBlendConstants(commandBuffer driver.VkCommandBuffer, blendConstants *driver.Float) {
 	m.ctrl.T.Helper()
@@ -696,6 +842,18 @@ func (mr *MockDriverMockRecorder) VkCmdSetBlendConstants(commandBuffer, blendCon
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetBlendConstants", reflect.TypeOf((*MockDriver)(nil).VkCmdSetBlendConstants), commandBuffer, blendConstants)
 }
 
+// VkCmdSetCullMode mocks base method.
+func (m *MockDriver) VkCmdSetCullMode(commandBuffer driver.VkCommandBuffer, cullMode driver.VkCullModeFlags) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetCullMode", commandBuffer, cullMode)
+}
+
+// VkCmdSetCullMode indicates an expected call of VkCmdSetCullMode.
+func (mr *MockDriverMockRecorder) VkCmdSetCullMode(commandBuffer, cullMode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetCullMode", reflect.TypeOf((*MockDriver)(nil).VkCmdSetCullMode), commandBuffer, cullMode)
+}
+
 // VkCmdSetDepthBias mocks base method.
 func (m *MockDriver) VkCmdSetDepthBias(commandBuffer driver.VkCommandBuffer, depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor driver.Float) {
 	m.ctrl.T.Helper()
@@ -708,6 +866,18 @@ func (mr *MockDriverMockRecorder) VkCmdSetDepthBias(commandBuffer, depthBiasCons
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetDepthBias", reflect.TypeOf((*MockDriver)(nil).VkCmdSetDepthBias), commandBuffer, depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor)
 }
 
+// VkCmdSetDepthBiasEnable mocks base method.
+func (m *MockDriver) VkCmdSetDepthBiasEnable(commandBuffer driver.VkCommandBuffer, depthBiasEnable driver.VkBool32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetDepthBiasEnable", commandBuffer, depthBiasEnable)
+}
+
+// VkCmdSetDepthBiasEnable indicates an expected call of VkCmdSetDepthBiasEnable.
+func (mr *MockDriverMockRecorder) VkCmdSetDepthBiasEnable(commandBuffer, depthBiasEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetDepthBiasEnable", reflect.TypeOf((*MockDriver)(nil).VkCmdSetDepthBiasEnable), commandBuffer, depthBiasEnable)
+}
+
 // VkCmdSetDepthBounds mocks base method.
 func (m *MockDriver) VkCmdSetDepthBounds(commandBuffer driver.VkCommandBuffer, minDepthBounds, maxDepthBounds driver.Float) {
 	m.ctrl.T.Helper()
@@ -720,6 +890,54 @@ func (mr *MockDriverMockRecorder) VkCmdSetDepthBounds(commandBuffer, minDepthBou
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetDepthBounds", reflect.TypeOf((*MockDriver)(nil).VkCmdSetDepthBounds), commandBuffer, minDepthBounds, maxDepthBounds)
 }
 
+// VkCmdSetDepthBoundsTestEnable mocks base method.
+func (m *MockDriver) VkCmdSetDepthBoundsTestEnable(commandBuffer driver.VkCommandBuffer, depthBoundsTestEnable driver.VkBool32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetDepthBoundsTestEnable", commandBuffer, depthBoundsTestEnable)
+}
+
+// VkCmdSetDepthBoundsTestEnable indicates an expected call of VkCmdSetDepthBoundsTestEnable.
+func (mr *MockDriverMockRecorder) VkCmdSetDepthBoundsTestEnable(commandBuffer, depthBoundsTestEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetDepthBoundsTestEnable", reflect.TypeOf((*MockDriver)(nil).VkCmdSetDepthBoundsTestEnable), commandBuffer, depthBoundsTestEnable)
+}
+
+// VkCmdSetDepthCompareOp mocks base method.
+func (m *MockDriver) VkCmdSetDepthCompareOp(commandBuffer driver.VkCommandBuffer, depthCompareOp driver.VkCompareOp) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetDepthCompareOp", commandBuffer, depthCompareOp)
+}
+
+// VkCmdSetDepthCompareOp indicates an expected call of VkCmdSetDepthCompareOp.
+func (mr *MockDriverMockRecorder) VkCmdSetDepthCompareOp(commandBuffer, depthCompareOp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetDepthCompareOp", reflect.TypeOf((*MockDriver)(nil).VkCmdSetDepthCompareOp), commandBuffer, depthCompareOp)
+}
+
+// VkCmdSetDepthTestEnable mocks base method.
+func (m *MockDriver) VkCmdSetDepthTestEnable(commandBuffer driver.VkCommandBuffer, depthTestEnable driver.VkBool32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetDepthTestEnable", commandBuffer, depthTestEnable)
+}
+
+// VkCmdSetDepthTestEnable indicates an expected call of VkCmdSetDepthTestEnable.
+func (mr *MockDriverMockRecorder) VkCmdSetDepthTestEnable(commandBuffer, depthTestEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetDepthTestEnable", reflect.TypeOf((*MockDriver)(nil).VkCmdSetDepthTestEnable), commandBuffer, depthTestEnable)
+}
+
+// VkCmdSetDepthWriteEnable mocks base method.
+func (m *MockDriver) VkCmdSetDepthWriteEnable(commandBuffer driver.VkCommandBuffer, depthWriteEnable driver.VkBool32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetDepthWriteEnable", commandBuffer, depthWriteEnable)
+}
+
+// VkCmdSetDepthWriteEnable indicates an expected call of VkCmdSetDepthWriteEnable.
+func (mr *MockDriverMockRecorder) VkCmdSetDepthWriteEnable(commandBuffer, depthWriteEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetDepthWriteEnable", reflect.TypeOf((*MockDriver)(nil).VkCmdSetDepthWriteEnable), commandBuffer, depthWriteEnable)
+}
+
 // VkCmdSetDeviceMask mocks base method.
 func (m *MockDriver) VkCmdSetDeviceMask(commandBuffer driver.VkCommandBuffer, deviceMask driver.Uint32) {
 	m.ctrl.T.Helper()
@@ -744,6 +962,30 @@ func (mr *MockDriverMockRecorder) VkCmdSetEvent(commandBuffer, event, stageMask
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetEvent", reflect.TypeOf((*MockDriver)(nil).VkCmdSetEvent), commandBuffer, event, stageMask)
 }
 
+// VkCmdSetEvent2 mocks base method.
+func (m *MockDriver) VkCmdSetEvent2(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, pDependencyInfo *driver.VkDependencyInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetEvent2", commandBuffer, event, pDependencyInfo)
+}
+
+// VkCmdSetEvent2 indicates an expected call of VkCmdSetEvent2.
+func (mr *MockDriverMockRecorder) VkCmdSetEvent2(commandBuffer, event, pDependencyInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetEvent2", reflect.TypeOf((*MockDriver)(nil).VkCmdSetEvent2), commandBuffer, event, pDependencyInfo)
+}
+
+// VkCmdSetFrontFace mocks base method.
+func (m *MockDriver) VkCmdSetFrontFace(commandBuffer driver.VkCommandBuffer, frontFace driver.VkFrontFace) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetFrontFace", commandBuffer, frontFace)
+}
+
+// VkCmdSetFrontFace indicates an expected call of VkCmdSetFrontFace.
+func (mr *MockDriverMockRecorder) VkCmdSetFrontFace(commandBuffer, frontFace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetFrontFace", reflect.TypeOf((*MockDriver)(nil).VkCmdSetFrontFace), commandBuffer, frontFace)
+}
+
 // VkCmdSetLineWidth mocks base method.
 func (m *MockDriver) VkCmdSetLineWidth(commandBuffer driver.VkCommandBuffer, lineWidth driver.Float) {
 	m.ctrl.T.Helper()
@@ -756,6 +998,42 @@ func (mr *MockDriverMockRecorder) VkCmdSetLineWidth(commandBuffer, lineWidth int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetLineWidth", reflect.TypeOf((*MockDriver)(nil).VkCmdSetLineWidth), commandBuffer, lineWidth)
 }
 
+// VkCmdSetPrimitiveRestartEnable mocks base method.
+func (m *MockDriver) VkCmdSetPrimitiveRestartEnable(commandBuffer driver.VkCommandBuffer, primitiveRestartEnable driver.VkBool32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetPrimitiveRestartEnable", commandBuffer, primitiveRestartEnable)
+}
+
+// VkCmdSetPrimitiveRestartEnable indicates an expected call of VkCmdSetPrimitiveRestartEnable.
+func (mr *MockDriverMockRecorder) VkCmdSetPrimitiveRestartEnable(commandBuffer, primitiveRestartEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetPrimitiveRestartEnable", reflect.TypeOf((*MockDriver)(nil).VkCmdSetPrimitiveRestartEnable), commandBuffer, primitiveRestartEnable)
+}
+
+// VkCmdSetPrimitiveTopology mocks base method.
+func (m *MockDriver) VkCmdSetPrimitiveTopology(commandBuffer driver.VkCommandBuffer, primitiveTopology driver.VkPrimitiveTopology) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetPrimitiveTopology", commandBuffer, primitiveTopology)
+}
+
+// VkCmdSetPrimitiveTopology indicates an expected call of VkCmdSetPrimitiveTopology.
+func (mr *MockDriverMockRecorder) VkCmdSetPrimitiveTopology(commandBuffer, primitiveTopology interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetPrimitiveTopology", reflect.TypeOf((*MockDriver)(nil).VkCmdSetPrimitiveTopology), commandBuffer, primitiveTopology)
+}
+
+// VkCmdSetRasterizerDiscardEnable mocks base method.
+func (m *MockDriver) VkCmdSetRasterizerDiscardEnable(commandBuffer driver.VkCommandBuffer, rasterizerDiscardEnable driver.VkBool32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetRasterizerDiscardEnable", commandBuffer, rasterizerDiscardEnable)
+}
+
+// VkCmdSetRasterizerDiscardEnable indicates an expected call of VkCmdSetRasterizerDiscardEnable.
+func (mr *MockDriverMockRecorder) VkCmdSetRasterizerDiscardEnable(commandBuffer, rasterizerDiscardEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetRasterizerDiscardEnable", reflect.TypeOf((*MockDriver)(nil).VkCmdSetRasterizerDiscardEnable), commandBuffer, rasterizerDiscardEnable)
+}
+
 // VkCmdSetScissor mocks base method.
 func (m *MockDriver) VkCmdSetScissor(commandBuffer driver.VkCommandBuffer, firstScissor, scissorCount driver.Uint32, pScissors *driver.VkRect2D) {
 	m.ctrl.T.Helper()
@@ -768,6 +1046,18 @@ func (mr *MockDriverMockRecorder) VkCmdSetScissor(commandBuffer, firstScissor, s
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetScissor", reflect.TypeOf((*MockDriver)(nil).VkCmdSetScissor), commandBuffer, firstScissor, scissorCount, pScissors)
 }
 
+// VkCmdSetScissorWithCount mocks base method.
+func (m *MockDriver) VkCmdSetScissorWithCount(commandBuffer driver.VkCommandBuffer, scissorCount driver.Uint32, pScissors *driver.VkRect2D) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetScissorWithCount", commandBuffer, scissorCount, pScissors)
+}
+
+// VkCmdSetScissorWithCount indicates an expected call of VkCmdSetScissorWithCount.
+func (mr *MockDriverMockRecorder) VkCmdSetScissorWithCount(commandBuffer, scissorCount, pScissors interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetScissorWithCount", reflect.TypeOf((*MockDriver)(nil).VkCmdSetScissorWithCount), commandBuffer, scissorCount, pScissors)
+}
+
 // VkCmdSetStencilCompareMask mocks base method.
 func (m *MockDriver) VkCmdSetStencilCompareMask(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, compareMask driver.Uint32) {
 	m.ctrl.T.Helper()
@@ -780,6 +1070,18 @@ func (mr *MockDriverMockRecorder) VkCmdSetStencilCompareMask(commandBuffer, face
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetStencilCompareMask", reflect.TypeOf((*MockDriver)(nil).VkCmdSetStencilCompareMask), commandBuffer, faceMask, compareMask)
 }
 
+// VkCmdSetStencilOp mocks base method.
+func (m *MockDriver) VkCmdSetStencilOp(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, failOp, passOp, depthFailOp driver.VkStencilOp, compareOp driver.VkCompareOp) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetStencilOp", commandBuffer, faceMask, failOp, passOp, depthFailOp, compareOp)
+}
+
+// VkCmdSetStencilOp indicates an expected call of VkCmdSetStencilOp.
+func (mr *MockDriverMockRecorder) VkCmdSetStencilOp(commandBuffer, faceMask, failOp, passOp, depthFailOp, compareOp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetStencilOp", reflect.TypeOf((*MockDriver)(nil).VkCmdSetStencilOp), commandBuffer, faceMask, failOp, passOp, depthFailOp, compareOp)
+}
+
 // VkCmdSetStencilReference mocks base method.
 func (m *MockDriver) VkCmdSetStencilReference(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, reference driver.Uint32) {
 	m.ctrl.T.Helper()
@@ -792,6 +1094,18 @@ func (mr *MockDriverMockRecorder) VkCmdSetStencilReference(commandBuffer, faceMa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetStencilReference", reflect.TypeOf((*MockDriver)(nil).VkCmdSetStencilReference), commandBuffer, faceMask, reference)
 }
 
+// VkCmdSetStencilTestEnable mocks base method.
+func (m *MockDriver) VkCmdSetStencilTestEnable(commandBuffer driver.VkCommandBuffer, stencilTestEnable driver.VkBool32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetStencilTestEnable", commandBuffer, stencilTestEnable)
+}
+
+// VkCmdSetStencilTestEnable indicates an expected call of VkCmdSetStencilTestEnable.
+func (mr *MockDriverMockRecorder) VkCmdSetStencilTestEnable(commandBuffer, stencilTestEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetStencilTestEnable", reflect.TypeOf((*MockDriver)(nil).VkCmdSetStencilTestEnable), commandBuffer, stencilTestEnable)
+}
+
 // VkCmdSetStencilWriteMask mocks base method.
 func (m *MockDriver) VkCmdSetStencilWriteMask(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, writeMask driver.Uint32) {
 	m.ctrl.T.Helper()
@@ -816,6 +1130,18 @@ func (mr *MockDriverMockRecorder) VkCmdSetViewport(commandBuffer, firstViewport,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetViewport", reflect.TypeOf((*MockDriver)(nil).VkCmdSetViewport), commandBuffer, firstViewport, viewportCount, pViewports)
 }
 
+// VkCmdSetViewportWithCount mocks base method.
+func (m *MockDriver) VkCmdSetViewportWithCount(commandBuffer driver.VkCommandBuffer, viewportCount driver.Uint32, pViewports *driver.VkViewport) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdSetViewportWithCount", commandBuffer, viewportCount, pViewports)
+}
+
+// VkCmdSetViewportWithCount indicates an expected call of VkCmdSetViewportWithCount.
+func (mr *MockDriverMockRecorder) VkCmdSetViewportWithCount(commandBuffer, viewportCount, pViewports interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdSetViewportWithCount", reflect.TypeOf((*MockDriver)(nil).VkCmdSetViewportWithCount), commandBuffer, viewportCount, pViewports)
+}
+
 // VkCmdUpdateBuffer mocks base method.
 func (m *MockDriver) VkCmdUpdateBuffer(commandBuffer driver.VkCommandBuffer, dstBuffer driver.VkBuffer, dstOffset, dataSize driver.VkDeviceSize, pData unsafe.Pointer) {
 	m.ctrl.T.Helper()
@@ -840,6 +1166,18 @@ func (mr *MockDriverMockRecorder) VkCmdWaitEvents(commandBuffer, eventCount, pEv
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdWaitEvents", reflect.TypeOf((*MockDriver)(nil).VkCmdWaitEvents), commandBuffer, eventCount, pEvents, srcStageMask, dstStageMask, memoryBarrierCount, pMemoryBarriers, bufferMemoryBarrierCount, pBufferMemoryBarriers, imageMemoryBarrierCount, pImageMemoryBarriers)
 }
 
+// VkCmdWaitEvents2 mocks base method.
+func (m *MockDriver) VkCmdWaitEvents2(commandBuffer driver.VkCommandBuffer, eventCount driver.Uint32, pEvents *driver.VkEvent, pDependencyInfos *driver.VkDependencyInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdWaitEvents2", commandBuffer, eventCount, pEvents, pDependencyInfos)
+}
+
+// VkCmdWaitEvents2 indicates an expected call of VkCmdWaitEvents2.
+func (mr *MockDriverMockRecorder) VkCmdWaitEvents2(commandBuffer, eventCount, pEvents, pDependencyInfos interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdWaitEvents2", reflect.TypeOf((*MockDriver)(nil).VkCmdWaitEvents2), commandBuffer, eventCount, pEvents, pDependencyInfos)
+}
+
 // VkCmdWriteTimestamp mocks base method.
 func (m *MockDriver) VkCmdWriteTimestamp(commandBuffer driver.VkCommandBuffer, pipelineStage driver.VkPipelineStageFlags, queryPool driver.VkQueryPool, query driver.Uint32) {
 	m.ctrl.T.Helper()
@@ -852,6 +1190,18 @@ func (mr *MockDriverMockRecorder) VkCmdWriteTimestamp(commandBuffer, pipelineSta
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdWriteTimestamp", reflect.TypeOf((*MockDriver)(nil).VkCmdWriteTimestamp), commandBuffer, pipelineStage, queryPool, query)
 }
 
+// VkCmdWriteTimestamp2 mocks base method.
+func (m *MockDriver) VkCmdWriteTimestamp2(commandBuffer driver.VkCommandBuffer, stage driver.VkPipelineStageFlags2, queryPool driver.VkQueryPool, query driver.Uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkCmdWriteTimestamp2", commandBuffer, stage, queryPool, query)
+}
+
+// VkCmdWriteTimestamp2 indicates an expected call of VkCmdWriteTimestamp2.
+func (mr *MockDriverMockRecorder) VkCmdWriteTimestamp2(commandBuffer, stage, queryPool, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCmdWriteTimestamp2", reflect.TypeOf((*MockDriver)(nil).VkCmdWriteTimestamp2), commandBuffer, stage, queryPool, query)
+}
+
 // VkCreateBuffer mocks base method.
 func (m *MockDriver) VkCreateBuffer(device driver.VkDevice, pCreateInfo *driver.VkBufferCreateInfo, pAllocator *driver.VkAllocationCallbacks, pBuffer *driver.VkBuffer) (common.VkResult, error) {
 	m.ctrl.T.Helper()
@@ -1107,6 +1457,21 @@ func (mr *MockDriverMockRecorder) VkCreatePipelineLayout(device, pCreateInfo, pA
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCreatePipelineLayout", reflect.TypeOf((*MockDriver)(nil).VkCreatePipelineLayout), device, pCreateInfo, pAllocator, pPipelineLayout)
 }
 
+// VkCreatePrivateDataSlot mocks base method.
+func (m *MockDriver) VkCreatePrivateDataSlot(device driver.VkDevice, pCreateInfo *driver.VkPrivateDataSlotCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPrivateDataSlot *driver.VkPrivateDataSlot) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VkCreatePrivateDataSlot", device, pCreateInfo, pAllocator, pPrivateDataSlot)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VkCreatePrivateDataSlot indicates an expected call of VkCreatePrivateDataSlot.
+func (mr *MockDriverMockRecorder) VkCreatePrivateDataSlot(device, pCreateInfo, pAllocator, pPrivateDataSlot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkCreatePrivateDataSlot", reflect.TypeOf((*MockDriver)(nil).VkCreatePrivateDataSlot), device, pCreateInfo, pAllocator, pPrivateDataSlot)
+}
+
 // VkCreateQueryPool mocks base method.
 func (m *MockDriver) VkCreateQueryPool(device driver.VkDevice, pCreateInfo *driver.VkQueryPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pQueryPool *driver.VkQueryPool) (common.VkResult, error) {
 	m.ctrl.T.Helper()
@@ -1404,6 +1769,18 @@ func (mr *MockDriverMockRecorder) VkDestroyPipelineLayout(device, pipelineLayout
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkDestroyPipelineLayout", reflect.TypeOf((*MockDriver)(nil).VkDestroyPipelineLayout), device, pipelineLayout, pAllocator)
 }
 
+// VkDestroyPrivateDataSlot mocks base method.
+func (m *MockDriver) VkDestroyPrivateDataSlot(device driver.VkDevice, privateDataSlot driver.VkPrivateDataSlot, pAllocator *driver.VkAllocationCallbacks) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkDestroyPrivateDataSlot", device, privateDataSlot, pAllocator)
+}
+
+// VkDestroyPrivateDataSlot indicates an expected call of VkDestroyPrivateDataSlot.
+func (mr *MockDriverMockRecorder) VkDestroyPrivateDataSlot(device, privateDataSlot, pAllocator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkDestroyPrivateDataSlot", reflect.TypeOf((*MockDriver)(nil).VkDestroyPrivateDataSlot), device, privateDataSlot, pAllocator)
+}
+
 // VkDestroyQueryPool mocks base method.
 func (m *MockDriver) VkDestroyQueryPool(device driver.VkDevice, queryPool driver.VkQueryPool, pAllocator *driver.VkAllocationCallbacks) {
 	m.ctrl.T.Helper()
@@ -1729,6 +2106,18 @@ func (mr *MockDriverMockRecorder) VkGetDescriptorSetLayoutSupport(device, pCreat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkGetDescriptorSetLayoutSupport", reflect.TypeOf((*MockDriver)(nil).VkGetDescriptorSetLayoutSupport), device, pCreateInfo, pSupport)
 }
 
+// VkGetDeviceBufferMemoryRequirements mocks base method.
+func (m *MockDriver) VkGetDeviceBufferMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceBufferMemoryRequirements, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkGetDeviceBufferMemoryRequirements", device, pInfo, pMemoryRequirements)
+}
+
+// VkGetDeviceBufferMemoryRequirements indicates an expected call of VkGetDeviceBufferMemoryRequirements.
+func (mr *MockDriverMockRecorder) VkGetDeviceBufferMemoryRequirements(device, pInfo, pMemoryRequirements interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkGetDeviceBufferMemoryRequirements", reflect.TypeOf((*MockDriver)(nil).VkGetDeviceBufferMemoryRequirements), device, pInfo, pMemoryRequirements)
+}
+
 // VkGetDeviceGroupPeerMemoryFeatures mocks base method.
 func (m *MockDriver) VkGetDeviceGroupPeerMemoryFeatures(device driver.VkDevice, heapIndex, localDeviceIndex, remoteDeviceIndex driver.Uint32, pPeerMemoryFeatures *driver.VkPeerMemoryFeatureFlags) {
 	m.ctrl.T.Helper()
@@ -1741,6 +2130,30 @@ func (mr *MockDriverMockRecorder) VkGetDeviceGroupPeerMemoryFeatures(device, hea
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkGetDeviceGroupPeerMemoryFeatures", reflect.TypeOf((*MockDriver)(nil).VkGetDeviceGroupPeerMemoryFeatures), device, heapIndex, localDeviceIndex, remoteDeviceIndex, pPeerMemoryFeatures)
 }
 
+// VkGetDeviceImageMemoryRequirements mocks base method.
+func (m *MockDriver) VkGetDeviceImageMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceImageMemoryRequirements, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkGetDeviceImageMemoryRequirements", device, pInfo, pMemoryRequirements)
+}
+
+// VkGetDeviceImageMemoryRequirements indicates an expected call of VkGetDeviceImageMemoryRequirements.
+func (mr *MockDriverMockRecorder) VkGetDeviceImageMemoryRequirements(device, pInfo, pMemoryRequirements interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkGetDeviceImageMemoryRequirements", reflect.TypeOf((*MockDriver)(nil).VkGetDeviceImageMemoryRequirements), device, pInfo, pMemoryRequirements)
+}
+
+// VkGetDeviceImageSparseMemoryRequirements mocks base method.
+func (m *MockDriver) VkGetDeviceImageSparseMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceImageMemoryRequirements, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements2) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkGetDeviceImageSparseMemoryRequirements", device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+}
+
+// VkGetDeviceImageSparseMemoryRequirements indicates an expected call of VkGetDeviceImageSparseMemoryRequirements.
+func (mr *MockDriverMockRecorder) VkGetDeviceImageSparseMemoryRequirements(device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkGetDeviceImageSparseMemoryRequirements", reflect.TypeOf((*MockDriver)(nil).VkGetDeviceImageSparseMemoryRequirements), device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+}
+
 // VkGetDeviceMemoryCommitment mocks base method.
 func (m *MockDriver) VkGetDeviceMemoryCommitment(device driver.VkDevice, memory driver.VkDeviceMemory, pCommittedMemoryInBytes *driver.VkDeviceSize) {
 	m.ctrl.T.Helper()
@@ -2091,6 +2504,21 @@ func (mr *MockDriverMockRecorder) VkGetPhysicalDeviceSparseImageFormatProperties
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkGetPhysicalDeviceSparseImageFormatProperties2", reflect.TypeOf((*MockDriver)(nil).VkGetPhysicalDeviceSparseImageFormatProperties2), physicalDevice, pFormatInfo, pPropertyCount, pProperties)
 }
 
+// VkGetPhysicalDeviceToolProperties mocks base method.
+func (m *MockDriver) VkGetPhysicalDeviceToolProperties(physicalDevice driver.VkPhysicalDevice, pToolCount *driver.Uint32, pToolProperties *driver.VkPhysicalDeviceToolProperties) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VkGetPhysicalDeviceToolProperties", physicalDevice, pToolCount, pToolProperties)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VkGetPhysicalDeviceToolProperties indicates an expected call of VkGetPhysicalDeviceToolProperties.
+func (mr *MockDriverMockRecorder) VkGetPhysicalDeviceToolProperties(physicalDevice, pToolCount, pToolProperties interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkGetPhysicalDeviceToolProperties", reflect.TypeOf((*MockDriver)(nil).VkGetPhysicalDeviceToolProperties), physicalDevice, pToolCount, pToolProperties)
+}
+
 // VkGetPipelineCacheData mocks base method.
 func (m *MockDriver) VkGetPipelineCacheData(device driver.VkDevice, pipelineCache driver.VkPipelineCache, pDataSize *driver.Size, pData unsafe.Pointer) (common.VkResult, error) {
 	m.ctrl.T.Helper()
@@ -2106,6 +2534,18 @@ func (mr *MockDriverMockRecorder) VkGetPipelineCacheData(device, pipelineCache,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkGetPipelineCacheData", reflect.TypeOf((*MockDriver)(nil).VkGetPipelineCacheData), device, pipelineCache, pDataSize, pData)
 }
 
+// VkGetPrivateData mocks base method.
+func (m *MockDriver) VkGetPrivateData(device driver.VkDevice, objectType driver.VkObjectType, objectHandle driver.Uint64, privateDataSlot driver.VkPrivateDataSlot, pData *driver.Uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "VkGetPrivateData", device, objectType, objectHandle, privateDataSlot, pData)
+}
+
+// VkGetPrivateData indicates an expected call of VkGetPrivateData.
+func (mr *MockDriverMockRecorder) VkGetPrivateData(device, objectType, objectHandle, privateDataSlot, pData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkGetPrivateData", reflect.TypeOf((*MockDriver)(nil).VkGetPrivateData), device, objectType, objectHandle, privateDataSlot, pData)
+}
+
 // VkGetQueryPoolResults mocks base method.
 func (m *MockDriver) VkGetQueryPoolResults(device driver.VkDevice, queryPool driver.VkQueryPool, firstQuery, queryCount driver.Uint32, dataSize driver.Size, pData unsafe.Pointer, stride driver.VkDeviceSize, flags driver.VkQueryResultFlags) (common.VkResult, error) {
 	m.ctrl.T.Helper()
@@ -2223,6 +2663,21 @@ func (mr *MockDriverMockRecorder) VkQueueSubmit(queue, submitCount, pSubmits, fe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkQueueSubmit", reflect.TypeOf((*MockDriver)(nil).VkQueueSubmit), queue, submitCount, pSubmits, fence)
 }
 
+// VkQueueSubmit2 mocks base method.
+func (m *MockDriver) VkQueueSubmit2(queue driver.VkQueue, submitCount driver.Uint32, pSubmits *driver.VkSubmitInfo2, fence driver.VkFence) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VkQueueSubmit2", queue, submitCount, pSubmits, fence)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VkQueueSubmit2 indicates an expected call of VkQueueSubmit2.
+func (mr *MockDriverMockRecorder) VkQueueSubmit2(queue, submitCount, pSubmits, fence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkQueueSubmit2", reflect.TypeOf((*MockDriver)(nil).VkQueueSubmit2), queue, submitCount, pSubmits, fence)
+}
+
 // VkQueueWaitIdle mocks base method.
 func (m *MockDriver) VkQueueWaitIdle(queue driver.VkQueue) (common.VkResult, error) {
 	m.ctrl.T.Helper()
@@ -2340,6 +2795,21 @@ func (mr *MockDriverMockRecorder) VkSetEvent(device, event interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkSetEvent", reflect.TypeOf((*MockDriver)(nil).VkSetEvent), device, event)
 }
 
+// VkSetPrivateData mocks base method.
+func (m *MockDriver) VkSetPrivateData(device driver.VkDevice, objectType driver.VkObjectType, objectHandle driver.Uint64, privateDataSlot driver.VkPrivateDataSlot, data driver.Uint64) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VkSetPrivateData", device, objectType, objectHandle, privateDataSlot, data)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VkSetPrivateData indicates an expected call of VkSetPrivateData.
+func (mr *MockDriverMockRecorder) VkSetPrivateData(device, objectType, objectHandle, privateDataSlot, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VkSetPrivateData", reflect.TypeOf((*MockDriver)(nil).VkSetPrivateData), device, objectType, objectHandle, privateDataSlot, data)
+}
+
 // VkSignalSemaphore mocks base method.
 func (m *MockDriver) VkSignalSemaphore(device driver.VkDevice, pSignalInfo *driver.VkSemaphoreSignalInfo) (common.VkResult, error) {
 	m.ctrl.T.Helper()
@@ -2,6 +2,7 @@ package driver
 
 import (
 	"fmt"
+	"runtime/debug"
 	"sync"
 )
 
@@ -20,6 +21,27 @@ type VulkanObjectStore struct {
 	objChildren  map[VulkanHandle]map[VulkanHandle]struct{}
 	objParents   map[VulkanHandle]VulkanHandle
 	controlMutex sync.Mutex
+
+	validateLifetimes bool
+	destroyed         map[VulkanHandle]*ObjectDestroyedError
+
+	recordCreation bool
+	createdAt      map[VulkanHandle]string
+}
+
+// ObjectDestroyedError is returned by VulkanObjectStore.CheckLive when a handle is used after
+// the object it refers to was destroyed
+type ObjectDestroyedError struct {
+	// Handle is the handle that was used
+	Handle VulkanHandle
+	// Object is the type of the wrapper object the handle belonged to, i.e. "*core1_0.VulkanBuffer"
+	Object string
+	// DestroyedAt is the stack trace of the goroutine that destroyed the object
+	DestroyedAt string
+}
+
+func (e *ObjectDestroyedError) Error() string {
+	return fmt.Sprintf("%s 0x%x was used after it was destroyed; it was destroyed at:\n%s", e.Object, uint64(e.Handle), e.DestroyedAt)
 }
 
 func NewObjectStore() *VulkanObjectStore {
@@ -39,6 +61,10 @@ func (s *VulkanObjectStore) GetOrCreate(handle VulkanHandle, key string, create
 	if !hasObj {
 		objMap = make(map[string]any)
 		s.objStore[handle] = objMap
+
+		if s.recordCreation {
+			s.createdAt[handle] = string(debug.Stack())
+		}
 	} else {
 		obj, hasObj = objMap[key]
 		if hasObj {
@@ -49,6 +75,9 @@ func (s *VulkanObjectStore) GetOrCreate(handle VulkanHandle, key string, create
 	obj = create()
 	objMap[key] = obj
 
+	// Drivers may reuse the handles of destroyed objects
+	delete(s.destroyed, handle)
+
 	return obj
 }
 
@@ -72,8 +101,26 @@ func (s *VulkanObjectStore) SetParent(parent VulkanHandle, child VulkanHandle) {
 	s.objParents[child] = parent
 }
 
-func (s *VulkanObjectStore) deleteSingle(handle VulkanHandle) {
+// Parent retrieves the handle that was registered as child's parent with SetParent
+func (s *VulkanObjectStore) Parent(child VulkanHandle) (VulkanHandle, bool) {
+	s.controlMutex.Lock()
+	defer s.controlMutex.Unlock()
+
+	parent, hasParent := s.objParents[child]
+	return parent, hasParent
+}
+
+func (s *VulkanObjectStore) deleteSingle(handle VulkanHandle, stack string) {
+	if s.validateLifetimes {
+		s.destroyed[handle] = &ObjectDestroyedError{
+			Handle:      handle,
+			Object:      objectType(s.objStore[handle]),
+			DestroyedAt: stack,
+		}
+	}
+
 	delete(s.objStore, handle)
+	delete(s.createdAt, handle)
 
 	parent := s.objParents[handle]
 	parentChildren := s.objChildren[parent]
@@ -87,7 +134,7 @@ func (s *VulkanObjectStore) deleteSingle(handle VulkanHandle) {
 	}
 
 	for _, child := range childrenToDelete {
-		s.deleteSingle(child)
+		s.deleteSingle(child, stack)
 	}
 }
 
@@ -100,20 +147,75 @@ func (s *VulkanObjectStore) Delete(handle VulkanHandle) {
 		return
 	}
 
-	s.deleteSingle(handle)
+	var stack string
+	if s.validateLifetimes {
+		stack = string(debug.Stack())
+	}
+
+	s.deleteSingle(handle, stack)
 }
 
-func (s *VulkanObjectStore) PrintDebug() {
+// EnableLifetimeValidation causes the store to remember every object deleted from it, along with
+// the stack trace of the goroutine that deleted it, so that CheckLive can report objects that are
+// used after they are destroyed. Destroyed objects are remembered until their handle is reused,
+// so this should only be enabled while debugging or testing.
+func (s *VulkanObjectStore) EnableLifetimeValidation() {
 	s.controlMutex.Lock()
 	defer s.controlMutex.Unlock()
 
-	if len(s.objStore) == 0 {
-		return
+	s.validateLifetimes = true
+	if s.destroyed == nil {
+		s.destroyed = make(map[VulkanHandle]*ObjectDestroyedError)
 	}
+}
 
-	fmt.Println("THE FOLLOWING VULKAN OBJECTS REMAIN LIVE:")
+// LifetimeValidationEnabled returns true if EnableLifetimeValidation has been called on this store
+func (s *VulkanObjectStore) LifetimeValidationEnabled() bool {
+	s.controlMutex.Lock()
+	defer s.controlMutex.Unlock()
+
+	return s.validateLifetimes
+}
+
+// CheckLive returns an *ObjectDestroyedError if handle refers to an object that has been deleted
+// from the store. Handles the store has never seen, and all handles when lifetime validation is
+// not enabled, are assumed to be live.
+func (s *VulkanObjectStore) CheckLive(handle VulkanHandle) error {
+	s.controlMutex.Lock()
+	defer s.controlMutex.Unlock()
+
+	destroyed, isDestroyed := s.destroyed[handle]
+	if !isDestroyed {
+		return nil
+	}
+
+	return destroyed
+}
 
-	for key, value := range s.objStore {
-		fmt.Printf("%T - %v: %v+\n", value, key, value)
+func objectType(objects map[string]any) string {
+	for _, key := range []string{Core1_0, Core1_1, Core1_2, Core1_3} {
+		obj, ok := objects[key]
+		if ok {
+			return fmt.Sprintf("%T", obj)
+		}
+	}
+
+	for _, obj := range objects {
+		return fmt.Sprintf("%T", obj)
 	}
+
+	return "object"
+}
+
+// PrintDebug prints every object that remains in the store to stdout.
+//
+// Deprecated: use LeakReport, which can be inspected by tests or written as JSON.
+func (s *VulkanObjectStore) PrintDebug() {
+	report := s.LeakReport()
+	if report.Empty() {
+		return
+	}
+
+	fmt.Println("THE FOLLOWING VULKAN OBJECTS REMAIN LIVE:")
+	fmt.Print(report.String())
 }
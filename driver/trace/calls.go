@@ -0,0 +1,2199 @@
+package trace
+
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+func (d *Driver) VkEnumerateInstanceVersion(pApiVersion *driver.Uint32) (common.VkResult, error) {
+	call := d.begin("vkEnumerateInstanceVersion")
+	res, err := d.inner.VkEnumerateInstanceVersion(pApiVersion)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEnumerateInstanceExtensionProperties(pLayerName *driver.Char, pPropertyCount *driver.Uint32, pProperties *driver.VkExtensionProperties) (common.VkResult, error) {
+	call := d.begin("vkEnumerateInstanceExtensionProperties")
+	res, err := d.inner.VkEnumerateInstanceExtensionProperties(pLayerName, pPropertyCount, pProperties)
+	call.end()
+	if pPropertyCount != nil {
+		call.count("pPropertyCount", uint64(*pPropertyCount))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEnumerateInstanceLayerProperties(pPropertyCount *driver.Uint32, pProperties *driver.VkLayerProperties) (common.VkResult, error) {
+	call := d.begin("vkEnumerateInstanceLayerProperties")
+	res, err := d.inner.VkEnumerateInstanceLayerProperties(pPropertyCount, pProperties)
+	call.end()
+	if pPropertyCount != nil {
+		call.count("pPropertyCount", uint64(*pPropertyCount))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateInstance(pCreateInfo *driver.VkInstanceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pInstance *driver.VkInstance) (common.VkResult, error) {
+	call := d.begin("vkCreateInstance")
+	res, err := d.inner.VkCreateInstance(pCreateInfo, pAllocator, pInstance)
+	call.end()
+	if pInstance != nil {
+		call.handle("pInstance", driver.VulkanHandle(*pInstance))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEnumeratePhysicalDevices(instance driver.VkInstance, pPhysicalDeviceCount *driver.Uint32, pPhysicalDevices *driver.VkPhysicalDevice) (common.VkResult, error) {
+	call := d.begin("vkEnumeratePhysicalDevices")
+	call.handle("instance", driver.VulkanHandle(instance))
+	res, err := d.inner.VkEnumeratePhysicalDevices(instance, pPhysicalDeviceCount, pPhysicalDevices)
+	call.end()
+	if pPhysicalDeviceCount != nil {
+		call.count("pPhysicalDeviceCount", uint64(*pPhysicalDeviceCount))
+	}
+	if pPhysicalDevices != nil && pPhysicalDeviceCount != nil {
+		addHandles(call, "pPhysicalDevices", pPhysicalDevices, int(*pPhysicalDeviceCount))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyInstance(instance driver.VkInstance, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyInstance")
+	call.handle("instance", driver.VulkanHandle(instance))
+	d.inner.VkDestroyInstance(instance, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceFeatures(physicalDevice driver.VkPhysicalDevice, pFeatures *driver.VkPhysicalDeviceFeatures) {
+	call := d.begin("vkGetPhysicalDeviceFeatures")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceFeatures(physicalDevice, pFeatures)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, pFormatProperties *driver.VkFormatProperties) {
+	call := d.begin("vkGetPhysicalDeviceFormatProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	call.value("format", uint64(format))
+	d.inner.VkGetPhysicalDeviceFormatProperties(physicalDevice, format, pFormatProperties)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceImageFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, t driver.VkImageType, tiling driver.VkImageTiling, usage driver.VkImageUsageFlags, flags driver.VkImageCreateFlags, pImageFormatProperties *driver.VkImageFormatProperties) (common.VkResult, error) {
+	call := d.begin("vkGetPhysicalDeviceImageFormatProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	call.value("format", uint64(format))
+	call.value("t", uint64(t))
+	call.value("tiling", uint64(tiling))
+	call.value("usage", uint64(usage))
+	call.value("flags", uint64(flags))
+	res, err := d.inner.VkGetPhysicalDeviceImageFormatProperties(physicalDevice, format, t, tiling, usage, flags, pImageFormatProperties)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetPhysicalDeviceProperties(physicalDevice driver.VkPhysicalDevice, pProperties *driver.VkPhysicalDeviceProperties) {
+	call := d.begin("vkGetPhysicalDeviceProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceProperties(physicalDevice, pProperties)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceQueueFamilyProperties(physicalDevice driver.VkPhysicalDevice, pQueueFamilyPropertyCount *driver.Uint32, pQueueFamilyProperties *driver.VkQueueFamilyProperties) {
+	call := d.begin("vkGetPhysicalDeviceQueueFamilyProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceQueueFamilyProperties(physicalDevice, pQueueFamilyPropertyCount, pQueueFamilyProperties)
+	call.end()
+	if pQueueFamilyPropertyCount != nil {
+		call.count("pQueueFamilyPropertyCount", uint64(*pQueueFamilyPropertyCount))
+	}
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceMemoryProperties(physicalDevice driver.VkPhysicalDevice, pMemoryProperties *driver.VkPhysicalDeviceMemoryProperties) {
+	call := d.begin("vkGetPhysicalDeviceMemoryProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceMemoryProperties(physicalDevice, pMemoryProperties)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkEnumerateDeviceExtensionProperties(physicalDevice driver.VkPhysicalDevice, pLayerName *driver.Char, pPropertyCount *driver.Uint32, pProperties *driver.VkExtensionProperties) (common.VkResult, error) {
+	call := d.begin("vkEnumerateDeviceExtensionProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	res, err := d.inner.VkEnumerateDeviceExtensionProperties(physicalDevice, pLayerName, pPropertyCount, pProperties)
+	call.end()
+	if pPropertyCount != nil {
+		call.count("pPropertyCount", uint64(*pPropertyCount))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEnumerateDeviceLayerProperties(physicalDevice driver.VkPhysicalDevice, pPropertyCount *driver.Uint32, pProperties *driver.VkLayerProperties) (common.VkResult, error) {
+	call := d.begin("vkEnumerateDeviceLayerProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	res, err := d.inner.VkEnumerateDeviceLayerProperties(physicalDevice, pPropertyCount, pProperties)
+	call.end()
+	if pPropertyCount != nil {
+		call.count("pPropertyCount", uint64(*pPropertyCount))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetPhysicalDeviceSparseImageFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, t driver.VkImageType, samples driver.VkSampleCountFlagBits, usage driver.VkImageUsageFlags, tiling driver.VkImageTiling, pPropertyCount *driver.Uint32, pProperties *driver.VkSparseImageFormatProperties) {
+	call := d.begin("vkGetPhysicalDeviceSparseImageFormatProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	call.value("format", uint64(format))
+	call.value("t", uint64(t))
+	call.value("samples", uint64(samples))
+	call.value("usage", uint64(usage))
+	call.value("tiling", uint64(tiling))
+	d.inner.VkGetPhysicalDeviceSparseImageFormatProperties(physicalDevice, format, t, samples, usage, tiling, pPropertyCount, pProperties)
+	call.end()
+	if pPropertyCount != nil {
+		call.count("pPropertyCount", uint64(*pPropertyCount))
+	}
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateDevice(physicalDevice driver.VkPhysicalDevice, pCreateInfo *driver.VkDeviceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDevice *driver.VkDevice) (common.VkResult, error) {
+	call := d.begin("vkCreateDevice")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	res, err := d.inner.VkCreateDevice(physicalDevice, pCreateInfo, pAllocator, pDevice)
+	call.end()
+	if pDevice != nil {
+		call.handle("pDevice", driver.VulkanHandle(*pDevice))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEnumeratePhysicalDeviceGroups(instance driver.VkInstance, pPhysicalDeviceGroupCount *driver.Uint32, pPhysicalDeviceGroupProperties *driver.VkPhysicalDeviceGroupProperties) (common.VkResult, error) {
+	call := d.begin("vkEnumeratePhysicalDeviceGroups")
+	call.handle("instance", driver.VulkanHandle(instance))
+	res, err := d.inner.VkEnumeratePhysicalDeviceGroups(instance, pPhysicalDeviceGroupCount, pPhysicalDeviceGroupProperties)
+	call.end()
+	if pPhysicalDeviceGroupCount != nil {
+		call.count("pPhysicalDeviceGroupCount", uint64(*pPhysicalDeviceGroupCount))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetPhysicalDeviceFeatures2(physicalDevice driver.VkPhysicalDevice, pFeatures *driver.VkPhysicalDeviceFeatures2) {
+	call := d.begin("vkGetPhysicalDeviceFeatures2")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceFeatures2(physicalDevice, pFeatures)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceProperties2(physicalDevice driver.VkPhysicalDevice, pProperties *driver.VkPhysicalDeviceProperties2) {
+	call := d.begin("vkGetPhysicalDeviceProperties2")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceProperties2(physicalDevice, pProperties)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceFormatProperties2(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, pFormatProperties *driver.VkFormatProperties2) {
+	call := d.begin("vkGetPhysicalDeviceFormatProperties2")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	call.value("format", uint64(format))
+	d.inner.VkGetPhysicalDeviceFormatProperties2(physicalDevice, format, pFormatProperties)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceImageFormatProperties2(physicalDevice driver.VkPhysicalDevice, pImageFormatInfo *driver.VkPhysicalDeviceImageFormatInfo2, pImageFormatProperties *driver.VkImageFormatProperties2) (common.VkResult, error) {
+	call := d.begin("vkGetPhysicalDeviceImageFormatProperties2")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	res, err := d.inner.VkGetPhysicalDeviceImageFormatProperties2(physicalDevice, pImageFormatInfo, pImageFormatProperties)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetPhysicalDeviceQueueFamilyProperties2(physicalDevice driver.VkPhysicalDevice, pQueueFamilyPropertyCount *driver.Uint32, pQueueFamilyProperties *driver.VkQueueFamilyProperties2) {
+	call := d.begin("vkGetPhysicalDeviceQueueFamilyProperties2")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceQueueFamilyProperties2(physicalDevice, pQueueFamilyPropertyCount, pQueueFamilyProperties)
+	call.end()
+	if pQueueFamilyPropertyCount != nil {
+		call.count("pQueueFamilyPropertyCount", uint64(*pQueueFamilyPropertyCount))
+	}
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceMemoryProperties2(physicalDevice driver.VkPhysicalDevice, pMemoryProperties *driver.VkPhysicalDeviceMemoryProperties2) {
+	call := d.begin("vkGetPhysicalDeviceMemoryProperties2")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceMemoryProperties2(physicalDevice, pMemoryProperties)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceSparseImageFormatProperties2(physicalDevice driver.VkPhysicalDevice, pFormatInfo *driver.VkPhysicalDeviceSparseImageFormatInfo2, pPropertyCount *driver.Uint32, pProperties *driver.VkSparseImageFormatProperties2) {
+	call := d.begin("vkGetPhysicalDeviceSparseImageFormatProperties2")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceSparseImageFormatProperties2(physicalDevice, pFormatInfo, pPropertyCount, pProperties)
+	call.end()
+	if pPropertyCount != nil {
+		call.count("pPropertyCount", uint64(*pPropertyCount))
+	}
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalBufferProperties(physicalDevice driver.VkPhysicalDevice, pExternalBufferInfo *driver.VkPhysicalDeviceExternalBufferInfo, pExternalBufferProperties *driver.VkExternalBufferProperties) {
+	call := d.begin("vkGetPhysicalDeviceExternalBufferProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceExternalBufferProperties(physicalDevice, pExternalBufferInfo, pExternalBufferProperties)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalFenceProperties(physicalDevice driver.VkPhysicalDevice, pExternalFenceInfo *driver.VkPhysicalDeviceExternalFenceInfo, pExternalFenceProperties *driver.VkExternalFenceProperties) {
+	call := d.begin("vkGetPhysicalDeviceExternalFenceProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceExternalFenceProperties(physicalDevice, pExternalFenceInfo, pExternalFenceProperties)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalSemaphoreProperties(physicalDevice driver.VkPhysicalDevice, pExternalSemaphoreInfo *driver.VkPhysicalDeviceExternalSemaphoreInfo, pExternalSemaphoreProperties *driver.VkExternalSemaphoreProperties) {
+	call := d.begin("vkGetPhysicalDeviceExternalSemaphoreProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	d.inner.VkGetPhysicalDeviceExternalSemaphoreProperties(physicalDevice, pExternalSemaphoreInfo, pExternalSemaphoreProperties)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkDestroyDevice(device driver.VkDevice, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyDevice")
+	call.handle("device", driver.VulkanHandle(device))
+	d.inner.VkDestroyDevice(device, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetDeviceQueue(device driver.VkDevice, queueFamilyIndex driver.Uint32, queueIndex driver.Uint32, pQueue *driver.VkQueue) {
+	call := d.begin("vkGetDeviceQueue")
+	call.handle("device", driver.VulkanHandle(device))
+	call.value("queueFamilyIndex", uint64(queueFamilyIndex))
+	call.value("queueIndex", uint64(queueIndex))
+	d.inner.VkGetDeviceQueue(device, queueFamilyIndex, queueIndex, pQueue)
+	call.end()
+	if pQueue != nil {
+		call.handle("pQueue", driver.VulkanHandle(*pQueue))
+	}
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkQueueSubmit(queue driver.VkQueue, submitCount driver.Uint32, pSubmits *driver.VkSubmitInfo, fence driver.VkFence) (common.VkResult, error) {
+	call := d.begin("vkQueueSubmit")
+	call.handle("queue", driver.VulkanHandle(queue))
+	call.count("submitCount", uint64(submitCount))
+	call.handle("fence", driver.VulkanHandle(fence))
+	res, err := d.inner.VkQueueSubmit(queue, submitCount, pSubmits, fence)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkQueueWaitIdle(queue driver.VkQueue) (common.VkResult, error) {
+	call := d.begin("vkQueueWaitIdle")
+	call.handle("queue", driver.VulkanHandle(queue))
+	res, err := d.inner.VkQueueWaitIdle(queue)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDeviceWaitIdle(device driver.VkDevice) (common.VkResult, error) {
+	call := d.begin("vkDeviceWaitIdle")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkDeviceWaitIdle(device)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkAllocateMemory(device driver.VkDevice, pAllocateInfo *driver.VkMemoryAllocateInfo, pAllocator *driver.VkAllocationCallbacks, pMemory *driver.VkDeviceMemory) (common.VkResult, error) {
+	call := d.begin("vkAllocateMemory")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkAllocateMemory(device, pAllocateInfo, pAllocator, pMemory)
+	call.end()
+	if pMemory != nil {
+		call.handle("pMemory", driver.VulkanHandle(*pMemory))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkFreeMemory(device driver.VkDevice, memory driver.VkDeviceMemory, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkFreeMemory")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("memory", driver.VulkanHandle(memory))
+	d.inner.VkFreeMemory(device, memory, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkMapMemory(device driver.VkDevice, memory driver.VkDeviceMemory, offset driver.VkDeviceSize, size driver.VkDeviceSize, flags driver.VkMemoryMapFlags, ppData *unsafe.Pointer) (common.VkResult, error) {
+	call := d.begin("vkMapMemory")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("memory", driver.VulkanHandle(memory))
+	call.value("offset", uint64(offset))
+	call.value("size", uint64(size))
+	call.value("flags", uint64(flags))
+	res, err := d.inner.VkMapMemory(device, memory, offset, size, flags, ppData)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkUnmapMemory(device driver.VkDevice, memory driver.VkDeviceMemory) {
+	call := d.begin("vkUnmapMemory")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("memory", driver.VulkanHandle(memory))
+	d.inner.VkUnmapMemory(device, memory)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkFlushMappedMemoryRanges(device driver.VkDevice, memoryRangeCount driver.Uint32, pMemoryRanges *driver.VkMappedMemoryRange) (common.VkResult, error) {
+	call := d.begin("vkFlushMappedMemoryRanges")
+	call.handle("device", driver.VulkanHandle(device))
+	call.count("memoryRangeCount", uint64(memoryRangeCount))
+	res, err := d.inner.VkFlushMappedMemoryRanges(device, memoryRangeCount, pMemoryRanges)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkInvalidateMappedMemoryRanges(device driver.VkDevice, memoryRangeCount driver.Uint32, pMemoryRanges *driver.VkMappedMemoryRange) (common.VkResult, error) {
+	call := d.begin("vkInvalidateMappedMemoryRanges")
+	call.handle("device", driver.VulkanHandle(device))
+	call.count("memoryRangeCount", uint64(memoryRangeCount))
+	res, err := d.inner.VkInvalidateMappedMemoryRanges(device, memoryRangeCount, pMemoryRanges)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetDeviceMemoryCommitment(device driver.VkDevice, memory driver.VkDeviceMemory, pCommittedMemoryInBytes *driver.VkDeviceSize) {
+	call := d.begin("vkGetDeviceMemoryCommitment")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("memory", driver.VulkanHandle(memory))
+	d.inner.VkGetDeviceMemoryCommitment(device, memory, pCommittedMemoryInBytes)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkBindBufferMemory(device driver.VkDevice, buffer driver.VkBuffer, memory driver.VkDeviceMemory, memoryOffset driver.VkDeviceSize) (common.VkResult, error) {
+	call := d.begin("vkBindBufferMemory")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("buffer", driver.VulkanHandle(buffer))
+	call.handle("memory", driver.VulkanHandle(memory))
+	call.value("memoryOffset", uint64(memoryOffset))
+	res, err := d.inner.VkBindBufferMemory(device, buffer, memory, memoryOffset)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkBindImageMemory(device driver.VkDevice, image driver.VkImage, memory driver.VkDeviceMemory, memoryOffset driver.VkDeviceSize) (common.VkResult, error) {
+	call := d.begin("vkBindImageMemory")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("image", driver.VulkanHandle(image))
+	call.handle("memory", driver.VulkanHandle(memory))
+	call.value("memoryOffset", uint64(memoryOffset))
+	res, err := d.inner.VkBindImageMemory(device, image, memory, memoryOffset)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetBufferMemoryRequirements(device driver.VkDevice, buffer driver.VkBuffer, pMemoryRequirements *driver.VkMemoryRequirements) {
+	call := d.begin("vkGetBufferMemoryRequirements")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("buffer", driver.VulkanHandle(buffer))
+	d.inner.VkGetBufferMemoryRequirements(device, buffer, pMemoryRequirements)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetImageMemoryRequirements(device driver.VkDevice, image driver.VkImage, pMemoryRequirements *driver.VkMemoryRequirements) {
+	call := d.begin("vkGetImageMemoryRequirements")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("image", driver.VulkanHandle(image))
+	d.inner.VkGetImageMemoryRequirements(device, image, pMemoryRequirements)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetImageSparseMemoryRequirements(device driver.VkDevice, image driver.VkImage, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements) {
+	call := d.begin("vkGetImageSparseMemoryRequirements")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("image", driver.VulkanHandle(image))
+	d.inner.VkGetImageSparseMemoryRequirements(device, image, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+	call.end()
+	if pSparseMemoryRequirementCount != nil {
+		call.count("pSparseMemoryRequirementCount", uint64(*pSparseMemoryRequirementCount))
+	}
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkQueueBindSparse(queue driver.VkQueue, bindInfoCount driver.Uint32, pBindInfo *driver.VkBindSparseInfo, fence driver.VkFence) (common.VkResult, error) {
+	call := d.begin("vkQueueBindSparse")
+	call.handle("queue", driver.VulkanHandle(queue))
+	call.count("bindInfoCount", uint64(bindInfoCount))
+	call.handle("fence", driver.VulkanHandle(fence))
+	res, err := d.inner.VkQueueBindSparse(queue, bindInfoCount, pBindInfo, fence)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateFence(device driver.VkDevice, pCreateInfo *driver.VkFenceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pFence *driver.VkFence) (common.VkResult, error) {
+	call := d.begin("vkCreateFence")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateFence(device, pCreateInfo, pAllocator, pFence)
+	call.end()
+	if pFence != nil {
+		call.handle("pFence", driver.VulkanHandle(*pFence))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyFence(device driver.VkDevice, fence driver.VkFence, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyFence")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("fence", driver.VulkanHandle(fence))
+	d.inner.VkDestroyFence(device, fence, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkResetFences(device driver.VkDevice, fenceCount driver.Uint32, pFences *driver.VkFence) (common.VkResult, error) {
+	call := d.begin("vkResetFences")
+	call.handle("device", driver.VulkanHandle(device))
+	call.count("fenceCount", uint64(fenceCount))
+	res, err := d.inner.VkResetFences(device, fenceCount, pFences)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetFenceStatus(device driver.VkDevice, fence driver.VkFence) (common.VkResult, error) {
+	call := d.begin("vkGetFenceStatus")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("fence", driver.VulkanHandle(fence))
+	res, err := d.inner.VkGetFenceStatus(device, fence)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkWaitForFences(device driver.VkDevice, fenceCount driver.Uint32, pFences *driver.VkFence, waitAll driver.VkBool32, timeout driver.Uint64) (common.VkResult, error) {
+	call := d.begin("vkWaitForFences")
+	call.handle("device", driver.VulkanHandle(device))
+	call.count("fenceCount", uint64(fenceCount))
+	call.value("waitAll", uint64(waitAll))
+	call.value("timeout", uint64(timeout))
+	res, err := d.inner.VkWaitForFences(device, fenceCount, pFences, waitAll, timeout)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateSemaphore(device driver.VkDevice, pCreateInfo *driver.VkSemaphoreCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSemaphore *driver.VkSemaphore) (common.VkResult, error) {
+	call := d.begin("vkCreateSemaphore")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateSemaphore(device, pCreateInfo, pAllocator, pSemaphore)
+	call.end()
+	if pSemaphore != nil {
+		call.handle("pSemaphore", driver.VulkanHandle(*pSemaphore))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroySemaphore(device driver.VkDevice, semaphore driver.VkSemaphore, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroySemaphore")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("semaphore", driver.VulkanHandle(semaphore))
+	d.inner.VkDestroySemaphore(device, semaphore, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateEvent(device driver.VkDevice, pCreateInfo *driver.VkEventCreateInfo, pAllocator *driver.VkAllocationCallbacks, pEvent *driver.VkEvent) (common.VkResult, error) {
+	call := d.begin("vkCreateEvent")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateEvent(device, pCreateInfo, pAllocator, pEvent)
+	call.end()
+	if pEvent != nil {
+		call.handle("pEvent", driver.VulkanHandle(*pEvent))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyEvent(device driver.VkDevice, event driver.VkEvent, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyEvent")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("event", driver.VulkanHandle(event))
+	d.inner.VkDestroyEvent(device, event, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetEventStatus(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	call := d.begin("vkGetEventStatus")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("event", driver.VulkanHandle(event))
+	res, err := d.inner.VkGetEventStatus(device, event)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkSetEvent(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	call := d.begin("vkSetEvent")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("event", driver.VulkanHandle(event))
+	res, err := d.inner.VkSetEvent(device, event)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkResetEvent(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	call := d.begin("vkResetEvent")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("event", driver.VulkanHandle(event))
+	res, err := d.inner.VkResetEvent(device, event)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateQueryPool(device driver.VkDevice, pCreateInfo *driver.VkQueryPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pQueryPool *driver.VkQueryPool) (common.VkResult, error) {
+	call := d.begin("vkCreateQueryPool")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateQueryPool(device, pCreateInfo, pAllocator, pQueryPool)
+	call.end()
+	if pQueryPool != nil {
+		call.handle("pQueryPool", driver.VulkanHandle(*pQueryPool))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyQueryPool(device driver.VkDevice, queryPool driver.VkQueryPool, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyQueryPool")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("queryPool", driver.VulkanHandle(queryPool))
+	d.inner.VkDestroyQueryPool(device, queryPool, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetQueryPoolResults(device driver.VkDevice, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32, dataSize driver.Size, pData unsafe.Pointer, stride driver.VkDeviceSize, flags driver.VkQueryResultFlags) (common.VkResult, error) {
+	call := d.begin("vkGetQueryPoolResults")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("queryPool", driver.VulkanHandle(queryPool))
+	call.value("firstQuery", uint64(firstQuery))
+	call.count("queryCount", uint64(queryCount))
+	call.value("dataSize", uint64(dataSize))
+	call.value("stride", uint64(stride))
+	call.value("flags", uint64(flags))
+	res, err := d.inner.VkGetQueryPoolResults(device, queryPool, firstQuery, queryCount, dataSize, pData, stride, flags)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateBuffer(device driver.VkDevice, pCreateInfo *driver.VkBufferCreateInfo, pAllocator *driver.VkAllocationCallbacks, pBuffer *driver.VkBuffer) (common.VkResult, error) {
+	call := d.begin("vkCreateBuffer")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateBuffer(device, pCreateInfo, pAllocator, pBuffer)
+	call.end()
+	if pBuffer != nil {
+		call.handle("pBuffer", driver.VulkanHandle(*pBuffer))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyBuffer(device driver.VkDevice, buffer driver.VkBuffer, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyBuffer")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("buffer", driver.VulkanHandle(buffer))
+	d.inner.VkDestroyBuffer(device, buffer, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateBufferView(device driver.VkDevice, pCreateInfo *driver.VkBufferViewCreateInfo, pAllocator *driver.VkAllocationCallbacks, pView *driver.VkBufferView) (common.VkResult, error) {
+	call := d.begin("vkCreateBufferView")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateBufferView(device, pCreateInfo, pAllocator, pView)
+	call.end()
+	if pView != nil {
+		call.handle("pView", driver.VulkanHandle(*pView))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyBufferView(device driver.VkDevice, bufferView driver.VkBufferView, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyBufferView")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("bufferView", driver.VulkanHandle(bufferView))
+	d.inner.VkDestroyBufferView(device, bufferView, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateImage(device driver.VkDevice, pCreateInfo *driver.VkImageCreateInfo, pAllocator *driver.VkAllocationCallbacks, pImage *driver.VkImage) (common.VkResult, error) {
+	call := d.begin("vkCreateImage")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateImage(device, pCreateInfo, pAllocator, pImage)
+	call.end()
+	if pImage != nil {
+		call.handle("pImage", driver.VulkanHandle(*pImage))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyImage(device driver.VkDevice, image driver.VkImage, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyImage")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("image", driver.VulkanHandle(image))
+	d.inner.VkDestroyImage(device, image, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetImageSubresourceLayout(device driver.VkDevice, image driver.VkImage, pSubresource *driver.VkImageSubresource, pLayout *driver.VkSubresourceLayout) {
+	call := d.begin("vkGetImageSubresourceLayout")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("image", driver.VulkanHandle(image))
+	d.inner.VkGetImageSubresourceLayout(device, image, pSubresource, pLayout)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateImageView(device driver.VkDevice, pCreateInfo *driver.VkImageViewCreateInfo, pAllocator *driver.VkAllocationCallbacks, pView *driver.VkImageView) (common.VkResult, error) {
+	call := d.begin("vkCreateImageView")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateImageView(device, pCreateInfo, pAllocator, pView)
+	call.end()
+	if pView != nil {
+		call.handle("pView", driver.VulkanHandle(*pView))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyImageView(device driver.VkDevice, imageView driver.VkImageView, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyImageView")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("imageView", driver.VulkanHandle(imageView))
+	d.inner.VkDestroyImageView(device, imageView, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateShaderModule(device driver.VkDevice, pCreateInfo *driver.VkShaderModuleCreateInfo, pAllocator *driver.VkAllocationCallbacks, pShaderModule *driver.VkShaderModule) (common.VkResult, error) {
+	call := d.begin("vkCreateShaderModule")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateShaderModule(device, pCreateInfo, pAllocator, pShaderModule)
+	call.end()
+	if pShaderModule != nil {
+		call.handle("pShaderModule", driver.VulkanHandle(*pShaderModule))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyShaderModule(device driver.VkDevice, shaderModule driver.VkShaderModule, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyShaderModule")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("shaderModule", driver.VulkanHandle(shaderModule))
+	d.inner.VkDestroyShaderModule(device, shaderModule, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreatePipelineCache(device driver.VkDevice, pCreateInfo *driver.VkPipelineCacheCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelineCache *driver.VkPipelineCache) (common.VkResult, error) {
+	call := d.begin("vkCreatePipelineCache")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreatePipelineCache(device, pCreateInfo, pAllocator, pPipelineCache)
+	call.end()
+	if pPipelineCache != nil {
+		call.handle("pPipelineCache", driver.VulkanHandle(*pPipelineCache))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyPipelineCache(device driver.VkDevice, pipelineCache driver.VkPipelineCache, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyPipelineCache")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("pipelineCache", driver.VulkanHandle(pipelineCache))
+	d.inner.VkDestroyPipelineCache(device, pipelineCache, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPipelineCacheData(device driver.VkDevice, pipelineCache driver.VkPipelineCache, pDataSize *driver.Size, pData unsafe.Pointer) (common.VkResult, error) {
+	call := d.begin("vkGetPipelineCacheData")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("pipelineCache", driver.VulkanHandle(pipelineCache))
+	res, err := d.inner.VkGetPipelineCacheData(device, pipelineCache, pDataSize, pData)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkMergePipelineCaches(device driver.VkDevice, dstCache driver.VkPipelineCache, srcCacheCount driver.Uint32, pSrcCaches *driver.VkPipelineCache) (common.VkResult, error) {
+	call := d.begin("vkMergePipelineCaches")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("dstCache", driver.VulkanHandle(dstCache))
+	call.count("srcCacheCount", uint64(srcCacheCount))
+	res, err := d.inner.VkMergePipelineCaches(device, dstCache, srcCacheCount, pSrcCaches)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateGraphicsPipelines(device driver.VkDevice, pipelineCache driver.VkPipelineCache, createInfoCount driver.Uint32, pCreateInfos *driver.VkGraphicsPipelineCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelines *driver.VkPipeline) (common.VkResult, error) {
+	call := d.begin("vkCreateGraphicsPipelines")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("pipelineCache", driver.VulkanHandle(pipelineCache))
+	call.count("createInfoCount", uint64(createInfoCount))
+	res, err := d.inner.VkCreateGraphicsPipelines(device, pipelineCache, createInfoCount, pCreateInfos, pAllocator, pPipelines)
+	call.end()
+	if pPipelines != nil && res == core1_0.VKSuccess {
+		addHandles(call, "pPipelines", pPipelines, int(createInfoCount))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCreateComputePipelines(device driver.VkDevice, pipelineCache driver.VkPipelineCache, createInfoCount driver.Uint32, pCreateInfos *driver.VkComputePipelineCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelines *driver.VkPipeline) (common.VkResult, error) {
+	call := d.begin("vkCreateComputePipelines")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("pipelineCache", driver.VulkanHandle(pipelineCache))
+	call.count("createInfoCount", uint64(createInfoCount))
+	res, err := d.inner.VkCreateComputePipelines(device, pipelineCache, createInfoCount, pCreateInfos, pAllocator, pPipelines)
+	call.end()
+	if pPipelines != nil && res == core1_0.VKSuccess {
+		addHandles(call, "pPipelines", pPipelines, int(createInfoCount))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyPipeline(device driver.VkDevice, pipeline driver.VkPipeline, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyPipeline")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("pipeline", driver.VulkanHandle(pipeline))
+	d.inner.VkDestroyPipeline(device, pipeline, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreatePipelineLayout(device driver.VkDevice, pCreateInfo *driver.VkPipelineLayoutCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelineLayout *driver.VkPipelineLayout) (common.VkResult, error) {
+	call := d.begin("vkCreatePipelineLayout")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreatePipelineLayout(device, pCreateInfo, pAllocator, pPipelineLayout)
+	call.end()
+	if pPipelineLayout != nil {
+		call.handle("pPipelineLayout", driver.VulkanHandle(*pPipelineLayout))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyPipelineLayout(device driver.VkDevice, pipelineLayout driver.VkPipelineLayout, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyPipelineLayout")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("pipelineLayout", driver.VulkanHandle(pipelineLayout))
+	d.inner.VkDestroyPipelineLayout(device, pipelineLayout, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateSampler(device driver.VkDevice, pCreateInfo *driver.VkSamplerCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSampler *driver.VkSampler) (common.VkResult, error) {
+	call := d.begin("vkCreateSampler")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateSampler(device, pCreateInfo, pAllocator, pSampler)
+	call.end()
+	if pSampler != nil {
+		call.handle("pSampler", driver.VulkanHandle(*pSampler))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroySampler(device driver.VkDevice, sampler driver.VkSampler, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroySampler")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("sampler", driver.VulkanHandle(sampler))
+	d.inner.VkDestroySampler(device, sampler, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateDescriptorSetLayout(device driver.VkDevice, pCreateInfo *driver.VkDescriptorSetLayoutCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSetLayout *driver.VkDescriptorSetLayout) (common.VkResult, error) {
+	call := d.begin("vkCreateDescriptorSetLayout")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateDescriptorSetLayout(device, pCreateInfo, pAllocator, pSetLayout)
+	call.end()
+	if pSetLayout != nil {
+		call.handle("pSetLayout", driver.VulkanHandle(*pSetLayout))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyDescriptorSetLayout(device driver.VkDevice, descriptorSetLayout driver.VkDescriptorSetLayout, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyDescriptorSetLayout")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("descriptorSetLayout", driver.VulkanHandle(descriptorSetLayout))
+	d.inner.VkDestroyDescriptorSetLayout(device, descriptorSetLayout, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateDescriptorPool(device driver.VkDevice, pCreateInfo *driver.VkDescriptorPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDescriptorPool *driver.VkDescriptorPool) (common.VkResult, error) {
+	call := d.begin("vkCreateDescriptorPool")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateDescriptorPool(device, pCreateInfo, pAllocator, pDescriptorPool)
+	call.end()
+	if pDescriptorPool != nil {
+		call.handle("pDescriptorPool", driver.VulkanHandle(*pDescriptorPool))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyDescriptorPool(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyDescriptorPool")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("descriptorPool", driver.VulkanHandle(descriptorPool))
+	d.inner.VkDestroyDescriptorPool(device, descriptorPool, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkResetDescriptorPool(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, flags driver.VkDescriptorPoolResetFlags) (common.VkResult, error) {
+	call := d.begin("vkResetDescriptorPool")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("descriptorPool", driver.VulkanHandle(descriptorPool))
+	call.value("flags", uint64(flags))
+	res, err := d.inner.VkResetDescriptorPool(device, descriptorPool, flags)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkAllocateDescriptorSets(device driver.VkDevice, pAllocateInfo *driver.VkDescriptorSetAllocateInfo, pDescriptorSets *driver.VkDescriptorSet) (common.VkResult, error) {
+	call := d.begin("vkAllocateDescriptorSets")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkAllocateDescriptorSets(device, pAllocateInfo, pDescriptorSets)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkFreeDescriptorSets(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, descriptorSetCount driver.Uint32, pDescriptorSets *driver.VkDescriptorSet) (common.VkResult, error) {
+	call := d.begin("vkFreeDescriptorSets")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("descriptorPool", driver.VulkanHandle(descriptorPool))
+	call.count("descriptorSetCount", uint64(descriptorSetCount))
+	res, err := d.inner.VkFreeDescriptorSets(device, descriptorPool, descriptorSetCount, pDescriptorSets)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkUpdateDescriptorSets(device driver.VkDevice, descriptorWriteCount driver.Uint32, pDescriptorWrites *driver.VkWriteDescriptorSet, descriptorCopyCount driver.Uint32, pDescriptorCopies *driver.VkCopyDescriptorSet) {
+	call := d.begin("vkUpdateDescriptorSets")
+	call.handle("device", driver.VulkanHandle(device))
+	call.count("descriptorWriteCount", uint64(descriptorWriteCount))
+	call.count("descriptorCopyCount", uint64(descriptorCopyCount))
+	d.inner.VkUpdateDescriptorSets(device, descriptorWriteCount, pDescriptorWrites, descriptorCopyCount, pDescriptorCopies)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateFramebuffer(device driver.VkDevice, pCreateInfo *driver.VkFramebufferCreateInfo, pAllocator *driver.VkAllocationCallbacks, pFramebuffer *driver.VkFramebuffer) (common.VkResult, error) {
+	call := d.begin("vkCreateFramebuffer")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateFramebuffer(device, pCreateInfo, pAllocator, pFramebuffer)
+	call.end()
+	if pFramebuffer != nil {
+		call.handle("pFramebuffer", driver.VulkanHandle(*pFramebuffer))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyFramebuffer(device driver.VkDevice, framebuffer driver.VkFramebuffer, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyFramebuffer")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("framebuffer", driver.VulkanHandle(framebuffer))
+	d.inner.VkDestroyFramebuffer(device, framebuffer, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateRenderPass(device driver.VkDevice, pCreateInfo *driver.VkRenderPassCreateInfo, pAllocator *driver.VkAllocationCallbacks, pRenderPass *driver.VkRenderPass) (common.VkResult, error) {
+	call := d.begin("vkCreateRenderPass")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateRenderPass(device, pCreateInfo, pAllocator, pRenderPass)
+	call.end()
+	if pRenderPass != nil {
+		call.handle("pRenderPass", driver.VulkanHandle(*pRenderPass))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyRenderPass(device driver.VkDevice, renderPass driver.VkRenderPass, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyRenderPass")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("renderPass", driver.VulkanHandle(renderPass))
+	d.inner.VkDestroyRenderPass(device, renderPass, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetRenderAreaGranularity(device driver.VkDevice, renderPass driver.VkRenderPass, pGranularity *driver.VkExtent2D) {
+	call := d.begin("vkGetRenderAreaGranularity")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("renderPass", driver.VulkanHandle(renderPass))
+	d.inner.VkGetRenderAreaGranularity(device, renderPass, pGranularity)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateCommandPool(device driver.VkDevice, pCreateInfo *driver.VkCommandPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pCommandPool *driver.VkCommandPool) (common.VkResult, error) {
+	call := d.begin("vkCreateCommandPool")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateCommandPool(device, pCreateInfo, pAllocator, pCommandPool)
+	call.end()
+	if pCommandPool != nil {
+		call.handle("pCommandPool", driver.VulkanHandle(*pCommandPool))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyCommandPool")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("commandPool", driver.VulkanHandle(commandPool))
+	d.inner.VkDestroyCommandPool(device, commandPool, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkResetCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, flags driver.VkCommandPoolResetFlags) (common.VkResult, error) {
+	call := d.begin("vkResetCommandPool")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("commandPool", driver.VulkanHandle(commandPool))
+	call.value("flags", uint64(flags))
+	res, err := d.inner.VkResetCommandPool(device, commandPool, flags)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkAllocateCommandBuffers(device driver.VkDevice, pAllocateInfo *driver.VkCommandBufferAllocateInfo, pCommandBuffers *driver.VkCommandBuffer) (common.VkResult, error) {
+	call := d.begin("vkAllocateCommandBuffers")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkAllocateCommandBuffers(device, pAllocateInfo, pCommandBuffers)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkFreeCommandBuffers(device driver.VkDevice, commandPool driver.VkCommandPool, commandBufferCount driver.Uint32, pCommandBuffers *driver.VkCommandBuffer) {
+	call := d.begin("vkFreeCommandBuffers")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("commandPool", driver.VulkanHandle(commandPool))
+	call.count("commandBufferCount", uint64(commandBufferCount))
+	d.inner.VkFreeCommandBuffers(device, commandPool, commandBufferCount, pCommandBuffers)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkBeginCommandBuffer(commandBuffer driver.VkCommandBuffer, pBeginInfo *driver.VkCommandBufferBeginInfo) (common.VkResult, error) {
+	call := d.begin("vkBeginCommandBuffer")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	res, err := d.inner.VkBeginCommandBuffer(commandBuffer, pBeginInfo)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkEndCommandBuffer(commandBuffer driver.VkCommandBuffer) (common.VkResult, error) {
+	call := d.begin("vkEndCommandBuffer")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	res, err := d.inner.VkEndCommandBuffer(commandBuffer)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkResetCommandBuffer(commandBuffer driver.VkCommandBuffer, flags driver.VkCommandBufferResetFlags) (common.VkResult, error) {
+	call := d.begin("vkResetCommandBuffer")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("flags", uint64(flags))
+	res, err := d.inner.VkResetCommandBuffer(commandBuffer, flags)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCmdBindPipeline(commandBuffer driver.VkCommandBuffer, pipelineBindPoint driver.VkPipelineBindPoint, pipeline driver.VkPipeline) {
+	call := d.begin("vkCmdBindPipeline")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("pipelineBindPoint", uint64(pipelineBindPoint))
+	call.handle("pipeline", driver.VulkanHandle(pipeline))
+	d.inner.VkCmdBindPipeline(commandBuffer, pipelineBindPoint, pipeline)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetViewport(commandBuffer driver.VkCommandBuffer, firstViewport driver.Uint32, viewportCount driver.Uint32, pViewports *driver.VkViewport) {
+	call := d.begin("vkCmdSetViewport")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("firstViewport", uint64(firstViewport))
+	call.count("viewportCount", uint64(viewportCount))
+	d.inner.VkCmdSetViewport(commandBuffer, firstViewport, viewportCount, pViewports)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetScissor(commandBuffer driver.VkCommandBuffer, firstScissor driver.Uint32, scissorCount driver.Uint32, pScissors *driver.VkRect2D) {
+	call := d.begin("vkCmdSetScissor")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("firstScissor", uint64(firstScissor))
+	call.count("scissorCount", uint64(scissorCount))
+	d.inner.VkCmdSetScissor(commandBuffer, firstScissor, scissorCount, pScissors)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetLineWidth(commandBuffer driver.VkCommandBuffer, lineWidth driver.Float) {
+	call := d.begin("vkCmdSetLineWidth")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdSetLineWidth(commandBuffer, lineWidth)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthBias(commandBuffer driver.VkCommandBuffer, depthBiasConstantFactor driver.Float, depthBiasClamp driver.Float, depthBiasSlopeFactor driver.Float) {
+	call := d.begin("vkCmdSetDepthBias")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdSetDepthBias(commandBuffer, depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetBlendConstants(commandBuffer driver.VkCommandBuffer, blendConstants *driver.Float) {
+	call := d.begin("vkCmdSetBlendConstants")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdSetBlendConstants(commandBuffer, blendConstants)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthBounds(commandBuffer driver.VkCommandBuffer, minDepthBounds driver.Float, maxDepthBounds driver.Float) {
+	call := d.begin("vkCmdSetDepthBounds")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdSetDepthBounds(commandBuffer, minDepthBounds, maxDepthBounds)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetStencilCompareMask(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, compareMask driver.Uint32) {
+	call := d.begin("vkCmdSetStencilCompareMask")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("faceMask", uint64(faceMask))
+	call.value("compareMask", uint64(compareMask))
+	d.inner.VkCmdSetStencilCompareMask(commandBuffer, faceMask, compareMask)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetStencilWriteMask(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, writeMask driver.Uint32) {
+	call := d.begin("vkCmdSetStencilWriteMask")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("faceMask", uint64(faceMask))
+	call.value("writeMask", uint64(writeMask))
+	d.inner.VkCmdSetStencilWriteMask(commandBuffer, faceMask, writeMask)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetStencilReference(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, reference driver.Uint32) {
+	call := d.begin("vkCmdSetStencilReference")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("faceMask", uint64(faceMask))
+	call.value("reference", uint64(reference))
+	d.inner.VkCmdSetStencilReference(commandBuffer, faceMask, reference)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdBindDescriptorSets(commandBuffer driver.VkCommandBuffer, pipelineBindPoint driver.VkPipelineBindPoint, layout driver.VkPipelineLayout, firstSet driver.Uint32, descriptorSetCount driver.Uint32, pDescriptorSets *driver.VkDescriptorSet, dynamicOffsetCount driver.Uint32, pDynamicOffsets *driver.Uint32) {
+	call := d.begin("vkCmdBindDescriptorSets")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("pipelineBindPoint", uint64(pipelineBindPoint))
+	call.handle("layout", driver.VulkanHandle(layout))
+	call.value("firstSet", uint64(firstSet))
+	call.count("descriptorSetCount", uint64(descriptorSetCount))
+	call.count("dynamicOffsetCount", uint64(dynamicOffsetCount))
+	d.inner.VkCmdBindDescriptorSets(commandBuffer, pipelineBindPoint, layout, firstSet, descriptorSetCount, pDescriptorSets, dynamicOffsetCount, pDynamicOffsets)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdBindIndexBuffer(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, indexType driver.VkIndexType) {
+	call := d.begin("vkCmdBindIndexBuffer")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("buffer", driver.VulkanHandle(buffer))
+	call.value("offset", uint64(offset))
+	call.value("indexType", uint64(indexType))
+	d.inner.VkCmdBindIndexBuffer(commandBuffer, buffer, offset, indexType)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdBindVertexBuffers(commandBuffer driver.VkCommandBuffer, firstBinding driver.Uint32, bindingCount driver.Uint32, pBuffers *driver.VkBuffer, pOffsets *driver.VkDeviceSize) {
+	call := d.begin("vkCmdBindVertexBuffers")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("firstBinding", uint64(firstBinding))
+	call.count("bindingCount", uint64(bindingCount))
+	d.inner.VkCmdBindVertexBuffers(commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdDraw(commandBuffer driver.VkCommandBuffer, vertexCount driver.Uint32, instanceCount driver.Uint32, firstVertex driver.Uint32, firstInstance driver.Uint32) {
+	call := d.begin("vkCmdDraw")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.count("vertexCount", uint64(vertexCount))
+	call.count("instanceCount", uint64(instanceCount))
+	call.value("firstVertex", uint64(firstVertex))
+	call.value("firstInstance", uint64(firstInstance))
+	d.inner.VkCmdDraw(commandBuffer, vertexCount, instanceCount, firstVertex, firstInstance)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdDrawIndexed(commandBuffer driver.VkCommandBuffer, indexCount driver.Uint32, instanceCount driver.Uint32, firstIndex driver.Uint32, vertexOffset driver.Int32, firstInstance driver.Uint32) {
+	call := d.begin("vkCmdDrawIndexed")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.count("indexCount", uint64(indexCount))
+	call.count("instanceCount", uint64(instanceCount))
+	call.value("firstIndex", uint64(firstIndex))
+	call.value("vertexOffset", uint64(vertexOffset))
+	call.value("firstInstance", uint64(firstInstance))
+	d.inner.VkCmdDrawIndexed(commandBuffer, indexCount, instanceCount, firstIndex, vertexOffset, firstInstance)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdDrawIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, drawCount driver.Uint32, stride driver.Uint32) {
+	call := d.begin("vkCmdDrawIndirect")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("buffer", driver.VulkanHandle(buffer))
+	call.value("offset", uint64(offset))
+	call.count("drawCount", uint64(drawCount))
+	call.value("stride", uint64(stride))
+	d.inner.VkCmdDrawIndirect(commandBuffer, buffer, offset, drawCount, stride)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdDrawIndexedIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, drawCount driver.Uint32, stride driver.Uint32) {
+	call := d.begin("vkCmdDrawIndexedIndirect")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("buffer", driver.VulkanHandle(buffer))
+	call.value("offset", uint64(offset))
+	call.count("drawCount", uint64(drawCount))
+	call.value("stride", uint64(stride))
+	d.inner.VkCmdDrawIndexedIndirect(commandBuffer, buffer, offset, drawCount, stride)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdDispatch(commandBuffer driver.VkCommandBuffer, groupCountX driver.Uint32, groupCountY driver.Uint32, groupCountZ driver.Uint32) {
+	call := d.begin("vkCmdDispatch")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("groupCountX", uint64(groupCountX))
+	call.value("groupCountY", uint64(groupCountY))
+	call.value("groupCountZ", uint64(groupCountZ))
+	d.inner.VkCmdDispatch(commandBuffer, groupCountX, groupCountY, groupCountZ)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdDispatchIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize) {
+	call := d.begin("vkCmdDispatchIndirect")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("buffer", driver.VulkanHandle(buffer))
+	call.value("offset", uint64(offset))
+	d.inner.VkCmdDispatchIndirect(commandBuffer, buffer, offset)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdCopyBuffer(commandBuffer driver.VkCommandBuffer, srcBuffer driver.VkBuffer, dstBuffer driver.VkBuffer, regionCount driver.Uint32, pRegions *driver.VkBufferCopy) {
+	call := d.begin("vkCmdCopyBuffer")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("srcBuffer", driver.VulkanHandle(srcBuffer))
+	call.handle("dstBuffer", driver.VulkanHandle(dstBuffer))
+	call.count("regionCount", uint64(regionCount))
+	d.inner.VkCmdCopyBuffer(commandBuffer, srcBuffer, dstBuffer, regionCount, pRegions)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdCopyImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageCopy) {
+	call := d.begin("vkCmdCopyImage")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("srcImage", driver.VulkanHandle(srcImage))
+	call.value("srcImageLayout", uint64(srcImageLayout))
+	call.handle("dstImage", driver.VulkanHandle(dstImage))
+	call.value("dstImageLayout", uint64(dstImageLayout))
+	call.count("regionCount", uint64(regionCount))
+	d.inner.VkCmdCopyImage(commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdBlitImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageBlit, filter driver.VkFilter) {
+	call := d.begin("vkCmdBlitImage")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("srcImage", driver.VulkanHandle(srcImage))
+	call.value("srcImageLayout", uint64(srcImageLayout))
+	call.handle("dstImage", driver.VulkanHandle(dstImage))
+	call.value("dstImageLayout", uint64(dstImageLayout))
+	call.count("regionCount", uint64(regionCount))
+	call.value("filter", uint64(filter))
+	d.inner.VkCmdBlitImage(commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions, filter)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdCopyBufferToImage(commandBuffer driver.VkCommandBuffer, srcBuffer driver.VkBuffer, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkBufferImageCopy) {
+	call := d.begin("vkCmdCopyBufferToImage")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("srcBuffer", driver.VulkanHandle(srcBuffer))
+	call.handle("dstImage", driver.VulkanHandle(dstImage))
+	call.value("dstImageLayout", uint64(dstImageLayout))
+	call.count("regionCount", uint64(regionCount))
+	d.inner.VkCmdCopyBufferToImage(commandBuffer, srcBuffer, dstImage, dstImageLayout, regionCount, pRegions)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdCopyImageToBuffer(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstBuffer driver.VkBuffer, regionCount driver.Uint32, pRegions *driver.VkBufferImageCopy) {
+	call := d.begin("vkCmdCopyImageToBuffer")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("srcImage", driver.VulkanHandle(srcImage))
+	call.value("srcImageLayout", uint64(srcImageLayout))
+	call.handle("dstBuffer", driver.VulkanHandle(dstBuffer))
+	call.count("regionCount", uint64(regionCount))
+	d.inner.VkCmdCopyImageToBuffer(commandBuffer, srcImage, srcImageLayout, dstBuffer, regionCount, pRegions)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdUpdateBuffer(commandBuffer driver.VkCommandBuffer, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, dataSize driver.VkDeviceSize, pData unsafe.Pointer) {
+	call := d.begin("vkCmdUpdateBuffer")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("dstBuffer", driver.VulkanHandle(dstBuffer))
+	call.value("dstOffset", uint64(dstOffset))
+	call.value("dataSize", uint64(dataSize))
+	d.inner.VkCmdUpdateBuffer(commandBuffer, dstBuffer, dstOffset, dataSize, pData)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdFillBuffer(commandBuffer driver.VkCommandBuffer, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, size driver.VkDeviceSize, data driver.Uint32) {
+	call := d.begin("vkCmdFillBuffer")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("dstBuffer", driver.VulkanHandle(dstBuffer))
+	call.value("dstOffset", uint64(dstOffset))
+	call.value("size", uint64(size))
+	call.value("data", uint64(data))
+	d.inner.VkCmdFillBuffer(commandBuffer, dstBuffer, dstOffset, size, data)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdClearColorImage(commandBuffer driver.VkCommandBuffer, image driver.VkImage, imageLayout driver.VkImageLayout, pColor *driver.VkClearColorValue, rangeCount driver.Uint32, pRanges *driver.VkImageSubresourceRange) {
+	call := d.begin("vkCmdClearColorImage")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("image", driver.VulkanHandle(image))
+	call.value("imageLayout", uint64(imageLayout))
+	call.count("rangeCount", uint64(rangeCount))
+	d.inner.VkCmdClearColorImage(commandBuffer, image, imageLayout, pColor, rangeCount, pRanges)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdClearDepthStencilImage(commandBuffer driver.VkCommandBuffer, image driver.VkImage, imageLayout driver.VkImageLayout, pDepthStencil *driver.VkClearDepthStencilValue, rangeCount driver.Uint32, pRanges *driver.VkImageSubresourceRange) {
+	call := d.begin("vkCmdClearDepthStencilImage")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("image", driver.VulkanHandle(image))
+	call.value("imageLayout", uint64(imageLayout))
+	call.count("rangeCount", uint64(rangeCount))
+	d.inner.VkCmdClearDepthStencilImage(commandBuffer, image, imageLayout, pDepthStencil, rangeCount, pRanges)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdClearAttachments(commandBuffer driver.VkCommandBuffer, attachmentCount driver.Uint32, pAttachments *driver.VkClearAttachment, rectCount driver.Uint32, pRects *driver.VkClearRect) {
+	call := d.begin("vkCmdClearAttachments")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.count("attachmentCount", uint64(attachmentCount))
+	call.count("rectCount", uint64(rectCount))
+	d.inner.VkCmdClearAttachments(commandBuffer, attachmentCount, pAttachments, rectCount, pRects)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdResolveImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageResolve) {
+	call := d.begin("vkCmdResolveImage")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("srcImage", driver.VulkanHandle(srcImage))
+	call.value("srcImageLayout", uint64(srcImageLayout))
+	call.handle("dstImage", driver.VulkanHandle(dstImage))
+	call.value("dstImageLayout", uint64(dstImageLayout))
+	call.count("regionCount", uint64(regionCount))
+	d.inner.VkCmdResolveImage(commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetEvent(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags) {
+	call := d.begin("vkCmdSetEvent")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("event", driver.VulkanHandle(event))
+	call.value("stageMask", uint64(stageMask))
+	d.inner.VkCmdSetEvent(commandBuffer, event, stageMask)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdResetEvent(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags) {
+	call := d.begin("vkCmdResetEvent")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("event", driver.VulkanHandle(event))
+	call.value("stageMask", uint64(stageMask))
+	d.inner.VkCmdResetEvent(commandBuffer, event, stageMask)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdWaitEvents(commandBuffer driver.VkCommandBuffer, eventCount driver.Uint32, pEvents *driver.VkEvent, srcStageMask driver.VkPipelineStageFlags, dstStageMask driver.VkPipelineStageFlags, memoryBarrierCount driver.Uint32, pMemoryBarriers *driver.VkMemoryBarrier, bufferMemoryBarrierCount driver.Uint32, pBufferMemoryBarriers *driver.VkBufferMemoryBarrier, imageMemoryBarrierCount driver.Uint32, pImageMemoryBarriers *driver.VkImageMemoryBarrier) {
+	call := d.begin("vkCmdWaitEvents")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.count("eventCount", uint64(eventCount))
+	call.value("srcStageMask", uint64(srcStageMask))
+	call.value("dstStageMask", uint64(dstStageMask))
+	call.count("memoryBarrierCount", uint64(memoryBarrierCount))
+	call.count("bufferMemoryBarrierCount", uint64(bufferMemoryBarrierCount))
+	call.count("imageMemoryBarrierCount", uint64(imageMemoryBarrierCount))
+	d.inner.VkCmdWaitEvents(commandBuffer, eventCount, pEvents, srcStageMask, dstStageMask, memoryBarrierCount, pMemoryBarriers, bufferMemoryBarrierCount, pBufferMemoryBarriers, imageMemoryBarrierCount, pImageMemoryBarriers)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdPipelineBarrier(commandBuffer driver.VkCommandBuffer, srcStageMask driver.VkPipelineStageFlags, dstStageMask driver.VkPipelineStageFlags, dependencyFlags driver.VkDependencyFlags, memoryBarrierCount driver.Uint32, pMemoryBarriers *driver.VkMemoryBarrier, bufferMemoryBarrierCount driver.Uint32, pBufferMemoryBarriers *driver.VkBufferMemoryBarrier, imageMemoryBarrierCount driver.Uint32, pImageMemoryBarriers *driver.VkImageMemoryBarrier) {
+	call := d.begin("vkCmdPipelineBarrier")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("srcStageMask", uint64(srcStageMask))
+	call.value("dstStageMask", uint64(dstStageMask))
+	call.value("dependencyFlags", uint64(dependencyFlags))
+	call.count("memoryBarrierCount", uint64(memoryBarrierCount))
+	call.count("bufferMemoryBarrierCount", uint64(bufferMemoryBarrierCount))
+	call.count("imageMemoryBarrierCount", uint64(imageMemoryBarrierCount))
+	d.inner.VkCmdPipelineBarrier(commandBuffer, srcStageMask, dstStageMask, dependencyFlags, memoryBarrierCount, pMemoryBarriers, bufferMemoryBarrierCount, pBufferMemoryBarriers, imageMemoryBarrierCount, pImageMemoryBarriers)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdBeginQuery(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, query driver.Uint32, flags driver.VkQueryControlFlags) {
+	call := d.begin("vkCmdBeginQuery")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("queryPool", driver.VulkanHandle(queryPool))
+	call.value("query", uint64(query))
+	call.value("flags", uint64(flags))
+	d.inner.VkCmdBeginQuery(commandBuffer, queryPool, query, flags)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdEndQuery(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, query driver.Uint32) {
+	call := d.begin("vkCmdEndQuery")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("queryPool", driver.VulkanHandle(queryPool))
+	call.value("query", uint64(query))
+	d.inner.VkCmdEndQuery(commandBuffer, queryPool, query)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdResetQueryPool(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32) {
+	call := d.begin("vkCmdResetQueryPool")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("queryPool", driver.VulkanHandle(queryPool))
+	call.value("firstQuery", uint64(firstQuery))
+	call.count("queryCount", uint64(queryCount))
+	d.inner.VkCmdResetQueryPool(commandBuffer, queryPool, firstQuery, queryCount)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdWriteTimestamp(commandBuffer driver.VkCommandBuffer, pipelineStage driver.VkPipelineStageFlags, queryPool driver.VkQueryPool, query driver.Uint32) {
+	call := d.begin("vkCmdWriteTimestamp")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("pipelineStage", uint64(pipelineStage))
+	call.handle("queryPool", driver.VulkanHandle(queryPool))
+	call.value("query", uint64(query))
+	d.inner.VkCmdWriteTimestamp(commandBuffer, pipelineStage, queryPool, query)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdCopyQueryPoolResults(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, stride driver.VkDeviceSize, flags driver.VkQueryResultFlags) {
+	call := d.begin("vkCmdCopyQueryPoolResults")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("queryPool", driver.VulkanHandle(queryPool))
+	call.value("firstQuery", uint64(firstQuery))
+	call.count("queryCount", uint64(queryCount))
+	call.handle("dstBuffer", driver.VulkanHandle(dstBuffer))
+	call.value("dstOffset", uint64(dstOffset))
+	call.value("stride", uint64(stride))
+	call.value("flags", uint64(flags))
+	d.inner.VkCmdCopyQueryPoolResults(commandBuffer, queryPool, firstQuery, queryCount, dstBuffer, dstOffset, stride, flags)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdPushConstants(commandBuffer driver.VkCommandBuffer, layout driver.VkPipelineLayout, stageFlags driver.VkShaderStageFlags, offset driver.Uint32, size driver.Uint32, pValues unsafe.Pointer) {
+	call := d.begin("vkCmdPushConstants")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("layout", driver.VulkanHandle(layout))
+	call.value("stageFlags", uint64(stageFlags))
+	call.value("offset", uint64(offset))
+	call.value("size", uint64(size))
+	d.inner.VkCmdPushConstants(commandBuffer, layout, stageFlags, offset, size, pValues)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdBeginRenderPass(commandBuffer driver.VkCommandBuffer, pRenderPassBegin *driver.VkRenderPassBeginInfo, contents driver.VkSubpassContents) {
+	call := d.begin("vkCmdBeginRenderPass")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("contents", uint64(contents))
+	d.inner.VkCmdBeginRenderPass(commandBuffer, pRenderPassBegin, contents)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdNextSubpass(commandBuffer driver.VkCommandBuffer, contents driver.VkSubpassContents) {
+	call := d.begin("vkCmdNextSubpass")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("contents", uint64(contents))
+	d.inner.VkCmdNextSubpass(commandBuffer, contents)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdEndRenderPass(commandBuffer driver.VkCommandBuffer) {
+	call := d.begin("vkCmdEndRenderPass")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdEndRenderPass(commandBuffer)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdExecuteCommands(commandBuffer driver.VkCommandBuffer, commandBufferCount driver.Uint32, pCommandBuffers *driver.VkCommandBuffer) {
+	call := d.begin("vkCmdExecuteCommands")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.count("commandBufferCount", uint64(commandBufferCount))
+	d.inner.VkCmdExecuteCommands(commandBuffer, commandBufferCount, pCommandBuffers)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkBindBufferMemory2(device driver.VkDevice, bindInfoCount driver.Uint32, pBindInfos *driver.VkBindBufferMemoryInfo) (common.VkResult, error) {
+	call := d.begin("vkBindBufferMemory2")
+	call.handle("device", driver.VulkanHandle(device))
+	call.count("bindInfoCount", uint64(bindInfoCount))
+	res, err := d.inner.VkBindBufferMemory2(device, bindInfoCount, pBindInfos)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkBindImageMemory2(device driver.VkDevice, bindInfoCount driver.Uint32, pBindInfos *driver.VkBindImageMemoryInfo) (common.VkResult, error) {
+	call := d.begin("vkBindImageMemory2")
+	call.handle("device", driver.VulkanHandle(device))
+	call.count("bindInfoCount", uint64(bindInfoCount))
+	res, err := d.inner.VkBindImageMemory2(device, bindInfoCount, pBindInfos)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetDeviceGroupPeerMemoryFeatures(device driver.VkDevice, heapIndex driver.Uint32, localDeviceIndex driver.Uint32, remoteDeviceIndex driver.Uint32, pPeerMemoryFeatures *driver.VkPeerMemoryFeatureFlags) {
+	call := d.begin("vkGetDeviceGroupPeerMemoryFeatures")
+	call.handle("device", driver.VulkanHandle(device))
+	call.value("heapIndex", uint64(heapIndex))
+	call.value("localDeviceIndex", uint64(localDeviceIndex))
+	call.value("remoteDeviceIndex", uint64(remoteDeviceIndex))
+	d.inner.VkGetDeviceGroupPeerMemoryFeatures(device, heapIndex, localDeviceIndex, remoteDeviceIndex, pPeerMemoryFeatures)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetDeviceMask(commandBuffer driver.VkCommandBuffer, deviceMask driver.Uint32) {
+	call := d.begin("vkCmdSetDeviceMask")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("deviceMask", uint64(deviceMask))
+	d.inner.VkCmdSetDeviceMask(commandBuffer, deviceMask)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdDispatchBase(commandBuffer driver.VkCommandBuffer, baseGroupX driver.Uint32, baseGroupY driver.Uint32, baseGroupZ driver.Uint32, groupCountX driver.Uint32, groupCountY driver.Uint32, groupCountZ driver.Uint32) {
+	call := d.begin("vkCmdDispatchBase")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("baseGroupX", uint64(baseGroupX))
+	call.value("baseGroupY", uint64(baseGroupY))
+	call.value("baseGroupZ", uint64(baseGroupZ))
+	call.value("groupCountX", uint64(groupCountX))
+	call.value("groupCountY", uint64(groupCountY))
+	call.value("groupCountZ", uint64(groupCountZ))
+	d.inner.VkCmdDispatchBase(commandBuffer, baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetImageMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkImageMemoryRequirementsInfo2, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	call := d.begin("vkGetImageMemoryRequirements2")
+	call.handle("device", driver.VulkanHandle(device))
+	d.inner.VkGetImageMemoryRequirements2(device, pInfo, pMemoryRequirements)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetBufferMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkBufferMemoryRequirementsInfo2, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	call := d.begin("vkGetBufferMemoryRequirements2")
+	call.handle("device", driver.VulkanHandle(device))
+	d.inner.VkGetBufferMemoryRequirements2(device, pInfo, pMemoryRequirements)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetImageSparseMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkImageSparseMemoryRequirementsInfo2, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements2) {
+	call := d.begin("vkGetImageSparseMemoryRequirements2")
+	call.handle("device", driver.VulkanHandle(device))
+	d.inner.VkGetImageSparseMemoryRequirements2(device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+	call.end()
+	if pSparseMemoryRequirementCount != nil {
+		call.count("pSparseMemoryRequirementCount", uint64(*pSparseMemoryRequirementCount))
+	}
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkTrimCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, flags driver.VkCommandPoolTrimFlags) {
+	call := d.begin("vkTrimCommandPool")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("commandPool", driver.VulkanHandle(commandPool))
+	call.value("flags", uint64(flags))
+	d.inner.VkTrimCommandPool(device, commandPool, flags)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetDeviceQueue2(device driver.VkDevice, pQueueInfo *driver.VkDeviceQueueInfo2, pQueue *driver.VkQueue) {
+	call := d.begin("vkGetDeviceQueue2")
+	call.handle("device", driver.VulkanHandle(device))
+	d.inner.VkGetDeviceQueue2(device, pQueueInfo, pQueue)
+	call.end()
+	if pQueue != nil {
+		call.handle("pQueue", driver.VulkanHandle(*pQueue))
+	}
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateSamplerYcbcrConversion(device driver.VkDevice, pCreateInfo *driver.VkSamplerYcbcrConversionCreateInfo, pAllocator *driver.VkAllocationCallbacks, pYcbcrConversion *driver.VkSamplerYcbcrConversion) (common.VkResult, error) {
+	call := d.begin("vkCreateSamplerYcbcrConversion")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateSamplerYcbcrConversion(device, pCreateInfo, pAllocator, pYcbcrConversion)
+	call.end()
+	if pYcbcrConversion != nil {
+		call.handle("pYcbcrConversion", driver.VulkanHandle(*pYcbcrConversion))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroySamplerYcbcrConversion(device driver.VkDevice, ycbcrConversion driver.VkSamplerYcbcrConversion, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroySamplerYcbcrConversion")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("ycbcrConversion", driver.VulkanHandle(ycbcrConversion))
+	d.inner.VkDestroySamplerYcbcrConversion(device, ycbcrConversion, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateDescriptorUpdateTemplate(device driver.VkDevice, pCreateInfo *driver.VkDescriptorUpdateTemplateCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDescriptorUpdateTemplate *driver.VkDescriptorUpdateTemplate) (common.VkResult, error) {
+	call := d.begin("vkCreateDescriptorUpdateTemplate")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateDescriptorUpdateTemplate(device, pCreateInfo, pAllocator, pDescriptorUpdateTemplate)
+	call.end()
+	if pDescriptorUpdateTemplate != nil {
+		call.handle("pDescriptorUpdateTemplate", driver.VulkanHandle(*pDescriptorUpdateTemplate))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyDescriptorUpdateTemplate(device driver.VkDevice, descriptorUpdateTemplate driver.VkDescriptorUpdateTemplate, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyDescriptorUpdateTemplate")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("descriptorUpdateTemplate", driver.VulkanHandle(descriptorUpdateTemplate))
+	d.inner.VkDestroyDescriptorUpdateTemplate(device, descriptorUpdateTemplate, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkUpdateDescriptorSetWithTemplate(device driver.VkDevice, descriptorSet driver.VkDescriptorSet, descriptorUpdateTemplate driver.VkDescriptorUpdateTemplate, pData unsafe.Pointer) {
+	call := d.begin("vkUpdateDescriptorSetWithTemplate")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("descriptorSet", driver.VulkanHandle(descriptorSet))
+	call.handle("descriptorUpdateTemplate", driver.VulkanHandle(descriptorUpdateTemplate))
+	d.inner.VkUpdateDescriptorSetWithTemplate(device, descriptorSet, descriptorUpdateTemplate, pData)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetDescriptorSetLayoutSupport(device driver.VkDevice, pCreateInfo *driver.VkDescriptorSetLayoutCreateInfo, pSupport *driver.VkDescriptorSetLayoutSupport) {
+	call := d.begin("vkGetDescriptorSetLayoutSupport")
+	call.handle("device", driver.VulkanHandle(device))
+	d.inner.VkGetDescriptorSetLayoutSupport(device, pCreateInfo, pSupport)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdDrawIndirectCount(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, countBuffer driver.VkBuffer, countBufferOffset driver.VkDeviceSize, maxDrawCount driver.Uint32, stride driver.Uint32) {
+	call := d.begin("vkCmdDrawIndirectCount")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("buffer", driver.VulkanHandle(buffer))
+	call.value("offset", uint64(offset))
+	call.handle("countBuffer", driver.VulkanHandle(countBuffer))
+	call.value("countBufferOffset", uint64(countBufferOffset))
+	call.count("maxDrawCount", uint64(maxDrawCount))
+	call.value("stride", uint64(stride))
+	d.inner.VkCmdDrawIndirectCount(commandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdDrawIndexedIndirectCount(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, countBuffer driver.VkBuffer, countBufferOffset driver.VkDeviceSize, maxDrawCount driver.Uint32, stride driver.Uint32) {
+	call := d.begin("vkCmdDrawIndexedIndirectCount")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("buffer", driver.VulkanHandle(buffer))
+	call.value("offset", uint64(offset))
+	call.handle("countBuffer", driver.VulkanHandle(countBuffer))
+	call.value("countBufferOffset", uint64(countBufferOffset))
+	call.count("maxDrawCount", uint64(maxDrawCount))
+	call.value("stride", uint64(stride))
+	d.inner.VkCmdDrawIndexedIndirectCount(commandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreateRenderPass2(device driver.VkDevice, pCreateInfo *driver.VkRenderPassCreateInfo2, pAllocator *driver.VkAllocationCallbacks, pRenderPass *driver.VkRenderPass) (common.VkResult, error) {
+	call := d.begin("vkCreateRenderPass2")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreateRenderPass2(device, pCreateInfo, pAllocator, pRenderPass)
+	call.end()
+	if pRenderPass != nil {
+		call.handle("pRenderPass", driver.VulkanHandle(*pRenderPass))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCmdBeginRenderPass2(commandBuffer driver.VkCommandBuffer, pRenderPassBegin *driver.VkRenderPassBeginInfo, pSubpassBeginInfo *driver.VkSubpassBeginInfo) {
+	call := d.begin("vkCmdBeginRenderPass2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdBeginRenderPass2(commandBuffer, pRenderPassBegin, pSubpassBeginInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdNextSubpass2(commandBuffer driver.VkCommandBuffer, pSubpassBeginInfo *driver.VkSubpassBeginInfo, pSubpassEndInfo *driver.VkSubpassEndInfo) {
+	call := d.begin("vkCmdNextSubpass2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdNextSubpass2(commandBuffer, pSubpassBeginInfo, pSubpassEndInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdEndRenderPass2(commandBuffer driver.VkCommandBuffer, pSubpassEndInfo *driver.VkSubpassEndInfo) {
+	call := d.begin("vkCmdEndRenderPass2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdEndRenderPass2(commandBuffer, pSubpassEndInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkResetQueryPool(device driver.VkDevice, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32) {
+	call := d.begin("vkResetQueryPool")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("queryPool", driver.VulkanHandle(queryPool))
+	call.value("firstQuery", uint64(firstQuery))
+	call.count("queryCount", uint64(queryCount))
+	d.inner.VkResetQueryPool(device, queryPool, firstQuery, queryCount)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetSemaphoreCounterValue(device driver.VkDevice, semaphore driver.VkSemaphore, pValue *driver.Uint64) (common.VkResult, error) {
+	call := d.begin("vkGetSemaphoreCounterValue")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("semaphore", driver.VulkanHandle(semaphore))
+	res, err := d.inner.VkGetSemaphoreCounterValue(device, semaphore, pValue)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkWaitSemaphores(device driver.VkDevice, pWaitInfo *driver.VkSemaphoreWaitInfo, timeout driver.Uint64) (common.VkResult, error) {
+	call := d.begin("vkWaitSemaphores")
+	call.handle("device", driver.VulkanHandle(device))
+	call.value("timeout", uint64(timeout))
+	res, err := d.inner.VkWaitSemaphores(device, pWaitInfo, timeout)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkSignalSemaphore(device driver.VkDevice, pSignalInfo *driver.VkSemaphoreSignalInfo) (common.VkResult, error) {
+	call := d.begin("vkSignalSemaphore")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkSignalSemaphore(device, pSignalInfo)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetBufferDeviceAddress(device driver.VkDevice, pInfo *driver.VkBufferDeviceAddressInfo) driver.VkDeviceAddress {
+	call := d.begin("vkGetBufferDeviceAddress")
+	call.handle("device", driver.VulkanHandle(device))
+	ret := d.inner.VkGetBufferDeviceAddress(device, pInfo)
+	call.end()
+	call.value("return", uint64(ret))
+	d.finish(call, 0)
+	return ret
+}
+
+func (d *Driver) VkGetBufferOpaqueCaptureAddress(device driver.VkDevice, pInfo *driver.VkBufferDeviceAddressInfo) driver.Uint64 {
+	call := d.begin("vkGetBufferOpaqueCaptureAddress")
+	call.handle("device", driver.VulkanHandle(device))
+	ret := d.inner.VkGetBufferOpaqueCaptureAddress(device, pInfo)
+	call.end()
+	call.value("return", uint64(ret))
+	d.finish(call, 0)
+	return ret
+}
+
+func (d *Driver) VkGetDeviceMemoryOpaqueCaptureAddress(device driver.VkDevice, pInfo *driver.VkDeviceMemoryOpaqueCaptureAddressInfo) driver.Uint64 {
+	call := d.begin("vkGetDeviceMemoryOpaqueCaptureAddress")
+	call.handle("device", driver.VulkanHandle(device))
+	ret := d.inner.VkGetDeviceMemoryOpaqueCaptureAddress(device, pInfo)
+	call.end()
+	call.value("return", uint64(ret))
+	d.finish(call, 0)
+	return ret
+}
+
+func (d *Driver) VkCmdBeginRendering(commandBuffer driver.VkCommandBuffer, pRenderingInfo *driver.VkRenderingInfo) {
+	call := d.begin("vkCmdBeginRendering")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdBeginRendering(commandBuffer, pRenderingInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdEndRendering(commandBuffer driver.VkCommandBuffer) {
+	call := d.begin("vkCmdEndRendering")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdEndRendering(commandBuffer)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetEvent2(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, pDependencyInfo *driver.VkDependencyInfo) {
+	call := d.begin("vkCmdSetEvent2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("event", driver.VulkanHandle(event))
+	d.inner.VkCmdSetEvent2(commandBuffer, event, pDependencyInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdResetEvent2(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags2) {
+	call := d.begin("vkCmdResetEvent2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.handle("event", driver.VulkanHandle(event))
+	call.value("stageMask", uint64(stageMask))
+	d.inner.VkCmdResetEvent2(commandBuffer, event, stageMask)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdWaitEvents2(commandBuffer driver.VkCommandBuffer, eventCount driver.Uint32, pEvents *driver.VkEvent, pDependencyInfos *driver.VkDependencyInfo) {
+	call := d.begin("vkCmdWaitEvents2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.count("eventCount", uint64(eventCount))
+	d.inner.VkCmdWaitEvents2(commandBuffer, eventCount, pEvents, pDependencyInfos)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdPipelineBarrier2(commandBuffer driver.VkCommandBuffer, pDependencyInfo *driver.VkDependencyInfo) {
+	call := d.begin("vkCmdPipelineBarrier2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdPipelineBarrier2(commandBuffer, pDependencyInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdWriteTimestamp2(commandBuffer driver.VkCommandBuffer, stage driver.VkPipelineStageFlags2, queryPool driver.VkQueryPool, query driver.Uint32) {
+	call := d.begin("vkCmdWriteTimestamp2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("stage", uint64(stage))
+	call.handle("queryPool", driver.VulkanHandle(queryPool))
+	call.value("query", uint64(query))
+	d.inner.VkCmdWriteTimestamp2(commandBuffer, stage, queryPool, query)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkQueueSubmit2(queue driver.VkQueue, submitCount driver.Uint32, pSubmits *driver.VkSubmitInfo2, fence driver.VkFence) (common.VkResult, error) {
+	call := d.begin("vkQueueSubmit2")
+	call.handle("queue", driver.VulkanHandle(queue))
+	call.count("submitCount", uint64(submitCount))
+	call.handle("fence", driver.VulkanHandle(fence))
+	res, err := d.inner.VkQueueSubmit2(queue, submitCount, pSubmits, fence)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkCmdSetCullMode(commandBuffer driver.VkCommandBuffer, cullMode driver.VkCullModeFlags) {
+	call := d.begin("vkCmdSetCullMode")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("cullMode", uint64(cullMode))
+	d.inner.VkCmdSetCullMode(commandBuffer, cullMode)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetFrontFace(commandBuffer driver.VkCommandBuffer, frontFace driver.VkFrontFace) {
+	call := d.begin("vkCmdSetFrontFace")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("frontFace", uint64(frontFace))
+	d.inner.VkCmdSetFrontFace(commandBuffer, frontFace)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetPrimitiveTopology(commandBuffer driver.VkCommandBuffer, primitiveTopology driver.VkPrimitiveTopology) {
+	call := d.begin("vkCmdSetPrimitiveTopology")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("primitiveTopology", uint64(primitiveTopology))
+	d.inner.VkCmdSetPrimitiveTopology(commandBuffer, primitiveTopology)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetViewportWithCount(commandBuffer driver.VkCommandBuffer, viewportCount driver.Uint32, pViewports *driver.VkViewport) {
+	call := d.begin("vkCmdSetViewportWithCount")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.count("viewportCount", uint64(viewportCount))
+	d.inner.VkCmdSetViewportWithCount(commandBuffer, viewportCount, pViewports)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetScissorWithCount(commandBuffer driver.VkCommandBuffer, scissorCount driver.Uint32, pScissors *driver.VkRect2D) {
+	call := d.begin("vkCmdSetScissorWithCount")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.count("scissorCount", uint64(scissorCount))
+	d.inner.VkCmdSetScissorWithCount(commandBuffer, scissorCount, pScissors)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdBindVertexBuffers2(commandBuffer driver.VkCommandBuffer, firstBinding driver.Uint32, bindingCount driver.Uint32, pBuffers *driver.VkBuffer, pOffsets *driver.VkDeviceSize, pSizes *driver.VkDeviceSize, pStrides *driver.VkDeviceSize) {
+	call := d.begin("vkCmdBindVertexBuffers2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("firstBinding", uint64(firstBinding))
+	call.count("bindingCount", uint64(bindingCount))
+	d.inner.VkCmdBindVertexBuffers2(commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets, pSizes, pStrides)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthTestEnable(commandBuffer driver.VkCommandBuffer, depthTestEnable driver.VkBool32) {
+	call := d.begin("vkCmdSetDepthTestEnable")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("depthTestEnable", uint64(depthTestEnable))
+	d.inner.VkCmdSetDepthTestEnable(commandBuffer, depthTestEnable)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthWriteEnable(commandBuffer driver.VkCommandBuffer, depthWriteEnable driver.VkBool32) {
+	call := d.begin("vkCmdSetDepthWriteEnable")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("depthWriteEnable", uint64(depthWriteEnable))
+	d.inner.VkCmdSetDepthWriteEnable(commandBuffer, depthWriteEnable)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthCompareOp(commandBuffer driver.VkCommandBuffer, depthCompareOp driver.VkCompareOp) {
+	call := d.begin("vkCmdSetDepthCompareOp")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("depthCompareOp", uint64(depthCompareOp))
+	d.inner.VkCmdSetDepthCompareOp(commandBuffer, depthCompareOp)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthBoundsTestEnable(commandBuffer driver.VkCommandBuffer, depthBoundsTestEnable driver.VkBool32) {
+	call := d.begin("vkCmdSetDepthBoundsTestEnable")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("depthBoundsTestEnable", uint64(depthBoundsTestEnable))
+	d.inner.VkCmdSetDepthBoundsTestEnable(commandBuffer, depthBoundsTestEnable)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetStencilTestEnable(commandBuffer driver.VkCommandBuffer, stencilTestEnable driver.VkBool32) {
+	call := d.begin("vkCmdSetStencilTestEnable")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("stencilTestEnable", uint64(stencilTestEnable))
+	d.inner.VkCmdSetStencilTestEnable(commandBuffer, stencilTestEnable)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetStencilOp(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, failOp driver.VkStencilOp, passOp driver.VkStencilOp, depthFailOp driver.VkStencilOp, compareOp driver.VkCompareOp) {
+	call := d.begin("vkCmdSetStencilOp")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("faceMask", uint64(faceMask))
+	call.value("failOp", uint64(failOp))
+	call.value("passOp", uint64(passOp))
+	call.value("depthFailOp", uint64(depthFailOp))
+	call.value("compareOp", uint64(compareOp))
+	d.inner.VkCmdSetStencilOp(commandBuffer, faceMask, failOp, passOp, depthFailOp, compareOp)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetRasterizerDiscardEnable(commandBuffer driver.VkCommandBuffer, rasterizerDiscardEnable driver.VkBool32) {
+	call := d.begin("vkCmdSetRasterizerDiscardEnable")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("rasterizerDiscardEnable", uint64(rasterizerDiscardEnable))
+	d.inner.VkCmdSetRasterizerDiscardEnable(commandBuffer, rasterizerDiscardEnable)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetDepthBiasEnable(commandBuffer driver.VkCommandBuffer, depthBiasEnable driver.VkBool32) {
+	call := d.begin("vkCmdSetDepthBiasEnable")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("depthBiasEnable", uint64(depthBiasEnable))
+	d.inner.VkCmdSetDepthBiasEnable(commandBuffer, depthBiasEnable)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdSetPrimitiveRestartEnable(commandBuffer driver.VkCommandBuffer, primitiveRestartEnable driver.VkBool32) {
+	call := d.begin("vkCmdSetPrimitiveRestartEnable")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	call.value("primitiveRestartEnable", uint64(primitiveRestartEnable))
+	d.inner.VkCmdSetPrimitiveRestartEnable(commandBuffer, primitiveRestartEnable)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdCopyBuffer2(commandBuffer driver.VkCommandBuffer, pCopyBufferInfo *driver.VkCopyBufferInfo2) {
+	call := d.begin("vkCmdCopyBuffer2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdCopyBuffer2(commandBuffer, pCopyBufferInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdCopyImage2(commandBuffer driver.VkCommandBuffer, pCopyImageInfo *driver.VkCopyImageInfo2) {
+	call := d.begin("vkCmdCopyImage2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdCopyImage2(commandBuffer, pCopyImageInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdCopyBufferToImage2(commandBuffer driver.VkCommandBuffer, pCopyBufferToImageInfo *driver.VkCopyBufferToImageInfo2) {
+	call := d.begin("vkCmdCopyBufferToImage2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdCopyBufferToImage2(commandBuffer, pCopyBufferToImageInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdCopyImageToBuffer2(commandBuffer driver.VkCommandBuffer, pCopyImageToBufferInfo *driver.VkCopyImageToBufferInfo2) {
+	call := d.begin("vkCmdCopyImageToBuffer2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdCopyImageToBuffer2(commandBuffer, pCopyImageToBufferInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdBlitImage2(commandBuffer driver.VkCommandBuffer, pBlitImageInfo *driver.VkBlitImageInfo2) {
+	call := d.begin("vkCmdBlitImage2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdBlitImage2(commandBuffer, pBlitImageInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCmdResolveImage2(commandBuffer driver.VkCommandBuffer, pResolveImageInfo *driver.VkResolveImageInfo2) {
+	call := d.begin("vkCmdResolveImage2")
+	call.handle("commandBuffer", driver.VulkanHandle(commandBuffer))
+	d.inner.VkCmdResolveImage2(commandBuffer, pResolveImageInfo)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetDeviceBufferMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceBufferMemoryRequirements, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	call := d.begin("vkGetDeviceBufferMemoryRequirements")
+	call.handle("device", driver.VulkanHandle(device))
+	d.inner.VkGetDeviceBufferMemoryRequirements(device, pInfo, pMemoryRequirements)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetDeviceImageMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceImageMemoryRequirements, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	call := d.begin("vkGetDeviceImageMemoryRequirements")
+	call.handle("device", driver.VulkanHandle(device))
+	d.inner.VkGetDeviceImageMemoryRequirements(device, pInfo, pMemoryRequirements)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetDeviceImageSparseMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceImageMemoryRequirements, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements2) {
+	call := d.begin("vkGetDeviceImageSparseMemoryRequirements")
+	call.handle("device", driver.VulkanHandle(device))
+	d.inner.VkGetDeviceImageSparseMemoryRequirements(device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+	call.end()
+	if pSparseMemoryRequirementCount != nil {
+		call.count("pSparseMemoryRequirementCount", uint64(*pSparseMemoryRequirementCount))
+	}
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkCreatePrivateDataSlot(device driver.VkDevice, pCreateInfo *driver.VkPrivateDataSlotCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPrivateDataSlot *driver.VkPrivateDataSlot) (common.VkResult, error) {
+	call := d.begin("vkCreatePrivateDataSlot")
+	call.handle("device", driver.VulkanHandle(device))
+	res, err := d.inner.VkCreatePrivateDataSlot(device, pCreateInfo, pAllocator, pPrivateDataSlot)
+	call.end()
+	if pPrivateDataSlot != nil {
+		call.handle("pPrivateDataSlot", driver.VulkanHandle(*pPrivateDataSlot))
+	}
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkDestroyPrivateDataSlot(device driver.VkDevice, privateDataSlot driver.VkPrivateDataSlot, pAllocator *driver.VkAllocationCallbacks) {
+	call := d.begin("vkDestroyPrivateDataSlot")
+	call.handle("device", driver.VulkanHandle(device))
+	call.handle("privateDataSlot", driver.VulkanHandle(privateDataSlot))
+	d.inner.VkDestroyPrivateDataSlot(device, privateDataSlot, pAllocator)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkSetPrivateData(device driver.VkDevice, objectType driver.VkObjectType, objectHandle driver.Uint64, privateDataSlot driver.VkPrivateDataSlot, data driver.Uint64) (common.VkResult, error) {
+	call := d.begin("vkSetPrivateData")
+	call.handle("device", driver.VulkanHandle(device))
+	call.value("objectType", uint64(objectType))
+	call.value("objectHandle", uint64(objectHandle))
+	call.handle("privateDataSlot", driver.VulkanHandle(privateDataSlot))
+	call.value("data", uint64(data))
+	res, err := d.inner.VkSetPrivateData(device, objectType, objectHandle, privateDataSlot, data)
+	call.end()
+	d.finish(call, res)
+	return res, err
+}
+
+func (d *Driver) VkGetPrivateData(device driver.VkDevice, objectType driver.VkObjectType, objectHandle driver.Uint64, privateDataSlot driver.VkPrivateDataSlot, pData *driver.Uint64) {
+	call := d.begin("vkGetPrivateData")
+	call.handle("device", driver.VulkanHandle(device))
+	call.value("objectType", uint64(objectType))
+	call.value("objectHandle", uint64(objectHandle))
+	call.handle("privateDataSlot", driver.VulkanHandle(privateDataSlot))
+	d.inner.VkGetPrivateData(device, objectType, objectHandle, privateDataSlot, pData)
+	call.end()
+	d.finish(call, 0)
+}
+
+func (d *Driver) VkGetPhysicalDeviceToolProperties(physicalDevice driver.VkPhysicalDevice, pToolCount *driver.Uint32, pToolProperties *driver.VkPhysicalDeviceToolProperties) (common.VkResult, error) {
+	call := d.begin("vkGetPhysicalDeviceToolProperties")
+	call.handle("physicalDevice", driver.VulkanHandle(physicalDevice))
+	res, err := d.inner.VkGetPhysicalDeviceToolProperties(physicalDevice, pToolCount, pToolProperties)
+	call.end()
+	if pToolCount != nil {
+		call.count("pToolCount", uint64(*pToolCount))
+	}
+	d.finish(call, res)
+	return res, err
+}
@@ -0,0 +1,106 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ChromeTraceSink is a Sink that writes each Event as a complete ("X") event in the Chrome trace
+// event format, which can be loaded into chrome://tracing or Perfetto. Each goroutine is shown as
+// its own thread. Close must be called once tracing is finished to terminate the JSON array.
+type ChromeTraceSink struct {
+	lock   sync.Mutex
+	writer io.Writer
+	start  time.Time
+	first  bool
+	err    error
+}
+
+var _ Sink = &ChromeTraceSink{}
+
+type chromeEvent struct {
+	Name      string         `json:"name"`
+	Category  string         `json:"cat"`
+	Phase     string         `json:"ph"`
+	Timestamp float64        `json:"ts"`
+	Duration  float64        `json:"dur"`
+	Process   int            `json:"pid"`
+	Thread    uint64         `json:"tid"`
+	Args      map[string]any `json:"args,omitempty"`
+}
+
+// NewChromeTraceSink creates a ChromeTraceSink that writes to writer. Timestamps are measured from
+// the moment this method is called.
+func NewChromeTraceSink(writer io.Writer) *ChromeTraceSink {
+	return &ChromeTraceSink{
+		writer: writer,
+		start:  time.Now(),
+		first:  true,
+	}
+}
+
+func (s *ChromeTraceSink) Record(event *Event) {
+	chrome := chromeEvent{
+		Name:      event.Function,
+		Category:  "vulkan",
+		Phase:     "X",
+		Timestamp: float64(event.Start.Sub(s.start).Nanoseconds()) / 1000,
+		Duration:  float64(event.Duration.Nanoseconds()) / 1000,
+		Process:   1,
+		Thread:    event.Goroutine,
+	}
+
+	if len(event.Args) > 0 || event.Result != 0 {
+		chrome.Args = make(map[string]any, len(event.Args)+1)
+		for _, arg := range event.Args {
+			chrome.Args[arg.Name] = formatArg(arg)
+		}
+		if event.Result != 0 {
+			chrome.Args["result"] = event.Result.String()
+		}
+	}
+
+	data, err := json.Marshal(chrome)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.err != nil {
+		return
+	}
+	if err != nil {
+		s.err = err
+		return
+	}
+
+	separator := ",\n"
+	if s.first {
+		separator = "[\n"
+		s.first = false
+	}
+
+	_, s.err = fmt.Fprintf(s.writer, "%s%s", separator, data)
+}
+
+// Close terminates the JSON array and returns the first error encountered while writing, if any.
+// It does not close the underlying io.Writer.
+func (s *ChromeTraceSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	terminator := "\n]\n"
+	if s.first {
+		terminator = "[]\n"
+		s.first = false
+	}
+
+	_, s.err = io.WriteString(s.writer, terminator)
+	return s.err
+}
@@ -0,0 +1,162 @@
+package trace
+
+import (
+	"fmt"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/driver"
+	"time"
+	"unsafe"
+)
+
+// ArgKind specifies how an Arg captured from a Vulkan command should be interpreted
+type ArgKind int
+
+const (
+	// ArgKindHandle indicates an Arg whose value is a Vulkan handle
+	ArgKindHandle ArgKind = iota
+	// ArgKindCount indicates an Arg whose value is the number of elements in an array
+	// parameter. Counts that are returned through a pointer are captured after the command returns.
+	ArgKindCount
+	// ArgKindValue indicates an Arg whose value is any other scalar, such as an enum, a set of
+	// flags, a size, or an offset
+	ArgKindValue
+)
+
+// Arg is a single scalar argument captured from a Vulkan command. Pointers to structures and
+// floating-point arguments are not captured.
+type Arg struct {
+	// Name is the name of the parameter in the Vulkan spec. Each element of an array parameter is
+	// captured as its own Arg, with its index appended to the name, i.e. "pPhysicalDevices[1]".
+	Name string
+	// Kind specifies how Value should be interpreted
+	Kind ArgKind
+	// Value is the value of the argument
+	Value uint64
+}
+
+// Event describes a single call to a Vulkan command made through a trace Driver
+type Event struct {
+	// Function is the name of the Vulkan command that was called, i.e. "vkCreateBuffer"
+	Function string
+	// Args is the list of captured arguments, in parameter order. Handles created by the command,
+	// such as the buffer written to pBuffer by vkCreateBuffer, are captured after the command
+	// returns and appear at the end.
+	Args []Arg
+	// Result is the VkResult returned by the command, or 0 if the command does not return one
+	Result common.VkResult
+	// Start is the time at which the command was called
+	Start time.Time
+	// Duration is the time spent inside the wrapped Driver
+	Duration time.Duration
+	// Goroutine is the id of the goroutine the command was called from
+	Goroutine uint64
+}
+
+// Sink receives an Event for each Vulkan command called through a trace Driver. Record may be
+// called from multiple goroutines at once, and must not retain the Event after it returns.
+type Sink interface {
+	Record(event *Event)
+}
+
+// SinkFunc is a Sink implemented by a single function
+type SinkFunc func(event *Event)
+
+func (f SinkFunc) Record(event *Event) {
+	f(event)
+}
+
+// Driver is a driver.Driver that forwards every call to another driver.Driver, reporting each Vulkan
+// command called to a Sink. Instance and Device drivers created from a trace Driver are traced
+// as well, and report to the same Sink.
+type Driver struct {
+	inner driver.Driver
+	sink  Sink
+}
+
+var _ driver.Driver = &Driver{}
+
+// NewDriver creates a Driver that traces calls to inner, reporting them to sink
+func NewDriver(inner driver.Driver, sink Sink) *Driver {
+	return &Driver{
+		inner: inner,
+		sink:  sink,
+	}
+}
+
+// Inner retrieves the driver.Driver this Driver forwards calls to
+func (d *Driver) Inner() driver.Driver {
+	return d.inner
+}
+
+func (d *Driver) Destroy() {
+	d.inner.Destroy()
+}
+
+func (d *Driver) CreateInstanceDriver(instance driver.VkInstance) (driver.Driver, error) {
+	instanceDriver, err := d.inner.CreateInstanceDriver(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDriver(instanceDriver, d.sink), nil
+}
+
+func (d *Driver) CreateDeviceDriver(device driver.VkDevice) (driver.Driver, error) {
+	deviceDriver, err := d.inner.CreateDeviceDriver(device)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDriver(deviceDriver, d.sink), nil
+}
+
+func (d *Driver) LoadProcAddr(name *driver.Char) unsafe.Pointer {
+	return d.inner.LoadProcAddr(name)
+}
+
+func (d *Driver) Version() common.APIVersion {
+	return d.inner.Version()
+}
+
+func (d *Driver) HasCommand(name string) bool {
+	return d.inner.HasCommand(name)
+}
+
+func (d *Driver) ObjectStore() *driver.VulkanObjectStore {
+	return d.inner.ObjectStore()
+}
+
+func (d *Driver) begin(function string) *Event {
+	return &Event{
+		Function:  function,
+		Start:     time.Now(),
+		Goroutine: goroutineID(),
+	}
+}
+
+func (d *Driver) finish(call *Event, res common.VkResult) {
+	call.Result = res
+	d.sink.Record(call)
+}
+
+func (e *Event) end() {
+	e.Duration = time.Since(e.Start)
+}
+
+func (e *Event) handle(name string, handle driver.VulkanHandle) {
+	e.Args = append(e.Args, Arg{Name: name, Kind: ArgKindHandle, Value: uint64(handle)})
+}
+
+func addHandles[T ~uintptr](e *Event, name string, handles *T, count int) {
+	for index, handle := range unsafe.Slice(handles, count) {
+		e.handle(fmt.Sprintf("%s[%d]", name, index), driver.VulkanHandle(handle))
+	}
+}
+
+func (e *Event) count(name string, count uint64) {
+	e.Args = append(e.Args, Arg{Name: name, Kind: ArgKindCount, Value: count})
+}
+
+func (e *Event) value(name string, value uint64) {
+	e.Args = append(e.Args, Arg{Name: name, Kind: ArgKindValue, Value: value})
+}
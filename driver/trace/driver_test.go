@@ -0,0 +1,193 @@
+package trace_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"github.com/vkngwrapper/core/v2/driver/trace"
+	"sync"
+	"testing"
+)
+
+type recordingSink struct {
+	lock   sync.Mutex
+	events []trace.Event
+}
+
+func (s *recordingSink) Record(event *trace.Event) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.events = append(s.events, *event)
+}
+
+func (s *recordingSink) find(function string) *trace.Event {
+	for i := range s.events {
+		if s.events[i].Function == function {
+			return &s.events[i]
+		}
+	}
+
+	return nil
+}
+
+func runFlow(t *testing.T, sink trace.Sink) core1_0.Buffer {
+	loader, err := core.CreateLoaderFromDriver(trace.NewDriver(fake.NewDriver(fake.Config{}), sink))
+	require.NoError(t, err)
+
+	instance, _, err := loader.CreateInstance(nil, core1_0.InstanceCreateInfo{
+		APIVersion: common.Vulkan1_2,
+	})
+	require.NoError(t, err)
+
+	physicalDevices, _, err := instance.EnumeratePhysicalDevices()
+	require.NoError(t, err)
+
+	device, _, err := physicalDevices[0].CreateDevice(nil, core1_0.DeviceCreateInfo{
+		QueueCreateInfos: []core1_0.DeviceQueueCreateInfo{
+			{
+				QueueFamilyIndex: 0,
+				QueuePriorities:  []float32{1},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:  256,
+		Usage: core1_0.BufferUsageTransferSrc,
+	})
+	require.NoError(t, err)
+
+	buffer.Destroy(nil)
+	device.Destroy(nil)
+	instance.Destroy(nil)
+
+	return buffer
+}
+
+func TestDriver_Events(t *testing.T) {
+	sink := &recordingSink{}
+	buffer := runFlow(t, sink)
+
+	enumerate := sink.find("vkEnumeratePhysicalDevices")
+	require.NotNil(t, enumerate)
+	require.Equal(t, core1_0.VKSuccess, enumerate.Result)
+	require.Equal(t, trace.Arg{Name: "pPhysicalDeviceCount", Kind: trace.ArgKindCount, Value: 1}, enumerate.Args[1])
+
+	// Device-level calls go through the driver returned from CreateDeviceDriver
+	create := sink.find("vkCreateBuffer")
+	require.NotNil(t, create)
+	require.Equal(t, trace.ArgKindHandle, create.Args[0].Kind)
+	require.Equal(t, "device", create.Args[0].Name)
+	require.Equal(t, trace.Arg{Name: "pBuffer", Kind: trace.ArgKindHandle, Value: uint64(buffer.Handle())}, create.Args[len(create.Args)-1])
+	require.NotZero(t, create.Goroutine)
+	require.False(t, create.Start.IsZero())
+
+	destroy := sink.find("vkDestroyBuffer")
+	require.NotNil(t, destroy)
+	require.Equal(t, common.VkResult(0), destroy.Result)
+	require.Equal(t, uint64(buffer.Handle()), destroy.Args[1].Value)
+
+	require.NotNil(t, sink.find("vkDestroyInstance"))
+}
+
+func TestChromeTraceSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := trace.NewChromeTraceSink(&buf)
+	runFlow(t, sink)
+	require.NoError(t, sink.Close())
+
+	var events []map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &events))
+	require.NotEmpty(t, events)
+
+	var found bool
+	for _, event := range events {
+		require.Equal(t, "X", event["ph"])
+		require.Equal(t, "vulkan", event["cat"])
+		if event["name"] == "vkCreateBuffer" {
+			found = true
+			args := event["args"].(map[string]any)
+			require.Contains(t, args, "device")
+			require.Contains(t, args, "pBuffer")
+		}
+	}
+	require.True(t, found)
+}
+
+func TestChromeTraceSink_HandleArrays(t *testing.T) {
+	var buf bytes.Buffer
+	sink := trace.NewChromeTraceSink(&buf)
+
+	fakeDriver := fake.NewDriver(fake.Config{
+		PhysicalDevices: []fake.PhysicalDevice{fake.DefaultPhysicalDevice(), fake.DefaultPhysicalDevice()},
+	})
+	loader, err := core.CreateLoaderFromDriver(trace.NewDriver(fakeDriver, sink))
+	require.NoError(t, err)
+
+	instance, _, err := loader.CreateInstance(nil, core1_0.InstanceCreateInfo{
+		APIVersion: common.Vulkan1_2,
+	})
+	require.NoError(t, err)
+
+	physicalDevices, _, err := instance.EnumeratePhysicalDevices()
+	require.NoError(t, err)
+	require.Len(t, physicalDevices, 2)
+
+	instance.Destroy(nil)
+	require.NoError(t, sink.Close())
+
+	var events []map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &events))
+
+	// Each handle in the array is kept under its own key
+	var handles []any
+	for _, event := range events {
+		args, _ := event["args"].(map[string]any)
+		if event["name"] == "vkEnumeratePhysicalDevices" && args["pPhysicalDevices[0]"] != nil {
+			handles = append(handles, args["pPhysicalDevices[0]"], args["pPhysicalDevices[1]"])
+		}
+	}
+	require.Equal(t, []any{
+		fmt.Sprintf("0x%x", physicalDevices[0].Handle()),
+		fmt.Sprintf("0x%x", physicalDevices[1].Handle()),
+	}, handles)
+}
+
+func TestChromeTraceSink_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	sink := trace.NewChromeTraceSink(&buf)
+	require.NoError(t, sink.Close())
+
+	var events []map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &events))
+	require.Empty(t, events)
+}
+
+type recordingLogger struct {
+	lines []string
+}
+
+func (l *recordingLogger) Info(msg string, args ...any) {
+	l.lines = append(l.lines, fmt.Sprint(append([]any{msg}, args...)...))
+}
+
+func TestLoggerSink(t *testing.T) {
+	logger := &recordingLogger{}
+	buffer := runFlow(t, trace.NewLoggerSink(logger))
+
+	var found bool
+	for _, line := range logger.lines {
+		if bytes.HasPrefix([]byte(line), []byte("vkDestroyBuffer")) {
+			found = true
+			require.Contains(t, line, fmt.Sprintf("0x%x", uint64(buffer.Handle())))
+		}
+	}
+	require.True(t, found)
+}
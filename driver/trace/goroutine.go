@@ -0,0 +1,29 @@
+package trace
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+)
+
+var goroutinePrefix = []byte("goroutine ")
+
+// goroutineID parses the id of the calling goroutine out of its stack trace header, which
+// always begins "goroutine N [". It returns 0 if the header cannot be parsed.
+func goroutineID() uint64 {
+	var buf [64]byte
+	header := buf[:runtime.Stack(buf[:], false)]
+	header = bytes.TrimPrefix(header, goroutinePrefix)
+
+	end := bytes.IndexByte(header, ' ')
+	if end < 0 {
+		return 0
+	}
+
+	id, err := strconv.ParseUint(string(header[:end]), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
@@ -0,0 +1,46 @@
+package trace
+
+import (
+	"fmt"
+)
+
+// Logger is a structured logger that accepts alternating key/value pairs, such as *slog.Logger
+type Logger interface {
+	Info(msg string, args ...any)
+}
+
+// LoggerSink is a Sink that writes each Event to a Logger as a single message. The message is the
+// name of the Vulkan command, and each captured argument is logged under its parameter name,
+// with handles formatted in hexadecimal.
+type LoggerSink struct {
+	logger Logger
+}
+
+var _ Sink = &LoggerSink{}
+
+// NewLoggerSink creates a LoggerSink that writes to logger
+func NewLoggerSink(logger Logger) *LoggerSink {
+	return &LoggerSink{logger: logger}
+}
+
+func (s *LoggerSink) Record(event *Event) {
+	args := make([]any, 0, 2*len(event.Args)+6)
+	for _, arg := range event.Args {
+		args = append(args, arg.Name, formatArg(arg))
+	}
+
+	if event.Result != 0 {
+		args = append(args, "result", event.Result.String())
+	}
+	args = append(args, "duration", event.Duration, "goroutine", event.Goroutine)
+
+	s.logger.Info(event.Function, args...)
+}
+
+func formatArg(arg Arg) any {
+	if arg.Kind == ArgKindHandle {
+		return fmt.Sprintf("0x%x", arg.Value)
+	}
+
+	return arg.Value
+}
@@ -0,0 +1,1908 @@
+package validation
+
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+func (d *Driver) VkEnumerateInstanceVersion(pApiVersion *driver.Uint32) (common.VkResult, error) {
+	return d.inner.VkEnumerateInstanceVersion(pApiVersion)
+}
+
+func (d *Driver) VkEnumerateInstanceExtensionProperties(pLayerName *driver.Char, pPropertyCount *driver.Uint32, pProperties *driver.VkExtensionProperties) (common.VkResult, error) {
+	return d.inner.VkEnumerateInstanceExtensionProperties(pLayerName, pPropertyCount, pProperties)
+}
+
+func (d *Driver) VkEnumerateInstanceLayerProperties(pPropertyCount *driver.Uint32, pProperties *driver.VkLayerProperties) (common.VkResult, error) {
+	return d.inner.VkEnumerateInstanceLayerProperties(pPropertyCount, pProperties)
+}
+
+func (d *Driver) VkCreateInstance(pCreateInfo *driver.VkInstanceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pInstance *driver.VkInstance) (common.VkResult, error) {
+	return d.inner.VkCreateInstance(pCreateInfo, pAllocator, pInstance)
+}
+
+func (d *Driver) VkEnumeratePhysicalDevices(instance driver.VkInstance, pPhysicalDeviceCount *driver.Uint32, pPhysicalDevices *driver.VkPhysicalDevice) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(instance)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkEnumeratePhysicalDevices(instance, pPhysicalDeviceCount, pPhysicalDevices)
+}
+
+func (d *Driver) VkDestroyInstance(instance driver.VkInstance, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(instance)})
+
+	release := d.mustAcquire("vkDestroyInstance", []driver.VulkanHandle{driver.VulkanHandle(instance)})
+	defer release()
+
+	d.inner.VkDestroyInstance(instance, pAllocator)
+}
+
+func (d *Driver) VkGetPhysicalDeviceFeatures(physicalDevice driver.VkPhysicalDevice, pFeatures *driver.VkPhysicalDeviceFeatures) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceFeatures(physicalDevice, pFeatures)
+}
+
+func (d *Driver) VkGetPhysicalDeviceFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, pFormatProperties *driver.VkFormatProperties) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceFormatProperties(physicalDevice, format, pFormatProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceImageFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, t driver.VkImageType, tiling driver.VkImageTiling, usage driver.VkImageUsageFlags, flags driver.VkImageCreateFlags, pImageFormatProperties *driver.VkImageFormatProperties) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkGetPhysicalDeviceImageFormatProperties(physicalDevice, format, t, tiling, usage, flags, pImageFormatProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceProperties(physicalDevice driver.VkPhysicalDevice, pProperties *driver.VkPhysicalDeviceProperties) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceProperties(physicalDevice, pProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceQueueFamilyProperties(physicalDevice driver.VkPhysicalDevice, pQueueFamilyPropertyCount *driver.Uint32, pQueueFamilyProperties *driver.VkQueueFamilyProperties) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceQueueFamilyProperties(physicalDevice, pQueueFamilyPropertyCount, pQueueFamilyProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceMemoryProperties(physicalDevice driver.VkPhysicalDevice, pMemoryProperties *driver.VkPhysicalDeviceMemoryProperties) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceMemoryProperties(physicalDevice, pMemoryProperties)
+}
+
+func (d *Driver) VkEnumerateDeviceExtensionProperties(physicalDevice driver.VkPhysicalDevice, pLayerName *driver.Char, pPropertyCount *driver.Uint32, pProperties *driver.VkExtensionProperties) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkEnumerateDeviceExtensionProperties(physicalDevice, pLayerName, pPropertyCount, pProperties)
+}
+
+func (d *Driver) VkEnumerateDeviceLayerProperties(physicalDevice driver.VkPhysicalDevice, pPropertyCount *driver.Uint32, pProperties *driver.VkLayerProperties) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkEnumerateDeviceLayerProperties(physicalDevice, pPropertyCount, pProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceSparseImageFormatProperties(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, t driver.VkImageType, samples driver.VkSampleCountFlagBits, usage driver.VkImageUsageFlags, tiling driver.VkImageTiling, pPropertyCount *driver.Uint32, pProperties *driver.VkSparseImageFormatProperties) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceSparseImageFormatProperties(physicalDevice, format, t, samples, usage, tiling, pPropertyCount, pProperties)
+}
+
+func (d *Driver) VkCreateDevice(physicalDevice driver.VkPhysicalDevice, pCreateInfo *driver.VkDeviceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDevice *driver.VkDevice) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateDevice(physicalDevice, pCreateInfo, pAllocator, pDevice)
+}
+
+func (d *Driver) VkEnumeratePhysicalDeviceGroups(instance driver.VkInstance, pPhysicalDeviceGroupCount *driver.Uint32, pPhysicalDeviceGroupProperties *driver.VkPhysicalDeviceGroupProperties) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(instance)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkEnumeratePhysicalDeviceGroups(instance, pPhysicalDeviceGroupCount, pPhysicalDeviceGroupProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceFeatures2(physicalDevice driver.VkPhysicalDevice, pFeatures *driver.VkPhysicalDeviceFeatures2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceFeatures2(physicalDevice, pFeatures)
+}
+
+func (d *Driver) VkGetPhysicalDeviceProperties2(physicalDevice driver.VkPhysicalDevice, pProperties *driver.VkPhysicalDeviceProperties2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceProperties2(physicalDevice, pProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceFormatProperties2(physicalDevice driver.VkPhysicalDevice, format driver.VkFormat, pFormatProperties *driver.VkFormatProperties2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceFormatProperties2(physicalDevice, format, pFormatProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceImageFormatProperties2(physicalDevice driver.VkPhysicalDevice, pImageFormatInfo *driver.VkPhysicalDeviceImageFormatInfo2, pImageFormatProperties *driver.VkImageFormatProperties2) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkGetPhysicalDeviceImageFormatProperties2(physicalDevice, pImageFormatInfo, pImageFormatProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceQueueFamilyProperties2(physicalDevice driver.VkPhysicalDevice, pQueueFamilyPropertyCount *driver.Uint32, pQueueFamilyProperties *driver.VkQueueFamilyProperties2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceQueueFamilyProperties2(physicalDevice, pQueueFamilyPropertyCount, pQueueFamilyProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceMemoryProperties2(physicalDevice driver.VkPhysicalDevice, pMemoryProperties *driver.VkPhysicalDeviceMemoryProperties2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceMemoryProperties2(physicalDevice, pMemoryProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceSparseImageFormatProperties2(physicalDevice driver.VkPhysicalDevice, pFormatInfo *driver.VkPhysicalDeviceSparseImageFormatInfo2, pPropertyCount *driver.Uint32, pProperties *driver.VkSparseImageFormatProperties2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceSparseImageFormatProperties2(physicalDevice, pFormatInfo, pPropertyCount, pProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalBufferProperties(physicalDevice driver.VkPhysicalDevice, pExternalBufferInfo *driver.VkPhysicalDeviceExternalBufferInfo, pExternalBufferProperties *driver.VkExternalBufferProperties) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceExternalBufferProperties(physicalDevice, pExternalBufferInfo, pExternalBufferProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalFenceProperties(physicalDevice driver.VkPhysicalDevice, pExternalFenceInfo *driver.VkPhysicalDeviceExternalFenceInfo, pExternalFenceProperties *driver.VkExternalFenceProperties) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceExternalFenceProperties(physicalDevice, pExternalFenceInfo, pExternalFenceProperties)
+}
+
+func (d *Driver) VkGetPhysicalDeviceExternalSemaphoreProperties(physicalDevice driver.VkPhysicalDevice, pExternalSemaphoreInfo *driver.VkPhysicalDeviceExternalSemaphoreInfo, pExternalSemaphoreProperties *driver.VkExternalSemaphoreProperties) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+
+	d.inner.VkGetPhysicalDeviceExternalSemaphoreProperties(physicalDevice, pExternalSemaphoreInfo, pExternalSemaphoreProperties)
+}
+
+func (d *Driver) VkDestroyDevice(device driver.VkDevice, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	release := d.mustAcquire("vkDestroyDevice", []driver.VulkanHandle{driver.VulkanHandle(device)})
+	defer release()
+
+	d.inner.VkDestroyDevice(device, pAllocator)
+}
+
+func (d *Driver) VkGetDeviceQueue(device driver.VkDevice, queueFamilyIndex driver.Uint32, queueIndex driver.Uint32, pQueue *driver.VkQueue) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	d.inner.VkGetDeviceQueue(device, queueFamilyIndex, queueIndex, pQueue)
+}
+
+func (d *Driver) VkQueueSubmit(queue driver.VkQueue, submitCount driver.Uint32, pSubmits *driver.VkSubmitInfo, fence driver.VkFence) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(queue), driver.VulkanHandle(fence)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkQueueSubmit", []driver.VulkanHandle{driver.VulkanHandle(queue), driver.VulkanHandle(fence)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkQueueSubmit(queue, submitCount, pSubmits, fence)
+}
+
+func (d *Driver) VkQueueWaitIdle(queue driver.VkQueue) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(queue)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkQueueWaitIdle", []driver.VulkanHandle{driver.VulkanHandle(queue)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkQueueWaitIdle(queue)
+}
+
+func (d *Driver) VkDeviceWaitIdle(device driver.VkDevice) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkDeviceWaitIdle(device)
+}
+
+func (d *Driver) VkAllocateMemory(device driver.VkDevice, pAllocateInfo *driver.VkMemoryAllocateInfo, pAllocator *driver.VkAllocationCallbacks, pMemory *driver.VkDeviceMemory) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkAllocateMemory(device, pAllocateInfo, pAllocator, pMemory)
+}
+
+func (d *Driver) VkFreeMemory(device driver.VkDevice, memory driver.VkDeviceMemory, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(memory)})
+
+	release := d.mustAcquire("vkFreeMemory", []driver.VulkanHandle{driver.VulkanHandle(memory)})
+	defer release()
+
+	d.inner.VkFreeMemory(device, memory, pAllocator)
+}
+
+func (d *Driver) VkMapMemory(device driver.VkDevice, memory driver.VkDeviceMemory, offset driver.VkDeviceSize, size driver.VkDeviceSize, flags driver.VkMemoryMapFlags, ppData *unsafe.Pointer) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(memory)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkMapMemory", []driver.VulkanHandle{driver.VulkanHandle(memory)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkMapMemory(device, memory, offset, size, flags, ppData)
+}
+
+func (d *Driver) VkUnmapMemory(device driver.VkDevice, memory driver.VkDeviceMemory) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(memory)})
+
+	release := d.mustAcquire("vkUnmapMemory", []driver.VulkanHandle{driver.VulkanHandle(memory)})
+	defer release()
+
+	d.inner.VkUnmapMemory(device, memory)
+}
+
+func (d *Driver) VkFlushMappedMemoryRanges(device driver.VkDevice, memoryRangeCount driver.Uint32, pMemoryRanges *driver.VkMappedMemoryRange) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkFlushMappedMemoryRanges(device, memoryRangeCount, pMemoryRanges)
+}
+
+func (d *Driver) VkInvalidateMappedMemoryRanges(device driver.VkDevice, memoryRangeCount driver.Uint32, pMemoryRanges *driver.VkMappedMemoryRange) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkInvalidateMappedMemoryRanges(device, memoryRangeCount, pMemoryRanges)
+}
+
+func (d *Driver) VkGetDeviceMemoryCommitment(device driver.VkDevice, memory driver.VkDeviceMemory, pCommittedMemoryInBytes *driver.VkDeviceSize) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(memory)})
+
+	d.inner.VkGetDeviceMemoryCommitment(device, memory, pCommittedMemoryInBytes)
+}
+
+func (d *Driver) VkBindBufferMemory(device driver.VkDevice, buffer driver.VkBuffer, memory driver.VkDeviceMemory, memoryOffset driver.VkDeviceSize) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(buffer), driver.VulkanHandle(memory)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkBindBufferMemory", []driver.VulkanHandle{driver.VulkanHandle(buffer)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkBindBufferMemory(device, buffer, memory, memoryOffset)
+}
+
+func (d *Driver) VkBindImageMemory(device driver.VkDevice, image driver.VkImage, memory driver.VkDeviceMemory, memoryOffset driver.VkDeviceSize) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(image), driver.VulkanHandle(memory)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkBindImageMemory", []driver.VulkanHandle{driver.VulkanHandle(image)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkBindImageMemory(device, image, memory, memoryOffset)
+}
+
+func (d *Driver) VkGetBufferMemoryRequirements(device driver.VkDevice, buffer driver.VkBuffer, pMemoryRequirements *driver.VkMemoryRequirements) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(buffer)})
+
+	d.inner.VkGetBufferMemoryRequirements(device, buffer, pMemoryRequirements)
+}
+
+func (d *Driver) VkGetImageMemoryRequirements(device driver.VkDevice, image driver.VkImage, pMemoryRequirements *driver.VkMemoryRequirements) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(image)})
+
+	d.inner.VkGetImageMemoryRequirements(device, image, pMemoryRequirements)
+}
+
+func (d *Driver) VkGetImageSparseMemoryRequirements(device driver.VkDevice, image driver.VkImage, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(image)})
+
+	d.inner.VkGetImageSparseMemoryRequirements(device, image, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+}
+
+func (d *Driver) VkQueueBindSparse(queue driver.VkQueue, bindInfoCount driver.Uint32, pBindInfo *driver.VkBindSparseInfo, fence driver.VkFence) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(queue), driver.VulkanHandle(fence)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkQueueBindSparse", []driver.VulkanHandle{driver.VulkanHandle(queue), driver.VulkanHandle(fence)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkQueueBindSparse(queue, bindInfoCount, pBindInfo, fence)
+}
+
+func (d *Driver) VkCreateFence(device driver.VkDevice, pCreateInfo *driver.VkFenceCreateInfo, pAllocator *driver.VkAllocationCallbacks, pFence *driver.VkFence) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateFence(device, pCreateInfo, pAllocator, pFence)
+}
+
+func (d *Driver) VkDestroyFence(device driver.VkDevice, fence driver.VkFence, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(fence)})
+
+	release := d.mustAcquire("vkDestroyFence", []driver.VulkanHandle{driver.VulkanHandle(fence)})
+	defer release()
+
+	d.inner.VkDestroyFence(device, fence, pAllocator)
+}
+
+func (d *Driver) VkResetFences(device driver.VkDevice, fenceCount driver.Uint32, pFences *driver.VkFence) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)}, handleSlice(pFences, int(fenceCount)))
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkResetFences", handleSlice(pFences, int(fenceCount)))
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkResetFences(device, fenceCount, pFences)
+}
+
+func (d *Driver) VkGetFenceStatus(device driver.VkDevice, fence driver.VkFence) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(fence)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkGetFenceStatus(device, fence)
+}
+
+func (d *Driver) VkWaitForFences(device driver.VkDevice, fenceCount driver.Uint32, pFences *driver.VkFence, waitAll driver.VkBool32, timeout driver.Uint64) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)}, handleSlice(pFences, int(fenceCount)))
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkWaitForFences(device, fenceCount, pFences, waitAll, timeout)
+}
+
+func (d *Driver) VkCreateSemaphore(device driver.VkDevice, pCreateInfo *driver.VkSemaphoreCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSemaphore *driver.VkSemaphore) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateSemaphore(device, pCreateInfo, pAllocator, pSemaphore)
+}
+
+func (d *Driver) VkDestroySemaphore(device driver.VkDevice, semaphore driver.VkSemaphore, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(semaphore)})
+
+	release := d.mustAcquire("vkDestroySemaphore", []driver.VulkanHandle{driver.VulkanHandle(semaphore)})
+	defer release()
+
+	d.inner.VkDestroySemaphore(device, semaphore, pAllocator)
+}
+
+func (d *Driver) VkCreateEvent(device driver.VkDevice, pCreateInfo *driver.VkEventCreateInfo, pAllocator *driver.VkAllocationCallbacks, pEvent *driver.VkEvent) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateEvent(device, pCreateInfo, pAllocator, pEvent)
+}
+
+func (d *Driver) VkDestroyEvent(device driver.VkDevice, event driver.VkEvent, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(event)})
+
+	release := d.mustAcquire("vkDestroyEvent", []driver.VulkanHandle{driver.VulkanHandle(event)})
+	defer release()
+
+	d.inner.VkDestroyEvent(device, event, pAllocator)
+}
+
+func (d *Driver) VkGetEventStatus(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(event)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkGetEventStatus(device, event)
+}
+
+func (d *Driver) VkSetEvent(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(event)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkSetEvent", []driver.VulkanHandle{driver.VulkanHandle(event)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkSetEvent(device, event)
+}
+
+func (d *Driver) VkResetEvent(device driver.VkDevice, event driver.VkEvent) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(event)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkResetEvent", []driver.VulkanHandle{driver.VulkanHandle(event)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkResetEvent(device, event)
+}
+
+func (d *Driver) VkCreateQueryPool(device driver.VkDevice, pCreateInfo *driver.VkQueryPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pQueryPool *driver.VkQueryPool) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateQueryPool(device, pCreateInfo, pAllocator, pQueryPool)
+}
+
+func (d *Driver) VkDestroyQueryPool(device driver.VkDevice, queryPool driver.VkQueryPool, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(queryPool)})
+
+	release := d.mustAcquire("vkDestroyQueryPool", []driver.VulkanHandle{driver.VulkanHandle(queryPool)})
+	defer release()
+
+	d.inner.VkDestroyQueryPool(device, queryPool, pAllocator)
+}
+
+func (d *Driver) VkGetQueryPoolResults(device driver.VkDevice, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32, dataSize driver.Size, pData unsafe.Pointer, stride driver.VkDeviceSize, flags driver.VkQueryResultFlags) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(queryPool)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkGetQueryPoolResults(device, queryPool, firstQuery, queryCount, dataSize, pData, stride, flags)
+}
+
+func (d *Driver) VkCreateBuffer(device driver.VkDevice, pCreateInfo *driver.VkBufferCreateInfo, pAllocator *driver.VkAllocationCallbacks, pBuffer *driver.VkBuffer) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateBuffer(device, pCreateInfo, pAllocator, pBuffer)
+}
+
+func (d *Driver) VkDestroyBuffer(device driver.VkDevice, buffer driver.VkBuffer, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(buffer)})
+
+	release := d.mustAcquire("vkDestroyBuffer", []driver.VulkanHandle{driver.VulkanHandle(buffer)})
+	defer release()
+
+	d.inner.VkDestroyBuffer(device, buffer, pAllocator)
+}
+
+func (d *Driver) VkCreateBufferView(device driver.VkDevice, pCreateInfo *driver.VkBufferViewCreateInfo, pAllocator *driver.VkAllocationCallbacks, pView *driver.VkBufferView) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateBufferView(device, pCreateInfo, pAllocator, pView)
+}
+
+func (d *Driver) VkDestroyBufferView(device driver.VkDevice, bufferView driver.VkBufferView, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(bufferView)})
+
+	release := d.mustAcquire("vkDestroyBufferView", []driver.VulkanHandle{driver.VulkanHandle(bufferView)})
+	defer release()
+
+	d.inner.VkDestroyBufferView(device, bufferView, pAllocator)
+}
+
+func (d *Driver) VkCreateImage(device driver.VkDevice, pCreateInfo *driver.VkImageCreateInfo, pAllocator *driver.VkAllocationCallbacks, pImage *driver.VkImage) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateImage(device, pCreateInfo, pAllocator, pImage)
+}
+
+func (d *Driver) VkDestroyImage(device driver.VkDevice, image driver.VkImage, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(image)})
+
+	release := d.mustAcquire("vkDestroyImage", []driver.VulkanHandle{driver.VulkanHandle(image)})
+	defer release()
+
+	d.inner.VkDestroyImage(device, image, pAllocator)
+}
+
+func (d *Driver) VkGetImageSubresourceLayout(device driver.VkDevice, image driver.VkImage, pSubresource *driver.VkImageSubresource, pLayout *driver.VkSubresourceLayout) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(image)})
+
+	d.inner.VkGetImageSubresourceLayout(device, image, pSubresource, pLayout)
+}
+
+func (d *Driver) VkCreateImageView(device driver.VkDevice, pCreateInfo *driver.VkImageViewCreateInfo, pAllocator *driver.VkAllocationCallbacks, pView *driver.VkImageView) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateImageView(device, pCreateInfo, pAllocator, pView)
+}
+
+func (d *Driver) VkDestroyImageView(device driver.VkDevice, imageView driver.VkImageView, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(imageView)})
+
+	release := d.mustAcquire("vkDestroyImageView", []driver.VulkanHandle{driver.VulkanHandle(imageView)})
+	defer release()
+
+	d.inner.VkDestroyImageView(device, imageView, pAllocator)
+}
+
+func (d *Driver) VkCreateShaderModule(device driver.VkDevice, pCreateInfo *driver.VkShaderModuleCreateInfo, pAllocator *driver.VkAllocationCallbacks, pShaderModule *driver.VkShaderModule) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateShaderModule(device, pCreateInfo, pAllocator, pShaderModule)
+}
+
+func (d *Driver) VkDestroyShaderModule(device driver.VkDevice, shaderModule driver.VkShaderModule, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(shaderModule)})
+
+	release := d.mustAcquire("vkDestroyShaderModule", []driver.VulkanHandle{driver.VulkanHandle(shaderModule)})
+	defer release()
+
+	d.inner.VkDestroyShaderModule(device, shaderModule, pAllocator)
+}
+
+func (d *Driver) VkCreatePipelineCache(device driver.VkDevice, pCreateInfo *driver.VkPipelineCacheCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelineCache *driver.VkPipelineCache) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreatePipelineCache(device, pCreateInfo, pAllocator, pPipelineCache)
+}
+
+func (d *Driver) VkDestroyPipelineCache(device driver.VkDevice, pipelineCache driver.VkPipelineCache, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(pipelineCache)})
+
+	release := d.mustAcquire("vkDestroyPipelineCache", []driver.VulkanHandle{driver.VulkanHandle(pipelineCache)})
+	defer release()
+
+	d.inner.VkDestroyPipelineCache(device, pipelineCache, pAllocator)
+}
+
+func (d *Driver) VkGetPipelineCacheData(device driver.VkDevice, pipelineCache driver.VkPipelineCache, pDataSize *driver.Size, pData unsafe.Pointer) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(pipelineCache)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkGetPipelineCacheData(device, pipelineCache, pDataSize, pData)
+}
+
+func (d *Driver) VkMergePipelineCaches(device driver.VkDevice, dstCache driver.VkPipelineCache, srcCacheCount driver.Uint32, pSrcCaches *driver.VkPipelineCache) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(dstCache)}, handleSlice(pSrcCaches, int(srcCacheCount)))
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkMergePipelineCaches", []driver.VulkanHandle{driver.VulkanHandle(dstCache)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkMergePipelineCaches(device, dstCache, srcCacheCount, pSrcCaches)
+}
+
+func (d *Driver) VkCreateGraphicsPipelines(device driver.VkDevice, pipelineCache driver.VkPipelineCache, createInfoCount driver.Uint32, pCreateInfos *driver.VkGraphicsPipelineCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelines *driver.VkPipeline) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(pipelineCache)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateGraphicsPipelines(device, pipelineCache, createInfoCount, pCreateInfos, pAllocator, pPipelines)
+}
+
+func (d *Driver) VkCreateComputePipelines(device driver.VkDevice, pipelineCache driver.VkPipelineCache, createInfoCount driver.Uint32, pCreateInfos *driver.VkComputePipelineCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelines *driver.VkPipeline) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(pipelineCache)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateComputePipelines(device, pipelineCache, createInfoCount, pCreateInfos, pAllocator, pPipelines)
+}
+
+func (d *Driver) VkDestroyPipeline(device driver.VkDevice, pipeline driver.VkPipeline, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(pipeline)})
+
+	release := d.mustAcquire("vkDestroyPipeline", []driver.VulkanHandle{driver.VulkanHandle(pipeline)})
+	defer release()
+
+	d.inner.VkDestroyPipeline(device, pipeline, pAllocator)
+}
+
+func (d *Driver) VkCreatePipelineLayout(device driver.VkDevice, pCreateInfo *driver.VkPipelineLayoutCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPipelineLayout *driver.VkPipelineLayout) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreatePipelineLayout(device, pCreateInfo, pAllocator, pPipelineLayout)
+}
+
+func (d *Driver) VkDestroyPipelineLayout(device driver.VkDevice, pipelineLayout driver.VkPipelineLayout, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(pipelineLayout)})
+
+	release := d.mustAcquire("vkDestroyPipelineLayout", []driver.VulkanHandle{driver.VulkanHandle(pipelineLayout)})
+	defer release()
+
+	d.inner.VkDestroyPipelineLayout(device, pipelineLayout, pAllocator)
+}
+
+func (d *Driver) VkCreateSampler(device driver.VkDevice, pCreateInfo *driver.VkSamplerCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSampler *driver.VkSampler) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateSampler(device, pCreateInfo, pAllocator, pSampler)
+}
+
+func (d *Driver) VkDestroySampler(device driver.VkDevice, sampler driver.VkSampler, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(sampler)})
+
+	release := d.mustAcquire("vkDestroySampler", []driver.VulkanHandle{driver.VulkanHandle(sampler)})
+	defer release()
+
+	d.inner.VkDestroySampler(device, sampler, pAllocator)
+}
+
+func (d *Driver) VkCreateDescriptorSetLayout(device driver.VkDevice, pCreateInfo *driver.VkDescriptorSetLayoutCreateInfo, pAllocator *driver.VkAllocationCallbacks, pSetLayout *driver.VkDescriptorSetLayout) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateDescriptorSetLayout(device, pCreateInfo, pAllocator, pSetLayout)
+}
+
+func (d *Driver) VkDestroyDescriptorSetLayout(device driver.VkDevice, descriptorSetLayout driver.VkDescriptorSetLayout, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(descriptorSetLayout)})
+
+	release := d.mustAcquire("vkDestroyDescriptorSetLayout", []driver.VulkanHandle{driver.VulkanHandle(descriptorSetLayout)})
+	defer release()
+
+	d.inner.VkDestroyDescriptorSetLayout(device, descriptorSetLayout, pAllocator)
+}
+
+func (d *Driver) VkCreateDescriptorPool(device driver.VkDevice, pCreateInfo *driver.VkDescriptorPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDescriptorPool *driver.VkDescriptorPool) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateDescriptorPool(device, pCreateInfo, pAllocator, pDescriptorPool)
+}
+
+func (d *Driver) VkDestroyDescriptorPool(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(descriptorPool)})
+
+	release := d.mustAcquire("vkDestroyDescriptorPool", []driver.VulkanHandle{driver.VulkanHandle(descriptorPool)})
+	defer release()
+
+	d.inner.VkDestroyDescriptorPool(device, descriptorPool, pAllocator)
+}
+
+func (d *Driver) VkResetDescriptorPool(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, flags driver.VkDescriptorPoolResetFlags) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(descriptorPool)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkResetDescriptorPool", []driver.VulkanHandle{driver.VulkanHandle(descriptorPool)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkResetDescriptorPool(device, descriptorPool, flags)
+}
+
+func (d *Driver) VkAllocateDescriptorSets(device driver.VkDevice, pAllocateInfo *driver.VkDescriptorSetAllocateInfo, pDescriptorSets *driver.VkDescriptorSet) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkAllocateDescriptorSets", []driver.VulkanHandle{structHandle(pAllocateInfo, "descriptorPool")})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkAllocateDescriptorSets(device, pAllocateInfo, pDescriptorSets)
+}
+
+func (d *Driver) VkFreeDescriptorSets(device driver.VkDevice, descriptorPool driver.VkDescriptorPool, descriptorSetCount driver.Uint32, pDescriptorSets *driver.VkDescriptorSet) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(descriptorPool)}, handleSlice(pDescriptorSets, int(descriptorSetCount)))
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkFreeDescriptorSets", []driver.VulkanHandle{driver.VulkanHandle(descriptorPool)}, handleSlice(pDescriptorSets, int(descriptorSetCount)))
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkFreeDescriptorSets(device, descriptorPool, descriptorSetCount, pDescriptorSets)
+}
+
+func (d *Driver) VkUpdateDescriptorSets(device driver.VkDevice, descriptorWriteCount driver.Uint32, pDescriptorWrites *driver.VkWriteDescriptorSet, descriptorCopyCount driver.Uint32, pDescriptorCopies *driver.VkCopyDescriptorSet) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	d.inner.VkUpdateDescriptorSets(device, descriptorWriteCount, pDescriptorWrites, descriptorCopyCount, pDescriptorCopies)
+}
+
+func (d *Driver) VkCreateFramebuffer(device driver.VkDevice, pCreateInfo *driver.VkFramebufferCreateInfo, pAllocator *driver.VkAllocationCallbacks, pFramebuffer *driver.VkFramebuffer) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateFramebuffer(device, pCreateInfo, pAllocator, pFramebuffer)
+}
+
+func (d *Driver) VkDestroyFramebuffer(device driver.VkDevice, framebuffer driver.VkFramebuffer, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(framebuffer)})
+
+	release := d.mustAcquire("vkDestroyFramebuffer", []driver.VulkanHandle{driver.VulkanHandle(framebuffer)})
+	defer release()
+
+	d.inner.VkDestroyFramebuffer(device, framebuffer, pAllocator)
+}
+
+func (d *Driver) VkCreateRenderPass(device driver.VkDevice, pCreateInfo *driver.VkRenderPassCreateInfo, pAllocator *driver.VkAllocationCallbacks, pRenderPass *driver.VkRenderPass) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateRenderPass(device, pCreateInfo, pAllocator, pRenderPass)
+}
+
+func (d *Driver) VkDestroyRenderPass(device driver.VkDevice, renderPass driver.VkRenderPass, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(renderPass)})
+
+	release := d.mustAcquire("vkDestroyRenderPass", []driver.VulkanHandle{driver.VulkanHandle(renderPass)})
+	defer release()
+
+	d.inner.VkDestroyRenderPass(device, renderPass, pAllocator)
+}
+
+func (d *Driver) VkGetRenderAreaGranularity(device driver.VkDevice, renderPass driver.VkRenderPass, pGranularity *driver.VkExtent2D) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(renderPass)})
+
+	d.inner.VkGetRenderAreaGranularity(device, renderPass, pGranularity)
+}
+
+func (d *Driver) VkCreateCommandPool(device driver.VkDevice, pCreateInfo *driver.VkCommandPoolCreateInfo, pAllocator *driver.VkAllocationCallbacks, pCommandPool *driver.VkCommandPool) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateCommandPool(device, pCreateInfo, pAllocator, pCommandPool)
+}
+
+func (d *Driver) VkDestroyCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(commandPool)})
+
+	release := d.mustAcquire("vkDestroyCommandPool", []driver.VulkanHandle{driver.VulkanHandle(commandPool)})
+	defer release()
+
+	d.inner.VkDestroyCommandPool(device, commandPool, pAllocator)
+}
+
+func (d *Driver) VkResetCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, flags driver.VkCommandPoolResetFlags) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(commandPool)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkResetCommandPool", []driver.VulkanHandle{driver.VulkanHandle(commandPool)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkResetCommandPool(device, commandPool, flags)
+}
+
+func (d *Driver) VkAllocateCommandBuffers(device driver.VkDevice, pAllocateInfo *driver.VkCommandBufferAllocateInfo, pCommandBuffers *driver.VkCommandBuffer) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkAllocateCommandBuffers", []driver.VulkanHandle{structHandle(pAllocateInfo, "commandPool")})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkAllocateCommandBuffers(device, pAllocateInfo, pCommandBuffers)
+}
+
+func (d *Driver) VkFreeCommandBuffers(device driver.VkDevice, commandPool driver.VkCommandPool, commandBufferCount driver.Uint32, pCommandBuffers *driver.VkCommandBuffer) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(commandPool)}, handleSlice(pCommandBuffers, int(commandBufferCount)))
+
+	release := d.mustAcquire("vkFreeCommandBuffers", []driver.VulkanHandle{driver.VulkanHandle(commandPool)}, handleSlice(pCommandBuffers, int(commandBufferCount)))
+	defer release()
+
+	d.inner.VkFreeCommandBuffers(device, commandPool, commandBufferCount, pCommandBuffers)
+}
+
+func (d *Driver) VkBeginCommandBuffer(commandBuffer driver.VkCommandBuffer, pBeginInfo *driver.VkCommandBufferBeginInfo) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkBeginCommandBuffer", d.commandBufferHandles(commandBuffer))
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkBeginCommandBuffer(commandBuffer, pBeginInfo)
+}
+
+func (d *Driver) VkEndCommandBuffer(commandBuffer driver.VkCommandBuffer) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkEndCommandBuffer", d.commandBufferHandles(commandBuffer))
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkEndCommandBuffer(commandBuffer)
+}
+
+func (d *Driver) VkResetCommandBuffer(commandBuffer driver.VkCommandBuffer, flags driver.VkCommandBufferResetFlags) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkResetCommandBuffer", d.commandBufferHandles(commandBuffer))
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkResetCommandBuffer(commandBuffer, flags)
+}
+
+func (d *Driver) VkCmdBindPipeline(commandBuffer driver.VkCommandBuffer, pipelineBindPoint driver.VkPipelineBindPoint, pipeline driver.VkPipeline) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(pipeline)})
+
+	release := d.mustAcquire("vkCmdBindPipeline", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdBindPipeline(commandBuffer, pipelineBindPoint, pipeline)
+}
+
+func (d *Driver) VkCmdSetViewport(commandBuffer driver.VkCommandBuffer, firstViewport driver.Uint32, viewportCount driver.Uint32, pViewports *driver.VkViewport) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetViewport", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetViewport(commandBuffer, firstViewport, viewportCount, pViewports)
+}
+
+func (d *Driver) VkCmdSetScissor(commandBuffer driver.VkCommandBuffer, firstScissor driver.Uint32, scissorCount driver.Uint32, pScissors *driver.VkRect2D) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetScissor", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetScissor(commandBuffer, firstScissor, scissorCount, pScissors)
+}
+
+func (d *Driver) VkCmdSetLineWidth(commandBuffer driver.VkCommandBuffer, lineWidth driver.Float) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetLineWidth", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetLineWidth(commandBuffer, lineWidth)
+}
+
+func (d *Driver) VkCmdSetDepthBias(commandBuffer driver.VkCommandBuffer, depthBiasConstantFactor driver.Float, depthBiasClamp driver.Float, depthBiasSlopeFactor driver.Float) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetDepthBias", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetDepthBias(commandBuffer, depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor)
+}
+
+func (d *Driver) VkCmdSetBlendConstants(commandBuffer driver.VkCommandBuffer, blendConstants *driver.Float) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetBlendConstants", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetBlendConstants(commandBuffer, blendConstants)
+}
+
+func (d *Driver) VkCmdSetDepthBounds(commandBuffer driver.VkCommandBuffer, minDepthBounds driver.Float, maxDepthBounds driver.Float) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetDepthBounds", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetDepthBounds(commandBuffer, minDepthBounds, maxDepthBounds)
+}
+
+func (d *Driver) VkCmdSetStencilCompareMask(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, compareMask driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetStencilCompareMask", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetStencilCompareMask(commandBuffer, faceMask, compareMask)
+}
+
+func (d *Driver) VkCmdSetStencilWriteMask(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, writeMask driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetStencilWriteMask", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetStencilWriteMask(commandBuffer, faceMask, writeMask)
+}
+
+func (d *Driver) VkCmdSetStencilReference(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, reference driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetStencilReference", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetStencilReference(commandBuffer, faceMask, reference)
+}
+
+func (d *Driver) VkCmdBindDescriptorSets(commandBuffer driver.VkCommandBuffer, pipelineBindPoint driver.VkPipelineBindPoint, layout driver.VkPipelineLayout, firstSet driver.Uint32, descriptorSetCount driver.Uint32, pDescriptorSets *driver.VkDescriptorSet, dynamicOffsetCount driver.Uint32, pDynamicOffsets *driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(layout)}, handleSlice(pDescriptorSets, int(descriptorSetCount)))
+
+	release := d.mustAcquire("vkCmdBindDescriptorSets", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdBindDescriptorSets(commandBuffer, pipelineBindPoint, layout, firstSet, descriptorSetCount, pDescriptorSets, dynamicOffsetCount, pDynamicOffsets)
+}
+
+func (d *Driver) VkCmdBindIndexBuffer(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, indexType driver.VkIndexType) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(buffer)})
+
+	release := d.mustAcquire("vkCmdBindIndexBuffer", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdBindIndexBuffer(commandBuffer, buffer, offset, indexType)
+}
+
+func (d *Driver) VkCmdBindVertexBuffers(commandBuffer driver.VkCommandBuffer, firstBinding driver.Uint32, bindingCount driver.Uint32, pBuffers *driver.VkBuffer, pOffsets *driver.VkDeviceSize) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)}, handleSlice(pBuffers, int(bindingCount)))
+
+	release := d.mustAcquire("vkCmdBindVertexBuffers", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdBindVertexBuffers(commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets)
+}
+
+func (d *Driver) VkCmdDraw(commandBuffer driver.VkCommandBuffer, vertexCount driver.Uint32, instanceCount driver.Uint32, firstVertex driver.Uint32, firstInstance driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdDraw", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdDraw(commandBuffer, vertexCount, instanceCount, firstVertex, firstInstance)
+}
+
+func (d *Driver) VkCmdDrawIndexed(commandBuffer driver.VkCommandBuffer, indexCount driver.Uint32, instanceCount driver.Uint32, firstIndex driver.Uint32, vertexOffset driver.Int32, firstInstance driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdDrawIndexed", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdDrawIndexed(commandBuffer, indexCount, instanceCount, firstIndex, vertexOffset, firstInstance)
+}
+
+func (d *Driver) VkCmdDrawIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, drawCount driver.Uint32, stride driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(buffer)})
+
+	release := d.mustAcquire("vkCmdDrawIndirect", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdDrawIndirect(commandBuffer, buffer, offset, drawCount, stride)
+}
+
+func (d *Driver) VkCmdDrawIndexedIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, drawCount driver.Uint32, stride driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(buffer)})
+
+	release := d.mustAcquire("vkCmdDrawIndexedIndirect", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdDrawIndexedIndirect(commandBuffer, buffer, offset, drawCount, stride)
+}
+
+func (d *Driver) VkCmdDispatch(commandBuffer driver.VkCommandBuffer, groupCountX driver.Uint32, groupCountY driver.Uint32, groupCountZ driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdDispatch", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdDispatch(commandBuffer, groupCountX, groupCountY, groupCountZ)
+}
+
+func (d *Driver) VkCmdDispatchIndirect(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(buffer)})
+
+	release := d.mustAcquire("vkCmdDispatchIndirect", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdDispatchIndirect(commandBuffer, buffer, offset)
+}
+
+func (d *Driver) VkCmdCopyBuffer(commandBuffer driver.VkCommandBuffer, srcBuffer driver.VkBuffer, dstBuffer driver.VkBuffer, regionCount driver.Uint32, pRegions *driver.VkBufferCopy) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(srcBuffer), driver.VulkanHandle(dstBuffer)})
+
+	release := d.mustAcquire("vkCmdCopyBuffer", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdCopyBuffer(commandBuffer, srcBuffer, dstBuffer, regionCount, pRegions)
+}
+
+func (d *Driver) VkCmdCopyImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageCopy) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(srcImage), driver.VulkanHandle(dstImage)})
+
+	release := d.mustAcquire("vkCmdCopyImage", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdCopyImage(commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)
+}
+
+func (d *Driver) VkCmdBlitImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageBlit, filter driver.VkFilter) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(srcImage), driver.VulkanHandle(dstImage)})
+
+	release := d.mustAcquire("vkCmdBlitImage", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdBlitImage(commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions, filter)
+}
+
+func (d *Driver) VkCmdCopyBufferToImage(commandBuffer driver.VkCommandBuffer, srcBuffer driver.VkBuffer, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkBufferImageCopy) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(srcBuffer), driver.VulkanHandle(dstImage)})
+
+	release := d.mustAcquire("vkCmdCopyBufferToImage", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdCopyBufferToImage(commandBuffer, srcBuffer, dstImage, dstImageLayout, regionCount, pRegions)
+}
+
+func (d *Driver) VkCmdCopyImageToBuffer(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstBuffer driver.VkBuffer, regionCount driver.Uint32, pRegions *driver.VkBufferImageCopy) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(srcImage), driver.VulkanHandle(dstBuffer)})
+
+	release := d.mustAcquire("vkCmdCopyImageToBuffer", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdCopyImageToBuffer(commandBuffer, srcImage, srcImageLayout, dstBuffer, regionCount, pRegions)
+}
+
+func (d *Driver) VkCmdUpdateBuffer(commandBuffer driver.VkCommandBuffer, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, dataSize driver.VkDeviceSize, pData unsafe.Pointer) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(dstBuffer)})
+
+	release := d.mustAcquire("vkCmdUpdateBuffer", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdUpdateBuffer(commandBuffer, dstBuffer, dstOffset, dataSize, pData)
+}
+
+func (d *Driver) VkCmdFillBuffer(commandBuffer driver.VkCommandBuffer, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, size driver.VkDeviceSize, data driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(dstBuffer)})
+
+	release := d.mustAcquire("vkCmdFillBuffer", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdFillBuffer(commandBuffer, dstBuffer, dstOffset, size, data)
+}
+
+func (d *Driver) VkCmdClearColorImage(commandBuffer driver.VkCommandBuffer, image driver.VkImage, imageLayout driver.VkImageLayout, pColor *driver.VkClearColorValue, rangeCount driver.Uint32, pRanges *driver.VkImageSubresourceRange) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(image)})
+
+	release := d.mustAcquire("vkCmdClearColorImage", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdClearColorImage(commandBuffer, image, imageLayout, pColor, rangeCount, pRanges)
+}
+
+func (d *Driver) VkCmdClearDepthStencilImage(commandBuffer driver.VkCommandBuffer, image driver.VkImage, imageLayout driver.VkImageLayout, pDepthStencil *driver.VkClearDepthStencilValue, rangeCount driver.Uint32, pRanges *driver.VkImageSubresourceRange) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(image)})
+
+	release := d.mustAcquire("vkCmdClearDepthStencilImage", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdClearDepthStencilImage(commandBuffer, image, imageLayout, pDepthStencil, rangeCount, pRanges)
+}
+
+func (d *Driver) VkCmdClearAttachments(commandBuffer driver.VkCommandBuffer, attachmentCount driver.Uint32, pAttachments *driver.VkClearAttachment, rectCount driver.Uint32, pRects *driver.VkClearRect) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdClearAttachments", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdClearAttachments(commandBuffer, attachmentCount, pAttachments, rectCount, pRects)
+}
+
+func (d *Driver) VkCmdResolveImage(commandBuffer driver.VkCommandBuffer, srcImage driver.VkImage, srcImageLayout driver.VkImageLayout, dstImage driver.VkImage, dstImageLayout driver.VkImageLayout, regionCount driver.Uint32, pRegions *driver.VkImageResolve) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(srcImage), driver.VulkanHandle(dstImage)})
+
+	release := d.mustAcquire("vkCmdResolveImage", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdResolveImage(commandBuffer, srcImage, srcImageLayout, dstImage, dstImageLayout, regionCount, pRegions)
+}
+
+func (d *Driver) VkCmdSetEvent(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(event)})
+
+	release := d.mustAcquire("vkCmdSetEvent", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetEvent(commandBuffer, event, stageMask)
+}
+
+func (d *Driver) VkCmdResetEvent(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(event)})
+
+	release := d.mustAcquire("vkCmdResetEvent", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdResetEvent(commandBuffer, event, stageMask)
+}
+
+func (d *Driver) VkCmdWaitEvents(commandBuffer driver.VkCommandBuffer, eventCount driver.Uint32, pEvents *driver.VkEvent, srcStageMask driver.VkPipelineStageFlags, dstStageMask driver.VkPipelineStageFlags, memoryBarrierCount driver.Uint32, pMemoryBarriers *driver.VkMemoryBarrier, bufferMemoryBarrierCount driver.Uint32, pBufferMemoryBarriers *driver.VkBufferMemoryBarrier, imageMemoryBarrierCount driver.Uint32, pImageMemoryBarriers *driver.VkImageMemoryBarrier) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)}, handleSlice(pEvents, int(eventCount)))
+
+	release := d.mustAcquire("vkCmdWaitEvents", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdWaitEvents(commandBuffer, eventCount, pEvents, srcStageMask, dstStageMask, memoryBarrierCount, pMemoryBarriers, bufferMemoryBarrierCount, pBufferMemoryBarriers, imageMemoryBarrierCount, pImageMemoryBarriers)
+}
+
+func (d *Driver) VkCmdPipelineBarrier(commandBuffer driver.VkCommandBuffer, srcStageMask driver.VkPipelineStageFlags, dstStageMask driver.VkPipelineStageFlags, dependencyFlags driver.VkDependencyFlags, memoryBarrierCount driver.Uint32, pMemoryBarriers *driver.VkMemoryBarrier, bufferMemoryBarrierCount driver.Uint32, pBufferMemoryBarriers *driver.VkBufferMemoryBarrier, imageMemoryBarrierCount driver.Uint32, pImageMemoryBarriers *driver.VkImageMemoryBarrier) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdPipelineBarrier", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdPipelineBarrier(commandBuffer, srcStageMask, dstStageMask, dependencyFlags, memoryBarrierCount, pMemoryBarriers, bufferMemoryBarrierCount, pBufferMemoryBarriers, imageMemoryBarrierCount, pImageMemoryBarriers)
+}
+
+func (d *Driver) VkCmdBeginQuery(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, query driver.Uint32, flags driver.VkQueryControlFlags) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(queryPool)})
+
+	release := d.mustAcquire("vkCmdBeginQuery", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdBeginQuery(commandBuffer, queryPool, query, flags)
+}
+
+func (d *Driver) VkCmdEndQuery(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, query driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(queryPool)})
+
+	release := d.mustAcquire("vkCmdEndQuery", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdEndQuery(commandBuffer, queryPool, query)
+}
+
+func (d *Driver) VkCmdResetQueryPool(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(queryPool)})
+
+	release := d.mustAcquire("vkCmdResetQueryPool", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdResetQueryPool(commandBuffer, queryPool, firstQuery, queryCount)
+}
+
+func (d *Driver) VkCmdWriteTimestamp(commandBuffer driver.VkCommandBuffer, pipelineStage driver.VkPipelineStageFlags, queryPool driver.VkQueryPool, query driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(queryPool)})
+
+	release := d.mustAcquire("vkCmdWriteTimestamp", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdWriteTimestamp(commandBuffer, pipelineStage, queryPool, query)
+}
+
+func (d *Driver) VkCmdCopyQueryPoolResults(commandBuffer driver.VkCommandBuffer, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32, dstBuffer driver.VkBuffer, dstOffset driver.VkDeviceSize, stride driver.VkDeviceSize, flags driver.VkQueryResultFlags) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(queryPool), driver.VulkanHandle(dstBuffer)})
+
+	release := d.mustAcquire("vkCmdCopyQueryPoolResults", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdCopyQueryPoolResults(commandBuffer, queryPool, firstQuery, queryCount, dstBuffer, dstOffset, stride, flags)
+}
+
+func (d *Driver) VkCmdPushConstants(commandBuffer driver.VkCommandBuffer, layout driver.VkPipelineLayout, stageFlags driver.VkShaderStageFlags, offset driver.Uint32, size driver.Uint32, pValues unsafe.Pointer) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(layout)})
+
+	release := d.mustAcquire("vkCmdPushConstants", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdPushConstants(commandBuffer, layout, stageFlags, offset, size, pValues)
+}
+
+func (d *Driver) VkCmdBeginRenderPass(commandBuffer driver.VkCommandBuffer, pRenderPassBegin *driver.VkRenderPassBeginInfo, contents driver.VkSubpassContents) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdBeginRenderPass", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdBeginRenderPass(commandBuffer, pRenderPassBegin, contents)
+}
+
+func (d *Driver) VkCmdNextSubpass(commandBuffer driver.VkCommandBuffer, contents driver.VkSubpassContents) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdNextSubpass", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdNextSubpass(commandBuffer, contents)
+}
+
+func (d *Driver) VkCmdEndRenderPass(commandBuffer driver.VkCommandBuffer) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdEndRenderPass", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdEndRenderPass(commandBuffer)
+}
+
+func (d *Driver) VkCmdExecuteCommands(commandBuffer driver.VkCommandBuffer, commandBufferCount driver.Uint32, pCommandBuffers *driver.VkCommandBuffer) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)}, handleSlice(pCommandBuffers, int(commandBufferCount)))
+
+	release := d.mustAcquire("vkCmdExecuteCommands", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdExecuteCommands(commandBuffer, commandBufferCount, pCommandBuffers)
+}
+
+func (d *Driver) VkBindBufferMemory2(device driver.VkDevice, bindInfoCount driver.Uint32, pBindInfos *driver.VkBindBufferMemoryInfo) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkBindBufferMemory2(device, bindInfoCount, pBindInfos)
+}
+
+func (d *Driver) VkBindImageMemory2(device driver.VkDevice, bindInfoCount driver.Uint32, pBindInfos *driver.VkBindImageMemoryInfo) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkBindImageMemory2(device, bindInfoCount, pBindInfos)
+}
+
+func (d *Driver) VkGetDeviceGroupPeerMemoryFeatures(device driver.VkDevice, heapIndex driver.Uint32, localDeviceIndex driver.Uint32, remoteDeviceIndex driver.Uint32, pPeerMemoryFeatures *driver.VkPeerMemoryFeatureFlags) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	d.inner.VkGetDeviceGroupPeerMemoryFeatures(device, heapIndex, localDeviceIndex, remoteDeviceIndex, pPeerMemoryFeatures)
+}
+
+func (d *Driver) VkCmdSetDeviceMask(commandBuffer driver.VkCommandBuffer, deviceMask driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetDeviceMask", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetDeviceMask(commandBuffer, deviceMask)
+}
+
+func (d *Driver) VkCmdDispatchBase(commandBuffer driver.VkCommandBuffer, baseGroupX driver.Uint32, baseGroupY driver.Uint32, baseGroupZ driver.Uint32, groupCountX driver.Uint32, groupCountY driver.Uint32, groupCountZ driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdDispatchBase", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdDispatchBase(commandBuffer, baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ)
+}
+
+func (d *Driver) VkGetImageMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkImageMemoryRequirementsInfo2, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	d.inner.VkGetImageMemoryRequirements2(device, pInfo, pMemoryRequirements)
+}
+
+func (d *Driver) VkGetBufferMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkBufferMemoryRequirementsInfo2, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	d.inner.VkGetBufferMemoryRequirements2(device, pInfo, pMemoryRequirements)
+}
+
+func (d *Driver) VkGetImageSparseMemoryRequirements2(device driver.VkDevice, pInfo *driver.VkImageSparseMemoryRequirementsInfo2, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	d.inner.VkGetImageSparseMemoryRequirements2(device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+}
+
+func (d *Driver) VkTrimCommandPool(device driver.VkDevice, commandPool driver.VkCommandPool, flags driver.VkCommandPoolTrimFlags) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(commandPool)})
+
+	release := d.mustAcquire("vkTrimCommandPool", []driver.VulkanHandle{driver.VulkanHandle(commandPool)})
+	defer release()
+
+	d.inner.VkTrimCommandPool(device, commandPool, flags)
+}
+
+func (d *Driver) VkGetDeviceQueue2(device driver.VkDevice, pQueueInfo *driver.VkDeviceQueueInfo2, pQueue *driver.VkQueue) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	d.inner.VkGetDeviceQueue2(device, pQueueInfo, pQueue)
+}
+
+func (d *Driver) VkCreateSamplerYcbcrConversion(device driver.VkDevice, pCreateInfo *driver.VkSamplerYcbcrConversionCreateInfo, pAllocator *driver.VkAllocationCallbacks, pYcbcrConversion *driver.VkSamplerYcbcrConversion) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateSamplerYcbcrConversion(device, pCreateInfo, pAllocator, pYcbcrConversion)
+}
+
+func (d *Driver) VkDestroySamplerYcbcrConversion(device driver.VkDevice, ycbcrConversion driver.VkSamplerYcbcrConversion, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(ycbcrConversion)})
+
+	release := d.mustAcquire("vkDestroySamplerYcbcrConversion", []driver.VulkanHandle{driver.VulkanHandle(ycbcrConversion)})
+	defer release()
+
+	d.inner.VkDestroySamplerYcbcrConversion(device, ycbcrConversion, pAllocator)
+}
+
+func (d *Driver) VkCreateDescriptorUpdateTemplate(device driver.VkDevice, pCreateInfo *driver.VkDescriptorUpdateTemplateCreateInfo, pAllocator *driver.VkAllocationCallbacks, pDescriptorUpdateTemplate *driver.VkDescriptorUpdateTemplate) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateDescriptorUpdateTemplate(device, pCreateInfo, pAllocator, pDescriptorUpdateTemplate)
+}
+
+func (d *Driver) VkDestroyDescriptorUpdateTemplate(device driver.VkDevice, descriptorUpdateTemplate driver.VkDescriptorUpdateTemplate, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(descriptorUpdateTemplate)})
+
+	release := d.mustAcquire("vkDestroyDescriptorUpdateTemplate", []driver.VulkanHandle{driver.VulkanHandle(descriptorUpdateTemplate)})
+	defer release()
+
+	d.inner.VkDestroyDescriptorUpdateTemplate(device, descriptorUpdateTemplate, pAllocator)
+}
+
+func (d *Driver) VkUpdateDescriptorSetWithTemplate(device driver.VkDevice, descriptorSet driver.VkDescriptorSet, descriptorUpdateTemplate driver.VkDescriptorUpdateTemplate, pData unsafe.Pointer) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(descriptorSet), driver.VulkanHandle(descriptorUpdateTemplate)})
+
+	release := d.mustAcquire("vkUpdateDescriptorSetWithTemplate", []driver.VulkanHandle{driver.VulkanHandle(descriptorSet)})
+	defer release()
+
+	d.inner.VkUpdateDescriptorSetWithTemplate(device, descriptorSet, descriptorUpdateTemplate, pData)
+}
+
+func (d *Driver) VkGetDescriptorSetLayoutSupport(device driver.VkDevice, pCreateInfo *driver.VkDescriptorSetLayoutCreateInfo, pSupport *driver.VkDescriptorSetLayoutSupport) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	d.inner.VkGetDescriptorSetLayoutSupport(device, pCreateInfo, pSupport)
+}
+
+func (d *Driver) VkCmdDrawIndirectCount(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, countBuffer driver.VkBuffer, countBufferOffset driver.VkDeviceSize, maxDrawCount driver.Uint32, stride driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(buffer), driver.VulkanHandle(countBuffer)})
+
+	release := d.mustAcquire("vkCmdDrawIndirectCount", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdDrawIndirectCount(commandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+func (d *Driver) VkCmdDrawIndexedIndirectCount(commandBuffer driver.VkCommandBuffer, buffer driver.VkBuffer, offset driver.VkDeviceSize, countBuffer driver.VkBuffer, countBufferOffset driver.VkDeviceSize, maxDrawCount driver.Uint32, stride driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(buffer), driver.VulkanHandle(countBuffer)})
+
+	release := d.mustAcquire("vkCmdDrawIndexedIndirectCount", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdDrawIndexedIndirectCount(commandBuffer, buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+func (d *Driver) VkCreateRenderPass2(device driver.VkDevice, pCreateInfo *driver.VkRenderPassCreateInfo2, pAllocator *driver.VkAllocationCallbacks, pRenderPass *driver.VkRenderPass) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreateRenderPass2(device, pCreateInfo, pAllocator, pRenderPass)
+}
+
+func (d *Driver) VkCmdBeginRenderPass2(commandBuffer driver.VkCommandBuffer, pRenderPassBegin *driver.VkRenderPassBeginInfo, pSubpassBeginInfo *driver.VkSubpassBeginInfo) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdBeginRenderPass2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdBeginRenderPass2(commandBuffer, pRenderPassBegin, pSubpassBeginInfo)
+}
+
+func (d *Driver) VkCmdNextSubpass2(commandBuffer driver.VkCommandBuffer, pSubpassBeginInfo *driver.VkSubpassBeginInfo, pSubpassEndInfo *driver.VkSubpassEndInfo) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdNextSubpass2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdNextSubpass2(commandBuffer, pSubpassBeginInfo, pSubpassEndInfo)
+}
+
+func (d *Driver) VkCmdEndRenderPass2(commandBuffer driver.VkCommandBuffer, pSubpassEndInfo *driver.VkSubpassEndInfo) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdEndRenderPass2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdEndRenderPass2(commandBuffer, pSubpassEndInfo)
+}
+
+func (d *Driver) VkResetQueryPool(device driver.VkDevice, queryPool driver.VkQueryPool, firstQuery driver.Uint32, queryCount driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(queryPool)})
+
+	d.inner.VkResetQueryPool(device, queryPool, firstQuery, queryCount)
+}
+
+func (d *Driver) VkGetSemaphoreCounterValue(device driver.VkDevice, semaphore driver.VkSemaphore, pValue *driver.Uint64) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(semaphore)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkGetSemaphoreCounterValue(device, semaphore, pValue)
+}
+
+func (d *Driver) VkWaitSemaphores(device driver.VkDevice, pWaitInfo *driver.VkSemaphoreWaitInfo, timeout driver.Uint64) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkWaitSemaphores(device, pWaitInfo, timeout)
+}
+
+func (d *Driver) VkSignalSemaphore(device driver.VkDevice, pSignalInfo *driver.VkSemaphoreSignalInfo) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkSignalSemaphore(device, pSignalInfo)
+}
+
+func (d *Driver) VkGetBufferDeviceAddress(device driver.VkDevice, pInfo *driver.VkBufferDeviceAddressInfo) driver.VkDeviceAddress {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	return d.inner.VkGetBufferDeviceAddress(device, pInfo)
+}
+
+func (d *Driver) VkGetBufferOpaqueCaptureAddress(device driver.VkDevice, pInfo *driver.VkBufferDeviceAddressInfo) driver.Uint64 {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	return d.inner.VkGetBufferOpaqueCaptureAddress(device, pInfo)
+}
+
+func (d *Driver) VkGetDeviceMemoryOpaqueCaptureAddress(device driver.VkDevice, pInfo *driver.VkDeviceMemoryOpaqueCaptureAddressInfo) driver.Uint64 {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	return d.inner.VkGetDeviceMemoryOpaqueCaptureAddress(device, pInfo)
+}
+
+func (d *Driver) VkCmdBeginRendering(commandBuffer driver.VkCommandBuffer, pRenderingInfo *driver.VkRenderingInfo) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdBeginRendering", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdBeginRendering(commandBuffer, pRenderingInfo)
+}
+
+func (d *Driver) VkCmdEndRendering(commandBuffer driver.VkCommandBuffer) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdEndRendering", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdEndRendering(commandBuffer)
+}
+
+func (d *Driver) VkCmdSetEvent2(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, pDependencyInfo *driver.VkDependencyInfo) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(event)})
+
+	release := d.mustAcquire("vkCmdSetEvent2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetEvent2(commandBuffer, event, pDependencyInfo)
+}
+
+func (d *Driver) VkCmdResetEvent2(commandBuffer driver.VkCommandBuffer, event driver.VkEvent, stageMask driver.VkPipelineStageFlags2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(event)})
+
+	release := d.mustAcquire("vkCmdResetEvent2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdResetEvent2(commandBuffer, event, stageMask)
+}
+
+func (d *Driver) VkCmdWaitEvents2(commandBuffer driver.VkCommandBuffer, eventCount driver.Uint32, pEvents *driver.VkEvent, pDependencyInfos *driver.VkDependencyInfo) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)}, handleSlice(pEvents, int(eventCount)))
+
+	release := d.mustAcquire("vkCmdWaitEvents2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdWaitEvents2(commandBuffer, eventCount, pEvents, pDependencyInfos)
+}
+
+func (d *Driver) VkCmdPipelineBarrier2(commandBuffer driver.VkCommandBuffer, pDependencyInfo *driver.VkDependencyInfo) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdPipelineBarrier2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdPipelineBarrier2(commandBuffer, pDependencyInfo)
+}
+
+func (d *Driver) VkCmdWriteTimestamp2(commandBuffer driver.VkCommandBuffer, stage driver.VkPipelineStageFlags2, queryPool driver.VkQueryPool, query driver.Uint32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer), driver.VulkanHandle(queryPool)})
+
+	release := d.mustAcquire("vkCmdWriteTimestamp2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdWriteTimestamp2(commandBuffer, stage, queryPool, query)
+}
+
+func (d *Driver) VkQueueSubmit2(queue driver.VkQueue, submitCount driver.Uint32, pSubmits *driver.VkSubmitInfo2, fence driver.VkFence) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(queue), driver.VulkanHandle(fence)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	release, err := d.acquire("vkQueueSubmit2", []driver.VulkanHandle{driver.VulkanHandle(queue), driver.VulkanHandle(fence)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	defer release()
+
+	return d.inner.VkQueueSubmit2(queue, submitCount, pSubmits, fence)
+}
+
+func (d *Driver) VkCmdSetCullMode(commandBuffer driver.VkCommandBuffer, cullMode driver.VkCullModeFlags) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetCullMode", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetCullMode(commandBuffer, cullMode)
+}
+
+func (d *Driver) VkCmdSetFrontFace(commandBuffer driver.VkCommandBuffer, frontFace driver.VkFrontFace) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetFrontFace", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetFrontFace(commandBuffer, frontFace)
+}
+
+func (d *Driver) VkCmdSetPrimitiveTopology(commandBuffer driver.VkCommandBuffer, primitiveTopology driver.VkPrimitiveTopology) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetPrimitiveTopology", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetPrimitiveTopology(commandBuffer, primitiveTopology)
+}
+
+func (d *Driver) VkCmdSetViewportWithCount(commandBuffer driver.VkCommandBuffer, viewportCount driver.Uint32, pViewports *driver.VkViewport) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetViewportWithCount", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetViewportWithCount(commandBuffer, viewportCount, pViewports)
+}
+
+func (d *Driver) VkCmdSetScissorWithCount(commandBuffer driver.VkCommandBuffer, scissorCount driver.Uint32, pScissors *driver.VkRect2D) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetScissorWithCount", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetScissorWithCount(commandBuffer, scissorCount, pScissors)
+}
+
+func (d *Driver) VkCmdBindVertexBuffers2(commandBuffer driver.VkCommandBuffer, firstBinding driver.Uint32, bindingCount driver.Uint32, pBuffers *driver.VkBuffer, pOffsets *driver.VkDeviceSize, pSizes *driver.VkDeviceSize, pStrides *driver.VkDeviceSize) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)}, handleSlice(pBuffers, int(bindingCount)))
+
+	release := d.mustAcquire("vkCmdBindVertexBuffers2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdBindVertexBuffers2(commandBuffer, firstBinding, bindingCount, pBuffers, pOffsets, pSizes, pStrides)
+}
+
+func (d *Driver) VkCmdSetDepthTestEnable(commandBuffer driver.VkCommandBuffer, depthTestEnable driver.VkBool32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetDepthTestEnable", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetDepthTestEnable(commandBuffer, depthTestEnable)
+}
+
+func (d *Driver) VkCmdSetDepthWriteEnable(commandBuffer driver.VkCommandBuffer, depthWriteEnable driver.VkBool32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetDepthWriteEnable", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetDepthWriteEnable(commandBuffer, depthWriteEnable)
+}
+
+func (d *Driver) VkCmdSetDepthCompareOp(commandBuffer driver.VkCommandBuffer, depthCompareOp driver.VkCompareOp) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetDepthCompareOp", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetDepthCompareOp(commandBuffer, depthCompareOp)
+}
+
+func (d *Driver) VkCmdSetDepthBoundsTestEnable(commandBuffer driver.VkCommandBuffer, depthBoundsTestEnable driver.VkBool32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetDepthBoundsTestEnable", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetDepthBoundsTestEnable(commandBuffer, depthBoundsTestEnable)
+}
+
+func (d *Driver) VkCmdSetStencilTestEnable(commandBuffer driver.VkCommandBuffer, stencilTestEnable driver.VkBool32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetStencilTestEnable", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetStencilTestEnable(commandBuffer, stencilTestEnable)
+}
+
+func (d *Driver) VkCmdSetStencilOp(commandBuffer driver.VkCommandBuffer, faceMask driver.VkStencilFaceFlags, failOp driver.VkStencilOp, passOp driver.VkStencilOp, depthFailOp driver.VkStencilOp, compareOp driver.VkCompareOp) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetStencilOp", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetStencilOp(commandBuffer, faceMask, failOp, passOp, depthFailOp, compareOp)
+}
+
+func (d *Driver) VkCmdSetRasterizerDiscardEnable(commandBuffer driver.VkCommandBuffer, rasterizerDiscardEnable driver.VkBool32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetRasterizerDiscardEnable", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetRasterizerDiscardEnable(commandBuffer, rasterizerDiscardEnable)
+}
+
+func (d *Driver) VkCmdSetDepthBiasEnable(commandBuffer driver.VkCommandBuffer, depthBiasEnable driver.VkBool32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetDepthBiasEnable", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetDepthBiasEnable(commandBuffer, depthBiasEnable)
+}
+
+func (d *Driver) VkCmdSetPrimitiveRestartEnable(commandBuffer driver.VkCommandBuffer, primitiveRestartEnable driver.VkBool32) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdSetPrimitiveRestartEnable", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdSetPrimitiveRestartEnable(commandBuffer, primitiveRestartEnable)
+}
+
+func (d *Driver) VkCmdCopyBuffer2(commandBuffer driver.VkCommandBuffer, pCopyBufferInfo *driver.VkCopyBufferInfo2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdCopyBuffer2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdCopyBuffer2(commandBuffer, pCopyBufferInfo)
+}
+
+func (d *Driver) VkCmdCopyImage2(commandBuffer driver.VkCommandBuffer, pCopyImageInfo *driver.VkCopyImageInfo2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdCopyImage2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdCopyImage2(commandBuffer, pCopyImageInfo)
+}
+
+func (d *Driver) VkCmdCopyBufferToImage2(commandBuffer driver.VkCommandBuffer, pCopyBufferToImageInfo *driver.VkCopyBufferToImageInfo2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdCopyBufferToImage2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdCopyBufferToImage2(commandBuffer, pCopyBufferToImageInfo)
+}
+
+func (d *Driver) VkCmdCopyImageToBuffer2(commandBuffer driver.VkCommandBuffer, pCopyImageToBufferInfo *driver.VkCopyImageToBufferInfo2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdCopyImageToBuffer2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdCopyImageToBuffer2(commandBuffer, pCopyImageToBufferInfo)
+}
+
+func (d *Driver) VkCmdBlitImage2(commandBuffer driver.VkCommandBuffer, pBlitImageInfo *driver.VkBlitImageInfo2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdBlitImage2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdBlitImage2(commandBuffer, pBlitImageInfo)
+}
+
+func (d *Driver) VkCmdResolveImage2(commandBuffer driver.VkCommandBuffer, pResolveImageInfo *driver.VkResolveImageInfo2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(commandBuffer)})
+
+	release := d.mustAcquire("vkCmdResolveImage2", d.commandBufferHandles(commandBuffer))
+	defer release()
+
+	d.inner.VkCmdResolveImage2(commandBuffer, pResolveImageInfo)
+}
+
+func (d *Driver) VkGetDeviceBufferMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceBufferMemoryRequirements, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	d.inner.VkGetDeviceBufferMemoryRequirements(device, pInfo, pMemoryRequirements)
+}
+
+func (d *Driver) VkGetDeviceImageMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceImageMemoryRequirements, pMemoryRequirements *driver.VkMemoryRequirements2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	d.inner.VkGetDeviceImageMemoryRequirements(device, pInfo, pMemoryRequirements)
+}
+
+func (d *Driver) VkGetDeviceImageSparseMemoryRequirements(device driver.VkDevice, pInfo *driver.VkDeviceImageMemoryRequirements, pSparseMemoryRequirementCount *driver.Uint32, pSparseMemoryRequirements *driver.VkSparseImageMemoryRequirements2) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device)})
+
+	d.inner.VkGetDeviceImageSparseMemoryRequirements(device, pInfo, pSparseMemoryRequirementCount, pSparseMemoryRequirements)
+}
+
+func (d *Driver) VkCreatePrivateDataSlot(device driver.VkDevice, pCreateInfo *driver.VkPrivateDataSlotCreateInfo, pAllocator *driver.VkAllocationCallbacks, pPrivateDataSlot *driver.VkPrivateDataSlot) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkCreatePrivateDataSlot(device, pCreateInfo, pAllocator, pPrivateDataSlot)
+}
+
+func (d *Driver) VkDestroyPrivateDataSlot(device driver.VkDevice, privateDataSlot driver.VkPrivateDataSlot, pAllocator *driver.VkAllocationCallbacks) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(privateDataSlot)})
+
+	release := d.mustAcquire("vkDestroyPrivateDataSlot", []driver.VulkanHandle{driver.VulkanHandle(privateDataSlot)})
+	defer release()
+
+	d.inner.VkDestroyPrivateDataSlot(device, privateDataSlot, pAllocator)
+}
+
+func (d *Driver) VkSetPrivateData(device driver.VkDevice, objectType driver.VkObjectType, objectHandle driver.Uint64, privateDataSlot driver.VkPrivateDataSlot, data driver.Uint64) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(privateDataSlot)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkSetPrivateData(device, objectType, objectHandle, privateDataSlot, data)
+}
+
+func (d *Driver) VkGetPrivateData(device driver.VkDevice, objectType driver.VkObjectType, objectHandle driver.Uint64, privateDataSlot driver.VkPrivateDataSlot, pData *driver.Uint64) {
+	d.mustCheck([]driver.VulkanHandle{driver.VulkanHandle(device), driver.VulkanHandle(privateDataSlot)})
+
+	d.inner.VkGetPrivateData(device, objectType, objectHandle, privateDataSlot, pData)
+}
+
+func (d *Driver) VkGetPhysicalDeviceToolProperties(physicalDevice driver.VkPhysicalDevice, pToolCount *driver.Uint32, pToolProperties *driver.VkPhysicalDeviceToolProperties) (common.VkResult, error) {
+	err := d.check([]driver.VulkanHandle{driver.VulkanHandle(physicalDevice)})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return d.inner.VkGetPhysicalDeviceToolProperties(physicalDevice, pToolCount, pToolProperties)
+}
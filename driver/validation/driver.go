@@ -0,0 +1,126 @@
+package validation
+
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/driver"
+	"unsafe"
+)
+
+// Driver is a driver.Driver that checks that every handle passed to a Vulkan command refers to an
+// object that has not been destroyed, and that objects the Vulkan spec requires to be externally
+// synchronized are not used by two goroutines at once, before forwarding the command to another
+// driver.Driver. Because every wrapper method in core1_0, core1_1, and core1_2 calls its commands
+// through the driver.Driver it was created with, this validates the receiver and handle arguments
+// of each one.
+//
+// Objects are tracked by the wrapped driver's driver.VulkanObjectStore, which has lifetime
+// validation enabled by NewDriver. When a destroyed object is used, commands that return an error
+// return a *driver.ObjectDestroyedError, which includes the stack trace of the goroutine that
+// destroyed the object, without calling the wrapped driver. Commands that do not return an error
+// panic with it instead. Handles inside structures, such as the CommandBuffers of a SubmitInfo,
+// are not checked.
+//
+// While a command is in the wrapped driver, the Driver holds each of its externally-synchronized
+// handles, such as the queue passed to vkQueueSubmit, or the command buffer passed to a vkCmd
+// command along with the command pool it was allocated from. A command that uses a handle held by
+// a command on another goroutine fails with a *ConcurrentUseError, in the same way as a command
+// that uses a destroyed object. Like the race detector, this only detects misuse that actually
+// overlaps, so tests that exercise concurrent code should be run several times.
+type Driver struct {
+	inner driver.Driver
+	sync  *syncTracker
+}
+
+var _ driver.Driver = &Driver{}
+
+// NewDriver creates a Driver that validates calls to inner, and enables lifetime validation on
+// inner's driver.VulkanObjectStore
+func NewDriver(inner driver.Driver) *Driver {
+	return newDriver(inner, newSyncTracker())
+}
+
+func newDriver(inner driver.Driver, sync *syncTracker) *Driver {
+	inner.ObjectStore().EnableLifetimeValidation()
+
+	return &Driver{
+		inner: inner,
+		sync:  sync,
+	}
+}
+
+func (d *Driver) Destroy() {
+	d.inner.Destroy()
+}
+
+func (d *Driver) CreateInstanceDriver(instance driver.VkInstance) (driver.Driver, error) {
+	instanceDriver, err := d.inner.CreateInstanceDriver(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDriver(instanceDriver, d.sync), nil
+}
+
+func (d *Driver) CreateDeviceDriver(device driver.VkDevice) (driver.Driver, error) {
+	deviceDriver, err := d.inner.CreateDeviceDriver(device)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDriver(deviceDriver, d.sync), nil
+}
+
+func (d *Driver) LoadProcAddr(name *driver.Char) unsafe.Pointer {
+	return d.inner.LoadProcAddr(name)
+}
+
+func (d *Driver) Version() common.APIVersion {
+	return d.inner.Version()
+}
+
+func (d *Driver) HasCommand(name string) bool {
+	return d.inner.HasCommand(name)
+}
+
+func (d *Driver) ObjectStore() *driver.VulkanObjectStore {
+	return d.inner.ObjectStore()
+}
+
+func handleSlice[T ~uintptr](handles *T, count int) []driver.VulkanHandle {
+	if handles == nil {
+		return nil
+	}
+
+	result := make([]driver.VulkanHandle, 0, count)
+	for _, handle := range unsafe.Slice(handles, count) {
+		result = append(result, driver.VulkanHandle(handle))
+	}
+
+	return result
+}
+
+func (d *Driver) check(handleSets ...[]driver.VulkanHandle) error {
+	store := d.inner.ObjectStore()
+
+	for _, handles := range handleSets {
+		for _, handle := range handles {
+			if handle == driver.NullHandle {
+				continue
+			}
+
+			err := store.CheckLive(handle)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (d *Driver) mustCheck(handleSets ...[]driver.VulkanHandle) {
+	err := d.check(handleSets...)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,147 @@
+package validation_test
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"github.com/vkngwrapper/core/v2/driver/validation"
+	"testing"
+)
+
+func createDevice(t *testing.T, inner driver.Driver) core1_0.Device {
+	loader, err := core.CreateLoaderFromDriver(validation.NewDriver(inner))
+	require.NoError(t, err)
+
+	instance, _, err := loader.CreateInstance(nil, core1_0.InstanceCreateInfo{
+		APIVersion: common.Vulkan1_2,
+	})
+	require.NoError(t, err)
+
+	physicalDevices, _, err := instance.EnumeratePhysicalDevices()
+	require.NoError(t, err)
+
+	device, _, err := physicalDevices[0].CreateDevice(nil, core1_0.DeviceCreateInfo{
+		QueueCreateInfos: []core1_0.DeviceQueueCreateInfo{
+			{
+				QueueFamilyIndex: 0,
+				QueuePriorities:  []float32{1},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	return device
+}
+
+func destroyedPanic(t *testing.T, f func()) *driver.ObjectDestroyedError {
+	var recovered any
+	func() {
+		defer func() {
+			recovered = recover()
+		}()
+		f()
+	}()
+
+	require.NotNil(t, recovered)
+	err, ok := recovered.(*driver.ObjectDestroyedError)
+	require.True(t, ok)
+	return err
+}
+
+func TestDriver_UseAfterDestroy(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	device := createDevice(t, fakeDriver)
+
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:  256,
+		Usage: core1_0.BufferUsageVertexBuffer,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, buffer.MemoryRequirements())
+
+	buffer.Destroy(nil)
+
+	destroyed := destroyedPanic(t, func() {
+		buffer.MemoryRequirements()
+	})
+	require.Equal(t, driver.VulkanHandle(buffer.Handle()), destroyed.Handle)
+	require.Equal(t, "*core1_0.VulkanBuffer", destroyed.Object)
+	require.Contains(t, destroyed.DestroyedAt, "TestDriver_UseAfterDestroy")
+	require.Contains(t, destroyed.Error(), "was used after it was destroyed")
+
+	// The destroyed buffer never reached the fake driver
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestDriver_HandleArguments(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	device := createDevice(t, fakeDriver)
+
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:  256,
+		Usage: core1_0.BufferUsageVertexBuffer,
+	})
+	require.NoError(t, err)
+
+	commandPool, _, err := device.CreateCommandPool(nil, core1_0.CommandPoolCreateInfo{})
+	require.NoError(t, err)
+
+	commandBuffers, _, err := device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        commandPool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 1,
+	})
+	require.NoError(t, err)
+
+	_, err = commandBuffers[0].Begin(core1_0.CommandBufferBeginInfo{})
+	require.NoError(t, err)
+
+	buffer.Destroy(nil)
+	destroyed := destroyedPanic(t, func() {
+		commandBuffers[0].CmdBindVertexBuffers(0, []core1_0.Buffer{buffer}, []int{0})
+	})
+	require.Equal(t, driver.VulkanHandle(buffer.Handle()), destroyed.Handle)
+
+	_, err = commandBuffers[0].End()
+	require.NoError(t, err)
+
+	fence, _, err := device.CreateFence(nil, core1_0.FenceCreateInfo{})
+	require.NoError(t, err)
+	fence.Destroy(nil)
+
+	res, err := device.GetQueue(0, 0).Submit(fence, []core1_0.SubmitInfo{
+		{CommandBuffers: commandBuffers},
+	})
+	require.Equal(t, core1_0.VKErrorUnknown, res)
+
+	var destroyedErr *driver.ObjectDestroyedError
+	require.True(t, errors.As(err, &destroyedErr))
+	require.Equal(t, driver.VulkanHandle(fence.Handle()), destroyedErr.Handle)
+	require.Empty(t, fakeDriver.Submissions())
+}
+
+func TestDriver_DestroyedWithParent(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	device := createDevice(t, fakeDriver)
+
+	commandPool, _, err := device.CreateCommandPool(nil, core1_0.CommandPoolCreateInfo{})
+	require.NoError(t, err)
+
+	commandBuffers, _, err := device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        commandPool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 1,
+	})
+	require.NoError(t, err)
+
+	commandPool.Destroy(nil)
+
+	_, err = commandBuffers[0].Begin(core1_0.CommandBufferBeginInfo{})
+	var destroyedErr *driver.ObjectDestroyedError
+	require.True(t, errors.As(err, &destroyedErr))
+	require.Equal(t, "*core1_0.VulkanCommandBuffer", destroyedErr.Object)
+}
@@ -0,0 +1,145 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/vkngwrapper/core/v2/driver"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+)
+
+// ConcurrentUseError is returned by a validation Driver when a Vulkan command uses an object that
+// must be externally synchronized while another goroutine is using it
+type ConcurrentUseError struct {
+	// Handle is the handle that was used by both commands
+	Handle driver.VulkanHandle
+	// Function is the name of the Vulkan command that failed, i.e. "vkCmdDraw"
+	Function string
+	// Stack is the stack trace of the goroutine that called Function
+	Stack string
+	// OtherFunction is the name of the Vulkan command that was using Handle on another goroutine
+	OtherFunction string
+	// OtherStack is the stack trace of the goroutine that called OtherFunction, as of the time it
+	// called it
+	OtherStack string
+}
+
+func (e *ConcurrentUseError) Error() string {
+	return fmt.Sprintf("%s used 0x%x while %s was using it on another goroutine, but it must be externally synchronized\n\n%s call:\n%s\n%s call:\n%s",
+		e.Function, uint64(e.Handle), e.OtherFunction, e.Function, e.Stack, e.OtherFunction, e.OtherStack)
+}
+
+type syncUse struct {
+	function string
+	callers  []uintptr
+}
+
+func (u *syncUse) stack() string {
+	var builder strings.Builder
+	frames := runtime.CallersFrames(u.callers)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&builder, "%s()\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+
+	return builder.String()
+}
+
+type syncTracker struct {
+	lock sync.Mutex
+	held map[driver.VulkanHandle]*syncUse
+}
+
+func newSyncTracker() *syncTracker {
+	return &syncTracker{
+		held: make(map[driver.VulkanHandle]*syncUse),
+	}
+}
+
+func (t *syncTracker) release(handles []driver.VulkanHandle) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, handle := range handles {
+		delete(t.held, handle)
+	}
+}
+
+func (d *Driver) acquire(function string, handleSets ...[]driver.VulkanHandle) (func(), error) {
+	// Stack traces are expensive, so only record the caller's program counters until another
+	// goroutine needs them
+	callers := make([]uintptr, 32)
+	callers = callers[:runtime.Callers(2, callers)]
+	use := &syncUse{function: function, callers: callers}
+
+	d.sync.lock.Lock()
+	defer d.sync.lock.Unlock()
+
+	var acquired []driver.VulkanHandle
+	for _, handles := range handleSets {
+		for _, handle := range handles {
+			if handle == driver.NullHandle {
+				continue
+			}
+
+			other, isHeld := d.sync.held[handle]
+			if isHeld && other == use {
+				continue
+			} else if isHeld {
+				for _, acquiredHandle := range acquired {
+					delete(d.sync.held, acquiredHandle)
+				}
+
+				return nil, &ConcurrentUseError{
+					Handle:        handle,
+					Function:      function,
+					Stack:         string(debug.Stack()),
+					OtherFunction: other.function,
+					OtherStack:    other.stack(),
+				}
+			}
+
+			d.sync.held[handle] = use
+			acquired = append(acquired, handle)
+		}
+	}
+
+	return func() { d.sync.release(acquired) }, nil
+}
+
+func (d *Driver) mustAcquire(function string, handleSets ...[]driver.VulkanHandle) func() {
+	release, err := d.acquire(function, handleSets...)
+	if err != nil {
+		panic(err)
+	}
+
+	return release
+}
+
+// commandBufferHandles returns commandBuffer along with the command pool it was allocated from,
+// since recording into a command buffer requires access to its pool to be externally synchronized
+func (d *Driver) commandBufferHandles(commandBuffer driver.VkCommandBuffer) []driver.VulkanHandle {
+	handles := []driver.VulkanHandle{driver.VulkanHandle(commandBuffer)}
+
+	commandPool, hasPool := d.inner.ObjectStore().Parent(driver.VulkanHandle(commandBuffer))
+	if hasPool {
+		handles = append(handles, commandPool)
+	}
+
+	return handles
+}
+
+// structHandle reads the handle in field out of the Vulkan structure that info points to
+func structHandle(info any, field string) driver.VulkanHandle {
+	value := reflect.ValueOf(info)
+	if value.IsNil() {
+		return driver.NullHandle
+	}
+
+	return driver.VulkanHandle(value.Elem().FieldByName(field).Pointer())
+}
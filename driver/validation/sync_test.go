@@ -0,0 +1,172 @@
+package validation_test
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"github.com/vkngwrapper/core/v2/driver/validation"
+	"testing"
+)
+
+// blockingDriver holds vkQueueWaitIdle and vkEndCommandBuffer inside the driver until unblock is
+// closed, so that tests can reliably overlap them with other commands
+type blockingDriver struct {
+	driver.Driver
+	entered chan struct{}
+	unblock chan struct{}
+}
+
+func newBlockingDriver(inner driver.Driver) *blockingDriver {
+	return &blockingDriver{
+		Driver:  inner,
+		entered: make(chan struct{}),
+		unblock: make(chan struct{}),
+	}
+}
+
+func (d *blockingDriver) CreateInstanceDriver(instance driver.VkInstance) (driver.Driver, error) {
+	instanceDriver, err := d.Driver.CreateInstanceDriver(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockingDriver{Driver: instanceDriver, entered: d.entered, unblock: d.unblock}, nil
+}
+
+func (d *blockingDriver) CreateDeviceDriver(device driver.VkDevice) (driver.Driver, error) {
+	deviceDriver, err := d.Driver.CreateDeviceDriver(device)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockingDriver{Driver: deviceDriver, entered: d.entered, unblock: d.unblock}, nil
+}
+
+func (d *blockingDriver) VkQueueWaitIdle(queue driver.VkQueue) (common.VkResult, error) {
+	d.entered <- struct{}{}
+	<-d.unblock
+	return d.Driver.VkQueueWaitIdle(queue)
+}
+
+func (d *blockingDriver) VkEndCommandBuffer(commandBuffer driver.VkCommandBuffer) (common.VkResult, error) {
+	d.entered <- struct{}{}
+	<-d.unblock
+	return d.Driver.VkEndCommandBuffer(commandBuffer)
+}
+
+func concurrentUsePanic(t *testing.T, f func()) *validation.ConcurrentUseError {
+	var recovered any
+	func() {
+		defer func() {
+			recovered = recover()
+		}()
+		f()
+	}()
+
+	require.NotNil(t, recovered)
+	err, ok := recovered.(*validation.ConcurrentUseError)
+	require.True(t, ok)
+	return err
+}
+
+func TestDriver_ConcurrentQueueUse(t *testing.T) {
+	blocking := newBlockingDriver(fake.NewDriver(fake.Config{}))
+	device := createDevice(t, blocking)
+	queue := device.GetQueue(0, 0)
+
+	waitErr := make(chan error)
+	go func() {
+		_, err := queue.WaitIdle()
+		waitErr <- err
+	}()
+	<-blocking.entered
+
+	_, err := queue.Submit(nil, []core1_0.SubmitInfo{})
+	require.Error(t, err)
+
+	var concurrentUse *validation.ConcurrentUseError
+	require.True(t, errors.As(err, &concurrentUse))
+	require.Equal(t, driver.VulkanHandle(queue.Handle()), concurrentUse.Handle)
+	require.Equal(t, "vkQueueSubmit", concurrentUse.Function)
+	require.Equal(t, "vkQueueWaitIdle", concurrentUse.OtherFunction)
+	require.Contains(t, concurrentUse.Stack, "TestDriver_ConcurrentQueueUse")
+	require.Contains(t, concurrentUse.OtherStack, "TestDriver_ConcurrentQueueUse.func1")
+	require.Contains(t, concurrentUse.OtherStack, "core1_0.(*VulkanQueue).WaitIdle")
+
+	close(blocking.unblock)
+	require.NoError(t, <-waitErr)
+
+	// Once WaitIdle has returned, the queue is free again
+	_, err = queue.Submit(nil, []core1_0.SubmitInfo{})
+	require.NoError(t, err)
+}
+
+func TestDriver_ConcurrentCommandPoolUse(t *testing.T) {
+	blocking := newBlockingDriver(fake.NewDriver(fake.Config{}))
+	device := createDevice(t, blocking)
+
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:  256,
+		Usage: core1_0.BufferUsageTransferDst,
+	})
+	require.NoError(t, err)
+
+	sharedPool, _, err := device.CreateCommandPool(nil, core1_0.CommandPoolCreateInfo{})
+	require.NoError(t, err)
+
+	commandBuffers, _, err := device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        sharedPool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 2,
+	})
+	require.NoError(t, err)
+
+	otherPool, _, err := device.CreateCommandPool(nil, core1_0.CommandPoolCreateInfo{})
+	require.NoError(t, err)
+
+	otherCommandBuffers, _, err := device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        otherPool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 1,
+	})
+	require.NoError(t, err)
+
+	for _, commandBuffer := range append(commandBuffers, otherCommandBuffers...) {
+		_, err = commandBuffer.Begin(core1_0.CommandBufferBeginInfo{})
+		require.NoError(t, err)
+	}
+
+	endErr := make(chan error)
+	go func() {
+		_, err := commandBuffers[0].End()
+		endErr <- err
+	}()
+	<-blocking.entered
+
+	// Recording into a different command buffer from the same pool is still a conflict
+	concurrentUse := concurrentUsePanic(t, func() {
+		commandBuffers[1].CmdFillBuffer(buffer, 0, 256, 0)
+	})
+	require.Equal(t, driver.VulkanHandle(sharedPool.Handle()), concurrentUse.Handle)
+	require.Equal(t, "vkCmdFillBuffer", concurrentUse.Function)
+	require.Equal(t, "vkEndCommandBuffer", concurrentUse.OtherFunction)
+	require.Contains(t, concurrentUse.Error(), "must be externally synchronized")
+
+	_, _, err = device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        sharedPool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 1,
+	})
+	require.True(t, errors.As(err, &concurrentUse))
+
+	// A command buffer from another pool is unaffected
+	otherCommandBuffers[0].CmdFillBuffer(buffer, 0, 256, 0)
+
+	close(blocking.unblock)
+	require.NoError(t, <-endErr)
+
+	commandBuffers[1].CmdFillBuffer(buffer, 0, 256, 0)
+}
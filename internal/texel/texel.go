@@ -0,0 +1,169 @@
+// Package texel describes how the texels of uncompressed formats are laid out in a Buffer by
+// CmdCopyImageToBuffer and CmdCopyBufferToImage. It is shared by the memory package and the fake
+// driver, which must agree on the layout.
+package texel
+
+import "github.com/vkngwrapper/core/v2/core1_0"
+
+// colorTexelSizes is the size in bytes of a single texel of each uncompressed color format
+var colorTexelSizes = map[core1_0.Format]int{
+	core1_0.FormatR4G4UnsignedNormalizedPacked:        1,
+	core1_0.FormatR4G4B4A4UnsignedNormalizedPacked:    2,
+	core1_0.FormatB4G4R4A4UnsignedNormalizedPacked:    2,
+	core1_0.FormatR5G6B5UnsignedNormalizedPacked:      2,
+	core1_0.FormatB5G6R5UnsignedNormalizedPacked:      2,
+	core1_0.FormatR5G5B5A1UnsignedNormalizedPacked:    2,
+	core1_0.FormatB5G5R5A1UnsignedNormalizedPacked:    2,
+	core1_0.FormatA1R5G5B5UnsignedNormalizedPacked:    2,
+	core1_0.FormatR8UnsignedNormalized:                1,
+	core1_0.FormatR8SignedNormalized:                  1,
+	core1_0.FormatR8UnsignedScaled:                    1,
+	core1_0.FormatR8SignedScaled:                      1,
+	core1_0.FormatR8UnsignedInt:                       1,
+	core1_0.FormatR8SignedInt:                         1,
+	core1_0.FormatR8SRGB:                              1,
+	core1_0.FormatR8G8UnsignedNormalized:              2,
+	core1_0.FormatR8G8SignedNormalized:                2,
+	core1_0.FormatR8G8UnsignedScaled:                  2,
+	core1_0.FormatR8G8SignedScaled:                    2,
+	core1_0.FormatR8G8UnsignedInt:                     2,
+	core1_0.FormatR8G8SignedInt:                       2,
+	core1_0.FormatR8G8SRGB:                            2,
+	core1_0.FormatR8G8B8UnsignedNormalized:            3,
+	core1_0.FormatR8G8B8SignedNormalized:              3,
+	core1_0.FormatR8G8B8UnsignedScaled:                3,
+	core1_0.FormatR8G8B8SignedScaled:                  3,
+	core1_0.FormatR8G8B8UnsignedInt:                   3,
+	core1_0.FormatR8G8B8SignedInt:                     3,
+	core1_0.FormatR8G8B8SRGB:                          3,
+	core1_0.FormatB8G8R8UnsignedNormalized:            3,
+	core1_0.FormatB8G8R8SignedNormalized:              3,
+	core1_0.FormatB8G8R8UnsignedScaled:                3,
+	core1_0.FormatB8G8R8SignedScaled:                  3,
+	core1_0.FormatB8G8R8UnsignedInt:                   3,
+	core1_0.FormatB8G8R8SignedInt:                     3,
+	core1_0.FormatB8G8R8SRGB:                          3,
+	core1_0.FormatR8G8B8A8UnsignedNormalized:          4,
+	core1_0.FormatR8G8B8A8SignedNormalized:            4,
+	core1_0.FormatR8G8B8A8UnsignedScaled:              4,
+	core1_0.FormatR8G8B8A8SignedScaled:                4,
+	core1_0.FormatR8G8B8A8UnsignedInt:                 4,
+	core1_0.FormatR8G8B8A8SignedInt:                   4,
+	core1_0.FormatR8G8B8A8SRGB:                        4,
+	core1_0.FormatB8G8R8A8UnsignedNormalized:          4,
+	core1_0.FormatB8G8R8A8SignedNormalized:            4,
+	core1_0.FormatB8G8R8A8UnsignedScaled:              4,
+	core1_0.FormatB8G8R8A8SignedScaled:                4,
+	core1_0.FormatB8G8R8A8UnsignedInt:                 4,
+	core1_0.FormatB8G8R8A8SignedInt:                   4,
+	core1_0.FormatB8G8R8A8SRGB:                        4,
+	core1_0.FormatA8B8G8R8UnsignedNormalizedPacked:    4,
+	core1_0.FormatA8B8G8R8SignedNormalizedPacked:      4,
+	core1_0.FormatA8B8G8R8UnsignedScaledPacked:        4,
+	core1_0.FormatA8B8G8R8SignedScaledPacked:          4,
+	core1_0.FormatA8B8G8R8UnsignedIntPacked:           4,
+	core1_0.FormatA8B8G8R8SignedIntPacked:             4,
+	core1_0.FormatA8B8G8R8SRGBPacked:                  4,
+	core1_0.FormatA2R10G10B10UnsignedNormalizedPacked: 4,
+	core1_0.FormatA2R10G10B10SignedNormalizedPacked:   4,
+	core1_0.FormatA2R10G10B10UnsignedScaledPacked:     4,
+	core1_0.FormatA2R10G10B10SignedScaledPacked:       4,
+	core1_0.FormatA2R10G10B10UnsignedIntPacked:        4,
+	core1_0.FormatA2R10G10B10SignedIntPacked:          4,
+	core1_0.FormatA2B10G10R10UnsignedNormalizedPacked: 4,
+	core1_0.FormatA2B10G10R10SignedNormalizedPacked:   4,
+	core1_0.FormatA2B10G10R10UnsignedScaledPacked:     4,
+	core1_0.FormatA2B10G10R10SignedScaledPacked:       4,
+	core1_0.FormatA2B10G10R10UnsignedIntPacked:        4,
+	core1_0.FormatA2B10G10R10SignedIntPacked:          4,
+	core1_0.FormatR16UnsignedNormalized:               2,
+	core1_0.FormatR16SignedNormalized:                 2,
+	core1_0.FormatR16UnsignedScaled:                   2,
+	core1_0.FormatR16SignedScaled:                     2,
+	core1_0.FormatR16UnsignedInt:                      2,
+	core1_0.FormatR16SignedInt:                        2,
+	core1_0.FormatR16SignedFloat:                      2,
+	core1_0.FormatR16G16UnsignedNormalized:            4,
+	core1_0.FormatR16G16SignedNormalized:              4,
+	core1_0.FormatR16G16UnsignedScaled:                4,
+	core1_0.FormatR16G16SignedScaled:                  4,
+	core1_0.FormatR16G16UnsignedInt:                   4,
+	core1_0.FormatR16G16SignedInt:                     4,
+	core1_0.FormatR16G16SignedFloat:                   4,
+	core1_0.FormatR16G16B16UnsignedNormalized:         6,
+	core1_0.FormatR16G16B16SignedNormalized:           6,
+	core1_0.FormatR16G16B16UnsignedScaled:             6,
+	core1_0.FormatR16G16B16SignedScaled:               6,
+	core1_0.FormatR16G16B16UnsignedInt:                6,
+	core1_0.FormatR16G16B16SignedInt:                  6,
+	core1_0.FormatR16G16B16SignedFloat:                6,
+	core1_0.FormatR16G16B16A16UnsignedNormalized:      8,
+	core1_0.FormatR16G16B16A16SignedNormalized:        8,
+	core1_0.FormatR16G16B16A16UnsignedScaled:          8,
+	core1_0.FormatR16G16B16A16SignedScaled:            8,
+	core1_0.FormatR16G16B16A16UnsignedInt:             8,
+	core1_0.FormatR16G16B16A16SignedInt:               8,
+	core1_0.FormatR16G16B16A16SignedFloat:             8,
+	core1_0.FormatR32UnsignedInt:                      4,
+	core1_0.FormatR32SignedInt:                        4,
+	core1_0.FormatR32SignedFloat:                      4,
+	core1_0.FormatR32G32UnsignedInt:                   8,
+	core1_0.FormatR32G32SignedInt:                     8,
+	core1_0.FormatR32G32SignedFloat:                   8,
+	core1_0.FormatR32G32B32UnsignedInt:                12,
+	core1_0.FormatR32G32B32SignedInt:                  12,
+	core1_0.FormatR32G32B32SignedFloat:                12,
+	core1_0.FormatR32G32B32A32UnsignedInt:             16,
+	core1_0.FormatR32G32B32A32SignedInt:               16,
+	core1_0.FormatR32G32B32A32SignedFloat:             16,
+	core1_0.FormatR64UnsignedInt:                      8,
+	core1_0.FormatR64SignedInt:                        8,
+	core1_0.FormatR64SignedFloat:                      8,
+	core1_0.FormatR64G64UnsignedInt:                   16,
+	core1_0.FormatR64G64SignedInt:                     16,
+	core1_0.FormatR64G64SignedFloat:                   16,
+	core1_0.FormatR64G64B64UnsignedInt:                24,
+	core1_0.FormatR64G64B64SignedInt:                  24,
+	core1_0.FormatR64G64B64SignedFloat:                24,
+	core1_0.FormatR64G64B64A64UnsignedInt:             32,
+	core1_0.FormatR64G64B64A64SignedInt:               32,
+	core1_0.FormatR64G64B64A64SignedFloat:             32,
+	core1_0.FormatB10G11R11UnsignedFloatPacked:        4,
+	core1_0.FormatE5B9G9R9UnsignedFloatPacked:         4,
+}
+
+// depthTexelSizes is the size in bytes of a single texel of the depth aspect of each depth format,
+// as it is laid out in a Buffer by CmdCopyImageToBuffer and CmdCopyBufferToImage
+var depthTexelSizes = map[core1_0.Format]int{
+	core1_0.FormatD16UnsignedNormalized:              2,
+	core1_0.FormatD24X8UnsignedNormalizedPacked:      4,
+	core1_0.FormatD32SignedFloat:                     4,
+	core1_0.FormatD16UnsignedNormalizedS8UnsignedInt: 2,
+	core1_0.FormatD24UnsignedNormalizedS8UnsignedInt: 4,
+	core1_0.FormatD32SignedFloatS8UnsignedInt:        4,
+}
+
+// stencilFormats is the set of formats that have a stencil aspect
+var stencilFormats = map[core1_0.Format]struct{}{
+	core1_0.FormatS8UnsignedInt:                      {},
+	core1_0.FormatD16UnsignedNormalizedS8UnsignedInt: {},
+	core1_0.FormatD24UnsignedNormalizedS8UnsignedInt: {},
+	core1_0.FormatD32SignedFloatS8UnsignedInt:        {},
+}
+
+// Size returns the size in bytes of a single texel of one aspect of an uncompressed format. It
+// returns 0 if the format is compressed, multi-planar, or does not have the requested aspect.
+func Size(format core1_0.Format, aspect core1_0.ImageAspectFlags) int {
+	switch aspect {
+	case core1_0.ImageAspectColor:
+		return colorTexelSizes[format]
+	case core1_0.ImageAspectDepth:
+		return depthTexelSizes[format]
+	case core1_0.ImageAspectStencil:
+		if _, ok := stencilFormats[format]; ok {
+			return 1
+		}
+	}
+
+	return 0
+}
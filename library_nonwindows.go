@@ -0,0 +1,49 @@
+//go:build !windows
+
+package core
+
+/*
+#cgo linux LDFLAGS: -ldl
+#include <dlfcn.h>
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"github.com/cockroachdb/errors"
+	"unsafe"
+)
+
+// CreateLoaderFromLibrary generates a Loader from the Vulkan loader at path, i.e. "libvulkan.so.1",
+// which is opened with dlopen. path may be a file name, which is searched for in the same way as
+// dlopen, or a path to a specific library. The library is never closed.
+//
+// An error is returned if the library cannot be opened, or if it does not export vkGetInstanceProcAddr.
+func CreateLoaderFromLibrary(path string) (*VulkanLoader, error) {
+	procAddr, err := loadLibraryProcAddr(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return CreateLoaderFromProcAddr(procAddr)
+}
+
+func loadLibraryProcAddr(path string) (unsafe.Pointer, error) {
+	libraryName := C.CString(path)
+	defer C.free(unsafe.Pointer(libraryName))
+
+	library := C.dlopen(libraryName, C.RTLD_NOW|C.RTLD_LOCAL)
+	if library == nil {
+		return nil, errors.Newf("could not load the Vulkan library %q: %s", path, C.GoString(C.dlerror()))
+	}
+
+	procName := C.CString("vkGetInstanceProcAddr")
+	defer C.free(unsafe.Pointer(procName))
+
+	procAddr := C.dlsym(library, procName)
+	if procAddr == nil {
+		C.dlclose(library)
+		return nil, errors.Newf("the library %q does not export vkGetInstanceProcAddr, and is not a Vulkan loader", path)
+	}
+
+	return procAddr, nil
+}
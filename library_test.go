@@ -0,0 +1,228 @@
+//go:build !windows
+
+package core_test
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/core1_3"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/internal/dummies"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"unsafe"
+)
+
+func buildSharedLibrary(t *testing.T, source string) string {
+	compiler := os.Getenv("CC")
+	if compiler == "" {
+		compiler = "cc"
+	}
+
+	_, err := exec.LookPath(compiler)
+	if err != nil {
+		t.Skipf("a C compiler is required to build %s", source)
+	}
+
+	library := filepath.Join(t.TempDir(), "lib.so")
+	output, err := exec.Command(compiler, "-shared", "-fPIC", "-o", library, source).CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	return library
+}
+
+func TestCreateLoaderFromLibrary(t *testing.T) {
+	library := buildSharedLibrary(t, filepath.Join("testdata", "stub_vulkan.c"))
+
+	loader, err := core.CreateLoaderFromLibrary(library)
+	require.NoError(t, err)
+	require.Equal(t, common.Vulkan1_2, loader.APIVersion())
+	require.True(t, loader.Driver().HasCommand("vkEnumerateInstanceVersion"))
+	require.False(t, loader.Driver().HasCommand("vkCreateInstance"))
+
+	_, _, err = loader.CreateInstance(nil, core1_0.InstanceCreateInfo{})
+	require.True(t, errors.Is(err, driver.ErrMissingCommand))
+}
+
+func TestCreateLoaderFromLibrary_Missing(t *testing.T) {
+	_, err := core.CreateLoaderFromLibrary(filepath.Join(t.TempDir(), "libvulkan.so.1"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "could not load the Vulkan library")
+}
+
+func TestCreateLoaderFromLibrary_NotVulkan(t *testing.T) {
+	library := buildSharedLibrary(t, filepath.Join("testdata", "not_vulkan.c"))
+
+	_, err := core.CreateLoaderFromLibrary(library)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not export vkGetInstanceProcAddr")
+}
+
+// stubObject mirrors the command buffers and queues handed out by testdata/stub_vulkan_extensions.c
+type stubObject struct {
+	CommandCount uint32
+	LastCommand  [64]byte
+	RenderArea   struct {
+		X, Y          int32
+		Width, Height uint32
+	}
+}
+
+func (b *stubObject) lastCommand() string {
+	for i, c := range b.LastCommand {
+		if c == 0 {
+			return string(b.LastCommand[:i])
+		}
+	}
+
+	return string(b.LastCommand[:])
+}
+
+// createStubExtensionDevice creates a core 1.2 Device from testdata/stub_vulkan_extensions.c that
+// enables the provided extensions
+func createStubExtensionDevice(t *testing.T, extensionNames ...string) core1_0.Device {
+	library := buildSharedLibrary(t, filepath.Join("testdata", "stub_vulkan_extensions.c"))
+
+	loader, err := core.CreateLoaderFromLibrary(library)
+	require.NoError(t, err)
+	instance, _, err := loader.CreateInstance(nil, core1_0.InstanceCreateInfo{APIVersion: common.Vulkan1_3})
+	require.NoError(t, err)
+	physicalDevices, _, err := instance.EnumeratePhysicalDevices()
+	require.NoError(t, err)
+	require.Len(t, physicalDevices, 1)
+
+	device, _, err := physicalDevices[0].CreateDevice(nil, core1_0.DeviceCreateInfo{
+		QueueCreateInfos: []core1_0.DeviceQueueCreateInfo{
+			{QueueFamilyIndex: 0, QueuePriorities: []float32{1}},
+		},
+		EnabledExtensionNames: extensionNames,
+	})
+	require.NoError(t, err)
+	require.Equal(t, common.Vulkan1_2, device.APIVersion())
+
+	return device
+}
+
+func createStubCommandBuffer(t *testing.T, device core1_0.Device) (core1_0.CommandBuffer, *stubObject) {
+	commandPool, _, err := device.CreateCommandPool(nil, core1_0.CommandPoolCreateInfo{})
+	require.NoError(t, err)
+	commandBuffers, _, err := device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        commandPool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 1,
+	})
+	require.NoError(t, err)
+
+	return commandBuffers[0], (*stubObject)(unsafe.Pointer(commandBuffers[0].Handle()))
+}
+
+func TestCreateLoaderFromLibrary_DynamicRenderingExtension(t *testing.T) {
+	device := createStubExtensionDevice(t, "VK_KHR_dynamic_rendering")
+	require.True(t, device.Driver().HasCommand("vkCmdBeginRendering"))
+	require.True(t, device.Driver().HasCommand("vkCmdEndRendering"))
+
+	baseBuffer, stub := createStubCommandBuffer(t, device)
+	require.Nil(t, core1_3.PromoteCommandBuffer(baseBuffer))
+
+	commandBuffer := core1_3.PromoteCommandBufferFromExtensions(baseBuffer, device)
+	require.NotNil(t, commandBuffer)
+
+	err := commandBuffer.CmdBeginRendering(core1_3.RenderingInfo{
+		RenderArea: core1_0.Rect2D{Offset: core1_0.Offset2D{X: 1, Y: 3}, Extent: core1_0.Extent2D{Width: 5, Height: 7}},
+		LayerCount: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "vkCmdBeginRenderingKHR", stub.lastCommand())
+	require.Equal(t, int32(1), stub.RenderArea.X)
+	require.Equal(t, int32(3), stub.RenderArea.Y)
+	require.Equal(t, uint32(5), stub.RenderArea.Width)
+	require.Equal(t, uint32(7), stub.RenderArea.Height)
+
+	commandBuffer.CmdEndRendering()
+	require.Equal(t, "vkCmdEndRenderingKHR", stub.lastCommand())
+	require.Equal(t, uint32(2), stub.CommandCount)
+	require.Equal(t, 2, commandBuffer.CommandsRecorded())
+}
+
+func TestCreateLoaderFromLibrary_Synchronization2Extension(t *testing.T) {
+	device := createStubExtensionDevice(t, "VK_KHR_synchronization2")
+
+	baseBuffer, stub := createStubCommandBuffer(t, device)
+	commandBuffer := core1_3.PromoteCommandBufferFromExtensions(baseBuffer, device)
+	require.NotNil(t, commandBuffer)
+
+	err := commandBuffer.CmdPipelineBarrier2(core1_3.DependencyInfo{})
+	require.NoError(t, err)
+	require.Equal(t, "vkCmdPipelineBarrier2KHR", stub.lastCommand())
+
+	baseQueue := device.GetQueue(0, 0)
+	require.Nil(t, core1_3.PromoteQueue(baseQueue))
+	queue := core1_3.PromoteQueueFromExtensions(baseQueue, device)
+	require.NotNil(t, queue)
+
+	_, err = queue.Submit2(nil, []core1_3.SubmitInfo2{{}})
+	require.NoError(t, err)
+	require.Equal(t, "vkQueueSubmit2KHR", (*stubObject)(unsafe.Pointer(queue.Handle())).lastCommand())
+}
+
+func TestCreateLoaderFromLibrary_ExtendedDynamicStateExtensions(t *testing.T) {
+	device := createStubExtensionDevice(t, "VK_EXT_extended_dynamic_state", "VK_EXT_extended_dynamic_state2")
+
+	baseBuffer, stub := createStubCommandBuffer(t, device)
+	commandBuffer := core1_3.PromoteCommandBufferFromExtensions(baseBuffer, device)
+	require.NotNil(t, commandBuffer)
+
+	commandBuffer.CmdSetCullMode(core1_0.CullModeBack)
+	require.Equal(t, "vkCmdSetCullModeEXT", stub.lastCommand())
+
+	commandBuffer.CmdSetPrimitiveRestartEnable(true)
+	require.Equal(t, "vkCmdSetPrimitiveRestartEnableEXT", stub.lastCommand())
+	require.Equal(t, uint32(2), stub.CommandCount)
+}
+
+func TestCreateLoaderFromLibrary_CopyCommands2Extension(t *testing.T) {
+	device := createStubExtensionDevice(t, "VK_KHR_copy_commands2")
+
+	baseBuffer, stub := createStubCommandBuffer(t, device)
+	commandBuffer := core1_3.PromoteCommandBufferFromExtensions(baseBuffer, device)
+	require.NotNil(t, commandBuffer)
+
+	err := commandBuffer.CmdCopyBuffer2(core1_3.CopyBufferInfo2{
+		SrcBuffer: dummies.EasyDummyBuffer(device.Driver(), device),
+		DstBuffer: dummies.EasyDummyBuffer(device.Driver(), device),
+		Regions:   []core1_3.BufferCopy2{{Size: 16}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "vkCmdCopyBuffer2KHR", stub.lastCommand())
+}
+
+func TestCreateLoaderFromLibrary_Maintenance4Extension(t *testing.T) {
+	baseDevice := createStubExtensionDevice(t, "VK_KHR_maintenance4")
+	require.Nil(t, core1_3.PromoteDevice(baseDevice))
+
+	device := core1_3.PromoteDeviceFromExtensions(baseDevice)
+	require.NotNil(t, device)
+
+	var outData core1_1.MemoryRequirements2
+	err := device.DeviceBufferMemoryRequirements(core1_3.DeviceBufferMemoryRequirements{
+		CreateInfo: core1_0.BufferCreateInfo{Size: 1000, Usage: core1_0.BufferUsageVertexBuffer},
+	}, &outData)
+	require.NoError(t, err)
+	require.Equal(t, 2000, outData.MemoryRequirements.Size)
+	require.Equal(t, 256, outData.MemoryRequirements.Alignment)
+	require.Equal(t, uint32(5), outData.MemoryRequirements.MemoryTypeBits)
+
+	// The stub does not provide vkGetDeviceImageMemoryRequirementsKHR
+	require.False(t, device.Driver().HasCommand("vkGetDeviceImageMemoryRequirements"))
+	err = func() (err error) {
+		defer common.RecoverFunctionError(&err)
+		return device.DeviceImageMemoryRequirements(core1_3.DeviceImageMemoryRequirements{}, &outData)
+	}()
+	require.True(t, errors.Is(err, driver.ErrMissingCommand))
+}
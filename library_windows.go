@@ -0,0 +1,38 @@
+//go:build windows
+
+package core
+
+import (
+	"github.com/cockroachdb/errors"
+	"syscall"
+	"unsafe"
+)
+
+// CreateLoaderFromLibrary generates a Loader from the Vulkan loader at path, i.e. "vulkan-1.dll",
+// which is opened with LoadLibrary. path may be a file name, which is searched for in the same way as
+// LoadLibrary, or a path to a specific library. The library is never freed.
+//
+// An error is returned if the library cannot be opened, or if it does not export vkGetInstanceProcAddr.
+func CreateLoaderFromLibrary(path string) (*VulkanLoader, error) {
+	procAddr, err := loadLibraryProcAddr(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return CreateLoaderFromProcAddr(procAddr)
+}
+
+func loadLibraryProcAddr(path string) (unsafe.Pointer, error) {
+	library, err := syscall.LoadLibrary(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load the Vulkan library %q", path)
+	}
+
+	procAddr, err := syscall.GetProcAddress(library, "vkGetInstanceProcAddr")
+	if err != nil {
+		syscall.FreeLibrary(library)
+		return nil, errors.Wrapf(err, "the library %q does not export vkGetInstanceProcAddr, and is not a Vulkan loader", path)
+	}
+
+	return unsafe.Pointer(procAddr), nil
+}
@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"unsafe"
+)
+
+// Allocation is a range of DeviceMemory handed out by an Allocator. It is either a sub-allocated
+// range of a larger block, or a dedicated DeviceMemory object of its own.
+type Allocation struct {
+	allocator *Allocator
+	pool      *pool
+	block     *block
+
+	memoryTypeIndex int
+	offset          int
+	size            int
+
+	mapCount int
+	freed    bool
+}
+
+// Memory is the DeviceMemory that this Allocation is a range of. When the Allocation is not
+// dedicated, the DeviceMemory is shared with other Allocation objects and must not be mapped,
+// unmapped, or freed directly.
+func (a *Allocation) Memory() core1_0.DeviceMemory {
+	return a.block.memory
+}
+
+// Offset is the offset in bytes of this Allocation from the start of Memory
+func (a *Allocation) Offset() int {
+	return a.offset
+}
+
+// Size is the size in bytes of this Allocation
+func (a *Allocation) Size() int {
+	return a.size
+}
+
+// MemoryTypeIndex is the index of the memory type this Allocation was made from
+func (a *Allocation) MemoryTypeIndex() int {
+	return a.memoryTypeIndex
+}
+
+// MemoryPropertyFlags are the properties of the memory type this Allocation was made from
+func (a *Allocation) MemoryPropertyFlags() core1_0.MemoryPropertyFlags {
+	return a.allocator.memoryProperties.MemoryTypes[a.memoryTypeIndex].PropertyFlags
+}
+
+// Dedicated returns true if this Allocation has its own DeviceMemory object
+func (a *Allocation) Dedicated() bool {
+	return a.pool == nil
+}
+
+// Map maps this Allocation into application address space and returns a pointer to its first
+// byte. Allocation objects that share a block share a single mapping of the block's
+// DeviceMemory, which stays mapped until every Allocation that mapped it has called Unmap
+// or Free.
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkMapMemory.html
+func (a *Allocation) Map() (unsafe.Pointer, common.VkResult, error) {
+	a.allocator.lock.Lock()
+	defer a.allocator.lock.Unlock()
+
+	if a.freed {
+		return nil, core1_0.VKErrorMemoryMapFailed, errors.New("attempted to map an Allocation that has been freed")
+	}
+	if a.MemoryPropertyFlags()&core1_0.MemoryPropertyHostVisible == 0 {
+		return nil, core1_0.VKErrorMemoryMapFailed, errors.Newf("attempted to map an Allocation from memory type %d, which is not host-visible", a.memoryTypeIndex)
+	}
+
+	ptr, res, err := a.block.mapMemory()
+	if err != nil {
+		return nil, res, err
+	}
+
+	a.mapCount++
+	return unsafe.Add(ptr, a.offset), res, nil
+}
+
+// Unmap releases a mapping made with Map
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkUnmapMemory.html
+func (a *Allocation) Unmap() {
+	a.allocator.lock.Lock()
+	defer a.allocator.lock.Unlock()
+
+	if a.freed || a.mapCount == 0 {
+		return
+	}
+
+	a.mapCount--
+	a.block.unmapMemory(1)
+}
+
+// Free returns this Allocation to its Allocator. Any Buffer or Image bound to this Allocation
+// must already have been destroyed. Calling Free more than once has no effect.
+func (a *Allocation) Free() {
+	a.allocator.lock.Lock()
+	defer a.allocator.lock.Unlock()
+
+	if a.freed {
+		return
+	}
+
+	a.allocator.release(a)
+	a.freed = true
+	a.mapCount = 0
+}
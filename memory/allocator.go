@@ -0,0 +1,410 @@
+package memory
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+	"github.com/vkngwrapper/core/v2/driver"
+	"sync"
+	"unsafe"
+)
+
+// DefaultBlockSize is the size of the DeviceMemory blocks that an Allocator allocates when
+// AllocatorOptions.BlockSize is left zero. Heaps smaller than 512MiB use blocks of 1/8th of
+// the heap size instead.
+const DefaultBlockSize = 64 << 20
+
+// AllocatorOptions controls the behavior of an Allocator
+type AllocatorOptions struct {
+	// BlockSize is the size of each DeviceMemory block that the Allocator sub-allocates from.
+	// It is rounded down to a power of two. If it is left zero, DefaultBlockSize is used.
+	BlockSize int
+	// AllocationCallbacks controls host memory allocation for the DeviceMemory objects
+	// allocated by the Allocator
+	AllocationCallbacks *driver.AllocationCallbacks
+}
+
+// AllocationCreateInfo specifies how an Allocation should be made
+type AllocationCreateInfo struct {
+	// Usage is how the application intends to access the Allocation. Memory types are tried in
+	// the order returned by core1_0.SelectMemoryTypes.
+	Usage core1_0.MemoryUsage
+	// RequiredProperties are memory properties that the memory type of the Allocation must have
+	RequiredProperties core1_0.MemoryPropertyFlags
+	// PreferredProperties are memory properties that the memory type of the Allocation should
+	// have if possible, in addition to those implied by Usage
+	PreferredProperties core1_0.MemoryPropertyFlags
+	// Dedicated forces the Allocation to receive its own DeviceMemory rather than being
+	// sub-allocated from a block. The Allocator also makes a dedicated allocation when
+	// core1_1.MemoryDedicatedRequirements indicates that one is preferred or required.
+	Dedicated bool
+	// ImageTiling is the tiling that an Image was created with. It is ignored for Buffer
+	// objects. Image objects with linear tiling and Image objects with optimal tiling are
+	// kept in separate blocks so that bufferImageGranularity is never violated.
+	ImageTiling core1_0.ImageTiling
+}
+
+// Allocator allocates large DeviceMemory blocks for each memory type and sub-allocates ranges of
+// them to Buffer and Image objects, so that an application does not quickly exhaust
+// PhysicalDeviceLimits.MaxMemoryAllocationCount. Ranges are handed out with the buddy system.
+//
+// Allocator is safe for concurrent use.
+type Allocator struct {
+	device         core1_0.Device
+	device1_1      core1_1.Device
+	physicalDevice core1_0.PhysicalDevice
+	callbacks      *driver.AllocationCallbacks
+
+	memoryProperties       *core1_0.PhysicalDeviceMemoryProperties
+	blockSizes             []int
+	bufferImageGranularity int
+	nonCoherentAtomSize    int
+	maxAllocationCount     int
+
+	optimalBufferCopyOffsetAlignment int
+
+	lock            sync.Mutex
+	pools           map[poolKey]*pool
+	dedicated       map[*Allocation]struct{}
+	allocationCount int
+}
+
+type poolKey struct {
+	memoryTypeIndex int
+	optimal         bool
+}
+
+// pool holds the blocks for a single memory type and resource kind
+type pool struct {
+	memoryTypeIndex int
+	blocks          []*block
+}
+
+// block is a single DeviceMemory object that ranges are sub-allocated from. Dedicated
+// allocations also receive a block, which has no buddyBlock.
+type block struct {
+	memory core1_0.DeviceMemory
+	buddy  *buddyBlock
+
+	mapped   unsafe.Pointer
+	mapCount int
+}
+
+// NewAllocator creates an Allocator for a Device. The Device must have been created from the
+// provided PhysicalDevice.
+//
+// device - The Device that DeviceMemory will be allocated from
+//
+// physicalDevice - The PhysicalDevice that device was created from
+//
+// options - Controls the behavior of the Allocator
+func NewAllocator(device core1_0.Device, physicalDevice core1_0.PhysicalDevice, options AllocatorOptions) (*Allocator, error) {
+	if device == nil {
+		return nil, common.NilArgumentError("NewAllocator", "device")
+	}
+	if physicalDevice == nil {
+		return nil, common.NilArgumentError("NewAllocator", "physicalDevice")
+	}
+
+	properties, err := physicalDevice.Properties()
+	if err != nil {
+		return nil, err
+	}
+
+	memoryProperties := physicalDevice.MemoryProperties()
+
+	blockSize := options.BlockSize
+	if blockSize == 0 {
+		blockSize = DefaultBlockSize
+	}
+	blockSize = floorPowerOfTwo(blockSize)
+	if blockSize < minimumNodeSize {
+		return nil, errors.Newf("block size %d is smaller than the minimum of %d", options.BlockSize, minimumNodeSize)
+	}
+
+	blockSizes := make([]int, len(memoryProperties.MemoryTypes))
+	for i, memoryType := range memoryProperties.MemoryTypes {
+		blockSizes[i] = blockSize
+
+		heapSize := memoryProperties.MemoryHeaps[memoryType.HeapIndex].Size
+		if options.BlockSize == 0 && heapSize < 512<<20 {
+			blockSizes[i] = floorPowerOfTwo(heapSize / 8)
+			if blockSizes[i] < minimumNodeSize {
+				blockSizes[i] = minimumNodeSize
+			}
+		}
+	}
+
+	return &Allocator{
+		device:         device,
+		device1_1:      core1_1.PromoteDevice(device),
+		physicalDevice: physicalDevice,
+		callbacks:      options.AllocationCallbacks,
+
+		memoryProperties:       memoryProperties,
+		blockSizes:             blockSizes,
+		bufferImageGranularity: properties.Limits.BufferImageGranularity,
+		nonCoherentAtomSize:    properties.Limits.NonCoherentAtomSize,
+		maxAllocationCount:     properties.Limits.MaxMemoryAllocationCount,
+
+		optimalBufferCopyOffsetAlignment: properties.Limits.OptimalBufferCopyOffsetAlignment,
+
+		pools:     make(map[poolKey]*pool),
+		dedicated: make(map[*Allocation]struct{}),
+	}, nil
+}
+
+// Destroy frees every DeviceMemory object allocated by this Allocator. Any Buffer or Image
+// objects bound to memory from this Allocator must already have been destroyed.
+func (a *Allocator) Destroy() {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	for _, p := range a.pools {
+		for _, b := range p.blocks {
+			a.freeBlock(b)
+		}
+	}
+	for allocation := range a.dedicated {
+		a.freeBlock(allocation.block)
+	}
+
+	a.pools = make(map[poolKey]*pool)
+	a.dedicated = make(map[*Allocation]struct{})
+}
+
+// allocate makes an Allocation that satisfies the provided requirements. The caller must hold the
+// allocator lock.
+func (a *Allocator) allocate(requirements core1_0.MemoryRequirements, info AllocationCreateInfo, optimal bool, dedicated bool, dedicatedInfo *core1_1.MemoryDedicatedAllocateInfo) (*Allocation, common.VkResult, error) {
+	candidates := core1_0.SelectMemoryTypes(a.memoryProperties, requirements, core1_0.MemoryTypeSelection{
+		Usage:               info.Usage,
+		RequiredProperties:  info.RequiredProperties,
+		PreferredProperties: info.PreferredProperties,
+	})
+	if len(candidates) == 0 {
+		return nil, core1_0.VKErrorFeatureNotPresent, errors.Newf("no memory type permitted by memory type bits 0x%x has the required properties %s", requirements.MemoryTypeBits, info.RequiredProperties)
+	}
+
+	res := core1_0.VKErrorOutOfDeviceMemory
+	err := res.ToError()
+	for _, memoryTypeIndex := range candidates {
+		var allocation *Allocation
+		alignment := a.alignment(memoryTypeIndex, requirements.Alignment)
+
+		if dedicated || nodeSize(requirements.Size, alignment) > a.blockSizes[memoryTypeIndex]/2 {
+			allocation, res, err = a.allocateDedicated(memoryTypeIndex, requirements.Size, dedicatedInfo)
+		} else {
+			allocation, res, err = a.allocateFromPool(memoryTypeIndex, optimal, requirements.Size, alignment)
+		}
+
+		if err == nil {
+			return allocation, res, nil
+		}
+		if res != core1_0.VKErrorOutOfDeviceMemory {
+			return nil, res, err
+		}
+	}
+
+	return nil, res, err
+}
+
+// alignment raises the alignment of ranges in non-coherent host-visible memory to
+// NonCoherentAtomSize, so that flushing or invalidating one Allocation never touches
+// its neighbors
+func (a *Allocator) alignment(memoryTypeIndex int, alignment int) int {
+	flags := a.memoryProperties.MemoryTypes[memoryTypeIndex].PropertyFlags
+	if flags&core1_0.MemoryPropertyHostVisible != 0 &&
+		flags&core1_0.MemoryPropertyHostCoherent == 0 &&
+		a.nonCoherentAtomSize > alignment {
+		return a.nonCoherentAtomSize
+	}
+
+	return alignment
+}
+
+func (a *Allocator) allocateDedicated(memoryTypeIndex int, size int, dedicatedInfo *core1_1.MemoryDedicatedAllocateInfo) (*Allocation, common.VkResult, error) {
+	allocateInfo := core1_0.MemoryAllocateInfo{
+		AllocationSize:  size,
+		MemoryTypeIndex: memoryTypeIndex,
+	}
+	if dedicatedInfo != nil {
+		allocateInfo.Next = *dedicatedInfo
+	}
+
+	memory, res, err := a.allocateDeviceMemory(allocateInfo)
+	if err != nil {
+		return nil, res, err
+	}
+
+	allocation := &Allocation{
+		allocator:       a,
+		block:           &block{memory: memory},
+		memoryTypeIndex: memoryTypeIndex,
+		size:            size,
+	}
+	a.dedicated[allocation] = struct{}{}
+
+	return allocation, res, nil
+}
+
+func (a *Allocator) allocateFromPool(memoryTypeIndex int, optimal bool, size int, alignment int) (*Allocation, common.VkResult, error) {
+	// Linear and optimal resources only need to be kept apart if the granularity is larger than
+	// the smallest node, which is already aligned to minimumNodeSize
+	if a.bufferImageGranularity <= minimumNodeSize {
+		optimal = false
+	}
+
+	key := poolKey{memoryTypeIndex: memoryTypeIndex, optimal: optimal}
+	p, ok := a.pools[key]
+	if !ok {
+		p = &pool{memoryTypeIndex: memoryTypeIndex}
+		a.pools[key] = p
+	}
+
+	for _, b := range p.blocks {
+		offset, ok := b.buddy.allocate(size, alignment)
+		if ok {
+			return a.blockAllocation(p, b, offset, size), core1_0.VKSuccess, nil
+		}
+	}
+
+	// No existing block has room, so allocate a new one, retrying with smaller blocks if the
+	// heap is running low
+	required := nodeSize(size, alignment)
+	blockSize := a.blockSizes[memoryTypeIndex]
+	for {
+		memory, res, err := a.allocateDeviceMemory(core1_0.MemoryAllocateInfo{
+			AllocationSize:  blockSize,
+			MemoryTypeIndex: memoryTypeIndex,
+		})
+		if err == nil {
+			b := &block{
+				memory: memory,
+				buddy:  newBuddyBlock(blockSize),
+			}
+			p.blocks = append(p.blocks, b)
+
+			offset, _ := b.buddy.allocate(size, alignment)
+			return a.blockAllocation(p, b, offset, size), res, nil
+		}
+
+		if res != core1_0.VKErrorOutOfDeviceMemory || blockSize/2 < required {
+			return nil, res, err
+		}
+		blockSize /= 2
+	}
+}
+
+func (a *Allocator) blockAllocation(p *pool, b *block, offset int, size int) *Allocation {
+	return &Allocation{
+		allocator:       a,
+		pool:            p,
+		block:           b,
+		memoryTypeIndex: p.memoryTypeIndex,
+		offset:          offset,
+		size:            size,
+	}
+}
+
+func (a *Allocator) allocateDeviceMemory(allocateInfo core1_0.MemoryAllocateInfo) (core1_0.DeviceMemory, common.VkResult, error) {
+	if a.maxAllocationCount > 0 && a.allocationCount >= a.maxAllocationCount {
+		return nil, core1_0.VKErrorTooManyObjects, errors.Newf("the device limit of %d DeviceMemory objects has been reached", a.maxAllocationCount)
+	}
+
+	memory, res, err := a.device.AllocateMemory(a.callbacks, allocateInfo)
+	if err != nil {
+		return nil, res, err
+	}
+
+	a.allocationCount++
+	return memory, res, nil
+}
+
+func (a *Allocator) freeBlock(b *block) {
+	if b.mapCount > 0 {
+		b.memory.Unmap()
+	}
+	b.memory.Free(a.callbacks)
+	b.mapped = nil
+	b.mapCount = 0
+	a.allocationCount--
+}
+
+// release returns an Allocation's memory to the Allocator. The caller must hold the
+// allocator lock.
+func (a *Allocator) release(allocation *Allocation) {
+	if allocation.pool == nil {
+		delete(a.dedicated, allocation)
+		a.freeBlock(allocation.block)
+		return
+	}
+
+	b := allocation.block
+	b.buddy.release(allocation.offset)
+	if allocation.mapCount > 0 {
+		b.unmapMemory(allocation.mapCount)
+	}
+
+	// Keep one empty block around per pool so that allocating and freeing a single resource
+	// does not allocate DeviceMemory every time
+	if !b.buddy.empty() {
+		return
+	}
+
+	p := allocation.pool
+	emptyBlocks := 0
+	for _, other := range p.blocks {
+		if other.buddy.empty() {
+			emptyBlocks++
+		}
+	}
+	if emptyBlocks < 2 {
+		return
+	}
+
+	for i, other := range p.blocks {
+		if other == b {
+			p.blocks = append(p.blocks[:i], p.blocks[i+1:]...)
+			break
+		}
+	}
+	a.freeBlock(b)
+}
+
+// mapMemory maps the block's DeviceMemory if it is not already mapped, and returns a pointer to
+// its first byte
+func (b *block) mapMemory() (unsafe.Pointer, common.VkResult, error) {
+	if b.mapCount == 0 {
+		ptr, res, err := b.memory.Map(0, -1, 0)
+		if err != nil {
+			return nil, res, err
+		}
+		b.mapped = ptr
+	}
+
+	b.mapCount++
+	return b.mapped, core1_0.VKSuccess, nil
+}
+
+// unmapMemory releases count mappings of the block, unmapping its DeviceMemory when none remain
+func (b *block) unmapMemory(count int) {
+	b.mapCount -= count
+	if b.mapCount <= 0 {
+		b.memory.Unmap()
+		b.mapped = nil
+		b.mapCount = 0
+	}
+}
+
+func floorPowerOfTwo(value int) int {
+	if value <= 0 {
+		return 0
+	}
+
+	result := 1
+	for result*2 <= value {
+		result *= 2
+	}
+	return result
+}
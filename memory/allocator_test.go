@@ -0,0 +1,380 @@
+package memory_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"github.com/vkngwrapper/core/v2/memory"
+	"testing"
+	"unsafe"
+)
+
+func createDevice(t *testing.T, vkDriver driver.Driver, version common.APIVersion) (core1_0.PhysicalDevice, core1_0.Device) {
+	loader, err := core.CreateLoaderFromDriver(vkDriver)
+	require.NoError(t, err)
+
+	instance, _, err := loader.CreateInstance(nil, core1_0.InstanceCreateInfo{
+		APIVersion: version,
+	})
+	require.NoError(t, err)
+
+	physicalDevices, _, err := instance.EnumeratePhysicalDevices()
+	require.NoError(t, err)
+	require.Len(t, physicalDevices, 1)
+
+	device, _, err := physicalDevices[0].CreateDevice(nil, core1_0.DeviceCreateInfo{
+		QueueCreateInfos: []core1_0.DeviceQueueCreateInfo{
+			{
+				QueueFamilyIndex: 0,
+				QueuePriorities:  []float32{1},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	return physicalDevices[0], device
+}
+
+func createBuffer(t *testing.T, device core1_0.Device, size int) core1_0.Buffer {
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:  size,
+		Usage: core1_0.BufferUsageTransferSrc,
+	})
+	require.NoError(t, err)
+	return buffer
+}
+
+func liveMemoryCount(fakeDriver *fake.Driver) int {
+	count := 0
+	for _, obj := range fakeDriver.Objects(core1_0.ObjectTypeDeviceMemory) {
+		if !obj.Destroyed {
+			count++
+		}
+	}
+	return count
+}
+
+func TestAllocator_SubAllocatesBuffers(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	buffer1 := createBuffer(t, device, 1000)
+	buffer2 := createBuffer(t, device, 3000)
+
+	allocation1, _, err := allocator.AllocateBufferMemory(buffer1, memory.AllocationCreateInfo{
+		RequiredProperties: core1_0.MemoryPropertyDeviceLocal,
+	})
+	require.NoError(t, err)
+	allocation2, _, err := allocator.AllocateBufferMemory(buffer2, memory.AllocationCreateInfo{
+		RequiredProperties: core1_0.MemoryPropertyDeviceLocal,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, allocation1.Memory().Handle(), allocation2.Memory().Handle())
+	require.Equal(t, 0, allocation1.MemoryTypeIndex())
+	require.False(t, allocation1.Dedicated())
+	require.Equal(t, 1024, allocation1.Size())
+	require.Equal(t, 3072, allocation2.Size())
+	require.NotEqual(t, allocation1.Offset(), allocation2.Offset())
+	require.Zero(t, allocation2.Offset()%4096)
+
+	bufferObj, _ := fakeDriver.Object(driver.VulkanHandle(buffer2.Handle()))
+	require.Equal(t, allocation2.Memory().Handle(), bufferObj.Memory)
+	require.Equal(t, allocation2.Offset(), bufferObj.MemoryOffset)
+
+	memoryObjects := fakeDriver.Objects(core1_0.ObjectTypeDeviceMemory)
+	require.Len(t, memoryObjects, 1)
+	require.Equal(t, memory.DefaultBlockSize, memoryObjects[0].CreateInfo.(core1_0.MemoryAllocateInfo).AllocationSize)
+
+	statistics := allocator.Statistics()
+	require.Len(t, statistics, 2)
+	require.Equal(t, memory.HeapStatistics{
+		BlockCount:      1,
+		BlockBytes:      memory.DefaultBlockSize,
+		AllocationCount: 2,
+		AllocationBytes: 1024 + 4096,
+		HeapSize:        4 << 30,
+	}, statistics[0])
+	require.Equal(t, memory.HeapStatistics{HeapSize: 8 << 30}, statistics[1])
+
+	buffer1.Destroy(nil)
+	buffer2.Destroy(nil)
+	allocation1.Free()
+	allocation2.Free()
+	allocation2.Free()
+
+	statistics = allocator.Statistics()
+	require.Equal(t, 1, statistics[0].BlockCount)
+	require.Equal(t, 0, statistics[0].AllocationCount)
+
+	allocator.Destroy()
+	require.Empty(t, fakeDriver.LiveObjects()[2:])
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestAllocator_ReleasesEmptyBlocks(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{
+		BlockSize: 8192,
+	})
+	require.NoError(t, err)
+
+	var allocations []*memory.Allocation
+	for i := 0; i < 5; i++ {
+		allocation, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 4096), memory.AllocationCreateInfo{})
+		require.NoError(t, err)
+		allocations = append(allocations, allocation)
+	}
+
+	statistics := allocator.Statistics()
+	require.Equal(t, 3, statistics[0].BlockCount)
+	require.Equal(t, 5, statistics[0].AllocationCount)
+	require.Equal(t, 3, liveMemoryCount(fakeDriver))
+
+	for _, allocation := range allocations {
+		allocation.Free()
+	}
+
+	statistics = allocator.Statistics()
+	require.Equal(t, 1, statistics[0].BlockCount)
+	require.Equal(t, 8192, statistics[0].BlockBytes)
+	require.Equal(t, 1, liveMemoryCount(fakeDriver))
+
+	// The freed halves of the remaining block merge back together, so it can hold a full-size
+	// allocation again
+	allocation, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 4096), memory.AllocationCreateInfo{})
+	require.NoError(t, err)
+	require.Equal(t, 0, allocation.Offset())
+	require.Equal(t, 1, allocator.Statistics()[0].BlockCount)
+}
+
+func TestAllocator_Dedicated(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{
+		PrefersDedicatedSize: 1 << 20,
+	})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	preferred, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 1<<20), memory.AllocationCreateInfo{})
+	require.NoError(t, err)
+	require.True(t, preferred.Dedicated())
+	require.Equal(t, 0, preferred.Offset())
+
+	requested, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 1024), memory.AllocationCreateInfo{
+		Dedicated: true,
+	})
+	require.NoError(t, err)
+	require.True(t, requested.Dedicated())
+
+	small, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 1024), memory.AllocationCreateInfo{})
+	require.NoError(t, err)
+	require.False(t, small.Dedicated())
+
+	memoryObj, _ := fakeDriver.Object(driver.VulkanHandle(preferred.Memory().Handle()))
+	require.Equal(t, 1<<20, memoryObj.CreateInfo.(core1_0.MemoryAllocateInfo).AllocationSize)
+
+	statistics := allocator.Statistics()
+	require.Equal(t, 3, statistics[0].BlockCount)
+	require.Equal(t, 2, statistics[0].DedicatedAllocationCount)
+	require.Equal(t, 3, statistics[0].AllocationCount)
+
+	preferred.Free()
+	requested.Free()
+
+	statistics = allocator.Statistics()
+	require.Equal(t, 1, statistics[0].BlockCount)
+	require.Equal(t, 0, statistics[0].DedicatedAllocationCount)
+	require.Equal(t, 1, liveMemoryCount(fakeDriver))
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestAllocator_LargeAllocationsAreDedicated(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{
+		BlockSize: 1 << 16,
+	})
+	require.NoError(t, err)
+
+	large, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 40000), memory.AllocationCreateInfo{})
+	require.NoError(t, err)
+	require.True(t, large.Dedicated())
+	require.Equal(t, 40192, large.Size())
+
+	half, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 1<<15), memory.AllocationCreateInfo{})
+	require.NoError(t, err)
+	require.False(t, half.Dedicated())
+}
+
+func TestAllocator_SeparatesLinearAndOptimalResources(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	image, _, err := device.CreateImage(nil, core1_0.ImageCreateInfo{
+		ImageType:   core1_0.ImageType2D,
+		Format:      core1_0.FormatR8G8B8A8UnsignedNormalized,
+		Extent:      core1_0.Extent3D{Width: 4, Height: 4, Depth: 1},
+		MipLevels:   1,
+		ArrayLayers: 1,
+		Samples:     core1_0.Samples1,
+		Tiling:      core1_0.ImageTilingOptimal,
+		Usage:       core1_0.ImageUsageSampled,
+	})
+	require.NoError(t, err)
+
+	imageAllocation, _, err := allocator.AllocateImageMemory(image, memory.AllocationCreateInfo{})
+	require.NoError(t, err)
+	bufferAllocation, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 256), memory.AllocationCreateInfo{})
+	require.NoError(t, err)
+
+	require.Equal(t, imageAllocation.MemoryTypeIndex(), bufferAllocation.MemoryTypeIndex())
+	require.NotEqual(t, imageAllocation.Memory().Handle(), bufferAllocation.Memory().Handle())
+
+	imageObj, _ := fakeDriver.Object(driver.VulkanHandle(image.Handle()))
+	require.Equal(t, imageAllocation.Memory().Handle(), imageObj.Memory)
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestAllocator_Map(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	info := memory.AllocationCreateInfo{
+		RequiredProperties:  core1_0.MemoryPropertyHostVisible,
+		PreferredProperties: core1_0.MemoryPropertyHostCached,
+	}
+	allocation1, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 256), info)
+	require.NoError(t, err)
+	allocation2, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 256), info)
+	require.NoError(t, err)
+	require.Equal(t, 2, allocation1.MemoryTypeIndex())
+	require.Equal(t, core1_0.MemoryPropertyHostVisible|core1_0.MemoryPropertyHostCoherent|core1_0.MemoryPropertyHostCached, allocation1.MemoryPropertyFlags())
+
+	ptr1, _, err := allocation1.Map()
+	require.NoError(t, err)
+	ptr2, _, err := allocation2.Map()
+	require.NoError(t, err)
+
+	copy(unsafe.Slice((*byte)(ptr1), 4), []byte{1, 2, 3, 4})
+	copy(unsafe.Slice((*byte)(ptr2), 4), []byte{5, 6, 7, 8})
+
+	contents := fakeDriver.MemoryBytes(allocation1.Memory().Handle())
+	require.Equal(t, []byte{1, 2, 3, 4}, contents[allocation1.Offset():allocation1.Offset()+4])
+	require.Equal(t, []byte{5, 6, 7, 8}, contents[allocation2.Offset():allocation2.Offset()+4])
+
+	allocation1.Unmap()
+	allocation2.Free()
+
+	// The block was unmapped once both mappings were released, so the DeviceMemory can be
+	// mapped directly again
+	_, _, err = allocation1.Memory().Map(0, -1, 0)
+	require.NoError(t, err)
+	allocation1.Memory().Unmap()
+	require.Empty(t, fakeDriver.Errors())
+
+	deviceLocal, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 256), memory.AllocationCreateInfo{
+		RequiredProperties: core1_0.MemoryPropertyDeviceLocal,
+	})
+	require.NoError(t, err)
+
+	_, res, err := deviceLocal.Map()
+	require.Error(t, err)
+	require.Equal(t, core1_0.VKErrorMemoryMapFailed, res)
+}
+
+func TestAllocator_Usage(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	gpuOnly, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 256), memory.AllocationCreateInfo{Usage: core1_0.MemoryUsageGPUOnly})
+	require.NoError(t, err)
+	require.Equal(t, 0, gpuOnly.MemoryTypeIndex())
+
+	upload, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 256), memory.AllocationCreateInfo{Usage: core1_0.MemoryUsageUpload})
+	require.NoError(t, err)
+	require.Equal(t, 1, upload.MemoryTypeIndex())
+
+	readback, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 256), memory.AllocationCreateInfo{Usage: core1_0.MemoryUsageReadback})
+	require.NoError(t, err)
+	require.Equal(t, 2, readback.MemoryTypeIndex())
+
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestAllocator_NoSuitableMemoryType(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	_, res, err := allocator.AllocateBufferMemory(createBuffer(t, device, 256), memory.AllocationCreateInfo{
+		RequiredProperties: core1_0.MemoryPropertyDeviceLocal | core1_0.MemoryPropertyHostVisible,
+	})
+	require.Error(t, err)
+	require.Equal(t, core1_0.VKErrorFeatureNotPresent, res)
+}
+
+func TestAllocator_MaxMemoryAllocationCount(t *testing.T) {
+	physicalDevice := fake.DefaultPhysicalDevice()
+	limits := *physicalDevice.Properties.Limits
+	limits.MaxMemoryAllocationCount = 1
+	physicalDevice.Properties.Limits = &limits
+
+	fakeDriver := fake.NewDriver(fake.Config{
+		PhysicalDevices: []fake.PhysicalDevice{physicalDevice},
+	})
+	physical, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physical, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	_, _, err = allocator.AllocateBufferMemory(createBuffer(t, device, 256), memory.AllocationCreateInfo{})
+	require.NoError(t, err)
+
+	_, res, err := allocator.AllocateBufferMemory(createBuffer(t, device, 256), memory.AllocationCreateInfo{
+		Dedicated: true,
+	})
+	require.Error(t, err)
+	require.Equal(t, core1_0.VKErrorTooManyObjects, res)
+}
+
+func TestAllocator_Vulkan1_0(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{
+		APIVersion: common.Vulkan1_0,
+	})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_0)
+	require.Equal(t, common.Vulkan1_0, device.APIVersion())
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	buffer := createBuffer(t, device, 256)
+	allocation, _, err := allocator.AllocateBufferMemory(buffer, memory.AllocationCreateInfo{})
+	require.NoError(t, err)
+
+	bufferObj, _ := fakeDriver.Object(driver.VulkanHandle(buffer.Handle()))
+	require.Equal(t, allocation.Memory().Handle(), bufferObj.Memory)
+	require.Equal(t, allocation.Offset(), bufferObj.MemoryOffset)
+	require.Empty(t, fakeDriver.Errors())
+}
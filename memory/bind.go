@@ -0,0 +1,128 @@
+package memory
+
+import (
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/core1_1"
+)
+
+// AllocateBufferMemory allocates memory suitable for a Buffer and binds the Buffer to it. The
+// Buffer is bound with core1_1.Device.BindBufferMemory2 when the Device supports it, and with
+// core1_0.Buffer.BindBufferMemory otherwise.
+//
+// buffer - The Buffer to allocate memory for. It must not already be bound to memory.
+//
+// info - Controls the memory type and placement of the Allocation
+func (a *Allocator) AllocateBufferMemory(buffer core1_0.Buffer, info AllocationCreateInfo) (*Allocation, common.VkResult, error) {
+	if buffer == nil {
+		return nil, core1_0.VKErrorUnknown, common.NilArgumentError("AllocateBufferMemory", "buffer")
+	}
+
+	var requirements core1_0.MemoryRequirements
+	dedicated := info.Dedicated
+	var dedicatedInfo *core1_1.MemoryDedicatedAllocateInfo
+
+	if a.device1_1 == nil {
+		requirements = *buffer.MemoryRequirements()
+	} else {
+		dedicatedRequirements := &core1_1.MemoryDedicatedRequirements{}
+		requirements2 := &core1_1.MemoryRequirements2{
+			NextOutData: common.NextOutData{Next: dedicatedRequirements},
+		}
+
+		err := a.device1_1.BufferMemoryRequirements2(core1_1.BufferMemoryRequirementsInfo2{Buffer: buffer}, requirements2)
+		if err != nil {
+			return nil, core1_0.VKErrorUnknown, err
+		}
+
+		requirements = requirements2.MemoryRequirements
+		dedicated = dedicated || dedicatedRequirements.PrefersDedicatedAllocation || dedicatedRequirements.RequiresDedicatedAllocation
+		dedicatedInfo = &core1_1.MemoryDedicatedAllocateInfo{Buffer: buffer}
+	}
+
+	a.lock.Lock()
+	allocation, res, err := a.allocate(requirements, info, false, dedicated, dedicatedInfo)
+	a.lock.Unlock()
+	if err != nil {
+		return nil, res, err
+	}
+
+	if a.device1_1 != nil {
+		res, err = a.device1_1.BindBufferMemory2([]core1_1.BindBufferMemoryInfo{
+			{
+				Buffer:       buffer,
+				Memory:       allocation.Memory(),
+				MemoryOffset: allocation.Offset(),
+			},
+		})
+	} else {
+		res, err = buffer.BindBufferMemory(allocation.Memory(), allocation.Offset())
+	}
+	if err != nil {
+		allocation.Free()
+		return nil, res, err
+	}
+
+	return allocation, res, nil
+}
+
+// AllocateImageMemory allocates memory suitable for an Image and binds the Image to it. The
+// Image is bound with core1_1.Device.BindImageMemory2 when the Device supports it, and with
+// core1_0.Image.BindImageMemory otherwise.
+//
+// image - The Image to allocate memory for. It must not already be bound to memory.
+//
+// info - Controls the memory type and placement of the Allocation. info.ImageTiling must match
+// the tiling the Image was created with.
+func (a *Allocator) AllocateImageMemory(image core1_0.Image, info AllocationCreateInfo) (*Allocation, common.VkResult, error) {
+	if image == nil {
+		return nil, core1_0.VKErrorUnknown, common.NilArgumentError("AllocateImageMemory", "image")
+	}
+
+	var requirements core1_0.MemoryRequirements
+	dedicated := info.Dedicated
+	var dedicatedInfo *core1_1.MemoryDedicatedAllocateInfo
+
+	if a.device1_1 == nil {
+		requirements = *image.MemoryRequirements()
+	} else {
+		dedicatedRequirements := &core1_1.MemoryDedicatedRequirements{}
+		requirements2 := &core1_1.MemoryRequirements2{
+			NextOutData: common.NextOutData{Next: dedicatedRequirements},
+		}
+
+		err := a.device1_1.ImageMemoryRequirements2(core1_1.ImageMemoryRequirementsInfo2{Image: image}, requirements2)
+		if err != nil {
+			return nil, core1_0.VKErrorUnknown, err
+		}
+
+		requirements = requirements2.MemoryRequirements
+		dedicated = dedicated || dedicatedRequirements.PrefersDedicatedAllocation || dedicatedRequirements.RequiresDedicatedAllocation
+		dedicatedInfo = &core1_1.MemoryDedicatedAllocateInfo{Image: image}
+	}
+
+	a.lock.Lock()
+	allocation, res, err := a.allocate(requirements, info, info.ImageTiling != core1_0.ImageTilingLinear, dedicated, dedicatedInfo)
+	a.lock.Unlock()
+	if err != nil {
+		return nil, res, err
+	}
+
+	if a.device1_1 != nil {
+		res, err = a.device1_1.BindImageMemory2([]core1_1.BindImageMemoryInfo{
+			{
+				Image:        image,
+				Memory:       allocation.Memory(),
+				MemoryOffset: uint64(allocation.Offset()),
+			},
+		})
+	} else {
+		res, err = image.BindImageMemory(allocation.Memory(), allocation.Offset())
+	}
+	if err != nil {
+		allocation.Free()
+		return nil, res, err
+	}
+
+	return allocation, res, nil
+}
@@ -0,0 +1,140 @@
+package memory
+
+import "sort"
+
+// minimumNodeSize is the smallest range that a buddyBlock will hand out. Smaller requests are
+// rounded up to this size, which keeps the number of orders in a block manageable.
+const minimumNodeSize = 256
+
+// buddyBlock sub-allocates a power-of-two range using the buddy system. Every node is aligned to
+// its own size, so a request is placed at an offset that satisfies any power-of-two alignment up to
+// the node size without any padding.
+type buddyBlock struct {
+	size   int
+	orders int
+
+	// free holds the offsets of unused nodes for each order, sorted in ascending order
+	free [][]int
+	// allocated maps the offset of each node in use to its order
+	allocated map[int]int
+	used      int
+}
+
+// newBuddyBlock creates a buddyBlock managing size bytes. size must be a power of two that is at
+// least minimumNodeSize.
+func newBuddyBlock(size int) *buddyBlock {
+	orders := nodeOrder(size) + 1
+	block := &buddyBlock{
+		size:      size,
+		orders:    orders,
+		free:      make([][]int, orders),
+		allocated: make(map[int]int),
+	}
+	block.free[orders-1] = []int{0}
+
+	return block
+}
+
+// nodeOrder returns the smallest order whose nodes are at least size bytes
+func nodeOrder(size int) int {
+	order := 0
+	for orderSize(order) < size {
+		order++
+	}
+	return order
+}
+
+func orderSize(order int) int {
+	return minimumNodeSize << order
+}
+
+// nodeSize returns the number of bytes that a request for size bytes with the provided
+// alignment will occupy
+func nodeSize(size, alignment int) int {
+	if alignment > size {
+		size = alignment
+	}
+	return orderSize(nodeOrder(size))
+}
+
+// allocate reserves a node large enough for size bytes at the provided alignment, preferring the
+// lowest available offset. It returns false if no node is available.
+func (b *buddyBlock) allocate(size, alignment int) (int, bool) {
+	order := nodeOrder(nodeSize(size, alignment))
+	if order >= b.orders {
+		return 0, false
+	}
+
+	current := order
+	for current < b.orders && len(b.free[current]) == 0 {
+		current++
+	}
+	if current == b.orders {
+		return 0, false
+	}
+
+	offset := b.free[current][0]
+	b.removeFree(current, offset)
+
+	// Split the node in half until it is the right size, returning the upper halves to the
+	// free lists
+	for current > order {
+		current--
+		b.insertFree(current, offset+orderSize(current))
+	}
+
+	b.allocated[offset] = order
+	b.used += orderSize(order)
+	return offset, true
+}
+
+// release returns the node at offset to the block, merging it with its buddy for as long as the
+// buddy is also free
+func (b *buddyBlock) release(offset int) {
+	order, ok := b.allocated[offset]
+	if !ok {
+		return
+	}
+
+	delete(b.allocated, offset)
+	b.used -= orderSize(order)
+
+	for order < b.orders-1 {
+		buddy := offset ^ orderSize(order)
+		if !b.removeFree(order, buddy) {
+			break
+		}
+
+		if buddy < offset {
+			offset = buddy
+		}
+		order++
+	}
+
+	b.insertFree(order, offset)
+}
+
+// empty returns true if no nodes in the block are in use
+func (b *buddyBlock) empty() bool {
+	return len(b.allocated) == 0
+}
+
+func (b *buddyBlock) insertFree(order int, offset int) {
+	offsets := b.free[order]
+	index := sort.SearchInts(offsets, offset)
+	offsets = append(offsets, 0)
+	copy(offsets[index+1:], offsets[index:])
+	offsets[index] = offset
+	b.free[order] = offsets
+}
+
+func (b *buddyBlock) removeFree(order int, offset int) bool {
+	offsets := b.free[order]
+	index := sort.SearchInts(offsets, offset)
+	if index == len(offsets) || offsets[index] != offset {
+		return false
+	}
+
+	b.free[order] = append(offsets[:index], offsets[index+1:]...)
+	return true
+}
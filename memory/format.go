@@ -0,0 +1,18 @@
+package memory
+
+import (
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/internal/texel"
+)
+
+// TexelSize returns the size in bytes of a single texel of one aspect of an uncompressed format,
+// as it is laid out in a Buffer by CmdCopyImageToBuffer and CmdCopyBufferToImage. It returns 0 if
+// the format is compressed, multi-planar, or does not have the requested aspect.
+//
+// format - The format of the Image
+//
+// aspect - A single aspect of the format: core1_0.ImageAspectColor, core1_0.ImageAspectDepth,
+// or core1_0.ImageAspectStencil
+func TexelSize(format core1_0.Format, aspect core1_0.ImageAspectFlags) int {
+	return texel.Size(format, aspect)
+}
@@ -0,0 +1,210 @@
+package memory
+
+import (
+	"encoding/binary"
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"image"
+	"image/color"
+	"math"
+)
+
+// ImageFromTexels converts tightly-packed texels, such as those returned by
+// Allocator.ReadbackImage, to an image.Image. 8-bit formats are converted to *image.NRGBA (or
+// *image.Gray for single-channel formats), and 16-bit and floating point formats are converted to
+// *image.NRGBA64 (or *image.Gray16). Floating point values are clamped to [0, 1]. Missing color
+// channels are zero and missing alpha channels are opaque.
+//
+// The supported formats are the R8, R8G8, R8G8B8, R8G8B8A8, B8G8R8, and B8G8R8A8 unsigned
+// normalized and sRGB formats; the R16, R16G16B16A16 unsigned normalized formats; and the R16,
+// R16G16B16A16, R32, and R32G32B32A32 signed float formats.
+//
+// format - The format of the texels
+//
+// width - The width in texels of the image
+//
+// height - The height in texels of the image
+//
+// data - The texels, at least width * height * TexelSize(format, core1_0.ImageAspectColor) bytes
+func ImageFromTexels(format core1_0.Format, width int, height int, data []byte) (image.Image, error) {
+	texelSize := TexelSize(format, core1_0.ImageAspectColor)
+	if texelSize == 0 {
+		return nil, errors.Newf("attempted to convert texels with format %s, which is not supported", format)
+	}
+	if width <= 0 || height <= 0 {
+		return nil, errors.Newf("attempted to convert texels to a %dx%d image", width, height)
+	}
+	if len(data) < width*height*texelSize {
+		return nil, errors.Newf("attempted to convert %d bytes of texels to a %dx%d image with format %s, which requires %d bytes", len(data), width, height, format, width*height*texelSize)
+	}
+
+	bounds := image.Rect(0, 0, width, height)
+	texels := width * height
+
+	switch format {
+	case core1_0.FormatR8UnsignedNormalized, core1_0.FormatR8SRGB:
+		img := image.NewGray(bounds)
+		copy(img.Pix, data[:texels])
+		return img, nil
+	case core1_0.FormatR8G8UnsignedNormalized, core1_0.FormatR8G8SRGB,
+		core1_0.FormatR8G8B8UnsignedNormalized, core1_0.FormatR8G8B8SRGB,
+		core1_0.FormatR8G8B8A8UnsignedNormalized, core1_0.FormatR8G8B8A8SRGB:
+		img := image.NewNRGBA(bounds)
+		for i := 0; i < texels; i++ {
+			texel := data[i*texelSize : (i+1)*texelSize]
+			pixel := img.Pix[i*4 : i*4+4]
+			copy(pixel, texel)
+			if texelSize < 4 {
+				pixel[3] = 0xff
+			}
+		}
+		return img, nil
+	case core1_0.FormatB8G8R8UnsignedNormalized, core1_0.FormatB8G8R8SRGB,
+		core1_0.FormatB8G8R8A8UnsignedNormalized, core1_0.FormatB8G8R8A8SRGB:
+		img := image.NewNRGBA(bounds)
+		for i := 0; i < texels; i++ {
+			texel := data[i*texelSize : (i+1)*texelSize]
+			pixel := img.Pix[i*4 : i*4+4]
+			pixel[0], pixel[1], pixel[2], pixel[3] = texel[2], texel[1], texel[0], 0xff
+			if texelSize == 4 {
+				pixel[3] = texel[3]
+			}
+		}
+		return img, nil
+	case core1_0.FormatR16UnsignedNormalized, core1_0.FormatR16SignedFloat, core1_0.FormatR32SignedFloat:
+		img := image.NewGray16(bounds)
+		for i := 0; i < texels; i++ {
+			binary.BigEndian.PutUint16(img.Pix[i*2:], channelValue(format, data[i*texelSize:]))
+		}
+		return img, nil
+	case core1_0.FormatR16G16B16A16UnsignedNormalized, core1_0.FormatR16G16B16A16SignedFloat, core1_0.FormatR32G32B32A32SignedFloat:
+		channelSize := texelSize / 4
+		img := image.NewNRGBA64(bounds)
+		for i := 0; i < texels*4; i++ {
+			binary.BigEndian.PutUint16(img.Pix[i*2:], channelValue(format, data[i*channelSize:]))
+		}
+		return img, nil
+	}
+
+	return nil, errors.Newf("attempted to convert texels with format %s, which is not supported", format)
+}
+
+// TexelsFromImage converts an image.Image to tightly-packed texels and returns them along with
+// the format they are laid out in. It is the inverse of ImageFromTexels.
+//
+// *image.Gray is converted to core1_0.FormatR8UnsignedNormalized, *image.Gray16 to
+// core1_0.FormatR16UnsignedNormalized, and *image.RGBA64 and *image.NRGBA64 to
+// core1_0.FormatR16G16B16A16UnsignedNormalized. Every other image type is converted to
+// core1_0.FormatR8G8B8A8SRGB, or core1_0.FormatR8G8B8A8UnsignedNormalized if linear is true.
+// Color channels are never premultiplied by alpha, so *image.RGBA and *image.RGBA64 pixels are
+// converted to non-premultiplied alpha.
+//
+// img - The image to convert
+//
+// linear - If true, 8-bit color data is stored in an unsigned normalized format rather than an
+// sRGB format, for images such as normal maps that do not hold sRGB-encoded color
+func TexelsFromImage(img image.Image, linear bool) (core1_0.Format, []byte) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	switch src := img.(type) {
+	case *image.Gray:
+		data := make([]byte, 0, width*height)
+		for y := 0; y < height; y++ {
+			start := src.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			data = append(data, src.Pix[start:start+width]...)
+		}
+		return core1_0.FormatR8UnsignedNormalized, data
+	case *image.Gray16:
+		data := make([]byte, width*height*2)
+		for y := 0; y < height; y++ {
+			start := src.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			for x := 0; x < width; x++ {
+				binary.LittleEndian.PutUint16(data[(y*width+x)*2:], binary.BigEndian.Uint16(src.Pix[start+x*2:]))
+			}
+		}
+		return core1_0.FormatR16UnsignedNormalized, data
+	case *image.NRGBA64, *image.RGBA64:
+		data := make([]byte, width*height*8)
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				pixel := color.NRGBA64Model.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA64)
+				texel := data[(y*width+x)*8:]
+				binary.LittleEndian.PutUint16(texel[0:], pixel.R)
+				binary.LittleEndian.PutUint16(texel[2:], pixel.G)
+				binary.LittleEndian.PutUint16(texel[4:], pixel.B)
+				binary.LittleEndian.PutUint16(texel[6:], pixel.A)
+			}
+		}
+		return core1_0.FormatR16G16B16A16UnsignedNormalized, data
+	}
+
+	format := core1_0.FormatR8G8B8A8SRGB
+	if linear {
+		format = core1_0.FormatR8G8B8A8UnsignedNormalized
+	}
+
+	data := make([]byte, 0, width*height*4)
+	if src, ok := img.(*image.NRGBA); ok {
+		for y := 0; y < height; y++ {
+			start := src.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			data = append(data, src.Pix[start:start+width*4]...)
+		}
+		return format, data
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			pixel := color.NRGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+			data = append(data, pixel.R, pixel.G, pixel.B, pixel.A)
+		}
+	}
+	return format, data
+}
+
+// channelValue reads a single little-endian 16-bit unsigned normalized, 16-bit float, or 32-bit
+// float channel and converts it to a 16-bit unsigned normalized value
+func channelValue(format core1_0.Format, data []byte) uint16 {
+	var value float32
+	switch format {
+	case core1_0.FormatR16UnsignedNormalized, core1_0.FormatR16G16B16A16UnsignedNormalized:
+		return binary.LittleEndian.Uint16(data)
+	case core1_0.FormatR16SignedFloat, core1_0.FormatR16G16B16A16SignedFloat:
+		value = halfToFloat32(binary.LittleEndian.Uint16(data))
+	default:
+		value = math.Float32frombits(binary.LittleEndian.Uint32(data))
+	}
+
+	if !(value > 0) {
+		// Negative values and NaN
+		return 0
+	}
+	if value >= 1 {
+		return math.MaxUint16
+	}
+	return uint16(value*math.MaxUint16 + 0.5)
+}
+
+// halfToFloat32 converts an IEEE 754 half-precision float to a float32
+func halfToFloat32(half uint16) float32 {
+	sign := uint32(half>>15) << 31
+	exponent := uint32(half>>10) & 0x1f
+	mantissa := uint32(half) & 0x3ff
+
+	switch {
+	case exponent == 0x1f:
+		// Infinity and NaN
+		return math.Float32frombits(sign | 0xff<<23 | mantissa<<13)
+	case exponent != 0:
+		return math.Float32frombits(sign | (exponent+127-15)<<23 | mantissa<<13)
+	case mantissa == 0:
+		return math.Float32frombits(sign)
+	}
+
+	// Subnormal halves are normal float32s
+	value := float32(mantissa) / (1 << 24)
+	if sign != 0 {
+		value = -value
+	}
+	return value
+}
@@ -0,0 +1,335 @@
+package memory
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"sort"
+	"unsafe"
+)
+
+// MappingInfo describes a range of DeviceMemory to map with MapMemory
+type MappingInfo struct {
+	// Offset is the offset in bytes from the start of the DeviceMemory to map. If the memory is
+	// not host-coherent, it must be a multiple of NonCoherentAtomSize.
+	Offset int
+	// Size is the number of bytes to map. It must be greater than zero.
+	Size int
+	// PropertyFlags are the properties of the memory type that the DeviceMemory was allocated
+	// from. Flushing and invalidating are skipped entirely when they include
+	// core1_0.MemoryPropertyHostCoherent.
+	PropertyFlags core1_0.MemoryPropertyFlags
+	// NonCoherentAtomSize is core1_0.PhysicalDeviceLimits.NonCoherentAtomSize for the
+	// PhysicalDevice that the DeviceMemory was allocated from
+	NonCoherentAtomSize int
+}
+
+type memoryRange struct {
+	start int
+	end   int
+}
+
+// Mapping is a range of host-visible DeviceMemory mapped into application address space. It
+// records the ranges that the application has written so that Flush only flushes what has
+// changed, rounding each range out to NonCoherentAtomSize. For host-coherent memory, Flush and
+// Invalidate do nothing.
+//
+// Mapping is not safe for concurrent use.
+type Mapping struct {
+	device  core1_0.Device
+	memory  core1_0.DeviceMemory
+	pointer unsafe.Pointer
+
+	offset int
+	size   int
+
+	// limit is the offset in DeviceMemory beyond which flushed and invalidated ranges must not
+	// be rounded. If wholeSizeAtLimit is true, the limit is the end of the current mapping of
+	// the DeviceMemory, and ranges that round past it are extended to the end of the mapping
+	// instead.
+	limit            int
+	wholeSizeAtLimit bool
+
+	coherent bool
+	atomSize int
+
+	dirty    []memoryRange
+	unmap    func()
+	unmapped bool
+}
+
+// MapMemory maps a range of DeviceMemory and returns a Mapping that tracks writes to it. The
+// DeviceMemory must not already be mapped, and must not be part of an Allocator block: use
+// Allocation.Mapping for those.
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkMapMemory.html
+func MapMemory(device core1_0.Device, memory core1_0.DeviceMemory, info MappingInfo) (*Mapping, common.VkResult, error) {
+	if device == nil {
+		return nil, core1_0.VKErrorUnknown, common.NilArgumentError("MapMemory", "device")
+	}
+	if memory == nil {
+		return nil, core1_0.VKErrorUnknown, common.NilArgumentError("MapMemory", "memory")
+	}
+	if info.Size <= 0 {
+		return nil, core1_0.VKErrorUnknown, errors.Newf("attempted to map %d bytes of DeviceMemory, but at least one byte must be mapped", info.Size)
+	}
+	if info.PropertyFlags&core1_0.MemoryPropertyHostVisible == 0 {
+		return nil, core1_0.VKErrorMemoryMapFailed, errors.New("attempted to map DeviceMemory from a memory type that is not host-visible")
+	}
+	if info.PropertyFlags&core1_0.MemoryPropertyHostCoherent == 0 && info.NonCoherentAtomSize > 1 && info.Offset%info.NonCoherentAtomSize != 0 {
+		return nil, core1_0.VKErrorMemoryMapFailed, errors.Newf("attempted to map non-coherent DeviceMemory at offset %d, which is not a multiple of NonCoherentAtomSize %d", info.Offset, info.NonCoherentAtomSize)
+	}
+
+	ptr, res, err := memory.Map(info.Offset, info.Size, 0)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return &Mapping{
+		device:           device,
+		memory:           memory,
+		pointer:          ptr,
+		offset:           info.Offset,
+		size:             info.Size,
+		limit:            info.Offset + info.Size,
+		wholeSizeAtLimit: true,
+		coherent:         info.PropertyFlags&core1_0.MemoryPropertyHostCoherent != 0,
+		atomSize:         info.NonCoherentAtomSize,
+		unmap:            memory.Unmap,
+	}, res, nil
+}
+
+// Mapping maps this Allocation and returns a Mapping that tracks writes to it. Each call maps
+// the Allocation again, and each Mapping must be unmapped separately.
+func (a *Allocation) Mapping() (*Mapping, common.VkResult, error) {
+	ptr, res, err := a.Map()
+	if err != nil {
+		return nil, res, err
+	}
+
+	a.allocator.lock.Lock()
+	limit := a.offset + a.size
+	wholeSizeAtLimit := true
+	if a.block.buddy != nil {
+		// Ranges can be rounded out to the end of the node this Allocation occupies, which is
+		// aligned to NonCoherentAtomSize for non-coherent memory types
+		limit = a.offset + orderSize(a.block.buddy.allocated[a.offset])
+		wholeSizeAtLimit = false
+	}
+	a.allocator.lock.Unlock()
+
+	return &Mapping{
+		device:           a.allocator.device,
+		memory:           a.block.memory,
+		pointer:          ptr,
+		offset:           a.offset,
+		size:             a.size,
+		limit:            limit,
+		wholeSizeAtLimit: wholeSizeAtLimit,
+		coherent:         a.MemoryPropertyFlags()&core1_0.MemoryPropertyHostCoherent != 0,
+		atomSize:         a.allocator.nonCoherentAtomSize,
+		unmap:            a.Unmap,
+	}, res, nil
+}
+
+// Pointer returns a pointer to the first mapped byte. Writes made through the pointer are not
+// tracked: call MarkDirty for them before calling Flush.
+func (m *Mapping) Pointer() unsafe.Pointer {
+	if m.unmapped {
+		return nil
+	}
+	return m.pointer
+}
+
+// Size is the number of bytes mapped
+func (m *Mapping) Size() int {
+	return m.size
+}
+
+// Coherent returns true if the mapped memory is host-coherent, in which case Flush and
+// Invalidate do nothing
+func (m *Mapping) Coherent() bool {
+	return m.coherent
+}
+
+// MarkDirty records that the application has written to size bytes starting offset bytes into
+// the Mapping, so that they will be flushed by the next call to Flush. A size of -1 marks
+// everything from offset to the end of the Mapping.
+func (m *Mapping) MarkDirty(offset int, size int) error {
+	start, end, err := m.bounds(offset, size)
+	if err != nil {
+		return err
+	}
+	if m.coherent || start == end {
+		return nil
+	}
+
+	m.dirty = mergeRange(m.dirty, memoryRange{start: start, end: end})
+	return nil
+}
+
+// Flush makes every range marked dirty since the last call to Flush available to the Device.
+// It does nothing for host-coherent memory.
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkFlushMappedMemoryRanges.html
+func (m *Mapping) Flush() (common.VkResult, error) {
+	if m.unmapped {
+		return core1_0.VKErrorMemoryMapFailed, errors.New("attempted to flush a Mapping that has been unmapped")
+	}
+	if len(m.dirty) == 0 {
+		return core1_0.VKSuccess, nil
+	}
+
+	res, err := m.device.FlushMappedMemoryRanges(m.mappedRanges(m.dirty))
+	if err != nil {
+		return res, err
+	}
+
+	m.dirty = nil
+	return res, nil
+}
+
+// Invalidate makes writes that the Device has made to size bytes starting offset bytes into the
+// Mapping visible to the host. A size of -1 invalidates everything from offset to the end of
+// the Mapping. It does nothing for host-coherent memory.
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkInvalidateMappedMemoryRanges.html
+func (m *Mapping) Invalidate(offset int, size int) (common.VkResult, error) {
+	if m.unmapped {
+		return core1_0.VKErrorMemoryMapFailed, errors.New("attempted to invalidate a Mapping that has been unmapped")
+	}
+
+	start, end, err := m.bounds(offset, size)
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+	if m.coherent || start == end {
+		return core1_0.VKSuccess, nil
+	}
+
+	return m.device.InvalidateMappedMemoryRanges(m.mappedRanges([]memoryRange{{start: start, end: end}}))
+}
+
+// Unmap flushes any ranges that are still marked dirty and unmaps the memory. Calling Unmap
+// more than once has no effect.
+//
+// https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/vkUnmapMemory.html
+func (m *Mapping) Unmap() (common.VkResult, error) {
+	if m.unmapped {
+		return core1_0.VKSuccess, nil
+	}
+
+	res, err := m.Flush()
+	m.unmap()
+	m.unmapped = true
+	m.dirty = nil
+
+	return res, err
+}
+
+// MapSlice returns a slice of count values of type T that views the mapped memory starting
+// offset bytes into the Mapping. The viewed range is marked dirty, so writes through the slice
+// are flushed by the next call to Mapping.Flush. The slice must not be used after the Mapping
+// is unmapped.
+func MapSlice[T any](mapping *Mapping, offset int, count int) ([]T, error) {
+	if mapping == nil {
+		return nil, common.NilArgumentError("MapSlice", "mapping")
+	}
+	if mapping.unmapped {
+		return nil, errors.New("attempted to view a Mapping that has been unmapped")
+	}
+	if count < 0 {
+		return nil, errors.Newf("attempted to view %d values of mapped memory", count)
+	}
+
+	var zero T
+	size := int(unsafe.Sizeof(zero)) * count
+	alignment := int(unsafe.Alignof(zero))
+	if (uintptr(mapping.pointer)+uintptr(offset))%uintptr(alignment) != 0 {
+		return nil, errors.Newf("attempted to view mapped memory at offset %d as %T, which requires %d-byte alignment", offset, zero, alignment)
+	}
+
+	err := mapping.MarkDirty(offset, size)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return []T{}, nil
+	}
+
+	return unsafe.Slice((*T)(unsafe.Add(mapping.pointer, offset)), count), nil
+}
+
+// bounds validates a range relative to the start of the Mapping and returns it relative to the
+// start of the DeviceMemory
+func (m *Mapping) bounds(offset int, size int) (int, int, error) {
+	if size == -1 {
+		size = m.size - offset
+	}
+	if offset < 0 || size < 0 || offset+size > m.size {
+		return 0, 0, errors.Newf("attempted to access %d bytes at offset %d of a Mapping that is only %d bytes", size, offset, m.size)
+	}
+
+	return m.offset + offset, m.offset + offset + size, nil
+}
+
+// mappedRanges rounds ranges out to NonCoherentAtomSize and converts them to
+// core1_0.MappedMemoryRange structures, merging any that overlap after rounding
+func (m *Mapping) mappedRanges(ranges []memoryRange) []core1_0.MappedMemoryRange {
+	atomSize := m.atomSize
+	if atomSize < 1 {
+		atomSize = 1
+	}
+
+	var rounded []memoryRange
+	for _, r := range ranges {
+		start := r.start - r.start%atomSize
+		end := r.end
+		if end%atomSize != 0 {
+			end += atomSize - end%atomSize
+		}
+		if end > m.limit {
+			end = m.limit
+		}
+		rounded = mergeRange(rounded, memoryRange{start: start, end: end})
+	}
+
+	mappedRanges := make([]core1_0.MappedMemoryRange, 0, len(rounded))
+	for _, r := range rounded {
+		size := r.end - r.start
+		if r.end == m.limit && m.wholeSizeAtLimit && m.limit%atomSize != 0 {
+			size = -1
+		}
+
+		mappedRanges = append(mappedRanges, core1_0.MappedMemoryRange{
+			Memory: m.memory,
+			Offset: r.start,
+			Size:   size,
+		})
+	}
+
+	return mappedRanges
+}
+
+// mergeRange inserts r into a sorted list of disjoint ranges, merging it with any ranges that it
+// overlaps or touches
+func mergeRange(ranges []memoryRange, r memoryRange) []memoryRange {
+	index := sort.Search(len(ranges), func(i int) bool {
+		return ranges[i].end >= r.start
+	})
+
+	last := index
+	for last < len(ranges) && ranges[last].start <= r.end {
+		if ranges[last].start < r.start {
+			r.start = ranges[last].start
+		}
+		if ranges[last].end > r.end {
+			r.end = ranges[last].end
+		}
+		last++
+	}
+
+	merged := append([]memoryRange{}, ranges[:index]...)
+	merged = append(merged, r)
+	return append(merged, ranges[last:]...)
+}
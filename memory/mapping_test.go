@@ -0,0 +1,248 @@
+package memory_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"github.com/vkngwrapper/core/v2/memory"
+	"github.com/vkngwrapper/core/v2/mocks"
+	"testing"
+	"unsafe"
+)
+
+func nonCoherentDriver() *fake.Driver {
+	physicalDevice := fake.DefaultPhysicalDevice()
+	physicalDevice.MemoryProperties.MemoryTypes[1].PropertyFlags = core1_0.MemoryPropertyHostVisible
+	physicalDevice.MemoryProperties.MemoryTypes[2].PropertyFlags = core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCached
+
+	return fake.NewDriver(fake.Config{
+		PhysicalDevices: []fake.PhysicalDevice{physicalDevice},
+	})
+}
+
+func TestMapMemory_FlushRoundsToNonCoherentAtomSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	device := mocks.NewMockDevice(ctrl)
+	deviceMemory := mocks.EasyMockDeviceMemory(ctrl)
+	backing := make([]uint32, 250)
+
+	deviceMemory.EXPECT().Map(256, 1000, core1_0.MemoryMapFlags(0)).Return(unsafe.Pointer(&backing[0]), core1_0.VKSuccess, nil)
+
+	mapping, _, err := memory.MapMemory(device, deviceMemory, memory.MappingInfo{
+		Offset:              256,
+		Size:                1000,
+		PropertyFlags:       core1_0.MemoryPropertyHostVisible,
+		NonCoherentAtomSize: 256,
+	})
+	require.NoError(t, err)
+	require.False(t, mapping.Coherent())
+
+	values, err := memory.MapSlice[uint32](mapping, 4, 2)
+	require.NoError(t, err)
+	values[0] = 7
+	values[1] = 11
+	require.Equal(t, []uint32{0, 7, 11, 0}, backing[:4])
+
+	bytes, err := memory.MapSlice[byte](mapping, 600, 10)
+	require.NoError(t, err)
+	require.Len(t, bytes, 10)
+	require.NoError(t, mapping.MarkDirty(44, 10))
+
+	device.EXPECT().FlushMappedMemoryRanges([]core1_0.MappedMemoryRange{
+		{Memory: deviceMemory, Offset: 256, Size: 256},
+		{Memory: deviceMemory, Offset: 768, Size: 256},
+	}).Return(core1_0.VKSuccess, nil)
+
+	_, err = mapping.Flush()
+	require.NoError(t, err)
+
+	// Nothing has been written since the last flush
+	_, err = mapping.Flush()
+	require.NoError(t, err)
+
+	// Ranges that round past the end of the mapping extend to the end of the mapping instead
+	require.NoError(t, mapping.MarkDirty(990, -1))
+	device.EXPECT().FlushMappedMemoryRanges([]core1_0.MappedMemoryRange{
+		{Memory: deviceMemory, Offset: 1024, Size: -1},
+	}).Return(core1_0.VKSuccess, nil)
+
+	_, err = mapping.Flush()
+	require.NoError(t, err)
+
+	device.EXPECT().InvalidateMappedMemoryRanges([]core1_0.MappedMemoryRange{
+		{Memory: deviceMemory, Offset: 256, Size: 512},
+	}).Return(core1_0.VKSuccess, nil)
+
+	_, err = mapping.Invalidate(100, 300)
+	require.NoError(t, err)
+
+	deviceMemory.EXPECT().Unmap()
+	_, err = mapping.Unmap()
+	require.NoError(t, err)
+
+	_, err = mapping.Unmap()
+	require.NoError(t, err)
+	require.Equal(t, unsafe.Pointer(nil), mapping.Pointer())
+}
+
+func TestMapMemory_CoherentMemoryIsNotFlushed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	device := mocks.NewMockDevice(ctrl)
+	deviceMemory := mocks.EasyMockDeviceMemory(ctrl)
+	backing := make([]byte, 512)
+
+	deviceMemory.EXPECT().Map(0, 512, core1_0.MemoryMapFlags(0)).Return(unsafe.Pointer(&backing[0]), core1_0.VKSuccess, nil)
+	deviceMemory.EXPECT().Unmap()
+
+	mapping, _, err := memory.MapMemory(device, deviceMemory, memory.MappingInfo{
+		Size:                512,
+		PropertyFlags:       core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent,
+		NonCoherentAtomSize: 256,
+	})
+	require.NoError(t, err)
+	require.True(t, mapping.Coherent())
+
+	values, err := memory.MapSlice[float32](mapping, 16, 4)
+	require.NoError(t, err)
+	copy(values, []float32{1, 2, 3, 4})
+
+	_, err = mapping.Flush()
+	require.NoError(t, err)
+	_, err = mapping.Invalidate(0, -1)
+	require.NoError(t, err)
+	_, err = mapping.Unmap()
+	require.NoError(t, err)
+}
+
+func TestMapMemory_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	device := mocks.NewMockDevice(ctrl)
+	deviceMemory := mocks.EasyMockDeviceMemory(ctrl)
+	backing := make([]uint64, 64)
+
+	_, _, err := memory.MapMemory(device, deviceMemory, memory.MappingInfo{
+		Offset:              64,
+		Size:                256,
+		PropertyFlags:       core1_0.MemoryPropertyHostVisible,
+		NonCoherentAtomSize: 256,
+	})
+	require.EqualError(t, err, "attempted to map non-coherent DeviceMemory at offset 64, which is not a multiple of NonCoherentAtomSize 256")
+
+	_, _, err = memory.MapMemory(device, deviceMemory, memory.MappingInfo{
+		Size:          256,
+		PropertyFlags: core1_0.MemoryPropertyDeviceLocal,
+	})
+	require.EqualError(t, err, "attempted to map DeviceMemory from a memory type that is not host-visible")
+
+	deviceMemory.EXPECT().Map(0, 512, core1_0.MemoryMapFlags(0)).Return(unsafe.Pointer(&backing[0]), core1_0.VKSuccess, nil)
+	deviceMemory.EXPECT().Unmap()
+
+	mapping, _, err := memory.MapMemory(device, deviceMemory, memory.MappingInfo{
+		Size:          512,
+		PropertyFlags: core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent,
+	})
+	require.NoError(t, err)
+
+	_, err = memory.MapSlice[uint32](mapping, 2, 1)
+	require.EqualError(t, err, "attempted to view mapped memory at offset 2 as uint32, which requires 4-byte alignment")
+
+	_, err = memory.MapSlice[uint64](mapping, 8, 64)
+	require.EqualError(t, err, "attempted to access 512 bytes at offset 8 of a Mapping that is only 512 bytes")
+
+	_, err = mapping.Unmap()
+	require.NoError(t, err)
+
+	_, err = memory.MapSlice[uint64](mapping, 0, 1)
+	require.EqualError(t, err, "attempted to view a Mapping that has been unmapped")
+}
+
+func TestMapMemory_AlreadyMapped(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	_, device := createDevice(t, fakeDriver, common.Vulkan1_0)
+
+	deviceMemory, _, err := device.AllocateMemory(nil, core1_0.MemoryAllocateInfo{
+		AllocationSize:  1024,
+		MemoryTypeIndex: 1,
+	})
+	require.NoError(t, err)
+
+	info := memory.MappingInfo{
+		Size:          1024,
+		PropertyFlags: core1_0.MemoryPropertyHostVisible | core1_0.MemoryPropertyHostCoherent,
+	}
+	mapping, _, err := memory.MapMemory(device, deviceMemory, info)
+	require.NoError(t, err)
+
+	_, res, err := memory.MapMemory(device, deviceMemory, info)
+	require.Error(t, err)
+	require.Equal(t, core1_0.VKErrorMemoryMapFailed, res)
+
+	_, err = mapping.Unmap()
+	require.NoError(t, err)
+
+	mapping, _, err = memory.MapMemory(device, deviceMemory, info)
+	require.NoError(t, err)
+	_, err = mapping.Unmap()
+	require.NoError(t, err)
+
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestAllocation_Mapping(t *testing.T) {
+	fakeDriver := nonCoherentDriver()
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	info := memory.AllocationCreateInfo{
+		RequiredProperties: core1_0.MemoryPropertyHostVisible,
+	}
+	allocation1, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 100), info)
+	require.NoError(t, err)
+	allocation2, _, err := allocator.AllocateBufferMemory(createBuffer(t, device, 100), info)
+	require.NoError(t, err)
+	require.Equal(t, allocation1.Memory(), allocation2.Memory())
+
+	mapping1, _, err := allocation1.Mapping()
+	require.NoError(t, err)
+	mapping2, _, err := allocation2.Mapping()
+	require.NoError(t, err)
+	require.False(t, mapping1.Coherent())
+
+	values1, err := memory.MapSlice[float32](mapping1, 0, 25)
+	require.NoError(t, err)
+	values2, err := memory.MapSlice[float32](mapping2, 96, 1)
+	require.NoError(t, err)
+	values1[24] = 0.5
+	values2[0] = 2
+
+	_, err = mapping1.Flush()
+	require.NoError(t, err)
+	_, err = mapping2.Invalidate(0, -1)
+	require.NoError(t, err)
+
+	contents := fakeDriver.MemoryBytes(allocation1.Memory().Handle())
+	require.Equal(t, float32(0.5), *(*float32)(unsafe.Pointer(&contents[allocation1.Offset()+96])))
+	require.Equal(t, float32(2), *(*float32)(unsafe.Pointer(&contents[allocation2.Offset()+96])))
+
+	_, err = mapping1.Unmap()
+	require.NoError(t, err)
+	_, err = mapping2.Unmap()
+	require.NoError(t, err)
+
+	// Both mappings have been released, so the block is no longer mapped
+	_, _, err = allocation1.Memory().Map(0, -1, 0)
+	require.NoError(t, err)
+	allocation1.Memory().Unmap()
+
+	require.Empty(t, fakeDriver.Errors())
+}
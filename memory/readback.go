@@ -0,0 +1,329 @@
+package memory
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"unsafe"
+)
+
+// ReadbackSubresource describes a single subresource of an Image to read back with
+// Allocator.ReadbackImage. core1_0.Image does not record how it was created, so the format and
+// extent it was created with must be provided.
+type ReadbackSubresource struct {
+	// Format is the format the Image was created with
+	Format core1_0.Format
+	// Extent is the extent the Image was created with. The extent of the subresource is
+	// calculated from it and MipLevel.
+	Extent core1_0.Extent3D
+	// AspectMask is a single aspect of the Image to read back: core1_0.ImageAspectColor,
+	// core1_0.ImageAspectDepth, or core1_0.ImageAspectStencil
+	AspectMask core1_0.ImageAspectFlags
+	// MipLevel is the mip level to read back
+	MipLevel int
+	// ArrayLayer is the array layer to read back
+	ArrayLayer int
+}
+
+// ReadbackBuffer copies size bytes starting offset bytes into a Buffer to host memory and returns
+// them. It records the copy into a one-time CommandBuffer, submits it to queue, and waits for it
+// to complete, so it is intended for tests and screenshots rather than per-frame use. If waiting
+// for the copy fails, the staging Buffer and the objects used to submit the copy are leaked rather
+// than destroyed while the Device may still be using them.
+//
+// Commands that write to the Buffer must have been submitted before ReadbackBuffer is called.
+// The Buffer must have been created with core1_0.BufferUsageTransferSrc.
+//
+// queue - The Queue to submit the copy to. It must support transfer operations.
+//
+// queueFamilyIndex - The index of the Queue family that queue belongs to
+//
+// buffer - The Buffer to read from
+//
+// offset - The offset in bytes from the start of buffer to read from
+//
+// size - The number of bytes to read
+func (a *Allocator) ReadbackBuffer(queue core1_0.Queue, queueFamilyIndex int, buffer core1_0.Buffer, offset int, size int) ([]byte, common.VkResult, error) {
+	if queue == nil {
+		return nil, core1_0.VKErrorUnknown, common.NilArgumentError("ReadbackBuffer", "queue")
+	}
+	if buffer == nil {
+		return nil, core1_0.VKErrorUnknown, common.NilArgumentError("ReadbackBuffer", "buffer")
+	}
+	if offset < 0 || size <= 0 {
+		return nil, core1_0.VKErrorUnknown, errors.Newf("attempted to read back %d bytes at offset %d of a Buffer", size, offset)
+	}
+
+	return a.readback(queue, queueFamilyIndex, size, func(commandBuffer core1_0.CommandBuffer, staging core1_0.Buffer) error {
+		err := commandBuffer.CmdPipelineBarrier(core1_0.PipelineStageAllCommands, core1_0.PipelineStageTransfer, 0, nil, []core1_0.BufferMemoryBarrier{
+			{
+				SrcAccessMask:       core1_0.AccessMemoryWrite,
+				DstAccessMask:       core1_0.AccessTransferRead,
+				SrcQueueFamilyIndex: queueFamilyIndex,
+				DstQueueFamilyIndex: queueFamilyIndex,
+				Buffer:              buffer,
+				Offset:              offset,
+				Size:                size,
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		return commandBuffer.CmdCopyBuffer(buffer, staging, []core1_0.BufferCopy{
+			{
+				SrcOffset: offset,
+				Size:      size,
+			},
+		})
+	})
+}
+
+// ReadbackImage copies a single subresource of an Image to host memory and returns its texels,
+// tightly packed row by row and slice by slice. Each texel is laid out as
+// CmdCopyImageToBuffer lays out the requested aspect of the Image's format; TexelSize reports
+// its size. Like ReadbackBuffer, it submits the copy to queue and waits for it to complete.
+//
+// Commands that write to the Image must have been submitted before ReadbackImage is called. The
+// Image must have been created with core1_0.ImageUsageTransferSrc, and is transitioned to
+// core1_0.ImageLayoutTransferSrcOptimal for the copy and back to layout afterward.
+//
+// queue - The Queue to submit the copy to. It must support transfer operations.
+//
+// queueFamilyIndex - The index of the Queue family that queue belongs to
+//
+// image - The Image to read from
+//
+// layout - The layout that the subresource is in when ReadbackImage is called, and will be in
+// when it returns
+//
+// subresource - The subresource of image to read
+func (a *Allocator) ReadbackImage(queue core1_0.Queue, queueFamilyIndex int, image core1_0.Image, layout core1_0.ImageLayout, subresource ReadbackSubresource) ([]byte, common.VkResult, error) {
+	if queue == nil {
+		return nil, core1_0.VKErrorUnknown, common.NilArgumentError("ReadbackImage", "queue")
+	}
+	if image == nil {
+		return nil, core1_0.VKErrorUnknown, common.NilArgumentError("ReadbackImage", "image")
+	}
+	if layout == core1_0.ImageLayoutUndefined || layout == core1_0.ImageLayoutPreInitialized {
+		return nil, core1_0.VKErrorUnknown, errors.Newf("attempted to read back an Image in layout %s, which does not preserve its contents", layout)
+	}
+
+	texelSize := TexelSize(subresource.Format, subresource.AspectMask)
+	if texelSize == 0 {
+		return nil, core1_0.VKErrorFormatNotSupported, errors.Newf("attempted to read back aspect %s of an Image with format %s, which is not supported", subresource.AspectMask, subresource.Format)
+	}
+
+	extent := MipExtent(subresource.Extent, subresource.MipLevel)
+	size := extent.Width * extent.Height * extent.Depth * texelSize
+	if size <= 0 {
+		return nil, core1_0.VKErrorUnknown, errors.Newf("attempted to read back an Image with extent %dx%dx%d", extent.Width, extent.Height, extent.Depth)
+	}
+
+	subresourceRange := core1_0.ImageSubresourceRange{
+		AspectMask:     subresource.AspectMask,
+		BaseMipLevel:   subresource.MipLevel,
+		LevelCount:     1,
+		BaseArrayLayer: subresource.ArrayLayer,
+		LayerCount:     1,
+	}
+
+	copyLayout := core1_0.ImageLayoutTransferSrcOptimal
+	if layout == core1_0.ImageLayoutGeneral {
+		copyLayout = layout
+	}
+
+	return a.readback(queue, queueFamilyIndex, size, func(commandBuffer core1_0.CommandBuffer, staging core1_0.Buffer) error {
+		err := commandBuffer.CmdPipelineBarrier(core1_0.PipelineStageAllCommands, core1_0.PipelineStageTransfer, 0, nil, nil, []core1_0.ImageMemoryBarrier{
+			{
+				SrcAccessMask:       core1_0.AccessMemoryWrite,
+				DstAccessMask:       core1_0.AccessTransferRead,
+				OldLayout:           layout,
+				NewLayout:           copyLayout,
+				SrcQueueFamilyIndex: queueFamilyIndex,
+				DstQueueFamilyIndex: queueFamilyIndex,
+				Image:               image,
+				SubresourceRange:    subresourceRange,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = commandBuffer.CmdCopyImageToBuffer(image, copyLayout, staging, []core1_0.BufferImageCopy{
+			{
+				ImageSubresource: core1_0.ImageSubresourceLayers{
+					AspectMask:     subresource.AspectMask,
+					MipLevel:       subresource.MipLevel,
+					BaseArrayLayer: subresource.ArrayLayer,
+					LayerCount:     1,
+				},
+				ImageExtent: extent,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		// The copy only reads the Image, so there are no writes to make available
+		return commandBuffer.CmdPipelineBarrier(core1_0.PipelineStageTransfer, core1_0.PipelineStageAllCommands, 0, nil, nil, []core1_0.ImageMemoryBarrier{
+			{
+				DstAccessMask:       core1_0.AccessMemoryRead | core1_0.AccessMemoryWrite,
+				OldLayout:           copyLayout,
+				NewLayout:           layout,
+				SrcQueueFamilyIndex: queueFamilyIndex,
+				DstQueueFamilyIndex: queueFamilyIndex,
+				Image:               image,
+				SubresourceRange:    subresourceRange,
+			},
+		})
+	})
+}
+
+// MipExtent returns the extent of a mip level of an Image created with the provided extent
+func MipExtent(extent core1_0.Extent3D, mipLevel int) core1_0.Extent3D {
+	for level := 0; level < mipLevel; level++ {
+		extent.Width = maxInt(extent.Width/2, 1)
+		extent.Height = maxInt(extent.Height/2, 1)
+		extent.Depth = maxInt(extent.Depth/2, 1)
+	}
+
+	return extent
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// readback creates a host-visible staging Buffer of the requested size, records commands that
+// copy into it with record, submits them and waits for them to complete, and returns the contents
+// of the staging Buffer. If the commands are submitted but cannot be waited on, the staging Buffer
+// and the objects used to submit the commands are leaked, since the Device may still be using them.
+func (a *Allocator) readback(queue core1_0.Queue, queueFamilyIndex int, size int, record func(commandBuffer core1_0.CommandBuffer, staging core1_0.Buffer) error) ([]byte, common.VkResult, error) {
+	// pending is true while the submitted commands may still be executing
+	pending := false
+
+	staging, res, err := a.device.CreateBuffer(a.callbacks, core1_0.BufferCreateInfo{
+		Size:        size,
+		Usage:       core1_0.BufferUsageTransferDst,
+		SharingMode: core1_0.SharingModeExclusive,
+	})
+	if err != nil {
+		return nil, res, err
+	}
+
+	allocation, res, err := a.AllocateBufferMemory(staging, AllocationCreateInfo{
+		Usage: core1_0.MemoryUsageReadback,
+	})
+	if err != nil {
+		staging.Destroy(a.callbacks)
+		return nil, res, err
+	}
+	defer func() {
+		if pending {
+			return
+		}
+		staging.Destroy(a.callbacks)
+		allocation.Free()
+	}()
+
+	commandPool, res, err := a.device.CreateCommandPool(a.callbacks, core1_0.CommandPoolCreateInfo{
+		QueueFamilyIndex: queueFamilyIndex,
+		Flags:            core1_0.CommandPoolCreateTransient,
+	})
+	if err != nil {
+		return nil, res, err
+	}
+	defer func() {
+		if !pending {
+			commandPool.Destroy(a.callbacks)
+		}
+	}()
+
+	commandBuffers, res, err := a.device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        commandPool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 1,
+	})
+	if err != nil {
+		return nil, res, err
+	}
+	commandBuffer := commandBuffers[0]
+
+	res, err = commandBuffer.Begin(core1_0.CommandBufferBeginInfo{
+		Flags: core1_0.CommandBufferUsageOneTimeSubmit,
+	})
+	if err != nil {
+		return nil, res, err
+	}
+
+	err = record(commandBuffer, staging)
+	if err != nil {
+		return nil, core1_0.VKErrorUnknown, err
+	}
+
+	err = commandBuffer.CmdPipelineBarrier(core1_0.PipelineStageTransfer, core1_0.PipelineStageHost, 0, nil, []core1_0.BufferMemoryBarrier{
+		{
+			SrcAccessMask:       core1_0.AccessTransferWrite,
+			DstAccessMask:       core1_0.AccessHostRead,
+			SrcQueueFamilyIndex: queueFamilyIndex,
+			DstQueueFamilyIndex: queueFamilyIndex,
+			Buffer:              staging,
+			Size:                size,
+		},
+	}, nil)
+	if err != nil {
+		return nil, core1_0.VKErrorUnknown, err
+	}
+
+	res, err = commandBuffer.End()
+	if err != nil {
+		return nil, res, err
+	}
+
+	fence, res, err := a.device.CreateFence(a.callbacks, core1_0.FenceCreateInfo{})
+	if err != nil {
+		return nil, res, err
+	}
+	defer func() {
+		if !pending {
+			fence.Destroy(a.callbacks)
+		}
+	}()
+
+	res, err = queue.Submit(fence, []core1_0.SubmitInfo{
+		{
+			CommandBuffers: []core1_0.CommandBuffer{commandBuffer},
+		},
+	})
+	if err != nil {
+		return nil, res, err
+	}
+
+	pending = true
+	res, err = fence.Wait(common.NoTimeout)
+	if err != nil {
+		return nil, res, err
+	}
+	pending = false
+
+	mapping, res, err := allocation.Mapping()
+	if err != nil {
+		return nil, res, err
+	}
+	defer mapping.Unmap()
+
+	res, err = mapping.Invalidate(0, -1)
+	if err != nil {
+		return nil, res, err
+	}
+
+	// The mapped memory is only read, so it is viewed without MapSlice, which would mark it dirty
+	// and cause Unmap to flush it back to the Device
+	data := make([]byte, size)
+	copy(data, unsafe.Slice((*byte)(mapping.Pointer()), size))
+	return data, res, nil
+}
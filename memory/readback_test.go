@@ -0,0 +1,276 @@
+package memory_test
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"github.com/vkngwrapper/core/v2/memory"
+	"image"
+	"image/color"
+	"testing"
+)
+
+// flushCountingDriver counts calls to vkFlushMappedMemoryRanges
+type flushCountingDriver struct {
+	driver.Driver
+	flushes *int
+}
+
+func (d *flushCountingDriver) CreateInstanceDriver(instance driver.VkInstance) (driver.Driver, error) {
+	instanceDriver, err := d.Driver.CreateInstanceDriver(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &flushCountingDriver{Driver: instanceDriver, flushes: d.flushes}, nil
+}
+
+func (d *flushCountingDriver) CreateDeviceDriver(device driver.VkDevice) (driver.Driver, error) {
+	deviceDriver, err := d.Driver.CreateDeviceDriver(device)
+	if err != nil {
+		return nil, err
+	}
+
+	return &flushCountingDriver{Driver: deviceDriver, flushes: d.flushes}, nil
+}
+
+func (d *flushCountingDriver) VkFlushMappedMemoryRanges(device driver.VkDevice, memoryRangeCount driver.Uint32, pMemoryRanges *driver.VkMappedMemoryRange) (common.VkResult, error) {
+	*d.flushes++
+	return d.Driver.VkFlushMappedMemoryRanges(device, memoryRangeCount, pMemoryRanges)
+}
+
+// failingWaitDriver fails vkWaitForFences with VK_ERROR_DEVICE_LOST while fail is true
+type failingWaitDriver struct {
+	driver.Driver
+	fail *bool
+}
+
+func (d *failingWaitDriver) CreateInstanceDriver(instance driver.VkInstance) (driver.Driver, error) {
+	instanceDriver, err := d.Driver.CreateInstanceDriver(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &failingWaitDriver{Driver: instanceDriver, fail: d.fail}, nil
+}
+
+func (d *failingWaitDriver) CreateDeviceDriver(device driver.VkDevice) (driver.Driver, error) {
+	deviceDriver, err := d.Driver.CreateDeviceDriver(device)
+	if err != nil {
+		return nil, err
+	}
+
+	return &failingWaitDriver{Driver: deviceDriver, fail: d.fail}, nil
+}
+
+func (d *failingWaitDriver) VkWaitForFences(device driver.VkDevice, fenceCount driver.Uint32, pFences *driver.VkFence, waitAll driver.VkBool32, timeout driver.Uint64) (common.VkResult, error) {
+	if *d.fail {
+		return core1_0.VKErrorDeviceLost, core1_0.VKErrorDeviceLost.ToError()
+	}
+
+	return d.Driver.VkWaitForFences(device, fenceCount, pFences, waitAll, timeout)
+}
+
+func TestAllocator_ReadbackBuffer(t *testing.T) {
+	fakeDriver := nonCoherentDriver()
+	var flushes int
+	physicalDevice, device := createDevice(t, &flushCountingDriver{Driver: fakeDriver, flushes: &flushes}, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	queue := device.GetQueue(0, 0)
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{Queue: queue})
+	require.NoError(t, err)
+
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:  1000,
+		Usage: core1_0.BufferUsageTransferSrc | core1_0.BufferUsageTransferDst,
+	})
+	require.NoError(t, err)
+	allocation, _, err := allocator.AllocateBufferMemory(buffer, memory.AllocationCreateInfo{
+		Usage: core1_0.MemoryUsageGPUOnly,
+	})
+	require.NoError(t, err)
+
+	data := make([]byte, 1000)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	_, err = uploader.Upload(buffer, 0, data)
+	require.NoError(t, err)
+	_, err = uploader.WaitIdle()
+	require.NoError(t, err)
+
+	// Reading back does not flush the host-visible memory it reads from
+	uploadFlushes := flushes
+	readback, _, err := allocator.ReadbackBuffer(queue, 0, buffer, 100, 300)
+	require.NoError(t, err)
+	require.Equal(t, data[100:400], readback)
+	require.Equal(t, uploadFlushes, flushes)
+
+	_, _, err = allocator.ReadbackBuffer(queue, 0, buffer, 0, 0)
+	require.EqualError(t, err, "attempted to read back 0 bytes at offset 0 of a Buffer")
+
+	uploader.Destroy()
+	buffer.Destroy(nil)
+	allocation.Free()
+	allocator.Destroy()
+
+	require.Empty(t, fakeDriver.Errors())
+	require.Len(t, fakeDriver.LiveObjects(), 2)
+}
+
+func TestAllocator_ReadbackImage(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	queue := device.GetQueue(0, 0)
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{Queue: queue})
+	require.NoError(t, err)
+
+	extent := core1_0.Extent3D{Width: 16, Height: 12, Depth: 1}
+	img, _, err := device.CreateImage(nil, core1_0.ImageCreateInfo{
+		ImageType:   core1_0.ImageType2D,
+		Format:      core1_0.FormatB8G8R8A8SRGB,
+		Extent:      extent,
+		MipLevels:   2,
+		ArrayLayers: 2,
+		Samples:     core1_0.Samples1,
+		Tiling:      core1_0.ImageTilingOptimal,
+		Usage:       core1_0.ImageUsageTransferSrc | core1_0.ImageUsageTransferDst | core1_0.ImageUsageSampled,
+	})
+	require.NoError(t, err)
+	allocation, _, err := allocator.AllocateImageMemory(img, memory.AllocationCreateInfo{
+		Usage: core1_0.MemoryUsageGPUOnly,
+	})
+	require.NoError(t, err)
+
+	mipExtent := memory.MipExtent(extent, 1)
+	require.Equal(t, core1_0.Extent3D{Width: 8, Height: 6, Depth: 1}, mipExtent)
+
+	// Each texel is blue, green, red, alpha
+	texels := make([]byte, 8*6*4)
+	for i := 0; i < 8*6; i++ {
+		copy(texels[i*4:], []byte{byte(i), 0x80, 0xff, 0x40})
+	}
+	_, err = uploader.UploadImage(img, core1_0.FormatB8G8R8A8SRGB, core1_0.ImageLayoutShaderReadOnlyOptimal, core1_0.BufferImageCopy{
+		ImageSubresource: core1_0.ImageSubresourceLayers{
+			AspectMask:     core1_0.ImageAspectColor,
+			MipLevel:       1,
+			BaseArrayLayer: 1,
+			LayerCount:     1,
+		},
+		ImageExtent: mipExtent,
+	}, texels)
+	require.NoError(t, err)
+	_, err = uploader.WaitIdle()
+	require.NoError(t, err)
+
+	subresource := memory.ReadbackSubresource{
+		Format:     core1_0.FormatB8G8R8A8SRGB,
+		Extent:     extent,
+		AspectMask: core1_0.ImageAspectColor,
+		MipLevel:   1,
+		ArrayLayer: 1,
+	}
+	readback, _, err := allocator.ReadbackImage(queue, 0, img, core1_0.ImageLayoutShaderReadOnlyOptimal, subresource)
+	require.NoError(t, err)
+	require.Equal(t, texels, readback)
+
+	converted, err := memory.ImageFromTexels(subresource.Format, mipExtent.Width, mipExtent.Height, readback)
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 8, 6), converted.Bounds())
+	require.Equal(t, color.NRGBA{R: 0xff, G: 0x80, B: 9, A: 0x40}, converted.At(1, 1))
+
+	// Other subresources were not written
+	subresource.ArrayLayer = 0
+	readback, _, err = allocator.ReadbackImage(queue, 0, img, core1_0.ImageLayoutShaderReadOnlyOptimal, subresource)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, len(texels)), readback)
+
+	_, _, err = allocator.ReadbackImage(queue, 0, img, core1_0.ImageLayoutUndefined, subresource)
+	require.Error(t, err)
+
+	subresource.AspectMask = core1_0.ImageAspectDepth
+	_, res, err := allocator.ReadbackImage(queue, 0, img, core1_0.ImageLayoutShaderReadOnlyOptimal, subresource)
+	require.Error(t, err)
+	require.Equal(t, core1_0.VKErrorFormatNotSupported, res)
+
+	uploader.Destroy()
+	img.Destroy(nil)
+	allocation.Free()
+	allocator.Destroy()
+
+	require.Empty(t, fakeDriver.Errors())
+	require.Len(t, fakeDriver.LiveObjects(), 2)
+}
+
+func TestImageFromTexels(t *testing.T) {
+	gray, err := memory.ImageFromTexels(core1_0.FormatR8UnsignedNormalized, 2, 1, []byte{0x10, 0x20})
+	require.NoError(t, err)
+	require.Equal(t, color.Gray{Y: 0x20}, gray.At(1, 0))
+
+	rgb, err := memory.ImageFromTexels(core1_0.FormatR8G8B8UnsignedNormalized, 1, 1, []byte{1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, color.NRGBA{R: 1, G: 2, B: 3, A: 0xff}, rgb.At(0, 0))
+
+	halves := make([]byte, 8)
+	binary.LittleEndian.PutUint16(halves[0:], 0x3800) // 0.5
+	binary.LittleEndian.PutUint16(halves[2:], 0x3c00) // 1
+	binary.LittleEndian.PutUint16(halves[4:], 0xbc00) // -1
+	binary.LittleEndian.PutUint16(halves[6:], 0x4000) // 2
+	float16, err := memory.ImageFromTexels(core1_0.FormatR16G16B16A16SignedFloat, 1, 1, halves)
+	require.NoError(t, err)
+	require.Equal(t, color.NRGBA64{R: 0x8000, G: 0xffff, B: 0, A: 0xffff}, float16.At(0, 0))
+
+	unorm16 := []byte{0x34, 0x12}
+	gray16, err := memory.ImageFromTexels(core1_0.FormatR16UnsignedNormalized, 1, 1, unorm16)
+	require.NoError(t, err)
+	require.Equal(t, color.Gray16{Y: 0x1234}, gray16.At(0, 0))
+
+	_, err = memory.ImageFromTexels(core1_0.FormatR8G8B8A8UnsignedNormalized, 2, 2, make([]byte, 15))
+	require.EqualError(t, err, "attempted to convert 15 bytes of texels to a 2x2 image with format R8G8B8A8 Unsigned Normalized, which requires 16 bytes")
+
+	_, err = memory.ImageFromTexels(core1_0.FormatR32G32UnsignedInt, 1, 1, make([]byte, 8))
+	require.Error(t, err)
+}
+
+func TestAllocator_ReadbackBuffer_WaitFailure(t *testing.T) {
+	fakeDriver := nonCoherentDriver()
+	fail := false
+	physicalDevice, device := createDevice(t, &failingWaitDriver{Driver: fakeDriver, fail: &fail}, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:  256,
+		Usage: core1_0.BufferUsageTransferSrc,
+	})
+	require.NoError(t, err)
+	_, _, err = allocator.AllocateBufferMemory(buffer, memory.AllocationCreateInfo{
+		Usage: core1_0.MemoryUsageGPUOnly,
+	})
+	require.NoError(t, err)
+
+	// The copy may still be executing, so the staging Buffer, CommandPool and Fence are not destroyed
+	fail = true
+	_, res, err := allocator.ReadbackBuffer(device.GetQueue(0, 0), 0, buffer, 0, 256)
+	require.Error(t, err)
+	require.Equal(t, core1_0.VKErrorDeviceLost, res)
+
+	for _, objectType := range []core1_0.ObjectType{core1_0.ObjectTypeBuffer, core1_0.ObjectTypeCommandPool, core1_0.ObjectTypeFence} {
+		for _, object := range fakeDriver.Objects(objectType) {
+			require.False(t, object.Destroyed)
+		}
+	}
+	require.Len(t, fakeDriver.Objects(core1_0.ObjectTypeBuffer), 2)
+	require.Empty(t, fakeDriver.Errors())
+}
@@ -0,0 +1,53 @@
+package memory
+
+// HeapStatistics describes an Allocator's use of a single memory heap
+type HeapStatistics struct {
+	// BlockCount is the number of DeviceMemory objects, including those of dedicated
+	// Allocation objects, that the Allocator has allocated from the heap
+	BlockCount int
+	// BlockBytes is the total size in bytes of those DeviceMemory objects
+	BlockBytes int
+	// AllocationCount is the number of live Allocation objects in the heap
+	AllocationCount int
+	// AllocationBytes is the total number of bytes occupied by live Allocation objects,
+	// including the padding used to satisfy alignment
+	AllocationBytes int
+	// DedicatedAllocationCount is the number of live Allocation objects in the heap that
+	// have their own DeviceMemory object
+	DedicatedAllocationCount int
+	// HeapSize is the size in bytes of the heap
+	HeapSize int
+}
+
+// Statistics reports this Allocator's use of each memory heap. The returned slice is indexed by
+// heap index.
+func (a *Allocator) Statistics() []HeapStatistics {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	statistics := make([]HeapStatistics, len(a.memoryProperties.MemoryHeaps))
+	for i, heap := range a.memoryProperties.MemoryHeaps {
+		statistics[i].HeapSize = heap.Size
+	}
+
+	for _, p := range a.pools {
+		heap := &statistics[a.memoryProperties.MemoryTypes[p.memoryTypeIndex].HeapIndex]
+		for _, b := range p.blocks {
+			heap.BlockCount++
+			heap.BlockBytes += b.buddy.size
+			heap.AllocationCount += len(b.buddy.allocated)
+			heap.AllocationBytes += b.buddy.used
+		}
+	}
+
+	for allocation := range a.dedicated {
+		heap := &statistics[a.memoryProperties.MemoryTypes[allocation.memoryTypeIndex].HeapIndex]
+		heap.BlockCount++
+		heap.BlockBytes += allocation.size
+		heap.AllocationCount++
+		heap.AllocationBytes += allocation.size
+		heap.DedicatedAllocationCount++
+	}
+
+	return statistics
+}
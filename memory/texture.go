@@ -0,0 +1,283 @@
+package memory
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"image"
+)
+
+// TextureOptions controls how Uploader.CreateTexture creates a Texture
+type TextureOptions struct {
+	// Linear stores 8-bit color data in an unsigned normalized format rather than an sRGB
+	// format, for textures such as normal maps that do not hold sRGB-encoded color. See
+	// TexelsFromImage.
+	Linear bool
+	// GenerateMipmaps creates a full mip chain for the Texture and fills it by repeatedly
+	// blitting each level into the next with linear filtering. The Uploader's Queue must
+	// support graphics operations, and the Texture's format must support
+	// core1_0.FormatFeatureBlitSource, core1_0.FormatFeatureBlitDestination, and
+	// core1_0.FormatFeatureSampledImageFilterLinear with optimal tiling.
+	GenerateMipmaps bool
+	// Usage is additional usage for the Image, beyond core1_0.ImageUsageSampled and the
+	// transfer usage required to upload it
+	Usage core1_0.ImageUsageFlags
+}
+
+// Texture is a sampled Image created from an image.Image by Uploader.CreateTexture, along with
+// its memory and an ImageView of every mip level
+type Texture struct {
+	// Image is the Image holding the texels
+	Image core1_0.Image
+	// ImageView is a 2D ImageView of every mip level of Image
+	ImageView core1_0.ImageView
+	// Allocation is the memory that Image is bound to
+	Allocation *Allocation
+	// Format is the format that Image was created with
+	Format core1_0.Format
+	// Extent is the extent that Image was created with
+	Extent core1_0.Extent3D
+	// MipLevels is the number of mip levels in Image
+	MipLevels int
+
+	allocator *Allocator
+}
+
+// Destroy destroys the Image and ImageView and frees their memory. Any commands that use the
+// Texture must have completed.
+func (t *Texture) Destroy() {
+	if t.ImageView != nil {
+		t.ImageView.Destroy(t.allocator.callbacks)
+	}
+	if t.Image != nil {
+		t.Image.Destroy(t.allocator.callbacks)
+	}
+	if t.Allocation != nil {
+		t.Allocation.Free()
+	}
+}
+
+// CreateTexture creates a device-local Image with core1_0.ImageUsageSampled from an
+// image.Image, along with an ImageView for it, and records the upload of its texels into the
+// current batch. The format of the Image is chosen by TexelsFromImage. Like UploadImage, the
+// upload is complete once the batch has been submitted, at which point every mip level of the
+// Image is in core1_0.ImageLayoutShaderReadOnlyOptimal.
+//
+// img - The image to create a Texture from. Its texels must fit in the staging ring.
+//
+// options - Controls how the Texture is created
+func (u *Uploader) CreateTexture(img image.Image, options TextureOptions) (*Texture, common.VkResult, error) {
+	if img == nil {
+		return nil, core1_0.VKErrorUnknown, common.NilArgumentError("CreateTexture", "img")
+	}
+
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return nil, core1_0.VKErrorUnknown, errors.Newf("attempted to create a Texture from an empty %dx%d image", bounds.Dx(), bounds.Dy())
+	}
+
+	format, data := TexelsFromImage(img, options.Linear)
+	if len(data) > u.ringSize {
+		return nil, core1_0.VKErrorUnknown, errors.Newf("attempted to upload %d bytes to an Image, but the staging ring is only %d bytes", len(data), u.ringSize)
+	}
+
+	requiredFeatures := core1_0.FormatFeatureSampledImage
+	usage := options.Usage | core1_0.ImageUsageSampled | core1_0.ImageUsageTransferDst
+	mipLevels := 1
+	if options.GenerateMipmaps {
+		requiredFeatures |= core1_0.FormatFeatureBlitSource | core1_0.FormatFeatureBlitDestination | core1_0.FormatFeatureSampledImageFilterLinear
+		usage |= core1_0.ImageUsageTransferSrc
+		for size := maxInt(bounds.Dx(), bounds.Dy()); size > 1; size /= 2 {
+			mipLevels++
+		}
+
+		queueFamilies := u.allocator.physicalDevice.QueueFamilyProperties()
+		if u.queueFamilyIndex >= len(queueFamilies) || queueFamilies[u.queueFamilyIndex].QueueFlags&core1_0.QueueGraphics == 0 {
+			return nil, core1_0.VKErrorFeatureNotPresent, errors.Newf("attempted to generate mipmaps on Queue family %d, which does not support graphics operations", u.queueFamilyIndex)
+		}
+	}
+
+	formatProperties := u.allocator.physicalDevice.FormatProperties(format)
+	if formatProperties == nil || formatProperties.OptimalTilingFeatures&requiredFeatures != requiredFeatures {
+		return nil, core1_0.VKErrorFormatNotSupported, errors.Newf("attempted to create a Texture with format %s, which does not support %s with optimal tiling", format, requiredFeatures)
+	}
+
+	texture := &Texture{
+		Format:    format,
+		Extent:    core1_0.Extent3D{Width: bounds.Dx(), Height: bounds.Dy(), Depth: 1},
+		MipLevels: mipLevels,
+		allocator: u.allocator,
+	}
+
+	res, err := u.createTexture(texture, usage, data)
+	if err != nil {
+		texture.Destroy()
+		return nil, res, err
+	}
+
+	return texture, res, nil
+}
+
+func (u *Uploader) createTexture(texture *Texture, usage core1_0.ImageUsageFlags, data []byte) (common.VkResult, error) {
+	var res common.VkResult
+	var err error
+
+	texture.Image, res, err = u.device.CreateImage(u.allocator.callbacks, core1_0.ImageCreateInfo{
+		ImageType:     core1_0.ImageType2D,
+		Format:        texture.Format,
+		Extent:        texture.Extent,
+		MipLevels:     texture.MipLevels,
+		ArrayLayers:   1,
+		Samples:       core1_0.Samples1,
+		Tiling:        core1_0.ImageTilingOptimal,
+		Usage:         usage,
+		SharingMode:   core1_0.SharingModeExclusive,
+		InitialLayout: core1_0.ImageLayoutUndefined,
+	})
+	if err != nil {
+		return res, err
+	}
+
+	texture.Allocation, res, err = u.allocator.AllocateImageMemory(texture.Image, AllocationCreateInfo{
+		Usage:       core1_0.MemoryUsageGPUOnly,
+		ImageTiling: core1_0.ImageTilingOptimal,
+	})
+	if err != nil {
+		return res, err
+	}
+
+	subresourceRange := core1_0.ImageSubresourceRange{
+		AspectMask: core1_0.ImageAspectColor,
+		LevelCount: texture.MipLevels,
+		LayerCount: 1,
+	}
+
+	texture.ImageView, res, err = u.device.CreateImageView(u.allocator.callbacks, core1_0.ImageViewCreateInfo{
+		Image:            texture.Image,
+		ViewType:         core1_0.ImageViewType2D,
+		Format:           texture.Format,
+		SubresourceRange: subresourceRange,
+	})
+	if err != nil {
+		return res, err
+	}
+
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	ringOffset, res, err := u.stage(data, u.imageCopyAlignment(texture.Format, core1_0.ImageAspectColor))
+	if err != nil {
+		return res, err
+	}
+
+	return u.recordTexture(texture, subresourceRange, ringOffset)
+}
+
+// recordTexture records the upload of a Texture's texels from the staging ring, and the
+// generation of its mipmaps, into the current batch. The caller must hold the uploader lock.
+func (u *Uploader) recordTexture(texture *Texture, subresourceRange core1_0.ImageSubresourceRange, ringOffset int) (common.VkResult, error) {
+	commandBuffer := u.current.commandBuffer
+
+	err := commandBuffer.CmdPipelineBarrier(core1_0.PipelineStageTopOfPipe, core1_0.PipelineStageTransfer, 0, nil, nil, []core1_0.ImageMemoryBarrier{
+		{
+			DstAccessMask:       core1_0.AccessTransferWrite,
+			OldLayout:           core1_0.ImageLayoutUndefined,
+			NewLayout:           core1_0.ImageLayoutTransferDstOptimal,
+			SrcQueueFamilyIndex: u.queueFamilyIndex,
+			DstQueueFamilyIndex: u.queueFamilyIndex,
+			Image:               texture.Image,
+			SubresourceRange:    subresourceRange,
+		},
+	})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	err = commandBuffer.CmdCopyBufferToImage(u.ring, texture.Image, core1_0.ImageLayoutTransferDstOptimal, []core1_0.BufferImageCopy{
+		{
+			BufferOffset: ringOffset,
+			ImageSubresource: core1_0.ImageSubresourceLayers{
+				AspectMask: core1_0.ImageAspectColor,
+				LayerCount: 1,
+			},
+			ImageExtent: texture.Extent,
+		},
+	})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	finalLayout := core1_0.ImageLayoutTransferDstOptimal
+	if texture.MipLevels > 1 {
+		// Every level is left in TransferSrcOptimal: each level but the last is read by the
+		// blit into the next level, and the last is transitioned to match
+		finalLayout = core1_0.ImageLayoutTransferSrcOptimal
+
+		for level := 1; level <= texture.MipLevels; level++ {
+			err = commandBuffer.CmdPipelineBarrier(core1_0.PipelineStageTransfer, core1_0.PipelineStageTransfer, 0, nil, nil, []core1_0.ImageMemoryBarrier{
+				{
+					SrcAccessMask:       core1_0.AccessTransferWrite,
+					DstAccessMask:       core1_0.AccessTransferRead,
+					OldLayout:           core1_0.ImageLayoutTransferDstOptimal,
+					NewLayout:           core1_0.ImageLayoutTransferSrcOptimal,
+					SrcQueueFamilyIndex: u.queueFamilyIndex,
+					DstQueueFamilyIndex: u.queueFamilyIndex,
+					Image:               texture.Image,
+					SubresourceRange: core1_0.ImageSubresourceRange{
+						AspectMask:   core1_0.ImageAspectColor,
+						BaseMipLevel: level - 1,
+						LevelCount:   1,
+						LayerCount:   1,
+					},
+				},
+			})
+			if err != nil {
+				return core1_0.VKErrorUnknown, err
+			}
+
+			if level == texture.MipLevels {
+				break
+			}
+
+			srcExtent := MipExtent(texture.Extent, level-1)
+			dstExtent := MipExtent(texture.Extent, level)
+			err = commandBuffer.CmdBlitImage(texture.Image, core1_0.ImageLayoutTransferSrcOptimal, texture.Image, core1_0.ImageLayoutTransferDstOptimal, []core1_0.ImageBlit{
+				{
+					SrcSubresource: core1_0.ImageSubresourceLayers{
+						AspectMask: core1_0.ImageAspectColor,
+						MipLevel:   level - 1,
+						LayerCount: 1,
+					},
+					SrcOffsets: [2]core1_0.Offset3D{
+						{},
+						{X: srcExtent.Width, Y: srcExtent.Height, Z: 1},
+					},
+					DstSubresource: core1_0.ImageSubresourceLayers{
+						AspectMask: core1_0.ImageAspectColor,
+						MipLevel:   level,
+						LayerCount: 1,
+					},
+					DstOffsets: [2]core1_0.Offset3D{
+						{},
+						{X: dstExtent.Width, Y: dstExtent.Height, Z: 1},
+					},
+				},
+			}, core1_0.FilterLinear)
+			if err != nil {
+				return core1_0.VKErrorUnknown, err
+			}
+		}
+	}
+
+	u.current.imageBarriers = append(u.current.imageBarriers, core1_0.ImageMemoryBarrier{
+		SrcAccessMask:       core1_0.AccessTransferWrite,
+		OldLayout:           finalLayout,
+		NewLayout:           core1_0.ImageLayoutShaderReadOnlyOptimal,
+		SrcQueueFamilyIndex: u.queueFamilyIndex,
+		DstQueueFamilyIndex: u.ownerQueueFamilyIndex(),
+		Image:               texture.Image,
+		SubresourceRange:    subresourceRange,
+	})
+
+	return core1_0.VKSuccess, nil
+}
@@ -0,0 +1,187 @@
+package memory_test
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"github.com/vkngwrapper/core/v2/memory"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func textureDriver() *fake.Driver {
+	filterable := core1_0.FormatProperties{
+		OptimalTilingFeatures: core1_0.FormatFeatureSampledImage | core1_0.FormatFeatureBlitSource |
+			core1_0.FormatFeatureBlitDestination | core1_0.FormatFeatureSampledImageFilterLinear,
+	}
+
+	physicalDevice := fake.DefaultPhysicalDevice()
+	physicalDevice.FormatProperties = map[core1_0.Format]core1_0.FormatProperties{
+		core1_0.FormatR8G8B8A8SRGB:                   filterable,
+		core1_0.FormatR8UnsignedNormalized:           filterable,
+		core1_0.FormatR16G16B16A16UnsignedNormalized: filterable,
+		core1_0.FormatR8G8B8A8UnsignedNormalized: {
+			OptimalTilingFeatures: core1_0.FormatFeatureSampledImage,
+		},
+	}
+
+	return fake.NewDriver(fake.Config{
+		PhysicalDevices: []fake.PhysicalDevice{physicalDevice},
+	})
+}
+
+func TestUploader_CreateTexture(t *testing.T) {
+	fakeDriver := textureDriver()
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	queue := device.GetQueue(0, 0)
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{Queue: queue})
+	require.NoError(t, err)
+
+	src := image.NewNRGBA(image.Rect(0, 0, 5, 3))
+	for i := range src.Pix {
+		src.Pix[i] = byte(i * 3)
+	}
+
+	texture, _, err := uploader.CreateTexture(src, memory.TextureOptions{
+		Usage: core1_0.ImageUsageTransferSrc,
+	})
+	require.NoError(t, err)
+	require.Equal(t, core1_0.FormatR8G8B8A8SRGB, texture.Format)
+	require.Equal(t, core1_0.Extent3D{Width: 5, Height: 3, Depth: 1}, texture.Extent)
+	require.Equal(t, 1, texture.MipLevels)
+
+	imageObj, _ := fakeDriver.Object(driver.VulkanHandle(texture.Image.Handle()))
+	createInfo := imageObj.CreateInfo.(core1_0.ImageCreateInfo)
+	require.Equal(t, core1_0.ImageUsageSampled|core1_0.ImageUsageTransferDst|core1_0.ImageUsageTransferSrc, createInfo.Usage)
+	require.Equal(t, core1_0.ImageTilingOptimal, createInfo.Tiling)
+	require.NotZero(t, imageObj.Memory)
+
+	_, err = uploader.Submit()
+	require.NoError(t, err)
+
+	// The transition to TransferDstOptimal, the copy, and the transition to ShaderReadOnlyOptimal
+	submissions := fakeDriver.Submissions()
+	require.Len(t, submissions, 1)
+	require.Equal(t, 3, fakeDriver.CommandCount(submissions[0].CommandBuffers[0]))
+
+	texels, _, err := allocator.ReadbackImage(queue, 0, texture.Image, core1_0.ImageLayoutShaderReadOnlyOptimal, memory.ReadbackSubresource{
+		Format:     texture.Format,
+		Extent:     texture.Extent,
+		AspectMask: core1_0.ImageAspectColor,
+	})
+	require.NoError(t, err)
+
+	readback, err := memory.ImageFromTexels(texture.Format, 5, 3, texels)
+	require.NoError(t, err)
+	require.Equal(t, src, readback)
+
+	uploader.Destroy()
+	texture.Destroy()
+	allocator.Destroy()
+
+	require.Empty(t, fakeDriver.Errors())
+	require.Len(t, fakeDriver.LiveObjects(), 2)
+}
+
+func TestUploader_CreateTexture_Mipmaps(t *testing.T) {
+	fakeDriver := textureDriver()
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{Queue: device.GetQueue(0, 0)})
+	require.NoError(t, err)
+
+	texture, _, err := uploader.CreateTexture(image.NewRGBA64(image.Rect(0, 0, 16, 5)), memory.TextureOptions{
+		GenerateMipmaps: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, core1_0.FormatR16G16B16A16UnsignedNormalized, texture.Format)
+	require.Equal(t, 5, texture.MipLevels)
+
+	imageObj, _ := fakeDriver.Object(driver.VulkanHandle(texture.Image.Handle()))
+	createInfo := imageObj.CreateInfo.(core1_0.ImageCreateInfo)
+	require.Equal(t, 5, createInfo.MipLevels)
+	require.Equal(t, core1_0.ImageUsageSampled|core1_0.ImageUsageTransferDst|core1_0.ImageUsageTransferSrc, createInfo.Usage)
+
+	viewObjects := fakeDriver.Objects(core1_0.ObjectTypeImageView)
+	require.Len(t, viewObjects, 1)
+	require.Equal(t, driver.VulkanHandle(texture.ImageView.Handle()), viewObjects[0].Handle)
+
+	_, err = uploader.Submit()
+	require.NoError(t, err)
+
+	// The initial transition and copy, a transition of each level to TransferSrcOptimal, a blit
+	// into each level after the first, and the final transition
+	submissions := fakeDriver.Submissions()
+	require.Len(t, submissions, 1)
+	require.Equal(t, 2+5+4+1, fakeDriver.CommandCount(submissions[0].CommandBuffers[0]))
+
+	_, err = uploader.WaitIdle()
+	require.NoError(t, err)
+
+	// Mipmaps can only be generated for formats that support linear blits
+	_, res, err := uploader.CreateTexture(image.NewNRGBA(image.Rect(0, 0, 4, 4)), memory.TextureOptions{
+		Linear:          true,
+		GenerateMipmaps: true,
+	})
+	require.Error(t, err)
+	require.Equal(t, core1_0.VKErrorFormatNotSupported, res)
+
+	uploader.Destroy()
+	texture.Destroy()
+	allocator.Destroy()
+
+	require.Empty(t, fakeDriver.Errors())
+	require.Len(t, fakeDriver.LiveObjects(), 2)
+}
+
+func TestTexelsFromImage(t *testing.T) {
+	// Sub-images are read from their bounds, honoring the stride of the parent image
+	gray := image.NewGray(image.Rect(0, 0, 4, 4))
+	for i := range gray.Pix {
+		gray.Pix[i] = byte(i)
+	}
+	format, data := memory.TexelsFromImage(gray.SubImage(image.Rect(1, 1, 3, 3)), false)
+	require.Equal(t, core1_0.FormatR8UnsignedNormalized, format)
+	require.Equal(t, []byte{5, 6, 9, 10}, data)
+
+	// Premultiplied alpha is removed
+	premultiplied := color.RGBA{R: 0x40, G: 0x20, B: 0, A: 0x80}
+	rgba := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	rgba.SetRGBA(0, 0, premultiplied)
+	format, data = memory.TexelsFromImage(rgba, true)
+	require.Equal(t, core1_0.FormatR8G8B8A8UnsignedNormalized, format)
+	expected := color.NRGBAModel.Convert(premultiplied).(color.NRGBA)
+	require.Equal(t, []byte{expected.R, expected.G, expected.B, expected.A}, data)
+	require.Greater(t, data[0], premultiplied.R)
+
+	gray16 := image.NewGray16(image.Rect(0, 0, 1, 1))
+	gray16.SetGray16(0, 0, color.Gray16{Y: 0x1234})
+	format, data = memory.TexelsFromImage(gray16, false)
+	require.Equal(t, core1_0.FormatR16UnsignedNormalized, format)
+	require.Equal(t, []byte{0x34, 0x12}, data)
+
+	nrgba64 := image.NewNRGBA64(image.Rect(0, 0, 1, 1))
+	nrgba64.SetNRGBA64(0, 0, color.NRGBA64{R: 1, G: 2, B: 3, A: 0xffff})
+	format, data = memory.TexelsFromImage(nrgba64, false)
+	require.Equal(t, core1_0.FormatR16G16B16A16UnsignedNormalized, format)
+	require.Equal(t, uint16(2), binary.LittleEndian.Uint16(data[2:]))
+	require.Equal(t, uint16(0xffff), binary.LittleEndian.Uint16(data[6:]))
+
+	// Other image types are converted through color.NRGBAModel
+	paletted := image.NewPaletted(image.Rect(0, 0, 2, 1), color.Palette{color.Black, color.White})
+	paletted.SetColorIndex(1, 0, 1)
+	format, data = memory.TexelsFromImage(paletted, false)
+	require.Equal(t, core1_0.FormatR8G8B8A8SRGB, format)
+	require.Equal(t, []byte{0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff}, data)
+}
@@ -0,0 +1,837 @@
+package memory
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"sync"
+)
+
+// DefaultRingSize is the size of the staging ring that an Uploader creates when
+// UploaderOptions.RingSize is left zero
+const DefaultRingSize = 16 << 20
+
+// minimumCopyAlignment is the smallest alignment of the ranges that an Uploader places in its
+// staging ring. It is a multiple of 4 and of the texel block size of every format whose texel
+// block size is a power of two, as CmdCopyBufferToImage requires. Ranges for Image copies are
+// further aligned to the texel size of the Image's format; see imageCopyAlignment.
+const minimumCopyAlignment = 16
+
+// UploaderOptions controls the behavior of an Uploader
+type UploaderOptions struct {
+	// RingSize is the size in bytes of the persistently-mapped staging Buffer that uploads are
+	// copied through. If it is left zero, DefaultRingSize is used. Image uploads must fit in the
+	// ring; Buffer uploads that do not fit are split into several copies.
+	RingSize int
+	// Queue is the Queue that copies are submitted to. It must support transfer operations.
+	Queue core1_0.Queue
+	// QueueFamilyIndex is the index of the Queue family that Queue belongs to
+	QueueFamilyIndex int
+	// DestinationQueue is the Queue that will use uploaded resources. It may be left nil if
+	// uploaded resources will be used on Queue, or on a Queue in the same family.
+	//
+	// If DestinationQueueFamilyIndex differs from QueueFamilyIndex, as it does when Queue is a
+	// dedicated transfer Queue, the Uploader releases ownership of uploaded ranges from Queue's
+	// family and acquires it on DestinationQueue. Uploaded resources must then have been created
+	// with core1_0.SharingModeExclusive.
+	DestinationQueue core1_0.Queue
+	// DestinationQueueFamilyIndex is the index of the Queue family that DestinationQueue
+	// belongs to
+	DestinationQueueFamilyIndex int
+}
+
+// uploadBatch is a set of copies recorded into a single transfer CommandBuffer and submitted
+// together
+type uploadBatch struct {
+	commandBuffer        core1_0.CommandBuffer
+	acquireCommandBuffer core1_0.CommandBuffer
+	semaphore            core1_0.Semaphore
+	fence                core1_0.Fence
+
+	// end is the position in the staging ring after the last byte used by this batch
+	end int
+
+	bufferBarriers []core1_0.BufferMemoryBarrier
+	imageBarriers  []core1_0.ImageMemoryBarrier
+	images         map[imageSubresourceKey]struct{}
+	// bufferSizes caches the memory requirement size of each Buffer copied to in this batch
+	bufferSizes map[driver.VkBuffer]int
+}
+
+type imageSubresourceKey struct {
+	image          driver.VkImage
+	aspectMask     core1_0.ImageAspectFlags
+	mipLevel       int
+	baseArrayLayer int
+	layerCount     int
+}
+
+// Uploader copies data into Buffer and Image objects, which are typically in device-local memory
+// that cannot be mapped, through a persistently-mapped staging ring. Copies are batched into a
+// transfer CommandBuffer that is submitted by Submit, or when the ring fills up. Ring space is
+// recycled once the Fence for the batch that used it has signaled.
+//
+// Uploader is safe for concurrent use.
+type Uploader struct {
+	allocator *Allocator
+	device    core1_0.Device
+
+	queue                       core1_0.Queue
+	queueFamilyIndex            int
+	destinationQueue            core1_0.Queue
+	destinationQueueFamilyIndex int
+	transferOwnership           bool
+
+	commandPool        core1_0.CommandPool
+	acquireCommandPool core1_0.CommandPool
+
+	ring           core1_0.Buffer
+	ringAllocation *Allocation
+	ringMapping    *Mapping
+	ringSize       int
+	copyAlignment  int
+
+	// head and tail are positions in the staging ring that increase monotonically; the offset
+	// into the ring Buffer is the position modulo ringSize
+	head int
+	tail int
+
+	lock     sync.Mutex
+	current  *uploadBatch
+	inFlight []*uploadBatch
+	free     []*uploadBatch
+}
+
+// NewUploader creates an Uploader that allocates its staging ring from the provided Allocator
+//
+// allocator - The Allocator to allocate the staging ring from
+//
+// options - Controls the behavior of the Uploader
+func NewUploader(allocator *Allocator, options UploaderOptions) (*Uploader, common.VkResult, error) {
+	if allocator == nil {
+		return nil, core1_0.VKErrorUnknown, common.NilArgumentError("NewUploader", "allocator")
+	}
+	if options.Queue == nil {
+		return nil, core1_0.VKErrorUnknown, common.NilArgumentError("NewUploader", "options.Queue")
+	}
+
+	ringSize := options.RingSize
+	if ringSize == 0 {
+		ringSize = DefaultRingSize
+	}
+
+	copyAlignment := minimumCopyAlignment
+	if allocator.optimalBufferCopyOffsetAlignment > copyAlignment {
+		copyAlignment = allocator.optimalBufferCopyOffsetAlignment
+	}
+	if ringSize%copyAlignment != 0 {
+		ringSize += copyAlignment - ringSize%copyAlignment
+	}
+
+	uploader := &Uploader{
+		allocator: allocator,
+		device:    allocator.device,
+
+		queue:                       options.Queue,
+		queueFamilyIndex:            options.QueueFamilyIndex,
+		destinationQueue:            options.DestinationQueue,
+		destinationQueueFamilyIndex: options.DestinationQueueFamilyIndex,
+		transferOwnership:           options.DestinationQueue != nil && options.DestinationQueueFamilyIndex != options.QueueFamilyIndex,
+
+		ringSize:      ringSize,
+		copyAlignment: copyAlignment,
+	}
+
+	res, err := uploader.createResources()
+	if err != nil {
+		uploader.destroyResources()
+		return nil, res, err
+	}
+
+	return uploader, res, nil
+}
+
+func (u *Uploader) createResources() (common.VkResult, error) {
+	var res common.VkResult
+	var err error
+
+	u.commandPool, res, err = u.device.CreateCommandPool(u.allocator.callbacks, core1_0.CommandPoolCreateInfo{
+		QueueFamilyIndex: u.queueFamilyIndex,
+		Flags:            core1_0.CommandPoolCreateTransient | core1_0.CommandPoolCreateResetBuffer,
+	})
+	if err != nil {
+		return res, err
+	}
+
+	if u.transferOwnership {
+		u.acquireCommandPool, res, err = u.device.CreateCommandPool(u.allocator.callbacks, core1_0.CommandPoolCreateInfo{
+			QueueFamilyIndex: u.destinationQueueFamilyIndex,
+			Flags:            core1_0.CommandPoolCreateTransient | core1_0.CommandPoolCreateResetBuffer,
+		})
+		if err != nil {
+			return res, err
+		}
+	}
+
+	u.ring, res, err = u.device.CreateBuffer(u.allocator.callbacks, core1_0.BufferCreateInfo{
+		Size:        u.ringSize,
+		Usage:       core1_0.BufferUsageTransferSrc,
+		SharingMode: core1_0.SharingModeExclusive,
+	})
+	if err != nil {
+		return res, err
+	}
+
+	u.ringAllocation, res, err = u.allocator.AllocateBufferMemory(u.ring, AllocationCreateInfo{
+		Usage: core1_0.MemoryUsageUpload,
+	})
+	if err != nil {
+		return res, err
+	}
+
+	u.ringMapping, res, err = u.ringAllocation.Mapping()
+	return res, err
+}
+
+func (u *Uploader) destroyResources() {
+	if u.ringMapping != nil {
+		_, _ = u.ringMapping.Unmap()
+	}
+	if u.ring != nil {
+		u.ring.Destroy(u.allocator.callbacks)
+	}
+	if u.ringAllocation != nil {
+		u.ringAllocation.Free()
+	}
+	if u.commandPool != nil {
+		u.commandPool.Destroy(u.allocator.callbacks)
+	}
+	if u.acquireCommandPool != nil {
+		u.acquireCommandPool.Destroy(u.allocator.callbacks)
+	}
+}
+
+// Destroy waits for every submitted copy to complete and destroys the staging ring and all
+// other objects owned by this Uploader. Copies that have not been submitted are discarded.
+//
+// If a submitted copy cannot be waited on, typically because the Device was lost, the staging
+// ring, the CommandPool objects, and the Fence and Semaphore objects of submitted copies are
+// leaked rather than destroyed while the Device may still be using them.
+func (u *Uploader) Destroy() {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	for len(u.inFlight) > 0 {
+		_, err := u.inFlight[0].fence.Wait(common.NoTimeout)
+		if err != nil {
+			break
+		}
+
+		_, err = u.retire()
+		if err != nil {
+			break
+		}
+	}
+
+	batches := u.free
+	if u.current != nil {
+		batches = append(batches, u.current)
+	}
+	for _, batch := range batches {
+		batch.fence.Destroy(u.allocator.callbacks)
+		if batch.semaphore != nil {
+			batch.semaphore.Destroy(u.allocator.callbacks)
+		}
+	}
+
+	pending := len(u.inFlight) > 0
+	u.current = nil
+	u.inFlight = nil
+	u.free = nil
+	if !pending {
+		u.destroyResources()
+	}
+}
+
+// Upload copies data into a Buffer, starting offset bytes from the start of the Buffer. The
+// Buffer must have been created with core1_0.BufferUsageTransferDst, and the Device must not
+// access the range being written until the copy has been submitted.
+//
+// dstBuffer - The Buffer to copy data into
+//
+// offset - The offset in bytes from the start of dstBuffer to copy data to
+//
+// data - The bytes to copy. core1_0.Buffer does not record the size it was created with, so
+// Upload rejects ranges that extend past the size in the Buffer's MemoryRequirements, but the
+// caller is responsible for staying within the Buffer's own size.
+func (u *Uploader) Upload(dstBuffer core1_0.Buffer, offset int, data []byte) (common.VkResult, error) {
+	if dstBuffer == nil {
+		return core1_0.VKErrorUnknown, common.NilArgumentError("Upload", "dstBuffer")
+	}
+	if offset < 0 {
+		return core1_0.VKErrorUnknown, errors.Newf("attempted to upload %d bytes at offset %d of a Buffer", len(data), offset)
+	}
+	if len(data) == 0 {
+		return core1_0.VKSuccess, nil
+	}
+
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	bufferSize := u.bufferSize(dstBuffer)
+	if offset+len(data) > bufferSize {
+		return core1_0.VKErrorUnknown, errors.Newf("attempted to upload %d bytes at offset %d of a Buffer, but the Buffer requires only %d bytes of memory", len(data), offset, bufferSize)
+	}
+
+	for len(data) > 0 {
+		size := len(data)
+		if size > u.ringSize {
+			size = u.ringSize
+		}
+
+		ringOffset, res, err := u.stage(data[:size], u.copyAlignment)
+		if err != nil {
+			return res, err
+		}
+		u.current.bufferSizes[dstBuffer.Handle()] = bufferSize
+
+		err = u.current.commandBuffer.CmdCopyBuffer(u.ring, dstBuffer, []core1_0.BufferCopy{
+			{
+				SrcOffset: ringOffset,
+				DstOffset: offset,
+				Size:      size,
+			},
+		})
+		if err != nil {
+			return core1_0.VKErrorUnknown, err
+		}
+
+		u.current.bufferBarriers = append(u.current.bufferBarriers, core1_0.BufferMemoryBarrier{
+			SrcAccessMask:       core1_0.AccessTransferWrite,
+			SrcQueueFamilyIndex: u.queueFamilyIndex,
+			DstQueueFamilyIndex: u.ownerQueueFamilyIndex(),
+			Buffer:              dstBuffer,
+			Offset:              offset,
+			Size:                size,
+		})
+
+		data = data[size:]
+		offset += size
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+// UploadImage copies data into a single subresource of an Image. The Image must have been created
+// with core1_0.ImageUsageTransferDst.
+//
+// Before the first copy into a subresource in each batch, the subresource is transitioned from
+// core1_0.ImageLayoutUndefined, so any contents it had before the batch are discarded. Several
+// regions of the same subresource can be uploaded in the same batch.
+//
+// dstImage - The Image to copy data into
+//
+// format - The format dstImage was created with. CmdCopyBufferToImage requires data to be staged
+// at a multiple of the texel size of format, such as 3 bytes for core1_0.FormatR8G8B8SRGB.
+// Formats that TexelSize does not report, such as block-compressed formats, are staged at a
+// multiple of 16 bytes.
+//
+// layout - The ImageLayout that the subresource is left in once the batch has been submitted
+//
+// region - Describes the subresource and region of dstImage to copy to, and how data is laid
+// out. region.BufferOffset is ignored. The data must fit in the staging ring.
+//
+// data - The texel data to copy
+func (u *Uploader) UploadImage(dstImage core1_0.Image, format core1_0.Format, layout core1_0.ImageLayout, region core1_0.BufferImageCopy, data []byte) (common.VkResult, error) {
+	if dstImage == nil {
+		return core1_0.VKErrorUnknown, common.NilArgumentError("UploadImage", "dstImage")
+	}
+	if len(data) > u.ringSize {
+		return core1_0.VKErrorUnknown, errors.Newf("attempted to upload %d bytes to an Image, but the staging ring is only %d bytes", len(data), u.ringSize)
+	}
+	if len(data) == 0 {
+		return core1_0.VKSuccess, nil
+	}
+
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	ringOffset, res, err := u.stage(data, u.imageCopyAlignment(format, region.ImageSubresource.AspectMask))
+	if err != nil {
+		return res, err
+	}
+
+	subresourceRange := core1_0.ImageSubresourceRange{
+		AspectMask:     region.ImageSubresource.AspectMask,
+		BaseMipLevel:   region.ImageSubresource.MipLevel,
+		LevelCount:     1,
+		BaseArrayLayer: region.ImageSubresource.BaseArrayLayer,
+		LayerCount:     region.ImageSubresource.LayerCount,
+	}
+	key := imageSubresourceKey{
+		image:          dstImage.Handle(),
+		aspectMask:     subresourceRange.AspectMask,
+		mipLevel:       subresourceRange.BaseMipLevel,
+		baseArrayLayer: subresourceRange.BaseArrayLayer,
+		layerCount:     subresourceRange.LayerCount,
+	}
+
+	if _, transitioned := u.current.images[key]; !transitioned {
+		err = u.current.commandBuffer.CmdPipelineBarrier(core1_0.PipelineStageTopOfPipe, core1_0.PipelineStageTransfer, 0, nil, nil, []core1_0.ImageMemoryBarrier{
+			{
+				DstAccessMask:       core1_0.AccessTransferWrite,
+				OldLayout:           core1_0.ImageLayoutUndefined,
+				NewLayout:           core1_0.ImageLayoutTransferDstOptimal,
+				SrcQueueFamilyIndex: u.queueFamilyIndex,
+				DstQueueFamilyIndex: u.queueFamilyIndex,
+				Image:               dstImage,
+				SubresourceRange:    subresourceRange,
+			},
+		})
+		if err != nil {
+			return core1_0.VKErrorUnknown, err
+		}
+
+		u.current.images[key] = struct{}{}
+		u.current.imageBarriers = append(u.current.imageBarriers, core1_0.ImageMemoryBarrier{
+			SrcAccessMask:       core1_0.AccessTransferWrite,
+			OldLayout:           core1_0.ImageLayoutTransferDstOptimal,
+			NewLayout:           layout,
+			SrcQueueFamilyIndex: u.queueFamilyIndex,
+			DstQueueFamilyIndex: u.ownerQueueFamilyIndex(),
+			Image:               dstImage,
+			SubresourceRange:    subresourceRange,
+		})
+	}
+
+	region.BufferOffset = ringOffset
+	err = u.current.commandBuffer.CmdCopyBufferToImage(u.ring, dstImage, core1_0.ImageLayoutTransferDstOptimal, []core1_0.BufferImageCopy{region})
+	if err != nil {
+		return core1_0.VKErrorUnknown, err
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+// Submit submits every copy recorded since the last call to Submit. It does not wait for the
+// copies to complete, but their results are visible to commands that are submitted to
+// DestinationQueue (or Queue, if no DestinationQueue was provided) after Submit returns. Use
+// WaitIdle to wait for the copies to complete.
+func (u *Uploader) Submit() (common.VkResult, error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	return u.submit()
+}
+
+// WaitIdle submits any copies that have not been submitted and waits for every submitted copy
+// to complete
+func (u *Uploader) WaitIdle() (common.VkResult, error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	res, err := u.submit()
+	if err != nil {
+		return res, err
+	}
+
+	for len(u.inFlight) > 0 {
+		res, err = u.inFlight[0].fence.Wait(common.NoTimeout)
+		if err != nil {
+			return res, err
+		}
+
+		res, err = u.retire()
+		if err != nil {
+			return res, err
+		}
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+// bufferSize returns the size in a Buffer's MemoryRequirements, which is cached for the batch
+// being recorded: a Buffer that the batch copies to cannot be destroyed before the batch completes.
+// The caller must hold the uploader lock.
+func (u *Uploader) bufferSize(buffer core1_0.Buffer) int {
+	if u.current != nil {
+		if size, cached := u.current.bufferSizes[buffer.Handle()]; cached {
+			return size
+		}
+	}
+
+	return buffer.MemoryRequirements().Size
+}
+
+// ownerQueueFamilyIndex is the index of the Queue family that owns uploaded resources once their
+// batch has been submitted
+func (u *Uploader) ownerQueueFamilyIndex() int {
+	if u.transferOwnership {
+		return u.destinationQueueFamilyIndex
+	}
+	return u.queueFamilyIndex
+}
+
+// stage copies data into the staging ring and returns its offset in the ring Buffer, making sure
+// that a batch is being recorded. The caller must hold the uploader lock.
+func (u *Uploader) stage(data []byte, alignment int) (int, common.VkResult, error) {
+	start, res, err := u.reserve(len(data), alignment)
+	if err != nil {
+		return 0, res, err
+	}
+
+	if u.current == nil {
+		res, err = u.beginBatch()
+		if err != nil {
+			return 0, res, err
+		}
+	}
+
+	// The range is only claimed once there is a batch to attribute it to, so that it is recycled
+	// when the batch retires
+	u.head = start + len(data)
+	ringOffset := start % u.ringSize
+
+	staging, err := MapSlice[byte](u.ringMapping, ringOffset, len(data))
+	if err != nil {
+		return 0, core1_0.VKErrorUnknown, err
+	}
+	copy(staging, data)
+
+	return ringOffset, core1_0.VKSuccess, nil
+}
+
+// reserve finds size bytes of space in the staging ring at an offset that is a multiple of
+// alignment, submitting the current batch and waiting for earlier batches to complete if the ring
+// is full. It returns the position of the space, which the caller claims by advancing head.
+func (u *Uploader) reserve(size int, alignment int) (int, common.VkResult, error) {
+	for {
+		res, err := u.retire()
+		if err != nil {
+			return 0, res, err
+		}
+
+		if u.head == u.tail {
+			// Nothing is staged, so the next range can start at the beginning of the ring
+			u.head, u.tail = 0, 0
+		}
+
+		// The alignment applies to the offset into the ring Buffer, which is not a multiple of
+		// alignment at every multiple of ringSize when alignment is not a power of two
+		ringStart := u.head - u.head%u.ringSize
+		offset := u.head % u.ringSize
+		if offset%alignment != 0 {
+			offset += alignment - offset%alignment
+		}
+		// Ranges never wrap around the end of the ring
+		if offset+size > u.ringSize {
+			ringStart += u.ringSize
+			offset = 0
+		}
+		start := ringStart + offset
+
+		if start+size-u.tail <= u.ringSize {
+			return start, core1_0.VKSuccess, nil
+		}
+
+		if u.current != nil {
+			res, err = u.submit()
+			if err != nil {
+				return 0, res, err
+			}
+		}
+		if len(u.inFlight) == 0 {
+			return 0, core1_0.VKErrorOutOfDeviceMemory, errors.Newf("attempted to stage %d bytes, but the staging ring is only %d bytes", size, u.ringSize)
+		}
+
+		res, err = u.inFlight[0].fence.Wait(common.NoTimeout)
+		if err != nil {
+			return 0, res, err
+		}
+	}
+}
+
+// imageCopyAlignment returns the alignment of ranges in the staging ring that are copied to an
+// Image with the provided format: the least common multiple of the texel size and copyAlignment,
+// which is itself a multiple of 4
+func (u *Uploader) imageCopyAlignment(format core1_0.Format, aspectMask core1_0.ImageAspectFlags) int {
+	texelSize := TexelSize(format, aspectMask)
+	if texelSize == 0 {
+		return u.copyAlignment
+	}
+
+	a, b := u.copyAlignment, texelSize
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return u.copyAlignment / a * texelSize
+}
+
+// retire recycles every batch at the front of the in-flight list whose Fence has signaled
+func (u *Uploader) retire() (common.VkResult, error) {
+	for len(u.inFlight) > 0 {
+		batch := u.inFlight[0]
+
+		res, err := batch.fence.Status()
+		if err != nil {
+			return res, err
+		}
+		if res != core1_0.VKSuccess {
+			return core1_0.VKSuccess, nil
+		}
+
+		res, err = batch.fence.Reset()
+		if err != nil {
+			return res, err
+		}
+
+		res, err = batch.commandBuffer.Reset(0)
+		if err != nil {
+			return res, err
+		}
+
+		if batch.acquireCommandBuffer != nil {
+			res, err = batch.acquireCommandBuffer.Reset(0)
+			if err != nil {
+				return res, err
+			}
+		}
+
+		u.tail = batch.end
+		u.inFlight = u.inFlight[1:]
+		u.free = append(u.free, batch)
+	}
+
+	return core1_0.VKSuccess, nil
+}
+
+// beginBatch starts recording a new batch, reusing a retired batch if one is available
+func (u *Uploader) beginBatch() (common.VkResult, error) {
+	var batch *uploadBatch
+	if len(u.free) > 0 {
+		batch = u.free[len(u.free)-1]
+		u.free = u.free[:len(u.free)-1]
+	} else {
+		var res common.VkResult
+		var err error
+
+		batch, res, err = u.createBatch()
+		if err != nil {
+			return res, err
+		}
+	}
+
+	res, err := batch.commandBuffer.Begin(core1_0.CommandBufferBeginInfo{
+		Flags: core1_0.CommandBufferUsageOneTimeSubmit,
+	})
+	if err != nil {
+		u.abandon(batch)
+		return res, err
+	}
+
+	batch.bufferBarriers = batch.bufferBarriers[:0]
+	batch.imageBarriers = batch.imageBarriers[:0]
+	batch.images = make(map[imageSubresourceKey]struct{})
+	batch.bufferSizes = make(map[driver.VkBuffer]int)
+	u.current = batch
+
+	return res, nil
+}
+
+func (u *Uploader) createBatch() (*uploadBatch, common.VkResult, error) {
+	batch := &uploadBatch{}
+
+	commandBuffers, res, err := u.device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+		CommandPool:        u.commandPool,
+		Level:              core1_0.CommandBufferLevelPrimary,
+		CommandBufferCount: 1,
+	})
+	if err != nil {
+		return nil, res, err
+	}
+	batch.commandBuffer = commandBuffers[0]
+
+	if u.transferOwnership {
+		commandBuffers, res, err = u.device.AllocateCommandBuffers(core1_0.CommandBufferAllocateInfo{
+			CommandPool:        u.acquireCommandPool,
+			Level:              core1_0.CommandBufferLevelPrimary,
+			CommandBufferCount: 1,
+		})
+		if err != nil {
+			return nil, res, err
+		}
+		batch.acquireCommandBuffer = commandBuffers[0]
+
+		batch.semaphore, res, err = u.device.CreateSemaphore(u.allocator.callbacks, core1_0.SemaphoreCreateInfo{})
+		if err != nil {
+			return nil, res, err
+		}
+	}
+
+	batch.fence, res, err = u.device.CreateFence(u.allocator.callbacks, core1_0.FenceCreateInfo{})
+	if err != nil {
+		if batch.semaphore != nil {
+			batch.semaphore.Destroy(u.allocator.callbacks)
+		}
+		return nil, res, err
+	}
+
+	return batch, res, nil
+}
+
+// abandon returns a batch that could not be recorded or submitted to the free list
+func (u *Uploader) abandon(batch *uploadBatch) {
+	_, _ = batch.commandBuffer.Reset(0)
+	if batch.acquireCommandBuffer != nil {
+		_, _ = batch.acquireCommandBuffer.Reset(0)
+	}
+	u.free = append(u.free, batch)
+}
+
+// recoverReleased handles a batch whose release of ownership was submitted to Queue, but whose
+// acquisition of ownership could not be submitted to DestinationQueue. Its transfer CommandBuffer
+// may still be pending and its semaphore will be signaled, so it cannot be abandoned.
+func (u *Uploader) recoverReleased(batch *uploadBatch) {
+	// Wait on the semaphore and signal the Fence on Queue instead, so that the batch stays in
+	// flight and is recycled like any other once it completes
+	_, err := u.queue.Submit(batch.fence, []core1_0.SubmitInfo{
+		{
+			WaitSemaphores:   []core1_0.Semaphore{batch.semaphore},
+			WaitDstStageMask: []core1_0.PipelineStageFlags{core1_0.PipelineStageAllCommands},
+		},
+	})
+	if err == nil {
+		batch.end = u.head
+		u.inFlight = append(u.inFlight, batch)
+		return
+	}
+
+	// Otherwise, wait for the release to complete. The semaphore is left signaled with nothing
+	// to wait on it, so the batch cannot be reused. If Queue cannot be waited on, the batch may
+	// still be pending and is dropped rather than destroyed.
+	_, err = u.queue.WaitIdle()
+	if err != nil {
+		return
+	}
+
+	u.device.FreeCommandBuffers([]core1_0.CommandBuffer{batch.commandBuffer})
+	u.device.FreeCommandBuffers([]core1_0.CommandBuffer{batch.acquireCommandBuffer})
+	batch.semaphore.Destroy(u.allocator.callbacks)
+	batch.fence.Destroy(u.allocator.callbacks)
+}
+
+// submit ends and submits the current batch, if there is one. The caller must hold the
+// uploader lock.
+func (u *Uploader) submit() (common.VkResult, error) {
+	batch := u.current
+	if batch == nil {
+		return core1_0.VKSuccess, nil
+	}
+	u.current = nil
+
+	dstStage := core1_0.PipelineStageAllCommands
+	dstAccess := core1_0.AccessMemoryRead | core1_0.AccessMemoryWrite
+	if u.transferOwnership {
+		// The destination Queue family makes the writes visible when it acquires ownership
+		dstStage = core1_0.PipelineStageBottomOfPipe
+		dstAccess = 0
+	}
+
+	for i := range batch.bufferBarriers {
+		batch.bufferBarriers[i].DstAccessMask = dstAccess
+	}
+	for i := range batch.imageBarriers {
+		batch.imageBarriers[i].DstAccessMask = dstAccess
+	}
+
+	err := batch.commandBuffer.CmdPipelineBarrier(core1_0.PipelineStageTransfer, dstStage, 0, nil, batch.bufferBarriers, batch.imageBarriers)
+	if err != nil {
+		u.abandon(batch)
+		return core1_0.VKErrorUnknown, err
+	}
+
+	res, err := batch.commandBuffer.End()
+	if err != nil {
+		u.abandon(batch)
+		return res, err
+	}
+
+	res, err = u.ringMapping.Flush()
+	if err != nil {
+		u.abandon(batch)
+		return res, err
+	}
+
+	released := false
+	if u.transferOwnership {
+		released, res, err = u.submitWithOwnershipTransfer(batch)
+	} else {
+		res, err = u.queue.Submit(batch.fence, []core1_0.SubmitInfo{
+			{
+				CommandBuffers: []core1_0.CommandBuffer{batch.commandBuffer},
+			},
+		})
+	}
+	if err != nil && released {
+		u.recoverReleased(batch)
+		return res, err
+	} else if err != nil {
+		u.abandon(batch)
+		return res, err
+	}
+
+	batch.end = u.head
+	u.inFlight = append(u.inFlight, batch)
+	return res, nil
+}
+
+// submitWithOwnershipTransfer submits the release of ownership recorded in a batch's transfer
+// CommandBuffer, followed by the matching acquisition of ownership on the destination Queue. The
+// first return value is true if the release was submitted, even if the acquisition was not.
+func (u *Uploader) submitWithOwnershipTransfer(batch *uploadBatch) (bool, common.VkResult, error) {
+	for i := range batch.bufferBarriers {
+		batch.bufferBarriers[i].SrcAccessMask = 0
+		batch.bufferBarriers[i].DstAccessMask = core1_0.AccessMemoryRead | core1_0.AccessMemoryWrite
+	}
+	for i := range batch.imageBarriers {
+		batch.imageBarriers[i].SrcAccessMask = 0
+		batch.imageBarriers[i].DstAccessMask = core1_0.AccessMemoryRead | core1_0.AccessMemoryWrite
+	}
+
+	res, err := batch.acquireCommandBuffer.Begin(core1_0.CommandBufferBeginInfo{
+		Flags: core1_0.CommandBufferUsageOneTimeSubmit,
+	})
+	if err != nil {
+		return false, res, err
+	}
+
+	err = batch.acquireCommandBuffer.CmdPipelineBarrier(core1_0.PipelineStageTopOfPipe, core1_0.PipelineStageAllCommands, 0, nil, batch.bufferBarriers, batch.imageBarriers)
+	if err != nil {
+		return false, core1_0.VKErrorUnknown, err
+	}
+
+	res, err = batch.acquireCommandBuffer.End()
+	if err != nil {
+		return false, res, err
+	}
+
+	res, err = u.queue.Submit(nil, []core1_0.SubmitInfo{
+		{
+			CommandBuffers:   []core1_0.CommandBuffer{batch.commandBuffer},
+			SignalSemaphores: []core1_0.Semaphore{batch.semaphore},
+		},
+	})
+	if err != nil {
+		return false, res, err
+	}
+
+	res, err = u.destinationQueue.Submit(batch.fence, []core1_0.SubmitInfo{
+		{
+			CommandBuffers:   []core1_0.CommandBuffer{batch.acquireCommandBuffer},
+			WaitSemaphores:   []core1_0.Semaphore{batch.semaphore},
+			WaitDstStageMask: []core1_0.PipelineStageFlags{core1_0.PipelineStageAllCommands},
+		},
+	})
+	return true, res, err
+}
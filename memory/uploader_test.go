@@ -0,0 +1,593 @@
+package memory_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"github.com/vkngwrapper/core/v2"
+	"github.com/vkngwrapper/core/v2/common"
+	"github.com/vkngwrapper/core/v2/core1_0"
+	"github.com/vkngwrapper/core/v2/driver"
+	"github.com/vkngwrapper/core/v2/driver/fake"
+	"github.com/vkngwrapper/core/v2/memory"
+	"testing"
+)
+
+func createUploadTarget(t *testing.T, device core1_0.Device, allocator *memory.Allocator, size int) core1_0.Buffer {
+	buffer, _, err := device.CreateBuffer(nil, core1_0.BufferCreateInfo{
+		Size:  size,
+		Usage: core1_0.BufferUsageTransferDst | core1_0.BufferUsageVertexBuffer,
+	})
+	require.NoError(t, err)
+
+	_, _, err = allocator.AllocateBufferMemory(buffer, memory.AllocationCreateInfo{
+		Usage: core1_0.MemoryUsageGPUOnly,
+	})
+	require.NoError(t, err)
+
+	return buffer
+}
+
+func createUploadImage(t *testing.T, device core1_0.Device, allocator *memory.Allocator) core1_0.Image {
+	image, _, err := device.CreateImage(nil, core1_0.ImageCreateInfo{
+		ImageType:   core1_0.ImageType2D,
+		Format:      core1_0.FormatR8G8B8A8UnsignedNormalized,
+		Extent:      core1_0.Extent3D{Width: 16, Height: 16, Depth: 1},
+		MipLevels:   1,
+		ArrayLayers: 1,
+		Samples:     core1_0.Samples1,
+		Tiling:      core1_0.ImageTilingOptimal,
+		Usage:       core1_0.ImageUsageTransferDst | core1_0.ImageUsageSampled,
+	})
+	require.NoError(t, err)
+
+	_, _, err = allocator.AllocateImageMemory(image, memory.AllocationCreateInfo{
+		Usage: core1_0.MemoryUsageGPUOnly,
+	})
+	require.NoError(t, err)
+
+	return image
+}
+
+func TestUploader_Upload(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	queue := device.GetQueue(0, 0)
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{
+		RingSize: 4096,
+		Queue:    queue,
+	})
+	require.NoError(t, err)
+
+	buffer := createUploadTarget(t, device, allocator, 1024)
+	image := createUploadImage(t, device, allocator)
+
+	_, err = uploader.Upload(buffer, 0, make([]byte, 512))
+	require.NoError(t, err)
+	_, err = uploader.Upload(buffer, 512, make([]byte, 512))
+	require.NoError(t, err)
+
+	region := core1_0.BufferImageCopy{
+		ImageSubresource: core1_0.ImageSubresourceLayers{
+			AspectMask: core1_0.ImageAspectColor,
+			LayerCount: 1,
+		},
+		ImageExtent: core1_0.Extent3D{Width: 16, Height: 8, Depth: 1},
+	}
+	_, err = uploader.UploadImage(image, core1_0.FormatR8G8B8A8UnsignedNormalized, core1_0.ImageLayoutShaderReadOnlyOptimal, region, make([]byte, 512))
+	require.NoError(t, err)
+
+	// The second region of the same subresource does not transition it again
+	region.ImageOffset.Y = 8
+	_, err = uploader.UploadImage(image, core1_0.FormatR8G8B8A8UnsignedNormalized, core1_0.ImageLayoutShaderReadOnlyOptimal, region, make([]byte, 512))
+	require.NoError(t, err)
+	require.Empty(t, fakeDriver.Submissions())
+
+	_, err = uploader.Submit()
+	require.NoError(t, err)
+
+	submissions := fakeDriver.Submissions()
+	require.Len(t, submissions, 1)
+	require.Equal(t, queue.Handle(), submissions[0].Queue)
+	require.Len(t, submissions[0].CommandBuffers, 1)
+	require.NotZero(t, submissions[0].Fence)
+	require.Empty(t, submissions[0].WaitSemaphores)
+
+	// Two buffer copies, one layout transition, two image copies, and the final barrier
+	require.Equal(t, 6, fakeDriver.CommandCount(submissions[0].CommandBuffers[0]))
+
+	// Nothing has been recorded since the last submission
+	_, err = uploader.Submit()
+	require.NoError(t, err)
+	require.Len(t, fakeDriver.Submissions(), 1)
+
+	_, err = uploader.WaitIdle()
+	require.NoError(t, err)
+
+	uploader.Destroy()
+	buffer.Destroy(nil)
+	image.Destroy(nil)
+	allocator.Destroy()
+
+	require.Empty(t, fakeDriver.Errors())
+	require.Len(t, fakeDriver.LiveObjects(), 2)
+}
+
+func TestUploader_RecyclesRing(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{
+		RingSize: 1024,
+		Queue:    device.GetQueue(0, 0),
+	})
+	require.NoError(t, err)
+
+	buffer := createUploadTarget(t, device, allocator, 4096)
+
+	// Each upload after the first two no longer fits, so the pending batch is submitted and
+	// its ring space is recycled once its Fence signals
+	for i := 0; i < 4; i++ {
+		_, err = uploader.Upload(buffer, i*400, make([]byte, 400))
+		require.NoError(t, err)
+	}
+	require.Len(t, fakeDriver.Submissions(), 1)
+
+	// Uploads larger than the ring are split
+	_, err = uploader.Upload(buffer, 1600, make([]byte, 2496))
+	require.NoError(t, err)
+
+	// Uploads that run past the end of the Buffer are rejected before anything is recorded
+	_, err = uploader.Upload(buffer, 1600, make([]byte, 2500))
+	require.EqualError(t, err, "attempted to upload 2500 bytes at offset 1600 of a Buffer, but the Buffer requires only 4096 bytes of memory")
+	_, err = uploader.Upload(buffer, -1, make([]byte, 1))
+	require.Error(t, err)
+
+	_, err = uploader.WaitIdle()
+	require.NoError(t, err)
+	require.Len(t, fakeDriver.Submissions(), 5)
+
+	uploader.Destroy()
+	buffer.Destroy(nil)
+	allocator.Destroy()
+
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestUploader_ImageLargerThanRing(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{
+		RingSize: 512,
+		Queue:    device.GetQueue(0, 0),
+	})
+	require.NoError(t, err)
+
+	image := createUploadImage(t, device, allocator)
+
+	_, err = uploader.UploadImage(image, core1_0.FormatR8G8B8A8UnsignedNormalized, core1_0.ImageLayoutShaderReadOnlyOptimal, core1_0.BufferImageCopy{
+		ImageSubresource: core1_0.ImageSubresourceLayers{
+			AspectMask: core1_0.ImageAspectColor,
+			LayerCount: 1,
+		},
+		ImageExtent: core1_0.Extent3D{Width: 16, Height: 16, Depth: 1},
+	}, make([]byte, 1024))
+	require.EqualError(t, err, "attempted to upload 1024 bytes to an Image, but the staging ring is only 512 bytes")
+
+	uploader.Destroy()
+	image.Destroy(nil)
+	allocator.Destroy()
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestUploader_TexelAlignment(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	physicalDevice, device := createDevice(t, fakeDriver, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	queue := device.GetQueue(0, 0)
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{
+		RingSize: 1024,
+		Queue:    queue,
+	})
+	require.NoError(t, err)
+
+	buffer := createUploadTarget(t, device, allocator, 256)
+	extent := core1_0.Extent3D{Width: 4, Height: 4, Depth: 1}
+	image, _, err := device.CreateImage(nil, core1_0.ImageCreateInfo{
+		ImageType:   core1_0.ImageType2D,
+		Format:      core1_0.FormatR8G8B8UnsignedNormalized,
+		Extent:      extent,
+		MipLevels:   1,
+		ArrayLayers: 1,
+		Samples:     core1_0.Samples1,
+		Tiling:      core1_0.ImageTilingOptimal,
+		Usage:       core1_0.ImageUsageTransferDst | core1_0.ImageUsageTransferSrc,
+	})
+	require.NoError(t, err)
+	allocation, _, err := allocator.AllocateImageMemory(image, memory.AllocationCreateInfo{
+		Usage: core1_0.MemoryUsageGPUOnly,
+	})
+	require.NoError(t, err)
+
+	// Each image upload is staged at a multiple of its 3-byte texels, including after the ring
+	// wraps around, or the fake driver reports an error
+	texels := make([]byte, 4*4*3)
+	for i := 0; i < 40; i++ {
+		_, err = uploader.Upload(buffer, 0, make([]byte, 20))
+		require.NoError(t, err)
+
+		for j := range texels {
+			texels[j] = byte(i + j)
+		}
+		_, err = uploader.UploadImage(image, core1_0.FormatR8G8B8UnsignedNormalized, core1_0.ImageLayoutTransferSrcOptimal, core1_0.BufferImageCopy{
+			ImageSubresource: core1_0.ImageSubresourceLayers{
+				AspectMask: core1_0.ImageAspectColor,
+				LayerCount: 1,
+			},
+			ImageExtent: extent,
+		}, texels)
+		require.NoError(t, err)
+	}
+
+	_, err = uploader.WaitIdle()
+	require.NoError(t, err)
+	require.Greater(t, len(fakeDriver.Submissions()), 1)
+
+	readback, _, err := allocator.ReadbackImage(queue, 0, image, core1_0.ImageLayoutTransferSrcOptimal, memory.ReadbackSubresource{
+		Format:     core1_0.FormatR8G8B8UnsignedNormalized,
+		Extent:     extent,
+		AspectMask: core1_0.ImageAspectColor,
+	})
+	require.NoError(t, err)
+	require.Equal(t, texels, readback)
+
+	uploader.Destroy()
+	buffer.Destroy(nil)
+	image.Destroy(nil)
+	allocation.Free()
+	allocator.Destroy()
+
+	require.Empty(t, fakeDriver.Errors())
+}
+
+// transferQueueDriver creates a fake driver whose physical device has a transfer-only queue family
+// at index 1
+func transferQueueDriver() *fake.Driver {
+	physicalDevice := fake.DefaultPhysicalDevice()
+	physicalDevice.QueueFamilies = append(physicalDevice.QueueFamilies, core1_0.QueueFamilyProperties{
+		QueueFlags:                  core1_0.QueueTransfer,
+		QueueCount:                  1,
+		MinImageTransferGranularity: core1_0.Extent3D{Width: 1, Height: 1, Depth: 1},
+	})
+	return fake.NewDriver(fake.Config{
+		PhysicalDevices: []fake.PhysicalDevice{physicalDevice},
+	})
+}
+
+// createTransferDevice creates a device with one Queue from each of the queue families of
+// transferQueueDriver
+func createTransferDevice(t *testing.T, vkDriver driver.Driver) (core1_0.PhysicalDevice, core1_0.Device) {
+	loader, err := core.CreateLoaderFromDriver(vkDriver)
+	require.NoError(t, err)
+	instance, _, err := loader.CreateInstance(nil, core1_0.InstanceCreateInfo{APIVersion: common.Vulkan1_2})
+	require.NoError(t, err)
+	physicalDevices, _, err := instance.EnumeratePhysicalDevices()
+	require.NoError(t, err)
+
+	device, _, err := physicalDevices[0].CreateDevice(nil, core1_0.DeviceCreateInfo{
+		QueueCreateInfos: []core1_0.DeviceQueueCreateInfo{
+			{QueueFamilyIndex: 0, QueuePriorities: []float32{1}},
+			{QueueFamilyIndex: 1, QueuePriorities: []float32{1}},
+		},
+	})
+	require.NoError(t, err)
+
+	return physicalDevices[0], device
+}
+
+// failingSubmitDriver fails vkQueueSubmit on the Queue that failQueue points to, without passing
+// the submission on to the driver
+type failingSubmitDriver struct {
+	driver.Driver
+	failQueue *driver.VkQueue
+}
+
+func (d *failingSubmitDriver) CreateInstanceDriver(instance driver.VkInstance) (driver.Driver, error) {
+	instanceDriver, err := d.Driver.CreateInstanceDriver(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &failingSubmitDriver{Driver: instanceDriver, failQueue: d.failQueue}, nil
+}
+
+func (d *failingSubmitDriver) CreateDeviceDriver(device driver.VkDevice) (driver.Driver, error) {
+	deviceDriver, err := d.Driver.CreateDeviceDriver(device)
+	if err != nil {
+		return nil, err
+	}
+
+	return &failingSubmitDriver{Driver: deviceDriver, failQueue: d.failQueue}, nil
+}
+
+func (d *failingSubmitDriver) VkQueueSubmit(queue driver.VkQueue, submitCount driver.Uint32, pSubmits *driver.VkSubmitInfo, fence driver.VkFence) (common.VkResult, error) {
+	if queue == *d.failQueue {
+		return core1_0.VKErrorDeviceLost, core1_0.VKErrorDeviceLost.ToError()
+	}
+
+	return d.Driver.VkQueueSubmit(queue, submitCount, pSubmits, fence)
+}
+
+// failingBeginDriver fails vkBeginCommandBuffer while fail is true
+type failingBeginDriver struct {
+	driver.Driver
+	fail *bool
+}
+
+func (d *failingBeginDriver) CreateInstanceDriver(instance driver.VkInstance) (driver.Driver, error) {
+	instanceDriver, err := d.Driver.CreateInstanceDriver(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &failingBeginDriver{Driver: instanceDriver, fail: d.fail}, nil
+}
+
+func (d *failingBeginDriver) CreateDeviceDriver(device driver.VkDevice) (driver.Driver, error) {
+	deviceDriver, err := d.Driver.CreateDeviceDriver(device)
+	if err != nil {
+		return nil, err
+	}
+
+	return &failingBeginDriver{Driver: deviceDriver, fail: d.fail}, nil
+}
+
+func (d *failingBeginDriver) VkBeginCommandBuffer(commandBuffer driver.VkCommandBuffer, pBeginInfo *driver.VkCommandBufferBeginInfo) (common.VkResult, error) {
+	if *d.fail {
+		return core1_0.VKErrorOutOfHostMemory, core1_0.VKErrorOutOfHostMemory.ToError()
+	}
+
+	return d.Driver.VkBeginCommandBuffer(commandBuffer, pBeginInfo)
+}
+
+// requirementsCountingDriver counts calls to vkGetBufferMemoryRequirements
+type requirementsCountingDriver struct {
+	driver.Driver
+	count *int
+}
+
+func (d *requirementsCountingDriver) CreateInstanceDriver(instance driver.VkInstance) (driver.Driver, error) {
+	instanceDriver, err := d.Driver.CreateInstanceDriver(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &requirementsCountingDriver{Driver: instanceDriver, count: d.count}, nil
+}
+
+func (d *requirementsCountingDriver) CreateDeviceDriver(device driver.VkDevice) (driver.Driver, error) {
+	deviceDriver, err := d.Driver.CreateDeviceDriver(device)
+	if err != nil {
+		return nil, err
+	}
+
+	return &requirementsCountingDriver{Driver: deviceDriver, count: d.count}, nil
+}
+
+func (d *requirementsCountingDriver) VkGetBufferMemoryRequirements(device driver.VkDevice, buffer driver.VkBuffer, pMemoryRequirements *driver.VkMemoryRequirements) {
+	*d.count++
+	d.Driver.VkGetBufferMemoryRequirements(device, buffer, pMemoryRequirements)
+}
+
+func TestUploader_OwnershipTransfer(t *testing.T) {
+	fakeDriver := transferQueueDriver()
+	physicalDevice, device := createTransferDevice(t, fakeDriver)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	graphicsQueue := device.GetQueue(0, 0)
+	transferQueue := device.GetQueue(1, 0)
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{
+		Queue:                       transferQueue,
+		QueueFamilyIndex:            1,
+		DestinationQueue:            graphicsQueue,
+		DestinationQueueFamilyIndex: 0,
+	})
+	require.NoError(t, err)
+
+	buffer := createUploadTarget(t, device, allocator, 256)
+	_, err = uploader.Upload(buffer, 0, make([]byte, 256))
+	require.NoError(t, err)
+
+	_, err = uploader.Submit()
+	require.NoError(t, err)
+
+	submissions := fakeDriver.Submissions()
+	require.Len(t, submissions, 2)
+
+	// The transfer Queue releases ownership and signals a Semaphore
+	require.Equal(t, transferQueue.Handle(), submissions[0].Queue)
+	require.Zero(t, submissions[0].Fence)
+	require.Len(t, submissions[0].SignalSemaphores, 1)
+	require.Equal(t, 2, fakeDriver.CommandCount(submissions[0].CommandBuffers[0]))
+
+	// The destination Queue waits on the Semaphore, acquires ownership, and signals the Fence
+	require.Equal(t, graphicsQueue.Handle(), submissions[1].Queue)
+	require.NotZero(t, submissions[1].Fence)
+	require.Equal(t, submissions[0].SignalSemaphores, submissions[1].WaitSemaphores)
+	require.Equal(t, 1, fakeDriver.CommandCount(submissions[1].CommandBuffers[0]))
+
+	transferPool, _ := fakeDriver.Object(driver.VulkanHandle(submissions[0].CommandBuffers[0]))
+	acquirePool, _ := fakeDriver.Object(driver.VulkanHandle(submissions[1].CommandBuffers[0]))
+	transferPoolObj, _ := fakeDriver.Object(transferPool.Parent)
+	acquirePoolObj, _ := fakeDriver.Object(acquirePool.Parent)
+	require.Equal(t, 1, transferPoolObj.CreateInfo.(core1_0.CommandPoolCreateInfo).QueueFamilyIndex)
+	require.Equal(t, 0, acquirePoolObj.CreateInfo.(core1_0.CommandPoolCreateInfo).QueueFamilyIndex)
+
+	uploader.Destroy()
+	buffer.Destroy(nil)
+	allocator.Destroy()
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestUploader_OwnershipTransfer_AcquireFailure(t *testing.T) {
+	fakeDriver := transferQueueDriver()
+	var failQueue driver.VkQueue
+	physicalDevice, device := createTransferDevice(t, &failingSubmitDriver{Driver: fakeDriver, failQueue: &failQueue})
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	graphicsQueue := device.GetQueue(0, 0)
+	transferQueue := device.GetQueue(1, 0)
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{
+		Queue:                       transferQueue,
+		QueueFamilyIndex:            1,
+		DestinationQueue:            graphicsQueue,
+		DestinationQueueFamilyIndex: 0,
+	})
+	require.NoError(t, err)
+
+	buffer := createUploadTarget(t, device, allocator, 256)
+	_, err = uploader.Upload(buffer, 0, make([]byte, 256))
+	require.NoError(t, err)
+
+	// The release of ownership is submitted, but the acquisition is not
+	failQueue = graphicsQueue.Handle()
+	res, err := uploader.Submit()
+	require.Error(t, err)
+	require.Equal(t, core1_0.VKErrorDeviceLost, res)
+	failQueue = driver.VkQueue(0)
+
+	// The transfer Queue waits on the Semaphore itself and signals the Fence, so that the batch
+	// stays in flight until the release completes
+	submissions := fakeDriver.Submissions()
+	require.Len(t, submissions, 2)
+	require.Equal(t, transferQueue.Handle(), submissions[1].Queue)
+	require.NotZero(t, submissions[1].Fence)
+	require.Empty(t, submissions[1].CommandBuffers)
+	require.Equal(t, submissions[0].SignalSemaphores, submissions[1].WaitSemaphores)
+
+	// Later batches do not reuse the Semaphore or CommandBuffers while they are pending
+	for i := 0; i < 4; i++ {
+		_, err = uploader.Upload(buffer, 0, make([]byte, 256))
+		require.NoError(t, err)
+		_, err = uploader.Submit()
+		require.NoError(t, err)
+	}
+
+	_, err = uploader.WaitIdle()
+	require.NoError(t, err)
+
+	uploader.Destroy()
+	buffer.Destroy(nil)
+	allocator.Destroy()
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestUploader_Destroy_WaitFailure(t *testing.T) {
+	fakeDriver := nonCoherentDriver()
+	fail := false
+	physicalDevice, device := createDevice(t, &failingWaitDriver{Driver: fakeDriver, fail: &fail}, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{
+		Queue:    device.GetQueue(0, 0),
+		RingSize: 1024,
+	})
+	require.NoError(t, err)
+
+	buffer := createUploadTarget(t, device, allocator, 256)
+	_, err = uploader.Upload(buffer, 0, make([]byte, 256))
+	require.NoError(t, err)
+	_, err = uploader.Submit()
+	require.NoError(t, err)
+
+	// The submitted copy may still be executing, so nothing that it uses is destroyed
+	fail = true
+	uploader.Destroy()
+
+	for _, objectType := range []core1_0.ObjectType{core1_0.ObjectTypeBuffer, core1_0.ObjectTypeCommandPool, core1_0.ObjectTypeFence} {
+		for _, object := range fakeDriver.Objects(objectType) {
+			require.False(t, object.Destroyed)
+		}
+	}
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestUploader_BeginFailure(t *testing.T) {
+	fakeDriver := fake.NewDriver(fake.Config{})
+	fail := false
+	physicalDevice, device := createDevice(t, &failingBeginDriver{Driver: fakeDriver, fail: &fail}, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{
+		Queue:    device.GetQueue(0, 0),
+		RingSize: 1024,
+	})
+	require.NoError(t, err)
+
+	buffer := createUploadTarget(t, device, allocator, 1024)
+
+	fail = true
+	res, err := uploader.Upload(buffer, 0, make([]byte, 1024))
+	require.Error(t, err)
+	require.Equal(t, core1_0.VKErrorOutOfHostMemory, res)
+	fail = false
+
+	// The range staged for the failed upload was not claimed, so the whole ring is still available
+	_, err = uploader.Upload(buffer, 0, make([]byte, 1024))
+	require.NoError(t, err)
+	_, err = uploader.WaitIdle()
+	require.NoError(t, err)
+
+	uploader.Destroy()
+	buffer.Destroy(nil)
+	allocator.Destroy()
+	require.Empty(t, fakeDriver.Errors())
+}
+
+func TestUploader_Upload_CachesBufferSize(t *testing.T) {
+	var count int
+	physicalDevice, device := createDevice(t, &requirementsCountingDriver{Driver: fake.NewDriver(fake.Config{}), count: &count}, common.Vulkan1_2)
+
+	allocator, err := memory.NewAllocator(device, physicalDevice, memory.AllocatorOptions{})
+	require.NoError(t, err)
+
+	uploader, _, err := memory.NewUploader(allocator, memory.UploaderOptions{
+		Queue:    device.GetQueue(0, 0),
+		RingSize: 1024,
+	})
+	require.NoError(t, err)
+
+	buffer := createUploadTarget(t, device, allocator, 256)
+
+	count = 0
+	for i := 0; i < 8; i++ {
+		_, err = uploader.Upload(buffer, i*32, make([]byte, 32))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, count)
+
+	_, err = uploader.WaitIdle()
+	require.NoError(t, err)
+
+	uploader.Destroy()
+}
@@ -0,0 +1,3190 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./iface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	common "github.com/vkngwrapper/core/v2/common"
+	core1_0 "github.com/vkngwrapper/core/v2/core1_0"
+	core1_1 "github.com/vkngwrapper/core/v2/core1_1"
+	core1_2 "github.com/vkngwrapper/core/v2/core1_2"
+	core1_3 "github.com/vkngwrapper/core/v2/core1_3"
+	driver "github.com/vkngwrapper/core/v2/driver"
+)
+
+// CommandBuffer1_3 is a mock of CommandBuffer interface.
+type CommandBuffer1_3 struct {
+	ctrl     *gomock.Controller
+	recorder *CommandBuffer1_3MockRecorder
+}
+
+// CommandBuffer1_3MockRecorder is the mock recorder for CommandBuffer1_3.
+type CommandBuffer1_3MockRecorder struct {
+	mock *CommandBuffer1_3
+}
+
+// NewCommandBuffer1_3 creates a new mock instance.
+func NewCommandBuffer1_3(ctrl *gomock.Controller) *CommandBuffer1_3 {
+	mock := &CommandBuffer1_3{ctrl: ctrl}
+	mock.recorder = &CommandBuffer1_3MockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *CommandBuffer1_3) EXPECT() *CommandBuffer1_3MockRecorder {
+	return m.recorder
+}
+
+// APIVersion mocks base method.
+func (m *CommandBuffer1_3) APIVersion() common.APIVersion {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIVersion")
+	ret0, _ := ret[0].(common.APIVersion)
+	return ret0
+}
+
+// APIVersion indicates an expected call of APIVersion.
+func (mr *CommandBuffer1_3MockRecorder) APIVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIVersion", reflect.TypeOf((*CommandBuffer1_3)(nil).APIVersion))
+}
+
+// Begin mocks base method.
+func (m *CommandBuffer1_3) Begin(o core1_0.CommandBufferBeginInfo) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", o)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *CommandBuffer1_3MockRecorder) Begin(o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*CommandBuffer1_3)(nil).Begin), o)
+}
+
+// CmdBeginQuery mocks base method.
+func (m *CommandBuffer1_3) CmdBeginQuery(queryPool core1_0.QueryPool, query int, flags core1_0.QueryControlFlags) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdBeginQuery", queryPool, query, flags)
+}
+
+// CmdBeginQuery indicates an expected call of CmdBeginQuery.
+func (mr *CommandBuffer1_3MockRecorder) CmdBeginQuery(queryPool, query, flags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdBeginQuery", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdBeginQuery), queryPool, query, flags)
+}
+
+// CmdBeginRenderPass mocks base method.
+func (m *CommandBuffer1_3) CmdBeginRenderPass(contents core1_0.SubpassContents, o core1_0.RenderPassBeginInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdBeginRenderPass", contents, o)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdBeginRenderPass indicates an expected call of CmdBeginRenderPass.
+func (mr *CommandBuffer1_3MockRecorder) CmdBeginRenderPass(contents, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdBeginRenderPass", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdBeginRenderPass), contents, o)
+}
+
+// CmdBeginRenderPass2 mocks base method.
+func (m *CommandBuffer1_3) CmdBeginRenderPass2(renderPassBegin core1_0.RenderPassBeginInfo, subpassBegin core1_2.SubpassBeginInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdBeginRenderPass2", renderPassBegin, subpassBegin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdBeginRenderPass2 indicates an expected call of CmdBeginRenderPass2.
+func (mr *CommandBuffer1_3MockRecorder) CmdBeginRenderPass2(renderPassBegin, subpassBegin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdBeginRenderPass2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdBeginRenderPass2), renderPassBegin, subpassBegin)
+}
+
+// CmdBeginRendering mocks base method.
+func (m *CommandBuffer1_3) CmdBeginRendering(renderingInfo core1_3.RenderingInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdBeginRendering", renderingInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdBeginRendering indicates an expected call of CmdBeginRendering.
+func (mr *CommandBuffer1_3MockRecorder) CmdBeginRendering(renderingInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdBeginRendering", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdBeginRendering), renderingInfo)
+}
+
+// CmdBindDescriptorSets mocks base method.
+func (m *CommandBuffer1_3) CmdBindDescriptorSets(bindPoint core1_0.PipelineBindPoint, layout core1_0.PipelineLayout, firstSet int, sets []core1_0.DescriptorSet, dynamicOffsets []int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdBindDescriptorSets", bindPoint, layout, firstSet, sets, dynamicOffsets)
+}
+
+// CmdBindDescriptorSets indicates an expected call of CmdBindDescriptorSets.
+func (mr *CommandBuffer1_3MockRecorder) CmdBindDescriptorSets(bindPoint, layout, firstSet, sets, dynamicOffsets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdBindDescriptorSets", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdBindDescriptorSets), bindPoint, layout, firstSet, sets, dynamicOffsets)
+}
+
+// CmdBindIndexBuffer mocks base method.
+func (m *CommandBuffer1_3) CmdBindIndexBuffer(buffer core1_0.Buffer, offset int, indexType core1_0.IndexType) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdBindIndexBuffer", buffer, offset, indexType)
+}
+
+// CmdBindIndexBuffer indicates an expected call of CmdBindIndexBuffer.
+func (mr *CommandBuffer1_3MockRecorder) CmdBindIndexBuffer(buffer, offset, indexType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdBindIndexBuffer", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdBindIndexBuffer), buffer, offset, indexType)
+}
+
+// CmdBindPipeline mocks base method.
+func (m *CommandBuffer1_3) CmdBindPipeline(bindPoint core1_0.PipelineBindPoint, pipeline core1_0.Pipeline) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdBindPipeline", bindPoint, pipeline)
+}
+
+// CmdBindPipeline indicates an expected call of CmdBindPipeline.
+func (mr *CommandBuffer1_3MockRecorder) CmdBindPipeline(bindPoint, pipeline interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdBindPipeline", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdBindPipeline), bindPoint, pipeline)
+}
+
+// CmdBindVertexBuffers mocks base method.
+func (m *CommandBuffer1_3) CmdBindVertexBuffers(firstBinding int, buffers []core1_0.Buffer, bufferOffsets []int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdBindVertexBuffers", firstBinding, buffers, bufferOffsets)
+}
+
+// CmdBindVertexBuffers indicates an expected call of CmdBindVertexBuffers.
+func (mr *CommandBuffer1_3MockRecorder) CmdBindVertexBuffers(firstBinding, buffers, bufferOffsets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdBindVertexBuffers", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdBindVertexBuffers), firstBinding, buffers, bufferOffsets)
+}
+
+// CmdBindVertexBuffers2 mocks base method.
+func (m *CommandBuffer1_3) CmdBindVertexBuffers2(firstBinding int, buffers []core1_0.Buffer, bufferOffsets, sizes, strides []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdBindVertexBuffers2", firstBinding, buffers, bufferOffsets, sizes, strides)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdBindVertexBuffers2 indicates an expected call of CmdBindVertexBuffers2.
+func (mr *CommandBuffer1_3MockRecorder) CmdBindVertexBuffers2(firstBinding, buffers, bufferOffsets, sizes, strides interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdBindVertexBuffers2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdBindVertexBuffers2), firstBinding, buffers, bufferOffsets, sizes, strides)
+}
+
+// CmdBlitImage mocks base method.
+func (m *CommandBuffer1_3) CmdBlitImage(sourceImage core1_0.Image, sourceImageLayout core1_0.ImageLayout, destinationImage core1_0.Image, destinationImageLayout core1_0.ImageLayout, regions []core1_0.ImageBlit, filter core1_0.Filter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdBlitImage", sourceImage, sourceImageLayout, destinationImage, destinationImageLayout, regions, filter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdBlitImage indicates an expected call of CmdBlitImage.
+func (mr *CommandBuffer1_3MockRecorder) CmdBlitImage(sourceImage, sourceImageLayout, destinationImage, destinationImageLayout, regions, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdBlitImage", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdBlitImage), sourceImage, sourceImageLayout, destinationImage, destinationImageLayout, regions, filter)
+}
+
+// CmdBlitImage2 mocks base method.
+func (m *CommandBuffer1_3) CmdBlitImage2(blitImageInfo core1_3.BlitImageInfo2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdBlitImage2", blitImageInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdBlitImage2 indicates an expected call of CmdBlitImage2.
+func (mr *CommandBuffer1_3MockRecorder) CmdBlitImage2(blitImageInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdBlitImage2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdBlitImage2), blitImageInfo)
+}
+
+// CmdClearAttachments mocks base method.
+func (m *CommandBuffer1_3) CmdClearAttachments(attachments []core1_0.ClearAttachment, rects []core1_0.ClearRect) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdClearAttachments", attachments, rects)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdClearAttachments indicates an expected call of CmdClearAttachments.
+func (mr *CommandBuffer1_3MockRecorder) CmdClearAttachments(attachments, rects interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdClearAttachments", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdClearAttachments), attachments, rects)
+}
+
+// CmdClearColorImage mocks base method.
+func (m *CommandBuffer1_3) CmdClearColorImage(image core1_0.Image, imageLayout core1_0.ImageLayout, color core1_0.ClearColorValue, ranges []core1_0.ImageSubresourceRange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdClearColorImage", image, imageLayout, color, ranges)
+}
+
+// CmdClearColorImage indicates an expected call of CmdClearColorImage.
+func (mr *CommandBuffer1_3MockRecorder) CmdClearColorImage(image, imageLayout, color, ranges interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdClearColorImage", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdClearColorImage), image, imageLayout, color, ranges)
+}
+
+// CmdClearDepthStencilImage mocks base method.
+func (m *CommandBuffer1_3) CmdClearDepthStencilImage(image core1_0.Image, imageLayout core1_0.ImageLayout, depthStencil *core1_0.ClearValueDepthStencil, ranges []core1_0.ImageSubresourceRange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdClearDepthStencilImage", image, imageLayout, depthStencil, ranges)
+}
+
+// CmdClearDepthStencilImage indicates an expected call of CmdClearDepthStencilImage.
+func (mr *CommandBuffer1_3MockRecorder) CmdClearDepthStencilImage(image, imageLayout, depthStencil, ranges interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdClearDepthStencilImage", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdClearDepthStencilImage), image, imageLayout, depthStencil, ranges)
+}
+
+// CmdCopyBuffer mocks base method.
+func (m *CommandBuffer1_3) CmdCopyBuffer(srcBuffer, dstBuffer core1_0.Buffer, copyRegions []core1_0.BufferCopy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdCopyBuffer", srcBuffer, dstBuffer, copyRegions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdCopyBuffer indicates an expected call of CmdCopyBuffer.
+func (mr *CommandBuffer1_3MockRecorder) CmdCopyBuffer(srcBuffer, dstBuffer, copyRegions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdCopyBuffer", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdCopyBuffer), srcBuffer, dstBuffer, copyRegions)
+}
+
+// CmdCopyBuffer2 mocks base method.
+func (m *CommandBuffer1_3) CmdCopyBuffer2(copyBufferInfo core1_3.CopyBufferInfo2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdCopyBuffer2", copyBufferInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdCopyBuffer2 indicates an expected call of CmdCopyBuffer2.
+func (mr *CommandBuffer1_3MockRecorder) CmdCopyBuffer2(copyBufferInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdCopyBuffer2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdCopyBuffer2), copyBufferInfo)
+}
+
+// CmdCopyBufferToImage mocks base method.
+func (m *CommandBuffer1_3) CmdCopyBufferToImage(buffer core1_0.Buffer, image core1_0.Image, layout core1_0.ImageLayout, regions []core1_0.BufferImageCopy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdCopyBufferToImage", buffer, image, layout, regions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdCopyBufferToImage indicates an expected call of CmdCopyBufferToImage.
+func (mr *CommandBuffer1_3MockRecorder) CmdCopyBufferToImage(buffer, image, layout, regions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdCopyBufferToImage", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdCopyBufferToImage), buffer, image, layout, regions)
+}
+
+// CmdCopyBufferToImage2 mocks base method.
+func (m *CommandBuffer1_3) CmdCopyBufferToImage2(copyBufferToImageInfo core1_3.CopyBufferToImageInfo2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdCopyBufferToImage2", copyBufferToImageInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdCopyBufferToImage2 indicates an expected call of CmdCopyBufferToImage2.
+func (mr *CommandBuffer1_3MockRecorder) CmdCopyBufferToImage2(copyBufferToImageInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdCopyBufferToImage2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdCopyBufferToImage2), copyBufferToImageInfo)
+}
+
+// CmdCopyImage mocks base method.
+func (m *CommandBuffer1_3) CmdCopyImage(srcImage core1_0.Image, srcImageLayout core1_0.ImageLayout, dstImage core1_0.Image, dstImageLayout core1_0.ImageLayout, regions []core1_0.ImageCopy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdCopyImage", srcImage, srcImageLayout, dstImage, dstImageLayout, regions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdCopyImage indicates an expected call of CmdCopyImage.
+func (mr *CommandBuffer1_3MockRecorder) CmdCopyImage(srcImage, srcImageLayout, dstImage, dstImageLayout, regions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdCopyImage", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdCopyImage), srcImage, srcImageLayout, dstImage, dstImageLayout, regions)
+}
+
+// CmdCopyImage2 mocks base method.
+func (m *CommandBuffer1_3) CmdCopyImage2(copyImageInfo core1_3.CopyImageInfo2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdCopyImage2", copyImageInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdCopyImage2 indicates an expected call of CmdCopyImage2.
+func (mr *CommandBuffer1_3MockRecorder) CmdCopyImage2(copyImageInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdCopyImage2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdCopyImage2), copyImageInfo)
+}
+
+// CmdCopyImageToBuffer mocks base method.
+func (m *CommandBuffer1_3) CmdCopyImageToBuffer(srcImage core1_0.Image, srcImageLayout core1_0.ImageLayout, dstBuffer core1_0.Buffer, regions []core1_0.BufferImageCopy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdCopyImageToBuffer", srcImage, srcImageLayout, dstBuffer, regions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdCopyImageToBuffer indicates an expected call of CmdCopyImageToBuffer.
+func (mr *CommandBuffer1_3MockRecorder) CmdCopyImageToBuffer(srcImage, srcImageLayout, dstBuffer, regions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdCopyImageToBuffer", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdCopyImageToBuffer), srcImage, srcImageLayout, dstBuffer, regions)
+}
+
+// CmdCopyImageToBuffer2 mocks base method.
+func (m *CommandBuffer1_3) CmdCopyImageToBuffer2(copyImageToBufferInfo core1_3.CopyImageToBufferInfo2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdCopyImageToBuffer2", copyImageToBufferInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdCopyImageToBuffer2 indicates an expected call of CmdCopyImageToBuffer2.
+func (mr *CommandBuffer1_3MockRecorder) CmdCopyImageToBuffer2(copyImageToBufferInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdCopyImageToBuffer2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdCopyImageToBuffer2), copyImageToBufferInfo)
+}
+
+// CmdCopyQueryPoolResults mocks base method.
+func (m *CommandBuffer1_3) CmdCopyQueryPoolResults(queryPool core1_0.QueryPool, firstQuery, queryCount int, dstBuffer core1_0.Buffer, dstOffset, stride int, flags core1_0.QueryResultFlags) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdCopyQueryPoolResults", queryPool, firstQuery, queryCount, dstBuffer, dstOffset, stride, flags)
+}
+
+// CmdCopyQueryPoolResults indicates an expected call of CmdCopyQueryPoolResults.
+func (mr *CommandBuffer1_3MockRecorder) CmdCopyQueryPoolResults(queryPool, firstQuery, queryCount, dstBuffer, dstOffset, stride, flags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdCopyQueryPoolResults", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdCopyQueryPoolResults), queryPool, firstQuery, queryCount, dstBuffer, dstOffset, stride, flags)
+}
+
+// CmdDispatch mocks base method.
+func (m *CommandBuffer1_3) CmdDispatch(groupCountX, groupCountY, groupCountZ int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdDispatch", groupCountX, groupCountY, groupCountZ)
+}
+
+// CmdDispatch indicates an expected call of CmdDispatch.
+func (mr *CommandBuffer1_3MockRecorder) CmdDispatch(groupCountX, groupCountY, groupCountZ interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdDispatch", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdDispatch), groupCountX, groupCountY, groupCountZ)
+}
+
+// CmdDispatchBase mocks base method.
+func (m *CommandBuffer1_3) CmdDispatchBase(baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdDispatchBase", baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ)
+}
+
+// CmdDispatchBase indicates an expected call of CmdDispatchBase.
+func (mr *CommandBuffer1_3MockRecorder) CmdDispatchBase(baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdDispatchBase", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdDispatchBase), baseGroupX, baseGroupY, baseGroupZ, groupCountX, groupCountY, groupCountZ)
+}
+
+// CmdDispatchIndirect mocks base method.
+func (m *CommandBuffer1_3) CmdDispatchIndirect(buffer core1_0.Buffer, offset int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdDispatchIndirect", buffer, offset)
+}
+
+// CmdDispatchIndirect indicates an expected call of CmdDispatchIndirect.
+func (mr *CommandBuffer1_3MockRecorder) CmdDispatchIndirect(buffer, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdDispatchIndirect", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdDispatchIndirect), buffer, offset)
+}
+
+// CmdDraw mocks base method.
+func (m *CommandBuffer1_3) CmdDraw(vertexCount, instanceCount int, firstVertex, firstInstance uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdDraw", vertexCount, instanceCount, firstVertex, firstInstance)
+}
+
+// CmdDraw indicates an expected call of CmdDraw.
+func (mr *CommandBuffer1_3MockRecorder) CmdDraw(vertexCount, instanceCount, firstVertex, firstInstance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdDraw", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdDraw), vertexCount, instanceCount, firstVertex, firstInstance)
+}
+
+// CmdDrawIndexed mocks base method.
+func (m *CommandBuffer1_3) CmdDrawIndexed(indexCount, instanceCount int, firstIndex uint32, vertexOffset int, firstInstance uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdDrawIndexed", indexCount, instanceCount, firstIndex, vertexOffset, firstInstance)
+}
+
+// CmdDrawIndexed indicates an expected call of CmdDrawIndexed.
+func (mr *CommandBuffer1_3MockRecorder) CmdDrawIndexed(indexCount, instanceCount, firstIndex, vertexOffset, firstInstance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdDrawIndexed", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdDrawIndexed), indexCount, instanceCount, firstIndex, vertexOffset, firstInstance)
+}
+
+// CmdDrawIndexedIndirect mocks base method.
+func (m *CommandBuffer1_3) CmdDrawIndexedIndirect(buffer core1_0.Buffer, offset, drawCount, stride int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdDrawIndexedIndirect", buffer, offset, drawCount, stride)
+}
+
+// CmdDrawIndexedIndirect indicates an expected call of CmdDrawIndexedIndirect.
+func (mr *CommandBuffer1_3MockRecorder) CmdDrawIndexedIndirect(buffer, offset, drawCount, stride interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdDrawIndexedIndirect", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdDrawIndexedIndirect), buffer, offset, drawCount, stride)
+}
+
+// CmdDrawIndexedIndirectCount mocks base method.
+func (m *CommandBuffer1_3) CmdDrawIndexedIndirectCount(buffer core1_0.Buffer, offset uint64, countBuffer core1_0.Buffer, countBufferOffset uint64, maxDrawCount, stride int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdDrawIndexedIndirectCount", buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// CmdDrawIndexedIndirectCount indicates an expected call of CmdDrawIndexedIndirectCount.
+func (mr *CommandBuffer1_3MockRecorder) CmdDrawIndexedIndirectCount(buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdDrawIndexedIndirectCount", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdDrawIndexedIndirectCount), buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// CmdDrawIndirect mocks base method.
+func (m *CommandBuffer1_3) CmdDrawIndirect(buffer core1_0.Buffer, offset, drawCount, stride int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdDrawIndirect", buffer, offset, drawCount, stride)
+}
+
+// CmdDrawIndirect indicates an expected call of CmdDrawIndirect.
+func (mr *CommandBuffer1_3MockRecorder) CmdDrawIndirect(buffer, offset, drawCount, stride interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdDrawIndirect", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdDrawIndirect), buffer, offset, drawCount, stride)
+}
+
+// CmdDrawIndirectCount mocks base method.
+func (m *CommandBuffer1_3) CmdDrawIndirectCount(buffer core1_0.Buffer, offset uint64, countBuffer core1_0.Buffer, countBufferOffset uint64, maxDrawCount, stride int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdDrawIndirectCount", buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// CmdDrawIndirectCount indicates an expected call of CmdDrawIndirectCount.
+func (mr *CommandBuffer1_3MockRecorder) CmdDrawIndirectCount(buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdDrawIndirectCount", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdDrawIndirectCount), buffer, offset, countBuffer, countBufferOffset, maxDrawCount, stride)
+}
+
+// CmdEndQuery mocks base method.
+func (m *CommandBuffer1_3) CmdEndQuery(queryPool core1_0.QueryPool, query int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdEndQuery", queryPool, query)
+}
+
+// CmdEndQuery indicates an expected call of CmdEndQuery.
+func (mr *CommandBuffer1_3MockRecorder) CmdEndQuery(queryPool, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdEndQuery", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdEndQuery), queryPool, query)
+}
+
+// CmdEndRenderPass mocks base method.
+func (m *CommandBuffer1_3) CmdEndRenderPass() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdEndRenderPass")
+}
+
+// CmdEndRenderPass indicates an expected call of CmdEndRenderPass.
+func (mr *CommandBuffer1_3MockRecorder) CmdEndRenderPass() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdEndRenderPass", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdEndRenderPass))
+}
+
+// CmdEndRenderPass2 mocks base method.
+func (m *CommandBuffer1_3) CmdEndRenderPass2(subpassEnd core1_2.SubpassEndInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdEndRenderPass2", subpassEnd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdEndRenderPass2 indicates an expected call of CmdEndRenderPass2.
+func (mr *CommandBuffer1_3MockRecorder) CmdEndRenderPass2(subpassEnd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdEndRenderPass2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdEndRenderPass2), subpassEnd)
+}
+
+// CmdEndRendering mocks base method.
+func (m *CommandBuffer1_3) CmdEndRendering() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdEndRendering")
+}
+
+// CmdEndRendering indicates an expected call of CmdEndRendering.
+func (mr *CommandBuffer1_3MockRecorder) CmdEndRendering() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdEndRendering", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdEndRendering))
+}
+
+// CmdExecuteCommands mocks base method.
+func (m *CommandBuffer1_3) CmdExecuteCommands(commandBuffers []core1_0.CommandBuffer) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdExecuteCommands", commandBuffers)
+}
+
+// CmdExecuteCommands indicates an expected call of CmdExecuteCommands.
+func (mr *CommandBuffer1_3MockRecorder) CmdExecuteCommands(commandBuffers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdExecuteCommands", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdExecuteCommands), commandBuffers)
+}
+
+// CmdFillBuffer mocks base method.
+func (m *CommandBuffer1_3) CmdFillBuffer(dstBuffer core1_0.Buffer, dstOffset, size int, data uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdFillBuffer", dstBuffer, dstOffset, size, data)
+}
+
+// CmdFillBuffer indicates an expected call of CmdFillBuffer.
+func (mr *CommandBuffer1_3MockRecorder) CmdFillBuffer(dstBuffer, dstOffset, size, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdFillBuffer", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdFillBuffer), dstBuffer, dstOffset, size, data)
+}
+
+// CmdNextSubpass mocks base method.
+func (m *CommandBuffer1_3) CmdNextSubpass(contents core1_0.SubpassContents) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdNextSubpass", contents)
+}
+
+// CmdNextSubpass indicates an expected call of CmdNextSubpass.
+func (mr *CommandBuffer1_3MockRecorder) CmdNextSubpass(contents interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdNextSubpass", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdNextSubpass), contents)
+}
+
+// CmdNextSubpass2 mocks base method.
+func (m *CommandBuffer1_3) CmdNextSubpass2(subpassBegin core1_2.SubpassBeginInfo, subpassEnd core1_2.SubpassEndInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdNextSubpass2", subpassBegin, subpassEnd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdNextSubpass2 indicates an expected call of CmdNextSubpass2.
+func (mr *CommandBuffer1_3MockRecorder) CmdNextSubpass2(subpassBegin, subpassEnd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdNextSubpass2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdNextSubpass2), subpassBegin, subpassEnd)
+}
+
+// CmdPipelineBarrier mocks base method.
+func (m *CommandBuffer1_3) CmdPipelineBarrier(srcStageMask, dstStageMask core1_0.PipelineStageFlags, dependencies core1_0.DependencyFlags, memoryBarriers []core1_0.MemoryBarrier, bufferMemoryBarriers []core1_0.BufferMemoryBarrier, imageMemoryBarriers []core1_0.ImageMemoryBarrier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdPipelineBarrier", srcStageMask, dstStageMask, dependencies, memoryBarriers, bufferMemoryBarriers, imageMemoryBarriers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdPipelineBarrier indicates an expected call of CmdPipelineBarrier.
+func (mr *CommandBuffer1_3MockRecorder) CmdPipelineBarrier(srcStageMask, dstStageMask, dependencies, memoryBarriers, bufferMemoryBarriers, imageMemoryBarriers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdPipelineBarrier", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdPipelineBarrier), srcStageMask, dstStageMask, dependencies, memoryBarriers, bufferMemoryBarriers, imageMemoryBarriers)
+}
+
+// CmdPipelineBarrier2 mocks base method.
+func (m *CommandBuffer1_3) CmdPipelineBarrier2(dependencyInfo core1_3.DependencyInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdPipelineBarrier2", dependencyInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdPipelineBarrier2 indicates an expected call of CmdPipelineBarrier2.
+func (mr *CommandBuffer1_3MockRecorder) CmdPipelineBarrier2(dependencyInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdPipelineBarrier2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdPipelineBarrier2), dependencyInfo)
+}
+
+// CmdPushConstants mocks base method.
+func (m *CommandBuffer1_3) CmdPushConstants(layout core1_0.PipelineLayout, stageFlags core1_0.ShaderStageFlags, offset int, valueBytes []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdPushConstants", layout, stageFlags, offset, valueBytes)
+}
+
+// CmdPushConstants indicates an expected call of CmdPushConstants.
+func (mr *CommandBuffer1_3MockRecorder) CmdPushConstants(layout, stageFlags, offset, valueBytes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdPushConstants", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdPushConstants), layout, stageFlags, offset, valueBytes)
+}
+
+// CmdResetEvent mocks base method.
+func (m *CommandBuffer1_3) CmdResetEvent(event core1_0.Event, stageMask core1_0.PipelineStageFlags) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdResetEvent", event, stageMask)
+}
+
+// CmdResetEvent indicates an expected call of CmdResetEvent.
+func (mr *CommandBuffer1_3MockRecorder) CmdResetEvent(event, stageMask interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdResetEvent", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdResetEvent), event, stageMask)
+}
+
+// CmdResetEvent2 mocks base method.
+func (m *CommandBuffer1_3) CmdResetEvent2(event core1_0.Event, stageMask core1_3.PipelineStageFlags2) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdResetEvent2", event, stageMask)
+}
+
+// CmdResetEvent2 indicates an expected call of CmdResetEvent2.
+func (mr *CommandBuffer1_3MockRecorder) CmdResetEvent2(event, stageMask interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdResetEvent2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdResetEvent2), event, stageMask)
+}
+
+// CmdResetQueryPool mocks base method.
+func (m *CommandBuffer1_3) CmdResetQueryPool(queryPool core1_0.QueryPool, startQuery, queryCount int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdResetQueryPool", queryPool, startQuery, queryCount)
+}
+
+// CmdResetQueryPool indicates an expected call of CmdResetQueryPool.
+func (mr *CommandBuffer1_3MockRecorder) CmdResetQueryPool(queryPool, startQuery, queryCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdResetQueryPool", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdResetQueryPool), queryPool, startQuery, queryCount)
+}
+
+// CmdResolveImage mocks base method.
+func (m *CommandBuffer1_3) CmdResolveImage(srcImage core1_0.Image, srcImageLayout core1_0.ImageLayout, dstImage core1_0.Image, dstImageLayout core1_0.ImageLayout, regions []core1_0.ImageResolve) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdResolveImage", srcImage, srcImageLayout, dstImage, dstImageLayout, regions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdResolveImage indicates an expected call of CmdResolveImage.
+func (mr *CommandBuffer1_3MockRecorder) CmdResolveImage(srcImage, srcImageLayout, dstImage, dstImageLayout, regions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdResolveImage", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdResolveImage), srcImage, srcImageLayout, dstImage, dstImageLayout, regions)
+}
+
+// CmdResolveImage2 mocks base method.
+func (m *CommandBuffer1_3) CmdResolveImage2(resolveImageInfo core1_3.ResolveImageInfo2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdResolveImage2", resolveImageInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdResolveImage2 indicates an expected call of CmdResolveImage2.
+func (mr *CommandBuffer1_3MockRecorder) CmdResolveImage2(resolveImageInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdResolveImage2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdResolveImage2), resolveImageInfo)
+}
+
+// CmdSetBlendConstants mocks base method.
+func (m *CommandBuffer1_3) CmdSetBlendConstants(blendConstants [4]float32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetBlendConstants", blendConstants)
+}
+
+// CmdSetBlendConstants indicates an expected call of CmdSetBlendConstants.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetBlendConstants(blendConstants interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetBlendConstants", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetBlendConstants), blendConstants)
+}
+
+// CmdSetCullMode mocks base method.
+func (m *CommandBuffer1_3) CmdSetCullMode(cullMode core1_0.CullModeFlags) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetCullMode", cullMode)
+}
+
+// CmdSetCullMode indicates an expected call of CmdSetCullMode.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetCullMode(cullMode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetCullMode", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetCullMode), cullMode)
+}
+
+// CmdSetDepthBias mocks base method.
+func (m *CommandBuffer1_3) CmdSetDepthBias(depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor float32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetDepthBias", depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor)
+}
+
+// CmdSetDepthBias indicates an expected call of CmdSetDepthBias.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetDepthBias(depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetDepthBias", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetDepthBias), depthBiasConstantFactor, depthBiasClamp, depthBiasSlopeFactor)
+}
+
+// CmdSetDepthBiasEnable mocks base method.
+func (m *CommandBuffer1_3) CmdSetDepthBiasEnable(depthBiasEnable bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetDepthBiasEnable", depthBiasEnable)
+}
+
+// CmdSetDepthBiasEnable indicates an expected call of CmdSetDepthBiasEnable.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetDepthBiasEnable(depthBiasEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetDepthBiasEnable", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetDepthBiasEnable), depthBiasEnable)
+}
+
+// CmdSetDepthBounds mocks base method.
+func (m *CommandBuffer1_3) CmdSetDepthBounds(min, max float32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetDepthBounds", min, max)
+}
+
+// CmdSetDepthBounds indicates an expected call of CmdSetDepthBounds.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetDepthBounds(min, max interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetDepthBounds", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetDepthBounds), min, max)
+}
+
+// CmdSetDepthBoundsTestEnable mocks base method.
+func (m *CommandBuffer1_3) CmdSetDepthBoundsTestEnable(depthBoundsTestEnable bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetDepthBoundsTestEnable", depthBoundsTestEnable)
+}
+
+// CmdSetDepthBoundsTestEnable indicates an expected call of CmdSetDepthBoundsTestEnable.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetDepthBoundsTestEnable(depthBoundsTestEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetDepthBoundsTestEnable", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetDepthBoundsTestEnable), depthBoundsTestEnable)
+}
+
+// CmdSetDepthCompareOp mocks base method.
+func (m *CommandBuffer1_3) CmdSetDepthCompareOp(depthCompareOp core1_0.CompareOp) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetDepthCompareOp", depthCompareOp)
+}
+
+// CmdSetDepthCompareOp indicates an expected call of CmdSetDepthCompareOp.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetDepthCompareOp(depthCompareOp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetDepthCompareOp", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetDepthCompareOp), depthCompareOp)
+}
+
+// CmdSetDepthTestEnable mocks base method.
+func (m *CommandBuffer1_3) CmdSetDepthTestEnable(depthTestEnable bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetDepthTestEnable", depthTestEnable)
+}
+
+// CmdSetDepthTestEnable indicates an expected call of CmdSetDepthTestEnable.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetDepthTestEnable(depthTestEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetDepthTestEnable", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetDepthTestEnable), depthTestEnable)
+}
+
+// CmdSetDepthWriteEnable mocks base method.
+func (m *CommandBuffer1_3) CmdSetDepthWriteEnable(depthWriteEnable bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetDepthWriteEnable", depthWriteEnable)
+}
+
+// CmdSetDepthWriteEnable indicates an expected call of CmdSetDepthWriteEnable.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetDepthWriteEnable(depthWriteEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetDepthWriteEnable", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetDepthWriteEnable), depthWriteEnable)
+}
+
+// CmdSetDeviceMask mocks base method.
+func (m *CommandBuffer1_3) CmdSetDeviceMask(deviceMask uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetDeviceMask", deviceMask)
+}
+
+// CmdSetDeviceMask indicates an expected call of CmdSetDeviceMask.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetDeviceMask(deviceMask interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetDeviceMask", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetDeviceMask), deviceMask)
+}
+
+// CmdSetEvent mocks base method.
+func (m *CommandBuffer1_3) CmdSetEvent(event core1_0.Event, stageMask core1_0.PipelineStageFlags) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetEvent", event, stageMask)
+}
+
+// CmdSetEvent indicates an expected call of CmdSetEvent.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetEvent(event, stageMask interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetEvent", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetEvent), event, stageMask)
+}
+
+// CmdSetEvent2 mocks base method.
+func (m *CommandBuffer1_3) CmdSetEvent2(event core1_0.Event, dependencyInfo core1_3.DependencyInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdSetEvent2", event, dependencyInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdSetEvent2 indicates an expected call of CmdSetEvent2.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetEvent2(event, dependencyInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetEvent2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetEvent2), event, dependencyInfo)
+}
+
+// CmdSetFrontFace mocks base method.
+func (m *CommandBuffer1_3) CmdSetFrontFace(frontFace core1_0.FrontFace) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetFrontFace", frontFace)
+}
+
+// CmdSetFrontFace indicates an expected call of CmdSetFrontFace.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetFrontFace(frontFace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetFrontFace", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetFrontFace), frontFace)
+}
+
+// CmdSetLineWidth mocks base method.
+func (m *CommandBuffer1_3) CmdSetLineWidth(lineWidth float32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetLineWidth", lineWidth)
+}
+
+// CmdSetLineWidth indicates an expected call of CmdSetLineWidth.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetLineWidth(lineWidth interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetLineWidth", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetLineWidth), lineWidth)
+}
+
+// CmdSetPrimitiveRestartEnable mocks base method.
+func (m *CommandBuffer1_3) CmdSetPrimitiveRestartEnable(primitiveRestartEnable bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetPrimitiveRestartEnable", primitiveRestartEnable)
+}
+
+// CmdSetPrimitiveRestartEnable indicates an expected call of CmdSetPrimitiveRestartEnable.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetPrimitiveRestartEnable(primitiveRestartEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetPrimitiveRestartEnable", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetPrimitiveRestartEnable), primitiveRestartEnable)
+}
+
+// CmdSetPrimitiveTopology mocks base method.
+func (m *CommandBuffer1_3) CmdSetPrimitiveTopology(primitiveTopology core1_0.PrimitiveTopology) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetPrimitiveTopology", primitiveTopology)
+}
+
+// CmdSetPrimitiveTopology indicates an expected call of CmdSetPrimitiveTopology.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetPrimitiveTopology(primitiveTopology interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetPrimitiveTopology", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetPrimitiveTopology), primitiveTopology)
+}
+
+// CmdSetRasterizerDiscardEnable mocks base method.
+func (m *CommandBuffer1_3) CmdSetRasterizerDiscardEnable(rasterizerDiscardEnable bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetRasterizerDiscardEnable", rasterizerDiscardEnable)
+}
+
+// CmdSetRasterizerDiscardEnable indicates an expected call of CmdSetRasterizerDiscardEnable.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetRasterizerDiscardEnable(rasterizerDiscardEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetRasterizerDiscardEnable", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetRasterizerDiscardEnable), rasterizerDiscardEnable)
+}
+
+// CmdSetScissor mocks base method.
+func (m *CommandBuffer1_3) CmdSetScissor(scissors []core1_0.Rect2D) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetScissor", scissors)
+}
+
+// CmdSetScissor indicates an expected call of CmdSetScissor.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetScissor(scissors interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetScissor", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetScissor), scissors)
+}
+
+// CmdSetScissorWithCount mocks base method.
+func (m *CommandBuffer1_3) CmdSetScissorWithCount(scissors []core1_0.Rect2D) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetScissorWithCount", scissors)
+}
+
+// CmdSetScissorWithCount indicates an expected call of CmdSetScissorWithCount.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetScissorWithCount(scissors interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetScissorWithCount", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetScissorWithCount), scissors)
+}
+
+// CmdSetStencilCompareMask mocks base method.
+func (m *CommandBuffer1_3) CmdSetStencilCompareMask(faceMask core1_0.StencilFaceFlags, compareMask uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetStencilCompareMask", faceMask, compareMask)
+}
+
+// CmdSetStencilCompareMask indicates an expected call of CmdSetStencilCompareMask.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetStencilCompareMask(faceMask, compareMask interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetStencilCompareMask", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetStencilCompareMask), faceMask, compareMask)
+}
+
+// CmdSetStencilOp mocks base method.
+func (m *CommandBuffer1_3) CmdSetStencilOp(faceMask core1_0.StencilFaceFlags, failOp, passOp, depthFailOp core1_0.StencilOp, compareOp core1_0.CompareOp) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetStencilOp", faceMask, failOp, passOp, depthFailOp, compareOp)
+}
+
+// CmdSetStencilOp indicates an expected call of CmdSetStencilOp.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetStencilOp(faceMask, failOp, passOp, depthFailOp, compareOp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetStencilOp", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetStencilOp), faceMask, failOp, passOp, depthFailOp, compareOp)
+}
+
+// CmdSetStencilReference mocks base method.
+func (m *CommandBuffer1_3) CmdSetStencilReference(faceMask core1_0.StencilFaceFlags, reference uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetStencilReference", faceMask, reference)
+}
+
+// CmdSetStencilReference indicates an expected call of CmdSetStencilReference.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetStencilReference(faceMask, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetStencilReference", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetStencilReference), faceMask, reference)
+}
+
+// CmdSetStencilTestEnable mocks base method.
+func (m *CommandBuffer1_3) CmdSetStencilTestEnable(stencilTestEnable bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetStencilTestEnable", stencilTestEnable)
+}
+
+// CmdSetStencilTestEnable indicates an expected call of CmdSetStencilTestEnable.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetStencilTestEnable(stencilTestEnable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetStencilTestEnable", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetStencilTestEnable), stencilTestEnable)
+}
+
+// CmdSetStencilWriteMask mocks base method.
+func (m *CommandBuffer1_3) CmdSetStencilWriteMask(faceMask core1_0.StencilFaceFlags, writeMask uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetStencilWriteMask", faceMask, writeMask)
+}
+
+// CmdSetStencilWriteMask indicates an expected call of CmdSetStencilWriteMask.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetStencilWriteMask(faceMask, writeMask interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetStencilWriteMask", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetStencilWriteMask), faceMask, writeMask)
+}
+
+// CmdSetViewport mocks base method.
+func (m *CommandBuffer1_3) CmdSetViewport(viewports []core1_0.Viewport) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetViewport", viewports)
+}
+
+// CmdSetViewport indicates an expected call of CmdSetViewport.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetViewport(viewports interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetViewport", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetViewport), viewports)
+}
+
+// CmdSetViewportWithCount mocks base method.
+func (m *CommandBuffer1_3) CmdSetViewportWithCount(viewports []core1_0.Viewport) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdSetViewportWithCount", viewports)
+}
+
+// CmdSetViewportWithCount indicates an expected call of CmdSetViewportWithCount.
+func (mr *CommandBuffer1_3MockRecorder) CmdSetViewportWithCount(viewports interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdSetViewportWithCount", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdSetViewportWithCount), viewports)
+}
+
+// CmdUpdateBuffer mocks base method.
+func (m *CommandBuffer1_3) CmdUpdateBuffer(dstBuffer core1_0.Buffer, dstOffset, dataSize int, data []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdUpdateBuffer", dstBuffer, dstOffset, dataSize, data)
+}
+
+// CmdUpdateBuffer indicates an expected call of CmdUpdateBuffer.
+func (mr *CommandBuffer1_3MockRecorder) CmdUpdateBuffer(dstBuffer, dstOffset, dataSize, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdUpdateBuffer", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdUpdateBuffer), dstBuffer, dstOffset, dataSize, data)
+}
+
+// CmdWaitEvents mocks base method.
+func (m *CommandBuffer1_3) CmdWaitEvents(events []core1_0.Event, srcStageMask, dstStageMask core1_0.PipelineStageFlags, memoryBarriers []core1_0.MemoryBarrier, bufferMemoryBarriers []core1_0.BufferMemoryBarrier, imageMemoryBarriers []core1_0.ImageMemoryBarrier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdWaitEvents", events, srcStageMask, dstStageMask, memoryBarriers, bufferMemoryBarriers, imageMemoryBarriers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdWaitEvents indicates an expected call of CmdWaitEvents.
+func (mr *CommandBuffer1_3MockRecorder) CmdWaitEvents(events, srcStageMask, dstStageMask, memoryBarriers, bufferMemoryBarriers, imageMemoryBarriers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdWaitEvents", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdWaitEvents), events, srcStageMask, dstStageMask, memoryBarriers, bufferMemoryBarriers, imageMemoryBarriers)
+}
+
+// CmdWaitEvents2 mocks base method.
+func (m *CommandBuffer1_3) CmdWaitEvents2(events []core1_0.Event, dependencyInfos []core1_3.DependencyInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CmdWaitEvents2", events, dependencyInfos)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CmdWaitEvents2 indicates an expected call of CmdWaitEvents2.
+func (mr *CommandBuffer1_3MockRecorder) CmdWaitEvents2(events, dependencyInfos interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdWaitEvents2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdWaitEvents2), events, dependencyInfos)
+}
+
+// CmdWriteTimestamp mocks base method.
+func (m *CommandBuffer1_3) CmdWriteTimestamp(pipelineStage core1_0.PipelineStageFlags, queryPool core1_0.QueryPool, query int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdWriteTimestamp", pipelineStage, queryPool, query)
+}
+
+// CmdWriteTimestamp indicates an expected call of CmdWriteTimestamp.
+func (mr *CommandBuffer1_3MockRecorder) CmdWriteTimestamp(pipelineStage, queryPool, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdWriteTimestamp", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdWriteTimestamp), pipelineStage, queryPool, query)
+}
+
+// CmdWriteTimestamp2 mocks base method.
+func (m *CommandBuffer1_3) CmdWriteTimestamp2(stage core1_3.PipelineStageFlags2, queryPool core1_0.QueryPool, query int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CmdWriteTimestamp2", stage, queryPool, query)
+}
+
+// CmdWriteTimestamp2 indicates an expected call of CmdWriteTimestamp2.
+func (mr *CommandBuffer1_3MockRecorder) CmdWriteTimestamp2(stage, queryPool, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CmdWriteTimestamp2", reflect.TypeOf((*CommandBuffer1_3)(nil).CmdWriteTimestamp2), stage, queryPool, query)
+}
+
+// CommandPoolHandle mocks base method.
+func (m *CommandBuffer1_3) CommandPoolHandle() driver.VkCommandPool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommandPoolHandle")
+	ret0, _ := ret[0].(driver.VkCommandPool)
+	return ret0
+}
+
+// CommandPoolHandle indicates an expected call of CommandPoolHandle.
+func (mr *CommandBuffer1_3MockRecorder) CommandPoolHandle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommandPoolHandle", reflect.TypeOf((*CommandBuffer1_3)(nil).CommandPoolHandle))
+}
+
+// CommandsRecorded mocks base method.
+func (m *CommandBuffer1_3) CommandsRecorded() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommandsRecorded")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// CommandsRecorded indicates an expected call of CommandsRecorded.
+func (mr *CommandBuffer1_3MockRecorder) CommandsRecorded() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommandsRecorded", reflect.TypeOf((*CommandBuffer1_3)(nil).CommandsRecorded))
+}
+
+// DeviceHandle mocks base method.
+func (m *CommandBuffer1_3) DeviceHandle() driver.VkDevice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeviceHandle")
+	ret0, _ := ret[0].(driver.VkDevice)
+	return ret0
+}
+
+// DeviceHandle indicates an expected call of DeviceHandle.
+func (mr *CommandBuffer1_3MockRecorder) DeviceHandle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceHandle", reflect.TypeOf((*CommandBuffer1_3)(nil).DeviceHandle))
+}
+
+// DispatchesRecorded mocks base method.
+func (m *CommandBuffer1_3) DispatchesRecorded() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchesRecorded")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// DispatchesRecorded indicates an expected call of DispatchesRecorded.
+func (mr *CommandBuffer1_3MockRecorder) DispatchesRecorded() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchesRecorded", reflect.TypeOf((*CommandBuffer1_3)(nil).DispatchesRecorded))
+}
+
+// DrawsRecorded mocks base method.
+func (m *CommandBuffer1_3) DrawsRecorded() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrawsRecorded")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// DrawsRecorded indicates an expected call of DrawsRecorded.
+func (mr *CommandBuffer1_3MockRecorder) DrawsRecorded() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrawsRecorded", reflect.TypeOf((*CommandBuffer1_3)(nil).DrawsRecorded))
+}
+
+// Driver mocks base method.
+func (m *CommandBuffer1_3) Driver() driver.Driver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Driver")
+	ret0, _ := ret[0].(driver.Driver)
+	return ret0
+}
+
+// Driver indicates an expected call of Driver.
+func (mr *CommandBuffer1_3MockRecorder) Driver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Driver", reflect.TypeOf((*CommandBuffer1_3)(nil).Driver))
+}
+
+// End mocks base method.
+func (m *CommandBuffer1_3) End() (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "End")
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// End indicates an expected call of End.
+func (mr *CommandBuffer1_3MockRecorder) End() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "End", reflect.TypeOf((*CommandBuffer1_3)(nil).End))
+}
+
+// Free mocks base method.
+func (m *CommandBuffer1_3) Free() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Free")
+}
+
+// Free indicates an expected call of Free.
+func (mr *CommandBuffer1_3MockRecorder) Free() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Free", reflect.TypeOf((*CommandBuffer1_3)(nil).Free))
+}
+
+// Handle mocks base method.
+func (m *CommandBuffer1_3) Handle() driver.VkCommandBuffer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(driver.VkCommandBuffer)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *CommandBuffer1_3MockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*CommandBuffer1_3)(nil).Handle))
+}
+
+// Reset mocks base method.
+func (m *CommandBuffer1_3) Reset(flags core1_0.CommandBufferResetFlags) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", flags)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *CommandBuffer1_3MockRecorder) Reset(flags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*CommandBuffer1_3)(nil).Reset), flags)
+}
+
+// Device1_3 is a mock of Device interface.
+type Device1_3 struct {
+	ctrl     *gomock.Controller
+	recorder *Device1_3MockRecorder
+}
+
+// Device1_3MockRecorder is the mock recorder for Device1_3.
+type Device1_3MockRecorder struct {
+	mock *Device1_3
+}
+
+// NewDevice1_3 creates a new mock instance.
+func NewDevice1_3(ctrl *gomock.Controller) *Device1_3 {
+	mock := &Device1_3{ctrl: ctrl}
+	mock.recorder = &Device1_3MockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Device1_3) EXPECT() *Device1_3MockRecorder {
+	return m.recorder
+}
+
+// APIVersion mocks base method.
+func (m *Device1_3) APIVersion() common.APIVersion {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIVersion")
+	ret0, _ := ret[0].(common.APIVersion)
+	return ret0
+}
+
+// APIVersion indicates an expected call of APIVersion.
+func (mr *Device1_3MockRecorder) APIVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIVersion", reflect.TypeOf((*Device1_3)(nil).APIVersion))
+}
+
+// AllocateCommandBuffers mocks base method.
+func (m *Device1_3) AllocateCommandBuffers(o core1_0.CommandBufferAllocateInfo) ([]core1_0.CommandBuffer, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllocateCommandBuffers", o)
+	ret0, _ := ret[0].([]core1_0.CommandBuffer)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AllocateCommandBuffers indicates an expected call of AllocateCommandBuffers.
+func (mr *Device1_3MockRecorder) AllocateCommandBuffers(o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateCommandBuffers", reflect.TypeOf((*Device1_3)(nil).AllocateCommandBuffers), o)
+}
+
+// AllocateDescriptorSets mocks base method.
+func (m *Device1_3) AllocateDescriptorSets(o core1_0.DescriptorSetAllocateInfo) ([]core1_0.DescriptorSet, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllocateDescriptorSets", o)
+	ret0, _ := ret[0].([]core1_0.DescriptorSet)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AllocateDescriptorSets indicates an expected call of AllocateDescriptorSets.
+func (mr *Device1_3MockRecorder) AllocateDescriptorSets(o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateDescriptorSets", reflect.TypeOf((*Device1_3)(nil).AllocateDescriptorSets), o)
+}
+
+// AllocateMemory mocks base method.
+func (m *Device1_3) AllocateMemory(allocationCallbacks *driver.AllocationCallbacks, o core1_0.MemoryAllocateInfo) (core1_0.DeviceMemory, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllocateMemory", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.DeviceMemory)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AllocateMemory indicates an expected call of AllocateMemory.
+func (mr *Device1_3MockRecorder) AllocateMemory(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateMemory", reflect.TypeOf((*Device1_3)(nil).AllocateMemory), allocationCallbacks, o)
+}
+
+// BindBufferMemory2 mocks base method.
+func (m *Device1_3) BindBufferMemory2(o []core1_1.BindBufferMemoryInfo) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindBufferMemory2", o)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BindBufferMemory2 indicates an expected call of BindBufferMemory2.
+func (mr *Device1_3MockRecorder) BindBufferMemory2(o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindBufferMemory2", reflect.TypeOf((*Device1_3)(nil).BindBufferMemory2), o)
+}
+
+// BindImageMemory2 mocks base method.
+func (m *Device1_3) BindImageMemory2(o []core1_1.BindImageMemoryInfo) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindImageMemory2", o)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BindImageMemory2 indicates an expected call of BindImageMemory2.
+func (mr *Device1_3MockRecorder) BindImageMemory2(o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindImageMemory2", reflect.TypeOf((*Device1_3)(nil).BindImageMemory2), o)
+}
+
+// BufferMemoryRequirements2 mocks base method.
+func (m *Device1_3) BufferMemoryRequirements2(o core1_1.BufferMemoryRequirementsInfo2, out *core1_1.MemoryRequirements2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BufferMemoryRequirements2", o, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BufferMemoryRequirements2 indicates an expected call of BufferMemoryRequirements2.
+func (mr *Device1_3MockRecorder) BufferMemoryRequirements2(o, out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BufferMemoryRequirements2", reflect.TypeOf((*Device1_3)(nil).BufferMemoryRequirements2), o, out)
+}
+
+// CreateBuffer mocks base method.
+func (m *Device1_3) CreateBuffer(allocationCallbacks *driver.AllocationCallbacks, o core1_0.BufferCreateInfo) (core1_0.Buffer, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBuffer", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.Buffer)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateBuffer indicates an expected call of CreateBuffer.
+func (mr *Device1_3MockRecorder) CreateBuffer(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBuffer", reflect.TypeOf((*Device1_3)(nil).CreateBuffer), allocationCallbacks, o)
+}
+
+// CreateBufferView mocks base method.
+func (m *Device1_3) CreateBufferView(allocationCallbacks *driver.AllocationCallbacks, o core1_0.BufferViewCreateInfo) (core1_0.BufferView, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBufferView", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.BufferView)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateBufferView indicates an expected call of CreateBufferView.
+func (mr *Device1_3MockRecorder) CreateBufferView(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBufferView", reflect.TypeOf((*Device1_3)(nil).CreateBufferView), allocationCallbacks, o)
+}
+
+// CreateCommandPool mocks base method.
+func (m *Device1_3) CreateCommandPool(allocationCallbacks *driver.AllocationCallbacks, o core1_0.CommandPoolCreateInfo) (core1_0.CommandPool, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCommandPool", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.CommandPool)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateCommandPool indicates an expected call of CreateCommandPool.
+func (mr *Device1_3MockRecorder) CreateCommandPool(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCommandPool", reflect.TypeOf((*Device1_3)(nil).CreateCommandPool), allocationCallbacks, o)
+}
+
+// CreateComputePipelines mocks base method.
+func (m *Device1_3) CreateComputePipelines(pipelineCache core1_0.PipelineCache, allocationCallbacks *driver.AllocationCallbacks, o []core1_0.ComputePipelineCreateInfo) ([]core1_0.Pipeline, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComputePipelines", pipelineCache, allocationCallbacks, o)
+	ret0, _ := ret[0].([]core1_0.Pipeline)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateComputePipelines indicates an expected call of CreateComputePipelines.
+func (mr *Device1_3MockRecorder) CreateComputePipelines(pipelineCache, allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComputePipelines", reflect.TypeOf((*Device1_3)(nil).CreateComputePipelines), pipelineCache, allocationCallbacks, o)
+}
+
+// CreateDescriptorPool mocks base method.
+func (m *Device1_3) CreateDescriptorPool(allocationCallbacks *driver.AllocationCallbacks, o core1_0.DescriptorPoolCreateInfo) (core1_0.DescriptorPool, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDescriptorPool", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.DescriptorPool)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateDescriptorPool indicates an expected call of CreateDescriptorPool.
+func (mr *Device1_3MockRecorder) CreateDescriptorPool(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDescriptorPool", reflect.TypeOf((*Device1_3)(nil).CreateDescriptorPool), allocationCallbacks, o)
+}
+
+// CreateDescriptorSetLayout mocks base method.
+func (m *Device1_3) CreateDescriptorSetLayout(allocationCallbacks *driver.AllocationCallbacks, o core1_0.DescriptorSetLayoutCreateInfo) (core1_0.DescriptorSetLayout, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDescriptorSetLayout", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.DescriptorSetLayout)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateDescriptorSetLayout indicates an expected call of CreateDescriptorSetLayout.
+func (mr *Device1_3MockRecorder) CreateDescriptorSetLayout(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDescriptorSetLayout", reflect.TypeOf((*Device1_3)(nil).CreateDescriptorSetLayout), allocationCallbacks, o)
+}
+
+// CreateDescriptorUpdateTemplate mocks base method.
+func (m *Device1_3) CreateDescriptorUpdateTemplate(o core1_1.DescriptorUpdateTemplateCreateInfo, allocator *driver.AllocationCallbacks) (core1_1.DescriptorUpdateTemplate, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDescriptorUpdateTemplate", o, allocator)
+	ret0, _ := ret[0].(core1_1.DescriptorUpdateTemplate)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateDescriptorUpdateTemplate indicates an expected call of CreateDescriptorUpdateTemplate.
+func (mr *Device1_3MockRecorder) CreateDescriptorUpdateTemplate(o, allocator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDescriptorUpdateTemplate", reflect.TypeOf((*Device1_3)(nil).CreateDescriptorUpdateTemplate), o, allocator)
+}
+
+// CreateEvent mocks base method.
+func (m *Device1_3) CreateEvent(allocationCallbacks *driver.AllocationCallbacks, options core1_0.EventCreateInfo) (core1_0.Event, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", allocationCallbacks, options)
+	ret0, _ := ret[0].(core1_0.Event)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *Device1_3MockRecorder) CreateEvent(allocationCallbacks, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*Device1_3)(nil).CreateEvent), allocationCallbacks, options)
+}
+
+// CreateFence mocks base method.
+func (m *Device1_3) CreateFence(allocationCallbacks *driver.AllocationCallbacks, o core1_0.FenceCreateInfo) (core1_0.Fence, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFence", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.Fence)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateFence indicates an expected call of CreateFence.
+func (mr *Device1_3MockRecorder) CreateFence(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFence", reflect.TypeOf((*Device1_3)(nil).CreateFence), allocationCallbacks, o)
+}
+
+// CreateFramebuffer mocks base method.
+func (m *Device1_3) CreateFramebuffer(allocationCallbacks *driver.AllocationCallbacks, o core1_0.FramebufferCreateInfo) (core1_0.Framebuffer, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFramebuffer", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.Framebuffer)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateFramebuffer indicates an expected call of CreateFramebuffer.
+func (mr *Device1_3MockRecorder) CreateFramebuffer(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFramebuffer", reflect.TypeOf((*Device1_3)(nil).CreateFramebuffer), allocationCallbacks, o)
+}
+
+// CreateGraphicsPipelines mocks base method.
+func (m *Device1_3) CreateGraphicsPipelines(pipelineCache core1_0.PipelineCache, allocationCallbacks *driver.AllocationCallbacks, o []core1_0.GraphicsPipelineCreateInfo) ([]core1_0.Pipeline, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGraphicsPipelines", pipelineCache, allocationCallbacks, o)
+	ret0, _ := ret[0].([]core1_0.Pipeline)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateGraphicsPipelines indicates an expected call of CreateGraphicsPipelines.
+func (mr *Device1_3MockRecorder) CreateGraphicsPipelines(pipelineCache, allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGraphicsPipelines", reflect.TypeOf((*Device1_3)(nil).CreateGraphicsPipelines), pipelineCache, allocationCallbacks, o)
+}
+
+// CreateImage mocks base method.
+func (m *Device1_3) CreateImage(allocationCallbacks *driver.AllocationCallbacks, options core1_0.ImageCreateInfo) (core1_0.Image, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImage", allocationCallbacks, options)
+	ret0, _ := ret[0].(core1_0.Image)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateImage indicates an expected call of CreateImage.
+func (mr *Device1_3MockRecorder) CreateImage(allocationCallbacks, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImage", reflect.TypeOf((*Device1_3)(nil).CreateImage), allocationCallbacks, options)
+}
+
+// CreateImageView mocks base method.
+func (m *Device1_3) CreateImageView(allocationCallbacks *driver.AllocationCallbacks, o core1_0.ImageViewCreateInfo) (core1_0.ImageView, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImageView", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.ImageView)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateImageView indicates an expected call of CreateImageView.
+func (mr *Device1_3MockRecorder) CreateImageView(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImageView", reflect.TypeOf((*Device1_3)(nil).CreateImageView), allocationCallbacks, o)
+}
+
+// CreatePipelineCache mocks base method.
+func (m *Device1_3) CreatePipelineCache(allocationCallbacks *driver.AllocationCallbacks, o core1_0.PipelineCacheCreateInfo) (core1_0.PipelineCache, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePipelineCache", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.PipelineCache)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePipelineCache indicates an expected call of CreatePipelineCache.
+func (mr *Device1_3MockRecorder) CreatePipelineCache(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipelineCache", reflect.TypeOf((*Device1_3)(nil).CreatePipelineCache), allocationCallbacks, o)
+}
+
+// CreatePipelineLayout mocks base method.
+func (m *Device1_3) CreatePipelineLayout(allocationCallbacks *driver.AllocationCallbacks, o core1_0.PipelineLayoutCreateInfo) (core1_0.PipelineLayout, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePipelineLayout", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.PipelineLayout)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePipelineLayout indicates an expected call of CreatePipelineLayout.
+func (mr *Device1_3MockRecorder) CreatePipelineLayout(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipelineLayout", reflect.TypeOf((*Device1_3)(nil).CreatePipelineLayout), allocationCallbacks, o)
+}
+
+// CreatePrivateDataSlot mocks base method.
+func (m *Device1_3) CreatePrivateDataSlot(o core1_3.PrivateDataSlotCreateInfo, allocator *driver.AllocationCallbacks) (core1_3.PrivateDataSlot, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrivateDataSlot", o, allocator)
+	ret0, _ := ret[0].(core1_3.PrivateDataSlot)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePrivateDataSlot indicates an expected call of CreatePrivateDataSlot.
+func (mr *Device1_3MockRecorder) CreatePrivateDataSlot(o, allocator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateDataSlot", reflect.TypeOf((*Device1_3)(nil).CreatePrivateDataSlot), o, allocator)
+}
+
+// CreateQueryPool mocks base method.
+func (m *Device1_3) CreateQueryPool(allocationCallbacks *driver.AllocationCallbacks, o core1_0.QueryPoolCreateInfo) (core1_0.QueryPool, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQueryPool", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.QueryPool)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateQueryPool indicates an expected call of CreateQueryPool.
+func (mr *Device1_3MockRecorder) CreateQueryPool(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQueryPool", reflect.TypeOf((*Device1_3)(nil).CreateQueryPool), allocationCallbacks, o)
+}
+
+// CreateRenderPass mocks base method.
+func (m *Device1_3) CreateRenderPass(allocationCallbacks *driver.AllocationCallbacks, o core1_0.RenderPassCreateInfo) (core1_0.RenderPass, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRenderPass", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.RenderPass)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRenderPass indicates an expected call of CreateRenderPass.
+func (mr *Device1_3MockRecorder) CreateRenderPass(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRenderPass", reflect.TypeOf((*Device1_3)(nil).CreateRenderPass), allocationCallbacks, o)
+}
+
+// CreateRenderPass2 mocks base method.
+func (m *Device1_3) CreateRenderPass2(allocator *driver.AllocationCallbacks, options core1_2.RenderPassCreateInfo2) (core1_0.RenderPass, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRenderPass2", allocator, options)
+	ret0, _ := ret[0].(core1_0.RenderPass)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRenderPass2 indicates an expected call of CreateRenderPass2.
+func (mr *Device1_3MockRecorder) CreateRenderPass2(allocator, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRenderPass2", reflect.TypeOf((*Device1_3)(nil).CreateRenderPass2), allocator, options)
+}
+
+// CreateSampler mocks base method.
+func (m *Device1_3) CreateSampler(allocationCallbacks *driver.AllocationCallbacks, o core1_0.SamplerCreateInfo) (core1_0.Sampler, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSampler", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.Sampler)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSampler indicates an expected call of CreateSampler.
+func (mr *Device1_3MockRecorder) CreateSampler(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSampler", reflect.TypeOf((*Device1_3)(nil).CreateSampler), allocationCallbacks, o)
+}
+
+// CreateSamplerYcbcrConversion mocks base method.
+func (m *Device1_3) CreateSamplerYcbcrConversion(o core1_1.SamplerYcbcrConversionCreateInfo, allocator *driver.AllocationCallbacks) (core1_1.SamplerYcbcrConversion, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSamplerYcbcrConversion", o, allocator)
+	ret0, _ := ret[0].(core1_1.SamplerYcbcrConversion)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSamplerYcbcrConversion indicates an expected call of CreateSamplerYcbcrConversion.
+func (mr *Device1_3MockRecorder) CreateSamplerYcbcrConversion(o, allocator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSamplerYcbcrConversion", reflect.TypeOf((*Device1_3)(nil).CreateSamplerYcbcrConversion), o, allocator)
+}
+
+// CreateSemaphore mocks base method.
+func (m *Device1_3) CreateSemaphore(allocationCallbacks *driver.AllocationCallbacks, o core1_0.SemaphoreCreateInfo) (core1_0.Semaphore, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSemaphore", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.Semaphore)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSemaphore indicates an expected call of CreateSemaphore.
+func (mr *Device1_3MockRecorder) CreateSemaphore(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSemaphore", reflect.TypeOf((*Device1_3)(nil).CreateSemaphore), allocationCallbacks, o)
+}
+
+// CreateShaderModule mocks base method.
+func (m *Device1_3) CreateShaderModule(allocationCallbacks *driver.AllocationCallbacks, o core1_0.ShaderModuleCreateInfo) (core1_0.ShaderModule, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShaderModule", allocationCallbacks, o)
+	ret0, _ := ret[0].(core1_0.ShaderModule)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateShaderModule indicates an expected call of CreateShaderModule.
+func (mr *Device1_3MockRecorder) CreateShaderModule(allocationCallbacks, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShaderModule", reflect.TypeOf((*Device1_3)(nil).CreateShaderModule), allocationCallbacks, o)
+}
+
+// DescriptorSetLayoutSupport mocks base method.
+func (m *Device1_3) DescriptorSetLayoutSupport(o core1_0.DescriptorSetLayoutCreateInfo, outData *core1_1.DescriptorSetLayoutSupport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescriptorSetLayoutSupport", o, outData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescriptorSetLayoutSupport indicates an expected call of DescriptorSetLayoutSupport.
+func (mr *Device1_3MockRecorder) DescriptorSetLayoutSupport(o, outData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescriptorSetLayoutSupport", reflect.TypeOf((*Device1_3)(nil).DescriptorSetLayoutSupport), o, outData)
+}
+
+// Destroy mocks base method.
+func (m *Device1_3) Destroy(callbacks *driver.AllocationCallbacks) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Destroy", callbacks)
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *Device1_3MockRecorder) Destroy(callbacks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*Device1_3)(nil).Destroy), callbacks)
+}
+
+// DeviceBufferMemoryRequirements mocks base method.
+func (m *Device1_3) DeviceBufferMemoryRequirements(o core1_3.DeviceBufferMemoryRequirements, out *core1_1.MemoryRequirements2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeviceBufferMemoryRequirements", o, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeviceBufferMemoryRequirements indicates an expected call of DeviceBufferMemoryRequirements.
+func (mr *Device1_3MockRecorder) DeviceBufferMemoryRequirements(o, out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceBufferMemoryRequirements", reflect.TypeOf((*Device1_3)(nil).DeviceBufferMemoryRequirements), o, out)
+}
+
+// DeviceGroupPeerMemoryFeatures mocks base method.
+func (m *Device1_3) DeviceGroupPeerMemoryFeatures(heapIndex, localDeviceIndex, remoteDeviceIndex int) core1_1.PeerMemoryFeatureFlags {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeviceGroupPeerMemoryFeatures", heapIndex, localDeviceIndex, remoteDeviceIndex)
+	ret0, _ := ret[0].(core1_1.PeerMemoryFeatureFlags)
+	return ret0
+}
+
+// DeviceGroupPeerMemoryFeatures indicates an expected call of DeviceGroupPeerMemoryFeatures.
+func (mr *Device1_3MockRecorder) DeviceGroupPeerMemoryFeatures(heapIndex, localDeviceIndex, remoteDeviceIndex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceGroupPeerMemoryFeatures", reflect.TypeOf((*Device1_3)(nil).DeviceGroupPeerMemoryFeatures), heapIndex, localDeviceIndex, remoteDeviceIndex)
+}
+
+// DeviceImageMemoryRequirements mocks base method.
+func (m *Device1_3) DeviceImageMemoryRequirements(o core1_3.DeviceImageMemoryRequirements, out *core1_1.MemoryRequirements2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeviceImageMemoryRequirements", o, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeviceImageMemoryRequirements indicates an expected call of DeviceImageMemoryRequirements.
+func (mr *Device1_3MockRecorder) DeviceImageMemoryRequirements(o, out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceImageMemoryRequirements", reflect.TypeOf((*Device1_3)(nil).DeviceImageMemoryRequirements), o, out)
+}
+
+// DeviceImageSparseMemoryRequirements mocks base method.
+func (m *Device1_3) DeviceImageSparseMemoryRequirements(o core1_3.DeviceImageMemoryRequirements, outDataFactory func() *core1_1.SparseImageMemoryRequirements2) ([]*core1_1.SparseImageMemoryRequirements2, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeviceImageSparseMemoryRequirements", o, outDataFactory)
+	ret0, _ := ret[0].([]*core1_1.SparseImageMemoryRequirements2)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeviceImageSparseMemoryRequirements indicates an expected call of DeviceImageSparseMemoryRequirements.
+func (mr *Device1_3MockRecorder) DeviceImageSparseMemoryRequirements(o, outDataFactory interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceImageSparseMemoryRequirements", reflect.TypeOf((*Device1_3)(nil).DeviceImageSparseMemoryRequirements), o, outDataFactory)
+}
+
+// Driver mocks base method.
+func (m *Device1_3) Driver() driver.Driver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Driver")
+	ret0, _ := ret[0].(driver.Driver)
+	return ret0
+}
+
+// Driver indicates an expected call of Driver.
+func (mr *Device1_3MockRecorder) Driver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Driver", reflect.TypeOf((*Device1_3)(nil).Driver))
+}
+
+// FlushMappedMemoryRanges mocks base method.
+func (m *Device1_3) FlushMappedMemoryRanges(ranges []core1_0.MappedMemoryRange) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushMappedMemoryRanges", ranges)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlushMappedMemoryRanges indicates an expected call of FlushMappedMemoryRanges.
+func (mr *Device1_3MockRecorder) FlushMappedMemoryRanges(ranges interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushMappedMemoryRanges", reflect.TypeOf((*Device1_3)(nil).FlushMappedMemoryRanges), ranges)
+}
+
+// FreeCommandBuffers mocks base method.
+func (m *Device1_3) FreeCommandBuffers(buffers []core1_0.CommandBuffer) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FreeCommandBuffers", buffers)
+}
+
+// FreeCommandBuffers indicates an expected call of FreeCommandBuffers.
+func (mr *Device1_3MockRecorder) FreeCommandBuffers(buffers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreeCommandBuffers", reflect.TypeOf((*Device1_3)(nil).FreeCommandBuffers), buffers)
+}
+
+// FreeDescriptorSets mocks base method.
+func (m *Device1_3) FreeDescriptorSets(sets []core1_0.DescriptorSet) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreeDescriptorSets", sets)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreeDescriptorSets indicates an expected call of FreeDescriptorSets.
+func (mr *Device1_3MockRecorder) FreeDescriptorSets(sets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreeDescriptorSets", reflect.TypeOf((*Device1_3)(nil).FreeDescriptorSets), sets)
+}
+
+// FreeMemory mocks base method.
+func (m *Device1_3) FreeMemory(deviceMemory core1_0.DeviceMemory, allocationCallbacks *driver.AllocationCallbacks) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FreeMemory", deviceMemory, allocationCallbacks)
+}
+
+// FreeMemory indicates an expected call of FreeMemory.
+func (mr *Device1_3MockRecorder) FreeMemory(deviceMemory, allocationCallbacks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreeMemory", reflect.TypeOf((*Device1_3)(nil).FreeMemory), deviceMemory, allocationCallbacks)
+}
+
+// GetBufferDeviceAddress mocks base method.
+func (m *Device1_3) GetBufferDeviceAddress(o core1_2.BufferDeviceAddressInfo) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBufferDeviceAddress", o)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBufferDeviceAddress indicates an expected call of GetBufferDeviceAddress.
+func (mr *Device1_3MockRecorder) GetBufferDeviceAddress(o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBufferDeviceAddress", reflect.TypeOf((*Device1_3)(nil).GetBufferDeviceAddress), o)
+}
+
+// GetBufferOpaqueCaptureAddress mocks base method.
+func (m *Device1_3) GetBufferOpaqueCaptureAddress(o core1_2.BufferDeviceAddressInfo) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBufferOpaqueCaptureAddress", o)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBufferOpaqueCaptureAddress indicates an expected call of GetBufferOpaqueCaptureAddress.
+func (mr *Device1_3MockRecorder) GetBufferOpaqueCaptureAddress(o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBufferOpaqueCaptureAddress", reflect.TypeOf((*Device1_3)(nil).GetBufferOpaqueCaptureAddress), o)
+}
+
+// GetDeviceMemoryOpaqueCaptureAddress mocks base method.
+func (m *Device1_3) GetDeviceMemoryOpaqueCaptureAddress(o core1_2.DeviceMemoryOpaqueCaptureAddressInfo) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceMemoryOpaqueCaptureAddress", o)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceMemoryOpaqueCaptureAddress indicates an expected call of GetDeviceMemoryOpaqueCaptureAddress.
+func (mr *Device1_3MockRecorder) GetDeviceMemoryOpaqueCaptureAddress(o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceMemoryOpaqueCaptureAddress", reflect.TypeOf((*Device1_3)(nil).GetDeviceMemoryOpaqueCaptureAddress), o)
+}
+
+// GetPrivateData mocks base method.
+func (m *Device1_3) GetPrivateData(objectType core1_0.ObjectType, objectHandle driver.VulkanHandle, privateDataSlot core1_3.PrivateDataSlot) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivateData", objectType, objectHandle, privateDataSlot)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetPrivateData indicates an expected call of GetPrivateData.
+func (mr *Device1_3MockRecorder) GetPrivateData(objectType, objectHandle, privateDataSlot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateData", reflect.TypeOf((*Device1_3)(nil).GetPrivateData), objectType, objectHandle, privateDataSlot)
+}
+
+// GetQueue mocks base method.
+func (m *Device1_3) GetQueue(queueFamilyIndex, queueIndex int) core1_0.Queue {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueue", queueFamilyIndex, queueIndex)
+	ret0, _ := ret[0].(core1_0.Queue)
+	return ret0
+}
+
+// GetQueue indicates an expected call of GetQueue.
+func (mr *Device1_3MockRecorder) GetQueue(queueFamilyIndex, queueIndex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue", reflect.TypeOf((*Device1_3)(nil).GetQueue), queueFamilyIndex, queueIndex)
+}
+
+// GetQueue2 mocks base method.
+func (m *Device1_3) GetQueue2(o core1_1.DeviceQueueInfo2) (core1_0.Queue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueue2", o)
+	ret0, _ := ret[0].(core1_0.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueue2 indicates an expected call of GetQueue2.
+func (mr *Device1_3MockRecorder) GetQueue2(o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue2", reflect.TypeOf((*Device1_3)(nil).GetQueue2), o)
+}
+
+// Handle mocks base method.
+func (m *Device1_3) Handle() driver.VkDevice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(driver.VkDevice)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *Device1_3MockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*Device1_3)(nil).Handle))
+}
+
+// ImageMemoryRequirements2 mocks base method.
+func (m *Device1_3) ImageMemoryRequirements2(o core1_1.ImageMemoryRequirementsInfo2, out *core1_1.MemoryRequirements2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageMemoryRequirements2", o, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImageMemoryRequirements2 indicates an expected call of ImageMemoryRequirements2.
+func (mr *Device1_3MockRecorder) ImageMemoryRequirements2(o, out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageMemoryRequirements2", reflect.TypeOf((*Device1_3)(nil).ImageMemoryRequirements2), o, out)
+}
+
+// ImageSparseMemoryRequirements2 mocks base method.
+func (m *Device1_3) ImageSparseMemoryRequirements2(o core1_1.ImageSparseMemoryRequirementsInfo2, outDataFactory func() *core1_1.SparseImageMemoryRequirements2) ([]*core1_1.SparseImageMemoryRequirements2, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageSparseMemoryRequirements2", o, outDataFactory)
+	ret0, _ := ret[0].([]*core1_1.SparseImageMemoryRequirements2)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageSparseMemoryRequirements2 indicates an expected call of ImageSparseMemoryRequirements2.
+func (mr *Device1_3MockRecorder) ImageSparseMemoryRequirements2(o, outDataFactory interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageSparseMemoryRequirements2", reflect.TypeOf((*Device1_3)(nil).ImageSparseMemoryRequirements2), o, outDataFactory)
+}
+
+// InvalidateMappedMemoryRanges mocks base method.
+func (m *Device1_3) InvalidateMappedMemoryRanges(ranges []core1_0.MappedMemoryRange) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateMappedMemoryRanges", ranges)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvalidateMappedMemoryRanges indicates an expected call of InvalidateMappedMemoryRanges.
+func (mr *Device1_3MockRecorder) InvalidateMappedMemoryRanges(ranges interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateMappedMemoryRanges", reflect.TypeOf((*Device1_3)(nil).InvalidateMappedMemoryRanges), ranges)
+}
+
+// IsDeviceExtensionActive mocks base method.
+func (m *Device1_3) IsDeviceExtensionActive(extensionName string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDeviceExtensionActive", extensionName)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDeviceExtensionActive indicates an expected call of IsDeviceExtensionActive.
+func (mr *Device1_3MockRecorder) IsDeviceExtensionActive(extensionName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDeviceExtensionActive", reflect.TypeOf((*Device1_3)(nil).IsDeviceExtensionActive), extensionName)
+}
+
+// ResetFences mocks base method.
+func (m *Device1_3) ResetFences(fences []core1_0.Fence) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetFences", fences)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetFences indicates an expected call of ResetFences.
+func (mr *Device1_3MockRecorder) ResetFences(fences interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetFences", reflect.TypeOf((*Device1_3)(nil).ResetFences), fences)
+}
+
+// SetPrivateData mocks base method.
+func (m *Device1_3) SetPrivateData(objectType core1_0.ObjectType, objectHandle driver.VulkanHandle, privateDataSlot core1_3.PrivateDataSlot, data uint64) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrivateData", objectType, objectHandle, privateDataSlot, data)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPrivateData indicates an expected call of SetPrivateData.
+func (mr *Device1_3MockRecorder) SetPrivateData(objectType, objectHandle, privateDataSlot, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrivateData", reflect.TypeOf((*Device1_3)(nil).SetPrivateData), objectType, objectHandle, privateDataSlot, data)
+}
+
+// SignalSemaphore mocks base method.
+func (m *Device1_3) SignalSemaphore(o core1_2.SemaphoreSignalInfo) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalSemaphore", o)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignalSemaphore indicates an expected call of SignalSemaphore.
+func (mr *Device1_3MockRecorder) SignalSemaphore(o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalSemaphore", reflect.TypeOf((*Device1_3)(nil).SignalSemaphore), o)
+}
+
+// UpdateDescriptorSets mocks base method.
+func (m *Device1_3) UpdateDescriptorSets(writes []core1_0.WriteDescriptorSet, copies []core1_0.CopyDescriptorSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDescriptorSets", writes, copies)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDescriptorSets indicates an expected call of UpdateDescriptorSets.
+func (mr *Device1_3MockRecorder) UpdateDescriptorSets(writes, copies interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDescriptorSets", reflect.TypeOf((*Device1_3)(nil).UpdateDescriptorSets), writes, copies)
+}
+
+// WaitForFences mocks base method.
+func (m *Device1_3) WaitForFences(waitForAll bool, timeout time.Duration, fences []core1_0.Fence) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForFences", waitForAll, timeout, fences)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForFences indicates an expected call of WaitForFences.
+func (mr *Device1_3MockRecorder) WaitForFences(waitForAll, timeout, fences interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForFences", reflect.TypeOf((*Device1_3)(nil).WaitForFences), waitForAll, timeout, fences)
+}
+
+// WaitIdle mocks base method.
+func (m *Device1_3) WaitIdle() (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitIdle")
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitIdle indicates an expected call of WaitIdle.
+func (mr *Device1_3MockRecorder) WaitIdle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitIdle", reflect.TypeOf((*Device1_3)(nil).WaitIdle))
+}
+
+// WaitSemaphores mocks base method.
+func (m *Device1_3) WaitSemaphores(timeout time.Duration, o core1_2.SemaphoreWaitInfo) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitSemaphores", timeout, o)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitSemaphores indicates an expected call of WaitSemaphores.
+func (mr *Device1_3MockRecorder) WaitSemaphores(timeout, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitSemaphores", reflect.TypeOf((*Device1_3)(nil).WaitSemaphores), timeout, o)
+}
+
+// PrivateDataSlot1_3 is a mock of PrivateDataSlot interface.
+type PrivateDataSlot1_3 struct {
+	ctrl     *gomock.Controller
+	recorder *PrivateDataSlot1_3MockRecorder
+}
+
+// PrivateDataSlot1_3MockRecorder is the mock recorder for PrivateDataSlot1_3.
+type PrivateDataSlot1_3MockRecorder struct {
+	mock *PrivateDataSlot1_3
+}
+
+// NewPrivateDataSlot1_3 creates a new mock instance.
+func NewPrivateDataSlot1_3(ctrl *gomock.Controller) *PrivateDataSlot1_3 {
+	mock := &PrivateDataSlot1_3{ctrl: ctrl}
+	mock.recorder = &PrivateDataSlot1_3MockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PrivateDataSlot1_3) EXPECT() *PrivateDataSlot1_3MockRecorder {
+	return m.recorder
+}
+
+// APIVersion mocks base method.
+func (m *PrivateDataSlot1_3) APIVersion() common.APIVersion {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIVersion")
+	ret0, _ := ret[0].(common.APIVersion)
+	return ret0
+}
+
+// APIVersion indicates an expected call of APIVersion.
+func (mr *PrivateDataSlot1_3MockRecorder) APIVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIVersion", reflect.TypeOf((*PrivateDataSlot1_3)(nil).APIVersion))
+}
+
+// Destroy mocks base method.
+func (m *PrivateDataSlot1_3) Destroy(allocator *driver.AllocationCallbacks) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Destroy", allocator)
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *PrivateDataSlot1_3MockRecorder) Destroy(allocator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*PrivateDataSlot1_3)(nil).Destroy), allocator)
+}
+
+// DeviceHandle mocks base method.
+func (m *PrivateDataSlot1_3) DeviceHandle() driver.VkDevice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeviceHandle")
+	ret0, _ := ret[0].(driver.VkDevice)
+	return ret0
+}
+
+// DeviceHandle indicates an expected call of DeviceHandle.
+func (mr *PrivateDataSlot1_3MockRecorder) DeviceHandle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceHandle", reflect.TypeOf((*PrivateDataSlot1_3)(nil).DeviceHandle))
+}
+
+// Driver mocks base method.
+func (m *PrivateDataSlot1_3) Driver() driver.Driver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Driver")
+	ret0, _ := ret[0].(driver.Driver)
+	return ret0
+}
+
+// Driver indicates an expected call of Driver.
+func (mr *PrivateDataSlot1_3MockRecorder) Driver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Driver", reflect.TypeOf((*PrivateDataSlot1_3)(nil).Driver))
+}
+
+// Handle mocks base method.
+func (m *PrivateDataSlot1_3) Handle() driver.VkPrivateDataSlot {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(driver.VkPrivateDataSlot)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *PrivateDataSlot1_3MockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*PrivateDataSlot1_3)(nil).Handle))
+}
+
+// Instance1_3 is a mock of Instance interface.
+type Instance1_3 struct {
+	ctrl     *gomock.Controller
+	recorder *Instance1_3MockRecorder
+}
+
+// Instance1_3MockRecorder is the mock recorder for Instance1_3.
+type Instance1_3MockRecorder struct {
+	mock *Instance1_3
+}
+
+// NewInstance1_3 creates a new mock instance.
+func NewInstance1_3(ctrl *gomock.Controller) *Instance1_3 {
+	mock := &Instance1_3{ctrl: ctrl}
+	mock.recorder = &Instance1_3MockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Instance1_3) EXPECT() *Instance1_3MockRecorder {
+	return m.recorder
+}
+
+// APIVersion mocks base method.
+func (m *Instance1_3) APIVersion() common.APIVersion {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIVersion")
+	ret0, _ := ret[0].(common.APIVersion)
+	return ret0
+}
+
+// APIVersion indicates an expected call of APIVersion.
+func (mr *Instance1_3MockRecorder) APIVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIVersion", reflect.TypeOf((*Instance1_3)(nil).APIVersion))
+}
+
+// Destroy mocks base method.
+func (m *Instance1_3) Destroy(callbacks *driver.AllocationCallbacks) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Destroy", callbacks)
+}
+
+// Destroy indicates an expected call of Destroy.
+func (mr *Instance1_3MockRecorder) Destroy(callbacks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*Instance1_3)(nil).Destroy), callbacks)
+}
+
+// Driver mocks base method.
+func (m *Instance1_3) Driver() driver.Driver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Driver")
+	ret0, _ := ret[0].(driver.Driver)
+	return ret0
+}
+
+// Driver indicates an expected call of Driver.
+func (mr *Instance1_3MockRecorder) Driver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Driver", reflect.TypeOf((*Instance1_3)(nil).Driver))
+}
+
+// EnumeratePhysicalDeviceGroups mocks base method.
+func (m *Instance1_3) EnumeratePhysicalDeviceGroups(outDataFactory func() *core1_1.PhysicalDeviceGroupProperties) ([]*core1_1.PhysicalDeviceGroupProperties, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnumeratePhysicalDeviceGroups", outDataFactory)
+	ret0, _ := ret[0].([]*core1_1.PhysicalDeviceGroupProperties)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnumeratePhysicalDeviceGroups indicates an expected call of EnumeratePhysicalDeviceGroups.
+func (mr *Instance1_3MockRecorder) EnumeratePhysicalDeviceGroups(outDataFactory interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnumeratePhysicalDeviceGroups", reflect.TypeOf((*Instance1_3)(nil).EnumeratePhysicalDeviceGroups), outDataFactory)
+}
+
+// EnumeratePhysicalDevices mocks base method.
+func (m *Instance1_3) EnumeratePhysicalDevices() ([]core1_0.PhysicalDevice, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnumeratePhysicalDevices")
+	ret0, _ := ret[0].([]core1_0.PhysicalDevice)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnumeratePhysicalDevices indicates an expected call of EnumeratePhysicalDevices.
+func (mr *Instance1_3MockRecorder) EnumeratePhysicalDevices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnumeratePhysicalDevices", reflect.TypeOf((*Instance1_3)(nil).EnumeratePhysicalDevices))
+}
+
+// Handle mocks base method.
+func (m *Instance1_3) Handle() driver.VkInstance {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(driver.VkInstance)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *Instance1_3MockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*Instance1_3)(nil).Handle))
+}
+
+// IsInstanceExtensionActive mocks base method.
+func (m *Instance1_3) IsInstanceExtensionActive(extensionName string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsInstanceExtensionActive", extensionName)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsInstanceExtensionActive indicates an expected call of IsInstanceExtensionActive.
+func (mr *Instance1_3MockRecorder) IsInstanceExtensionActive(extensionName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInstanceExtensionActive", reflect.TypeOf((*Instance1_3)(nil).IsInstanceExtensionActive), extensionName)
+}
+
+// InstanceScopedPhysicalDevice1_3 is a mock of InstanceScopedPhysicalDevice interface.
+type InstanceScopedPhysicalDevice1_3 struct {
+	ctrl     *gomock.Controller
+	recorder *InstanceScopedPhysicalDevice1_3MockRecorder
+}
+
+// InstanceScopedPhysicalDevice1_3MockRecorder is the mock recorder for InstanceScopedPhysicalDevice1_3.
+type InstanceScopedPhysicalDevice1_3MockRecorder struct {
+	mock *InstanceScopedPhysicalDevice1_3
+}
+
+// NewInstanceScopedPhysicalDevice1_3 creates a new mock instance.
+func NewInstanceScopedPhysicalDevice1_3(ctrl *gomock.Controller) *InstanceScopedPhysicalDevice1_3 {
+	mock := &InstanceScopedPhysicalDevice1_3{ctrl: ctrl}
+	mock.recorder = &InstanceScopedPhysicalDevice1_3MockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *InstanceScopedPhysicalDevice1_3) EXPECT() *InstanceScopedPhysicalDevice1_3MockRecorder {
+	return m.recorder
+}
+
+// CreateDevice mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) CreateDevice(allocationCallbacks *driver.AllocationCallbacks, options core1_0.DeviceCreateInfo) (core1_0.Device, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDevice", allocationCallbacks, options)
+	ret0, _ := ret[0].(core1_0.Device)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateDevice indicates an expected call of CreateDevice.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) CreateDevice(allocationCallbacks, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevice", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).CreateDevice), allocationCallbacks, options)
+}
+
+// DeviceAPIVersion mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) DeviceAPIVersion() common.APIVersion {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeviceAPIVersion")
+	ret0, _ := ret[0].(common.APIVersion)
+	return ret0
+}
+
+// DeviceAPIVersion indicates an expected call of DeviceAPIVersion.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) DeviceAPIVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceAPIVersion", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).DeviceAPIVersion))
+}
+
+// Driver mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) Driver() driver.Driver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Driver")
+	ret0, _ := ret[0].(driver.Driver)
+	return ret0
+}
+
+// Driver indicates an expected call of Driver.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) Driver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Driver", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).Driver))
+}
+
+// EnumerateDeviceExtensionProperties mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) EnumerateDeviceExtensionProperties() (map[string]*core1_0.ExtensionProperties, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnumerateDeviceExtensionProperties")
+	ret0, _ := ret[0].(map[string]*core1_0.ExtensionProperties)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnumerateDeviceExtensionProperties indicates an expected call of EnumerateDeviceExtensionProperties.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) EnumerateDeviceExtensionProperties() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnumerateDeviceExtensionProperties", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).EnumerateDeviceExtensionProperties))
+}
+
+// EnumerateDeviceExtensionPropertiesForLayer mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) EnumerateDeviceExtensionPropertiesForLayer(layerName string) (map[string]*core1_0.ExtensionProperties, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnumerateDeviceExtensionPropertiesForLayer", layerName)
+	ret0, _ := ret[0].(map[string]*core1_0.ExtensionProperties)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnumerateDeviceExtensionPropertiesForLayer indicates an expected call of EnumerateDeviceExtensionPropertiesForLayer.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) EnumerateDeviceExtensionPropertiesForLayer(layerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnumerateDeviceExtensionPropertiesForLayer", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).EnumerateDeviceExtensionPropertiesForLayer), layerName)
+}
+
+// EnumerateDeviceLayerProperties mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) EnumerateDeviceLayerProperties() (map[string]*core1_0.LayerProperties, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnumerateDeviceLayerProperties")
+	ret0, _ := ret[0].(map[string]*core1_0.LayerProperties)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnumerateDeviceLayerProperties indicates an expected call of EnumerateDeviceLayerProperties.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) EnumerateDeviceLayerProperties() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnumerateDeviceLayerProperties", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).EnumerateDeviceLayerProperties))
+}
+
+// ExternalBufferProperties mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) ExternalBufferProperties(o core1_1.PhysicalDeviceExternalBufferInfo, outData *core1_1.ExternalBufferProperties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalBufferProperties", o, outData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExternalBufferProperties indicates an expected call of ExternalBufferProperties.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) ExternalBufferProperties(o, outData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalBufferProperties", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).ExternalBufferProperties), o, outData)
+}
+
+// ExternalFenceProperties mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) ExternalFenceProperties(o core1_1.PhysicalDeviceExternalFenceInfo, outData *core1_1.ExternalFenceProperties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalFenceProperties", o, outData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExternalFenceProperties indicates an expected call of ExternalFenceProperties.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) ExternalFenceProperties(o, outData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalFenceProperties", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).ExternalFenceProperties), o, outData)
+}
+
+// ExternalSemaphoreProperties mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) ExternalSemaphoreProperties(o core1_1.PhysicalDeviceExternalSemaphoreInfo, outData *core1_1.ExternalSemaphoreProperties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalSemaphoreProperties", o, outData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExternalSemaphoreProperties indicates an expected call of ExternalSemaphoreProperties.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) ExternalSemaphoreProperties(o, outData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalSemaphoreProperties", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).ExternalSemaphoreProperties), o, outData)
+}
+
+// Features mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) Features() *core1_0.PhysicalDeviceFeatures {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Features")
+	ret0, _ := ret[0].(*core1_0.PhysicalDeviceFeatures)
+	return ret0
+}
+
+// Features indicates an expected call of Features.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) Features() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Features", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).Features))
+}
+
+// Features2 mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) Features2(out *core1_1.PhysicalDeviceFeatures2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Features2", out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Features2 indicates an expected call of Features2.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) Features2(out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Features2", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).Features2), out)
+}
+
+// FormatProperties mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) FormatProperties(format core1_0.Format) *core1_0.FormatProperties {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FormatProperties", format)
+	ret0, _ := ret[0].(*core1_0.FormatProperties)
+	return ret0
+}
+
+// FormatProperties indicates an expected call of FormatProperties.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) FormatProperties(format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormatProperties", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).FormatProperties), format)
+}
+
+// FormatProperties2 mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) FormatProperties2(format core1_0.Format, out *core1_1.FormatProperties2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FormatProperties2", format, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FormatProperties2 indicates an expected call of FormatProperties2.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) FormatProperties2(format, out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormatProperties2", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).FormatProperties2), format, out)
+}
+
+// Handle mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) Handle() driver.VkPhysicalDevice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(driver.VkPhysicalDevice)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).Handle))
+}
+
+// ImageFormatProperties mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) ImageFormatProperties(format core1_0.Format, imageType core1_0.ImageType, tiling core1_0.ImageTiling, usages core1_0.ImageUsageFlags, flags core1_0.ImageCreateFlags) (*core1_0.ImageFormatProperties, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageFormatProperties", format, imageType, tiling, usages, flags)
+	ret0, _ := ret[0].(*core1_0.ImageFormatProperties)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ImageFormatProperties indicates an expected call of ImageFormatProperties.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) ImageFormatProperties(format, imageType, tiling, usages, flags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageFormatProperties", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).ImageFormatProperties), format, imageType, tiling, usages, flags)
+}
+
+// ImageFormatProperties2 mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) ImageFormatProperties2(o core1_1.PhysicalDeviceImageFormatInfo2, out *core1_1.ImageFormatProperties2) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageFormatProperties2", o, out)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageFormatProperties2 indicates an expected call of ImageFormatProperties2.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) ImageFormatProperties2(o, out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageFormatProperties2", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).ImageFormatProperties2), o, out)
+}
+
+// InstanceAPIVersion mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) InstanceAPIVersion() common.APIVersion {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceAPIVersion")
+	ret0, _ := ret[0].(common.APIVersion)
+	return ret0
+}
+
+// InstanceAPIVersion indicates an expected call of InstanceAPIVersion.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) InstanceAPIVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceAPIVersion", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).InstanceAPIVersion))
+}
+
+// MemoryProperties mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) MemoryProperties() *core1_0.PhysicalDeviceMemoryProperties {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemoryProperties")
+	ret0, _ := ret[0].(*core1_0.PhysicalDeviceMemoryProperties)
+	return ret0
+}
+
+// MemoryProperties indicates an expected call of MemoryProperties.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) MemoryProperties() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemoryProperties", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).MemoryProperties))
+}
+
+// MemoryProperties2 mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) MemoryProperties2(out *core1_1.PhysicalDeviceMemoryProperties2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemoryProperties2", out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MemoryProperties2 indicates an expected call of MemoryProperties2.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) MemoryProperties2(out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemoryProperties2", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).MemoryProperties2), out)
+}
+
+// Properties mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) Properties() (*core1_0.PhysicalDeviceProperties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Properties")
+	ret0, _ := ret[0].(*core1_0.PhysicalDeviceProperties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Properties indicates an expected call of Properties.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) Properties() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Properties", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).Properties))
+}
+
+// Properties2 mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) Properties2(out *core1_1.PhysicalDeviceProperties2) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Properties2", out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Properties2 indicates an expected call of Properties2.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) Properties2(out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Properties2", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).Properties2), out)
+}
+
+// QueueFamilyProperties mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) QueueFamilyProperties() []*core1_0.QueueFamilyProperties {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueFamilyProperties")
+	ret0, _ := ret[0].([]*core1_0.QueueFamilyProperties)
+	return ret0
+}
+
+// QueueFamilyProperties indicates an expected call of QueueFamilyProperties.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) QueueFamilyProperties() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueFamilyProperties", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).QueueFamilyProperties))
+}
+
+// QueueFamilyProperties2 mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) QueueFamilyProperties2(outDataFactory func() *core1_1.QueueFamilyProperties2) ([]*core1_1.QueueFamilyProperties2, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueFamilyProperties2", outDataFactory)
+	ret0, _ := ret[0].([]*core1_1.QueueFamilyProperties2)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueFamilyProperties2 indicates an expected call of QueueFamilyProperties2.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) QueueFamilyProperties2(outDataFactory interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueFamilyProperties2", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).QueueFamilyProperties2), outDataFactory)
+}
+
+// SparseImageFormatProperties mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) SparseImageFormatProperties(format core1_0.Format, imageType core1_0.ImageType, samples core1_0.SampleCountFlags, usages core1_0.ImageUsageFlags, tiling core1_0.ImageTiling) []core1_0.SparseImageFormatProperties {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SparseImageFormatProperties", format, imageType, samples, usages, tiling)
+	ret0, _ := ret[0].([]core1_0.SparseImageFormatProperties)
+	return ret0
+}
+
+// SparseImageFormatProperties indicates an expected call of SparseImageFormatProperties.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) SparseImageFormatProperties(format, imageType, samples, usages, tiling interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SparseImageFormatProperties", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).SparseImageFormatProperties), format, imageType, samples, usages, tiling)
+}
+
+// SparseImageFormatProperties2 mocks base method.
+func (m *InstanceScopedPhysicalDevice1_3) SparseImageFormatProperties2(o core1_1.PhysicalDeviceSparseImageFormatInfo2, outDataFactory func() *core1_1.SparseImageFormatProperties2) ([]*core1_1.SparseImageFormatProperties2, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SparseImageFormatProperties2", o, outDataFactory)
+	ret0, _ := ret[0].([]*core1_1.SparseImageFormatProperties2)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SparseImageFormatProperties2 indicates an expected call of SparseImageFormatProperties2.
+func (mr *InstanceScopedPhysicalDevice1_3MockRecorder) SparseImageFormatProperties2(o, outDataFactory interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SparseImageFormatProperties2", reflect.TypeOf((*InstanceScopedPhysicalDevice1_3)(nil).SparseImageFormatProperties2), o, outDataFactory)
+}
+
+// PhysicalDevice1_3 is a mock of PhysicalDevice interface.
+type PhysicalDevice1_3 struct {
+	ctrl     *gomock.Controller
+	recorder *PhysicalDevice1_3MockRecorder
+}
+
+// PhysicalDevice1_3MockRecorder is the mock recorder for PhysicalDevice1_3.
+type PhysicalDevice1_3MockRecorder struct {
+	mock *PhysicalDevice1_3
+}
+
+// NewPhysicalDevice1_3 creates a new mock instance.
+func NewPhysicalDevice1_3(ctrl *gomock.Controller) *PhysicalDevice1_3 {
+	mock := &PhysicalDevice1_3{ctrl: ctrl}
+	mock.recorder = &PhysicalDevice1_3MockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PhysicalDevice1_3) EXPECT() *PhysicalDevice1_3MockRecorder {
+	return m.recorder
+}
+
+// CreateDevice mocks base method.
+func (m *PhysicalDevice1_3) CreateDevice(allocationCallbacks *driver.AllocationCallbacks, options core1_0.DeviceCreateInfo) (core1_0.Device, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDevice", allocationCallbacks, options)
+	ret0, _ := ret[0].(core1_0.Device)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateDevice indicates an expected call of CreateDevice.
+func (mr *PhysicalDevice1_3MockRecorder) CreateDevice(allocationCallbacks, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevice", reflect.TypeOf((*PhysicalDevice1_3)(nil).CreateDevice), allocationCallbacks, options)
+}
+
+// DeviceAPIVersion mocks base method.
+func (m *PhysicalDevice1_3) DeviceAPIVersion() common.APIVersion {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeviceAPIVersion")
+	ret0, _ := ret[0].(common.APIVersion)
+	return ret0
+}
+
+// DeviceAPIVersion indicates an expected call of DeviceAPIVersion.
+func (mr *PhysicalDevice1_3MockRecorder) DeviceAPIVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceAPIVersion", reflect.TypeOf((*PhysicalDevice1_3)(nil).DeviceAPIVersion))
+}
+
+// Driver mocks base method.
+func (m *PhysicalDevice1_3) Driver() driver.Driver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Driver")
+	ret0, _ := ret[0].(driver.Driver)
+	return ret0
+}
+
+// Driver indicates an expected call of Driver.
+func (mr *PhysicalDevice1_3MockRecorder) Driver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Driver", reflect.TypeOf((*PhysicalDevice1_3)(nil).Driver))
+}
+
+// EnumerateDeviceExtensionProperties mocks base method.
+func (m *PhysicalDevice1_3) EnumerateDeviceExtensionProperties() (map[string]*core1_0.ExtensionProperties, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnumerateDeviceExtensionProperties")
+	ret0, _ := ret[0].(map[string]*core1_0.ExtensionProperties)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnumerateDeviceExtensionProperties indicates an expected call of EnumerateDeviceExtensionProperties.
+func (mr *PhysicalDevice1_3MockRecorder) EnumerateDeviceExtensionProperties() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnumerateDeviceExtensionProperties", reflect.TypeOf((*PhysicalDevice1_3)(nil).EnumerateDeviceExtensionProperties))
+}
+
+// EnumerateDeviceExtensionPropertiesForLayer mocks base method.
+func (m *PhysicalDevice1_3) EnumerateDeviceExtensionPropertiesForLayer(layerName string) (map[string]*core1_0.ExtensionProperties, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnumerateDeviceExtensionPropertiesForLayer", layerName)
+	ret0, _ := ret[0].(map[string]*core1_0.ExtensionProperties)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnumerateDeviceExtensionPropertiesForLayer indicates an expected call of EnumerateDeviceExtensionPropertiesForLayer.
+func (mr *PhysicalDevice1_3MockRecorder) EnumerateDeviceExtensionPropertiesForLayer(layerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnumerateDeviceExtensionPropertiesForLayer", reflect.TypeOf((*PhysicalDevice1_3)(nil).EnumerateDeviceExtensionPropertiesForLayer), layerName)
+}
+
+// EnumerateDeviceLayerProperties mocks base method.
+func (m *PhysicalDevice1_3) EnumerateDeviceLayerProperties() (map[string]*core1_0.LayerProperties, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnumerateDeviceLayerProperties")
+	ret0, _ := ret[0].(map[string]*core1_0.LayerProperties)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnumerateDeviceLayerProperties indicates an expected call of EnumerateDeviceLayerProperties.
+func (mr *PhysicalDevice1_3MockRecorder) EnumerateDeviceLayerProperties() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnumerateDeviceLayerProperties", reflect.TypeOf((*PhysicalDevice1_3)(nil).EnumerateDeviceLayerProperties))
+}
+
+// Features mocks base method.
+func (m *PhysicalDevice1_3) Features() *core1_0.PhysicalDeviceFeatures {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Features")
+	ret0, _ := ret[0].(*core1_0.PhysicalDeviceFeatures)
+	return ret0
+}
+
+// Features indicates an expected call of Features.
+func (mr *PhysicalDevice1_3MockRecorder) Features() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Features", reflect.TypeOf((*PhysicalDevice1_3)(nil).Features))
+}
+
+// FormatProperties mocks base method.
+func (m *PhysicalDevice1_3) FormatProperties(format core1_0.Format) *core1_0.FormatProperties {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FormatProperties", format)
+	ret0, _ := ret[0].(*core1_0.FormatProperties)
+	return ret0
+}
+
+// FormatProperties indicates an expected call of FormatProperties.
+func (mr *PhysicalDevice1_3MockRecorder) FormatProperties(format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormatProperties", reflect.TypeOf((*PhysicalDevice1_3)(nil).FormatProperties), format)
+}
+
+// Handle mocks base method.
+func (m *PhysicalDevice1_3) Handle() driver.VkPhysicalDevice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(driver.VkPhysicalDevice)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *PhysicalDevice1_3MockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*PhysicalDevice1_3)(nil).Handle))
+}
+
+// ImageFormatProperties mocks base method.
+func (m *PhysicalDevice1_3) ImageFormatProperties(format core1_0.Format, imageType core1_0.ImageType, tiling core1_0.ImageTiling, usages core1_0.ImageUsageFlags, flags core1_0.ImageCreateFlags) (*core1_0.ImageFormatProperties, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageFormatProperties", format, imageType, tiling, usages, flags)
+	ret0, _ := ret[0].(*core1_0.ImageFormatProperties)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ImageFormatProperties indicates an expected call of ImageFormatProperties.
+func (mr *PhysicalDevice1_3MockRecorder) ImageFormatProperties(format, imageType, tiling, usages, flags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageFormatProperties", reflect.TypeOf((*PhysicalDevice1_3)(nil).ImageFormatProperties), format, imageType, tiling, usages, flags)
+}
+
+// InstanceAPIVersion mocks base method.
+func (m *PhysicalDevice1_3) InstanceAPIVersion() common.APIVersion {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceAPIVersion")
+	ret0, _ := ret[0].(common.APIVersion)
+	return ret0
+}
+
+// InstanceAPIVersion indicates an expected call of InstanceAPIVersion.
+func (mr *PhysicalDevice1_3MockRecorder) InstanceAPIVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceAPIVersion", reflect.TypeOf((*PhysicalDevice1_3)(nil).InstanceAPIVersion))
+}
+
+// InstanceScopedPhysicalDevice1_1 mocks base method.
+func (m *PhysicalDevice1_3) InstanceScopedPhysicalDevice1_1() core1_1.InstanceScopedPhysicalDevice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceScopedPhysicalDevice1_1")
+	ret0, _ := ret[0].(core1_1.InstanceScopedPhysicalDevice)
+	return ret0
+}
+
+// InstanceScopedPhysicalDevice1_1 indicates an expected call of InstanceScopedPhysicalDevice1_1.
+func (mr *PhysicalDevice1_3MockRecorder) InstanceScopedPhysicalDevice1_1() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceScopedPhysicalDevice1_1", reflect.TypeOf((*PhysicalDevice1_3)(nil).InstanceScopedPhysicalDevice1_1))
+}
+
+// InstanceScopedPhysicalDevice1_2 mocks base method.
+func (m *PhysicalDevice1_3) InstanceScopedPhysicalDevice1_2() core1_2.InstanceScopedPhysicalDevice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceScopedPhysicalDevice1_2")
+	ret0, _ := ret[0].(core1_2.InstanceScopedPhysicalDevice)
+	return ret0
+}
+
+// InstanceScopedPhysicalDevice1_2 indicates an expected call of InstanceScopedPhysicalDevice1_2.
+func (mr *PhysicalDevice1_3MockRecorder) InstanceScopedPhysicalDevice1_2() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceScopedPhysicalDevice1_2", reflect.TypeOf((*PhysicalDevice1_3)(nil).InstanceScopedPhysicalDevice1_2))
+}
+
+// InstanceScopedPhysicalDevice1_3 mocks base method.
+func (m *PhysicalDevice1_3) InstanceScopedPhysicalDevice1_3() core1_3.InstanceScopedPhysicalDevice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceScopedPhysicalDevice1_3")
+	ret0, _ := ret[0].(core1_3.InstanceScopedPhysicalDevice)
+	return ret0
+}
+
+// InstanceScopedPhysicalDevice1_3 indicates an expected call of InstanceScopedPhysicalDevice1_3.
+func (mr *PhysicalDevice1_3MockRecorder) InstanceScopedPhysicalDevice1_3() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceScopedPhysicalDevice1_3", reflect.TypeOf((*PhysicalDevice1_3)(nil).InstanceScopedPhysicalDevice1_3))
+}
+
+// MemoryProperties mocks base method.
+func (m *PhysicalDevice1_3) MemoryProperties() *core1_0.PhysicalDeviceMemoryProperties {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemoryProperties")
+	ret0, _ := ret[0].(*core1_0.PhysicalDeviceMemoryProperties)
+	return ret0
+}
+
+// MemoryProperties indicates an expected call of MemoryProperties.
+func (mr *PhysicalDevice1_3MockRecorder) MemoryProperties() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemoryProperties", reflect.TypeOf((*PhysicalDevice1_3)(nil).MemoryProperties))
+}
+
+// MergedFormatProperties mocks base method.
+func (m *PhysicalDevice1_3) MergedFormatProperties(format core1_0.Format) (*core1_3.FormatProperties3, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergedFormatProperties", format)
+	ret0, _ := ret[0].(*core1_3.FormatProperties3)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergedFormatProperties indicates an expected call of MergedFormatProperties.
+func (mr *PhysicalDevice1_3MockRecorder) MergedFormatProperties(format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergedFormatProperties", reflect.TypeOf((*PhysicalDevice1_3)(nil).MergedFormatProperties), format)
+}
+
+// Properties mocks base method.
+func (m *PhysicalDevice1_3) Properties() (*core1_0.PhysicalDeviceProperties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Properties")
+	ret0, _ := ret[0].(*core1_0.PhysicalDeviceProperties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Properties indicates an expected call of Properties.
+func (mr *PhysicalDevice1_3MockRecorder) Properties() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Properties", reflect.TypeOf((*PhysicalDevice1_3)(nil).Properties))
+}
+
+// QueueFamilyProperties mocks base method.
+func (m *PhysicalDevice1_3) QueueFamilyProperties() []*core1_0.QueueFamilyProperties {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueFamilyProperties")
+	ret0, _ := ret[0].([]*core1_0.QueueFamilyProperties)
+	return ret0
+}
+
+// QueueFamilyProperties indicates an expected call of QueueFamilyProperties.
+func (mr *PhysicalDevice1_3MockRecorder) QueueFamilyProperties() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueFamilyProperties", reflect.TypeOf((*PhysicalDevice1_3)(nil).QueueFamilyProperties))
+}
+
+// SparseImageFormatProperties mocks base method.
+func (m *PhysicalDevice1_3) SparseImageFormatProperties(format core1_0.Format, imageType core1_0.ImageType, samples core1_0.SampleCountFlags, usages core1_0.ImageUsageFlags, tiling core1_0.ImageTiling) []core1_0.SparseImageFormatProperties {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SparseImageFormatProperties", format, imageType, samples, usages, tiling)
+	ret0, _ := ret[0].([]core1_0.SparseImageFormatProperties)
+	return ret0
+}
+
+// SparseImageFormatProperties indicates an expected call of SparseImageFormatProperties.
+func (mr *PhysicalDevice1_3MockRecorder) SparseImageFormatProperties(format, imageType, samples, usages, tiling interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SparseImageFormatProperties", reflect.TypeOf((*PhysicalDevice1_3)(nil).SparseImageFormatProperties), format, imageType, samples, usages, tiling)
+}
+
+// SupportsFormatFeatures mocks base method.
+func (m *PhysicalDevice1_3) SupportsFormatFeatures(format core1_0.Format, tiling core1_0.ImageTiling, features core1_3.FormatFeatureFlags2) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsFormatFeatures", format, tiling, features)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SupportsFormatFeatures indicates an expected call of SupportsFormatFeatures.
+func (mr *PhysicalDevice1_3MockRecorder) SupportsFormatFeatures(format, tiling, features interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsFormatFeatures", reflect.TypeOf((*PhysicalDevice1_3)(nil).SupportsFormatFeatures), format, tiling, features)
+}
+
+// ToolProperties mocks base method.
+func (m *PhysicalDevice1_3) ToolProperties(outDataFactory func() *core1_3.PhysicalDeviceToolProperties) ([]*core1_3.PhysicalDeviceToolProperties, common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToolProperties", outDataFactory)
+	ret0, _ := ret[0].([]*core1_3.PhysicalDeviceToolProperties)
+	ret1, _ := ret[1].(common.VkResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ToolProperties indicates an expected call of ToolProperties.
+func (mr *PhysicalDevice1_3MockRecorder) ToolProperties(outDataFactory interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToolProperties", reflect.TypeOf((*PhysicalDevice1_3)(nil).ToolProperties), outDataFactory)
+}
+
+// Queue1_3 is a mock of Queue interface.
+type Queue1_3 struct {
+	ctrl     *gomock.Controller
+	recorder *Queue1_3MockRecorder
+}
+
+// Queue1_3MockRecorder is the mock recorder for Queue1_3.
+type Queue1_3MockRecorder struct {
+	mock *Queue1_3
+}
+
+// NewQueue1_3 creates a new mock instance.
+func NewQueue1_3(ctrl *gomock.Controller) *Queue1_3 {
+	mock := &Queue1_3{ctrl: ctrl}
+	mock.recorder = &Queue1_3MockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Queue1_3) EXPECT() *Queue1_3MockRecorder {
+	return m.recorder
+}
+
+// APIVersion mocks base method.
+func (m *Queue1_3) APIVersion() common.APIVersion {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIVersion")
+	ret0, _ := ret[0].(common.APIVersion)
+	return ret0
+}
+
+// APIVersion indicates an expected call of APIVersion.
+func (mr *Queue1_3MockRecorder) APIVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIVersion", reflect.TypeOf((*Queue1_3)(nil).APIVersion))
+}
+
+// BindSparse mocks base method.
+func (m *Queue1_3) BindSparse(fence core1_0.Fence, bindInfos []core1_0.BindSparseInfo) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindSparse", fence, bindInfos)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BindSparse indicates an expected call of BindSparse.
+func (mr *Queue1_3MockRecorder) BindSparse(fence, bindInfos interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindSparse", reflect.TypeOf((*Queue1_3)(nil).BindSparse), fence, bindInfos)
+}
+
+// DeviceHandle mocks base method.
+func (m *Queue1_3) DeviceHandle() driver.VkDevice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeviceHandle")
+	ret0, _ := ret[0].(driver.VkDevice)
+	return ret0
+}
+
+// DeviceHandle indicates an expected call of DeviceHandle.
+func (mr *Queue1_3MockRecorder) DeviceHandle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeviceHandle", reflect.TypeOf((*Queue1_3)(nil).DeviceHandle))
+}
+
+// Driver mocks base method.
+func (m *Queue1_3) Driver() driver.Driver {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Driver")
+	ret0, _ := ret[0].(driver.Driver)
+	return ret0
+}
+
+// Driver indicates an expected call of Driver.
+func (mr *Queue1_3MockRecorder) Driver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Driver", reflect.TypeOf((*Queue1_3)(nil).Driver))
+}
+
+// Handle mocks base method.
+func (m *Queue1_3) Handle() driver.VkQueue {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle")
+	ret0, _ := ret[0].(driver.VkQueue)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *Queue1_3MockRecorder) Handle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*Queue1_3)(nil).Handle))
+}
+
+// Submit mocks base method.
+func (m *Queue1_3) Submit(fence core1_0.Fence, o []core1_0.SubmitInfo) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", fence, o)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *Queue1_3MockRecorder) Submit(fence, o interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*Queue1_3)(nil).Submit), fence, o)
+}
+
+// Submit2 mocks base method.
+func (m *Queue1_3) Submit2(fence core1_0.Fence, submits []core1_3.SubmitInfo2) (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit2", fence, submits)
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit2 indicates an expected call of Submit2.
+func (mr *Queue1_3MockRecorder) Submit2(fence, submits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit2", reflect.TypeOf((*Queue1_3)(nil).Submit2), fence, submits)
+}
+
+// WaitIdle mocks base method.
+func (m *Queue1_3) WaitIdle() (common.VkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitIdle")
+	ret0, _ := ret[0].(common.VkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitIdle indicates an expected call of WaitIdle.
+func (mr *Queue1_3MockRecorder) WaitIdle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitIdle", reflect.TypeOf((*Queue1_3)(nil).WaitIdle))
+}
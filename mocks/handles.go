@@ -90,6 +90,10 @@ func NewFakePipelineLayout() driver.VkPipelineLayout {
 	return driver.VkPipelineLayout(fakePointer())
 }
 
+func NewFakePrivateDataSlot() driver.VkPrivateDataSlot {
+	return driver.VkPrivateDataSlot(fakePointer())
+}
+
 func NewFakeQueryPool() driver.VkQueryPool {
 	return driver.VkQueryPool(fakePointer())
 }
@@ -158,6 +158,13 @@ func EasyMockPhysicalDevice(ctrl *gomock.Controller, driver driver.Driver) *Mock
 	return physicalDevice
 }
 
+func EasyMockPrivateDataSlot(ctrl *gomock.Controller) *PrivateDataSlot1_3 {
+	slot := NewPrivateDataSlot1_3(ctrl)
+	slot.EXPECT().Handle().Return(NewFakePrivateDataSlot()).AnyTimes()
+
+	return slot
+}
+
 func EasyMockQueryPool(ctrl *gomock.Controller) *MockQueryPool {
 	queryPool := NewMockQueryPool(ctrl)
 	queryPool.EXPECT().Handle().Return(NewFakeQueryPool()).AnyTimes()
@@ -0,0 +1,18 @@
+//go:build !windows && vulkan_dlopen
+
+package core
+
+import "runtime"
+
+// CreateSystemLoader generates a Loader from a vulkan-1.dll/so located on the local file system
+//
+// Because this binary was built with the vulkan_dlopen build tag, it does not link against the
+// Vulkan loader, and the loader is opened with CreateLoaderFromLibrary instead. This returns an
+// error, rather than preventing the binary from starting, on machines without Vulkan.
+func CreateSystemLoader() (*VulkanLoader, error) {
+	if runtime.GOOS == "darwin" {
+		return CreateLoaderFromLibrary("libvulkan.1.dylib")
+	}
+
+	return CreateLoaderFromLibrary("libvulkan.so.1")
+}
@@ -1,4 +1,4 @@
-//go:build !windows
+//go:build !windows && !vulkan_dlopen
 
 package core
 
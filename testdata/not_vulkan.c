@@ -0,0 +1,4 @@
+// A shared library that does not export vkGetInstanceProcAddr, used by library_test.go
+int notVulkan(void) {
+    return 0;
+}
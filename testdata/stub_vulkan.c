@@ -0,0 +1,19 @@
+// A stand-in for the Vulkan loader, used by library_test.go. It only provides
+// vkEnumerateInstanceVersion, which reports Vulkan 1.2.
+#include <stdint.h>
+#include <string.h>
+
+typedef void (*PFN_vkVoidFunction)(void);
+
+static int32_t enumerateInstanceVersion(uint32_t *pApiVersion) {
+    *pApiVersion = (1u << 22) | (2u << 12);
+    return 0;
+}
+
+PFN_vkVoidFunction vkGetInstanceProcAddr(void *instance, const char *pName) {
+    if (instance == NULL && strcmp(pName, "vkEnumerateInstanceVersion") == 0) {
+        return (PFN_vkVoidFunction)enumerateInstanceVersion;
+    }
+
+    return NULL;
+}
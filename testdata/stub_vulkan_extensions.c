@@ -0,0 +1,157 @@
+// A stand-in for a Vulkan 1.2 driver, used by library_test.go. Its Device provides none of the
+// core 1.3 commands, only the aliases of the extensions that provide them. Each command buffer and
+// queue it hands out is a stubObject, which records the commands that are called on it.
+#include <string.h>
+#include "../common/vulkan.h"
+
+typedef struct stubObject {
+    uint32_t commandCount;
+    char lastCommand[64];
+    VkRect2D renderArea;
+} stubObject;
+
+static int stubInstance;
+static int stubPhysicalDevice;
+static int stubDevice;
+static stubObject stubQueue;
+static stubObject stubCommandBuffers[4];
+static uint32_t stubCommandBufferCount;
+
+static void record(void *object, const char *command) {
+    stubObject *stub = (stubObject *)object;
+    stub->commandCount++;
+    strncpy(stub->lastCommand, command, sizeof(stub->lastCommand) - 1);
+}
+
+static VkResult enumerateInstanceVersion(uint32_t *pApiVersion) {
+    *pApiVersion = VK_API_VERSION_1_2;
+    return VK_SUCCESS;
+}
+
+static VkResult createInstance(const VkInstanceCreateInfo *pCreateInfo, const VkAllocationCallbacks *pAllocator, VkInstance *pInstance) {
+    *pInstance = (VkInstance)&stubInstance;
+    return VK_SUCCESS;
+}
+
+static VkResult enumeratePhysicalDevices(VkInstance instance, uint32_t *pPhysicalDeviceCount, VkPhysicalDevice *pPhysicalDevices) {
+    if (pPhysicalDevices != NULL) {
+        pPhysicalDevices[0] = (VkPhysicalDevice)&stubPhysicalDevice;
+    }
+    *pPhysicalDeviceCount = 1;
+    return VK_SUCCESS;
+}
+
+static void getPhysicalDeviceProperties(VkPhysicalDevice physicalDevice, VkPhysicalDeviceProperties *pProperties) {
+    memset(pProperties, 0, sizeof(*pProperties));
+    pProperties->apiVersion = VK_API_VERSION_1_2;
+    strcpy(pProperties->deviceName, "Stub Device");
+}
+
+static VkResult createDevice(VkPhysicalDevice physicalDevice, const VkDeviceCreateInfo *pCreateInfo, const VkAllocationCallbacks *pAllocator, VkDevice *pDevice) {
+    *pDevice = (VkDevice)&stubDevice;
+    return VK_SUCCESS;
+}
+
+static void getDeviceQueue(VkDevice device, uint32_t queueFamilyIndex, uint32_t queueIndex, VkQueue *pQueue) {
+    *pQueue = (VkQueue)&stubQueue;
+}
+
+static VkResult createCommandPool(VkDevice device, const VkCommandPoolCreateInfo *pCreateInfo, const VkAllocationCallbacks *pAllocator, VkCommandPool *pCommandPool) {
+    *pCommandPool = (VkCommandPool)1;
+    return VK_SUCCESS;
+}
+
+static VkResult allocateCommandBuffers(VkDevice device, const VkCommandBufferAllocateInfo *pAllocateInfo, VkCommandBuffer *pCommandBuffers) {
+    if (stubCommandBufferCount + pAllocateInfo->commandBufferCount > 4) {
+        return VK_ERROR_OUT_OF_HOST_MEMORY;
+    }
+
+    for (uint32_t i = 0; i < pAllocateInfo->commandBufferCount; i++) {
+        pCommandBuffers[i] = (VkCommandBuffer)&stubCommandBuffers[stubCommandBufferCount++];
+    }
+    return VK_SUCCESS;
+}
+
+static void cmdBeginRenderingKHR(VkCommandBuffer commandBuffer, const VkRenderingInfo *pRenderingInfo) {
+    record(commandBuffer, "vkCmdBeginRenderingKHR");
+    ((stubObject *)commandBuffer)->renderArea = pRenderingInfo->renderArea;
+}
+
+static void cmdEndRenderingKHR(VkCommandBuffer commandBuffer) {
+    record(commandBuffer, "vkCmdEndRenderingKHR");
+}
+
+static void cmdPipelineBarrier2KHR(VkCommandBuffer commandBuffer, const VkDependencyInfo *pDependencyInfo) {
+    record(commandBuffer, "vkCmdPipelineBarrier2KHR");
+}
+
+static void cmdSetCullModeEXT(VkCommandBuffer commandBuffer, VkCullModeFlags cullMode) {
+    record(commandBuffer, "vkCmdSetCullModeEXT");
+}
+
+static void cmdSetPrimitiveRestartEnableEXT(VkCommandBuffer commandBuffer, VkBool32 primitiveRestartEnable) {
+    record(commandBuffer, "vkCmdSetPrimitiveRestartEnableEXT");
+}
+
+static void cmdCopyBuffer2KHR(VkCommandBuffer commandBuffer, const VkCopyBufferInfo2 *pCopyBufferInfo) {
+    record(commandBuffer, "vkCmdCopyBuffer2KHR");
+}
+
+static void getDeviceBufferMemoryRequirementsKHR(VkDevice device, const VkDeviceBufferMemoryRequirements *pInfo, VkMemoryRequirements2 *pMemoryRequirements) {
+    pMemoryRequirements->memoryRequirements.size = pInfo->pCreateInfo->size * 2;
+    pMemoryRequirements->memoryRequirements.alignment = 256;
+    pMemoryRequirements->memoryRequirements.memoryTypeBits = 5;
+}
+
+static VkResult queueSubmit2KHR(VkQueue queue, uint32_t submitCount, const VkSubmitInfo2 *pSubmits, VkFence fence) {
+    record(queue, "vkQueueSubmit2KHR");
+    return VK_SUCCESS;
+}
+
+PFN_vkVoidFunction vkGetDeviceProcAddr(VkDevice device, const char *pName) {
+    if (strcmp(pName, "vkGetDeviceQueue") == 0) {
+        return (PFN_vkVoidFunction)getDeviceQueue;
+    } else if (strcmp(pName, "vkCreateCommandPool") == 0) {
+        return (PFN_vkVoidFunction)createCommandPool;
+    } else if (strcmp(pName, "vkAllocateCommandBuffers") == 0) {
+        return (PFN_vkVoidFunction)allocateCommandBuffers;
+    } else if (strcmp(pName, "vkCmdBeginRenderingKHR") == 0) {
+        return (PFN_vkVoidFunction)cmdBeginRenderingKHR;
+    } else if (strcmp(pName, "vkCmdEndRenderingKHR") == 0) {
+        return (PFN_vkVoidFunction)cmdEndRenderingKHR;
+    } else if (strcmp(pName, "vkCmdPipelineBarrier2KHR") == 0) {
+        return (PFN_vkVoidFunction)cmdPipelineBarrier2KHR;
+    } else if (strcmp(pName, "vkQueueSubmit2KHR") == 0) {
+        return (PFN_vkVoidFunction)queueSubmit2KHR;
+    } else if (strcmp(pName, "vkCmdSetCullModeEXT") == 0) {
+        return (PFN_vkVoidFunction)cmdSetCullModeEXT;
+    } else if (strcmp(pName, "vkCmdSetPrimitiveRestartEnableEXT") == 0) {
+        return (PFN_vkVoidFunction)cmdSetPrimitiveRestartEnableEXT;
+    } else if (strcmp(pName, "vkCmdCopyBuffer2KHR") == 0) {
+        return (PFN_vkVoidFunction)cmdCopyBuffer2KHR;
+    } else if (strcmp(pName, "vkGetDeviceBufferMemoryRequirementsKHR") == 0) {
+        return (PFN_vkVoidFunction)getDeviceBufferMemoryRequirementsKHR;
+    }
+
+    return NULL;
+}
+
+PFN_vkVoidFunction vkGetInstanceProcAddr(VkInstance instance, const char *pName) {
+    if (strcmp(pName, "vkEnumerateInstanceVersion") == 0) {
+        return (PFN_vkVoidFunction)enumerateInstanceVersion;
+    } else if (strcmp(pName, "vkCreateInstance") == 0) {
+        return (PFN_vkVoidFunction)createInstance;
+    } else if (instance == NULL) {
+        return NULL;
+    } else if (strcmp(pName, "vkEnumeratePhysicalDevices") == 0) {
+        return (PFN_vkVoidFunction)enumeratePhysicalDevices;
+    } else if (strcmp(pName, "vkGetPhysicalDeviceProperties") == 0) {
+        return (PFN_vkVoidFunction)getPhysicalDeviceProperties;
+    } else if (strcmp(pName, "vkCreateDevice") == 0) {
+        return (PFN_vkVoidFunction)createDevice;
+    } else if (strcmp(pName, "vkGetDeviceProcAddr") == 0) {
+        return (PFN_vkVoidFunction)vkGetDeviceProcAddr;
+    }
+
+    return NULL;
+}